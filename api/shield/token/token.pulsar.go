@@ -521,6 +521,10 @@ const (
 	Type_DIV       Type = 22
 	Type_TRUE      Type = 23
 	Type_FALSE     Type = 24
+	Type_LBRACE    Type = 25
+	Type_RBRACE    Type = 26
+	Type_COLON     Type = 27
+	Type_DOT       Type = 28
 )
 
 // Enum value maps for Type.
@@ -551,6 +555,10 @@ var (
 		22: "DIV",
 		23: "TRUE",
 		24: "FALSE",
+		25: "LBRACE",
+		26: "RBRACE",
+		27: "COLON",
+		28: "DOT",
 	}
 	Type_value = map[string]int32{
 		"ILLEGAL":   0,
@@ -578,6 +586,10 @@ var (
 		"DIV":       22,
 		"TRUE":      23,
 		"FALSE":     24,
+		"LBRACE":    25,
+		"RBRACE":    26,
+		"COLON":     27,
+		"DOT":       28,
 	}
 )
 
@@ -661,7 +673,7 @@ var file_shield_token_token_proto_rawDesc = []byte{
 	0x12, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x54,
 	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x74,
 	0x65, 0x72, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x2a, 0x87, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x72, 0x61, 0x6c, 0x2a, 0xb3, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
 	0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4f, 0x46,
 	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a,
 	0x03, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47,
@@ -677,19 +689,21 @@ var file_shield_token_token_proto_rawDesc = []byte{
 	0x54, 0x45, 0x10, 0x12, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x13, 0x12, 0x07, 0x0a,
 	0x03, 0x53, 0x55, 0x42, 0x10, 0x14, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x55, 0x4c, 0x10, 0x15, 0x12,
 	0x07, 0x0a, 0x03, 0x44, 0x49, 0x56, 0x10, 0x16, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x55, 0x45,
-	0x10, 0x17, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x18, 0x42, 0xab, 0x01,
-	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x42, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xa2, 0x02, 0x03, 0x53,
-	0x54, 0x58, 0xaa, 0x02, 0x0c, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0xca, 0x02, 0x0c, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0xe2, 0x02, 0x18, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x53, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x10, 0x17, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x18, 0x12, 0x0a, 0x0a,
+	0x06, 0x4c, 0x42, 0x52, 0x41, 0x43, 0x45, 0x10, 0x19, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x42, 0x52,
+	0x41, 0x43, 0x45, 0x10, 0x1a, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4f, 0x4c, 0x4f, 0x4e, 0x10, 0x1b,
+	0x12, 0x07, 0x0a, 0x03, 0x44, 0x4f, 0x54, 0x10, 0x1c, 0x42, 0xab, 0x01, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0a,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xa2, 0x02, 0x03, 0x53, 0x54, 0x58, 0xaa, 0x02,
+	0x0c, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0x02, 0x0c,
+	0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0xe2, 0x02, 0x18, 0x53,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -11,6 +11,7 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
@@ -114,20 +115,331 @@ func (x *_Action_12_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Action_15_list)(nil)
+
+type _Action_15_list struct {
+	list *[]string
+}
+
+func (x *_Action_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Action_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Action_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Action_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Action_15_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Action at list field Dependencies as it is not of Message kind"))
+}
+
+func (x *_Action_15_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Action_15_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Action_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Action_18_list)(nil)
+
+type _Action_18_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Action_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Action_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Action_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Action_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Action_18_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Action_18_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Action_19_list)(nil)
+
+type _Action_19_list struct {
+	list *[]*ActionMsgExpressions
+}
+
+func (x *_Action_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Action_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Action_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActionMsgExpressions)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Action_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActionMsgExpressions)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Action_19_list) AppendMutable() protoreflect.Value {
+	v := new(ActionMsgExpressions)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Action_19_list) NewElement() protoreflect.Value {
+	v := new(ActionMsgExpressions)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Action_23_list)(nil)
+
+type _Action_23_list struct {
+	list *[]*TemplateRef
+}
+
+func (x *_Action_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Action_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Action_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TemplateRef)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Action_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TemplateRef)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Action_23_list) AppendMutable() protoreflect.Value {
+	v := new(TemplateRef)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Action_23_list) NewElement() protoreflect.Value {
+	v := new(TemplateRef)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Action_24_list)(nil)
+
+type _Action_24_list struct {
+	list *[]*TemplateRef
+}
+
+func (x *_Action_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Action_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Action_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TemplateRef)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Action_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TemplateRef)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Action_24_list) AppendMutable() protoreflect.Value {
+	v := new(TemplateRef)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Action_24_list) NewElement() protoreflect.Value {
+	v := new(TemplateRef)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Action_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Action_25_list)(nil)
+
+type _Action_25_list struct {
+	list *[]uint64
+}
+
+func (x *_Action_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Action_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_Action_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Action_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Action_25_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Action at list field SpaceIds as it is not of Message kind"))
+}
+
+func (x *_Action_25_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Action_25_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_Action_25_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Action                    protoreflect.MessageDescriptor
-	fd_Action_id                 protoreflect.FieldDescriptor
-	fd_Action_status             protoreflect.FieldDescriptor
-	fd_Action_msg                protoreflect.FieldDescriptor
-	fd_Action_result             protoreflect.FieldDescriptor
-	fd_Action_creator            protoreflect.FieldDescriptor
-	fd_Action_timeout_height     protoreflect.FieldDescriptor
-	fd_Action_created_at         protoreflect.FieldDescriptor
-	fd_Action_updated_at         protoreflect.FieldDescriptor
-	fd_Action_mentions           protoreflect.FieldDescriptor
-	fd_Action_approve_expression protoreflect.FieldDescriptor
-	fd_Action_reject_expression  protoreflect.FieldDescriptor
-	fd_Action_votes              protoreflect.FieldDescriptor
+	md_Action                             protoreflect.MessageDescriptor
+	fd_Action_id                          protoreflect.FieldDescriptor
+	fd_Action_status                      protoreflect.FieldDescriptor
+	fd_Action_msg                         protoreflect.FieldDescriptor
+	fd_Action_result                      protoreflect.FieldDescriptor
+	fd_Action_creator                     protoreflect.FieldDescriptor
+	fd_Action_timeout_height              protoreflect.FieldDescriptor
+	fd_Action_created_at                  protoreflect.FieldDescriptor
+	fd_Action_updated_at                  protoreflect.FieldDescriptor
+	fd_Action_mentions                    protoreflect.FieldDescriptor
+	fd_Action_approve_expression          protoreflect.FieldDescriptor
+	fd_Action_reject_expression           protoreflect.FieldDescriptor
+	fd_Action_votes                       protoreflect.FieldDescriptor
+	fd_Action_approve_bytecode            protoreflect.FieldDescriptor
+	fd_Action_reject_bytecode             protoreflect.FieldDescriptor
+	fd_Action_dependencies                protoreflect.FieldDescriptor
+	fd_Action_approve_template_expression protoreflect.FieldDescriptor
+	fd_Action_reject_template_expression  protoreflect.FieldDescriptor
+	fd_Action_msgs                        protoreflect.FieldDescriptor
+	fd_Action_msg_expressions             protoreflect.FieldDescriptor
+	fd_Action_error                       protoreflect.FieldDescriptor
+	fd_Action_execution_delay             protoreflect.FieldDescriptor
+	fd_Action_execute_after               protoreflect.FieldDescriptor
+	fd_Action_approve_templates           protoreflect.FieldDescriptor
+	fd_Action_reject_templates            protoreflect.FieldDescriptor
+	fd_Action_space_ids                   protoreflect.FieldDescriptor
+	fd_Action_evaluation_error            protoreflect.FieldDescriptor
+	fd_Action_retries                     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -145,6 +457,21 @@ func init() {
 	fd_Action_approve_expression = md_Action.Fields().ByName("approve_expression")
 	fd_Action_reject_expression = md_Action.Fields().ByName("reject_expression")
 	fd_Action_votes = md_Action.Fields().ByName("votes")
+	fd_Action_approve_bytecode = md_Action.Fields().ByName("approve_bytecode")
+	fd_Action_reject_bytecode = md_Action.Fields().ByName("reject_bytecode")
+	fd_Action_dependencies = md_Action.Fields().ByName("dependencies")
+	fd_Action_approve_template_expression = md_Action.Fields().ByName("approve_template_expression")
+	fd_Action_reject_template_expression = md_Action.Fields().ByName("reject_template_expression")
+	fd_Action_msgs = md_Action.Fields().ByName("msgs")
+	fd_Action_msg_expressions = md_Action.Fields().ByName("msg_expressions")
+	fd_Action_error = md_Action.Fields().ByName("error")
+	fd_Action_execution_delay = md_Action.Fields().ByName("execution_delay")
+	fd_Action_execute_after = md_Action.Fields().ByName("execute_after")
+	fd_Action_approve_templates = md_Action.Fields().ByName("approve_templates")
+	fd_Action_reject_templates = md_Action.Fields().ByName("reject_templates")
+	fd_Action_space_ids = md_Action.Fields().ByName("space_ids")
+	fd_Action_evaluation_error = md_Action.Fields().ByName("evaluation_error")
+	fd_Action_retries = md_Action.Fields().ByName("retries")
 }
 
 var _ protoreflect.Message = (*fastReflection_Action)(nil)
@@ -284,6 +611,96 @@ func (x *fastReflection_Action) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.ApproveBytecode) != 0 {
+		value := protoreflect.ValueOfBytes(x.ApproveBytecode)
+		if !f(fd_Action_approve_bytecode, value) {
+			return
+		}
+	}
+	if len(x.RejectBytecode) != 0 {
+		value := protoreflect.ValueOfBytes(x.RejectBytecode)
+		if !f(fd_Action_reject_bytecode, value) {
+			return
+		}
+	}
+	if len(x.Dependencies) != 0 {
+		value := protoreflect.ValueOfList(&_Action_15_list{list: &x.Dependencies})
+		if !f(fd_Action_dependencies, value) {
+			return
+		}
+	}
+	if x.ApproveTemplateExpression != nil {
+		value := protoreflect.ValueOfMessage(x.ApproveTemplateExpression.ProtoReflect())
+		if !f(fd_Action_approve_template_expression, value) {
+			return
+		}
+	}
+	if x.RejectTemplateExpression != nil {
+		value := protoreflect.ValueOfMessage(x.RejectTemplateExpression.ProtoReflect())
+		if !f(fd_Action_reject_template_expression, value) {
+			return
+		}
+	}
+	if len(x.Msgs) != 0 {
+		value := protoreflect.ValueOfList(&_Action_18_list{list: &x.Msgs})
+		if !f(fd_Action_msgs, value) {
+			return
+		}
+	}
+	if len(x.MsgExpressions) != 0 {
+		value := protoreflect.ValueOfList(&_Action_19_list{list: &x.MsgExpressions})
+		if !f(fd_Action_msg_expressions, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_Action_error, value) {
+			return
+		}
+	}
+	if x.ExecutionDelay != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionDelay.ProtoReflect())
+		if !f(fd_Action_execution_delay, value) {
+			return
+		}
+	}
+	if x.ExecuteAfter != nil {
+		value := protoreflect.ValueOfMessage(x.ExecuteAfter.ProtoReflect())
+		if !f(fd_Action_execute_after, value) {
+			return
+		}
+	}
+	if len(x.ApproveTemplates) != 0 {
+		value := protoreflect.ValueOfList(&_Action_23_list{list: &x.ApproveTemplates})
+		if !f(fd_Action_approve_templates, value) {
+			return
+		}
+	}
+	if len(x.RejectTemplates) != 0 {
+		value := protoreflect.ValueOfList(&_Action_24_list{list: &x.RejectTemplates})
+		if !f(fd_Action_reject_templates, value) {
+			return
+		}
+	}
+	if len(x.SpaceIds) != 0 {
+		value := protoreflect.ValueOfList(&_Action_25_list{list: &x.SpaceIds})
+		if !f(fd_Action_space_ids, value) {
+			return
+		}
+	}
+	if x.EvaluationError != "" {
+		value := protoreflect.ValueOfString(x.EvaluationError)
+		if !f(fd_Action_evaluation_error, value) {
+			return
+		}
+	}
+	if x.Retries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Retries)
+		if !f(fd_Action_retries, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -323,6 +740,36 @@ func (x *fastReflection_Action) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.RejectExpression != nil
 	case "warden.act.v1beta1.Action.votes":
 		return len(x.Votes) != 0
+	case "warden.act.v1beta1.Action.approve_bytecode":
+		return len(x.ApproveBytecode) != 0
+	case "warden.act.v1beta1.Action.reject_bytecode":
+		return len(x.RejectBytecode) != 0
+	case "warden.act.v1beta1.Action.dependencies":
+		return len(x.Dependencies) != 0
+	case "warden.act.v1beta1.Action.approve_template_expression":
+		return x.ApproveTemplateExpression != nil
+	case "warden.act.v1beta1.Action.reject_template_expression":
+		return x.RejectTemplateExpression != nil
+	case "warden.act.v1beta1.Action.msgs":
+		return len(x.Msgs) != 0
+	case "warden.act.v1beta1.Action.msg_expressions":
+		return len(x.MsgExpressions) != 0
+	case "warden.act.v1beta1.Action.error":
+		return x.Error != ""
+	case "warden.act.v1beta1.Action.execution_delay":
+		return x.ExecutionDelay != nil
+	case "warden.act.v1beta1.Action.execute_after":
+		return x.ExecuteAfter != nil
+	case "warden.act.v1beta1.Action.approve_templates":
+		return len(x.ApproveTemplates) != 0
+	case "warden.act.v1beta1.Action.reject_templates":
+		return len(x.RejectTemplates) != 0
+	case "warden.act.v1beta1.Action.space_ids":
+		return len(x.SpaceIds) != 0
+	case "warden.act.v1beta1.Action.evaluation_error":
+		return x.EvaluationError != ""
+	case "warden.act.v1beta1.Action.retries":
+		return x.Retries != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.Action"))
@@ -363,6 +810,36 @@ func (x *fastReflection_Action) Clear(fd protoreflect.FieldDescriptor) {
 		x.RejectExpression = nil
 	case "warden.act.v1beta1.Action.votes":
 		x.Votes = nil
+	case "warden.act.v1beta1.Action.approve_bytecode":
+		x.ApproveBytecode = nil
+	case "warden.act.v1beta1.Action.reject_bytecode":
+		x.RejectBytecode = nil
+	case "warden.act.v1beta1.Action.dependencies":
+		x.Dependencies = nil
+	case "warden.act.v1beta1.Action.approve_template_expression":
+		x.ApproveTemplateExpression = nil
+	case "warden.act.v1beta1.Action.reject_template_expression":
+		x.RejectTemplateExpression = nil
+	case "warden.act.v1beta1.Action.msgs":
+		x.Msgs = nil
+	case "warden.act.v1beta1.Action.msg_expressions":
+		x.MsgExpressions = nil
+	case "warden.act.v1beta1.Action.error":
+		x.Error = ""
+	case "warden.act.v1beta1.Action.execution_delay":
+		x.ExecutionDelay = nil
+	case "warden.act.v1beta1.Action.execute_after":
+		x.ExecuteAfter = nil
+	case "warden.act.v1beta1.Action.approve_templates":
+		x.ApproveTemplates = nil
+	case "warden.act.v1beta1.Action.reject_templates":
+		x.RejectTemplates = nil
+	case "warden.act.v1beta1.Action.space_ids":
+		x.SpaceIds = nil
+	case "warden.act.v1beta1.Action.evaluation_error":
+		x.EvaluationError = ""
+	case "warden.act.v1beta1.Action.retries":
+		x.Retries = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.Action"))
@@ -421,9 +898,72 @@ func (x *fastReflection_Action) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Action_12_list{list: &x.Votes}
 		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.Action"))
+	case "warden.act.v1beta1.Action.approve_bytecode":
+		value := x.ApproveBytecode
+		return protoreflect.ValueOfBytes(value)
+	case "warden.act.v1beta1.Action.reject_bytecode":
+		value := x.RejectBytecode
+		return protoreflect.ValueOfBytes(value)
+	case "warden.act.v1beta1.Action.dependencies":
+		if len(x.Dependencies) == 0 {
+			return protoreflect.ValueOfList(&_Action_15_list{})
+		}
+		listValue := &_Action_15_list{list: &x.Dependencies}
+		return protoreflect.ValueOfList(listValue)
+	case "warden.act.v1beta1.Action.approve_template_expression":
+		value := x.ApproveTemplateExpression
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.Action.reject_template_expression":
+		value := x.RejectTemplateExpression
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.Action.msgs":
+		if len(x.Msgs) == 0 {
+			return protoreflect.ValueOfList(&_Action_18_list{})
+		}
+		listValue := &_Action_18_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(listValue)
+	case "warden.act.v1beta1.Action.msg_expressions":
+		if len(x.MsgExpressions) == 0 {
+			return protoreflect.ValueOfList(&_Action_19_list{})
+		}
+		listValue := &_Action_19_list{list: &x.MsgExpressions}
+		return protoreflect.ValueOfList(listValue)
+	case "warden.act.v1beta1.Action.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.Action.execution_delay":
+		value := x.ExecutionDelay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.Action.execute_after":
+		value := x.ExecuteAfter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.Action.approve_templates":
+		if len(x.ApproveTemplates) == 0 {
+			return protoreflect.ValueOfList(&_Action_23_list{})
+		}
+		listValue := &_Action_23_list{list: &x.ApproveTemplates}
+		return protoreflect.ValueOfList(listValue)
+	case "warden.act.v1beta1.Action.reject_templates":
+		if len(x.RejectTemplates) == 0 {
+			return protoreflect.ValueOfList(&_Action_24_list{})
+		}
+		listValue := &_Action_24_list{list: &x.RejectTemplates}
+		return protoreflect.ValueOfList(listValue)
+	case "warden.act.v1beta1.Action.space_ids":
+		if len(x.SpaceIds) == 0 {
+			return protoreflect.ValueOfList(&_Action_25_list{})
+		}
+		listValue := &_Action_25_list{list: &x.SpaceIds}
+		return protoreflect.ValueOfList(listValue)
+	case "warden.act.v1beta1.Action.evaluation_error":
+		value := x.EvaluationError
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.Action.retries":
+		value := x.Retries
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.Action"))
 		}
 		panic(fmt.Errorf("message warden.act.v1beta1.Action does not contain field %s", descriptor.FullName()))
 	}
@@ -469,6 +1009,48 @@ func (x *fastReflection_Action) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Action_12_list)
 		x.Votes = *clv.list
+	case "warden.act.v1beta1.Action.approve_bytecode":
+		x.ApproveBytecode = value.Bytes()
+	case "warden.act.v1beta1.Action.reject_bytecode":
+		x.RejectBytecode = value.Bytes()
+	case "warden.act.v1beta1.Action.dependencies":
+		lv := value.List()
+		clv := lv.(*_Action_15_list)
+		x.Dependencies = *clv.list
+	case "warden.act.v1beta1.Action.approve_template_expression":
+		x.ApproveTemplateExpression = value.Message().Interface().(*ast.Expression)
+	case "warden.act.v1beta1.Action.reject_template_expression":
+		x.RejectTemplateExpression = value.Message().Interface().(*ast.Expression)
+	case "warden.act.v1beta1.Action.msgs":
+		lv := value.List()
+		clv := lv.(*_Action_18_list)
+		x.Msgs = *clv.list
+	case "warden.act.v1beta1.Action.msg_expressions":
+		lv := value.List()
+		clv := lv.(*_Action_19_list)
+		x.MsgExpressions = *clv.list
+	case "warden.act.v1beta1.Action.error":
+		x.Error = value.Interface().(string)
+	case "warden.act.v1beta1.Action.execution_delay":
+		x.ExecutionDelay = value.Message().Interface().(*durationpb.Duration)
+	case "warden.act.v1beta1.Action.execute_after":
+		x.ExecuteAfter = value.Message().Interface().(*timestamppb.Timestamp)
+	case "warden.act.v1beta1.Action.approve_templates":
+		lv := value.List()
+		clv := lv.(*_Action_23_list)
+		x.ApproveTemplates = *clv.list
+	case "warden.act.v1beta1.Action.reject_templates":
+		lv := value.List()
+		clv := lv.(*_Action_24_list)
+		x.RejectTemplates = *clv.list
+	case "warden.act.v1beta1.Action.space_ids":
+		lv := value.List()
+		clv := lv.(*_Action_25_list)
+		x.SpaceIds = *clv.list
+	case "warden.act.v1beta1.Action.evaluation_error":
+		x.EvaluationError = value.Interface().(string)
+	case "warden.act.v1beta1.Action.retries":
+		x.Retries = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.Action"))
@@ -531,6 +1113,62 @@ func (x *fastReflection_Action) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Action_12_list{list: &x.Votes}
 		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.Action.dependencies":
+		if x.Dependencies == nil {
+			x.Dependencies = []string{}
+		}
+		value := &_Action_15_list{list: &x.Dependencies}
+		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.Action.approve_template_expression":
+		if x.ApproveTemplateExpression == nil {
+			x.ApproveTemplateExpression = new(ast.Expression)
+		}
+		return protoreflect.ValueOfMessage(x.ApproveTemplateExpression.ProtoReflect())
+	case "warden.act.v1beta1.Action.reject_template_expression":
+		if x.RejectTemplateExpression == nil {
+			x.RejectTemplateExpression = new(ast.Expression)
+		}
+		return protoreflect.ValueOfMessage(x.RejectTemplateExpression.ProtoReflect())
+	case "warden.act.v1beta1.Action.msgs":
+		if x.Msgs == nil {
+			x.Msgs = []*anypb.Any{}
+		}
+		value := &_Action_18_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.Action.msg_expressions":
+		if x.MsgExpressions == nil {
+			x.MsgExpressions = []*ActionMsgExpressions{}
+		}
+		value := &_Action_19_list{list: &x.MsgExpressions}
+		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.Action.execution_delay":
+		if x.ExecutionDelay == nil {
+			x.ExecutionDelay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionDelay.ProtoReflect())
+	case "warden.act.v1beta1.Action.execute_after":
+		if x.ExecuteAfter == nil {
+			x.ExecuteAfter = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExecuteAfter.ProtoReflect())
+	case "warden.act.v1beta1.Action.approve_templates":
+		if x.ApproveTemplates == nil {
+			x.ApproveTemplates = []*TemplateRef{}
+		}
+		value := &_Action_23_list{list: &x.ApproveTemplates}
+		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.Action.reject_templates":
+		if x.RejectTemplates == nil {
+			x.RejectTemplates = []*TemplateRef{}
+		}
+		value := &_Action_24_list{list: &x.RejectTemplates}
+		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.Action.space_ids":
+		if x.SpaceIds == nil {
+			x.SpaceIds = []uint64{}
+		}
+		value := &_Action_25_list{list: &x.SpaceIds}
+		return protoreflect.ValueOfList(value)
 	case "warden.act.v1beta1.Action.id":
 		panic(fmt.Errorf("field id of message warden.act.v1beta1.Action is not mutable"))
 	case "warden.act.v1beta1.Action.status":
@@ -539,6 +1177,16 @@ func (x *fastReflection_Action) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field creator of message warden.act.v1beta1.Action is not mutable"))
 	case "warden.act.v1beta1.Action.timeout_height":
 		panic(fmt.Errorf("field timeout_height of message warden.act.v1beta1.Action is not mutable"))
+	case "warden.act.v1beta1.Action.approve_bytecode":
+		panic(fmt.Errorf("field approve_bytecode of message warden.act.v1beta1.Action is not mutable"))
+	case "warden.act.v1beta1.Action.reject_bytecode":
+		panic(fmt.Errorf("field reject_bytecode of message warden.act.v1beta1.Action is not mutable"))
+	case "warden.act.v1beta1.Action.error":
+		panic(fmt.Errorf("field error of message warden.act.v1beta1.Action is not mutable"))
+	case "warden.act.v1beta1.Action.evaluation_error":
+		panic(fmt.Errorf("field evaluation_error of message warden.act.v1beta1.Action is not mutable"))
+	case "warden.act.v1beta1.Action.retries":
+		panic(fmt.Errorf("field retries of message warden.act.v1beta1.Action is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.Action"))
@@ -584,6 +1232,46 @@ func (x *fastReflection_Action) NewField(fd protoreflect.FieldDescriptor) protor
 	case "warden.act.v1beta1.Action.votes":
 		list := []*ActionVote{}
 		return protoreflect.ValueOfList(&_Action_12_list{list: &list})
+	case "warden.act.v1beta1.Action.approve_bytecode":
+		return protoreflect.ValueOfBytes(nil)
+	case "warden.act.v1beta1.Action.reject_bytecode":
+		return protoreflect.ValueOfBytes(nil)
+	case "warden.act.v1beta1.Action.dependencies":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Action_15_list{list: &list})
+	case "warden.act.v1beta1.Action.approve_template_expression":
+		m := new(ast.Expression)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.Action.reject_template_expression":
+		m := new(ast.Expression)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.Action.msgs":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Action_18_list{list: &list})
+	case "warden.act.v1beta1.Action.msg_expressions":
+		list := []*ActionMsgExpressions{}
+		return protoreflect.ValueOfList(&_Action_19_list{list: &list})
+	case "warden.act.v1beta1.Action.error":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.Action.execution_delay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.Action.execute_after":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.Action.approve_templates":
+		list := []*TemplateRef{}
+		return protoreflect.ValueOfList(&_Action_23_list{list: &list})
+	case "warden.act.v1beta1.Action.reject_templates":
+		list := []*TemplateRef{}
+		return protoreflect.ValueOfList(&_Action_24_list{list: &list})
+	case "warden.act.v1beta1.Action.space_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_Action_25_list{list: &list})
+	case "warden.act.v1beta1.Action.evaluation_error":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.Action.retries":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.Action"))
@@ -702,6 +1390,78 @@ func (x *fastReflection_Action) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.ApproveBytecode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RejectBytecode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Dependencies) > 0 {
+			for _, s := range x.Dependencies {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ApproveTemplateExpression != nil {
+			l = options.Size(x.ApproveTemplateExpression)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.RejectTemplateExpression != nil {
+			l = options.Size(x.RejectTemplateExpression)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Msgs) > 0 {
+			for _, e := range x.Msgs {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MsgExpressions) > 0 {
+			for _, e := range x.MsgExpressions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionDelay != nil {
+			l = options.Size(x.ExecutionDelay)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecuteAfter != nil {
+			l = options.Size(x.ExecuteAfter)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ApproveTemplates) > 0 {
+			for _, e := range x.ApproveTemplates {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RejectTemplates) > 0 {
+			for _, e := range x.RejectTemplates {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SpaceIds) > 0 {
+			l = 0
+			for _, e := range x.SpaceIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		l = len(x.EvaluationError)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Retries != 0 {
+			n += 2 + runtime.Sov(uint64(x.Retries))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -731,6 +1491,212 @@ func (x *fastReflection_Action) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Retries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Retries))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd8
+		}
+		if len(x.EvaluationError) > 0 {
+			i -= len(x.EvaluationError)
+			copy(dAtA[i:], x.EvaluationError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvaluationError)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+		if len(x.SpaceIds) > 0 {
+			var pksize2 int
+			for _, num := range x.SpaceIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.SpaceIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.RejectTemplates) > 0 {
+			for iNdEx := len(x.RejectTemplates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RejectTemplates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.ApproveTemplates) > 0 {
+			for iNdEx := len(x.ApproveTemplates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ApproveTemplates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if x.ExecuteAfter != nil {
+			encoded, err := options.Marshal(x.ExecuteAfter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.ExecutionDelay != nil {
+			encoded, err := options.Marshal(x.ExecutionDelay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.MsgExpressions) > 0 {
+			for iNdEx := len(x.MsgExpressions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgExpressions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.Msgs) > 0 {
+			for iNdEx := len(x.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Msgs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.RejectTemplateExpression != nil {
+			encoded, err := options.Marshal(x.RejectTemplateExpression)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.ApproveTemplateExpression != nil {
+			encoded, err := options.Marshal(x.ApproveTemplateExpression)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.Dependencies) > 0 {
+			for iNdEx := len(x.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Dependencies[iNdEx])
+				copy(dAtA[i:], x.Dependencies[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dependencies[iNdEx])))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.RejectBytecode) > 0 {
+			i -= len(x.RejectBytecode)
+			copy(dAtA[i:], x.RejectBytecode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RejectBytecode)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.ApproveBytecode) > 0 {
+			i -= len(x.ApproveBytecode)
+			copy(dAtA[i:], x.ApproveBytecode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ApproveBytecode)))
+			i--
+			dAtA[i] = 0x6a
+		}
 		if len(x.Votes) > 0 {
 			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Votes[iNdEx])
@@ -1234,23 +2200,1728 @@ func (x *fastReflection_Action) ProtoMethods() *protoiface.Methods {
 				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RejectExpression == nil {
+					x.RejectExpression = &ast.Expression{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectExpression); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Votes = append(x.Votes, &ActionVote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApproveBytecode", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ApproveBytecode = append(x.ApproveBytecode[:0], dAtA[iNdEx:postIndex]...)
+				if x.ApproveBytecode == nil {
+					x.ApproveBytecode = []byte{}
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectBytecode", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RejectBytecode = append(x.RejectBytecode[:0], dAtA[iNdEx:postIndex]...)
+				if x.RejectBytecode == nil {
+					x.RejectBytecode = []byte{}
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dependencies = append(x.Dependencies, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateExpression", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ApproveTemplateExpression == nil {
+					x.ApproveTemplateExpression = &ast.Expression{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ApproveTemplateExpression); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateExpression", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RejectTemplateExpression == nil {
+					x.RejectTemplateExpression = &ast.Expression{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectTemplateExpression); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Msgs = append(x.Msgs, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msgs[len(x.Msgs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgExpressions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgExpressions = append(x.MsgExpressions, &ActionMsgExpressions{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgExpressions[len(x.MsgExpressions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecutionDelay == nil {
+					x.ExecutionDelay = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionDelay); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecuteAfter == nil {
+					x.ExecuteAfter = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecuteAfter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ApproveTemplates = append(x.ApproveTemplates, &TemplateRef{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ApproveTemplates[len(x.ApproveTemplates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectTemplates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RejectTemplates = append(x.RejectTemplates, &TemplateRef{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectTemplates[len(x.RejectTemplates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.SpaceIds = append(x.SpaceIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.SpaceIds) == 0 {
+						x.SpaceIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.SpaceIds = append(x.SpaceIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpaceIds", wireType)
+				}
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvaluationError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvaluationError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 27:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+				}
+				x.Retries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Retries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ActionMsgExpressions                             protoreflect.MessageDescriptor
+	fd_ActionMsgExpressions_approve_expression          protoreflect.FieldDescriptor
+	fd_ActionMsgExpressions_reject_expression           protoreflect.FieldDescriptor
+	fd_ActionMsgExpressions_approve_template_expression protoreflect.FieldDescriptor
+	fd_ActionMsgExpressions_reject_template_expression  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_action_proto_init()
+	md_ActionMsgExpressions = File_warden_act_v1beta1_action_proto.Messages().ByName("ActionMsgExpressions")
+	fd_ActionMsgExpressions_approve_expression = md_ActionMsgExpressions.Fields().ByName("approve_expression")
+	fd_ActionMsgExpressions_reject_expression = md_ActionMsgExpressions.Fields().ByName("reject_expression")
+	fd_ActionMsgExpressions_approve_template_expression = md_ActionMsgExpressions.Fields().ByName("approve_template_expression")
+	fd_ActionMsgExpressions_reject_template_expression = md_ActionMsgExpressions.Fields().ByName("reject_template_expression")
+}
+
+var _ protoreflect.Message = (*fastReflection_ActionMsgExpressions)(nil)
+
+type fastReflection_ActionMsgExpressions ActionMsgExpressions
+
+func (x *ActionMsgExpressions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActionMsgExpressions)(x)
+}
+
+func (x *ActionMsgExpressions) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_action_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ActionMsgExpressions_messageType fastReflection_ActionMsgExpressions_messageType
+var _ protoreflect.MessageType = fastReflection_ActionMsgExpressions_messageType{}
+
+type fastReflection_ActionMsgExpressions_messageType struct{}
+
+func (x fastReflection_ActionMsgExpressions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActionMsgExpressions)(nil)
+}
+func (x fastReflection_ActionMsgExpressions_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActionMsgExpressions)
+}
+func (x fastReflection_ActionMsgExpressions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActionMsgExpressions
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ActionMsgExpressions) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActionMsgExpressions
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ActionMsgExpressions) Type() protoreflect.MessageType {
+	return _fastReflection_ActionMsgExpressions_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ActionMsgExpressions) New() protoreflect.Message {
+	return new(fastReflection_ActionMsgExpressions)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ActionMsgExpressions) Interface() protoreflect.ProtoMessage {
+	return (*ActionMsgExpressions)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ActionMsgExpressions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ApproveExpression != nil {
+		value := protoreflect.ValueOfMessage(x.ApproveExpression.ProtoReflect())
+		if !f(fd_ActionMsgExpressions_approve_expression, value) {
+			return
+		}
+	}
+	if x.RejectExpression != nil {
+		value := protoreflect.ValueOfMessage(x.RejectExpression.ProtoReflect())
+		if !f(fd_ActionMsgExpressions_reject_expression, value) {
+			return
+		}
+	}
+	if x.ApproveTemplateExpression != nil {
+		value := protoreflect.ValueOfMessage(x.ApproveTemplateExpression.ProtoReflect())
+		if !f(fd_ActionMsgExpressions_approve_template_expression, value) {
+			return
+		}
+	}
+	if x.RejectTemplateExpression != nil {
+		value := protoreflect.ValueOfMessage(x.RejectTemplateExpression.ProtoReflect())
+		if !f(fd_ActionMsgExpressions_reject_template_expression, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ActionMsgExpressions) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_expression":
+		return x.ApproveExpression != nil
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_expression":
+		return x.RejectExpression != nil
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_template_expression":
+		return x.ApproveTemplateExpression != nil
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_template_expression":
+		return x.RejectTemplateExpression != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionMsgExpressions"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionMsgExpressions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionMsgExpressions) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_expression":
+		x.ApproveExpression = nil
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_expression":
+		x.RejectExpression = nil
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_template_expression":
+		x.ApproveTemplateExpression = nil
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_template_expression":
+		x.RejectTemplateExpression = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionMsgExpressions"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionMsgExpressions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ActionMsgExpressions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_expression":
+		value := x.ApproveExpression
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_expression":
+		value := x.RejectExpression
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_template_expression":
+		value := x.ApproveTemplateExpression
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_template_expression":
+		value := x.RejectTemplateExpression
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionMsgExpressions"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionMsgExpressions does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionMsgExpressions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_expression":
+		x.ApproveExpression = value.Message().Interface().(*ast.Expression)
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_expression":
+		x.RejectExpression = value.Message().Interface().(*ast.Expression)
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_template_expression":
+		x.ApproveTemplateExpression = value.Message().Interface().(*ast.Expression)
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_template_expression":
+		x.RejectTemplateExpression = value.Message().Interface().(*ast.Expression)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionMsgExpressions"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionMsgExpressions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionMsgExpressions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_expression":
+		if x.ApproveExpression == nil {
+			x.ApproveExpression = new(ast.Expression)
+		}
+		return protoreflect.ValueOfMessage(x.ApproveExpression.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_expression":
+		if x.RejectExpression == nil {
+			x.RejectExpression = new(ast.Expression)
+		}
+		return protoreflect.ValueOfMessage(x.RejectExpression.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_template_expression":
+		if x.ApproveTemplateExpression == nil {
+			x.ApproveTemplateExpression = new(ast.Expression)
+		}
+		return protoreflect.ValueOfMessage(x.ApproveTemplateExpression.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_template_expression":
+		if x.RejectTemplateExpression == nil {
+			x.RejectTemplateExpression = new(ast.Expression)
+		}
+		return protoreflect.ValueOfMessage(x.RejectTemplateExpression.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionMsgExpressions"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionMsgExpressions does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ActionMsgExpressions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_expression":
+		m := new(ast.Expression)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_expression":
+		m := new(ast.Expression)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.approve_template_expression":
+		m := new(ast.Expression)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.ActionMsgExpressions.reject_template_expression":
+		m := new(ast.Expression)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionMsgExpressions"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionMsgExpressions does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ActionMsgExpressions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.ActionMsgExpressions", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ActionMsgExpressions) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionMsgExpressions) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ActionMsgExpressions) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ActionMsgExpressions) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ActionMsgExpressions)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ApproveExpression != nil {
+			l = options.Size(x.ApproveExpression)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RejectExpression != nil {
+			l = options.Size(x.RejectExpression)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ApproveTemplateExpression != nil {
+			l = options.Size(x.ApproveTemplateExpression)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RejectTemplateExpression != nil {
+			l = options.Size(x.RejectTemplateExpression)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ActionMsgExpressions)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RejectTemplateExpression != nil {
+			encoded, err := options.Marshal(x.RejectTemplateExpression)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ApproveTemplateExpression != nil {
+			encoded, err := options.Marshal(x.ApproveTemplateExpression)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.RejectExpression != nil {
+			encoded, err := options.Marshal(x.RejectExpression)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ApproveExpression != nil {
+			encoded, err := options.Marshal(x.ApproveExpression)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ActionMsgExpressions)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActionMsgExpressions: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActionMsgExpressions: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApproveExpression", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ApproveExpression == nil {
+					x.ApproveExpression = &ast.Expression{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ApproveExpression); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectExpression", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RejectExpression == nil {
+					x.RejectExpression = &ast.Expression{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectExpression); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateExpression", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ApproveTemplateExpression == nil {
+					x.ApproveTemplateExpression = &ast.Expression{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ApproveTemplateExpression); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateExpression", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RejectTemplateExpression == nil {
+					x.RejectTemplateExpression = &ast.Expression{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectTemplateExpression); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ActionResults_1_list)(nil)
+
+type _ActionResults_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_ActionResults_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ActionResults_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ActionResults_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ActionResults_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ActionResults_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ActionResults_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ActionResults_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ActionResults_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ActionResults         protoreflect.MessageDescriptor
+	fd_ActionResults_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_action_proto_init()
+	md_ActionResults = File_warden_act_v1beta1_action_proto.Messages().ByName("ActionResults")
+	fd_ActionResults_results = md_ActionResults.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_ActionResults)(nil)
+
+type fastReflection_ActionResults ActionResults
+
+func (x *ActionResults) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActionResults)(x)
+}
+
+func (x *ActionResults) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_action_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ActionResults_messageType fastReflection_ActionResults_messageType
+var _ protoreflect.MessageType = fastReflection_ActionResults_messageType{}
+
+type fastReflection_ActionResults_messageType struct{}
+
+func (x fastReflection_ActionResults_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActionResults)(nil)
+}
+func (x fastReflection_ActionResults_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActionResults)
+}
+func (x fastReflection_ActionResults_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActionResults
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ActionResults) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActionResults
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ActionResults) Type() protoreflect.MessageType {
+	return _fastReflection_ActionResults_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ActionResults) New() protoreflect.Message {
+	return new(fastReflection_ActionResults)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ActionResults) Interface() protoreflect.ProtoMessage {
+	return (*ActionResults)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ActionResults) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_ActionResults_1_list{list: &x.Results})
+		if !f(fd_ActionResults_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ActionResults) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionResults.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionResults"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionResults does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionResults) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionResults.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionResults"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionResults does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ActionResults) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.ActionResults.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_ActionResults_1_list{})
+		}
+		listValue := &_ActionResults_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionResults"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionResults does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionResults) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionResults.results":
+		lv := value.List()
+		clv := lv.(*_ActionResults_1_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionResults"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionResults does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionResults) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionResults.results":
+		if x.Results == nil {
+			x.Results = []*anypb.Any{}
+		}
+		value := &_ActionResults_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionResults"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionResults does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ActionResults) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.ActionResults.results":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_ActionResults_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.ActionResults"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.ActionResults does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ActionResults) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.ActionResults", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ActionResults) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ActionResults) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ActionResults) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ActionResults) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ActionResults)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ActionResults)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ActionResults)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.RejectExpression == nil {
-					x.RejectExpression = &ast.Expression{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RejectExpression); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 12:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActionResults: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActionResults: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1277,8 +3948,8 @@ func (x *fastReflection_Action) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Votes = append(x.Votes, &ActionVote{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+				x.Results = append(x.Results, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1344,6 +4015,12 @@ const (
 	ActionStatus_ACTION_STATUS_REVOKED ActionStatus = 3
 	// Action has been rejected since TimeoutHeight has been reached.
 	ActionStatus_ACTION_STATUS_TIMEOUT ActionStatus = 4
+	// Action has been approved but the execution of its messages failed. It can
+	// be retried until TimeoutHeight is reached.
+	ActionStatus_ACTION_STATUS_FAILED ActionStatus = 5
+	// Action has been approved and its execution is delayed until
+	// execute_after. It can still be rejected.
+	ActionStatus_ACTION_STATUS_QUEUED ActionStatus = 6
 )
 
 // Enum value maps for ActionStatus.
@@ -1354,6 +4031,8 @@ var (
 		2: "ACTION_STATUS_COMPLETED",
 		3: "ACTION_STATUS_REVOKED",
 		4: "ACTION_STATUS_TIMEOUT",
+		5: "ACTION_STATUS_FAILED",
+		6: "ACTION_STATUS_QUEUED",
 	}
 	ActionStatus_value = map[string]int32{
 		"ACTION_STATUS_UNSPECIFIED": 0,
@@ -1361,6 +4040,8 @@ var (
 		"ACTION_STATUS_COMPLETED":   2,
 		"ACTION_STATUS_REVOKED":     3,
 		"ACTION_STATUS_TIMEOUT":     4,
+		"ACTION_STATUS_FAILED":      5,
+		"ACTION_STATUS_QUEUED":      6,
 	}
 )
 
@@ -1420,6 +4101,50 @@ type Action struct {
 	RejectExpression *ast.Expression `protobuf:"bytes,11,opt,name=reject_expression,json=rejectExpression,proto3" json:"reject_expression,omitempty"`
 	// The votes accepted from the voting participants.
 	Votes []*ActionVote `protobuf:"bytes,12,rep,name=votes,proto3" json:"votes,omitempty"`
+	// The compiled approve_expression, evaluated instead of the expression when
+	// present.
+	ApproveBytecode []byte `protobuf:"bytes,13,opt,name=approve_bytecode,json=approveBytecode,proto3" json:"approve_bytecode,omitempty"`
+	// The compiled reject_expression, evaluated instead of the expression when
+	// present.
+	RejectBytecode []byte `protobuf:"bytes,14,opt,name=reject_bytecode,json=rejectBytecode,proto3" json:"reject_bytecode,omitempty"`
+	// The dynamic inputs, such as the owners of a Space, the expressions depend
+	// on. When one of them changes, the expressions are expanded again.
+	Dependencies []string `protobuf:"bytes,15,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// The approve expression before the expansion of its dependencies, set when
+	// it has any.
+	ApproveTemplateExpression *ast.Expression `protobuf:"bytes,16,opt,name=approve_template_expression,json=approveTemplateExpression,proto3" json:"approve_template_expression,omitempty"`
+	// The reject expression before the expansion of its dependencies, set when
+	// it has any.
+	RejectTemplateExpression *ast.Expression `protobuf:"bytes,17,opt,name=reject_template_expression,json=rejectTemplateExpression,proto3" json:"reject_template_expression,omitempty"`
+	// The messages of a multi-message action, executed in order and atomically
+	// when the action is approved. msg is not set when msgs is.
+	Msgs []*anypb.Any `protobuf:"bytes,18,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// The expressions of each message of msgs, approve_expression and
+	// reject_expression are derived by combining them.
+	MsgExpressions []*ActionMsgExpressions `protobuf:"bytes,19,rep,name=msg_expressions,json=msgExpressions,proto3" json:"msg_expressions,omitempty"`
+	// The error returned by the execution of the messages, set when the action
+	// is in ACTION_STATUS_FAILED.
+	Error string `protobuf:"bytes,20,opt,name=error,proto3" json:"error,omitempty"`
+	// The delay between the approval of the action and its execution, taken
+	// from the approve templates of its messages.
+	ExecutionDelay *durationpb.Duration `protobuf:"bytes,21,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// The time after which a queued action is executed, set when the action is
+	// in ACTION_STATUS_QUEUED.
+	ExecuteAfter *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=execute_after,json=executeAfter,proto3" json:"execute_after,omitempty"`
+	// The versions of the approve and reject templates the action was created
+	// with, one for each message in execution order.
+	ApproveTemplates []*TemplateRef `protobuf:"bytes,23,rep,name=approve_templates,json=approveTemplates,proto3" json:"approve_templates,omitempty"`
+	RejectTemplates  []*TemplateRef `protobuf:"bytes,24,rep,name=reject_templates,json=rejectTemplates,proto3" json:"reject_templates,omitempty"`
+	// The ids of the Spaces the messages of the action belong to, as reported
+	// by the templates registry.
+	SpaceIds []uint64 `protobuf:"varint,25,rep,packed,name=space_ids,json=spaceIds,proto3" json:"space_ids,omitempty"`
+	// The error returned by the last re-evaluation of the expressions in
+	// EndBlocker. While it is set, the action is not re-evaluated at every block
+	// anymore, until one of its dependencies changes.
+	EvaluationError string `protobuf:"bytes,26,opt,name=evaluation_error,json=evaluationError,proto3" json:"evaluation_error,omitempty"`
+	// The number of times the creator retried the execution of the action
+	// after it failed, see MsgRetryAction.
+	Retries uint32 `protobuf:"varint,27,opt,name=retries,proto3" json:"retries,omitempty"`
 }
 
 func (x *Action) Reset() {
@@ -1526,6 +4251,215 @@ func (x *Action) GetVotes() []*ActionVote {
 	return nil
 }
 
+func (x *Action) GetApproveBytecode() []byte {
+	if x != nil {
+		return x.ApproveBytecode
+	}
+	return nil
+}
+
+func (x *Action) GetRejectBytecode() []byte {
+	if x != nil {
+		return x.RejectBytecode
+	}
+	return nil
+}
+
+func (x *Action) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *Action) GetApproveTemplateExpression() *ast.Expression {
+	if x != nil {
+		return x.ApproveTemplateExpression
+	}
+	return nil
+}
+
+func (x *Action) GetRejectTemplateExpression() *ast.Expression {
+	if x != nil {
+		return x.RejectTemplateExpression
+	}
+	return nil
+}
+
+func (x *Action) GetMsgs() []*anypb.Any {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *Action) GetMsgExpressions() []*ActionMsgExpressions {
+	if x != nil {
+		return x.MsgExpressions
+	}
+	return nil
+}
+
+func (x *Action) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Action) GetExecutionDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ExecutionDelay
+	}
+	return nil
+}
+
+func (x *Action) GetExecuteAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return nil
+}
+
+func (x *Action) GetApproveTemplates() []*TemplateRef {
+	if x != nil {
+		return x.ApproveTemplates
+	}
+	return nil
+}
+
+func (x *Action) GetRejectTemplates() []*TemplateRef {
+	if x != nil {
+		return x.RejectTemplates
+	}
+	return nil
+}
+
+func (x *Action) GetSpaceIds() []uint64 {
+	if x != nil {
+		return x.SpaceIds
+	}
+	return nil
+}
+
+func (x *Action) GetEvaluationError() string {
+	if x != nil {
+		return x.EvaluationError
+	}
+	return ""
+}
+
+func (x *Action) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+// ActionMsgExpressions contains the expressions of a single message of a
+// multi-message action.
+type ActionMsgExpressions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The expression to be evaluated for approval.
+	ApproveExpression *ast.Expression `protobuf:"bytes,1,opt,name=approve_expression,json=approveExpression,proto3" json:"approve_expression,omitempty"`
+	// The expression to be evaluated for rejection.
+	RejectExpression *ast.Expression `protobuf:"bytes,2,opt,name=reject_expression,json=rejectExpression,proto3" json:"reject_expression,omitempty"`
+	// The approve expression before the expansion of its dependencies, set when
+	// it has any.
+	ApproveTemplateExpression *ast.Expression `protobuf:"bytes,3,opt,name=approve_template_expression,json=approveTemplateExpression,proto3" json:"approve_template_expression,omitempty"`
+	// The reject expression before the expansion of its dependencies, set when
+	// it has any.
+	RejectTemplateExpression *ast.Expression `protobuf:"bytes,4,opt,name=reject_template_expression,json=rejectTemplateExpression,proto3" json:"reject_template_expression,omitempty"`
+}
+
+func (x *ActionMsgExpressions) Reset() {
+	*x = ActionMsgExpressions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_action_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionMsgExpressions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionMsgExpressions) ProtoMessage() {}
+
+// Deprecated: Use ActionMsgExpressions.ProtoReflect.Descriptor instead.
+func (*ActionMsgExpressions) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_action_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActionMsgExpressions) GetApproveExpression() *ast.Expression {
+	if x != nil {
+		return x.ApproveExpression
+	}
+	return nil
+}
+
+func (x *ActionMsgExpressions) GetRejectExpression() *ast.Expression {
+	if x != nil {
+		return x.RejectExpression
+	}
+	return nil
+}
+
+func (x *ActionMsgExpressions) GetApproveTemplateExpression() *ast.Expression {
+	if x != nil {
+		return x.ApproveTemplateExpression
+	}
+	return nil
+}
+
+func (x *ActionMsgExpressions) GetRejectTemplateExpression() *ast.Expression {
+	if x != nil {
+		return x.RejectTemplateExpression
+	}
+	return nil
+}
+
+// ActionResults is the result of a multi-message action, it contains the
+// response of each message in the same order as Action.msgs.
+type ActionResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*anypb.Any `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ActionResults) Reset() {
+	*x = ActionResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_action_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionResults) ProtoMessage() {}
+
+// Deprecated: Use ActionResults.ProtoReflect.Descriptor instead.
+func (*ActionResults) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_action_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActionResults) GetResults() []*anypb.Any {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_warden_act_v1beta1_action_proto protoreflect.FileDescriptor
 
 var file_warden_act_v1beta1_action_proto_rawDesc = []byte{
@@ -1536,7 +4470,9 @@ var file_warden_act_v1beta1_action_proto_rawDesc = []byte{
 	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x73, 0x68, 0x69, 0x65,
 	0x6c, 0x64, 0x2f, 0x61, 0x73, 0x74, 0x2f, 0x61, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -1544,7 +4480,7 @@ var file_warden_act_v1beta1_action_proto_rawDesc = []byte{
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
-	0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x04, 0x0a, 0x06, 0x41, 0x63,
+	0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x0b, 0x0a, 0x06, 0x41, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
@@ -1583,17 +4519,100 @@ var file_warden_act_v1beta1_action_proto_rawDesc = []byte{
 	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
 	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
 	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f,
-	0x74, 0x65, 0x73, 0x2a, 0x9b, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b,
-	0x0a, 0x17, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56,
-	0x4f, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
-	0x04, 0x42, 0xdc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x42, 0x79, 0x74, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x1b, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x1a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x18, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x6d,
+	0x73, 0x67, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x6d, 0x73,
+	0x67, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x45, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x10, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0xdc, 0x02, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x61, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x11, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x56, 0x0a, 0x1b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x61,
+	0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x1a, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x61, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3f,
+	0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a,
+	0xcf, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a,
+	0x14, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10,
+	0x06, 0x42, 0xdc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
 	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x41, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2d, 0x70,
@@ -1623,29 +4642,46 @@ func file_warden_act_v1beta1_action_proto_rawDescGZIP() []byte {
 }
 
 var file_warden_act_v1beta1_action_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_warden_act_v1beta1_action_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_warden_act_v1beta1_action_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_warden_act_v1beta1_action_proto_goTypes = []interface{}{
 	(ActionStatus)(0),             // 0: warden.act.v1beta1.ActionStatus
 	(*Action)(nil),                // 1: warden.act.v1beta1.Action
-	(*anypb.Any)(nil),             // 2: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
-	(*ast.Expression)(nil),        // 4: shield.ast.Expression
-	(*ActionVote)(nil),            // 5: warden.act.v1beta1.ActionVote
+	(*ActionMsgExpressions)(nil),  // 2: warden.act.v1beta1.ActionMsgExpressions
+	(*ActionResults)(nil),         // 3: warden.act.v1beta1.ActionResults
+	(*anypb.Any)(nil),             // 4: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*ast.Expression)(nil),        // 6: shield.ast.Expression
+	(*ActionVote)(nil),            // 7: warden.act.v1beta1.ActionVote
+	(*durationpb.Duration)(nil),   // 8: google.protobuf.Duration
+	(*TemplateRef)(nil),           // 9: warden.act.v1beta1.TemplateRef
 }
 var file_warden_act_v1beta1_action_proto_depIdxs = []int32{
-	0, // 0: warden.act.v1beta1.Action.status:type_name -> warden.act.v1beta1.ActionStatus
-	2, // 1: warden.act.v1beta1.Action.msg:type_name -> google.protobuf.Any
-	2, // 2: warden.act.v1beta1.Action.result:type_name -> google.protobuf.Any
-	3, // 3: warden.act.v1beta1.Action.created_at:type_name -> google.protobuf.Timestamp
-	3, // 4: warden.act.v1beta1.Action.updated_at:type_name -> google.protobuf.Timestamp
-	4, // 5: warden.act.v1beta1.Action.approve_expression:type_name -> shield.ast.Expression
-	4, // 6: warden.act.v1beta1.Action.reject_expression:type_name -> shield.ast.Expression
-	5, // 7: warden.act.v1beta1.Action.votes:type_name -> warden.act.v1beta1.ActionVote
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: warden.act.v1beta1.Action.status:type_name -> warden.act.v1beta1.ActionStatus
+	4,  // 1: warden.act.v1beta1.Action.msg:type_name -> google.protobuf.Any
+	4,  // 2: warden.act.v1beta1.Action.result:type_name -> google.protobuf.Any
+	5,  // 3: warden.act.v1beta1.Action.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 4: warden.act.v1beta1.Action.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: warden.act.v1beta1.Action.approve_expression:type_name -> shield.ast.Expression
+	6,  // 6: warden.act.v1beta1.Action.reject_expression:type_name -> shield.ast.Expression
+	7,  // 7: warden.act.v1beta1.Action.votes:type_name -> warden.act.v1beta1.ActionVote
+	6,  // 8: warden.act.v1beta1.Action.approve_template_expression:type_name -> shield.ast.Expression
+	6,  // 9: warden.act.v1beta1.Action.reject_template_expression:type_name -> shield.ast.Expression
+	4,  // 10: warden.act.v1beta1.Action.msgs:type_name -> google.protobuf.Any
+	2,  // 11: warden.act.v1beta1.Action.msg_expressions:type_name -> warden.act.v1beta1.ActionMsgExpressions
+	8,  // 12: warden.act.v1beta1.Action.execution_delay:type_name -> google.protobuf.Duration
+	5,  // 13: warden.act.v1beta1.Action.execute_after:type_name -> google.protobuf.Timestamp
+	9,  // 14: warden.act.v1beta1.Action.approve_templates:type_name -> warden.act.v1beta1.TemplateRef
+	9,  // 15: warden.act.v1beta1.Action.reject_templates:type_name -> warden.act.v1beta1.TemplateRef
+	6,  // 16: warden.act.v1beta1.ActionMsgExpressions.approve_expression:type_name -> shield.ast.Expression
+	6,  // 17: warden.act.v1beta1.ActionMsgExpressions.reject_expression:type_name -> shield.ast.Expression
+	6,  // 18: warden.act.v1beta1.ActionMsgExpressions.approve_template_expression:type_name -> shield.ast.Expression
+	6,  // 19: warden.act.v1beta1.ActionMsgExpressions.reject_template_expression:type_name -> shield.ast.Expression
+	4,  // 20: warden.act.v1beta1.ActionResults.results:type_name -> google.protobuf.Any
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_warden_act_v1beta1_action_proto_init() }
@@ -1668,6 +4704,30 @@ func file_warden_act_v1beta1_action_proto_init() {
 				return nil
 			}
 		}
+		file_warden_act_v1beta1_action_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionMsgExpressions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_action_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1675,7 +4735,7 @@ func file_warden_act_v1beta1_action_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_warden_act_v1beta1_action_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
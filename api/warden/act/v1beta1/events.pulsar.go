@@ -4,9 +4,11 @@ package actv1beta1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -481,14 +483,22 @@ func (x *fastReflection_EventCreateTemplate) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_EventUpdateTemplate    protoreflect.MessageDescriptor
-	fd_EventUpdateTemplate_id protoreflect.FieldDescriptor
+	md_EventUpdateTemplate                protoreflect.MessageDescriptor
+	fd_EventUpdateTemplate_id             protoreflect.FieldDescriptor
+	fd_EventUpdateTemplate_version        protoreflect.FieldDescriptor
+	fd_EventUpdateTemplate_updated_by     protoreflect.FieldDescriptor
+	fd_EventUpdateTemplate_old_expression protoreflect.FieldDescriptor
+	fd_EventUpdateTemplate_new_expression protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_events_proto_init()
 	md_EventUpdateTemplate = File_warden_act_v1beta1_events_proto.Messages().ByName("EventUpdateTemplate")
 	fd_EventUpdateTemplate_id = md_EventUpdateTemplate.Fields().ByName("id")
+	fd_EventUpdateTemplate_version = md_EventUpdateTemplate.Fields().ByName("version")
+	fd_EventUpdateTemplate_updated_by = md_EventUpdateTemplate.Fields().ByName("updated_by")
+	fd_EventUpdateTemplate_old_expression = md_EventUpdateTemplate.Fields().ByName("old_expression")
+	fd_EventUpdateTemplate_new_expression = md_EventUpdateTemplate.Fields().ByName("new_expression")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventUpdateTemplate)(nil)
@@ -562,6 +572,30 @@ func (x *fastReflection_EventUpdateTemplate) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_EventUpdateTemplate_version, value) {
+			return
+		}
+	}
+	if x.UpdatedBy != "" {
+		value := protoreflect.ValueOfString(x.UpdatedBy)
+		if !f(fd_EventUpdateTemplate_updated_by, value) {
+			return
+		}
+	}
+	if x.OldExpression != "" {
+		value := protoreflect.ValueOfString(x.OldExpression)
+		if !f(fd_EventUpdateTemplate_old_expression, value) {
+			return
+		}
+	}
+	if x.NewExpression != "" {
+		value := protoreflect.ValueOfString(x.NewExpression)
+		if !f(fd_EventUpdateTemplate_new_expression, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -579,6 +613,14 @@ func (x *fastReflection_EventUpdateTemplate) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "warden.act.v1beta1.EventUpdateTemplate.id":
 		return x.Id != uint64(0)
+	case "warden.act.v1beta1.EventUpdateTemplate.version":
+		return x.Version != uint64(0)
+	case "warden.act.v1beta1.EventUpdateTemplate.updated_by":
+		return x.UpdatedBy != ""
+	case "warden.act.v1beta1.EventUpdateTemplate.old_expression":
+		return x.OldExpression != ""
+	case "warden.act.v1beta1.EventUpdateTemplate.new_expression":
+		return x.NewExpression != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventUpdateTemplate"))
@@ -597,6 +639,14 @@ func (x *fastReflection_EventUpdateTemplate) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "warden.act.v1beta1.EventUpdateTemplate.id":
 		x.Id = uint64(0)
+	case "warden.act.v1beta1.EventUpdateTemplate.version":
+		x.Version = uint64(0)
+	case "warden.act.v1beta1.EventUpdateTemplate.updated_by":
+		x.UpdatedBy = ""
+	case "warden.act.v1beta1.EventUpdateTemplate.old_expression":
+		x.OldExpression = ""
+	case "warden.act.v1beta1.EventUpdateTemplate.new_expression":
+		x.NewExpression = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventUpdateTemplate"))
@@ -616,6 +666,18 @@ func (x *fastReflection_EventUpdateTemplate) Get(descriptor protoreflect.FieldDe
 	case "warden.act.v1beta1.EventUpdateTemplate.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventUpdateTemplate.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventUpdateTemplate.updated_by":
+		value := x.UpdatedBy
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.EventUpdateTemplate.old_expression":
+		value := x.OldExpression
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.EventUpdateTemplate.new_expression":
+		value := x.NewExpression
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventUpdateTemplate"))
@@ -638,6 +700,14 @@ func (x *fastReflection_EventUpdateTemplate) Set(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "warden.act.v1beta1.EventUpdateTemplate.id":
 		x.Id = value.Uint()
+	case "warden.act.v1beta1.EventUpdateTemplate.version":
+		x.Version = value.Uint()
+	case "warden.act.v1beta1.EventUpdateTemplate.updated_by":
+		x.UpdatedBy = value.Interface().(string)
+	case "warden.act.v1beta1.EventUpdateTemplate.old_expression":
+		x.OldExpression = value.Interface().(string)
+	case "warden.act.v1beta1.EventUpdateTemplate.new_expression":
+		x.NewExpression = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventUpdateTemplate"))
@@ -660,6 +730,14 @@ func (x *fastReflection_EventUpdateTemplate) Mutable(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "warden.act.v1beta1.EventUpdateTemplate.id":
 		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventUpdateTemplate is not mutable"))
+	case "warden.act.v1beta1.EventUpdateTemplate.version":
+		panic(fmt.Errorf("field version of message warden.act.v1beta1.EventUpdateTemplate is not mutable"))
+	case "warden.act.v1beta1.EventUpdateTemplate.updated_by":
+		panic(fmt.Errorf("field updated_by of message warden.act.v1beta1.EventUpdateTemplate is not mutable"))
+	case "warden.act.v1beta1.EventUpdateTemplate.old_expression":
+		panic(fmt.Errorf("field old_expression of message warden.act.v1beta1.EventUpdateTemplate is not mutable"))
+	case "warden.act.v1beta1.EventUpdateTemplate.new_expression":
+		panic(fmt.Errorf("field new_expression of message warden.act.v1beta1.EventUpdateTemplate is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventUpdateTemplate"))
@@ -675,6 +753,14 @@ func (x *fastReflection_EventUpdateTemplate) NewField(fd protoreflect.FieldDescr
 	switch fd.FullName() {
 	case "warden.act.v1beta1.EventUpdateTemplate.id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventUpdateTemplate.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventUpdateTemplate.updated_by":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.EventUpdateTemplate.old_expression":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.EventUpdateTemplate.new_expression":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventUpdateTemplate"))
@@ -747,6 +833,21 @@ func (x *fastReflection_EventUpdateTemplate) ProtoMethods() *protoiface.Methods
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.UpdatedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldExpression)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewExpression)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -776,6 +877,32 @@ func (x *fastReflection_EventUpdateTemplate) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NewExpression) > 0 {
+			i -= len(x.NewExpression)
+			copy(dAtA[i:], x.NewExpression)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewExpression)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.OldExpression) > 0 {
+			i -= len(x.OldExpression)
+			copy(dAtA[i:], x.OldExpression)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldExpression)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.UpdatedBy) > 0 {
+			i -= len(x.UpdatedBy)
+			copy(dAtA[i:], x.UpdatedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpdatedBy)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
@@ -849,6 +976,121 @@ func (x *fastReflection_EventUpdateTemplate) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpdatedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldExpression", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldExpression = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewExpression", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewExpression = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -885,27 +1127,29 @@ func (x *fastReflection_EventUpdateTemplate) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_EventCreateAction         protoreflect.MessageDescriptor
-	fd_EventCreateAction_id      protoreflect.FieldDescriptor
-	fd_EventCreateAction_creator protoreflect.FieldDescriptor
+	md_EventTransferTemplateOwnership                protoreflect.MessageDescriptor
+	fd_EventTransferTemplateOwnership_id             protoreflect.FieldDescriptor
+	fd_EventTransferTemplateOwnership_previous_owner protoreflect.FieldDescriptor
+	fd_EventTransferTemplateOwnership_new_owner      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_events_proto_init()
-	md_EventCreateAction = File_warden_act_v1beta1_events_proto.Messages().ByName("EventCreateAction")
-	fd_EventCreateAction_id = md_EventCreateAction.Fields().ByName("id")
-	fd_EventCreateAction_creator = md_EventCreateAction.Fields().ByName("creator")
+	md_EventTransferTemplateOwnership = File_warden_act_v1beta1_events_proto.Messages().ByName("EventTransferTemplateOwnership")
+	fd_EventTransferTemplateOwnership_id = md_EventTransferTemplateOwnership.Fields().ByName("id")
+	fd_EventTransferTemplateOwnership_previous_owner = md_EventTransferTemplateOwnership.Fields().ByName("previous_owner")
+	fd_EventTransferTemplateOwnership_new_owner = md_EventTransferTemplateOwnership.Fields().ByName("new_owner")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventCreateAction)(nil)
+var _ protoreflect.Message = (*fastReflection_EventTransferTemplateOwnership)(nil)
 
-type fastReflection_EventCreateAction EventCreateAction
+type fastReflection_EventTransferTemplateOwnership EventTransferTemplateOwnership
 
-func (x *EventCreateAction) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventCreateAction)(x)
+func (x *EventTransferTemplateOwnership) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTransferTemplateOwnership)(x)
 }
 
-func (x *EventCreateAction) slowProtoReflect() protoreflect.Message {
+func (x *EventTransferTemplateOwnership) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_events_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,43 +1161,43 @@ func (x *EventCreateAction) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventCreateAction_messageType fastReflection_EventCreateAction_messageType
-var _ protoreflect.MessageType = fastReflection_EventCreateAction_messageType{}
+var _fastReflection_EventTransferTemplateOwnership_messageType fastReflection_EventTransferTemplateOwnership_messageType
+var _ protoreflect.MessageType = fastReflection_EventTransferTemplateOwnership_messageType{}
 
-type fastReflection_EventCreateAction_messageType struct{}
+type fastReflection_EventTransferTemplateOwnership_messageType struct{}
 
-func (x fastReflection_EventCreateAction_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventCreateAction)(nil)
+func (x fastReflection_EventTransferTemplateOwnership_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTransferTemplateOwnership)(nil)
 }
-func (x fastReflection_EventCreateAction_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventCreateAction)
+func (x fastReflection_EventTransferTemplateOwnership_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTransferTemplateOwnership)
 }
-func (x fastReflection_EventCreateAction_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCreateAction
+func (x fastReflection_EventTransferTemplateOwnership_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTransferTemplateOwnership
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventCreateAction) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCreateAction
+func (x *fastReflection_EventTransferTemplateOwnership) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTransferTemplateOwnership
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventCreateAction) Type() protoreflect.MessageType {
-	return _fastReflection_EventCreateAction_messageType
+func (x *fastReflection_EventTransferTemplateOwnership) Type() protoreflect.MessageType {
+	return _fastReflection_EventTransferTemplateOwnership_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventCreateAction) New() protoreflect.Message {
-	return new(fastReflection_EventCreateAction)
+func (x *fastReflection_EventTransferTemplateOwnership) New() protoreflect.Message {
+	return new(fastReflection_EventTransferTemplateOwnership)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventCreateAction) Interface() protoreflect.ProtoMessage {
-	return (*EventCreateAction)(x)
+func (x *fastReflection_EventTransferTemplateOwnership) Interface() protoreflect.ProtoMessage {
+	return (*EventTransferTemplateOwnership)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -961,16 +1205,22 @@ func (x *fastReflection_EventCreateAction) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventCreateAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventTransferTemplateOwnership) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_EventCreateAction_id, value) {
+		if !f(fd_EventTransferTemplateOwnership_id, value) {
 			return
 		}
 	}
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_EventCreateAction_creator, value) {
+	if x.PreviousOwner != "" {
+		value := protoreflect.ValueOfString(x.PreviousOwner)
+		if !f(fd_EventTransferTemplateOwnership_previous_owner, value) {
+			return
+		}
+	}
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_EventTransferTemplateOwnership_new_owner, value) {
 			return
 		}
 	}
@@ -987,17 +1237,19 @@ func (x *fastReflection_EventCreateAction) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventCreateAction) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventTransferTemplateOwnership) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventCreateAction.id":
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.id":
 		return x.Id != uint64(0)
-	case "warden.act.v1beta1.EventCreateAction.creator":
-		return x.Creator != ""
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.previous_owner":
+		return x.PreviousOwner != ""
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.new_owner":
+		return x.NewOwner != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1007,17 +1259,19 @@ func (x *fastReflection_EventCreateAction) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAction) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventTransferTemplateOwnership) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventCreateAction.id":
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.id":
 		x.Id = uint64(0)
-	case "warden.act.v1beta1.EventCreateAction.creator":
-		x.Creator = ""
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.previous_owner":
+		x.PreviousOwner = ""
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.new_owner":
+		x.NewOwner = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1027,19 +1281,22 @@ func (x *fastReflection_EventCreateAction) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventCreateAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTransferTemplateOwnership) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.EventCreateAction.id":
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "warden.act.v1beta1.EventCreateAction.creator":
-		value := x.Creator
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.previous_owner":
+		value := x.PreviousOwner
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.new_owner":
+		value := x.NewOwner
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventTransferTemplateOwnership does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1053,17 +1310,19 @@ func (x *fastReflection_EventCreateAction) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventTransferTemplateOwnership) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventCreateAction.id":
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.id":
 		x.Id = value.Uint()
-	case "warden.act.v1beta1.EventCreateAction.creator":
-		x.Creator = value.Interface().(string)
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.previous_owner":
+		x.PreviousOwner = value.Interface().(string)
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.new_owner":
+		x.NewOwner = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1077,44 +1336,48 @@ func (x *fastReflection_EventCreateAction) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTransferTemplateOwnership) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventCreateAction.id":
-		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventCreateAction is not mutable"))
-	case "warden.act.v1beta1.EventCreateAction.creator":
-		panic(fmt.Errorf("field creator of message warden.act.v1beta1.EventCreateAction is not mutable"))
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventTransferTemplateOwnership is not mutable"))
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.previous_owner":
+		panic(fmt.Errorf("field previous_owner of message warden.act.v1beta1.EventTransferTemplateOwnership is not mutable"))
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.new_owner":
+		panic(fmt.Errorf("field new_owner of message warden.act.v1beta1.EventTransferTemplateOwnership is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventCreateAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTransferTemplateOwnership) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventCreateAction.id":
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "warden.act.v1beta1.EventCreateAction.creator":
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.previous_owner":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.EventTransferTemplateOwnership.new_owner":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventCreateAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventTransferTemplateOwnership) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventCreateAction", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventTransferTemplateOwnership", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1122,7 +1385,7 @@ func (x *fastReflection_EventCreateAction) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventCreateAction) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventTransferTemplateOwnership) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1133,7 +1396,7 @@ func (x *fastReflection_EventCreateAction) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventCreateAction) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventTransferTemplateOwnership) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1145,7 +1408,7 @@ func (x *fastReflection_EventCreateAction) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventCreateAction) IsValid() bool {
+func (x *fastReflection_EventTransferTemplateOwnership) IsValid() bool {
 	return x != nil
 }
 
@@ -1155,9 +1418,9 @@ func (x *fastReflection_EventCreateAction) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventTransferTemplateOwnership) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventCreateAction)
+		x := input.Message.Interface().(*EventTransferTemplateOwnership)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1172,7 +1435,11 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		l = len(x.Creator)
+		l = len(x.PreviousOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewOwner)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1186,7 +1453,7 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventCreateAction)
+		x := input.Message.Interface().(*EventTransferTemplateOwnership)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1205,10 +1472,17 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PreviousOwner) > 0 {
+			i -= len(x.PreviousOwner)
+			copy(dAtA[i:], x.PreviousOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousOwner)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -1228,7 +1502,7 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventCreateAction)
+		x := input.Message.Interface().(*EventTransferTemplateOwnership)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1260,10 +1534,10 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAction: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTransferTemplateOwnership: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAction: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTransferTemplateOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1287,7 +1561,7 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1315,22 +1589,54 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.PreviousOwner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -1353,29 +1659,29 @@ func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventActionVoted             protoreflect.MessageDescriptor
-	fd_EventActionVoted_id          protoreflect.FieldDescriptor
-	fd_EventActionVoted_participant protoreflect.FieldDescriptor
-	fd_EventActionVoted_vote_type   protoreflect.FieldDescriptor
+	md_EventSetContractTemplates                     protoreflect.MessageDescriptor
+	fd_EventSetContractTemplates_contract            protoreflect.FieldDescriptor
+	fd_EventSetContractTemplates_approve_template_id protoreflect.FieldDescriptor
+	fd_EventSetContractTemplates_reject_template_id  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_events_proto_init()
-	md_EventActionVoted = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionVoted")
-	fd_EventActionVoted_id = md_EventActionVoted.Fields().ByName("id")
-	fd_EventActionVoted_participant = md_EventActionVoted.Fields().ByName("participant")
-	fd_EventActionVoted_vote_type = md_EventActionVoted.Fields().ByName("vote_type")
+	md_EventSetContractTemplates = File_warden_act_v1beta1_events_proto.Messages().ByName("EventSetContractTemplates")
+	fd_EventSetContractTemplates_contract = md_EventSetContractTemplates.Fields().ByName("contract")
+	fd_EventSetContractTemplates_approve_template_id = md_EventSetContractTemplates.Fields().ByName("approve_template_id")
+	fd_EventSetContractTemplates_reject_template_id = md_EventSetContractTemplates.Fields().ByName("reject_template_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventActionVoted)(nil)
+var _ protoreflect.Message = (*fastReflection_EventSetContractTemplates)(nil)
 
-type fastReflection_EventActionVoted EventActionVoted
+type fastReflection_EventSetContractTemplates EventSetContractTemplates
 
-func (x *EventActionVoted) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventActionVoted)(x)
+func (x *EventSetContractTemplates) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSetContractTemplates)(x)
 }
 
-func (x *EventActionVoted) slowProtoReflect() protoreflect.Message {
+func (x *EventSetContractTemplates) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_events_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1387,43 +1693,43 @@ func (x *EventActionVoted) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventActionVoted_messageType fastReflection_EventActionVoted_messageType
-var _ protoreflect.MessageType = fastReflection_EventActionVoted_messageType{}
+var _fastReflection_EventSetContractTemplates_messageType fastReflection_EventSetContractTemplates_messageType
+var _ protoreflect.MessageType = fastReflection_EventSetContractTemplates_messageType{}
 
-type fastReflection_EventActionVoted_messageType struct{}
+type fastReflection_EventSetContractTemplates_messageType struct{}
 
-func (x fastReflection_EventActionVoted_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventActionVoted)(nil)
+func (x fastReflection_EventSetContractTemplates_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSetContractTemplates)(nil)
 }
-func (x fastReflection_EventActionVoted_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventActionVoted)
+func (x fastReflection_EventSetContractTemplates_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSetContractTemplates)
 }
-func (x fastReflection_EventActionVoted_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventActionVoted
+func (x fastReflection_EventSetContractTemplates_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetContractTemplates
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventActionVoted) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventActionVoted
+func (x *fastReflection_EventSetContractTemplates) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetContractTemplates
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventActionVoted) Type() protoreflect.MessageType {
-	return _fastReflection_EventActionVoted_messageType
+func (x *fastReflection_EventSetContractTemplates) Type() protoreflect.MessageType {
+	return _fastReflection_EventSetContractTemplates_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventActionVoted) New() protoreflect.Message {
-	return new(fastReflection_EventActionVoted)
+func (x *fastReflection_EventSetContractTemplates) New() protoreflect.Message {
+	return new(fastReflection_EventSetContractTemplates)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventActionVoted) Interface() protoreflect.ProtoMessage {
-	return (*EventActionVoted)(x)
+func (x *fastReflection_EventSetContractTemplates) Interface() protoreflect.ProtoMessage {
+	return (*EventSetContractTemplates)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1431,22 +1737,22 @@ func (x *fastReflection_EventActionVoted) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventActionVoted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_EventActionVoted_id, value) {
+func (x *fastReflection_EventSetContractTemplates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_EventSetContractTemplates_contract, value) {
 			return
 		}
 	}
-	if x.Participant != "" {
-		value := protoreflect.ValueOfString(x.Participant)
-		if !f(fd_EventActionVoted_participant, value) {
+	if x.ApproveTemplateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ApproveTemplateId)
+		if !f(fd_EventSetContractTemplates_approve_template_id, value) {
 			return
 		}
 	}
-	if x.VoteType != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.VoteType))
-		if !f(fd_EventActionVoted_vote_type, value) {
+	if x.RejectTemplateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RejectTemplateId)
+		if !f(fd_EventSetContractTemplates_reject_template_id, value) {
 			return
 		}
 	}
@@ -1463,19 +1769,19 @@ func (x *fastReflection_EventActionVoted) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventActionVoted) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventSetContractTemplates) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionVoted.id":
-		return x.Id != uint64(0)
-	case "warden.act.v1beta1.EventActionVoted.participant":
-		return x.Participant != ""
-	case "warden.act.v1beta1.EventActionVoted.vote_type":
-		return x.VoteType != 0
+	case "warden.act.v1beta1.EventSetContractTemplates.contract":
+		return x.Contract != ""
+	case "warden.act.v1beta1.EventSetContractTemplates.approve_template_id":
+		return x.ApproveTemplateId != uint64(0)
+	case "warden.act.v1beta1.EventSetContractTemplates.reject_template_id":
+		return x.RejectTemplateId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventSetContractTemplates"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventSetContractTemplates does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1485,19 +1791,19 @@ func (x *fastReflection_EventActionVoted) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionVoted) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventSetContractTemplates) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionVoted.id":
-		x.Id = uint64(0)
-	case "warden.act.v1beta1.EventActionVoted.participant":
-		x.Participant = ""
-	case "warden.act.v1beta1.EventActionVoted.vote_type":
-		x.VoteType = 0
+	case "warden.act.v1beta1.EventSetContractTemplates.contract":
+		x.Contract = ""
+	case "warden.act.v1beta1.EventSetContractTemplates.approve_template_id":
+		x.ApproveTemplateId = uint64(0)
+	case "warden.act.v1beta1.EventSetContractTemplates.reject_template_id":
+		x.RejectTemplateId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventSetContractTemplates"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventSetContractTemplates does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1507,22 +1813,22 @@ func (x *fastReflection_EventActionVoted) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventActionVoted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSetContractTemplates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.EventActionVoted.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "warden.act.v1beta1.EventActionVoted.participant":
-		value := x.Participant
+	case "warden.act.v1beta1.EventSetContractTemplates.contract":
+		value := x.Contract
 		return protoreflect.ValueOfString(value)
-	case "warden.act.v1beta1.EventActionVoted.vote_type":
-		value := x.VoteType
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "warden.act.v1beta1.EventSetContractTemplates.approve_template_id":
+		value := x.ApproveTemplateId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventSetContractTemplates.reject_template_id":
+		value := x.RejectTemplateId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventSetContractTemplates"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventSetContractTemplates does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1536,19 +1842,19 @@ func (x *fastReflection_EventActionVoted) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionVoted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventSetContractTemplates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionVoted.id":
-		x.Id = value.Uint()
-	case "warden.act.v1beta1.EventActionVoted.participant":
-		x.Participant = value.Interface().(string)
-	case "warden.act.v1beta1.EventActionVoted.vote_type":
-		x.VoteType = (ActionVoteType)(value.Enum())
+	case "warden.act.v1beta1.EventSetContractTemplates.contract":
+		x.Contract = value.Interface().(string)
+	case "warden.act.v1beta1.EventSetContractTemplates.approve_template_id":
+		x.ApproveTemplateId = value.Uint()
+	case "warden.act.v1beta1.EventSetContractTemplates.reject_template_id":
+		x.RejectTemplateId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventSetContractTemplates"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventSetContractTemplates does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1562,48 +1868,48 @@ func (x *fastReflection_EventActionVoted) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionVoted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSetContractTemplates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionVoted.id":
-		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionVoted is not mutable"))
-	case "warden.act.v1beta1.EventActionVoted.participant":
-		panic(fmt.Errorf("field participant of message warden.act.v1beta1.EventActionVoted is not mutable"))
-	case "warden.act.v1beta1.EventActionVoted.vote_type":
-		panic(fmt.Errorf("field vote_type of message warden.act.v1beta1.EventActionVoted is not mutable"))
+	case "warden.act.v1beta1.EventSetContractTemplates.contract":
+		panic(fmt.Errorf("field contract of message warden.act.v1beta1.EventSetContractTemplates is not mutable"))
+	case "warden.act.v1beta1.EventSetContractTemplates.approve_template_id":
+		panic(fmt.Errorf("field approve_template_id of message warden.act.v1beta1.EventSetContractTemplates is not mutable"))
+	case "warden.act.v1beta1.EventSetContractTemplates.reject_template_id":
+		panic(fmt.Errorf("field reject_template_id of message warden.act.v1beta1.EventSetContractTemplates is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventSetContractTemplates"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventSetContractTemplates does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventActionVoted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSetContractTemplates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionVoted.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "warden.act.v1beta1.EventActionVoted.participant":
+	case "warden.act.v1beta1.EventSetContractTemplates.contract":
 		return protoreflect.ValueOfString("")
-	case "warden.act.v1beta1.EventActionVoted.vote_type":
-		return protoreflect.ValueOfEnum(0)
+	case "warden.act.v1beta1.EventSetContractTemplates.approve_template_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventSetContractTemplates.reject_template_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventSetContractTemplates"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventSetContractTemplates does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventActionVoted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventSetContractTemplates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionVoted", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventSetContractTemplates", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1611,7 +1917,7 @@ func (x *fastReflection_EventActionVoted) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventActionVoted) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventSetContractTemplates) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1622,7 +1928,7 @@ func (x *fastReflection_EventActionVoted) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionVoted) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventSetContractTemplates) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1634,7 +1940,7 @@ func (x *fastReflection_EventActionVoted) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventActionVoted) IsValid() bool {
+func (x *fastReflection_EventSetContractTemplates) IsValid() bool {
 	return x != nil
 }
 
@@ -1644,9 +1950,9 @@ func (x *fastReflection_EventActionVoted) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventSetContractTemplates) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventActionVoted)
+		x := input.Message.Interface().(*EventSetContractTemplates)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1658,15 +1964,15 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.Participant)
+		l = len(x.Contract)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.VoteType != 0 {
-			n += 1 + runtime.Sov(uint64(x.VoteType))
+		if x.ApproveTemplateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApproveTemplateId))
+		}
+		if x.RejectTemplateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RejectTemplateId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1678,7 +1984,7 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventActionVoted)
+		x := input.Message.Interface().(*EventSetContractTemplates)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1697,22 +2003,22 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VoteType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteType))
+		if x.RejectTemplateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RejectTemplateId))
 			i--
 			dAtA[i] = 0x18
 		}
-		if len(x.Participant) > 0 {
-			i -= len(x.Participant)
-			copy(dAtA[i:], x.Participant)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participant)))
+		if x.ApproveTemplateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApproveTemplateId))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1725,7 +2031,7 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventActionVoted)
+		x := input.Message.Interface().(*EventSetContractTemplates)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1757,34 +2063,15 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionVoted: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetContractTemplates: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionVoted: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetContractTemplates: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1812,13 +2099,32 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Participant = string(dAtA[iNdEx:postIndex])
+				x.Contract = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
+				}
+				x.ApproveTemplateId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ApproveTemplateId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
 				}
-				x.VoteType = 0
+				x.RejectTemplateId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1828,7 +2134,7 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.VoteType |= ActionVoteType(b&0x7F) << shift
+					x.RejectTemplateId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1869,29 +2175,27 @@ func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventActionStateChange                 protoreflect.MessageDescriptor
-	fd_EventActionStateChange_id              protoreflect.FieldDescriptor
-	fd_EventActionStateChange_previous_status protoreflect.FieldDescriptor
-	fd_EventActionStateChange_new_status      protoreflect.FieldDescriptor
+	md_EventCreateAction         protoreflect.MessageDescriptor
+	fd_EventCreateAction_id      protoreflect.FieldDescriptor
+	fd_EventCreateAction_creator protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_events_proto_init()
-	md_EventActionStateChange = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionStateChange")
-	fd_EventActionStateChange_id = md_EventActionStateChange.Fields().ByName("id")
-	fd_EventActionStateChange_previous_status = md_EventActionStateChange.Fields().ByName("previous_status")
-	fd_EventActionStateChange_new_status = md_EventActionStateChange.Fields().ByName("new_status")
+	md_EventCreateAction = File_warden_act_v1beta1_events_proto.Messages().ByName("EventCreateAction")
+	fd_EventCreateAction_id = md_EventCreateAction.Fields().ByName("id")
+	fd_EventCreateAction_creator = md_EventCreateAction.Fields().ByName("creator")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventActionStateChange)(nil)
+var _ protoreflect.Message = (*fastReflection_EventCreateAction)(nil)
 
-type fastReflection_EventActionStateChange EventActionStateChange
+type fastReflection_EventCreateAction EventCreateAction
 
-func (x *EventActionStateChange) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventActionStateChange)(x)
+func (x *EventCreateAction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCreateAction)(x)
 }
 
-func (x *EventActionStateChange) slowProtoReflect() protoreflect.Message {
+func (x *EventCreateAction) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_events_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1903,43 +2207,43 @@ func (x *EventActionStateChange) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventActionStateChange_messageType fastReflection_EventActionStateChange_messageType
-var _ protoreflect.MessageType = fastReflection_EventActionStateChange_messageType{}
+var _fastReflection_EventCreateAction_messageType fastReflection_EventCreateAction_messageType
+var _ protoreflect.MessageType = fastReflection_EventCreateAction_messageType{}
 
-type fastReflection_EventActionStateChange_messageType struct{}
+type fastReflection_EventCreateAction_messageType struct{}
 
-func (x fastReflection_EventActionStateChange_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventActionStateChange)(nil)
+func (x fastReflection_EventCreateAction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCreateAction)(nil)
 }
-func (x fastReflection_EventActionStateChange_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventActionStateChange)
+func (x fastReflection_EventCreateAction_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCreateAction)
 }
-func (x fastReflection_EventActionStateChange_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventActionStateChange
+func (x fastReflection_EventCreateAction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCreateAction
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventActionStateChange) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventActionStateChange
+func (x *fastReflection_EventCreateAction) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCreateAction
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventActionStateChange) Type() protoreflect.MessageType {
-	return _fastReflection_EventActionStateChange_messageType
+func (x *fastReflection_EventCreateAction) Type() protoreflect.MessageType {
+	return _fastReflection_EventCreateAction_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventActionStateChange) New() protoreflect.Message {
-	return new(fastReflection_EventActionStateChange)
+func (x *fastReflection_EventCreateAction) New() protoreflect.Message {
+	return new(fastReflection_EventCreateAction)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventActionStateChange) Interface() protoreflect.ProtoMessage {
-	return (*EventActionStateChange)(x)
+func (x *fastReflection_EventCreateAction) Interface() protoreflect.ProtoMessage {
+	return (*EventCreateAction)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1947,22 +2251,16 @@ func (x *fastReflection_EventActionStateChange) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventActionStateChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventCreateAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_EventActionStateChange_id, value) {
-			return
-		}
-	}
-	if x.PreviousStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PreviousStatus))
-		if !f(fd_EventActionStateChange_previous_status, value) {
+		if !f(fd_EventCreateAction_id, value) {
 			return
 		}
 	}
-	if x.NewStatus != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.NewStatus))
-		if !f(fd_EventActionStateChange_new_status, value) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EventCreateAction_creator, value) {
 			return
 		}
 	}
@@ -1979,19 +2277,17 @@ func (x *fastReflection_EventActionStateChange) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventActionStateChange) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventCreateAction) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionStateChange.id":
+	case "warden.act.v1beta1.EventCreateAction.id":
 		return x.Id != uint64(0)
-	case "warden.act.v1beta1.EventActionStateChange.previous_status":
-		return x.PreviousStatus != 0
-	case "warden.act.v1beta1.EventActionStateChange.new_status":
-		return x.NewStatus != 0
+	case "warden.act.v1beta1.EventCreateAction.creator":
+		return x.Creator != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2001,19 +2297,17 @@ func (x *fastReflection_EventActionStateChange) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionStateChange) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventCreateAction) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionStateChange.id":
+	case "warden.act.v1beta1.EventCreateAction.id":
 		x.Id = uint64(0)
-	case "warden.act.v1beta1.EventActionStateChange.previous_status":
-		x.PreviousStatus = 0
-	case "warden.act.v1beta1.EventActionStateChange.new_status":
-		x.NewStatus = 0
+	case "warden.act.v1beta1.EventCreateAction.creator":
+		x.Creator = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2023,22 +2317,19 @@ func (x *fastReflection_EventActionStateChange) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventActionStateChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCreateAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.EventActionStateChange.id":
+	case "warden.act.v1beta1.EventCreateAction.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "warden.act.v1beta1.EventActionStateChange.previous_status":
-		value := x.PreviousStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "warden.act.v1beta1.EventActionStateChange.new_status":
-		value := x.NewStatus
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "warden.act.v1beta1.EventCreateAction.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2052,19 +2343,17 @@ func (x *fastReflection_EventActionStateChange) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionStateChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventCreateAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionStateChange.id":
+	case "warden.act.v1beta1.EventCreateAction.id":
 		x.Id = value.Uint()
-	case "warden.act.v1beta1.EventActionStateChange.previous_status":
-		x.PreviousStatus = (ActionStatus)(value.Enum())
-	case "warden.act.v1beta1.EventActionStateChange.new_status":
-		x.NewStatus = (ActionStatus)(value.Enum())
+	case "warden.act.v1beta1.EventCreateAction.creator":
+		x.Creator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2078,48 +2367,44 @@ func (x *fastReflection_EventActionStateChange) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionStateChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCreateAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionStateChange.id":
-		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionStateChange is not mutable"))
-	case "warden.act.v1beta1.EventActionStateChange.previous_status":
-		panic(fmt.Errorf("field previous_status of message warden.act.v1beta1.EventActionStateChange is not mutable"))
-	case "warden.act.v1beta1.EventActionStateChange.new_status":
-		panic(fmt.Errorf("field new_status of message warden.act.v1beta1.EventActionStateChange is not mutable"))
+	case "warden.act.v1beta1.EventCreateAction.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventCreateAction is not mutable"))
+	case "warden.act.v1beta1.EventCreateAction.creator":
+		panic(fmt.Errorf("field creator of message warden.act.v1beta1.EventCreateAction is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventActionStateChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventCreateAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionStateChange.id":
+	case "warden.act.v1beta1.EventCreateAction.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "warden.act.v1beta1.EventActionStateChange.previous_status":
-		return protoreflect.ValueOfEnum(0)
-	case "warden.act.v1beta1.EventActionStateChange.new_status":
-		return protoreflect.ValueOfEnum(0)
+	case "warden.act.v1beta1.EventCreateAction.creator":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventCreateAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventCreateAction does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventActionStateChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventCreateAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionStateChange", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventCreateAction", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2127,7 +2412,7 @@ func (x *fastReflection_EventActionStateChange) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventActionStateChange) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventCreateAction) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2138,7 +2423,7 @@ func (x *fastReflection_EventActionStateChange) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionStateChange) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventCreateAction) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2150,7 +2435,7 @@ func (x *fastReflection_EventActionStateChange) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventActionStateChange) IsValid() bool {
+func (x *fastReflection_EventCreateAction) IsValid() bool {
 	return x != nil
 }
 
@@ -2160,9 +2445,9 @@ func (x *fastReflection_EventActionStateChange) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventCreateAction) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventActionStateChange)
+		x := input.Message.Interface().(*EventCreateAction)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2177,11 +2462,9 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		if x.PreviousStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.PreviousStatus))
-		}
-		if x.NewStatus != 0 {
-			n += 1 + runtime.Sov(uint64(x.NewStatus))
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2193,7 +2476,7 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventActionStateChange)
+		x := input.Message.Interface().(*EventCreateAction)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2212,15 +2495,12 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.NewStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewStatus))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.PreviousStatus != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousStatus))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
@@ -2238,7 +2518,7 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventActionStateChange)
+		x := input.Message.Interface().(*EventCreateAction)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2270,10 +2550,10 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionStateChange: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAction: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateAction: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2296,10 +2576,10 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 					}
 				}
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
-				x.PreviousStatus = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2309,30 +2589,24 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PreviousStatus |= ActionStatus(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.NewStatus = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.NewStatus |= ActionStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2369,25 +2643,29 @@ func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_EventActionPruned    protoreflect.MessageDescriptor
-	fd_EventActionPruned_id protoreflect.FieldDescriptor
+	md_EventActionVoted             protoreflect.MessageDescriptor
+	fd_EventActionVoted_id          protoreflect.FieldDescriptor
+	fd_EventActionVoted_participant protoreflect.FieldDescriptor
+	fd_EventActionVoted_vote_type   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_events_proto_init()
-	md_EventActionPruned = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionPruned")
-	fd_EventActionPruned_id = md_EventActionPruned.Fields().ByName("id")
+	md_EventActionVoted = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionVoted")
+	fd_EventActionVoted_id = md_EventActionVoted.Fields().ByName("id")
+	fd_EventActionVoted_participant = md_EventActionVoted.Fields().ByName("participant")
+	fd_EventActionVoted_vote_type = md_EventActionVoted.Fields().ByName("vote_type")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventActionPruned)(nil)
+var _ protoreflect.Message = (*fastReflection_EventActionVoted)(nil)
 
-type fastReflection_EventActionPruned EventActionPruned
+type fastReflection_EventActionVoted EventActionVoted
 
-func (x *EventActionPruned) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventActionPruned)(x)
+func (x *EventActionVoted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventActionVoted)(x)
 }
 
-func (x *EventActionPruned) slowProtoReflect() protoreflect.Message {
+func (x *EventActionVoted) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2399,43 +2677,43 @@ func (x *EventActionPruned) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventActionPruned_messageType fastReflection_EventActionPruned_messageType
-var _ protoreflect.MessageType = fastReflection_EventActionPruned_messageType{}
+var _fastReflection_EventActionVoted_messageType fastReflection_EventActionVoted_messageType
+var _ protoreflect.MessageType = fastReflection_EventActionVoted_messageType{}
 
-type fastReflection_EventActionPruned_messageType struct{}
+type fastReflection_EventActionVoted_messageType struct{}
 
-func (x fastReflection_EventActionPruned_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventActionPruned)(nil)
+func (x fastReflection_EventActionVoted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventActionVoted)(nil)
 }
-func (x fastReflection_EventActionPruned_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventActionPruned)
+func (x fastReflection_EventActionVoted_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventActionVoted)
 }
-func (x fastReflection_EventActionPruned_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventActionPruned
+func (x fastReflection_EventActionVoted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionVoted
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventActionPruned) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventActionPruned
+func (x *fastReflection_EventActionVoted) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionVoted
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventActionPruned) Type() protoreflect.MessageType {
-	return _fastReflection_EventActionPruned_messageType
+func (x *fastReflection_EventActionVoted) Type() protoreflect.MessageType {
+	return _fastReflection_EventActionVoted_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventActionPruned) New() protoreflect.Message {
-	return new(fastReflection_EventActionPruned)
+func (x *fastReflection_EventActionVoted) New() protoreflect.Message {
+	return new(fastReflection_EventActionVoted)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventActionPruned) Interface() protoreflect.ProtoMessage {
-	return (*EventActionPruned)(x)
+func (x *fastReflection_EventActionVoted) Interface() protoreflect.ProtoMessage {
+	return (*EventActionVoted)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2443,10 +2721,3109 @@ func (x *fastReflection_EventActionPruned) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventActionPruned) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventActionVoted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_EventActionPruned_id, value) {
+		if !f(fd_EventActionVoted_id, value) {
+			return
+		}
+	}
+	if x.Participant != "" {
+		value := protoreflect.ValueOfString(x.Participant)
+		if !f(fd_EventActionVoted_participant, value) {
+			return
+		}
+	}
+	if x.VoteType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.VoteType))
+		if !f(fd_EventActionVoted_vote_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventActionVoted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionVoted.id":
+		return x.Id != uint64(0)
+	case "warden.act.v1beta1.EventActionVoted.participant":
+		return x.Participant != ""
+	case "warden.act.v1beta1.EventActionVoted.vote_type":
+		return x.VoteType != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionVoted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionVoted.id":
+		x.Id = uint64(0)
+	case "warden.act.v1beta1.EventActionVoted.participant":
+		x.Participant = ""
+	case "warden.act.v1beta1.EventActionVoted.vote_type":
+		x.VoteType = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventActionVoted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.EventActionVoted.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventActionVoted.participant":
+		value := x.Participant
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.EventActionVoted.vote_type":
+		value := x.VoteType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionVoted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionVoted.id":
+		x.Id = value.Uint()
+	case "warden.act.v1beta1.EventActionVoted.participant":
+		x.Participant = value.Interface().(string)
+	case "warden.act.v1beta1.EventActionVoted.vote_type":
+		x.VoteType = (ActionVoteType)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionVoted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionVoted.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionVoted is not mutable"))
+	case "warden.act.v1beta1.EventActionVoted.participant":
+		panic(fmt.Errorf("field participant of message warden.act.v1beta1.EventActionVoted is not mutable"))
+	case "warden.act.v1beta1.EventActionVoted.vote_type":
+		panic(fmt.Errorf("field vote_type of message warden.act.v1beta1.EventActionVoted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventActionVoted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionVoted.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventActionVoted.participant":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.EventActionVoted.vote_type":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionVoted"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionVoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventActionVoted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionVoted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventActionVoted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionVoted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventActionVoted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventActionVoted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventActionVoted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Participant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VoteType != 0 {
+			n += 1 + runtime.Sov(uint64(x.VoteType))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionVoted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VoteType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Participant) > 0 {
+			i -= len(x.Participant)
+			copy(dAtA[i:], x.Participant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participant)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionVoted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionVoted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionVoted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				}
+				x.VoteType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VoteType |= ActionVoteType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventActionStateChange                 protoreflect.MessageDescriptor
+	fd_EventActionStateChange_id              protoreflect.FieldDescriptor
+	fd_EventActionStateChange_previous_status protoreflect.FieldDescriptor
+	fd_EventActionStateChange_new_status      protoreflect.FieldDescriptor
+	fd_EventActionStateChange_error           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_events_proto_init()
+	md_EventActionStateChange = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionStateChange")
+	fd_EventActionStateChange_id = md_EventActionStateChange.Fields().ByName("id")
+	fd_EventActionStateChange_previous_status = md_EventActionStateChange.Fields().ByName("previous_status")
+	fd_EventActionStateChange_new_status = md_EventActionStateChange.Fields().ByName("new_status")
+	fd_EventActionStateChange_error = md_EventActionStateChange.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventActionStateChange)(nil)
+
+type fastReflection_EventActionStateChange EventActionStateChange
+
+func (x *EventActionStateChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventActionStateChange)(x)
+}
+
+func (x *EventActionStateChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventActionStateChange_messageType fastReflection_EventActionStateChange_messageType
+var _ protoreflect.MessageType = fastReflection_EventActionStateChange_messageType{}
+
+type fastReflection_EventActionStateChange_messageType struct{}
+
+func (x fastReflection_EventActionStateChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventActionStateChange)(nil)
+}
+func (x fastReflection_EventActionStateChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventActionStateChange)
+}
+func (x fastReflection_EventActionStateChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionStateChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventActionStateChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionStateChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventActionStateChange) Type() protoreflect.MessageType {
+	return _fastReflection_EventActionStateChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventActionStateChange) New() protoreflect.Message {
+	return new(fastReflection_EventActionStateChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventActionStateChange) Interface() protoreflect.ProtoMessage {
+	return (*EventActionStateChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventActionStateChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EventActionStateChange_id, value) {
+			return
+		}
+	}
+	if x.PreviousStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PreviousStatus))
+		if !f(fd_EventActionStateChange_previous_status, value) {
+			return
+		}
+	}
+	if x.NewStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.NewStatus))
+		if !f(fd_EventActionStateChange_new_status, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventActionStateChange_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventActionStateChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionStateChange.id":
+		return x.Id != uint64(0)
+	case "warden.act.v1beta1.EventActionStateChange.previous_status":
+		return x.PreviousStatus != 0
+	case "warden.act.v1beta1.EventActionStateChange.new_status":
+		return x.NewStatus != 0
+	case "warden.act.v1beta1.EventActionStateChange.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionStateChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionStateChange.id":
+		x.Id = uint64(0)
+	case "warden.act.v1beta1.EventActionStateChange.previous_status":
+		x.PreviousStatus = 0
+	case "warden.act.v1beta1.EventActionStateChange.new_status":
+		x.NewStatus = 0
+	case "warden.act.v1beta1.EventActionStateChange.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventActionStateChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.EventActionStateChange.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventActionStateChange.previous_status":
+		value := x.PreviousStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "warden.act.v1beta1.EventActionStateChange.new_status":
+		value := x.NewStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "warden.act.v1beta1.EventActionStateChange.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionStateChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionStateChange.id":
+		x.Id = value.Uint()
+	case "warden.act.v1beta1.EventActionStateChange.previous_status":
+		x.PreviousStatus = (ActionStatus)(value.Enum())
+	case "warden.act.v1beta1.EventActionStateChange.new_status":
+		x.NewStatus = (ActionStatus)(value.Enum())
+	case "warden.act.v1beta1.EventActionStateChange.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionStateChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionStateChange.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionStateChange is not mutable"))
+	case "warden.act.v1beta1.EventActionStateChange.previous_status":
+		panic(fmt.Errorf("field previous_status of message warden.act.v1beta1.EventActionStateChange is not mutable"))
+	case "warden.act.v1beta1.EventActionStateChange.new_status":
+		panic(fmt.Errorf("field new_status of message warden.act.v1beta1.EventActionStateChange is not mutable"))
+	case "warden.act.v1beta1.EventActionStateChange.error":
+		panic(fmt.Errorf("field error of message warden.act.v1beta1.EventActionStateChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventActionStateChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionStateChange.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventActionStateChange.previous_status":
+		return protoreflect.ValueOfEnum(0)
+	case "warden.act.v1beta1.EventActionStateChange.new_status":
+		return protoreflect.ValueOfEnum(0)
+	case "warden.act.v1beta1.EventActionStateChange.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionStateChange"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionStateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventActionStateChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionStateChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventActionStateChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionStateChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventActionStateChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventActionStateChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventActionStateChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.PreviousStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousStatus))
+		}
+		if x.NewStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewStatus))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionStateChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.NewStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewStatus))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PreviousStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousStatus))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionStateChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionStateChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+				}
+				x.PreviousStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousStatus |= ActionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				}
+				x.NewStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewStatus |= ActionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventActionPruned    protoreflect.MessageDescriptor
+	fd_EventActionPruned_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_events_proto_init()
+	md_EventActionPruned = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionPruned")
+	fd_EventActionPruned_id = md_EventActionPruned.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventActionPruned)(nil)
+
+type fastReflection_EventActionPruned EventActionPruned
+
+func (x *EventActionPruned) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventActionPruned)(x)
+}
+
+func (x *EventActionPruned) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventActionPruned_messageType fastReflection_EventActionPruned_messageType
+var _ protoreflect.MessageType = fastReflection_EventActionPruned_messageType{}
+
+type fastReflection_EventActionPruned_messageType struct{}
+
+func (x fastReflection_EventActionPruned_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventActionPruned)(nil)
+}
+func (x fastReflection_EventActionPruned_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventActionPruned)
+}
+func (x fastReflection_EventActionPruned_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionPruned
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventActionPruned) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionPruned
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventActionPruned) Type() protoreflect.MessageType {
+	return _fastReflection_EventActionPruned_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventActionPruned) New() protoreflect.Message {
+	return new(fastReflection_EventActionPruned)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventActionPruned) Interface() protoreflect.ProtoMessage {
+	return (*EventActionPruned)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventActionPruned) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EventActionPruned_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventActionPruned) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionPruned.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionPruned) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionPruned.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventActionPruned) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.EventActionPruned.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionPruned) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionPruned.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionPruned) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionPruned.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionPruned is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventActionPruned) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionPruned.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventActionPruned) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionPruned", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventActionPruned) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionPruned) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventActionPruned) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventActionPruned)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionPruned)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionPruned)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionPruned: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventActionDependencyChanged            protoreflect.MessageDescriptor
+	fd_EventActionDependencyChanged_id         protoreflect.FieldDescriptor
+	fd_EventActionDependencyChanged_dependency protoreflect.FieldDescriptor
+	fd_EventActionDependencyChanged_reason     protoreflect.FieldDescriptor
+	fd_EventActionDependencyChanged_error      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_events_proto_init()
+	md_EventActionDependencyChanged = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionDependencyChanged")
+	fd_EventActionDependencyChanged_id = md_EventActionDependencyChanged.Fields().ByName("id")
+	fd_EventActionDependencyChanged_dependency = md_EventActionDependencyChanged.Fields().ByName("dependency")
+	fd_EventActionDependencyChanged_reason = md_EventActionDependencyChanged.Fields().ByName("reason")
+	fd_EventActionDependencyChanged_error = md_EventActionDependencyChanged.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventActionDependencyChanged)(nil)
+
+type fastReflection_EventActionDependencyChanged EventActionDependencyChanged
+
+func (x *EventActionDependencyChanged) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventActionDependencyChanged)(x)
+}
+
+func (x *EventActionDependencyChanged) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventActionDependencyChanged_messageType fastReflection_EventActionDependencyChanged_messageType
+var _ protoreflect.MessageType = fastReflection_EventActionDependencyChanged_messageType{}
+
+type fastReflection_EventActionDependencyChanged_messageType struct{}
+
+func (x fastReflection_EventActionDependencyChanged_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventActionDependencyChanged)(nil)
+}
+func (x fastReflection_EventActionDependencyChanged_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventActionDependencyChanged)
+}
+func (x fastReflection_EventActionDependencyChanged_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionDependencyChanged
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventActionDependencyChanged) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionDependencyChanged
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventActionDependencyChanged) Type() protoreflect.MessageType {
+	return _fastReflection_EventActionDependencyChanged_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventActionDependencyChanged) New() protoreflect.Message {
+	return new(fastReflection_EventActionDependencyChanged)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventActionDependencyChanged) Interface() protoreflect.ProtoMessage {
+	return (*EventActionDependencyChanged)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventActionDependencyChanged) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EventActionDependencyChanged_id, value) {
+			return
+		}
+	}
+	if x.Dependency != "" {
+		value := protoreflect.ValueOfString(x.Dependency)
+		if !f(fd_EventActionDependencyChanged_dependency, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventActionDependencyChanged_reason, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventActionDependencyChanged_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventActionDependencyChanged) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionDependencyChanged.id":
+		return x.Id != uint64(0)
+	case "warden.act.v1beta1.EventActionDependencyChanged.dependency":
+		return x.Dependency != ""
+	case "warden.act.v1beta1.EventActionDependencyChanged.reason":
+		return x.Reason != ""
+	case "warden.act.v1beta1.EventActionDependencyChanged.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionDependencyChanged"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionDependencyChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionDependencyChanged) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionDependencyChanged.id":
+		x.Id = uint64(0)
+	case "warden.act.v1beta1.EventActionDependencyChanged.dependency":
+		x.Dependency = ""
+	case "warden.act.v1beta1.EventActionDependencyChanged.reason":
+		x.Reason = ""
+	case "warden.act.v1beta1.EventActionDependencyChanged.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionDependencyChanged"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionDependencyChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventActionDependencyChanged) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.EventActionDependencyChanged.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventActionDependencyChanged.dependency":
+		value := x.Dependency
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.EventActionDependencyChanged.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.EventActionDependencyChanged.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionDependencyChanged"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionDependencyChanged does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionDependencyChanged) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionDependencyChanged.id":
+		x.Id = value.Uint()
+	case "warden.act.v1beta1.EventActionDependencyChanged.dependency":
+		x.Dependency = value.Interface().(string)
+	case "warden.act.v1beta1.EventActionDependencyChanged.reason":
+		x.Reason = value.Interface().(string)
+	case "warden.act.v1beta1.EventActionDependencyChanged.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionDependencyChanged"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionDependencyChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionDependencyChanged) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionDependencyChanged.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionDependencyChanged is not mutable"))
+	case "warden.act.v1beta1.EventActionDependencyChanged.dependency":
+		panic(fmt.Errorf("field dependency of message warden.act.v1beta1.EventActionDependencyChanged is not mutable"))
+	case "warden.act.v1beta1.EventActionDependencyChanged.reason":
+		panic(fmt.Errorf("field reason of message warden.act.v1beta1.EventActionDependencyChanged is not mutable"))
+	case "warden.act.v1beta1.EventActionDependencyChanged.error":
+		panic(fmt.Errorf("field error of message warden.act.v1beta1.EventActionDependencyChanged is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionDependencyChanged"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionDependencyChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventActionDependencyChanged) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionDependencyChanged.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventActionDependencyChanged.dependency":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.EventActionDependencyChanged.reason":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.EventActionDependencyChanged.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionDependencyChanged"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionDependencyChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventActionDependencyChanged) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionDependencyChanged", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventActionDependencyChanged) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionDependencyChanged) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventActionDependencyChanged) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventActionDependencyChanged) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventActionDependencyChanged)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Dependency)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionDependencyChanged)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Dependency) > 0 {
+			i -= len(x.Dependency)
+			copy(dAtA[i:], x.Dependency)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dependency)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionDependencyChanged)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionDependencyChanged: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionDependencyChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dependency", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dependency = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventActionQueued               protoreflect.MessageDescriptor
+	fd_EventActionQueued_id            protoreflect.FieldDescriptor
+	fd_EventActionQueued_execute_after protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_events_proto_init()
+	md_EventActionQueued = File_warden_act_v1beta1_events_proto.Messages().ByName("EventActionQueued")
+	fd_EventActionQueued_id = md_EventActionQueued.Fields().ByName("id")
+	fd_EventActionQueued_execute_after = md_EventActionQueued.Fields().ByName("execute_after")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventActionQueued)(nil)
+
+type fastReflection_EventActionQueued EventActionQueued
+
+func (x *EventActionQueued) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventActionQueued)(x)
+}
+
+func (x *EventActionQueued) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventActionQueued_messageType fastReflection_EventActionQueued_messageType
+var _ protoreflect.MessageType = fastReflection_EventActionQueued_messageType{}
+
+type fastReflection_EventActionQueued_messageType struct{}
+
+func (x fastReflection_EventActionQueued_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventActionQueued)(nil)
+}
+func (x fastReflection_EventActionQueued_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventActionQueued)
+}
+func (x fastReflection_EventActionQueued_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionQueued
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventActionQueued) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActionQueued
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventActionQueued) Type() protoreflect.MessageType {
+	return _fastReflection_EventActionQueued_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventActionQueued) New() protoreflect.Message {
+	return new(fastReflection_EventActionQueued)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventActionQueued) Interface() protoreflect.ProtoMessage {
+	return (*EventActionQueued)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventActionQueued) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EventActionQueued_id, value) {
+			return
+		}
+	}
+	if x.ExecuteAfter != nil {
+		value := protoreflect.ValueOfMessage(x.ExecuteAfter.ProtoReflect())
+		if !f(fd_EventActionQueued_execute_after, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventActionQueued) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionQueued.id":
+		return x.Id != uint64(0)
+	case "warden.act.v1beta1.EventActionQueued.execute_after":
+		return x.ExecuteAfter != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionQueued"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionQueued does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionQueued) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionQueued.id":
+		x.Id = uint64(0)
+	case "warden.act.v1beta1.EventActionQueued.execute_after":
+		x.ExecuteAfter = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionQueued"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionQueued does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventActionQueued) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.EventActionQueued.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventActionQueued.execute_after":
+		value := x.ExecuteAfter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionQueued"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionQueued does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionQueued) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionQueued.id":
+		x.Id = value.Uint()
+	case "warden.act.v1beta1.EventActionQueued.execute_after":
+		x.ExecuteAfter = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionQueued"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionQueued does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionQueued) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionQueued.execute_after":
+		if x.ExecuteAfter == nil {
+			x.ExecuteAfter = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExecuteAfter.ProtoReflect())
+	case "warden.act.v1beta1.EventActionQueued.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionQueued is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionQueued"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionQueued does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventActionQueued) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventActionQueued.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventActionQueued.execute_after":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionQueued"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventActionQueued does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventActionQueued) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionQueued", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventActionQueued) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActionQueued) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventActionQueued) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventActionQueued) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventActionQueued)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.ExecuteAfter != nil {
+			l = options.Size(x.ExecuteAfter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionQueued)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExecuteAfter != nil {
+			encoded, err := options.Marshal(x.ExecuteAfter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventActionQueued)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionQueued: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecuteAfter == nil {
+					x.ExecuteAfter = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecuteAfter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventVoteDelegated           protoreflect.MessageDescriptor
+	fd_EventVoteDelegated_id        protoreflect.FieldDescriptor
+	fd_EventVoteDelegated_delegator protoreflect.FieldDescriptor
+	fd_EventVoteDelegated_delegate  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_events_proto_init()
+	md_EventVoteDelegated = File_warden_act_v1beta1_events_proto.Messages().ByName("EventVoteDelegated")
+	fd_EventVoteDelegated_id = md_EventVoteDelegated.Fields().ByName("id")
+	fd_EventVoteDelegated_delegator = md_EventVoteDelegated.Fields().ByName("delegator")
+	fd_EventVoteDelegated_delegate = md_EventVoteDelegated.Fields().ByName("delegate")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventVoteDelegated)(nil)
+
+type fastReflection_EventVoteDelegated EventVoteDelegated
+
+func (x *EventVoteDelegated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVoteDelegated)(x)
+}
+
+func (x *EventVoteDelegated) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventVoteDelegated_messageType fastReflection_EventVoteDelegated_messageType
+var _ protoreflect.MessageType = fastReflection_EventVoteDelegated_messageType{}
+
+type fastReflection_EventVoteDelegated_messageType struct{}
+
+func (x fastReflection_EventVoteDelegated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVoteDelegated)(nil)
+}
+func (x fastReflection_EventVoteDelegated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVoteDelegated)
+}
+func (x fastReflection_EventVoteDelegated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVoteDelegated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventVoteDelegated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVoteDelegated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventVoteDelegated) Type() protoreflect.MessageType {
+	return _fastReflection_EventVoteDelegated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventVoteDelegated) New() protoreflect.Message {
+	return new(fastReflection_EventVoteDelegated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventVoteDelegated) Interface() protoreflect.ProtoMessage {
+	return (*EventVoteDelegated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventVoteDelegated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EventVoteDelegated_id, value) {
+			return
+		}
+	}
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_EventVoteDelegated_delegator, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_EventVoteDelegated_delegate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventVoteDelegated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventVoteDelegated.id":
+		return x.Id != uint64(0)
+	case "warden.act.v1beta1.EventVoteDelegated.delegator":
+		return x.Delegator != ""
+	case "warden.act.v1beta1.EventVoteDelegated.delegate":
+		return x.Delegate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegated"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVoteDelegated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventVoteDelegated.id":
+		x.Id = uint64(0)
+	case "warden.act.v1beta1.EventVoteDelegated.delegator":
+		x.Delegator = ""
+	case "warden.act.v1beta1.EventVoteDelegated.delegate":
+		x.Delegate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegated"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventVoteDelegated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.EventVoteDelegated.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventVoteDelegated.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.EventVoteDelegated.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegated"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVoteDelegated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventVoteDelegated.id":
+		x.Id = value.Uint()
+	case "warden.act.v1beta1.EventVoteDelegated.delegator":
+		x.Delegator = value.Interface().(string)
+	case "warden.act.v1beta1.EventVoteDelegated.delegate":
+		x.Delegate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegated"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVoteDelegated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventVoteDelegated.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventVoteDelegated is not mutable"))
+	case "warden.act.v1beta1.EventVoteDelegated.delegator":
+		panic(fmt.Errorf("field delegator of message warden.act.v1beta1.EventVoteDelegated is not mutable"))
+	case "warden.act.v1beta1.EventVoteDelegated.delegate":
+		panic(fmt.Errorf("field delegate of message warden.act.v1beta1.EventVoteDelegated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegated"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventVoteDelegated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.EventVoteDelegated.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventVoteDelegated.delegator":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.EventVoteDelegated.delegate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegated"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventVoteDelegated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventVoteDelegated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventVoteDelegated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVoteDelegated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventVoteDelegated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventVoteDelegated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventVoteDelegated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventVoteDelegated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventVoteDelegated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVoteDelegated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVoteDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventVoteDelegationRevoked           protoreflect.MessageDescriptor
+	fd_EventVoteDelegationRevoked_id        protoreflect.FieldDescriptor
+	fd_EventVoteDelegationRevoked_delegator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_events_proto_init()
+	md_EventVoteDelegationRevoked = File_warden_act_v1beta1_events_proto.Messages().ByName("EventVoteDelegationRevoked")
+	fd_EventVoteDelegationRevoked_id = md_EventVoteDelegationRevoked.Fields().ByName("id")
+	fd_EventVoteDelegationRevoked_delegator = md_EventVoteDelegationRevoked.Fields().ByName("delegator")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventVoteDelegationRevoked)(nil)
+
+type fastReflection_EventVoteDelegationRevoked EventVoteDelegationRevoked
+
+func (x *EventVoteDelegationRevoked) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVoteDelegationRevoked)(x)
+}
+
+func (x *EventVoteDelegationRevoked) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventVoteDelegationRevoked_messageType fastReflection_EventVoteDelegationRevoked_messageType
+var _ protoreflect.MessageType = fastReflection_EventVoteDelegationRevoked_messageType{}
+
+type fastReflection_EventVoteDelegationRevoked_messageType struct{}
+
+func (x fastReflection_EventVoteDelegationRevoked_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVoteDelegationRevoked)(nil)
+}
+func (x fastReflection_EventVoteDelegationRevoked_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVoteDelegationRevoked)
+}
+func (x fastReflection_EventVoteDelegationRevoked_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVoteDelegationRevoked
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventVoteDelegationRevoked) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVoteDelegationRevoked
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventVoteDelegationRevoked) Type() protoreflect.MessageType {
+	return _fastReflection_EventVoteDelegationRevoked_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventVoteDelegationRevoked) New() protoreflect.Message {
+	return new(fastReflection_EventVoteDelegationRevoked)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventVoteDelegationRevoked) Interface() protoreflect.ProtoMessage {
+	return (*EventVoteDelegationRevoked)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventVoteDelegationRevoked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EventVoteDelegationRevoked_id, value) {
+			return
+		}
+	}
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_EventVoteDelegationRevoked_delegator, value) {
 			return
 		}
 	}
@@ -2463,15 +5840,17 @@ func (x *fastReflection_EventActionPruned) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventActionPruned) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventVoteDelegationRevoked) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionPruned.id":
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.id":
 		return x.Id != uint64(0)
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.delegator":
+		return x.Delegator != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegationRevoked"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegationRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2481,15 +5860,17 @@ func (x *fastReflection_EventActionPruned) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionPruned) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventVoteDelegationRevoked) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionPruned.id":
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.id":
 		x.Id = uint64(0)
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.delegator":
+		x.Delegator = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegationRevoked"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegationRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2499,16 +5880,19 @@ func (x *fastReflection_EventActionPruned) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventActionPruned) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVoteDelegationRevoked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.EventActionPruned.id":
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegationRevoked"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegationRevoked does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2522,15 +5906,17 @@ func (x *fastReflection_EventActionPruned) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionPruned) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventVoteDelegationRevoked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionPruned.id":
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.id":
 		x.Id = value.Uint()
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.delegator":
+		x.Delegator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegationRevoked"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegationRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2544,40 +5930,44 @@ func (x *fastReflection_EventActionPruned) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionPruned) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVoteDelegationRevoked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionPruned.id":
-		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventActionPruned is not mutable"))
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.EventVoteDelegationRevoked is not mutable"))
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.delegator":
+		panic(fmt.Errorf("field delegator of message warden.act.v1beta1.EventVoteDelegationRevoked is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegationRevoked"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegationRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventActionPruned) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventVoteDelegationRevoked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.EventActionPruned.id":
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.EventVoteDelegationRevoked.delegator":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventActionPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.EventVoteDelegationRevoked"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.EventActionPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.EventVoteDelegationRevoked does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventActionPruned) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventVoteDelegationRevoked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventActionPruned", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.EventVoteDelegationRevoked", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2585,7 +5975,7 @@ func (x *fastReflection_EventActionPruned) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventActionPruned) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventVoteDelegationRevoked) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2596,7 +5986,7 @@ func (x *fastReflection_EventActionPruned) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventActionPruned) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventVoteDelegationRevoked) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2608,7 +5998,7 @@ func (x *fastReflection_EventActionPruned) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventActionPruned) IsValid() bool {
+func (x *fastReflection_EventVoteDelegationRevoked) IsValid() bool {
 	return x != nil
 }
 
@@ -2618,9 +6008,9 @@ func (x *fastReflection_EventActionPruned) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventVoteDelegationRevoked) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventActionPruned)
+		x := input.Message.Interface().(*EventVoteDelegationRevoked)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2635,6 +6025,10 @@ func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2645,7 +6039,7 @@ func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventActionPruned)
+		x := input.Message.Interface().(*EventVoteDelegationRevoked)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2664,6 +6058,13 @@ func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
@@ -2680,7 +6081,7 @@ func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventActionPruned)
+		x := input.Message.Interface().(*EventVoteDelegationRevoked)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2712,10 +6113,10 @@ func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionPruned: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVoteDelegationRevoked: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActionPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVoteDelegationRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2737,6 +6138,38 @@ func (x *fastReflection_EventActionPruned) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2817,53 +6250,201 @@ func (*EventCreateTemplate) Descriptor() ([]byte, []int) {
 	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *EventCreateTemplate) GetId() uint64 {
+func (x *EventCreateTemplate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventCreateTemplate) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+// EventUpdateTemplate is emitted when Template is updated
+type EventUpdateTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of updated intent
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version is the new version of the template
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// updated_by is the address that updated the template
+	UpdatedBy string `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// old_expression is the expression of the previous version, formatted
+	OldExpression string `protobuf:"bytes,4,opt,name=old_expression,json=oldExpression,proto3" json:"old_expression,omitempty"`
+	// new_expression is the expression of the new version, formatted
+	NewExpression string `protobuf:"bytes,5,opt,name=new_expression,json=newExpression,proto3" json:"new_expression,omitempty"`
+}
+
+func (x *EventUpdateTemplate) Reset() {
+	*x = EventUpdateTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateTemplate) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateTemplate.ProtoReflect.Descriptor instead.
+func (*EventUpdateTemplate) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventUpdateTemplate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventUpdateTemplate) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EventUpdateTemplate) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *EventUpdateTemplate) GetOldExpression() string {
+	if x != nil {
+		return x.OldExpression
+	}
+	return ""
+}
+
+func (x *EventUpdateTemplate) GetNewExpression() string {
+	if x != nil {
+		return x.NewExpression
+	}
+	return ""
+}
+
+// EventTransferTemplateOwnership is emitted when the ownership of a Template
+// is transferred
+type EventTransferTemplateOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the template
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// previous_owner is the address of the previous owner
+	PreviousOwner string `protobuf:"bytes,2,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	// new_owner is the address of the new owner
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (x *EventTransferTemplateOwnership) Reset() {
+	*x = EventTransferTemplateOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTransferTemplateOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTransferTemplateOwnership) ProtoMessage() {}
+
+// Deprecated: Use EventTransferTemplateOwnership.ProtoReflect.Descriptor instead.
+func (*EventTransferTemplateOwnership) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventTransferTemplateOwnership) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *EventCreateTemplate) GetCreator() string {
+func (x *EventTransferTemplateOwnership) GetPreviousOwner() string {
 	if x != nil {
-		return x.Creator
+		return x.PreviousOwner
 	}
 	return ""
 }
 
-// EventUpdateTemplate is emitted when Template is updated
-type EventUpdateTemplate struct {
+func (x *EventTransferTemplateOwnership) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+// EventSetContractTemplates is emitted when the templates of a contract are
+// set or removed
+type EventSetContractTemplates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id of updated intent
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// contract is the address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// approve_template_id is the id of the approve template, 0 if removed
+	ApproveTemplateId uint64 `protobuf:"varint,2,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
+	// reject_template_id is the id of the reject template, 0 if removed
+	RejectTemplateId uint64 `protobuf:"varint,3,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
 }
 
-func (x *EventUpdateTemplate) Reset() {
-	*x = EventUpdateTemplate{}
+func (x *EventSetContractTemplates) Reset() {
+	*x = EventSetContractTemplates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_events_proto_msgTypes[1]
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateTemplate) String() string {
+func (x *EventSetContractTemplates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateTemplate) ProtoMessage() {}
+func (*EventSetContractTemplates) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateTemplate.ProtoReflect.Descriptor instead.
-func (*EventUpdateTemplate) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventSetContractTemplates.ProtoReflect.Descriptor instead.
+func (*EventSetContractTemplates) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EventUpdateTemplate) GetId() uint64 {
+func (x *EventSetContractTemplates) GetContract() string {
 	if x != nil {
-		return x.Id
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *EventSetContractTemplates) GetApproveTemplateId() uint64 {
+	if x != nil {
+		return x.ApproveTemplateId
+	}
+	return 0
+}
+
+func (x *EventSetContractTemplates) GetRejectTemplateId() uint64 {
+	if x != nil {
+		return x.RejectTemplateId
 	}
 	return 0
 }
@@ -2883,7 +6464,7 @@ type EventCreateAction struct {
 func (x *EventCreateAction) Reset() {
 	*x = EventCreateAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_events_proto_msgTypes[2]
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2897,7 +6478,7 @@ func (*EventCreateAction) ProtoMessage() {}
 
 // Deprecated: Use EventCreateAction.ProtoReflect.Descriptor instead.
 func (*EventCreateAction) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{2}
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EventCreateAction) GetId() uint64 {
@@ -2931,7 +6512,7 @@ type EventActionVoted struct {
 func (x *EventActionVoted) Reset() {
 	*x = EventActionVoted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_events_proto_msgTypes[3]
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2945,7 +6526,7 @@ func (*EventActionVoted) ProtoMessage() {}
 
 // Deprecated: Use EventActionVoted.ProtoReflect.Descriptor instead.
 func (*EventActionVoted) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{3}
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EventActionVoted) GetId() uint64 {
@@ -2981,12 +6562,15 @@ type EventActionStateChange struct {
 	PreviousStatus ActionStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=warden.act.v1beta1.ActionStatus" json:"previous_status,omitempty"`
 	// new_status is the new status of the action
 	NewStatus ActionStatus `protobuf:"varint,3,opt,name=new_status,json=newStatus,proto3,enum=warden.act.v1beta1.ActionStatus" json:"new_status,omitempty"`
+	// error returned by the execution of the action, set when new_status is
+	// ACTION_STATUS_FAILED
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (x *EventActionStateChange) Reset() {
 	*x = EventActionStateChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_events_proto_msgTypes[4]
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3000,7 +6584,7 @@ func (*EventActionStateChange) ProtoMessage() {}
 
 // Deprecated: Use EventActionStateChange.ProtoReflect.Descriptor instead.
 func (*EventActionStateChange) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{4}
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EventActionStateChange) GetId() uint64 {
@@ -3024,7 +6608,14 @@ func (x *EventActionStateChange) GetNewStatus() ActionStatus {
 	return ActionStatus_ACTION_STATUS_UNSPECIFIED
 }
 
-// EventActionPruned is emitted when an Action is pruned in `Completed`, `Revoked`, `Pending` or `Timeout`
+func (x *EventActionStateChange) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EventActionPruned is emitted when an Action is pruned in `Completed`, `Revoked`, `Failed`, `Pending` or `Timeout`
 // states and won't be processed further
 type EventActionPruned struct {
 	state         protoimpl.MessageState
@@ -3038,7 +6629,7 @@ type EventActionPruned struct {
 func (x *EventActionPruned) Reset() {
 	*x = EventActionPruned{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_events_proto_msgTypes[5]
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3052,7 +6643,7 @@ func (*EventActionPruned) ProtoMessage() {}
 
 // Deprecated: Use EventActionPruned.ProtoReflect.Descriptor instead.
 func (*EventActionPruned) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{5}
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventActionPruned) GetId() uint64 {
@@ -3062,64 +6653,334 @@ func (x *EventActionPruned) GetId() uint64 {
 	return 0
 }
 
+// EventActionDependencyChanged is emitted when the expressions of a pending
+// Action are expanded again because one of their dependencies changed
+type EventActionDependencyChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of action
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// dependency that changed, e.g. `warden/spaces/1`
+	Dependency string `protobuf:"bytes,2,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	// reason of the change, as reported by the module owning the dependency
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// error is set when the expressions couldn't be expanded again, in which
+	// case the action is revoked
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventActionDependencyChanged) Reset() {
+	*x = EventActionDependencyChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventActionDependencyChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventActionDependencyChanged) ProtoMessage() {}
+
+// Deprecated: Use EventActionDependencyChanged.ProtoReflect.Descriptor instead.
+func (*EventActionDependencyChanged) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventActionDependencyChanged) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventActionDependencyChanged) GetDependency() string {
+	if x != nil {
+		return x.Dependency
+	}
+	return ""
+}
+
+func (x *EventActionDependencyChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventActionDependencyChanged) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EventActionQueued is emitted when an approved Action is queued for a delayed
+// execution
+type EventActionQueued struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of action
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// execute_after is the time after which the action is executed
+	ExecuteAfter *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=execute_after,json=executeAfter,proto3" json:"execute_after,omitempty"`
+}
+
+func (x *EventActionQueued) Reset() {
+	*x = EventActionQueued{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventActionQueued) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventActionQueued) ProtoMessage() {}
+
+// Deprecated: Use EventActionQueued.ProtoReflect.Descriptor instead.
+func (*EventActionQueued) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventActionQueued) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventActionQueued) GetExecuteAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecuteAfter
+	}
+	return nil
+}
+
+// EventVoteDelegated is emitted when an address delegates its votes
+type EventVoteDelegated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the delegation
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator is the address delegating its votes
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address voting on behalf of the delegator
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (x *EventVoteDelegated) Reset() {
+	*x = EventVoteDelegated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVoteDelegated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVoteDelegated) ProtoMessage() {}
+
+// Deprecated: Use EventVoteDelegated.ProtoReflect.Descriptor instead.
+func (*EventVoteDelegated) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventVoteDelegated) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventVoteDelegated) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *EventVoteDelegated) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+// EventVoteDelegationRevoked is emitted when a vote delegation is revoked
+type EventVoteDelegationRevoked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the delegation
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator is the address that delegated its votes
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (x *EventVoteDelegationRevoked) Reset() {
+	*x = EventVoteDelegationRevoked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVoteDelegationRevoked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVoteDelegationRevoked) ProtoMessage() {}
+
+// Deprecated: Use EventVoteDelegationRevoked.ProtoReflect.Descriptor instead.
+func (*EventVoteDelegationRevoked) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventVoteDelegationRevoked) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventVoteDelegationRevoked) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
 var File_warden_act_v1beta1_events_proto protoreflect.FileDescriptor
 
 var file_warden_act_v1beta1_events_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x12, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63,
-	0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61,
-	0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3f, 0x0a, 0x13,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x25, 0x0a,
-	0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x6f,
-	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
-	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x16,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61,
-	0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x42, 0xdc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
-	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x3b, 0x61, 0x63, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02,
-	0x03, 0x57, 0x41, 0x58, 0xaa, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x41, 0x63,
-	0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
-	0x1e, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x14, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x74, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x3f, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f,
+	0x6c, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x6e, 0x65, 0x77, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x19, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x22, 0x3d, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x85, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x76, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f,
+	0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x23, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x1c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6e, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a,
+	0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x22, 0x4a, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0xdc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
+	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x3b, 0x61, 0x63, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x57, 0x41,
+	0x58, 0xaa, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x2e, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c,
+	0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x57, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x57,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3134,26 +6995,34 @@ func file_warden_act_v1beta1_events_proto_rawDescGZIP() []byte {
 	return file_warden_act_v1beta1_events_proto_rawDescData
 }
 
-var file_warden_act_v1beta1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_warden_act_v1beta1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_warden_act_v1beta1_events_proto_goTypes = []interface{}{
-	(*EventCreateTemplate)(nil),    // 0: warden.act.v1beta1.EventCreateTemplate
-	(*EventUpdateTemplate)(nil),    // 1: warden.act.v1beta1.EventUpdateTemplate
-	(*EventCreateAction)(nil),      // 2: warden.act.v1beta1.EventCreateAction
-	(*EventActionVoted)(nil),       // 3: warden.act.v1beta1.EventActionVoted
-	(*EventActionStateChange)(nil), // 4: warden.act.v1beta1.EventActionStateChange
-	(*EventActionPruned)(nil),      // 5: warden.act.v1beta1.EventActionPruned
-	(ActionVoteType)(0),            // 6: warden.act.v1beta1.ActionVoteType
-	(ActionStatus)(0),              // 7: warden.act.v1beta1.ActionStatus
+	(*EventCreateTemplate)(nil),            // 0: warden.act.v1beta1.EventCreateTemplate
+	(*EventUpdateTemplate)(nil),            // 1: warden.act.v1beta1.EventUpdateTemplate
+	(*EventTransferTemplateOwnership)(nil), // 2: warden.act.v1beta1.EventTransferTemplateOwnership
+	(*EventSetContractTemplates)(nil),      // 3: warden.act.v1beta1.EventSetContractTemplates
+	(*EventCreateAction)(nil),              // 4: warden.act.v1beta1.EventCreateAction
+	(*EventActionVoted)(nil),               // 5: warden.act.v1beta1.EventActionVoted
+	(*EventActionStateChange)(nil),         // 6: warden.act.v1beta1.EventActionStateChange
+	(*EventActionPruned)(nil),              // 7: warden.act.v1beta1.EventActionPruned
+	(*EventActionDependencyChanged)(nil),   // 8: warden.act.v1beta1.EventActionDependencyChanged
+	(*EventActionQueued)(nil),              // 9: warden.act.v1beta1.EventActionQueued
+	(*EventVoteDelegated)(nil),             // 10: warden.act.v1beta1.EventVoteDelegated
+	(*EventVoteDelegationRevoked)(nil),     // 11: warden.act.v1beta1.EventVoteDelegationRevoked
+	(ActionVoteType)(0),                    // 12: warden.act.v1beta1.ActionVoteType
+	(ActionStatus)(0),                      // 13: warden.act.v1beta1.ActionStatus
+	(*timestamppb.Timestamp)(nil),          // 14: google.protobuf.Timestamp
 }
 var file_warden_act_v1beta1_events_proto_depIdxs = []int32{
-	6, // 0: warden.act.v1beta1.EventActionVoted.vote_type:type_name -> warden.act.v1beta1.ActionVoteType
-	7, // 1: warden.act.v1beta1.EventActionStateChange.previous_status:type_name -> warden.act.v1beta1.ActionStatus
-	7, // 2: warden.act.v1beta1.EventActionStateChange.new_status:type_name -> warden.act.v1beta1.ActionStatus
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	12, // 0: warden.act.v1beta1.EventActionVoted.vote_type:type_name -> warden.act.v1beta1.ActionVoteType
+	13, // 1: warden.act.v1beta1.EventActionStateChange.previous_status:type_name -> warden.act.v1beta1.ActionStatus
+	13, // 2: warden.act.v1beta1.EventActionStateChange.new_status:type_name -> warden.act.v1beta1.ActionStatus
+	14, // 3: warden.act.v1beta1.EventActionQueued.execute_after:type_name -> google.protobuf.Timestamp
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_warden_act_v1beta1_events_proto_init() }
@@ -3189,7 +7058,7 @@ func file_warden_act_v1beta1_events_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventCreateAction); i {
+			switch v := v.(*EventTransferTemplateOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3201,7 +7070,7 @@ func file_warden_act_v1beta1_events_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventActionVoted); i {
+			switch v := v.(*EventSetContractTemplates); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3213,7 +7082,7 @@ func file_warden_act_v1beta1_events_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventActionStateChange); i {
+			switch v := v.(*EventCreateAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3225,6 +7094,30 @@ func file_warden_act_v1beta1_events_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventActionVoted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventActionStateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventActionPruned); i {
 			case 0:
 				return &v.state
@@ -3236,6 +7129,54 @@ func file_warden_act_v1beta1_events_proto_init() {
 				return nil
 			}
 		}
+		file_warden_act_v1beta1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventActionDependencyChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventActionQueued); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventVoteDelegated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventVoteDelegationRevoked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3243,7 +7184,7 @@ func file_warden_act_v1beta1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_warden_act_v1beta1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,6 +12,7 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -809,14 +810,24 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryActionsRequest            protoreflect.MessageDescriptor
-	fd_QueryActionsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryActionsRequest                protoreflect.MessageDescriptor
+	fd_QueryActionsRequest_pagination     protoreflect.FieldDescriptor
+	fd_QueryActionsRequest_status         protoreflect.FieldDescriptor
+	fd_QueryActionsRequest_creator        protoreflect.FieldDescriptor
+	fd_QueryActionsRequest_msg_type_url   protoreflect.FieldDescriptor
+	fd_QueryActionsRequest_updated_after  protoreflect.FieldDescriptor
+	fd_QueryActionsRequest_updated_before protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_query_proto_init()
 	md_QueryActionsRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionsRequest")
 	fd_QueryActionsRequest_pagination = md_QueryActionsRequest.Fields().ByName("pagination")
+	fd_QueryActionsRequest_status = md_QueryActionsRequest.Fields().ByName("status")
+	fd_QueryActionsRequest_creator = md_QueryActionsRequest.Fields().ByName("creator")
+	fd_QueryActionsRequest_msg_type_url = md_QueryActionsRequest.Fields().ByName("msg_type_url")
+	fd_QueryActionsRequest_updated_after = md_QueryActionsRequest.Fields().ByName("updated_after")
+	fd_QueryActionsRequest_updated_before = md_QueryActionsRequest.Fields().ByName("updated_before")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryActionsRequest)(nil)
@@ -890,6 +901,36 @@ func (x *fastReflection_QueryActionsRequest) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_QueryActionsRequest_status, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_QueryActionsRequest_creator, value) {
+			return
+		}
+	}
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_QueryActionsRequest_msg_type_url, value) {
+			return
+		}
+	}
+	if x.UpdatedAfter != nil {
+		value := protoreflect.ValueOfMessage(x.UpdatedAfter.ProtoReflect())
+		if !f(fd_QueryActionsRequest_updated_after, value) {
+			return
+		}
+	}
+	if x.UpdatedBefore != nil {
+		value := protoreflect.ValueOfMessage(x.UpdatedBefore.ProtoReflect())
+		if !f(fd_QueryActionsRequest_updated_before, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -907,6 +948,16 @@ func (x *fastReflection_QueryActionsRequest) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryActionsRequest.pagination":
 		return x.Pagination != nil
+	case "warden.act.v1beta1.QueryActionsRequest.status":
+		return x.Status != 0
+	case "warden.act.v1beta1.QueryActionsRequest.creator":
+		return x.Creator != ""
+	case "warden.act.v1beta1.QueryActionsRequest.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "warden.act.v1beta1.QueryActionsRequest.updated_after":
+		return x.UpdatedAfter != nil
+	case "warden.act.v1beta1.QueryActionsRequest.updated_before":
+		return x.UpdatedBefore != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsRequest"))
@@ -925,6 +976,16 @@ func (x *fastReflection_QueryActionsRequest) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryActionsRequest.pagination":
 		x.Pagination = nil
+	case "warden.act.v1beta1.QueryActionsRequest.status":
+		x.Status = 0
+	case "warden.act.v1beta1.QueryActionsRequest.creator":
+		x.Creator = ""
+	case "warden.act.v1beta1.QueryActionsRequest.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "warden.act.v1beta1.QueryActionsRequest.updated_after":
+		x.UpdatedAfter = nil
+	case "warden.act.v1beta1.QueryActionsRequest.updated_before":
+		x.UpdatedBefore = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsRequest"))
@@ -944,6 +1005,21 @@ func (x *fastReflection_QueryActionsRequest) Get(descriptor protoreflect.FieldDe
 	case "warden.act.v1beta1.QueryActionsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "warden.act.v1beta1.QueryActionsRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.QueryActionsRequest.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.QueryActionsRequest.updated_after":
+		value := x.UpdatedAfter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsRequest.updated_before":
+		value := x.UpdatedBefore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsRequest"))
@@ -966,6 +1042,16 @@ func (x *fastReflection_QueryActionsRequest) Set(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryActionsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "warden.act.v1beta1.QueryActionsRequest.status":
+		x.Status = (ActionStatus)(value.Enum())
+	case "warden.act.v1beta1.QueryActionsRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "warden.act.v1beta1.QueryActionsRequest.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "warden.act.v1beta1.QueryActionsRequest.updated_after":
+		x.UpdatedAfter = value.Message().Interface().(*timestamppb.Timestamp)
+	case "warden.act.v1beta1.QueryActionsRequest.updated_before":
+		x.UpdatedBefore = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsRequest"))
@@ -991,6 +1077,22 @@ func (x *fastReflection_QueryActionsRequest) Mutable(fd protoreflect.FieldDescri
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsRequest.updated_after":
+		if x.UpdatedAfter == nil {
+			x.UpdatedAfter = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UpdatedAfter.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsRequest.updated_before":
+		if x.UpdatedBefore == nil {
+			x.UpdatedBefore = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.UpdatedBefore.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsRequest.status":
+		panic(fmt.Errorf("field status of message warden.act.v1beta1.QueryActionsRequest is not mutable"))
+	case "warden.act.v1beta1.QueryActionsRequest.creator":
+		panic(fmt.Errorf("field creator of message warden.act.v1beta1.QueryActionsRequest is not mutable"))
+	case "warden.act.v1beta1.QueryActionsRequest.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message warden.act.v1beta1.QueryActionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsRequest"))
@@ -1007,6 +1109,18 @@ func (x *fastReflection_QueryActionsRequest) NewField(fd protoreflect.FieldDescr
 	case "warden.act.v1beta1.QueryActionsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	case "warden.act.v1beta1.QueryActionsRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.QueryActionsRequest.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.QueryActionsRequest.updated_after":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsRequest.updated_before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsRequest"))
@@ -1080,6 +1194,25 @@ func (x *fastReflection_QueryActionsRequest) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdatedAfter != nil {
+			l = options.Size(x.UpdatedAfter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdatedBefore != nil {
+			l = options.Size(x.UpdatedBefore)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1109,6 +1242,53 @@ func (x *fastReflection_QueryActionsRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.UpdatedBefore != nil {
+			encoded, err := options.Marshal(x.UpdatedBefore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.UpdatedAfter != nil {
+			encoded, err := options.Marshal(x.UpdatedAfter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -1208,6 +1388,161 @@ func (x *fastReflection_QueryActionsRequest) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ActionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedAfter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UpdatedAfter == nil {
+					x.UpdatedAfter = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdatedAfter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedBefore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.UpdatedBefore == nil {
+					x.UpdatedBefore = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpdatedBefore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3808,14 +4143,16 @@ func (x *fastReflection_QuerySimulateTemplateResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryTemplateByIdRequest    protoreflect.MessageDescriptor
-	fd_QueryTemplateByIdRequest_id protoreflect.FieldDescriptor
+	md_QueryTemplateByIdRequest         protoreflect.MessageDescriptor
+	fd_QueryTemplateByIdRequest_id      protoreflect.FieldDescriptor
+	fd_QueryTemplateByIdRequest_version protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_query_proto_init()
 	md_QueryTemplateByIdRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryTemplateByIdRequest")
 	fd_QueryTemplateByIdRequest_id = md_QueryTemplateByIdRequest.Fields().ByName("id")
+	fd_QueryTemplateByIdRequest_version = md_QueryTemplateByIdRequest.Fields().ByName("version")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryTemplateByIdRequest)(nil)
@@ -3889,6 +4226,12 @@ func (x *fastReflection_QueryTemplateByIdRequest) Range(f func(protoreflect.Fiel
 			return
 		}
 	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_QueryTemplateByIdRequest_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3906,6 +4249,8 @@ func (x *fastReflection_QueryTemplateByIdRequest) Has(fd protoreflect.FieldDescr
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryTemplateByIdRequest.id":
 		return x.Id != uint64(0)
+	case "warden.act.v1beta1.QueryTemplateByIdRequest.version":
+		return x.Version != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateByIdRequest"))
@@ -3924,6 +4269,8 @@ func (x *fastReflection_QueryTemplateByIdRequest) Clear(fd protoreflect.FieldDes
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryTemplateByIdRequest.id":
 		x.Id = uint64(0)
+	case "warden.act.v1beta1.QueryTemplateByIdRequest.version":
+		x.Version = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateByIdRequest"))
@@ -3943,6 +4290,9 @@ func (x *fastReflection_QueryTemplateByIdRequest) Get(descriptor protoreflect.Fi
 	case "warden.act.v1beta1.QueryTemplateByIdRequest.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.QueryTemplateByIdRequest.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateByIdRequest"))
@@ -3965,6 +4315,8 @@ func (x *fastReflection_QueryTemplateByIdRequest) Set(fd protoreflect.FieldDescr
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryTemplateByIdRequest.id":
 		x.Id = value.Uint()
+	case "warden.act.v1beta1.QueryTemplateByIdRequest.version":
+		x.Version = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateByIdRequest"))
@@ -3987,6 +4339,8 @@ func (x *fastReflection_QueryTemplateByIdRequest) Mutable(fd protoreflect.FieldD
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryTemplateByIdRequest.id":
 		panic(fmt.Errorf("field id of message warden.act.v1beta1.QueryTemplateByIdRequest is not mutable"))
+	case "warden.act.v1beta1.QueryTemplateByIdRequest.version":
+		panic(fmt.Errorf("field version of message warden.act.v1beta1.QueryTemplateByIdRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateByIdRequest"))
@@ -4002,6 +4356,8 @@ func (x *fastReflection_QueryTemplateByIdRequest) NewField(fd protoreflect.Field
 	switch fd.FullName() {
 	case "warden.act.v1beta1.QueryTemplateByIdRequest.id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.QueryTemplateByIdRequest.version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateByIdRequest"))
@@ -4074,6 +4430,9 @@ func (x *fastReflection_QueryTemplateByIdRequest) ProtoMethods() *protoiface.Met
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4103,6 +4462,11 @@ func (x *fastReflection_QueryTemplateByIdRequest) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
@@ -4176,6 +4540,25 @@ func (x *fastReflection_QueryTemplateByIdRequest) ProtoMethods() *protoiface.Met
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4647,29 +5030,27 @@ func (x *fastReflection_QueryTemplateByIdResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryActionsByAddressRequest            protoreflect.MessageDescriptor
-	fd_QueryActionsByAddressRequest_pagination protoreflect.FieldDescriptor
-	fd_QueryActionsByAddressRequest_address    protoreflect.FieldDescriptor
-	fd_QueryActionsByAddressRequest_status     protoreflect.FieldDescriptor
+	md_QueryTemplateVersionsRequest            protoreflect.MessageDescriptor
+	fd_QueryTemplateVersionsRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryTemplateVersionsRequest_id         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_query_proto_init()
-	md_QueryActionsByAddressRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionsByAddressRequest")
-	fd_QueryActionsByAddressRequest_pagination = md_QueryActionsByAddressRequest.Fields().ByName("pagination")
-	fd_QueryActionsByAddressRequest_address = md_QueryActionsByAddressRequest.Fields().ByName("address")
-	fd_QueryActionsByAddressRequest_status = md_QueryActionsByAddressRequest.Fields().ByName("status")
+	md_QueryTemplateVersionsRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryTemplateVersionsRequest")
+	fd_QueryTemplateVersionsRequest_pagination = md_QueryTemplateVersionsRequest.Fields().ByName("pagination")
+	fd_QueryTemplateVersionsRequest_id = md_QueryTemplateVersionsRequest.Fields().ByName("id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActionsByAddressRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTemplateVersionsRequest)(nil)
 
-type fastReflection_QueryActionsByAddressRequest QueryActionsByAddressRequest
+type fastReflection_QueryTemplateVersionsRequest QueryTemplateVersionsRequest
 
-func (x *QueryActionsByAddressRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActionsByAddressRequest)(x)
+func (x *QueryTemplateVersionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTemplateVersionsRequest)(x)
 }
 
-func (x *QueryActionsByAddressRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTemplateVersionsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4681,43 +5062,43 @@ func (x *QueryActionsByAddressRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActionsByAddressRequest_messageType fastReflection_QueryActionsByAddressRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActionsByAddressRequest_messageType{}
+var _fastReflection_QueryTemplateVersionsRequest_messageType fastReflection_QueryTemplateVersionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTemplateVersionsRequest_messageType{}
 
-type fastReflection_QueryActionsByAddressRequest_messageType struct{}
+type fastReflection_QueryTemplateVersionsRequest_messageType struct{}
 
-func (x fastReflection_QueryActionsByAddressRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActionsByAddressRequest)(nil)
+func (x fastReflection_QueryTemplateVersionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTemplateVersionsRequest)(nil)
 }
-func (x fastReflection_QueryActionsByAddressRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActionsByAddressRequest)
+func (x fastReflection_QueryTemplateVersionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTemplateVersionsRequest)
 }
-func (x fastReflection_QueryActionsByAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionsByAddressRequest
+func (x fastReflection_QueryTemplateVersionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTemplateVersionsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActionsByAddressRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionsByAddressRequest
+func (x *fastReflection_QueryTemplateVersionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTemplateVersionsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActionsByAddressRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActionsByAddressRequest_messageType
+func (x *fastReflection_QueryTemplateVersionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTemplateVersionsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActionsByAddressRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryActionsByAddressRequest)
+func (x *fastReflection_QueryTemplateVersionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTemplateVersionsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActionsByAddressRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryActionsByAddressRequest)(x)
+func (x *fastReflection_QueryTemplateVersionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTemplateVersionsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4725,22 +5106,16 @@ func (x *fastReflection_QueryActionsByAddressRequest) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActionsByAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryTemplateVersionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryActionsByAddressRequest_pagination, value) {
-			return
-		}
-	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryActionsByAddressRequest_address, value) {
+		if !f(fd_QueryTemplateVersionsRequest_pagination, value) {
 			return
 		}
 	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_QueryActionsByAddressRequest_status, value) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryTemplateVersionsRequest_id, value) {
 			return
 		}
 	}
@@ -4757,19 +5132,17 @@ func (x *fastReflection_QueryActionsByAddressRequest) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActionsByAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTemplateVersionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.pagination":
 		return x.Pagination != nil
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
-		return x.Address != ""
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
-		return x.Status != 0
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.id":
+		return x.Id != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4779,19 +5152,17 @@ func (x *fastReflection_QueryActionsByAddressRequest) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTemplateVersionsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.pagination":
 		x.Pagination = nil
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
-		x.Address = ""
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
-		x.Status = 0
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.id":
+		x.Id = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4801,22 +5172,19 @@ func (x *fastReflection_QueryActionsByAddressRequest) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActionsByAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTemplateVersionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4830,19 +5198,17 @@ func (x *fastReflection_QueryActionsByAddressRequest) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTemplateVersionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
-		x.Address = value.Interface().(string)
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
-		x.Status = (ActionStatus)(value.Enum())
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.id":
+		x.Id = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4856,52 +5222,48 @@ func (x *fastReflection_QueryActionsByAddressRequest) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTemplateVersionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
-		panic(fmt.Errorf("field address of message warden.act.v1beta1.QueryActionsByAddressRequest is not mutable"))
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
-		panic(fmt.Errorf("field status of message warden.act.v1beta1.QueryActionsByAddressRequest is not mutable"))
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.QueryTemplateVersionsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActionsByAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTemplateVersionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
-		return protoreflect.ValueOfString("")
-	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
-		return protoreflect.ValueOfEnum(0)
+	case "warden.act.v1beta1.QueryTemplateVersionsRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActionsByAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTemplateVersionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionsByAddressRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryTemplateVersionsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4909,7 +5271,7 @@ func (x *fastReflection_QueryActionsByAddressRequest) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActionsByAddressRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTemplateVersionsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4920,7 +5282,7 @@ func (x *fastReflection_QueryActionsByAddressRequest) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTemplateVersionsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4932,7 +5294,7 @@ func (x *fastReflection_QueryActionsByAddressRequest) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActionsByAddressRequest) IsValid() bool {
+func (x *fastReflection_QueryTemplateVersionsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4942,9 +5304,9 @@ func (x *fastReflection_QueryActionsByAddressRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTemplateVersionsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActionsByAddressRequest)
+		x := input.Message.Interface().(*QueryTemplateVersionsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4960,12 +5322,8 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4977,7 +5335,7 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionsByAddressRequest)
+		x := input.Message.Interface().(*QueryTemplateVersionsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4996,17 +5354,10 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
@@ -5033,7 +5384,7 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionsByAddressRequest)
+		x := input.Message.Interface().(*QueryTemplateVersionsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5065,10 +5416,10 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTemplateVersionsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTemplateVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5108,42 +5459,10 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.Status = 0
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5153,7 +5472,7 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Status |= ActionStatus(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -5193,79 +5512,30 @@ func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_QueryActionsByAddressResponse_2_list)(nil)
-
-type _QueryActionsByAddressResponse_2_list struct {
-	list *[]*Action
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Action)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Action)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(Action)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) NewElement() protoreflect.Value {
-	v := new(Action)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryActionsByAddressResponse_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryActionsByAddressResponse            protoreflect.MessageDescriptor
-	fd_QueryActionsByAddressResponse_pagination protoreflect.FieldDescriptor
-	fd_QueryActionsByAddressResponse_actions    protoreflect.FieldDescriptor
+	md_QueryVoteDelegationsRequest            protoreflect.MessageDescriptor
+	fd_QueryVoteDelegationsRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryVoteDelegationsRequest_delegator  protoreflect.FieldDescriptor
+	fd_QueryVoteDelegationsRequest_delegate   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_query_proto_init()
-	md_QueryActionsByAddressResponse = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionsByAddressResponse")
-	fd_QueryActionsByAddressResponse_pagination = md_QueryActionsByAddressResponse.Fields().ByName("pagination")
-	fd_QueryActionsByAddressResponse_actions = md_QueryActionsByAddressResponse.Fields().ByName("actions")
+	md_QueryVoteDelegationsRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryVoteDelegationsRequest")
+	fd_QueryVoteDelegationsRequest_pagination = md_QueryVoteDelegationsRequest.Fields().ByName("pagination")
+	fd_QueryVoteDelegationsRequest_delegator = md_QueryVoteDelegationsRequest.Fields().ByName("delegator")
+	fd_QueryVoteDelegationsRequest_delegate = md_QueryVoteDelegationsRequest.Fields().ByName("delegate")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActionsByAddressResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteDelegationsRequest)(nil)
 
-type fastReflection_QueryActionsByAddressResponse QueryActionsByAddressResponse
+type fastReflection_QueryVoteDelegationsRequest QueryVoteDelegationsRequest
 
-func (x *QueryActionsByAddressResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActionsByAddressResponse)(x)
+func (x *QueryVoteDelegationsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteDelegationsRequest)(x)
 }
 
-func (x *QueryActionsByAddressResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteDelegationsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5277,43 +5547,43 @@ func (x *QueryActionsByAddressResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActionsByAddressResponse_messageType fastReflection_QueryActionsByAddressResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActionsByAddressResponse_messageType{}
+var _fastReflection_QueryVoteDelegationsRequest_messageType fastReflection_QueryVoteDelegationsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteDelegationsRequest_messageType{}
 
-type fastReflection_QueryActionsByAddressResponse_messageType struct{}
+type fastReflection_QueryVoteDelegationsRequest_messageType struct{}
 
-func (x fastReflection_QueryActionsByAddressResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActionsByAddressResponse)(nil)
+func (x fastReflection_QueryVoteDelegationsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteDelegationsRequest)(nil)
 }
-func (x fastReflection_QueryActionsByAddressResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActionsByAddressResponse)
+func (x fastReflection_QueryVoteDelegationsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteDelegationsRequest)
 }
-func (x fastReflection_QueryActionsByAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionsByAddressResponse
+func (x fastReflection_QueryVoteDelegationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteDelegationsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActionsByAddressResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionsByAddressResponse
+func (x *fastReflection_QueryVoteDelegationsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteDelegationsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActionsByAddressResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActionsByAddressResponse_messageType
+func (x *fastReflection_QueryVoteDelegationsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteDelegationsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActionsByAddressResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryActionsByAddressResponse)
+func (x *fastReflection_QueryVoteDelegationsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteDelegationsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActionsByAddressResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryActionsByAddressResponse)(x)
+func (x *fastReflection_QueryVoteDelegationsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteDelegationsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5321,16 +5591,22 @@ func (x *fastReflection_QueryActionsByAddressResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActionsByAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryVoteDelegationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryActionsByAddressResponse_pagination, value) {
+		if !f(fd_QueryVoteDelegationsRequest_pagination, value) {
 			return
 		}
 	}
-	if len(x.Actions) != 0 {
-		value := protoreflect.ValueOfList(&_QueryActionsByAddressResponse_2_list{list: &x.Actions})
-		if !f(fd_QueryActionsByAddressResponse_actions, value) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_QueryVoteDelegationsRequest_delegator, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_QueryVoteDelegationsRequest_delegate, value) {
 			return
 		}
 	}
@@ -5347,17 +5623,19 @@ func (x *fastReflection_QueryActionsByAddressResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActionsByAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteDelegationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.pagination":
 		return x.Pagination != nil
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
-		return len(x.Actions) != 0
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegator":
+		return x.Delegator != ""
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegate":
+		return x.Delegate != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5367,17 +5645,19 @@ func (x *fastReflection_QueryActionsByAddressResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteDelegationsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.pagination":
 		x.Pagination = nil
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
-		x.Actions = nil
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegator":
+		x.Delegator = ""
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegate":
+		x.Delegate = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5387,22 +5667,22 @@ func (x *fastReflection_QueryActionsByAddressResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActionsByAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteDelegationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
-		if len(x.Actions) == 0 {
-			return protoreflect.ValueOfList(&_QueryActionsByAddressResponse_2_list{})
-		}
-		listValue := &_QueryActionsByAddressResponse_2_list{list: &x.Actions}
-		return protoreflect.ValueOfList(listValue)
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5416,19 +5696,19 @@ func (x *fastReflection_QueryActionsByAddressResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteDelegationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
-		lv := value.List()
-		clv := lv.(*_QueryActionsByAddressResponse_2_list)
-		x.Actions = *clv.list
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegator":
+		x.Delegator = value.Interface().(string)
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegate":
+		x.Delegate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5442,53 +5722,52 @@ func (x *fastReflection_QueryActionsByAddressResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteDelegationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
-		if x.Actions == nil {
-			x.Actions = []*Action{}
-		}
-		value := &_QueryActionsByAddressResponse_2_list{list: &x.Actions}
-		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegator":
+		panic(fmt.Errorf("field delegator of message warden.act.v1beta1.QueryVoteDelegationsRequest is not mutable"))
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegate":
+		panic(fmt.Errorf("field delegate of message warden.act.v1beta1.QueryVoteDelegationsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActionsByAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteDelegationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
-		list := []*Action{}
-		return protoreflect.ValueOfList(&_QueryActionsByAddressResponse_2_list{list: &list})
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegator":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.QueryVoteDelegationsRequest.delegate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActionsByAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteDelegationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionsByAddressResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryVoteDelegationsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5496,7 +5775,7 @@ func (x *fastReflection_QueryActionsByAddressResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActionsByAddressResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteDelegationsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5507,7 +5786,7 @@ func (x *fastReflection_QueryActionsByAddressResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionsByAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteDelegationsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5519,7 +5798,7 @@ func (x *fastReflection_QueryActionsByAddressResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActionsByAddressResponse) IsValid() bool {
+func (x *fastReflection_QueryVoteDelegationsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5529,9 +5808,9 @@ func (x *fastReflection_QueryActionsByAddressResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteDelegationsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActionsByAddressResponse)
+		x := input.Message.Interface().(*QueryVoteDelegationsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5547,11 +5826,13 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Actions) > 0 {
-			for _, e := range x.Actions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5563,7 +5844,7 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionsByAddressResponse)
+		x := input.Message.Interface().(*QueryVoteDelegationsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5582,21 +5863,19 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Actions) > 0 {
-			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Actions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0x12
 		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
@@ -5623,7 +5902,7 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionsByAddressResponse)
+		x := input.Message.Interface().(*QueryVoteDelegationsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5655,10 +5934,10 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteDelegationsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5691,7 +5970,7 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+					x.Pagination = &v1beta1.PageRequest{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -5699,9 +5978,9 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5711,25 +5990,55 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Actions = append(x.Actions, &Action{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Actions[len(x.Actions)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5766,26 +6075,79 @@ func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoifac
 	}
 }
 
+var _ protoreflect.List = (*_QueryVoteDelegationsResponse_2_list)(nil)
+
+type _QueryVoteDelegationsResponse_2_list struct {
+	list *[]*VoteDelegation
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoteDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoteDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(VoteDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(VoteDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVoteDelegationsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryActionByIdRequest    protoreflect.MessageDescriptor
-	fd_QueryActionByIdRequest_id protoreflect.FieldDescriptor
+	md_QueryVoteDelegationsResponse             protoreflect.MessageDescriptor
+	fd_QueryVoteDelegationsResponse_pagination  protoreflect.FieldDescriptor
+	fd_QueryVoteDelegationsResponse_delegations protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_query_proto_init()
-	md_QueryActionByIdRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionByIdRequest")
-	fd_QueryActionByIdRequest_id = md_QueryActionByIdRequest.Fields().ByName("id")
+	md_QueryVoteDelegationsResponse = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryVoteDelegationsResponse")
+	fd_QueryVoteDelegationsResponse_pagination = md_QueryVoteDelegationsResponse.Fields().ByName("pagination")
+	fd_QueryVoteDelegationsResponse_delegations = md_QueryVoteDelegationsResponse.Fields().ByName("delegations")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActionByIdRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVoteDelegationsResponse)(nil)
 
-type fastReflection_QueryActionByIdRequest QueryActionByIdRequest
+type fastReflection_QueryVoteDelegationsResponse QueryVoteDelegationsResponse
 
-func (x *QueryActionByIdRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActionByIdRequest)(x)
+func (x *QueryVoteDelegationsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteDelegationsResponse)(x)
 }
 
-func (x *QueryActionByIdRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryVoteDelegationsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5797,43 +6159,43 @@ func (x *QueryActionByIdRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActionByIdRequest_messageType fastReflection_QueryActionByIdRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActionByIdRequest_messageType{}
+var _fastReflection_QueryVoteDelegationsResponse_messageType fastReflection_QueryVoteDelegationsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteDelegationsResponse_messageType{}
 
-type fastReflection_QueryActionByIdRequest_messageType struct{}
+type fastReflection_QueryVoteDelegationsResponse_messageType struct{}
 
-func (x fastReflection_QueryActionByIdRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActionByIdRequest)(nil)
+func (x fastReflection_QueryVoteDelegationsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteDelegationsResponse)(nil)
 }
-func (x fastReflection_QueryActionByIdRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActionByIdRequest)
+func (x fastReflection_QueryVoteDelegationsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteDelegationsResponse)
 }
-func (x fastReflection_QueryActionByIdRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionByIdRequest
+func (x fastReflection_QueryVoteDelegationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteDelegationsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActionByIdRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionByIdRequest
+func (x *fastReflection_QueryVoteDelegationsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteDelegationsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActionByIdRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActionByIdRequest_messageType
+func (x *fastReflection_QueryVoteDelegationsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteDelegationsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActionByIdRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryActionByIdRequest)
+func (x *fastReflection_QueryVoteDelegationsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteDelegationsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActionByIdRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryActionByIdRequest)(x)
+func (x *fastReflection_QueryVoteDelegationsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteDelegationsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5841,10 +6203,16 @@ func (x *fastReflection_QueryActionByIdRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActionByIdRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_QueryActionByIdRequest_id, value) {
+func (x *fastReflection_QueryVoteDelegationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVoteDelegationsResponse_pagination, value) {
+			return
+		}
+	}
+	if len(x.Delegations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVoteDelegationsResponse_2_list{list: &x.Delegations})
+		if !f(fd_QueryVoteDelegationsResponse_delegations, value) {
 			return
 		}
 	}
@@ -5861,15 +6229,17 @@ func (x *fastReflection_QueryActionByIdRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActionByIdRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVoteDelegationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdRequest.id":
-		return x.Id != uint64(0)
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.pagination":
+		return x.Pagination != nil
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.delegations":
+		return len(x.Delegations) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5879,15 +6249,17 @@ func (x *fastReflection_QueryActionByIdRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVoteDelegationsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdRequest.id":
-		x.Id = uint64(0)
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.pagination":
+		x.Pagination = nil
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.delegations":
+		x.Delegations = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5897,16 +6269,22 @@ func (x *fastReflection_QueryActionByIdRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActionByIdRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteDelegationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.delegations":
+		if len(x.Delegations) == 0 {
+			return protoreflect.ValueOfList(&_QueryVoteDelegationsResponse_2_list{})
+		}
+		listValue := &_QueryVoteDelegationsResponse_2_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5920,15 +6298,19 @@ func (x *fastReflection_QueryActionByIdRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVoteDelegationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdRequest.id":
-		x.Id = value.Uint()
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.delegations":
+		lv := value.List()
+		clv := lv.(*_QueryVoteDelegationsResponse_2_list)
+		x.Delegations = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5942,40 +6324,53 @@ func (x *fastReflection_QueryActionByIdRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteDelegationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdRequest.id":
-		panic(fmt.Errorf("field id of message warden.act.v1beta1.QueryActionByIdRequest is not mutable"))
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.delegations":
+		if x.Delegations == nil {
+			x.Delegations = []*VoteDelegation{}
+		}
+		value := &_QueryVoteDelegationsResponse_2_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActionByIdRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVoteDelegationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.QueryVoteDelegationsResponse.delegations":
+		list := []*VoteDelegation{}
+		return protoreflect.ValueOfList(&_QueryVoteDelegationsResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryVoteDelegationsResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryVoteDelegationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActionByIdRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVoteDelegationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionByIdRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryVoteDelegationsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5983,7 +6378,7 @@ func (x *fastReflection_QueryActionByIdRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActionByIdRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVoteDelegationsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5994,7 +6389,7 @@ func (x *fastReflection_QueryActionByIdRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVoteDelegationsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6006,7 +6401,7 @@ func (x *fastReflection_QueryActionByIdRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActionByIdRequest) IsValid() bool {
+func (x *fastReflection_QueryVoteDelegationsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6016,9 +6411,9 @@ func (x *fastReflection_QueryActionByIdRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVoteDelegationsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActionByIdRequest)
+		x := input.Message.Interface().(*QueryVoteDelegationsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6030,8 +6425,15 @@ func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Delegations) > 0 {
+			for _, e := range x.Delegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6043,7 +6445,7 @@ func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionByIdRequest)
+		x := input.Message.Interface().(*QueryVoteDelegationsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6062,10 +6464,35 @@ func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.Delegations) > 0 {
+			for iNdEx := len(x.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6078,7 +6505,7 @@ func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionByIdRequest)
+		x := input.Message.Interface().(*QueryVoteDelegationsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6110,17 +6537,17 @@ func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteDelegationsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.Id = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6130,11 +6557,62 @@ func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &VoteDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6171,25 +6649,25 @@ func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryActionByIdResponse        protoreflect.MessageDescriptor
-	fd_QueryActionByIdResponse_action protoreflect.FieldDescriptor
+	md_QueryContractTemplatesRequest          protoreflect.MessageDescriptor
+	fd_QueryContractTemplatesRequest_contract protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_query_proto_init()
-	md_QueryActionByIdResponse = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionByIdResponse")
-	fd_QueryActionByIdResponse_action = md_QueryActionByIdResponse.Fields().ByName("action")
+	md_QueryContractTemplatesRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryContractTemplatesRequest")
+	fd_QueryContractTemplatesRequest_contract = md_QueryContractTemplatesRequest.Fields().ByName("contract")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActionByIdResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryContractTemplatesRequest)(nil)
 
-type fastReflection_QueryActionByIdResponse QueryActionByIdResponse
+type fastReflection_QueryContractTemplatesRequest QueryContractTemplatesRequest
 
-func (x *QueryActionByIdResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActionByIdResponse)(x)
+func (x *QueryContractTemplatesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractTemplatesRequest)(x)
 }
 
-func (x *QueryActionByIdResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryContractTemplatesRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6201,43 +6679,43 @@ func (x *QueryActionByIdResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActionByIdResponse_messageType fastReflection_QueryActionByIdResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActionByIdResponse_messageType{}
+var _fastReflection_QueryContractTemplatesRequest_messageType fastReflection_QueryContractTemplatesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractTemplatesRequest_messageType{}
 
-type fastReflection_QueryActionByIdResponse_messageType struct{}
+type fastReflection_QueryContractTemplatesRequest_messageType struct{}
 
-func (x fastReflection_QueryActionByIdResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActionByIdResponse)(nil)
+func (x fastReflection_QueryContractTemplatesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractTemplatesRequest)(nil)
 }
-func (x fastReflection_QueryActionByIdResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActionByIdResponse)
+func (x fastReflection_QueryContractTemplatesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractTemplatesRequest)
 }
-func (x fastReflection_QueryActionByIdResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionByIdResponse
+func (x fastReflection_QueryContractTemplatesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractTemplatesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActionByIdResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActionByIdResponse
+func (x *fastReflection_QueryContractTemplatesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractTemplatesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActionByIdResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActionByIdResponse_messageType
+func (x *fastReflection_QueryContractTemplatesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractTemplatesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActionByIdResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryActionByIdResponse)
+func (x *fastReflection_QueryContractTemplatesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryContractTemplatesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActionByIdResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryActionByIdResponse)(x)
+func (x *fastReflection_QueryContractTemplatesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractTemplatesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6245,10 +6723,10 @@ func (x *fastReflection_QueryActionByIdResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActionByIdResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Action != nil {
-		value := protoreflect.ValueOfMessage(x.Action.ProtoReflect())
-		if !f(fd_QueryActionByIdResponse_action, value) {
+func (x *fastReflection_QueryContractTemplatesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_QueryContractTemplatesRequest_contract, value) {
 			return
 		}
 	}
@@ -6265,15 +6743,15 @@ func (x *fastReflection_QueryActionByIdResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActionByIdResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryContractTemplatesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdResponse.action":
-		return x.Action != nil
+	case "warden.act.v1beta1.QueryContractTemplatesRequest.contract":
+		return x.Contract != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6283,15 +6761,15 @@ func (x *fastReflection_QueryActionByIdResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryContractTemplatesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdResponse.action":
-		x.Action = nil
+	case "warden.act.v1beta1.QueryContractTemplatesRequest.contract":
+		x.Contract = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6301,16 +6779,16 @@ func (x *fastReflection_QueryActionByIdResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActionByIdResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractTemplatesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdResponse.action":
-		value := x.Action
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.QueryContractTemplatesRequest.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6324,15 +6802,15 @@ func (x *fastReflection_QueryActionByIdResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryContractTemplatesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdResponse.action":
-		x.Action = value.Message().Interface().(*Action)
+	case "warden.act.v1beta1.QueryContractTemplatesRequest.contract":
+		x.Contract = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6346,44 +6824,40 @@ func (x *fastReflection_QueryActionByIdResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractTemplatesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdResponse.action":
-		if x.Action == nil {
-			x.Action = new(Action)
-		}
-		return protoreflect.ValueOfMessage(x.Action.ProtoReflect())
+	case "warden.act.v1beta1.QueryContractTemplatesRequest.contract":
+		panic(fmt.Errorf("field contract of message warden.act.v1beta1.QueryContractTemplatesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActionByIdResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractTemplatesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.QueryActionByIdResponse.action":
-		m := new(Action)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.QueryContractTemplatesRequest.contract":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesRequest"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActionByIdResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryContractTemplatesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionByIdResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryContractTemplatesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6391,7 +6865,7 @@ func (x *fastReflection_QueryActionByIdResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActionByIdResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryContractTemplatesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6402,7 +6876,7 @@ func (x *fastReflection_QueryActionByIdResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActionByIdResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryContractTemplatesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6414,7 +6888,7 @@ func (x *fastReflection_QueryActionByIdResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActionByIdResponse) IsValid() bool {
+func (x *fastReflection_QueryContractTemplatesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6424,9 +6898,9 @@ func (x *fastReflection_QueryActionByIdResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryContractTemplatesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActionByIdResponse)
+		x := input.Message.Interface().(*QueryContractTemplatesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6438,8 +6912,8 @@ func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Action != nil {
-			l = options.Size(x.Action)
+		l = len(x.Contract)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6452,7 +6926,7 @@ func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionByIdResponse)
+		x := input.Message.Interface().(*QueryContractTemplatesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6471,17 +6945,10 @@ func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Action != nil {
-			encoded, err := options.Marshal(x.Action)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6496,7 +6963,7 @@ func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActionByIdResponse)
+		x := input.Message.Interface().(*QueryContractTemplatesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6528,17 +6995,17 @@ func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractTemplatesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6548,27 +7015,23 @@ func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Action == nil {
-					x.Action = &Action{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Action); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6605,391 +7068,3663 @@ func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: warden/act/v1beta1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryContractTemplatesResponse                    protoreflect.MessageDescriptor
+	fd_QueryContractTemplatesResponse_contract_templates protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_warden_act_v1beta1_query_proto_init()
+	md_QueryContractTemplatesResponse = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryContractTemplatesResponse")
+	fd_QueryContractTemplatesResponse_contract_templates = md_QueryContractTemplatesResponse.Fields().ByName("contract_templates")
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_QueryContractTemplatesResponse)(nil)
+
+type fastReflection_QueryContractTemplatesResponse QueryContractTemplatesResponse
+
+func (x *QueryContractTemplatesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractTemplatesResponse)(x)
+}
+
+func (x *QueryContractTemplatesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_QueryContractTemplatesResponse_messageType fastReflection_QueryContractTemplatesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractTemplatesResponse_messageType{}
 
-func (*QueryParamsRequest) ProtoMessage() {}
+type fastReflection_QueryContractTemplatesResponse_messageType struct{}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_QueryContractTemplatesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractTemplatesResponse)(nil)
+}
+func (x fastReflection_QueryContractTemplatesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractTemplatesResponse)
+}
+func (x fastReflection_QueryContractTemplatesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractTemplatesResponse
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryContractTemplatesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractTemplatesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryContractTemplatesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractTemplatesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryContractTemplatesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryContractTemplatesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryContractTemplatesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractTemplatesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryContractTemplatesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractTemplates != nil {
+		value := protoreflect.ValueOfMessage(x.ContractTemplates.ProtoReflect())
+		if !f(fd_QueryContractTemplatesResponse_contract_templates, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryContractTemplatesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryContractTemplatesResponse.contract_templates":
+		return x.ContractTemplates != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractTemplatesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryContractTemplatesResponse.contract_templates":
+		x.ContractTemplates = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryContractTemplatesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.QueryContractTemplatesResponse.contract_templates":
+		value := x.ContractTemplates
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractTemplatesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryContractTemplatesResponse.contract_templates":
+		x.ContractTemplates = value.Message().Interface().(*ContractTemplates)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractTemplatesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryContractTemplatesResponse.contract_templates":
+		if x.ContractTemplates == nil {
+			x.ContractTemplates = new(ContractTemplates)
+		}
+		return protoreflect.ValueOfMessage(x.ContractTemplates.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryContractTemplatesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryContractTemplatesResponse.contract_templates":
+		m := new(ContractTemplates)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryContractTemplatesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryContractTemplatesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryContractTemplatesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractTemplatesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryContractTemplatesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryContractTemplatesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryContractTemplatesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractTemplates != nil {
+			l = options.Size(x.ContractTemplates)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractTemplatesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ContractTemplates != nil {
+			encoded, err := options.Marshal(x.ContractTemplates)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractTemplatesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractTemplatesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractTemplates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ContractTemplates == nil {
+					x.ContractTemplates = &ContractTemplates{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractTemplates); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTemplateVersionsResponse_2_list)(nil)
+
+type _QueryTemplateVersionsResponse_2_list struct {
+	list *[]*Template
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Template)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Template)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(Template)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(Template)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTemplateVersionsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTemplateVersionsResponse            protoreflect.MessageDescriptor
+	fd_QueryTemplateVersionsResponse_pagination protoreflect.FieldDescriptor
+	fd_QueryTemplateVersionsResponse_templates  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_query_proto_init()
+	md_QueryTemplateVersionsResponse = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryTemplateVersionsResponse")
+	fd_QueryTemplateVersionsResponse_pagination = md_QueryTemplateVersionsResponse.Fields().ByName("pagination")
+	fd_QueryTemplateVersionsResponse_templates = md_QueryTemplateVersionsResponse.Fields().ByName("templates")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTemplateVersionsResponse)(nil)
+
+type fastReflection_QueryTemplateVersionsResponse QueryTemplateVersionsResponse
+
+func (x *QueryTemplateVersionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTemplateVersionsResponse)(x)
+}
+
+func (x *QueryTemplateVersionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTemplateVersionsResponse_messageType fastReflection_QueryTemplateVersionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTemplateVersionsResponse_messageType{}
+
+type fastReflection_QueryTemplateVersionsResponse_messageType struct{}
+
+func (x fastReflection_QueryTemplateVersionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTemplateVersionsResponse)(nil)
+}
+func (x fastReflection_QueryTemplateVersionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTemplateVersionsResponse)
+}
+func (x fastReflection_QueryTemplateVersionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTemplateVersionsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTemplateVersionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTemplateVersionsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTemplateVersionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTemplateVersionsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTemplateVersionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTemplateVersionsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTemplateVersionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTemplateVersionsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTemplateVersionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTemplateVersionsResponse_pagination, value) {
+			return
+		}
+	}
+	if len(x.Templates) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTemplateVersionsResponse_2_list{list: &x.Templates})
+		if !f(fd_QueryTemplateVersionsResponse_templates, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTemplateVersionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.pagination":
+		return x.Pagination != nil
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.templates":
+		return len(x.Templates) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTemplateVersionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.pagination":
+		x.Pagination = nil
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.templates":
+		x.Templates = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTemplateVersionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.templates":
+		if len(x.Templates) == 0 {
+			return protoreflect.ValueOfList(&_QueryTemplateVersionsResponse_2_list{})
+		}
+		listValue := &_QueryTemplateVersionsResponse_2_list{list: &x.Templates}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTemplateVersionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.templates":
+		lv := value.List()
+		clv := lv.(*_QueryTemplateVersionsResponse_2_list)
+		x.Templates = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTemplateVersionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.templates":
+		if x.Templates == nil {
+			x.Templates = []*Template{}
+		}
+		value := &_QueryTemplateVersionsResponse_2_list{list: &x.Templates}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTemplateVersionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.QueryTemplateVersionsResponse.templates":
+		list := []*Template{}
+		return protoreflect.ValueOfList(&_QueryTemplateVersionsResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryTemplateVersionsResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryTemplateVersionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTemplateVersionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryTemplateVersionsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTemplateVersionsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTemplateVersionsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTemplateVersionsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTemplateVersionsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTemplateVersionsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Templates) > 0 {
+			for _, e := range x.Templates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTemplateVersionsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Templates) > 0 {
+			for iNdEx := len(x.Templates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Templates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTemplateVersionsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTemplateVersionsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTemplateVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Templates = append(x.Templates, &Template{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Templates[len(x.Templates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryActionsByAddressRequest            protoreflect.MessageDescriptor
+	fd_QueryActionsByAddressRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryActionsByAddressRequest_address    protoreflect.FieldDescriptor
+	fd_QueryActionsByAddressRequest_status     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_query_proto_init()
+	md_QueryActionsByAddressRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionsByAddressRequest")
+	fd_QueryActionsByAddressRequest_pagination = md_QueryActionsByAddressRequest.Fields().ByName("pagination")
+	fd_QueryActionsByAddressRequest_address = md_QueryActionsByAddressRequest.Fields().ByName("address")
+	fd_QueryActionsByAddressRequest_status = md_QueryActionsByAddressRequest.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActionsByAddressRequest)(nil)
+
+type fastReflection_QueryActionsByAddressRequest QueryActionsByAddressRequest
+
+func (x *QueryActionsByAddressRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActionsByAddressRequest)(x)
+}
+
+func (x *QueryActionsByAddressRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActionsByAddressRequest_messageType fastReflection_QueryActionsByAddressRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActionsByAddressRequest_messageType{}
+
+type fastReflection_QueryActionsByAddressRequest_messageType struct{}
+
+func (x fastReflection_QueryActionsByAddressRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActionsByAddressRequest)(nil)
+}
+func (x fastReflection_QueryActionsByAddressRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActionsByAddressRequest)
+}
+func (x fastReflection_QueryActionsByAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionsByAddressRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActionsByAddressRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionsByAddressRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActionsByAddressRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActionsByAddressRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActionsByAddressRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryActionsByAddressRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActionsByAddressRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryActionsByAddressRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActionsByAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryActionsByAddressRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryActionsByAddressRequest_address, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_QueryActionsByAddressRequest_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActionsByAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+		return x.Pagination != nil
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
+		return x.Address != ""
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+		x.Pagination = nil
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
+		x.Address = ""
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActionsByAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
+		x.Address = value.Interface().(string)
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
+		x.Status = (ActionStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
+		panic(fmt.Errorf("field address of message warden.act.v1beta1.QueryActionsByAddressRequest is not mutable"))
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
+		panic(fmt.Errorf("field status of message warden.act.v1beta1.QueryActionsByAddressRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActionsByAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.address":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.QueryActionsByAddressRequest.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActionsByAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionsByAddressRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActionsByAddressRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActionsByAddressRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActionsByAddressRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActionsByAddressRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionsByAddressRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionsByAddressRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ActionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryActionsByAddressResponse_2_list)(nil)
+
+type _QueryActionsByAddressResponse_2_list struct {
+	list *[]*Action
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Action)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Action)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(Action)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) NewElement() protoreflect.Value {
+	v := new(Action)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryActionsByAddressResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryActionsByAddressResponse            protoreflect.MessageDescriptor
+	fd_QueryActionsByAddressResponse_pagination protoreflect.FieldDescriptor
+	fd_QueryActionsByAddressResponse_actions    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_query_proto_init()
+	md_QueryActionsByAddressResponse = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionsByAddressResponse")
+	fd_QueryActionsByAddressResponse_pagination = md_QueryActionsByAddressResponse.Fields().ByName("pagination")
+	fd_QueryActionsByAddressResponse_actions = md_QueryActionsByAddressResponse.Fields().ByName("actions")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActionsByAddressResponse)(nil)
+
+type fastReflection_QueryActionsByAddressResponse QueryActionsByAddressResponse
+
+func (x *QueryActionsByAddressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActionsByAddressResponse)(x)
+}
+
+func (x *QueryActionsByAddressResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActionsByAddressResponse_messageType fastReflection_QueryActionsByAddressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActionsByAddressResponse_messageType{}
+
+type fastReflection_QueryActionsByAddressResponse_messageType struct{}
+
+func (x fastReflection_QueryActionsByAddressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActionsByAddressResponse)(nil)
+}
+func (x fastReflection_QueryActionsByAddressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActionsByAddressResponse)
+}
+func (x fastReflection_QueryActionsByAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionsByAddressResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActionsByAddressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionsByAddressResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActionsByAddressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActionsByAddressResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActionsByAddressResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryActionsByAddressResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActionsByAddressResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryActionsByAddressResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActionsByAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryActionsByAddressResponse_pagination, value) {
+			return
+		}
+	}
+	if len(x.Actions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryActionsByAddressResponse_2_list{list: &x.Actions})
+		if !f(fd_QueryActionsByAddressResponse_actions, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActionsByAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+		return x.Pagination != nil
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
+		return len(x.Actions) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+		x.Pagination = nil
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
+		x.Actions = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActionsByAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
+		if len(x.Actions) == 0 {
+			return protoreflect.ValueOfList(&_QueryActionsByAddressResponse_2_list{})
+		}
+		listValue := &_QueryActionsByAddressResponse_2_list{list: &x.Actions}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
+		lv := value.List()
+		clv := lv.(*_QueryActionsByAddressResponse_2_list)
+		x.Actions = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
+		if x.Actions == nil {
+			x.Actions = []*Action{}
+		}
+		value := &_QueryActionsByAddressResponse_2_list{list: &x.Actions}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActionsByAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.QueryActionsByAddressResponse.actions":
+		list := []*Action{}
+		return protoreflect.ValueOfList(&_QueryActionsByAddressResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionsByAddressResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionsByAddressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActionsByAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionsByAddressResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActionsByAddressResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionsByAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActionsByAddressResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActionsByAddressResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActionsByAddressResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Actions) > 0 {
+			for _, e := range x.Actions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionsByAddressResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Actions) > 0 {
+			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Actions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionsByAddressResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actions = append(x.Actions, &Action{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Actions[len(x.Actions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryActionByIdRequest    protoreflect.MessageDescriptor
+	fd_QueryActionByIdRequest_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_query_proto_init()
+	md_QueryActionByIdRequest = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionByIdRequest")
+	fd_QueryActionByIdRequest_id = md_QueryActionByIdRequest.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActionByIdRequest)(nil)
+
+type fastReflection_QueryActionByIdRequest QueryActionByIdRequest
+
+func (x *QueryActionByIdRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActionByIdRequest)(x)
+}
+
+func (x *QueryActionByIdRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActionByIdRequest_messageType fastReflection_QueryActionByIdRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActionByIdRequest_messageType{}
+
+type fastReflection_QueryActionByIdRequest_messageType struct{}
+
+func (x fastReflection_QueryActionByIdRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActionByIdRequest)(nil)
+}
+func (x fastReflection_QueryActionByIdRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActionByIdRequest)
+}
+func (x fastReflection_QueryActionByIdRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionByIdRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActionByIdRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionByIdRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActionByIdRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActionByIdRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActionByIdRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryActionByIdRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActionByIdRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryActionByIdRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActionByIdRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryActionByIdRequest_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActionByIdRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdRequest.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdRequest.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActionByIdRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdRequest.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdRequest.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.QueryActionByIdRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActionByIdRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdRequest"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActionByIdRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionByIdRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActionByIdRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActionByIdRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActionByIdRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActionByIdRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionByIdRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionByIdRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryActionByIdResponse        protoreflect.MessageDescriptor
+	fd_QueryActionByIdResponse_action protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_query_proto_init()
+	md_QueryActionByIdResponse = File_warden_act_v1beta1_query_proto.Messages().ByName("QueryActionByIdResponse")
+	fd_QueryActionByIdResponse_action = md_QueryActionByIdResponse.Fields().ByName("action")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActionByIdResponse)(nil)
+
+type fastReflection_QueryActionByIdResponse QueryActionByIdResponse
+
+func (x *QueryActionByIdResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActionByIdResponse)(x)
+}
+
+func (x *QueryActionByIdResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActionByIdResponse_messageType fastReflection_QueryActionByIdResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActionByIdResponse_messageType{}
+
+type fastReflection_QueryActionByIdResponse_messageType struct{}
+
+func (x fastReflection_QueryActionByIdResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActionByIdResponse)(nil)
+}
+func (x fastReflection_QueryActionByIdResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActionByIdResponse)
+}
+func (x fastReflection_QueryActionByIdResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionByIdResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActionByIdResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActionByIdResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActionByIdResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActionByIdResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActionByIdResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryActionByIdResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActionByIdResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryActionByIdResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActionByIdResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Action != nil {
+		value := protoreflect.ValueOfMessage(x.Action.ProtoReflect())
+		if !f(fd_QueryActionByIdResponse_action, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActionByIdResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdResponse.action":
+		return x.Action != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdResponse.action":
+		x.Action = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActionByIdResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdResponse.action":
+		value := x.Action
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdResponse.action":
+		x.Action = value.Message().Interface().(*Action)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdResponse.action":
+		if x.Action == nil {
+			x.Action = new(Action)
+		}
+		return protoreflect.ValueOfMessage(x.Action.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActionByIdResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.QueryActionByIdResponse.action":
+		m := new(Action)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.QueryActionByIdResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.QueryActionByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActionByIdResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.QueryActionByIdResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActionByIdResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActionByIdResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActionByIdResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActionByIdResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActionByIdResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Action != nil {
+			l = options.Size(x.Action)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionByIdResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Action != nil {
+			encoded, err := options.Marshal(x.Action)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActionByIdResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Action == nil {
+					x.Action = &Action{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Action); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: warden/act/v1beta1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type QueryActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status filters the actions by status, if set.
+	Status ActionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=warden.act.v1beta1.ActionStatus" json:"status,omitempty"`
+	// creator filters the actions by creator, if set.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// msg_type_url filters the actions by the type URL of their message, if
+	// set, e.g. "/warden.warden.v1beta3.MsgAddSpaceOwner".
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// updated_after filters the actions updated at or after this time, if set.
+	UpdatedAfter *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	// updated_before filters the actions updated before this time, if set.
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+}
+
+func (x *QueryActionsRequest) Reset() {
+	*x = QueryActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryActionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryActionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryActionsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryActionsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryActionsRequest) GetStatus() ActionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ActionStatus_ACTION_STATUS_UNSPECIFIED
+}
+
+func (x *QueryActionsRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *QueryActionsRequest) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *QueryActionsRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *QueryActionsRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+type QueryActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Actions    []*Action             `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryActionsResponse) Reset() {
+	*x = QueryActionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[1]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryActionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryActionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryActionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryActionsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryActionsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.Params
+		return x.Pagination
 	}
 	return nil
 }
 
-type QueryActionsRequest struct {
+func (x *QueryActionsResponse) GetActions() []*Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type QueryTemplatesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Creator    string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (x *QueryActionsRequest) Reset() {
-	*x = QueryActionsRequest{}
+func (x *QueryTemplatesRequest) Reset() {
+	*x = QueryTemplatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[2]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryActionsRequest) String() string {
+func (x *QueryTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTemplatesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*QueryTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryTemplatesRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryTemplatesRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+type QueryTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Templates  []*Template           `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *QueryTemplatesResponse) Reset() {
+	*x = QueryTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTemplatesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*QueryTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryTemplatesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type QuerySimulateTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Definition string               `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+}
+
+func (x *QuerySimulateTemplateRequest) Reset() {
+	*x = QuerySimulateTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySimulateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySimulateTemplateRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuerySimulateTemplateRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QuerySimulateTemplateRequest) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+type QuerySimulateTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evaluation string `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+}
+
+func (x *QuerySimulateTemplateResponse) Reset() {
+	*x = QuerySimulateTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySimulateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySimulateTemplateResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuerySimulateTemplateResponse) GetEvaluation() string {
+	if x != nil {
+		return x.Evaluation
+	}
+	return ""
+}
+
+type QueryTemplateByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version of the template, the latest one if 0.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *QueryTemplateByIdRequest) Reset() {
+	*x = QueryTemplateByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTemplateByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryActionsRequest) ProtoMessage() {}
+func (*QueryTemplateByIdRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTemplateByIdRequest.ProtoReflect.Descriptor instead.
+func (*QueryTemplateByIdRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{8}
+}
 
-// Deprecated: Use QueryActionsRequest.ProtoReflect.Descriptor instead.
-func (*QueryActionsRequest) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{2}
+func (x *QueryTemplateByIdRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-func (x *QueryActionsRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryTemplateByIdRequest) GetVersion() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-type QueryActionsResponse struct {
+type QueryTemplateByIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Actions    []*Action             `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	Template *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 }
 
-func (x *QueryActionsResponse) Reset() {
-	*x = QueryActionsResponse{}
+func (x *QueryTemplateByIdResponse) Reset() {
+	*x = QueryTemplateByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[3]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryActionsResponse) String() string {
+func (x *QueryTemplateByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryActionsResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryActionsResponse.ProtoReflect.Descriptor instead.
-func (*QueryActionsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{3}
-}
+func (*QueryTemplateByIdResponse) ProtoMessage() {}
 
-func (x *QueryActionsResponse) GetPagination() *v1beta1.PageResponse {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+// Deprecated: Use QueryTemplateByIdResponse.ProtoReflect.Descriptor instead.
+func (*QueryTemplateByIdResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryActionsResponse) GetActions() []*Action {
+func (x *QueryTemplateByIdResponse) GetTemplate() *Template {
 	if x != nil {
-		return x.Actions
+		return x.Template
 	}
 	return nil
 }
 
-type QueryTemplatesRequest struct {
+type QueryTemplateVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Creator    string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id         uint64               `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *QueryTemplatesRequest) Reset() {
-	*x = QueryTemplatesRequest{}
+func (x *QueryTemplateVersionsRequest) Reset() {
+	*x = QueryTemplateVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[4]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTemplatesRequest) String() string {
+func (x *QueryTemplateVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTemplatesRequest) ProtoMessage() {}
+func (*QueryTemplateVersionsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTemplatesRequest.ProtoReflect.Descriptor instead.
-func (*QueryTemplatesRequest) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryTemplateVersionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTemplateVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryTemplatesRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryTemplateVersionsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *QueryTemplatesRequest) GetCreator() string {
+func (x *QueryTemplateVersionsRequest) GetId() uint64 {
 	if x != nil {
-		return x.Creator
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type QueryTemplatesResponse struct {
+type QueryVoteDelegationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Templates  []*Template           `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Delegator  string               `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Delegate   string               `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
 }
 
-func (x *QueryTemplatesResponse) Reset() {
-	*x = QueryTemplatesResponse{}
+func (x *QueryVoteDelegationsRequest) Reset() {
+	*x = QueryVoteDelegationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[5]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTemplatesResponse) String() string {
+func (x *QueryVoteDelegationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTemplatesResponse) ProtoMessage() {}
+func (*QueryVoteDelegationsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTemplatesResponse.ProtoReflect.Descriptor instead.
-func (*QueryTemplatesResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryVoteDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*QueryVoteDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryTemplatesResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryVoteDelegationsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *QueryTemplatesResponse) GetTemplates() []*Template {
+func (x *QueryVoteDelegationsRequest) GetDelegator() string {
 	if x != nil {
-		return x.Templates
+		return x.Delegator
 	}
-	return nil
+	return ""
 }
 
-type QuerySimulateTemplateRequest struct {
+func (x *QueryVoteDelegationsRequest) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+type QueryVoteDelegationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Definition string               `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+	Pagination  *v1beta1.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Delegations []*VoteDelegation     `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
 }
 
-func (x *QuerySimulateTemplateRequest) Reset() {
-	*x = QuerySimulateTemplateRequest{}
+func (x *QueryVoteDelegationsResponse) Reset() {
+	*x = QueryVoteDelegationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[6]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySimulateTemplateRequest) String() string {
+func (x *QueryVoteDelegationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySimulateTemplateRequest) ProtoMessage() {}
+func (*QueryVoteDelegationsResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySimulateTemplateRequest.ProtoReflect.Descriptor instead.
-func (*QuerySimulateTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryVoteDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*QueryVoteDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QuerySimulateTemplateRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryVoteDelegationsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *QuerySimulateTemplateRequest) GetDefinition() string {
+func (x *QueryVoteDelegationsResponse) GetDelegations() []*VoteDelegation {
 	if x != nil {
-		return x.Definition
+		return x.Delegations
 	}
-	return ""
+	return nil
 }
 
-type QuerySimulateTemplateResponse struct {
+type QueryContractTemplatesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Evaluation string `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 }
 
-func (x *QuerySimulateTemplateResponse) Reset() {
-	*x = QuerySimulateTemplateResponse{}
+func (x *QueryContractTemplatesRequest) Reset() {
+	*x = QueryContractTemplatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[7]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySimulateTemplateResponse) String() string {
+func (x *QueryContractTemplatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySimulateTemplateResponse) ProtoMessage() {}
+func (*QueryContractTemplatesRequest) ProtoMessage() {}
 
-// Deprecated: Use QuerySimulateTemplateResponse.ProtoReflect.Descriptor instead.
-func (*QuerySimulateTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryContractTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*QueryContractTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QuerySimulateTemplateResponse) GetEvaluation() string {
+func (x *QueryContractTemplatesRequest) GetContract() string {
 	if x != nil {
-		return x.Evaluation
+		return x.Contract
 	}
 	return ""
 }
 
-type QueryTemplateByIdRequest struct {
+type QueryContractTemplatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContractTemplates *ContractTemplates `protobuf:"bytes,1,opt,name=contract_templates,json=contractTemplates,proto3" json:"contract_templates,omitempty"`
 }
 
-func (x *QueryTemplateByIdRequest) Reset() {
-	*x = QueryTemplateByIdRequest{}
+func (x *QueryContractTemplatesResponse) Reset() {
+	*x = QueryContractTemplatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[8]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTemplateByIdRequest) String() string {
+func (x *QueryContractTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTemplateByIdRequest) ProtoMessage() {}
+func (*QueryContractTemplatesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTemplateByIdRequest.ProtoReflect.Descriptor instead.
-func (*QueryTemplateByIdRequest) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryContractTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*QueryContractTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryTemplateByIdRequest) GetId() uint64 {
+func (x *QueryContractTemplatesResponse) GetContractTemplates() *ContractTemplates {
 	if x != nil {
-		return x.Id
+		return x.ContractTemplates
 	}
-	return 0
+	return nil
 }
 
-type QueryTemplateByIdResponse struct {
+type QueryTemplateVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Template *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Templates  []*Template           `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
 }
 
-func (x *QueryTemplateByIdResponse) Reset() {
-	*x = QueryTemplateByIdResponse{}
+func (x *QueryTemplateVersionsResponse) Reset() {
+	*x = QueryTemplateVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[9]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTemplateByIdResponse) String() string {
+func (x *QueryTemplateVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTemplateByIdResponse) ProtoMessage() {}
+func (*QueryTemplateVersionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTemplateByIdResponse.ProtoReflect.Descriptor instead.
-func (*QueryTemplateByIdResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryTemplateVersionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTemplateVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryTemplateByIdResponse) GetTemplate() *Template {
+func (x *QueryTemplateVersionsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.Template
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryTemplateVersionsResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
 	}
 	return nil
 }
@@ -7007,7 +10742,7 @@ type QueryActionsByAddressRequest struct {
 func (x *QueryActionsByAddressRequest) Reset() {
 	*x = QueryActionsByAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[10]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7021,7 +10756,7 @@ func (*QueryActionsByAddressRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryActionsByAddressRequest.ProtoReflect.Descriptor instead.
 func (*QueryActionsByAddressRequest) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{10}
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryActionsByAddressRequest) GetPagination() *v1beta1.PageRequest {
@@ -7057,7 +10792,7 @@ type QueryActionsByAddressResponse struct {
 func (x *QueryActionsByAddressResponse) Reset() {
 	*x = QueryActionsByAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[11]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7071,7 +10806,7 @@ func (*QueryActionsByAddressResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryActionsByAddressResponse.ProtoReflect.Descriptor instead.
 func (*QueryActionsByAddressResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{11}
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryActionsByAddressResponse) GetPagination() *v1beta1.PageResponse {
@@ -7099,7 +10834,7 @@ type QueryActionByIdRequest struct {
 func (x *QueryActionByIdRequest) Reset() {
 	*x = QueryActionByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[12]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7113,7 +10848,7 @@ func (*QueryActionByIdRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryActionByIdRequest.ProtoReflect.Descriptor instead.
 func (*QueryActionByIdRequest) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{12}
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryActionByIdRequest) GetId() uint64 {
@@ -7134,7 +10869,7 @@ type QueryActionByIdResponse struct {
 func (x *QueryActionByIdResponse) Reset() {
 	*x = QueryActionByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_query_proto_msgTypes[13]
+		mi := &file_warden_act_v1beta1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7148,7 +10883,7 @@ func (*QueryActionByIdResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryActionByIdResponse.ProtoReflect.Descriptor instead.
 func (*QueryActionByIdResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{13}
+	return file_warden_act_v1beta1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryActionByIdResponse) GetAction() *Action {
@@ -7170,190 +10905,298 @@ var file_warden_act_v1beta1_query_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
-	0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a,
-	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
-	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x5d,
-	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63,
+	0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x6f, 0x74,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x13, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x45, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22,
+	0x9b, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7f, 0x0a,
+	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xa3,
+	0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01,
-	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a,
+	0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x1c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x3a, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7f, 0x0a, 0x15, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x4a, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3b, 0x0a, 0x1d, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x7c, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x40, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a,
-	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x40, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x1d, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x18,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
-	0xc0, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a,
-	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x32, 0xbe, 0x08, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x84, 0x01, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
-	0x12, 0x21, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x27, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x90,
-	0x01, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x77,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xde, 0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x84, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77,
 	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0xa4, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
+	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x29, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12,
+	0x24, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f,
+	0x61, 0x63, 0x74, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a,
+	0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x12, 0xb2, 0x01,
+	0x0a, 0x10, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12,
+	0x31, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xa9, 0x01, 0x0a, 0x0f, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
 	0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f,
-	0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x79, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
 	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29,
-	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
-	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x12, 0xae, 0x01, 0x0a, 0x10, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
-	0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x0a, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64,
+	0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2d, 0x12, 0x2b, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xbc,
+	0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x7d, 0x12, 0xae, 0x01,
+	0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12,
+	0x2d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x96,
+	0x01, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64,
 	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61,
-	0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79,
-	0x5f, 0x69, 0x64, 0x42, 0xdb, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2d,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61,
-	0x63, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x57, 0x41, 0x58, 0xaa,
-	0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x2e, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x41, 0x63,
-	0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x57, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x57, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27,
+	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x42, 0xdb, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x61, 0x63, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
+	0x57, 0x41, 0x58, 0xaa, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x74,
+	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e,
+	0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x14, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x74, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7368,63 +11211,88 @@ func file_warden_act_v1beta1_query_proto_rawDescGZIP() []byte {
 	return file_warden_act_v1beta1_query_proto_rawDescData
 }
 
-var file_warden_act_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_warden_act_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_warden_act_v1beta1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),            // 0: warden.act.v1beta1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),           // 1: warden.act.v1beta1.QueryParamsResponse
-	(*QueryActionsRequest)(nil),           // 2: warden.act.v1beta1.QueryActionsRequest
-	(*QueryActionsResponse)(nil),          // 3: warden.act.v1beta1.QueryActionsResponse
-	(*QueryTemplatesRequest)(nil),         // 4: warden.act.v1beta1.QueryTemplatesRequest
-	(*QueryTemplatesResponse)(nil),        // 5: warden.act.v1beta1.QueryTemplatesResponse
-	(*QuerySimulateTemplateRequest)(nil),  // 6: warden.act.v1beta1.QuerySimulateTemplateRequest
-	(*QuerySimulateTemplateResponse)(nil), // 7: warden.act.v1beta1.QuerySimulateTemplateResponse
-	(*QueryTemplateByIdRequest)(nil),      // 8: warden.act.v1beta1.QueryTemplateByIdRequest
-	(*QueryTemplateByIdResponse)(nil),     // 9: warden.act.v1beta1.QueryTemplateByIdResponse
-	(*QueryActionsByAddressRequest)(nil),  // 10: warden.act.v1beta1.QueryActionsByAddressRequest
-	(*QueryActionsByAddressResponse)(nil), // 11: warden.act.v1beta1.QueryActionsByAddressResponse
-	(*QueryActionByIdRequest)(nil),        // 12: warden.act.v1beta1.QueryActionByIdRequest
-	(*QueryActionByIdResponse)(nil),       // 13: warden.act.v1beta1.QueryActionByIdResponse
-	(*Params)(nil),                        // 14: warden.act.v1beta1.Params
-	(*v1beta1.PageRequest)(nil),           // 15: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),          // 16: cosmos.base.query.v1beta1.PageResponse
-	(*Action)(nil),                        // 17: warden.act.v1beta1.Action
-	(*Template)(nil),                      // 18: warden.act.v1beta1.Template
-	(ActionStatus)(0),                     // 19: warden.act.v1beta1.ActionStatus
+	(*QueryParamsRequest)(nil),             // 0: warden.act.v1beta1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 1: warden.act.v1beta1.QueryParamsResponse
+	(*QueryActionsRequest)(nil),            // 2: warden.act.v1beta1.QueryActionsRequest
+	(*QueryActionsResponse)(nil),           // 3: warden.act.v1beta1.QueryActionsResponse
+	(*QueryTemplatesRequest)(nil),          // 4: warden.act.v1beta1.QueryTemplatesRequest
+	(*QueryTemplatesResponse)(nil),         // 5: warden.act.v1beta1.QueryTemplatesResponse
+	(*QuerySimulateTemplateRequest)(nil),   // 6: warden.act.v1beta1.QuerySimulateTemplateRequest
+	(*QuerySimulateTemplateResponse)(nil),  // 7: warden.act.v1beta1.QuerySimulateTemplateResponse
+	(*QueryTemplateByIdRequest)(nil),       // 8: warden.act.v1beta1.QueryTemplateByIdRequest
+	(*QueryTemplateByIdResponse)(nil),      // 9: warden.act.v1beta1.QueryTemplateByIdResponse
+	(*QueryTemplateVersionsRequest)(nil),   // 10: warden.act.v1beta1.QueryTemplateVersionsRequest
+	(*QueryVoteDelegationsRequest)(nil),    // 11: warden.act.v1beta1.QueryVoteDelegationsRequest
+	(*QueryVoteDelegationsResponse)(nil),   // 12: warden.act.v1beta1.QueryVoteDelegationsResponse
+	(*QueryContractTemplatesRequest)(nil),  // 13: warden.act.v1beta1.QueryContractTemplatesRequest
+	(*QueryContractTemplatesResponse)(nil), // 14: warden.act.v1beta1.QueryContractTemplatesResponse
+	(*QueryTemplateVersionsResponse)(nil),  // 15: warden.act.v1beta1.QueryTemplateVersionsResponse
+	(*QueryActionsByAddressRequest)(nil),   // 16: warden.act.v1beta1.QueryActionsByAddressRequest
+	(*QueryActionsByAddressResponse)(nil),  // 17: warden.act.v1beta1.QueryActionsByAddressResponse
+	(*QueryActionByIdRequest)(nil),         // 18: warden.act.v1beta1.QueryActionByIdRequest
+	(*QueryActionByIdResponse)(nil),        // 19: warden.act.v1beta1.QueryActionByIdResponse
+	(*Params)(nil),                         // 20: warden.act.v1beta1.Params
+	(*v1beta1.PageRequest)(nil),            // 21: cosmos.base.query.v1beta1.PageRequest
+	(ActionStatus)(0),                      // 22: warden.act.v1beta1.ActionStatus
+	(*timestamppb.Timestamp)(nil),          // 23: google.protobuf.Timestamp
+	(*v1beta1.PageResponse)(nil),           // 24: cosmos.base.query.v1beta1.PageResponse
+	(*Action)(nil),                         // 25: warden.act.v1beta1.Action
+	(*Template)(nil),                       // 26: warden.act.v1beta1.Template
+	(*VoteDelegation)(nil),                 // 27: warden.act.v1beta1.VoteDelegation
+	(*ContractTemplates)(nil),              // 28: warden.act.v1beta1.ContractTemplates
 }
 var file_warden_act_v1beta1_query_proto_depIdxs = []int32{
-	14, // 0: warden.act.v1beta1.QueryParamsResponse.params:type_name -> warden.act.v1beta1.Params
-	15, // 1: warden.act.v1beta1.QueryActionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	16, // 2: warden.act.v1beta1.QueryActionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	17, // 3: warden.act.v1beta1.QueryActionsResponse.actions:type_name -> warden.act.v1beta1.Action
-	15, // 4: warden.act.v1beta1.QueryTemplatesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	16, // 5: warden.act.v1beta1.QueryTemplatesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	18, // 6: warden.act.v1beta1.QueryTemplatesResponse.templates:type_name -> warden.act.v1beta1.Template
-	15, // 7: warden.act.v1beta1.QuerySimulateTemplateRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 8: warden.act.v1beta1.QueryTemplateByIdResponse.template:type_name -> warden.act.v1beta1.Template
-	15, // 9: warden.act.v1beta1.QueryActionsByAddressRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	19, // 10: warden.act.v1beta1.QueryActionsByAddressRequest.status:type_name -> warden.act.v1beta1.ActionStatus
-	16, // 11: warden.act.v1beta1.QueryActionsByAddressResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	17, // 12: warden.act.v1beta1.QueryActionsByAddressResponse.actions:type_name -> warden.act.v1beta1.Action
-	17, // 13: warden.act.v1beta1.QueryActionByIdResponse.action:type_name -> warden.act.v1beta1.Action
-	0,  // 14: warden.act.v1beta1.Query.Params:input_type -> warden.act.v1beta1.QueryParamsRequest
-	2,  // 15: warden.act.v1beta1.Query.Actions:input_type -> warden.act.v1beta1.QueryActionsRequest
-	4,  // 16: warden.act.v1beta1.Query.Templates:input_type -> warden.act.v1beta1.QueryTemplatesRequest
-	6,  // 17: warden.act.v1beta1.Query.SimulateTemplate:input_type -> warden.act.v1beta1.QuerySimulateTemplateRequest
-	8,  // 18: warden.act.v1beta1.Query.TemplateById:input_type -> warden.act.v1beta1.QueryTemplateByIdRequest
-	10, // 19: warden.act.v1beta1.Query.ActionsByAddress:input_type -> warden.act.v1beta1.QueryActionsByAddressRequest
-	12, // 20: warden.act.v1beta1.Query.ActionById:input_type -> warden.act.v1beta1.QueryActionByIdRequest
-	1,  // 21: warden.act.v1beta1.Query.Params:output_type -> warden.act.v1beta1.QueryParamsResponse
-	3,  // 22: warden.act.v1beta1.Query.Actions:output_type -> warden.act.v1beta1.QueryActionsResponse
-	5,  // 23: warden.act.v1beta1.Query.Templates:output_type -> warden.act.v1beta1.QueryTemplatesResponse
-	7,  // 24: warden.act.v1beta1.Query.SimulateTemplate:output_type -> warden.act.v1beta1.QuerySimulateTemplateResponse
-	9,  // 25: warden.act.v1beta1.Query.TemplateById:output_type -> warden.act.v1beta1.QueryTemplateByIdResponse
-	11, // 26: warden.act.v1beta1.Query.ActionsByAddress:output_type -> warden.act.v1beta1.QueryActionsByAddressResponse
-	13, // 27: warden.act.v1beta1.Query.ActionById:output_type -> warden.act.v1beta1.QueryActionByIdResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	20, // 0: warden.act.v1beta1.QueryParamsResponse.params:type_name -> warden.act.v1beta1.Params
+	21, // 1: warden.act.v1beta1.QueryActionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	22, // 2: warden.act.v1beta1.QueryActionsRequest.status:type_name -> warden.act.v1beta1.ActionStatus
+	23, // 3: warden.act.v1beta1.QueryActionsRequest.updated_after:type_name -> google.protobuf.Timestamp
+	23, // 4: warden.act.v1beta1.QueryActionsRequest.updated_before:type_name -> google.protobuf.Timestamp
+	24, // 5: warden.act.v1beta1.QueryActionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	25, // 6: warden.act.v1beta1.QueryActionsResponse.actions:type_name -> warden.act.v1beta1.Action
+	21, // 7: warden.act.v1beta1.QueryTemplatesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	24, // 8: warden.act.v1beta1.QueryTemplatesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	26, // 9: warden.act.v1beta1.QueryTemplatesResponse.templates:type_name -> warden.act.v1beta1.Template
+	21, // 10: warden.act.v1beta1.QuerySimulateTemplateRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	26, // 11: warden.act.v1beta1.QueryTemplateByIdResponse.template:type_name -> warden.act.v1beta1.Template
+	21, // 12: warden.act.v1beta1.QueryTemplateVersionsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	21, // 13: warden.act.v1beta1.QueryVoteDelegationsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	24, // 14: warden.act.v1beta1.QueryVoteDelegationsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	27, // 15: warden.act.v1beta1.QueryVoteDelegationsResponse.delegations:type_name -> warden.act.v1beta1.VoteDelegation
+	28, // 16: warden.act.v1beta1.QueryContractTemplatesResponse.contract_templates:type_name -> warden.act.v1beta1.ContractTemplates
+	24, // 17: warden.act.v1beta1.QueryTemplateVersionsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	26, // 18: warden.act.v1beta1.QueryTemplateVersionsResponse.templates:type_name -> warden.act.v1beta1.Template
+	21, // 19: warden.act.v1beta1.QueryActionsByAddressRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	22, // 20: warden.act.v1beta1.QueryActionsByAddressRequest.status:type_name -> warden.act.v1beta1.ActionStatus
+	24, // 21: warden.act.v1beta1.QueryActionsByAddressResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	25, // 22: warden.act.v1beta1.QueryActionsByAddressResponse.actions:type_name -> warden.act.v1beta1.Action
+	25, // 23: warden.act.v1beta1.QueryActionByIdResponse.action:type_name -> warden.act.v1beta1.Action
+	0,  // 24: warden.act.v1beta1.Query.Params:input_type -> warden.act.v1beta1.QueryParamsRequest
+	2,  // 25: warden.act.v1beta1.Query.Actions:input_type -> warden.act.v1beta1.QueryActionsRequest
+	4,  // 26: warden.act.v1beta1.Query.Templates:input_type -> warden.act.v1beta1.QueryTemplatesRequest
+	6,  // 27: warden.act.v1beta1.Query.SimulateTemplate:input_type -> warden.act.v1beta1.QuerySimulateTemplateRequest
+	8,  // 28: warden.act.v1beta1.Query.TemplateById:input_type -> warden.act.v1beta1.QueryTemplateByIdRequest
+	10, // 29: warden.act.v1beta1.Query.TemplateVersions:input_type -> warden.act.v1beta1.QueryTemplateVersionsRequest
+	11, // 30: warden.act.v1beta1.Query.VoteDelegations:input_type -> warden.act.v1beta1.QueryVoteDelegationsRequest
+	13, // 31: warden.act.v1beta1.Query.ContractTemplates:input_type -> warden.act.v1beta1.QueryContractTemplatesRequest
+	16, // 32: warden.act.v1beta1.Query.ActionsByAddress:input_type -> warden.act.v1beta1.QueryActionsByAddressRequest
+	18, // 33: warden.act.v1beta1.Query.ActionById:input_type -> warden.act.v1beta1.QueryActionByIdRequest
+	1,  // 34: warden.act.v1beta1.Query.Params:output_type -> warden.act.v1beta1.QueryParamsResponse
+	3,  // 35: warden.act.v1beta1.Query.Actions:output_type -> warden.act.v1beta1.QueryActionsResponse
+	5,  // 36: warden.act.v1beta1.Query.Templates:output_type -> warden.act.v1beta1.QueryTemplatesResponse
+	7,  // 37: warden.act.v1beta1.Query.SimulateTemplate:output_type -> warden.act.v1beta1.QuerySimulateTemplateResponse
+	9,  // 38: warden.act.v1beta1.Query.TemplateById:output_type -> warden.act.v1beta1.QueryTemplateByIdResponse
+	15, // 39: warden.act.v1beta1.Query.TemplateVersions:output_type -> warden.act.v1beta1.QueryTemplateVersionsResponse
+	12, // 40: warden.act.v1beta1.Query.VoteDelegations:output_type -> warden.act.v1beta1.QueryVoteDelegationsResponse
+	14, // 41: warden.act.v1beta1.Query.ContractTemplates:output_type -> warden.act.v1beta1.QueryContractTemplatesResponse
+	17, // 42: warden.act.v1beta1.Query.ActionsByAddress:output_type -> warden.act.v1beta1.QueryActionsByAddressResponse
+	19, // 43: warden.act.v1beta1.Query.ActionById:output_type -> warden.act.v1beta1.QueryActionByIdResponse
+	34, // [34:44] is the sub-list for method output_type
+	24, // [24:34] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_warden_act_v1beta1_query_proto_init() }
@@ -7435,6 +11303,7 @@ func file_warden_act_v1beta1_query_proto_init() {
 	file_warden_act_v1beta1_params_proto_init()
 	file_warden_act_v1beta1_action_proto_init()
 	file_warden_act_v1beta1_template_proto_init()
+	file_warden_act_v1beta1_vote_delegation_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_warden_act_v1beta1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsRequest); i {
@@ -7557,7 +11426,7 @@ func file_warden_act_v1beta1_query_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActionsByAddressRequest); i {
+			switch v := v.(*QueryTemplateVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7569,7 +11438,7 @@ func file_warden_act_v1beta1_query_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActionsByAddressResponse); i {
+			switch v := v.(*QueryVoteDelegationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7581,7 +11450,7 @@ func file_warden_act_v1beta1_query_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActionByIdRequest); i {
+			switch v := v.(*QueryVoteDelegationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7593,6 +11462,78 @@ func file_warden_act_v1beta1_query_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTemplateVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryActionsByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryActionsByAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryActionByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryActionByIdResponse); i {
 			case 0:
 				return &v.state
@@ -7611,7 +11552,7 @@ func file_warden_act_v1beta1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_warden_act_v1beta1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName           = "/warden.act.v1beta1.Query/Params"
-	Query_Actions_FullMethodName          = "/warden.act.v1beta1.Query/Actions"
-	Query_Templates_FullMethodName        = "/warden.act.v1beta1.Query/Templates"
-	Query_SimulateTemplate_FullMethodName = "/warden.act.v1beta1.Query/SimulateTemplate"
-	Query_TemplateById_FullMethodName     = "/warden.act.v1beta1.Query/TemplateById"
-	Query_ActionsByAddress_FullMethodName = "/warden.act.v1beta1.Query/ActionsByAddress"
-	Query_ActionById_FullMethodName       = "/warden.act.v1beta1.Query/ActionById"
+	Query_Params_FullMethodName            = "/warden.act.v1beta1.Query/Params"
+	Query_Actions_FullMethodName           = "/warden.act.v1beta1.Query/Actions"
+	Query_Templates_FullMethodName         = "/warden.act.v1beta1.Query/Templates"
+	Query_SimulateTemplate_FullMethodName  = "/warden.act.v1beta1.Query/SimulateTemplate"
+	Query_TemplateById_FullMethodName      = "/warden.act.v1beta1.Query/TemplateById"
+	Query_TemplateVersions_FullMethodName  = "/warden.act.v1beta1.Query/TemplateVersions"
+	Query_VoteDelegations_FullMethodName   = "/warden.act.v1beta1.Query/VoteDelegations"
+	Query_ContractTemplates_FullMethodName = "/warden.act.v1beta1.Query/ContractTemplates"
+	Query_ActionsByAddress_FullMethodName  = "/warden.act.v1beta1.Query/ActionsByAddress"
+	Query_ActionById_FullMethodName        = "/warden.act.v1beta1.Query/ActionById"
 )
 
 // QueryClient is the client API for Query service.
@@ -42,6 +45,13 @@ type QueryClient interface {
 	SimulateTemplate(ctx context.Context, in *QuerySimulateTemplateRequest, opts ...grpc.CallOption) (*QuerySimulateTemplateResponse, error)
 	// Queries a list of TemplateById items.
 	TemplateById(ctx context.Context, in *QueryTemplateByIdRequest, opts ...grpc.CallOption) (*QueryTemplateByIdResponse, error)
+	// Queries the versions of a Template, oldest first.
+	TemplateVersions(ctx context.Context, in *QueryTemplateVersionsRequest, opts ...grpc.CallOption) (*QueryTemplateVersionsResponse, error)
+	// Queries the active vote delegations, optionally filtered by delegator or
+	// delegate.
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
+	// Queries the templates of the Actions executing a CosmWasm contract.
+	ContractTemplates(ctx context.Context, in *QueryContractTemplatesRequest, opts ...grpc.CallOption) (*QueryContractTemplatesResponse, error)
 	// Queries a list of Actions items by one participant address.
 	ActionsByAddress(ctx context.Context, in *QueryActionsByAddressRequest, opts ...grpc.CallOption) (*QueryActionsByAddressResponse, error)
 	ActionById(ctx context.Context, in *QueryActionByIdRequest, opts ...grpc.CallOption) (*QueryActionByIdResponse, error)
@@ -100,6 +110,33 @@ func (c *queryClient) TemplateById(ctx context.Context, in *QueryTemplateByIdReq
 	return out, nil
 }
 
+func (c *queryClient) TemplateVersions(ctx context.Context, in *QueryTemplateVersionsRequest, opts ...grpc.CallOption) (*QueryTemplateVersionsResponse, error) {
+	out := new(QueryTemplateVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_TemplateVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_VoteDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractTemplates(ctx context.Context, in *QueryContractTemplatesRequest, opts ...grpc.CallOption) (*QueryContractTemplatesResponse, error) {
+	out := new(QueryContractTemplatesResponse)
+	err := c.cc.Invoke(ctx, Query_ContractTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ActionsByAddress(ctx context.Context, in *QueryActionsByAddressRequest, opts ...grpc.CallOption) (*QueryActionsByAddressResponse, error) {
 	out := new(QueryActionsByAddressResponse)
 	err := c.cc.Invoke(ctx, Query_ActionsByAddress_FullMethodName, in, out, opts...)
@@ -132,6 +169,13 @@ type QueryServer interface {
 	SimulateTemplate(context.Context, *QuerySimulateTemplateRequest) (*QuerySimulateTemplateResponse, error)
 	// Queries a list of TemplateById items.
 	TemplateById(context.Context, *QueryTemplateByIdRequest) (*QueryTemplateByIdResponse, error)
+	// Queries the versions of a Template, oldest first.
+	TemplateVersions(context.Context, *QueryTemplateVersionsRequest) (*QueryTemplateVersionsResponse, error)
+	// Queries the active vote delegations, optionally filtered by delegator or
+	// delegate.
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
+	// Queries the templates of the Actions executing a CosmWasm contract.
+	ContractTemplates(context.Context, *QueryContractTemplatesRequest) (*QueryContractTemplatesResponse, error)
 	// Queries a list of Actions items by one participant address.
 	ActionsByAddress(context.Context, *QueryActionsByAddressRequest) (*QueryActionsByAddressResponse, error)
 	ActionById(context.Context, *QueryActionByIdRequest) (*QueryActionByIdResponse, error)
@@ -157,6 +201,15 @@ func (UnimplementedQueryServer) SimulateTemplate(context.Context, *QuerySimulate
 func (UnimplementedQueryServer) TemplateById(context.Context, *QueryTemplateByIdRequest) (*QueryTemplateByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemplateById not implemented")
 }
+func (UnimplementedQueryServer) TemplateVersions(context.Context, *QueryTemplateVersionsRequest) (*QueryTemplateVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateVersions not implemented")
+}
+func (UnimplementedQueryServer) VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
+func (UnimplementedQueryServer) ContractTemplates(context.Context, *QueryContractTemplatesRequest) (*QueryContractTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractTemplates not implemented")
+}
 func (UnimplementedQueryServer) ActionsByAddress(context.Context, *QueryActionsByAddressRequest) (*QueryActionsByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActionsByAddress not implemented")
 }
@@ -266,6 +319,60 @@ func _Query_TemplateById_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTemplateVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TemplateVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TemplateVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TemplateVersions(ctx, req.(*QueryTemplateVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractTemplates(ctx, req.(*QueryContractTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ActionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActionsByAddressRequest)
 	if err := dec(in); err != nil {
@@ -329,6 +436,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateById",
 			Handler:    _Query_TemplateById_Handler,
 		},
+		{
+			MethodName: "TemplateVersions",
+			Handler:    _Query_TemplateVersions_Handler,
+		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
+		{
+			MethodName: "ContractTemplates",
+			Handler:    _Query_ContractTemplates_Handler,
+		},
 		{
 			MethodName: "ActionsByAddress",
 			Handler:    _Query_ActionsByAddress_Handler,
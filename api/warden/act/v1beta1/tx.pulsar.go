@@ -13,6 +13,8 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -873,6 +875,57 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_MsgNewAction_6_list)(nil)
+
+type _MsgNewAction_6_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgNewAction_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgNewAction_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgNewAction_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgNewAction_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgNewAction_6_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgNewAction_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgNewAction_6_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgNewAction_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgNewAction                             protoreflect.MessageDescriptor
 	fd_MsgNewAction_creator                     protoreflect.FieldDescriptor
@@ -880,6 +933,7 @@ var (
 	fd_MsgNewAction_action_timeout_height       protoreflect.FieldDescriptor
 	fd_MsgNewAction_expected_approve_expression protoreflect.FieldDescriptor
 	fd_MsgNewAction_expected_reject_expression  protoreflect.FieldDescriptor
+	fd_MsgNewAction_messages                    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -890,6 +944,7 @@ func init() {
 	fd_MsgNewAction_action_timeout_height = md_MsgNewAction.Fields().ByName("action_timeout_height")
 	fd_MsgNewAction_expected_approve_expression = md_MsgNewAction.Fields().ByName("expected_approve_expression")
 	fd_MsgNewAction_expected_reject_expression = md_MsgNewAction.Fields().ByName("expected_reject_expression")
+	fd_MsgNewAction_messages = md_MsgNewAction.Fields().ByName("messages")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgNewAction)(nil)
@@ -987,6 +1042,12 @@ func (x *fastReflection_MsgNewAction) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_MsgNewAction_6_list{list: &x.Messages})
+		if !f(fd_MsgNewAction_messages, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1012,6 +1073,8 @@ func (x *fastReflection_MsgNewAction) Has(fd protoreflect.FieldDescriptor) bool
 		return x.ExpectedApproveExpression != ""
 	case "warden.act.v1beta1.MsgNewAction.expected_reject_expression":
 		return x.ExpectedRejectExpression != ""
+	case "warden.act.v1beta1.MsgNewAction.messages":
+		return len(x.Messages) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewAction"))
@@ -1038,6 +1101,8 @@ func (x *fastReflection_MsgNewAction) Clear(fd protoreflect.FieldDescriptor) {
 		x.ExpectedApproveExpression = ""
 	case "warden.act.v1beta1.MsgNewAction.expected_reject_expression":
 		x.ExpectedRejectExpression = ""
+	case "warden.act.v1beta1.MsgNewAction.messages":
+		x.Messages = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewAction"))
@@ -1069,6 +1134,12 @@ func (x *fastReflection_MsgNewAction) Get(descriptor protoreflect.FieldDescripto
 	case "warden.act.v1beta1.MsgNewAction.expected_reject_expression":
 		value := x.ExpectedRejectExpression
 		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgNewAction.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_MsgNewAction_6_list{})
+		}
+		listValue := &_MsgNewAction_6_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewAction"))
@@ -1099,6 +1170,10 @@ func (x *fastReflection_MsgNewAction) Set(fd protoreflect.FieldDescriptor, value
 		x.ExpectedApproveExpression = value.Interface().(string)
 	case "warden.act.v1beta1.MsgNewAction.expected_reject_expression":
 		x.ExpectedRejectExpression = value.Interface().(string)
+	case "warden.act.v1beta1.MsgNewAction.messages":
+		lv := value.List()
+		clv := lv.(*_MsgNewAction_6_list)
+		x.Messages = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewAction"))
@@ -1124,6 +1199,12 @@ func (x *fastReflection_MsgNewAction) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Message = new(anypb.Any)
 		}
 		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "warden.act.v1beta1.MsgNewAction.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_MsgNewAction_6_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
 	case "warden.act.v1beta1.MsgNewAction.creator":
 		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgNewAction is not mutable"))
 	case "warden.act.v1beta1.MsgNewAction.action_timeout_height":
@@ -1156,6 +1237,9 @@ func (x *fastReflection_MsgNewAction) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "warden.act.v1beta1.MsgNewAction.expected_reject_expression":
 		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgNewAction.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgNewAction_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewAction"))
@@ -1244,6 +1328,12 @@ func (x *fastReflection_MsgNewAction) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1273,6 +1363,22 @@ func (x *fastReflection_MsgNewAction) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.ExpectedRejectExpression) > 0 {
 			i -= len(x.ExpectedRejectExpression)
 			copy(dAtA[i:], x.ExpectedRejectExpression)
@@ -1513,6 +1619,40 @@ func (x *fastReflection_MsgNewAction) ProtoMethods() *protoiface.Methods {
 				}
 				x.ExpectedRejectExpression = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1953,10 +2093,12 @@ func (x *fastReflection_MsgNewActionResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgNewTemplate            protoreflect.MessageDescriptor
-	fd_MsgNewTemplate_creator    protoreflect.FieldDescriptor
-	fd_MsgNewTemplate_name       protoreflect.FieldDescriptor
-	fd_MsgNewTemplate_definition protoreflect.FieldDescriptor
+	md_MsgNewTemplate                        protoreflect.MessageDescriptor
+	fd_MsgNewTemplate_creator                protoreflect.FieldDescriptor
+	fd_MsgNewTemplate_name                   protoreflect.FieldDescriptor
+	fd_MsgNewTemplate_definition             protoreflect.FieldDescriptor
+	fd_MsgNewTemplate_execution_delay        protoreflect.FieldDescriptor
+	fd_MsgNewTemplate_governance_template_id protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1965,6 +2107,8 @@ func init() {
 	fd_MsgNewTemplate_creator = md_MsgNewTemplate.Fields().ByName("creator")
 	fd_MsgNewTemplate_name = md_MsgNewTemplate.Fields().ByName("name")
 	fd_MsgNewTemplate_definition = md_MsgNewTemplate.Fields().ByName("definition")
+	fd_MsgNewTemplate_execution_delay = md_MsgNewTemplate.Fields().ByName("execution_delay")
+	fd_MsgNewTemplate_governance_template_id = md_MsgNewTemplate.Fields().ByName("governance_template_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgNewTemplate)(nil)
@@ -2050,6 +2194,18 @@ func (x *fastReflection_MsgNewTemplate) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.ExecutionDelay != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionDelay.ProtoReflect())
+		if !f(fd_MsgNewTemplate_execution_delay, value) {
+			return
+		}
+	}
+	if x.GovernanceTemplateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GovernanceTemplateId)
+		if !f(fd_MsgNewTemplate_governance_template_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2071,6 +2227,10 @@ func (x *fastReflection_MsgNewTemplate) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Name != ""
 	case "warden.act.v1beta1.MsgNewTemplate.definition":
 		return x.Definition != ""
+	case "warden.act.v1beta1.MsgNewTemplate.execution_delay":
+		return x.ExecutionDelay != nil
+	case "warden.act.v1beta1.MsgNewTemplate.governance_template_id":
+		return x.GovernanceTemplateId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewTemplate"))
@@ -2093,6 +2253,10 @@ func (x *fastReflection_MsgNewTemplate) Clear(fd protoreflect.FieldDescriptor) {
 		x.Name = ""
 	case "warden.act.v1beta1.MsgNewTemplate.definition":
 		x.Definition = ""
+	case "warden.act.v1beta1.MsgNewTemplate.execution_delay":
+		x.ExecutionDelay = nil
+	case "warden.act.v1beta1.MsgNewTemplate.governance_template_id":
+		x.GovernanceTemplateId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewTemplate"))
@@ -2118,6 +2282,12 @@ func (x *fastReflection_MsgNewTemplate) Get(descriptor protoreflect.FieldDescrip
 	case "warden.act.v1beta1.MsgNewTemplate.definition":
 		value := x.Definition
 		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgNewTemplate.execution_delay":
+		value := x.ExecutionDelay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.MsgNewTemplate.governance_template_id":
+		value := x.GovernanceTemplateId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewTemplate"))
@@ -2144,6 +2314,10 @@ func (x *fastReflection_MsgNewTemplate) Set(fd protoreflect.FieldDescriptor, val
 		x.Name = value.Interface().(string)
 	case "warden.act.v1beta1.MsgNewTemplate.definition":
 		x.Definition = value.Interface().(string)
+	case "warden.act.v1beta1.MsgNewTemplate.execution_delay":
+		x.ExecutionDelay = value.Message().Interface().(*durationpb.Duration)
+	case "warden.act.v1beta1.MsgNewTemplate.governance_template_id":
+		x.GovernanceTemplateId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewTemplate"))
@@ -2164,12 +2338,19 @@ func (x *fastReflection_MsgNewTemplate) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgNewTemplate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgNewTemplate.execution_delay":
+		if x.ExecutionDelay == nil {
+			x.ExecutionDelay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionDelay.ProtoReflect())
 	case "warden.act.v1beta1.MsgNewTemplate.creator":
 		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgNewTemplate is not mutable"))
 	case "warden.act.v1beta1.MsgNewTemplate.name":
 		panic(fmt.Errorf("field name of message warden.act.v1beta1.MsgNewTemplate is not mutable"))
 	case "warden.act.v1beta1.MsgNewTemplate.definition":
 		panic(fmt.Errorf("field definition of message warden.act.v1beta1.MsgNewTemplate is not mutable"))
+	case "warden.act.v1beta1.MsgNewTemplate.governance_template_id":
+		panic(fmt.Errorf("field governance_template_id of message warden.act.v1beta1.MsgNewTemplate is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewTemplate"))
@@ -2189,6 +2370,11 @@ func (x *fastReflection_MsgNewTemplate) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "warden.act.v1beta1.MsgNewTemplate.definition":
 		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgNewTemplate.execution_delay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.MsgNewTemplate.governance_template_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgNewTemplate"))
@@ -2270,6 +2456,13 @@ func (x *fastReflection_MsgNewTemplate) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExecutionDelay != nil {
+			l = options.Size(x.ExecutionDelay)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GovernanceTemplateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GovernanceTemplateId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2299,6 +2492,25 @@ func (x *fastReflection_MsgNewTemplate) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GovernanceTemplateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GovernanceTemplateId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExecutionDelay != nil {
+			encoded, err := options.Marshal(x.ExecutionDelay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Definition) > 0 {
 			i -= len(x.Definition)
 			copy(dAtA[i:], x.Definition)
@@ -2465,6 +2677,61 @@ func (x *fastReflection_MsgNewTemplate) ProtoMethods() *protoiface.Methods {
 				}
 				x.Definition = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecutionDelay == nil {
+					x.ExecutionDelay = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionDelay); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovernanceTemplateId", wireType)
+				}
+				x.GovernanceTemplateId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GovernanceTemplateId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2905,11 +3172,15 @@ func (x *fastReflection_MsgNewTemplateResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_MsgUpdateTemplate            protoreflect.MessageDescriptor
-	fd_MsgUpdateTemplate_creator    protoreflect.FieldDescriptor
-	fd_MsgUpdateTemplate_id         protoreflect.FieldDescriptor
-	fd_MsgUpdateTemplate_name       protoreflect.FieldDescriptor
-	fd_MsgUpdateTemplate_definition protoreflect.FieldDescriptor
+	md_MsgUpdateTemplate                               protoreflect.MessageDescriptor
+	fd_MsgUpdateTemplate_creator                       protoreflect.FieldDescriptor
+	fd_MsgUpdateTemplate_id                            protoreflect.FieldDescriptor
+	fd_MsgUpdateTemplate_name                          protoreflect.FieldDescriptor
+	fd_MsgUpdateTemplate_definition                    protoreflect.FieldDescriptor
+	fd_MsgUpdateTemplate_execution_delay               protoreflect.FieldDescriptor
+	fd_MsgUpdateTemplate_governance_template_id        protoreflect.FieldDescriptor
+	fd_MsgUpdateTemplate_update_execution_delay        protoreflect.FieldDescriptor
+	fd_MsgUpdateTemplate_update_governance_template_id protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2919,6 +3190,10 @@ func init() {
 	fd_MsgUpdateTemplate_id = md_MsgUpdateTemplate.Fields().ByName("id")
 	fd_MsgUpdateTemplate_name = md_MsgUpdateTemplate.Fields().ByName("name")
 	fd_MsgUpdateTemplate_definition = md_MsgUpdateTemplate.Fields().ByName("definition")
+	fd_MsgUpdateTemplate_execution_delay = md_MsgUpdateTemplate.Fields().ByName("execution_delay")
+	fd_MsgUpdateTemplate_governance_template_id = md_MsgUpdateTemplate.Fields().ByName("governance_template_id")
+	fd_MsgUpdateTemplate_update_execution_delay = md_MsgUpdateTemplate.Fields().ByName("update_execution_delay")
+	fd_MsgUpdateTemplate_update_governance_template_id = md_MsgUpdateTemplate.Fields().ByName("update_governance_template_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateTemplate)(nil)
@@ -3010,6 +3285,30 @@ func (x *fastReflection_MsgUpdateTemplate) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.ExecutionDelay != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionDelay.ProtoReflect())
+		if !f(fd_MsgUpdateTemplate_execution_delay, value) {
+			return
+		}
+	}
+	if x.GovernanceTemplateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GovernanceTemplateId)
+		if !f(fd_MsgUpdateTemplate_governance_template_id, value) {
+			return
+		}
+	}
+	if x.UpdateExecutionDelay != false {
+		value := protoreflect.ValueOfBool(x.UpdateExecutionDelay)
+		if !f(fd_MsgUpdateTemplate_update_execution_delay, value) {
+			return
+		}
+	}
+	if x.UpdateGovernanceTemplateId != false {
+		value := protoreflect.ValueOfBool(x.UpdateGovernanceTemplateId)
+		if !f(fd_MsgUpdateTemplate_update_governance_template_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3033,6 +3332,14 @@ func (x *fastReflection_MsgUpdateTemplate) Has(fd protoreflect.FieldDescriptor)
 		return x.Name != ""
 	case "warden.act.v1beta1.MsgUpdateTemplate.definition":
 		return x.Definition != ""
+	case "warden.act.v1beta1.MsgUpdateTemplate.execution_delay":
+		return x.ExecutionDelay != nil
+	case "warden.act.v1beta1.MsgUpdateTemplate.governance_template_id":
+		return x.GovernanceTemplateId != uint64(0)
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_execution_delay":
+		return x.UpdateExecutionDelay != false
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_governance_template_id":
+		return x.UpdateGovernanceTemplateId != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgUpdateTemplate"))
@@ -3057,6 +3364,14 @@ func (x *fastReflection_MsgUpdateTemplate) Clear(fd protoreflect.FieldDescriptor
 		x.Name = ""
 	case "warden.act.v1beta1.MsgUpdateTemplate.definition":
 		x.Definition = ""
+	case "warden.act.v1beta1.MsgUpdateTemplate.execution_delay":
+		x.ExecutionDelay = nil
+	case "warden.act.v1beta1.MsgUpdateTemplate.governance_template_id":
+		x.GovernanceTemplateId = uint64(0)
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_execution_delay":
+		x.UpdateExecutionDelay = false
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_governance_template_id":
+		x.UpdateGovernanceTemplateId = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgUpdateTemplate"))
@@ -3085,6 +3400,18 @@ func (x *fastReflection_MsgUpdateTemplate) Get(descriptor protoreflect.FieldDesc
 	case "warden.act.v1beta1.MsgUpdateTemplate.definition":
 		value := x.Definition
 		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgUpdateTemplate.execution_delay":
+		value := x.ExecutionDelay
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.act.v1beta1.MsgUpdateTemplate.governance_template_id":
+		value := x.GovernanceTemplateId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_execution_delay":
+		value := x.UpdateExecutionDelay
+		return protoreflect.ValueOfBool(value)
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_governance_template_id":
+		value := x.UpdateGovernanceTemplateId
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgUpdateTemplate"))
@@ -3113,6 +3440,14 @@ func (x *fastReflection_MsgUpdateTemplate) Set(fd protoreflect.FieldDescriptor,
 		x.Name = value.Interface().(string)
 	case "warden.act.v1beta1.MsgUpdateTemplate.definition":
 		x.Definition = value.Interface().(string)
+	case "warden.act.v1beta1.MsgUpdateTemplate.execution_delay":
+		x.ExecutionDelay = value.Message().Interface().(*durationpb.Duration)
+	case "warden.act.v1beta1.MsgUpdateTemplate.governance_template_id":
+		x.GovernanceTemplateId = value.Uint()
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_execution_delay":
+		x.UpdateExecutionDelay = value.Bool()
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_governance_template_id":
+		x.UpdateGovernanceTemplateId = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgUpdateTemplate"))
@@ -3133,6 +3468,11 @@ func (x *fastReflection_MsgUpdateTemplate) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgUpdateTemplate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgUpdateTemplate.execution_delay":
+		if x.ExecutionDelay == nil {
+			x.ExecutionDelay = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionDelay.ProtoReflect())
 	case "warden.act.v1beta1.MsgUpdateTemplate.creator":
 		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgUpdateTemplate is not mutable"))
 	case "warden.act.v1beta1.MsgUpdateTemplate.id":
@@ -3141,6 +3481,12 @@ func (x *fastReflection_MsgUpdateTemplate) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field name of message warden.act.v1beta1.MsgUpdateTemplate is not mutable"))
 	case "warden.act.v1beta1.MsgUpdateTemplate.definition":
 		panic(fmt.Errorf("field definition of message warden.act.v1beta1.MsgUpdateTemplate is not mutable"))
+	case "warden.act.v1beta1.MsgUpdateTemplate.governance_template_id":
+		panic(fmt.Errorf("field governance_template_id of message warden.act.v1beta1.MsgUpdateTemplate is not mutable"))
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_execution_delay":
+		panic(fmt.Errorf("field update_execution_delay of message warden.act.v1beta1.MsgUpdateTemplate is not mutable"))
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_governance_template_id":
+		panic(fmt.Errorf("field update_governance_template_id of message warden.act.v1beta1.MsgUpdateTemplate is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgUpdateTemplate"))
@@ -3162,6 +3508,15 @@ func (x *fastReflection_MsgUpdateTemplate) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfString("")
 	case "warden.act.v1beta1.MsgUpdateTemplate.definition":
 		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgUpdateTemplate.execution_delay":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.act.v1beta1.MsgUpdateTemplate.governance_template_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_execution_delay":
+		return protoreflect.ValueOfBool(false)
+	case "warden.act.v1beta1.MsgUpdateTemplate.update_governance_template_id":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgUpdateTemplate"))
@@ -3246,6 +3601,19 @@ func (x *fastReflection_MsgUpdateTemplate) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExecutionDelay != nil {
+			l = options.Size(x.ExecutionDelay)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GovernanceTemplateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GovernanceTemplateId))
+		}
+		if x.UpdateExecutionDelay {
+			n += 2
+		}
+		if x.UpdateGovernanceTemplateId {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3275,6 +3643,45 @@ func (x *fastReflection_MsgUpdateTemplate) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.UpdateGovernanceTemplateId {
+			i--
+			if x.UpdateGovernanceTemplateId {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.UpdateExecutionDelay {
+			i--
+			if x.UpdateExecutionDelay {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.GovernanceTemplateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GovernanceTemplateId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExecutionDelay != nil {
+			encoded, err := options.Marshal(x.ExecutionDelay)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.Definition) > 0 {
 			i -= len(x.Definition)
 			copy(dAtA[i:], x.Definition)
@@ -3465,64 +3872,159 @@ func (x *fastReflection_MsgUpdateTemplate) ProtoMethods() *protoiface.Methods {
 				}
 				x.Definition = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.ExecutionDelay == nil {
+					x.ExecutionDelay = &durationpb.Duration{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgUpdateTemplateResponse protoreflect.MessageDescriptor
-)
-
-func init() {
-	file_warden_act_v1beta1_tx_proto_init()
-	md_MsgUpdateTemplateResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgUpdateTemplateResponse")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgUpdateTemplateResponse)(nil)
-
-type fastReflection_MsgUpdateTemplateResponse MsgUpdateTemplateResponse
-
-func (x *MsgUpdateTemplateResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateTemplateResponse)(x)
-}
-
-func (x *MsgUpdateTemplateResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionDelay); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovernanceTemplateId", wireType)
+				}
+				x.GovernanceTemplateId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GovernanceTemplateId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateExecutionDelay", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.UpdateExecutionDelay = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateGovernanceTemplateId", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.UpdateGovernanceTemplateId = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateTemplateResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgUpdateTemplateResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgUpdateTemplateResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateTemplateResponse)(nil)
+
+type fastReflection_MsgUpdateTemplateResponse MsgUpdateTemplateResponse
+
+func (x *MsgUpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateTemplateResponse)(x)
+}
+
+func (x *MsgUpdateTemplateResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
 		return ms
 	}
@@ -3857,27 +4359,29 @@ func (x *fastReflection_MsgUpdateTemplateResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_MsgRevokeAction           protoreflect.MessageDescriptor
-	fd_MsgRevokeAction_creator   protoreflect.FieldDescriptor
-	fd_MsgRevokeAction_action_id protoreflect.FieldDescriptor
+	md_MsgTransferTemplateOwnership           protoreflect.MessageDescriptor
+	fd_MsgTransferTemplateOwnership_creator   protoreflect.FieldDescriptor
+	fd_MsgTransferTemplateOwnership_id        protoreflect.FieldDescriptor
+	fd_MsgTransferTemplateOwnership_new_owner protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_tx_proto_init()
-	md_MsgRevokeAction = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRevokeAction")
-	fd_MsgRevokeAction_creator = md_MsgRevokeAction.Fields().ByName("creator")
-	fd_MsgRevokeAction_action_id = md_MsgRevokeAction.Fields().ByName("action_id")
+	md_MsgTransferTemplateOwnership = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgTransferTemplateOwnership")
+	fd_MsgTransferTemplateOwnership_creator = md_MsgTransferTemplateOwnership.Fields().ByName("creator")
+	fd_MsgTransferTemplateOwnership_id = md_MsgTransferTemplateOwnership.Fields().ByName("id")
+	fd_MsgTransferTemplateOwnership_new_owner = md_MsgTransferTemplateOwnership.Fields().ByName("new_owner")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRevokeAction)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgTransferTemplateOwnership)(nil)
 
-type fastReflection_MsgRevokeAction MsgRevokeAction
+type fastReflection_MsgTransferTemplateOwnership MsgTransferTemplateOwnership
 
-func (x *MsgRevokeAction) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevokeAction)(x)
+func (x *MsgTransferTemplateOwnership) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferTemplateOwnership)(x)
 }
 
-func (x *MsgRevokeAction) slowProtoReflect() protoreflect.Message {
+func (x *MsgTransferTemplateOwnership) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3889,43 +4393,43 @@ func (x *MsgRevokeAction) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRevokeAction_messageType fastReflection_MsgRevokeAction_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevokeAction_messageType{}
+var _fastReflection_MsgTransferTemplateOwnership_messageType fastReflection_MsgTransferTemplateOwnership_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferTemplateOwnership_messageType{}
 
-type fastReflection_MsgRevokeAction_messageType struct{}
+type fastReflection_MsgTransferTemplateOwnership_messageType struct{}
 
-func (x fastReflection_MsgRevokeAction_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevokeAction)(nil)
+func (x fastReflection_MsgTransferTemplateOwnership_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferTemplateOwnership)(nil)
 }
-func (x fastReflection_MsgRevokeAction_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeAction)
+func (x fastReflection_MsgTransferTemplateOwnership_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTemplateOwnership)
 }
-func (x fastReflection_MsgRevokeAction_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeAction
+func (x fastReflection_MsgTransferTemplateOwnership_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTemplateOwnership
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRevokeAction) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeAction
+func (x *fastReflection_MsgTransferTemplateOwnership) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTemplateOwnership
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevokeAction) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevokeAction_messageType
+func (x *fastReflection_MsgTransferTemplateOwnership) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferTemplateOwnership_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevokeAction) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeAction)
+func (x *fastReflection_MsgTransferTemplateOwnership) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTemplateOwnership)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevokeAction) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevokeAction)(x)
+func (x *fastReflection_MsgTransferTemplateOwnership) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferTemplateOwnership)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3933,16 +4437,22 @@ func (x *fastReflection_MsgRevokeAction) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevokeAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgTransferTemplateOwnership) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgRevokeAction_creator, value) {
+		if !f(fd_MsgTransferTemplateOwnership_creator, value) {
 			return
 		}
 	}
-	if x.ActionId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ActionId)
-		if !f(fd_MsgRevokeAction_action_id, value) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgTransferTemplateOwnership_id, value) {
+			return
+		}
+	}
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_MsgTransferTemplateOwnership_new_owner, value) {
 			return
 		}
 	}
@@ -3959,17 +4469,19 @@ func (x *fastReflection_MsgRevokeAction) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevokeAction) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgTransferTemplateOwnership) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgRevokeAction.creator":
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.creator":
 		return x.Creator != ""
-	case "warden.act.v1beta1.MsgRevokeAction.action_id":
-		return x.ActionId != uint64(0)
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.id":
+		return x.Id != uint64(0)
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.new_owner":
+		return x.NewOwner != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3979,17 +4491,19 @@ func (x *fastReflection_MsgRevokeAction) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeAction) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgTransferTemplateOwnership) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgRevokeAction.creator":
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.creator":
 		x.Creator = ""
-	case "warden.act.v1beta1.MsgRevokeAction.action_id":
-		x.ActionId = uint64(0)
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.id":
+		x.Id = uint64(0)
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.new_owner":
+		x.NewOwner = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3999,19 +4513,22 @@ func (x *fastReflection_MsgRevokeAction) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevokeAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTemplateOwnership) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.MsgRevokeAction.creator":
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "warden.act.v1beta1.MsgRevokeAction.action_id":
-		value := x.ActionId
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.id":
+		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.new_owner":
+		value := x.NewOwner
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnership does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4025,17 +4542,19 @@ func (x *fastReflection_MsgRevokeAction) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgTransferTemplateOwnership) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgRevokeAction.creator":
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.creator":
 		x.Creator = value.Interface().(string)
-	case "warden.act.v1beta1.MsgRevokeAction.action_id":
-		x.ActionId = value.Uint()
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.id":
+		x.Id = value.Uint()
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.new_owner":
+		x.NewOwner = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4049,44 +4568,48 @@ func (x *fastReflection_MsgRevokeAction) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTemplateOwnership) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgRevokeAction.creator":
-		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgRevokeAction is not mutable"))
-	case "warden.act.v1beta1.MsgRevokeAction.action_id":
-		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgRevokeAction is not mutable"))
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.creator":
+		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgTransferTemplateOwnership is not mutable"))
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.MsgTransferTemplateOwnership is not mutable"))
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.new_owner":
+		panic(fmt.Errorf("field new_owner of message warden.act.v1beta1.MsgTransferTemplateOwnership is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevokeAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTemplateOwnership) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgRevokeAction.creator":
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.creator":
 		return protoreflect.ValueOfString("")
-	case "warden.act.v1beta1.MsgRevokeAction.action_id":
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.MsgTransferTemplateOwnership.new_owner":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnership"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnership does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevokeAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgTransferTemplateOwnership) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRevokeAction", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgTransferTemplateOwnership", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4094,7 +4617,7 @@ func (x *fastReflection_MsgRevokeAction) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevokeAction) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgTransferTemplateOwnership) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4105,7 +4628,7 @@ func (x *fastReflection_MsgRevokeAction) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeAction) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgTransferTemplateOwnership) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4117,7 +4640,7 @@ func (x *fastReflection_MsgRevokeAction) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevokeAction) IsValid() bool {
+func (x *fastReflection_MsgTransferTemplateOwnership) IsValid() bool {
 	return x != nil
 }
 
@@ -4127,9 +4650,9 @@ func (x *fastReflection_MsgRevokeAction) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgTransferTemplateOwnership) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevokeAction)
+		x := input.Message.Interface().(*MsgTransferTemplateOwnership)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4145,8 +4668,12 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ActionId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ActionId))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.NewOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4158,7 +4685,7 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeAction)
+		x := input.Message.Interface().(*MsgTransferTemplateOwnership)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4177,8 +4704,15 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ActionId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActionId))
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -4200,7 +4734,7 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeAction)
+		x := input.Message.Interface().(*MsgTransferTemplateOwnership)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4232,10 +4766,10 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeAction: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTemplateOwnership: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeAction: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTemplateOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4272,9 +4806,9 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.ActionId = 0
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4284,11 +4818,43 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ActionId |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4325,23 +4891,23 @@ func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRevokeActionResponse protoreflect.MessageDescriptor
+	md_MsgTransferTemplateOwnershipResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_tx_proto_init()
-	md_MsgRevokeActionResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRevokeActionResponse")
+	md_MsgTransferTemplateOwnershipResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgTransferTemplateOwnershipResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRevokeActionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgTransferTemplateOwnershipResponse)(nil)
 
-type fastReflection_MsgRevokeActionResponse MsgRevokeActionResponse
+type fastReflection_MsgTransferTemplateOwnershipResponse MsgTransferTemplateOwnershipResponse
 
-func (x *MsgRevokeActionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevokeActionResponse)(x)
+func (x *MsgTransferTemplateOwnershipResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferTemplateOwnershipResponse)(x)
 }
 
-func (x *MsgRevokeActionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgTransferTemplateOwnershipResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4353,43 +4919,43 @@ func (x *MsgRevokeActionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRevokeActionResponse_messageType fastReflection_MsgRevokeActionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevokeActionResponse_messageType{}
+var _fastReflection_MsgTransferTemplateOwnershipResponse_messageType fastReflection_MsgTransferTemplateOwnershipResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferTemplateOwnershipResponse_messageType{}
 
-type fastReflection_MsgRevokeActionResponse_messageType struct{}
+type fastReflection_MsgTransferTemplateOwnershipResponse_messageType struct{}
 
-func (x fastReflection_MsgRevokeActionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevokeActionResponse)(nil)
+func (x fastReflection_MsgTransferTemplateOwnershipResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferTemplateOwnershipResponse)(nil)
 }
-func (x fastReflection_MsgRevokeActionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeActionResponse)
+func (x fastReflection_MsgTransferTemplateOwnershipResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTemplateOwnershipResponse)
 }
-func (x fastReflection_MsgRevokeActionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeActionResponse
+func (x fastReflection_MsgTransferTemplateOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTemplateOwnershipResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRevokeActionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevokeActionResponse
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferTemplateOwnershipResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevokeActionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevokeActionResponse_messageType
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferTemplateOwnershipResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevokeActionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRevokeActionResponse)
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferTemplateOwnershipResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevokeActionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevokeActionResponse)(x)
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferTemplateOwnershipResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4397,7 +4963,7 @@ func (x *fastReflection_MsgRevokeActionResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevokeActionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4411,13 +4977,13 @@ func (x *fastReflection_MsgRevokeActionResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevokeActionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4427,13 +4993,13 @@ func (x *fastReflection_MsgRevokeActionResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeActionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4443,13 +5009,13 @@ func (x *fastReflection_MsgRevokeActionResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevokeActionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnershipResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4463,13 +5029,13 @@ func (x *fastReflection_MsgRevokeActionResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeActionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4483,36 +5049,36 @@ func (x *fastReflection_MsgRevokeActionResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeActionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevokeActionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgTransferTemplateOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgTransferTemplateOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevokeActionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRevokeActionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgTransferTemplateOwnershipResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4520,7 +5086,7 @@ func (x *fastReflection_MsgRevokeActionResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevokeActionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4531,7 +5097,7 @@ func (x *fastReflection_MsgRevokeActionResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevokeActionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4543,7 +5109,7 @@ func (x *fastReflection_MsgRevokeActionResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevokeActionResponse) IsValid() bool {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4553,9 +5119,9 @@ func (x *fastReflection_MsgRevokeActionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevokeActionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgTransferTemplateOwnershipResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevokeActionResponse)
+		x := input.Message.Interface().(*MsgTransferTemplateOwnershipResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4577,7 +5143,7 @@ func (x *fastReflection_MsgRevokeActionResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeActionResponse)
+		x := input.Message.Interface().(*MsgTransferTemplateOwnershipResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4607,7 +5173,7 @@ func (x *fastReflection_MsgRevokeActionResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevokeActionResponse)
+		x := input.Message.Interface().(*MsgTransferTemplateOwnershipResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4639,10 +5205,10 @@ func (x *fastReflection_MsgRevokeActionResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeActionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTemplateOwnershipResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferTemplateOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4681,27 +5247,27 @@ func (x *fastReflection_MsgRevokeActionResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_MsgCheckAction           protoreflect.MessageDescriptor
-	fd_MsgCheckAction_creator   protoreflect.FieldDescriptor
-	fd_MsgCheckAction_action_id protoreflect.FieldDescriptor
+	md_MsgRevokeAction           protoreflect.MessageDescriptor
+	fd_MsgRevokeAction_creator   protoreflect.FieldDescriptor
+	fd_MsgRevokeAction_action_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_tx_proto_init()
-	md_MsgCheckAction = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgCheckAction")
-	fd_MsgCheckAction_creator = md_MsgCheckAction.Fields().ByName("creator")
-	fd_MsgCheckAction_action_id = md_MsgCheckAction.Fields().ByName("action_id")
+	md_MsgRevokeAction = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRevokeAction")
+	fd_MsgRevokeAction_creator = md_MsgRevokeAction.Fields().ByName("creator")
+	fd_MsgRevokeAction_action_id = md_MsgRevokeAction.Fields().ByName("action_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCheckAction)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevokeAction)(nil)
 
-type fastReflection_MsgCheckAction MsgCheckAction
+type fastReflection_MsgRevokeAction MsgRevokeAction
 
-func (x *MsgCheckAction) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCheckAction)(x)
+func (x *MsgRevokeAction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeAction)(x)
 }
 
-func (x *MsgCheckAction) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevokeAction) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4713,43 +5279,43 @@ func (x *MsgCheckAction) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCheckAction_messageType fastReflection_MsgCheckAction_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCheckAction_messageType{}
+var _fastReflection_MsgRevokeAction_messageType fastReflection_MsgRevokeAction_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeAction_messageType{}
 
-type fastReflection_MsgCheckAction_messageType struct{}
+type fastReflection_MsgRevokeAction_messageType struct{}
 
-func (x fastReflection_MsgCheckAction_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCheckAction)(nil)
+func (x fastReflection_MsgRevokeAction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeAction)(nil)
 }
-func (x fastReflection_MsgCheckAction_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCheckAction)
+func (x fastReflection_MsgRevokeAction_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeAction)
 }
-func (x fastReflection_MsgCheckAction_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCheckAction
+func (x fastReflection_MsgRevokeAction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeAction
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCheckAction) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCheckAction
+func (x *fastReflection_MsgRevokeAction) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeAction
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCheckAction) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCheckAction_messageType
+func (x *fastReflection_MsgRevokeAction) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeAction_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCheckAction) New() protoreflect.Message {
-	return new(fastReflection_MsgCheckAction)
+func (x *fastReflection_MsgRevokeAction) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeAction)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCheckAction) Interface() protoreflect.ProtoMessage {
-	return (*MsgCheckAction)(x)
+func (x *fastReflection_MsgRevokeAction) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeAction)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4757,16 +5323,16 @@ func (x *fastReflection_MsgCheckAction) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCheckAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRevokeAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgCheckAction_creator, value) {
+		if !f(fd_MsgRevokeAction_creator, value) {
 			return
 		}
 	}
 	if x.ActionId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ActionId)
-		if !f(fd_MsgCheckAction_action_id, value) {
+		if !f(fd_MsgRevokeAction_action_id, value) {
 			return
 		}
 	}
@@ -4783,17 +5349,17 @@ func (x *fastReflection_MsgCheckAction) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCheckAction) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevokeAction) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckAction.creator":
+	case "warden.act.v1beta1.MsgRevokeAction.creator":
 		return x.Creator != ""
-	case "warden.act.v1beta1.MsgCheckAction.action_id":
+	case "warden.act.v1beta1.MsgRevokeAction.action_id":
 		return x.ActionId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4803,17 +5369,17 @@ func (x *fastReflection_MsgCheckAction) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckAction) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevokeAction) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckAction.creator":
+	case "warden.act.v1beta1.MsgRevokeAction.creator":
 		x.Creator = ""
-	case "warden.act.v1beta1.MsgCheckAction.action_id":
+	case "warden.act.v1beta1.MsgRevokeAction.action_id":
 		x.ActionId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4823,19 +5389,19 @@ func (x *fastReflection_MsgCheckAction) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCheckAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.MsgCheckAction.creator":
+	case "warden.act.v1beta1.MsgRevokeAction.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "warden.act.v1beta1.MsgCheckAction.action_id":
+	case "warden.act.v1beta1.MsgRevokeAction.action_id":
 		value := x.ActionId
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4849,17 +5415,17 @@ func (x *fastReflection_MsgCheckAction) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevokeAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckAction.creator":
+	case "warden.act.v1beta1.MsgRevokeAction.creator":
 		x.Creator = value.Interface().(string)
-	case "warden.act.v1beta1.MsgCheckAction.action_id":
+	case "warden.act.v1beta1.MsgRevokeAction.action_id":
 		x.ActionId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4873,44 +5439,44 @@ func (x *fastReflection_MsgCheckAction) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckAction.creator":
-		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgCheckAction is not mutable"))
-	case "warden.act.v1beta1.MsgCheckAction.action_id":
-		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgCheckAction is not mutable"))
+	case "warden.act.v1beta1.MsgRevokeAction.creator":
+		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgRevokeAction is not mutable"))
+	case "warden.act.v1beta1.MsgRevokeAction.action_id":
+		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgRevokeAction is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCheckAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckAction.creator":
+	case "warden.act.v1beta1.MsgRevokeAction.creator":
 		return protoreflect.ValueOfString("")
-	case "warden.act.v1beta1.MsgCheckAction.action_id":
+	case "warden.act.v1beta1.MsgRevokeAction.action_id":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeAction does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCheckAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevokeAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgCheckAction", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRevokeAction", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4918,7 +5484,7 @@ func (x *fastReflection_MsgCheckAction) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCheckAction) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevokeAction) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4929,7 +5495,7 @@ func (x *fastReflection_MsgCheckAction) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckAction) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevokeAction) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4941,7 +5507,7 @@ func (x *fastReflection_MsgCheckAction) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCheckAction) IsValid() bool {
+func (x *fastReflection_MsgRevokeAction) IsValid() bool {
 	return x != nil
 }
 
@@ -4951,9 +5517,9 @@ func (x *fastReflection_MsgCheckAction) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCheckAction) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevokeAction) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCheckAction)
+		x := input.Message.Interface().(*MsgRevokeAction)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4982,7 +5548,7 @@ func (x *fastReflection_MsgCheckAction) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCheckAction)
+		x := input.Message.Interface().(*MsgRevokeAction)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5024,7 +5590,7 @@ func (x *fastReflection_MsgCheckAction) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCheckAction)
+		x := input.Message.Interface().(*MsgRevokeAction)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5056,10 +5622,10 @@ func (x *fastReflection_MsgCheckAction) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckAction: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeAction: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckAction: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeAction: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5149,25 +5715,23 @@ func (x *fastReflection_MsgCheckAction) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgCheckActionResponse        protoreflect.MessageDescriptor
-	fd_MsgCheckActionResponse_status protoreflect.FieldDescriptor
+	md_MsgRevokeActionResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_tx_proto_init()
-	md_MsgCheckActionResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgCheckActionResponse")
-	fd_MsgCheckActionResponse_status = md_MsgCheckActionResponse.Fields().ByName("status")
+	md_MsgRevokeActionResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRevokeActionResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCheckActionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevokeActionResponse)(nil)
 
-type fastReflection_MsgCheckActionResponse MsgCheckActionResponse
+type fastReflection_MsgRevokeActionResponse MsgRevokeActionResponse
 
-func (x *MsgCheckActionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCheckActionResponse)(x)
+func (x *MsgRevokeActionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeActionResponse)(x)
 }
 
-func (x *MsgCheckActionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevokeActionResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5179,43 +5743,43 @@ func (x *MsgCheckActionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCheckActionResponse_messageType fastReflection_MsgCheckActionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCheckActionResponse_messageType{}
+var _fastReflection_MsgRevokeActionResponse_messageType fastReflection_MsgRevokeActionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeActionResponse_messageType{}
 
-type fastReflection_MsgCheckActionResponse_messageType struct{}
+type fastReflection_MsgRevokeActionResponse_messageType struct{}
 
-func (x fastReflection_MsgCheckActionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCheckActionResponse)(nil)
+func (x fastReflection_MsgRevokeActionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeActionResponse)(nil)
 }
-func (x fastReflection_MsgCheckActionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCheckActionResponse)
+func (x fastReflection_MsgRevokeActionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeActionResponse)
 }
-func (x fastReflection_MsgCheckActionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCheckActionResponse
+func (x fastReflection_MsgRevokeActionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeActionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCheckActionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCheckActionResponse
+func (x *fastReflection_MsgRevokeActionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeActionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCheckActionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCheckActionResponse_messageType
+func (x *fastReflection_MsgRevokeActionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeActionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCheckActionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCheckActionResponse)
+func (x *fastReflection_MsgRevokeActionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeActionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCheckActionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCheckActionResponse)(x)
+func (x *fastReflection_MsgRevokeActionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeActionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5223,13 +5787,7 @@ func (x *fastReflection_MsgCheckActionResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCheckActionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != "" {
-		value := protoreflect.ValueOfString(x.Status)
-		if !f(fd_MsgCheckActionResponse_status, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgRevokeActionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5243,15 +5801,13 @@ func (x *fastReflection_MsgCheckActionResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCheckActionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevokeActionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckActionResponse.status":
-		return x.Status != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5261,15 +5817,13 @@ func (x *fastReflection_MsgCheckActionResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckActionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevokeActionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckActionResponse.status":
-		x.Status = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5279,16 +5833,13 @@ func (x *fastReflection_MsgCheckActionResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCheckActionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeActionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.MsgCheckActionResponse.status":
-		value := x.Status
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5302,15 +5853,13 @@ func (x *fastReflection_MsgCheckActionResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckActionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevokeActionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckActionResponse.status":
-		x.Status = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5324,40 +5873,36 @@ func (x *fastReflection_MsgCheckActionResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckActionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeActionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckActionResponse.status":
-		panic(fmt.Errorf("field status of message warden.act.v1beta1.MsgCheckActionResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCheckActionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeActionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgCheckActionResponse.status":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCheckActionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevokeActionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgCheckActionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRevokeActionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5365,7 +5910,7 @@ func (x *fastReflection_MsgCheckActionResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCheckActionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevokeActionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5376,7 +5921,7 @@ func (x *fastReflection_MsgCheckActionResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCheckActionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevokeActionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5388,7 +5933,7 @@ func (x *fastReflection_MsgCheckActionResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCheckActionResponse) IsValid() bool {
+func (x *fastReflection_MsgRevokeActionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5398,9 +5943,9 @@ func (x *fastReflection_MsgCheckActionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevokeActionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCheckActionResponse)
+		x := input.Message.Interface().(*MsgRevokeActionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5412,10 +5957,6 @@ func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.Status)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5426,7 +5967,7 @@ func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCheckActionResponse)
+		x := input.Message.Interface().(*MsgRevokeActionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5445,13 +5986,6 @@ func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Status) > 0 {
-			i -= len(x.Status)
-			copy(dAtA[i:], x.Status)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5463,7 +5997,7 @@ func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCheckActionResponse)
+		x := input.Message.Interface().(*MsgRevokeActionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5495,44 +6029,12 @@ func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckActionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeActionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Status = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5569,29 +6071,27 @@ func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_MsgVoteForAction             protoreflect.MessageDescriptor
-	fd_MsgVoteForAction_participant protoreflect.FieldDescriptor
-	fd_MsgVoteForAction_action_id   protoreflect.FieldDescriptor
-	fd_MsgVoteForAction_vote_type   protoreflect.FieldDescriptor
+	md_MsgCheckAction           protoreflect.MessageDescriptor
+	fd_MsgCheckAction_creator   protoreflect.FieldDescriptor
+	fd_MsgCheckAction_action_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_tx_proto_init()
-	md_MsgVoteForAction = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgVoteForAction")
-	fd_MsgVoteForAction_participant = md_MsgVoteForAction.Fields().ByName("participant")
-	fd_MsgVoteForAction_action_id = md_MsgVoteForAction.Fields().ByName("action_id")
-	fd_MsgVoteForAction_vote_type = md_MsgVoteForAction.Fields().ByName("vote_type")
+	md_MsgCheckAction = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgCheckAction")
+	fd_MsgCheckAction_creator = md_MsgCheckAction.Fields().ByName("creator")
+	fd_MsgCheckAction_action_id = md_MsgCheckAction.Fields().ByName("action_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgVoteForAction)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCheckAction)(nil)
 
-type fastReflection_MsgVoteForAction MsgVoteForAction
+type fastReflection_MsgCheckAction MsgCheckAction
 
-func (x *MsgVoteForAction) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgVoteForAction)(x)
+func (x *MsgCheckAction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCheckAction)(x)
 }
 
-func (x *MsgVoteForAction) slowProtoReflect() protoreflect.Message {
+func (x *MsgCheckAction) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5603,43 +6103,43 @@ func (x *MsgVoteForAction) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgVoteForAction_messageType fastReflection_MsgVoteForAction_messageType
-var _ protoreflect.MessageType = fastReflection_MsgVoteForAction_messageType{}
+var _fastReflection_MsgCheckAction_messageType fastReflection_MsgCheckAction_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCheckAction_messageType{}
 
-type fastReflection_MsgVoteForAction_messageType struct{}
+type fastReflection_MsgCheckAction_messageType struct{}
 
-func (x fastReflection_MsgVoteForAction_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgVoteForAction)(nil)
+func (x fastReflection_MsgCheckAction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCheckAction)(nil)
 }
-func (x fastReflection_MsgVoteForAction_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgVoteForAction)
+func (x fastReflection_MsgCheckAction_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCheckAction)
 }
-func (x fastReflection_MsgVoteForAction_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVoteForAction
+func (x fastReflection_MsgCheckAction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCheckAction
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgVoteForAction) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVoteForAction
+func (x *fastReflection_MsgCheckAction) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCheckAction
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgVoteForAction) Type() protoreflect.MessageType {
-	return _fastReflection_MsgVoteForAction_messageType
+func (x *fastReflection_MsgCheckAction) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCheckAction_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgVoteForAction) New() protoreflect.Message {
-	return new(fastReflection_MsgVoteForAction)
+func (x *fastReflection_MsgCheckAction) New() protoreflect.Message {
+	return new(fastReflection_MsgCheckAction)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgVoteForAction) Interface() protoreflect.ProtoMessage {
-	return (*MsgVoteForAction)(x)
+func (x *fastReflection_MsgCheckAction) Interface() protoreflect.ProtoMessage {
+	return (*MsgCheckAction)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5647,22 +6147,16 @@ func (x *fastReflection_MsgVoteForAction) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgVoteForAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Participant != "" {
-		value := protoreflect.ValueOfString(x.Participant)
-		if !f(fd_MsgVoteForAction_participant, value) {
+func (x *fastReflection_MsgCheckAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgCheckAction_creator, value) {
 			return
 		}
 	}
 	if x.ActionId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ActionId)
-		if !f(fd_MsgVoteForAction_action_id, value) {
-			return
-		}
-	}
-	if x.VoteType != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.VoteType))
-		if !f(fd_MsgVoteForAction_vote_type, value) {
+		if !f(fd_MsgCheckAction_action_id, value) {
 			return
 		}
 	}
@@ -5679,19 +6173,17 @@ func (x *fastReflection_MsgVoteForAction) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgVoteForAction) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCheckAction) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForAction.participant":
-		return x.Participant != ""
-	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+	case "warden.act.v1beta1.MsgCheckAction.creator":
+		return x.Creator != ""
+	case "warden.act.v1beta1.MsgCheckAction.action_id":
 		return x.ActionId != uint64(0)
-	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
-		return x.VoteType != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5701,19 +6193,17 @@ func (x *fastReflection_MsgVoteForAction) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForAction) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCheckAction) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForAction.participant":
-		x.Participant = ""
-	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+	case "warden.act.v1beta1.MsgCheckAction.creator":
+		x.Creator = ""
+	case "warden.act.v1beta1.MsgCheckAction.action_id":
 		x.ActionId = uint64(0)
-	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
-		x.VoteType = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5723,22 +6213,19 @@ func (x *fastReflection_MsgVoteForAction) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgVoteForAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCheckAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.MsgVoteForAction.participant":
-		value := x.Participant
+	case "warden.act.v1beta1.MsgCheckAction.creator":
+		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+	case "warden.act.v1beta1.MsgCheckAction.action_id":
 		value := x.ActionId
 		return protoreflect.ValueOfUint64(value)
-	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
-		value := x.VoteType
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5752,19 +6239,17 @@ func (x *fastReflection_MsgVoteForAction) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCheckAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForAction.participant":
-		x.Participant = value.Interface().(string)
-	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+	case "warden.act.v1beta1.MsgCheckAction.creator":
+		x.Creator = value.Interface().(string)
+	case "warden.act.v1beta1.MsgCheckAction.action_id":
 		x.ActionId = value.Uint()
-	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
-		x.VoteType = (ActionVoteType)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5778,48 +6263,44 @@ func (x *fastReflection_MsgVoteForAction) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCheckAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForAction.participant":
-		panic(fmt.Errorf("field participant of message warden.act.v1beta1.MsgVoteForAction is not mutable"))
-	case "warden.act.v1beta1.MsgVoteForAction.action_id":
-		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgVoteForAction is not mutable"))
-	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
-		panic(fmt.Errorf("field vote_type of message warden.act.v1beta1.MsgVoteForAction is not mutable"))
+	case "warden.act.v1beta1.MsgCheckAction.creator":
+		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgCheckAction is not mutable"))
+	case "warden.act.v1beta1.MsgCheckAction.action_id":
+		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgCheckAction is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgVoteForAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCheckAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForAction.participant":
+	case "warden.act.v1beta1.MsgCheckAction.creator":
 		return protoreflect.ValueOfString("")
-	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+	case "warden.act.v1beta1.MsgCheckAction.action_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
-		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckAction"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckAction does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgVoteForAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCheckAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgVoteForAction", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgCheckAction", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5827,7 +6308,7 @@ func (x *fastReflection_MsgVoteForAction) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgVoteForAction) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCheckAction) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5838,7 +6319,7 @@ func (x *fastReflection_MsgVoteForAction) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForAction) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCheckAction) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5850,7 +6331,7 @@ func (x *fastReflection_MsgVoteForAction) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgVoteForAction) IsValid() bool {
+func (x *fastReflection_MsgCheckAction) IsValid() bool {
 	return x != nil
 }
 
@@ -5860,9 +6341,9 @@ func (x *fastReflection_MsgVoteForAction) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCheckAction) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgVoteForAction)
+		x := input.Message.Interface().(*MsgCheckAction)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5874,16 +6355,13 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Participant)
+		l = len(x.Creator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.ActionId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ActionId))
 		}
-		if x.VoteType != 0 {
-			n += 1 + runtime.Sov(uint64(x.VoteType))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5894,7 +6372,7 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVoteForAction)
+		x := input.Message.Interface().(*MsgCheckAction)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5913,20 +6391,15 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VoteType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteType))
-			i--
-			dAtA[i] = 0x18
-		}
 		if x.ActionId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActionId))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Participant) > 0 {
-			i -= len(x.Participant)
-			copy(dAtA[i:], x.Participant)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participant)))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5941,7 +6414,7 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVoteForAction)
+		x := input.Message.Interface().(*MsgCheckAction)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5973,15 +6446,15 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForAction: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckAction: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForAction: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckAction: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6009,7 +6482,7 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Participant = string(dAtA[iNdEx:postIndex])
+				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
@@ -6030,25 +6503,6 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
-				}
-				x.VoteType = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.VoteType |= ActionVoteType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6085,25 +6539,25 @@ func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgVoteForActionResponse        protoreflect.MessageDescriptor
-	fd_MsgVoteForActionResponse_status protoreflect.FieldDescriptor
+	md_MsgCheckActionResponse        protoreflect.MessageDescriptor
+	fd_MsgCheckActionResponse_status protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_warden_act_v1beta1_tx_proto_init()
-	md_MsgVoteForActionResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgVoteForActionResponse")
-	fd_MsgVoteForActionResponse_status = md_MsgVoteForActionResponse.Fields().ByName("status")
+	md_MsgCheckActionResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgCheckActionResponse")
+	fd_MsgCheckActionResponse_status = md_MsgCheckActionResponse.Fields().ByName("status")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgVoteForActionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCheckActionResponse)(nil)
 
-type fastReflection_MsgVoteForActionResponse MsgVoteForActionResponse
+type fastReflection_MsgCheckActionResponse MsgCheckActionResponse
 
-func (x *MsgVoteForActionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgVoteForActionResponse)(x)
+func (x *MsgCheckActionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCheckActionResponse)(x)
 }
 
-func (x *MsgVoteForActionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgCheckActionResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6115,43 +6569,43 @@ func (x *MsgVoteForActionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgVoteForActionResponse_messageType fastReflection_MsgVoteForActionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgVoteForActionResponse_messageType{}
+var _fastReflection_MsgCheckActionResponse_messageType fastReflection_MsgCheckActionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCheckActionResponse_messageType{}
 
-type fastReflection_MsgVoteForActionResponse_messageType struct{}
+type fastReflection_MsgCheckActionResponse_messageType struct{}
 
-func (x fastReflection_MsgVoteForActionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgVoteForActionResponse)(nil)
+func (x fastReflection_MsgCheckActionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCheckActionResponse)(nil)
 }
-func (x fastReflection_MsgVoteForActionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgVoteForActionResponse)
+func (x fastReflection_MsgCheckActionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCheckActionResponse)
 }
-func (x fastReflection_MsgVoteForActionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVoteForActionResponse
+func (x fastReflection_MsgCheckActionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCheckActionResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgVoteForActionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVoteForActionResponse
+func (x *fastReflection_MsgCheckActionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCheckActionResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgVoteForActionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgVoteForActionResponse_messageType
+func (x *fastReflection_MsgCheckActionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCheckActionResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgVoteForActionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgVoteForActionResponse)
+func (x *fastReflection_MsgCheckActionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCheckActionResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgVoteForActionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgVoteForActionResponse)(x)
+func (x *fastReflection_MsgCheckActionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCheckActionResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6159,10 +6613,10 @@ func (x *fastReflection_MsgVoteForActionResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgVoteForActionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCheckActionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Status != "" {
 		value := protoreflect.ValueOfString(x.Status)
-		if !f(fd_MsgVoteForActionResponse_status, value) {
+		if !f(fd_MsgCheckActionResponse_status, value) {
 			return
 		}
 	}
@@ -6179,15 +6633,15 @@ func (x *fastReflection_MsgVoteForActionResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgVoteForActionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCheckActionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+	case "warden.act.v1beta1.MsgCheckActionResponse.status":
 		return x.Status != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6197,15 +6651,15 @@ func (x *fastReflection_MsgVoteForActionResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForActionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCheckActionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+	case "warden.act.v1beta1.MsgCheckActionResponse.status":
 		x.Status = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6215,16 +6669,16 @@ func (x *fastReflection_MsgVoteForActionResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgVoteForActionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCheckActionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+	case "warden.act.v1beta1.MsgCheckActionResponse.status":
 		value := x.Status
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6238,15 +6692,15 @@ func (x *fastReflection_MsgVoteForActionResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForActionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCheckActionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+	case "warden.act.v1beta1.MsgCheckActionResponse.status":
 		x.Status = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6260,40 +6714,40 @@ func (x *fastReflection_MsgVoteForActionResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForActionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCheckActionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
-		panic(fmt.Errorf("field status of message warden.act.v1beta1.MsgVoteForActionResponse is not mutable"))
+	case "warden.act.v1beta1.MsgCheckActionResponse.status":
+		panic(fmt.Errorf("field status of message warden.act.v1beta1.MsgCheckActionResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgVoteForActionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCheckActionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+	case "warden.act.v1beta1.MsgCheckActionResponse.status":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgCheckActionResponse"))
 		}
-		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgCheckActionResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgVoteForActionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCheckActionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgVoteForActionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgCheckActionResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6301,7 +6755,7 @@ func (x *fastReflection_MsgVoteForActionResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgVoteForActionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCheckActionResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6312,7 +6766,7 @@ func (x *fastReflection_MsgVoteForActionResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteForActionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCheckActionResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6324,7 +6778,7 @@ func (x *fastReflection_MsgVoteForActionResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgVoteForActionResponse) IsValid() bool {
+func (x *fastReflection_MsgCheckActionResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6334,9 +6788,9 @@ func (x *fastReflection_MsgVoteForActionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgVoteForActionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCheckActionResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgVoteForActionResponse)
+		x := input.Message.Interface().(*MsgCheckActionResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6362,7 +6816,7 @@ func (x *fastReflection_MsgVoteForActionResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVoteForActionResponse)
+		x := input.Message.Interface().(*MsgCheckActionResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6399,7 +6853,7 @@ func (x *fastReflection_MsgVoteForActionResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVoteForActionResponse)
+		x := input.Message.Interface().(*MsgCheckActionResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6431,10 +6885,10 @@ func (x *fastReflection_MsgVoteForActionResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForActionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckActionResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCheckActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6504,603 +6958,6871 @@ func (x *fastReflection_MsgVoteForActionResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: warden/act/v1beta1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgVoteForAction             protoreflect.MessageDescriptor
+	fd_MsgVoteForAction_participant protoreflect.FieldDescriptor
+	fd_MsgVoteForAction_action_id   protoreflect.FieldDescriptor
+	fd_MsgVoteForAction_vote_type   protoreflect.FieldDescriptor
 )
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgVoteForAction = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgVoteForAction")
+	fd_MsgVoteForAction_participant = md_MsgVoteForAction.Fields().ByName("participant")
+	fd_MsgVoteForAction_action_id = md_MsgVoteForAction.Fields().ByName("action_id")
+	fd_MsgVoteForAction_vote_type = md_MsgVoteForAction.Fields().ByName("vote_type")
+}
 
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgVoteForAction)(nil)
+
+type fastReflection_MsgVoteForAction MsgVoteForAction
+
+func (x *MsgVoteForAction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVoteForAction)(x)
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[0]
+func (x *MsgVoteForAction) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgUpdateParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgVoteForAction_messageType fastReflection_MsgVoteForAction_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVoteForAction_messageType{}
+
+type fastReflection_MsgVoteForAction_messageType struct{}
+
+func (x fastReflection_MsgVoteForAction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVoteForAction)(nil)
+}
+func (x fastReflection_MsgVoteForAction_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVoteForAction)
+}
+func (x fastReflection_MsgVoteForAction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVoteForAction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgVoteForAction) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVoteForAction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgVoteForAction) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVoteForAction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgVoteForAction) New() protoreflect.Message {
+	return new(fastReflection_MsgVoteForAction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgVoteForAction) Interface() protoreflect.ProtoMessage {
+	return (*MsgVoteForAction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgVoteForAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Participant != "" {
+		value := protoreflect.ValueOfString(x.Participant)
+		if !f(fd_MsgVoteForAction_participant, value) {
+			return
+		}
+	}
+	if x.ActionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActionId)
+		if !f(fd_MsgVoteForAction_action_id, value) {
+			return
+		}
+	}
+	if x.VoteType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.VoteType))
+		if !f(fd_MsgVoteForAction_vote_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgVoteForAction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForAction.participant":
+		return x.Participant != ""
+	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+		return x.ActionId != uint64(0)
+	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
+		return x.VoteType != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForAction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForAction.participant":
+		x.Participant = ""
+	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+		x.ActionId = uint64(0)
+	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
+		x.VoteType = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgVoteForAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgVoteForAction.participant":
+		value := x.Participant
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+		value := x.ActionId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
+		value := x.VoteType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForAction.participant":
+		x.Participant = value.Interface().(string)
+	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+		x.ActionId = value.Uint()
+	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
+		x.VoteType = (ActionVoteType)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForAction.participant":
+		panic(fmt.Errorf("field participant of message warden.act.v1beta1.MsgVoteForAction is not mutable"))
+	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgVoteForAction is not mutable"))
+	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
+		panic(fmt.Errorf("field vote_type of message warden.act.v1beta1.MsgVoteForAction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgVoteForAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForAction.participant":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgVoteForAction.action_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.MsgVoteForAction.vote_type":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgVoteForAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgVoteForAction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgVoteForAction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForAction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgVoteForAction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgVoteForAction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgVoteForAction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Participant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActionId))
+		}
+		if x.VoteType != 0 {
+			n += 1 + runtime.Sov(uint64(x.VoteType))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgVoteForAction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VoteType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VoteType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ActionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActionId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Participant) > 0 {
+			i -= len(x.Participant)
+			copy(dAtA[i:], x.Participant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Participant)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgVoteForAction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForAction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				}
+				x.ActionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				}
+				x.VoteType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VoteType |= ActionVoteType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgVoteForActionResponse        protoreflect.MessageDescriptor
+	fd_MsgVoteForActionResponse_status protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgVoteForActionResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgVoteForActionResponse")
+	fd_MsgVoteForActionResponse_status = md_MsgVoteForActionResponse.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgVoteForActionResponse)(nil)
+
+type fastReflection_MsgVoteForActionResponse MsgVoteForActionResponse
+
+func (x *MsgVoteForActionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVoteForActionResponse)(x)
+}
+
+func (x *MsgVoteForActionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgVoteForActionResponse_messageType fastReflection_MsgVoteForActionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVoteForActionResponse_messageType{}
+
+type fastReflection_MsgVoteForActionResponse_messageType struct{}
+
+func (x fastReflection_MsgVoteForActionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVoteForActionResponse)(nil)
+}
+func (x fastReflection_MsgVoteForActionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVoteForActionResponse)
+}
+func (x fastReflection_MsgVoteForActionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVoteForActionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgVoteForActionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVoteForActionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgVoteForActionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVoteForActionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgVoteForActionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgVoteForActionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgVoteForActionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgVoteForActionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgVoteForActionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_MsgVoteForActionResponse_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgVoteForActionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+		return x.Status != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForActionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+		x.Status = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgVoteForActionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForActionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+		x.Status = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForActionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+		panic(fmt.Errorf("field status of message warden.act.v1beta1.MsgVoteForActionResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgVoteForActionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgVoteForActionResponse.status":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgVoteForActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgVoteForActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgVoteForActionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgVoteForActionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgVoteForActionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgVoteForActionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgVoteForActionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgVoteForActionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgVoteForActionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgVoteForActionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgVoteForActionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForActionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRetryAction           protoreflect.MessageDescriptor
+	fd_MsgRetryAction_creator   protoreflect.FieldDescriptor
+	fd_MsgRetryAction_action_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgRetryAction = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRetryAction")
+	fd_MsgRetryAction_creator = md_MsgRetryAction.Fields().ByName("creator")
+	fd_MsgRetryAction_action_id = md_MsgRetryAction.Fields().ByName("action_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRetryAction)(nil)
+
+type fastReflection_MsgRetryAction MsgRetryAction
+
+func (x *MsgRetryAction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRetryAction)(x)
+}
+
+func (x *MsgRetryAction) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRetryAction_messageType fastReflection_MsgRetryAction_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRetryAction_messageType{}
+
+type fastReflection_MsgRetryAction_messageType struct{}
+
+func (x fastReflection_MsgRetryAction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRetryAction)(nil)
+}
+func (x fastReflection_MsgRetryAction_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryAction)
+}
+func (x fastReflection_MsgRetryAction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryAction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRetryAction) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryAction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRetryAction) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRetryAction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRetryAction) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryAction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRetryAction) Interface() protoreflect.ProtoMessage {
+	return (*MsgRetryAction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRetryAction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRetryAction_creator, value) {
+			return
+		}
+	}
+	if x.ActionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActionId)
+		if !f(fd_MsgRetryAction_action_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRetryAction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryAction.creator":
+		return x.Creator != ""
+	case "warden.act.v1beta1.MsgRetryAction.action_id":
+		return x.ActionId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryAction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryAction.creator":
+		x.Creator = ""
+	case "warden.act.v1beta1.MsgRetryAction.action_id":
+		x.ActionId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRetryAction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgRetryAction.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgRetryAction.action_id":
+		value := x.ActionId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryAction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryAction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryAction.creator":
+		x.Creator = value.Interface().(string)
+	case "warden.act.v1beta1.MsgRetryAction.action_id":
+		x.ActionId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryAction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryAction.creator":
+		panic(fmt.Errorf("field creator of message warden.act.v1beta1.MsgRetryAction is not mutable"))
+	case "warden.act.v1beta1.MsgRetryAction.action_id":
+		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgRetryAction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRetryAction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryAction.creator":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgRetryAction.action_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryAction"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryAction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRetryAction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRetryAction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRetryAction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryAction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRetryAction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRetryAction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRetryAction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActionId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRetryAction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ActionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActionId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRetryAction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryAction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				}
+				x.ActionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRetryActionResponse        protoreflect.MessageDescriptor
+	fd_MsgRetryActionResponse_status protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgRetryActionResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRetryActionResponse")
+	fd_MsgRetryActionResponse_status = md_MsgRetryActionResponse.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRetryActionResponse)(nil)
+
+type fastReflection_MsgRetryActionResponse MsgRetryActionResponse
+
+func (x *MsgRetryActionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRetryActionResponse)(x)
+}
+
+func (x *MsgRetryActionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRetryActionResponse_messageType fastReflection_MsgRetryActionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRetryActionResponse_messageType{}
+
+type fastReflection_MsgRetryActionResponse_messageType struct{}
+
+func (x fastReflection_MsgRetryActionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRetryActionResponse)(nil)
+}
+func (x fastReflection_MsgRetryActionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryActionResponse)
+}
+func (x fastReflection_MsgRetryActionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryActionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRetryActionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRetryActionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRetryActionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRetryActionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRetryActionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRetryActionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRetryActionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRetryActionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRetryActionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_MsgRetryActionResponse_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRetryActionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryActionResponse.status":
+		return x.Status != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryActionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryActionResponse.status":
+		x.Status = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRetryActionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgRetryActionResponse.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryActionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryActionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryActionResponse.status":
+		x.Status = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryActionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryActionResponse.status":
+		panic(fmt.Errorf("field status of message warden.act.v1beta1.MsgRetryActionResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRetryActionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRetryActionResponse.status":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRetryActionResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRetryActionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRetryActionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRetryActionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRetryActionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRetryActionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRetryActionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRetryActionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRetryActionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRetryActionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRetryActionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryActionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRetryActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitSignedVotes_3_list)(nil)
+
+type _MsgSubmitSignedVotes_3_list struct {
+	list *[]*SignedVote
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignedVote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignedVote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) AppendMutable() protoreflect.Value {
+	v := new(SignedVote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) NewElement() protoreflect.Value {
+	v := new(SignedVote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitSignedVotes_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitSignedVotes           protoreflect.MessageDescriptor
+	fd_MsgSubmitSignedVotes_relayer   protoreflect.FieldDescriptor
+	fd_MsgSubmitSignedVotes_action_id protoreflect.FieldDescriptor
+	fd_MsgSubmitSignedVotes_votes     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgSubmitSignedVotes = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgSubmitSignedVotes")
+	fd_MsgSubmitSignedVotes_relayer = md_MsgSubmitSignedVotes.Fields().ByName("relayer")
+	fd_MsgSubmitSignedVotes_action_id = md_MsgSubmitSignedVotes.Fields().ByName("action_id")
+	fd_MsgSubmitSignedVotes_votes = md_MsgSubmitSignedVotes.Fields().ByName("votes")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSignedVotes)(nil)
+
+type fastReflection_MsgSubmitSignedVotes MsgSubmitSignedVotes
+
+func (x *MsgSubmitSignedVotes) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignedVotes)(x)
+}
+
+func (x *MsgSubmitSignedVotes) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSignedVotes_messageType fastReflection_MsgSubmitSignedVotes_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSignedVotes_messageType{}
+
+type fastReflection_MsgSubmitSignedVotes_messageType struct{}
+
+func (x fastReflection_MsgSubmitSignedVotes_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignedVotes)(nil)
+}
+func (x fastReflection_MsgSubmitSignedVotes_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignedVotes)
+}
+func (x fastReflection_MsgSubmitSignedVotes_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignedVotes
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSignedVotes) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignedVotes
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSignedVotes) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSignedVotes_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSignedVotes) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignedVotes)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSignedVotes) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSignedVotes)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSignedVotes) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Relayer != "" {
+		value := protoreflect.ValueOfString(x.Relayer)
+		if !f(fd_MsgSubmitSignedVotes_relayer, value) {
+			return
+		}
+	}
+	if x.ActionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ActionId)
+		if !f(fd_MsgSubmitSignedVotes_action_id, value) {
+			return
+		}
+	}
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitSignedVotes_3_list{list: &x.Votes})
+		if !f(fd_MsgSubmitSignedVotes_votes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSignedVotes) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.relayer":
+		return x.Relayer != ""
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.action_id":
+		return x.ActionId != uint64(0)
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.votes":
+		return len(x.Votes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotes"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotes) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.relayer":
+		x.Relayer = ""
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.action_id":
+		x.ActionId = uint64(0)
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.votes":
+		x.Votes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotes"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSignedVotes) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.relayer":
+		value := x.Relayer
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.action_id":
+		value := x.ActionId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitSignedVotes_3_list{})
+		}
+		listValue := &_MsgSubmitSignedVotes_3_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotes"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotes does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotes) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.relayer":
+		x.Relayer = value.Interface().(string)
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.action_id":
+		x.ActionId = value.Uint()
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.votes":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitSignedVotes_3_list)
+		x.Votes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotes"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotes) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.votes":
+		if x.Votes == nil {
+			x.Votes = []*SignedVote{}
+		}
+		value := &_MsgSubmitSignedVotes_3_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.relayer":
+		panic(fmt.Errorf("field relayer of message warden.act.v1beta1.MsgSubmitSignedVotes is not mutable"))
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.action_id":
+		panic(fmt.Errorf("field action_id of message warden.act.v1beta1.MsgSubmitSignedVotes is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotes"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSignedVotes) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.relayer":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.action_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.MsgSubmitSignedVotes.votes":
+		list := []*SignedVote{}
+		return protoreflect.ValueOfList(&_MsgSubmitSignedVotes_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotes"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotes does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSignedVotes) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgSubmitSignedVotes", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSignedVotes) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotes) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSignedVotes) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSignedVotes) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSignedVotes)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Relayer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActionId))
+		}
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignedVotes)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.ActionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActionId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Relayer) > 0 {
+			i -= len(x.Relayer)
+			copy(dAtA[i:], x.Relayer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Relayer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignedVotes)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignedVotes: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignedVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Relayer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				}
+				x.ActionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Votes = append(x.Votes, &SignedVote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitSignedVotesResponse        protoreflect.MessageDescriptor
+	fd_MsgSubmitSignedVotesResponse_status protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgSubmitSignedVotesResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgSubmitSignedVotesResponse")
+	fd_MsgSubmitSignedVotesResponse_status = md_MsgSubmitSignedVotesResponse.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSignedVotesResponse)(nil)
+
+type fastReflection_MsgSubmitSignedVotesResponse MsgSubmitSignedVotesResponse
+
+func (x *MsgSubmitSignedVotesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignedVotesResponse)(x)
+}
+
+func (x *MsgSubmitSignedVotesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSignedVotesResponse_messageType fastReflection_MsgSubmitSignedVotesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSignedVotesResponse_messageType{}
+
+type fastReflection_MsgSubmitSignedVotesResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitSignedVotesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSignedVotesResponse)(nil)
+}
+func (x fastReflection_MsgSubmitSignedVotesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignedVotesResponse)
+}
+func (x fastReflection_MsgSubmitSignedVotesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignedVotesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSignedVotesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSignedVotesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSignedVotesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSignedVotesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_MsgSubmitSignedVotesResponse_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotesResponse.status":
+		return x.Status != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotesResponse.status":
+		x.Status = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotesResponse.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotesResponse.status":
+		x.Status = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotesResponse.status":
+		panic(fmt.Errorf("field status of message warden.act.v1beta1.MsgSubmitSignedVotesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSubmitSignedVotesResponse.status":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSubmitSignedVotesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSubmitSignedVotesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgSubmitSignedVotesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSignedVotesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSignedVotesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignedVotesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSignedVotesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignedVotesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSignedVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDelegateVote             protoreflect.MessageDescriptor
+	fd_MsgDelegateVote_delegator   protoreflect.FieldDescriptor
+	fd_MsgDelegateVote_delegate    protoreflect.FieldDescriptor
+	fd_MsgDelegateVote_space_id    protoreflect.FieldDescriptor
+	fd_MsgDelegateVote_template_id protoreflect.FieldDescriptor
+	fd_MsgDelegateVote_expires_at  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgDelegateVote = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgDelegateVote")
+	fd_MsgDelegateVote_delegator = md_MsgDelegateVote.Fields().ByName("delegator")
+	fd_MsgDelegateVote_delegate = md_MsgDelegateVote.Fields().ByName("delegate")
+	fd_MsgDelegateVote_space_id = md_MsgDelegateVote.Fields().ByName("space_id")
+	fd_MsgDelegateVote_template_id = md_MsgDelegateVote.Fields().ByName("template_id")
+	fd_MsgDelegateVote_expires_at = md_MsgDelegateVote.Fields().ByName("expires_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDelegateVote)(nil)
+
+type fastReflection_MsgDelegateVote MsgDelegateVote
+
+func (x *MsgDelegateVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVote)(x)
+}
+
+func (x *MsgDelegateVote) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDelegateVote_messageType fastReflection_MsgDelegateVote_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDelegateVote_messageType{}
+
+type fastReflection_MsgDelegateVote_messageType struct{}
+
+func (x fastReflection_MsgDelegateVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVote)(nil)
+}
+func (x fastReflection_MsgDelegateVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVote)
+}
+func (x fastReflection_MsgDelegateVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDelegateVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDelegateVote) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDelegateVote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDelegateVote) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDelegateVote) Interface() protoreflect.ProtoMessage {
+	return (*MsgDelegateVote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDelegateVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_MsgDelegateVote_delegator, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_MsgDelegateVote_delegate, value) {
+			return
+		}
+	}
+	if x.SpaceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpaceId)
+		if !f(fd_MsgDelegateVote_space_id, value) {
+			return
+		}
+	}
+	if x.TemplateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TemplateId)
+		if !f(fd_MsgDelegateVote_template_id, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+		if !f(fd_MsgDelegateVote_expires_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDelegateVote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVote.delegator":
+		return x.Delegator != ""
+	case "warden.act.v1beta1.MsgDelegateVote.delegate":
+		return x.Delegate != ""
+	case "warden.act.v1beta1.MsgDelegateVote.space_id":
+		return x.SpaceId != uint64(0)
+	case "warden.act.v1beta1.MsgDelegateVote.template_id":
+		return x.TemplateId != uint64(0)
+	case "warden.act.v1beta1.MsgDelegateVote.expires_at":
+		return x.ExpiresAt != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVote"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVote.delegator":
+		x.Delegator = ""
+	case "warden.act.v1beta1.MsgDelegateVote.delegate":
+		x.Delegate = ""
+	case "warden.act.v1beta1.MsgDelegateVote.space_id":
+		x.SpaceId = uint64(0)
+	case "warden.act.v1beta1.MsgDelegateVote.template_id":
+		x.TemplateId = uint64(0)
+	case "warden.act.v1beta1.MsgDelegateVote.expires_at":
+		x.ExpiresAt = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVote"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDelegateVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVote.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgDelegateVote.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgDelegateVote.space_id":
+		value := x.SpaceId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.MsgDelegateVote.template_id":
+		value := x.TemplateId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.MsgDelegateVote.expires_at":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVote"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVote does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVote.delegator":
+		x.Delegator = value.Interface().(string)
+	case "warden.act.v1beta1.MsgDelegateVote.delegate":
+		x.Delegate = value.Interface().(string)
+	case "warden.act.v1beta1.MsgDelegateVote.space_id":
+		x.SpaceId = value.Uint()
+	case "warden.act.v1beta1.MsgDelegateVote.template_id":
+		x.TemplateId = value.Uint()
+	case "warden.act.v1beta1.MsgDelegateVote.expires_at":
+		x.ExpiresAt = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVote"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVote.expires_at":
+		if x.ExpiresAt == nil {
+			x.ExpiresAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiresAt.ProtoReflect())
+	case "warden.act.v1beta1.MsgDelegateVote.delegator":
+		panic(fmt.Errorf("field delegator of message warden.act.v1beta1.MsgDelegateVote is not mutable"))
+	case "warden.act.v1beta1.MsgDelegateVote.delegate":
+		panic(fmt.Errorf("field delegate of message warden.act.v1beta1.MsgDelegateVote is not mutable"))
+	case "warden.act.v1beta1.MsgDelegateVote.space_id":
+		panic(fmt.Errorf("field space_id of message warden.act.v1beta1.MsgDelegateVote is not mutable"))
+	case "warden.act.v1beta1.MsgDelegateVote.template_id":
+		panic(fmt.Errorf("field template_id of message warden.act.v1beta1.MsgDelegateVote is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVote"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDelegateVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVote.delegator":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgDelegateVote.delegate":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgDelegateVote.space_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.MsgDelegateVote.template_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.MsgDelegateVote.expires_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVote"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVote does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDelegateVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgDelegateVote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDelegateVote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDelegateVote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDelegateVote) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDelegateVote)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpaceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpaceId))
+		}
+		if x.TemplateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TemplateId))
+		}
+		if x.ExpiresAt != nil {
+			l = options.Size(x.ExpiresAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDelegateVote)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiresAt != nil {
+			encoded, err := options.Marshal(x.ExpiresAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.TemplateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TemplateId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SpaceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpaceId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDelegateVote)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				}
+				x.SpaceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpaceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TemplateId", wireType)
+				}
+				x.TemplateId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TemplateId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiresAt == nil {
+					x.ExpiresAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiresAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDelegateVoteResponse    protoreflect.MessageDescriptor
+	fd_MsgDelegateVoteResponse_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgDelegateVoteResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgDelegateVoteResponse")
+	fd_MsgDelegateVoteResponse_id = md_MsgDelegateVoteResponse.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDelegateVoteResponse)(nil)
+
+type fastReflection_MsgDelegateVoteResponse MsgDelegateVoteResponse
+
+func (x *MsgDelegateVoteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVoteResponse)(x)
+}
+
+func (x *MsgDelegateVoteResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDelegateVoteResponse_messageType fastReflection_MsgDelegateVoteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDelegateVoteResponse_messageType{}
+
+type fastReflection_MsgDelegateVoteResponse_messageType struct{}
+
+func (x fastReflection_MsgDelegateVoteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDelegateVoteResponse)(nil)
+}
+func (x fastReflection_MsgDelegateVoteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVoteResponse)
+}
+func (x fastReflection_MsgDelegateVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVoteResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDelegateVoteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelegateVoteResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDelegateVoteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDelegateVoteResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDelegateVoteResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDelegateVoteResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDelegateVoteResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDelegateVoteResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDelegateVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgDelegateVoteResponse_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDelegateVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVoteResponse.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVoteResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVoteResponse.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVoteResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDelegateVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVoteResponse.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVoteResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVoteResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVoteResponse.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVoteResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVoteResponse.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.MsgDelegateVoteResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVoteResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDelegateVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgDelegateVoteResponse.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgDelegateVoteResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgDelegateVoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDelegateVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgDelegateVoteResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDelegateVoteResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDelegateVoteResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDelegateVoteResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDelegateVoteResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDelegateVoteResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDelegateVoteResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDelegateVoteResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRevokeVoteDelegation           protoreflect.MessageDescriptor
+	fd_MsgRevokeVoteDelegation_delegator protoreflect.FieldDescriptor
+	fd_MsgRevokeVoteDelegation_id        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgRevokeVoteDelegation = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRevokeVoteDelegation")
+	fd_MsgRevokeVoteDelegation_delegator = md_MsgRevokeVoteDelegation.Fields().ByName("delegator")
+	fd_MsgRevokeVoteDelegation_id = md_MsgRevokeVoteDelegation.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevokeVoteDelegation)(nil)
+
+type fastReflection_MsgRevokeVoteDelegation MsgRevokeVoteDelegation
+
+func (x *MsgRevokeVoteDelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVoteDelegation)(x)
+}
+
+func (x *MsgRevokeVoteDelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevokeVoteDelegation_messageType fastReflection_MsgRevokeVoteDelegation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeVoteDelegation_messageType{}
+
+type fastReflection_MsgRevokeVoteDelegation_messageType struct{}
+
+func (x fastReflection_MsgRevokeVoteDelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVoteDelegation)(nil)
+}
+func (x fastReflection_MsgRevokeVoteDelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVoteDelegation)
+}
+func (x fastReflection_MsgRevokeVoteDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVoteDelegation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevokeVoteDelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVoteDelegation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevokeVoteDelegation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeVoteDelegation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevokeVoteDelegation) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVoteDelegation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevokeVoteDelegation) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeVoteDelegation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevokeVoteDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_MsgRevokeVoteDelegation_delegator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgRevokeVoteDelegation_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevokeVoteDelegation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.delegator":
+		return x.Delegator != ""
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegation"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.delegator":
+		x.Delegator = ""
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegation"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevokeVoteDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegation"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.delegator":
+		x.Delegator = value.Interface().(string)
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegation"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.delegator":
+		panic(fmt.Errorf("field delegator of message warden.act.v1beta1.MsgRevokeVoteDelegation is not mutable"))
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.id":
+		panic(fmt.Errorf("field id of message warden.act.v1beta1.MsgRevokeVoteDelegation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegation"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevokeVoteDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.delegator":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgRevokeVoteDelegation.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegation"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevokeVoteDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRevokeVoteDelegation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevokeVoteDelegation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevokeVoteDelegation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevokeVoteDelegation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevokeVoteDelegation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeVoteDelegation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeVoteDelegation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVoteDelegation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRevokeVoteDelegationResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgRevokeVoteDelegationResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgRevokeVoteDelegationResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevokeVoteDelegationResponse)(nil)
+
+type fastReflection_MsgRevokeVoteDelegationResponse MsgRevokeVoteDelegationResponse
+
+func (x *MsgRevokeVoteDelegationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVoteDelegationResponse)(x)
+}
+
+func (x *MsgRevokeVoteDelegationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevokeVoteDelegationResponse_messageType fastReflection_MsgRevokeVoteDelegationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeVoteDelegationResponse_messageType{}
+
+type fastReflection_MsgRevokeVoteDelegationResponse_messageType struct{}
+
+func (x fastReflection_MsgRevokeVoteDelegationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeVoteDelegationResponse)(nil)
+}
+func (x fastReflection_MsgRevokeVoteDelegationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVoteDelegationResponse)
+}
+func (x fastReflection_MsgRevokeVoteDelegationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVoteDelegationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeVoteDelegationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeVoteDelegationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeVoteDelegationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeVoteDelegationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegationResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegationResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegationResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegationResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegationResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgRevokeVoteDelegationResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgRevokeVoteDelegationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgRevokeVoteDelegationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevokeVoteDelegationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevokeVoteDelegationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeVoteDelegationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeVoteDelegationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetContractTemplates                     protoreflect.MessageDescriptor
+	fd_MsgSetContractTemplates_sender              protoreflect.FieldDescriptor
+	fd_MsgSetContractTemplates_contract            protoreflect.FieldDescriptor
+	fd_MsgSetContractTemplates_approve_template_id protoreflect.FieldDescriptor
+	fd_MsgSetContractTemplates_reject_template_id  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgSetContractTemplates = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgSetContractTemplates")
+	fd_MsgSetContractTemplates_sender = md_MsgSetContractTemplates.Fields().ByName("sender")
+	fd_MsgSetContractTemplates_contract = md_MsgSetContractTemplates.Fields().ByName("contract")
+	fd_MsgSetContractTemplates_approve_template_id = md_MsgSetContractTemplates.Fields().ByName("approve_template_id")
+	fd_MsgSetContractTemplates_reject_template_id = md_MsgSetContractTemplates.Fields().ByName("reject_template_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetContractTemplates)(nil)
+
+type fastReflection_MsgSetContractTemplates MsgSetContractTemplates
+
+func (x *MsgSetContractTemplates) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetContractTemplates)(x)
+}
+
+func (x *MsgSetContractTemplates) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetContractTemplates_messageType fastReflection_MsgSetContractTemplates_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetContractTemplates_messageType{}
+
+type fastReflection_MsgSetContractTemplates_messageType struct{}
+
+func (x fastReflection_MsgSetContractTemplates_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetContractTemplates)(nil)
+}
+func (x fastReflection_MsgSetContractTemplates_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetContractTemplates)
+}
+func (x fastReflection_MsgSetContractTemplates_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetContractTemplates
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetContractTemplates) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetContractTemplates
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetContractTemplates) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetContractTemplates_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetContractTemplates) New() protoreflect.Message {
+	return new(fastReflection_MsgSetContractTemplates)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetContractTemplates) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetContractTemplates)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetContractTemplates) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgSetContractTemplates_sender, value) {
+			return
+		}
+	}
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_MsgSetContractTemplates_contract, value) {
+			return
+		}
+	}
+	if x.ApproveTemplateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ApproveTemplateId)
+		if !f(fd_MsgSetContractTemplates_approve_template_id, value) {
+			return
+		}
+	}
+	if x.RejectTemplateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RejectTemplateId)
+		if !f(fd_MsgSetContractTemplates_reject_template_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetContractTemplates) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSetContractTemplates.sender":
+		return x.Sender != ""
+	case "warden.act.v1beta1.MsgSetContractTemplates.contract":
+		return x.Contract != ""
+	case "warden.act.v1beta1.MsgSetContractTemplates.approve_template_id":
+		return x.ApproveTemplateId != uint64(0)
+	case "warden.act.v1beta1.MsgSetContractTemplates.reject_template_id":
+		return x.RejectTemplateId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplates"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplates) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSetContractTemplates.sender":
+		x.Sender = ""
+	case "warden.act.v1beta1.MsgSetContractTemplates.contract":
+		x.Contract = ""
+	case "warden.act.v1beta1.MsgSetContractTemplates.approve_template_id":
+		x.ApproveTemplateId = uint64(0)
+	case "warden.act.v1beta1.MsgSetContractTemplates.reject_template_id":
+		x.RejectTemplateId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplates"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetContractTemplates) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.act.v1beta1.MsgSetContractTemplates.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgSetContractTemplates.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "warden.act.v1beta1.MsgSetContractTemplates.approve_template_id":
+		value := x.ApproveTemplateId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.act.v1beta1.MsgSetContractTemplates.reject_template_id":
+		value := x.RejectTemplateId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplates"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplates does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplates) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSetContractTemplates.sender":
+		x.Sender = value.Interface().(string)
+	case "warden.act.v1beta1.MsgSetContractTemplates.contract":
+		x.Contract = value.Interface().(string)
+	case "warden.act.v1beta1.MsgSetContractTemplates.approve_template_id":
+		x.ApproveTemplateId = value.Uint()
+	case "warden.act.v1beta1.MsgSetContractTemplates.reject_template_id":
+		x.RejectTemplateId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplates"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplates does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplates) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSetContractTemplates.sender":
+		panic(fmt.Errorf("field sender of message warden.act.v1beta1.MsgSetContractTemplates is not mutable"))
+	case "warden.act.v1beta1.MsgSetContractTemplates.contract":
+		panic(fmt.Errorf("field contract of message warden.act.v1beta1.MsgSetContractTemplates is not mutable"))
+	case "warden.act.v1beta1.MsgSetContractTemplates.approve_template_id":
+		panic(fmt.Errorf("field approve_template_id of message warden.act.v1beta1.MsgSetContractTemplates is not mutable"))
+	case "warden.act.v1beta1.MsgSetContractTemplates.reject_template_id":
+		panic(fmt.Errorf("field reject_template_id of message warden.act.v1beta1.MsgSetContractTemplates is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplates"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplates does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetContractTemplates) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.act.v1beta1.MsgSetContractTemplates.sender":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgSetContractTemplates.contract":
+		return protoreflect.ValueOfString("")
+	case "warden.act.v1beta1.MsgSetContractTemplates.approve_template_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.act.v1beta1.MsgSetContractTemplates.reject_template_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplates"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplates does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetContractTemplates) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgSetContractTemplates", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetContractTemplates) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplates) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetContractTemplates) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetContractTemplates) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetContractTemplates)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ApproveTemplateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApproveTemplateId))
+		}
+		if x.RejectTemplateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RejectTemplateId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetContractTemplates)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RejectTemplateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RejectTemplateId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ApproveTemplateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApproveTemplateId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetContractTemplates)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetContractTemplates: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetContractTemplates: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
+				}
+				x.ApproveTemplateId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ApproveTemplateId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
+				}
+				x.RejectTemplateId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RejectTemplateId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetContractTemplatesResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_warden_act_v1beta1_tx_proto_init()
+	md_MsgSetContractTemplatesResponse = File_warden_act_v1beta1_tx_proto.Messages().ByName("MsgSetContractTemplatesResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetContractTemplatesResponse)(nil)
+
+type fastReflection_MsgSetContractTemplatesResponse MsgSetContractTemplatesResponse
+
+func (x *MsgSetContractTemplatesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetContractTemplatesResponse)(x)
+}
+
+func (x *MsgSetContractTemplatesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_act_v1beta1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetContractTemplatesResponse_messageType fastReflection_MsgSetContractTemplatesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetContractTemplatesResponse_messageType{}
+
+type fastReflection_MsgSetContractTemplatesResponse_messageType struct{}
+
+func (x fastReflection_MsgSetContractTemplatesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetContractTemplatesResponse)(nil)
+}
+func (x fastReflection_MsgSetContractTemplatesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetContractTemplatesResponse)
+}
+func (x fastReflection_MsgSetContractTemplatesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetContractTemplatesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetContractTemplatesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetContractTemplatesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetContractTemplatesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetContractTemplatesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetContractTemplatesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplatesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplatesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetContractTemplatesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.act.v1beta1.MsgSetContractTemplatesResponse"))
+		}
+		panic(fmt.Errorf("message warden.act.v1beta1.MsgSetContractTemplatesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetContractTemplatesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.act.v1beta1.MsgSetContractTemplatesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetContractTemplatesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetContractTemplatesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetContractTemplatesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetContractTemplatesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetContractTemplatesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetContractTemplatesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetContractTemplatesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetContractTemplatesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetContractTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: warden/act/v1beta1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+type MsgNewAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator is the address of the requester of this action.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// message is the arbitrary message to be executed when the action is ready.
+	Message *anypb.Any `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// action_timeout_height is the block height up until this action can be executed.
+	ActionTimeoutHeight uint64 `protobuf:"varint,3,opt,name=action_timeout_height,json=actionTimeoutHeight,proto3" json:"action_timeout_height,omitempty"`
+	// expected_approve_expression is the definition of expected approval expression the action is created with
+	ExpectedApproveExpression string `protobuf:"bytes,4,opt,name=expected_approve_expression,json=expectedApproveExpression,proto3" json:"expected_approve_expression,omitempty"`
+	// expected_reject_expression is the definition of expected reject expression the action is created with
+	ExpectedRejectExpression string `protobuf:"bytes,5,opt,name=expected_reject_expression,json=expectedRejectExpression,proto3" json:"expected_reject_expression,omitempty"`
+	// messages is an ordered list of messages executed atomically when the
+	// action is ready, it can't be used together with message. The expected
+	// expressions are compared against the templates of the messages combined
+	// with `&&` (approve) and `||` (reject), in the order of the messages.
+	// Nested chains of the same operator are flattened before the comparison,
+	// so `a || b || c` matches the templates `a` and `b || c`.
+	Messages []*anypb.Any `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *MsgNewAction) Reset() {
+	*x = MsgNewAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewAction) ProtoMessage() {}
+
+// Deprecated: Use MsgNewAction.ProtoReflect.Descriptor instead.
+func (*MsgNewAction) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgNewAction) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgNewAction) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MsgNewAction) GetActionTimeoutHeight() uint64 {
+	if x != nil {
+		return x.ActionTimeoutHeight
+	}
+	return 0
+}
+
+func (x *MsgNewAction) GetExpectedApproveExpression() string {
+	if x != nil {
+		return x.ExpectedApproveExpression
+	}
+	return ""
+}
+
+func (x *MsgNewAction) GetExpectedRejectExpression() string {
+	if x != nil {
+		return x.ExpectedRejectExpression
+	}
+	return ""
+}
+
+func (x *MsgNewAction) GetMessages() []*anypb.Any {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type MsgNewActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique id of the action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgNewActionResponse) Reset() {
+	*x = MsgNewActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewActionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgNewActionResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewActionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgNewActionResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgNewTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Definition string `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	// execution_delay is the delay between the approval of the Actions using
+	// the template and their execution.
+	ExecutionDelay *durationpb.Duration `protobuf:"bytes,4,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// governance_template_id is the id of the template governing the new
+	// template, 0 if it's not governed.
+	GovernanceTemplateId uint64 `protobuf:"varint,5,opt,name=governance_template_id,json=governanceTemplateId,proto3" json:"governance_template_id,omitempty"`
+}
+
+func (x *MsgNewTemplate) Reset() {
+	*x = MsgNewTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewTemplate) ProtoMessage() {}
+
+// Deprecated: Use MsgNewTemplate.ProtoReflect.Descriptor instead.
+func (*MsgNewTemplate) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgNewTemplate) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgNewTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (x *MsgNewTemplate) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{0}
+func (x *MsgNewTemplate) GetExecutionDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ExecutionDelay
+	}
+	return nil
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgNewTemplate) GetGovernanceTemplateId() uint64 {
 	if x != nil {
-		return x.Authority
+		return x.GovernanceTemplateId
+	}
+	return 0
+}
+
+type MsgNewTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgNewTemplateResponse) Reset() {
+	*x = MsgNewTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewTemplateResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgNewTemplateResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgNewTemplateResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgUpdateTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	// execution_delay is the delay between the approval of the Actions using
+	// the template and their execution. It's only applied if
+	// update_execution_delay is set.
+	ExecutionDelay *durationpb.Duration `protobuf:"bytes,5,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// governance_template_id is the id of the template governing the
+	// template, 0 if it's not governed. It can be the id of the template
+	// itself. It's only applied if update_governance_template_id is set.
+	GovernanceTemplateId uint64 `protobuf:"varint,6,opt,name=governance_template_id,json=governanceTemplateId,proto3" json:"governance_template_id,omitempty"`
+	// update_execution_delay replaces the execution delay of the template
+	// with execution_delay, otherwise the current one is kept.
+	UpdateExecutionDelay bool `protobuf:"varint,7,opt,name=update_execution_delay,json=updateExecutionDelay,proto3" json:"update_execution_delay,omitempty"`
+	// update_governance_template_id replaces the governance template of the
+	// template with governance_template_id, otherwise the current one is
+	// kept.
+	UpdateGovernanceTemplateId bool `protobuf:"varint,8,opt,name=update_governance_template_id,json=updateGovernanceTemplateId,proto3" json:"update_governance_template_id,omitempty"`
+}
+
+func (x *MsgUpdateTemplate) Reset() {
+	*x = MsgUpdateTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateTemplate) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateTemplate.ProtoReflect.Descriptor instead.
+func (*MsgUpdateTemplate) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgUpdateTemplate) GetCreator() string {
+	if x != nil {
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgUpdateTemplate) GetId() uint64 {
 	if x != nil {
-		return x.Params
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgUpdateTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgUpdateTemplate) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *MsgUpdateTemplate) GetExecutionDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ExecutionDelay
 	}
 	return nil
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+func (x *MsgUpdateTemplate) GetGovernanceTemplateId() uint64 {
+	if x != nil {
+		return x.GovernanceTemplateId
+	}
+	return 0
+}
+
+func (x *MsgUpdateTemplate) GetUpdateExecutionDelay() bool {
+	if x != nil {
+		return x.UpdateExecutionDelay
+	}
+	return false
+}
+
+func (x *MsgUpdateTemplate) GetUpdateGovernanceTemplateId() bool {
+	if x != nil {
+		return x.UpdateGovernanceTemplateId
+	}
+	return false
+}
+
+type MsgUpdateTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateTemplateResponse) Reset() {
+	*x = MsgUpdateTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateTemplateResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+type MsgTransferTemplateOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator is the current owner of the template, or the x/act module
+	// account for governed templates.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// new_owner is the address of the new owner of the template.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (x *MsgTransferTemplateOwnership) Reset() {
+	*x = MsgTransferTemplateOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTransferTemplateOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferTemplateOwnership) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferTemplateOwnership.ProtoReflect.Descriptor instead.
+func (*MsgTransferTemplateOwnership) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgTransferTemplateOwnership) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgTransferTemplateOwnership) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgTransferTemplateOwnership) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+type MsgTransferTemplateOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgTransferTemplateOwnershipResponse) Reset() {
+	*x = MsgTransferTemplateOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTransferTemplateOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferTemplateOwnershipResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferTemplateOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*MsgTransferTemplateOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+type MsgRevokeAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (x *MsgRevokeAction) Reset() {
+	*x = MsgRevokeAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevokeAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevokeAction) ProtoMessage() {}
+
+// Deprecated: Use MsgRevokeAction.ProtoReflect.Descriptor instead.
+func (*MsgRevokeAction) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgRevokeAction) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRevokeAction) GetActionId() uint64 {
+	if x != nil {
+		return x.ActionId
+	}
+	return 0
+}
+
+type MsgRevokeActionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgRevokeActionResponse) Reset() {
+	*x = MsgRevokeActionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[1]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgRevokeActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgRevokeActionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgRevokeActionResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevokeActionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-type MsgNewAction struct {
+type MsgCheckAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// creator is the address of the requester of this action.
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	// message is the arbitrary message to be executed when the action is ready.
-	Message *anypb.Any `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// action_timeout_height is the block height up until this action can be executed.
-	ActionTimeoutHeight uint64 `protobuf:"varint,3,opt,name=action_timeout_height,json=actionTimeoutHeight,proto3" json:"action_timeout_height,omitempty"`
-	// expected_approve_expression is the definition of expected approval expression the action is created with
-	ExpectedApproveExpression string `protobuf:"bytes,4,opt,name=expected_approve_expression,json=expectedApproveExpression,proto3" json:"expected_approve_expression,omitempty"`
-	// expected_reject_expression is the definition of expected reject expression the action is created with
-	ExpectedRejectExpression string `protobuf:"bytes,5,opt,name=expected_reject_expression,json=expectedRejectExpression,proto3" json:"expected_reject_expression,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 }
 
-func (x *MsgNewAction) Reset() {
-	*x = MsgNewAction{}
+func (x *MsgCheckAction) Reset() {
+	*x = MsgCheckAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[2]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewAction) String() string {
+func (x *MsgCheckAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewAction) ProtoMessage() {}
+func (*MsgCheckAction) ProtoMessage() {}
 
-// Deprecated: Use MsgNewAction.ProtoReflect.Descriptor instead.
-func (*MsgNewAction) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgCheckAction.ProtoReflect.Descriptor instead.
+func (*MsgCheckAction) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgNewAction) GetCreator() string {
+func (x *MsgCheckAction) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgNewAction) GetMessage() *anypb.Any {
+func (x *MsgCheckAction) GetActionId() uint64 {
 	if x != nil {
-		return x.Message
+		return x.ActionId
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgNewAction) GetActionTimeoutHeight() uint64 {
-	if x != nil {
-		return x.ActionTimeoutHeight
-	}
-	return 0
+type MsgCheckActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *MsgNewAction) GetExpectedApproveExpression() string {
-	if x != nil {
-		return x.ExpectedApproveExpression
+func (x *MsgCheckActionResponse) Reset() {
+	*x = MsgCheckActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MsgNewAction) GetExpectedRejectExpression() string {
+func (x *MsgCheckActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCheckActionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCheckActionResponse.ProtoReflect.Descriptor instead.
+func (*MsgCheckActionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgCheckActionResponse) GetStatus() string {
 	if x != nil {
-		return x.ExpectedRejectExpression
+		return x.Status
 	}
 	return ""
 }
 
-type MsgNewActionResponse struct {
+type MsgVoteForAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the unique id of the action.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Participant string         `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	ActionId    uint64         `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	VoteType    ActionVoteType `protobuf:"varint,3,opt,name=vote_type,json=voteType,proto3,enum=warden.act.v1beta1.ActionVoteType" json:"vote_type,omitempty"`
 }
 
-func (x *MsgNewActionResponse) Reset() {
-	*x = MsgNewActionResponse{}
+func (x *MsgVoteForAction) Reset() {
+	*x = MsgVoteForAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[3]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewActionResponse) String() string {
+func (x *MsgVoteForAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewActionResponse) ProtoMessage() {}
+func (*MsgVoteForAction) ProtoMessage() {}
 
-// Deprecated: Use MsgNewActionResponse.ProtoReflect.Descriptor instead.
-func (*MsgNewActionResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgVoteForAction.ProtoReflect.Descriptor instead.
+func (*MsgVoteForAction) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgNewActionResponse) GetId() uint64 {
+func (x *MsgVoteForAction) GetParticipant() string {
 	if x != nil {
-		return x.Id
+		return x.Participant
+	}
+	return ""
+}
+
+func (x *MsgVoteForAction) GetActionId() uint64 {
+	if x != nil {
+		return x.ActionId
 	}
 	return 0
 }
 
-type MsgNewTemplate struct {
+func (x *MsgVoteForAction) GetVoteType() ActionVoteType {
+	if x != nil {
+		return x.VoteType
+	}
+	return ActionVoteType_VOTE_TYPE_UNSPECIFIED
+}
+
+type MsgVoteForActionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Definition string `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *MsgNewTemplate) Reset() {
-	*x = MsgNewTemplate{}
+func (x *MsgVoteForActionResponse) Reset() {
+	*x = MsgVoteForActionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[4]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewTemplate) String() string {
+func (x *MsgVoteForActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewTemplate) ProtoMessage() {}
+func (*MsgVoteForActionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgNewTemplate.ProtoReflect.Descriptor instead.
-func (*MsgNewTemplate) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgVoteForActionResponse.ProtoReflect.Descriptor instead.
+func (*MsgVoteForActionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MsgNewTemplate) GetCreator() string {
+func (x *MsgVoteForActionResponse) GetStatus() string {
 	if x != nil {
-		return x.Creator
+		return x.Status
 	}
 	return ""
 }
 
-func (x *MsgNewTemplate) GetName() string {
+type MsgRetryAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator is the address of the creator of the action.
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (x *MsgRetryAction) Reset() {
+	*x = MsgRetryAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRetryAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRetryAction) ProtoMessage() {}
+
+// Deprecated: Use MsgRetryAction.ProtoReflect.Descriptor instead.
+func (*MsgRetryAction) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgRetryAction) GetCreator() string {
 	if x != nil {
-		return x.Name
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgNewTemplate) GetDefinition() string {
+func (x *MsgRetryAction) GetActionId() uint64 {
 	if x != nil {
-		return x.Definition
+		return x.ActionId
 	}
-	return ""
+	return 0
 }
 
-type MsgNewTemplateResponse struct {
+type MsgRetryActionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status of the action after the retry, ACTION_STATUS_FAILED if the
+	// execution failed again.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *MsgNewTemplateResponse) Reset() {
-	*x = MsgNewTemplateResponse{}
+func (x *MsgRetryActionResponse) Reset() {
+	*x = MsgRetryActionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[5]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewTemplateResponse) String() string {
+func (x *MsgRetryActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewTemplateResponse) ProtoMessage() {}
+func (*MsgRetryActionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgNewTemplateResponse.ProtoReflect.Descriptor instead.
-func (*MsgNewTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgRetryActionResponse.ProtoReflect.Descriptor instead.
+func (*MsgRetryActionResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgNewTemplateResponse) GetId() uint64 {
+func (x *MsgRetryActionResponse) GetStatus() string {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type MsgUpdateTemplate struct {
+type MsgSubmitSignedVotes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	// relayer is the address submitting the votes, it doesn't need to be one
+	// of the participants.
+	Relayer  string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	// votes signed off-chain by their participants.
+	Votes []*SignedVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func (x *MsgUpdateTemplate) Reset() {
-	*x = MsgUpdateTemplate{}
+func (x *MsgSubmitSignedVotes) Reset() {
+	*x = MsgSubmitSignedVotes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[6]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateTemplate) String() string {
+func (x *MsgSubmitSignedVotes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateTemplate) ProtoMessage() {}
+func (*MsgSubmitSignedVotes) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateTemplate.ProtoReflect.Descriptor instead.
-func (*MsgUpdateTemplate) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgSubmitSignedVotes.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSignedVotes) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgUpdateTemplate) GetCreator() string {
+func (x *MsgSubmitSignedVotes) GetRelayer() string {
 	if x != nil {
-		return x.Creator
+		return x.Relayer
 	}
 	return ""
 }
 
-func (x *MsgUpdateTemplate) GetId() uint64 {
+func (x *MsgSubmitSignedVotes) GetActionId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ActionId
 	}
 	return 0
 }
 
-func (x *MsgUpdateTemplate) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *MsgUpdateTemplate) GetDefinition() string {
+func (x *MsgSubmitSignedVotes) GetVotes() []*SignedVote {
 	if x != nil {
-		return x.Definition
+		return x.Votes
 	}
-	return ""
+	return nil
 }
 
-type MsgUpdateTemplateResponse struct {
+type MsgSubmitSignedVotesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *MsgUpdateTemplateResponse) Reset() {
-	*x = MsgUpdateTemplateResponse{}
+func (x *MsgSubmitSignedVotesResponse) Reset() {
+	*x = MsgSubmitSignedVotesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[7]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateTemplateResponse) String() string {
+func (x *MsgSubmitSignedVotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateTemplateResponse) ProtoMessage() {}
+func (*MsgSubmitSignedVotesResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateTemplateResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgSubmitSignedVotesResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSignedVotesResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-type MsgRevokeAction struct {
+func (x *MsgSubmitSignedVotesResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type MsgDelegateVote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
-}
-
-func (x *MsgRevokeAction) Reset() {
-	*x = MsgRevokeAction{}
+
+	// delegator is the address delegating its votes.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// space_id restricts the delegation to the Actions of a Space, 0 for no
+	// restriction.
+	SpaceId uint64 `protobuf:"varint,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// template_id restricts the delegation to the Actions created with a
+	// Template, 0 for no restriction.
+	TemplateId uint64 `protobuf:"varint,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// expires_at is the time after which the delegation is no longer active,
+	// unset if the delegation doesn't expire.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *MsgDelegateVote) Reset() {
+	*x = MsgDelegateVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[8]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevokeAction) String() string {
+func (x *MsgDelegateVote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevokeAction) ProtoMessage() {}
+func (*MsgDelegateVote) ProtoMessage() {}
 
-// Deprecated: Use MsgRevokeAction.ProtoReflect.Descriptor instead.
-func (*MsgRevokeAction) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgDelegateVote.ProtoReflect.Descriptor instead.
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgRevokeAction) GetCreator() string {
+func (x *MsgDelegateVote) GetDelegator() string {
 	if x != nil {
-		return x.Creator
+		return x.Delegator
 	}
 	return ""
 }
 
-func (x *MsgRevokeAction) GetActionId() uint64 {
+func (x *MsgDelegateVote) GetDelegate() string {
 	if x != nil {
-		return x.ActionId
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *MsgDelegateVote) GetSpaceId() uint64 {
+	if x != nil {
+		return x.SpaceId
 	}
 	return 0
 }
 
-type MsgRevokeActionResponse struct {
+func (x *MsgDelegateVote) GetTemplateId() uint64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *MsgDelegateVote) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type MsgDelegateVoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// id of the delegation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgRevokeActionResponse) Reset() {
-	*x = MsgRevokeActionResponse{}
+func (x *MsgDelegateVoteResponse) Reset() {
+	*x = MsgDelegateVoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[9]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevokeActionResponse) String() string {
+func (x *MsgDelegateVoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevokeActionResponse) ProtoMessage() {}
+func (*MsgDelegateVoteResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRevokeActionResponse.ProtoReflect.Descriptor instead.
-func (*MsgRevokeActionResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgDelegateVoteResponse.ProtoReflect.Descriptor instead.
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{21}
 }
 
-type MsgCheckAction struct {
+func (x *MsgDelegateVoteResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgRevokeVoteDelegation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgCheckAction) Reset() {
-	*x = MsgCheckAction{}
+func (x *MsgRevokeVoteDelegation) Reset() {
+	*x = MsgRevokeVoteDelegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[10]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCheckAction) String() string {
+func (x *MsgRevokeVoteDelegation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCheckAction) ProtoMessage() {}
+func (*MsgRevokeVoteDelegation) ProtoMessage() {}
 
-// Deprecated: Use MsgCheckAction.ProtoReflect.Descriptor instead.
-func (*MsgCheckAction) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgRevokeVoteDelegation.ProtoReflect.Descriptor instead.
+func (*MsgRevokeVoteDelegation) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgCheckAction) GetCreator() string {
+func (x *MsgRevokeVoteDelegation) GetDelegator() string {
 	if x != nil {
-		return x.Creator
+		return x.Delegator
 	}
 	return ""
 }
 
-func (x *MsgCheckAction) GetActionId() uint64 {
+func (x *MsgRevokeVoteDelegation) GetId() uint64 {
 	if x != nil {
-		return x.ActionId
+		return x.Id
 	}
 	return 0
 }
 
-type MsgCheckActionResponse struct {
+type MsgRevokeVoteDelegationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *MsgCheckActionResponse) Reset() {
-	*x = MsgCheckActionResponse{}
+func (x *MsgRevokeVoteDelegationResponse) Reset() {
+	*x = MsgRevokeVoteDelegationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[11]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCheckActionResponse) String() string {
+func (x *MsgRevokeVoteDelegationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCheckActionResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgCheckActionResponse.ProtoReflect.Descriptor instead.
-func (*MsgCheckActionResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{11}
-}
+func (*MsgRevokeVoteDelegationResponse) ProtoMessage() {}
 
-func (x *MsgCheckActionResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use MsgRevokeVoteDelegationResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevokeVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{23}
 }
 
-type MsgVoteForAction struct {
+type MsgSetContractTemplates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Participant string         `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
-	ActionId    uint64         `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
-	VoteType    ActionVoteType `protobuf:"varint,3,opt,name=vote_type,json=voteType,proto3,enum=warden.act.v1beta1.ActionVoteType" json:"vote_type,omitempty"`
+	// sender is the contract itself or its admin.
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// approve_template_id and reject_template_id are the templates of the
+	// Actions executing the contract, both zero to remove them.
+	ApproveTemplateId uint64 `protobuf:"varint,3,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
+	RejectTemplateId  uint64 `protobuf:"varint,4,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
 }
 
-func (x *MsgVoteForAction) Reset() {
-	*x = MsgVoteForAction{}
+func (x *MsgSetContractTemplates) Reset() {
+	*x = MsgSetContractTemplates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[12]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgVoteForAction) String() string {
+func (x *MsgSetContractTemplates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgVoteForAction) ProtoMessage() {}
+func (*MsgSetContractTemplates) ProtoMessage() {}
 
-// Deprecated: Use MsgVoteForAction.ProtoReflect.Descriptor instead.
-func (*MsgVoteForAction) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgSetContractTemplates.ProtoReflect.Descriptor instead.
+func (*MsgSetContractTemplates) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgVoteForAction) GetParticipant() string {
+func (x *MsgSetContractTemplates) GetSender() string {
 	if x != nil {
-		return x.Participant
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *MsgVoteForAction) GetActionId() uint64 {
+func (x *MsgSetContractTemplates) GetContract() string {
 	if x != nil {
-		return x.ActionId
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *MsgSetContractTemplates) GetApproveTemplateId() uint64 {
+	if x != nil {
+		return x.ApproveTemplateId
 	}
 	return 0
 }
 
-func (x *MsgVoteForAction) GetVoteType() ActionVoteType {
+func (x *MsgSetContractTemplates) GetRejectTemplateId() uint64 {
 	if x != nil {
-		return x.VoteType
+		return x.RejectTemplateId
 	}
-	return ActionVoteType_VOTE_TYPE_UNSPECIFIED
+	return 0
 }
 
-type MsgVoteForActionResponse struct {
+type MsgSetContractTemplatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *MsgVoteForActionResponse) Reset() {
-	*x = MsgVoteForActionResponse{}
+func (x *MsgSetContractTemplatesResponse) Reset() {
+	*x = MsgSetContractTemplatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[13]
+		mi := &file_warden_act_v1beta1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgVoteForActionResponse) String() string {
+func (x *MsgSetContractTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgVoteForActionResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgVoteForActionResponse.ProtoReflect.Descriptor instead.
-func (*MsgVoteForActionResponse) Descriptor() ([]byte, []int) {
-	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{13}
-}
+func (*MsgSetContractTemplatesResponse) ProtoMessage() {}
 
-func (x *MsgVoteForActionResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use MsgSetContractTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetContractTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_warden_act_v1beta1_tx_proto_rawDescGZIP(), []int{25}
 }
 
 var File_warden_act_v1beta1_tx_proto protoreflect.FileDescriptor
@@ -7116,153 +13838,302 @@ var file_warden_act_v1beta1_tx_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x14, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x61, 0x73, 0x74, 0x2f, 0x61, 0x73, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
-	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x78, 0x2f, 0x61,
-	0x63, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x02,
-	0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3e, 0x0a, 0x1b,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x1a,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x4e,
-	0x65, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x61, 0x73, 0x74, 0x2f, 0x61, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x78, 0x2f,
+	0x61, 0x63, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xca,
+	0x02, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3e, 0x0a,
+	0x1b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a,
+	0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x0c, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x4d,
+	0x73, 0x67, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
+	0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x6c, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x28,
-	0x0a, 0x16, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x22, 0xfc, 0x02, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
+	0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x41,
+	0x0a, 0x1d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x76,
+	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a,
+	0x1c, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x18, 0x0a,
 	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x26, 0x0a, 0x24,
+	0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x19, 0x0a, 0x17,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x19,
-	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x0e, 0x4d, 0x73, 0x67,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0x30, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x76,
-	0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x10, 0x82, 0xe7, 0xb0, 0x2a, 0x0b, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x4d, 0x73, 0x67,
-	0x56, 0x6f, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xb4, 0x05,
-	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x60, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61,
-	0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2b, 0x2e, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
-	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x28, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
-	0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e,
-	0x65, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5d, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5d, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x1a, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x12, 0x25, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x2d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x30,
+	0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xa4, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x76, 0x6f, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x10, 0x82, 0xe7, 0xb0, 0x2a, 0x0b, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x56, 0x6f,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x55, 0x0a, 0x0e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x30, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56,
+	0x6f, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x36,
+	0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x3a, 0x0e, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x29, 0x0a, 0x17, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a,
+	0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x6f, 0x74, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe4, 0x0a, 0x0a, 0x03, 0x4d,
+	0x73, 0x67, 0x12, 0x60, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
 	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
-	0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x77, 0x61,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x28, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a,
+	0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0b,
+	0x4e, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x77, 0x61,
 	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65,
-	0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x77, 0x61, 0x72, 0x64,
+	0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a,
+	0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x1a, 0x2d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x1a, 0x38, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x63, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x6f, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2c, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56,
+	0x6f, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x1a, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x1a, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64,
 	0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0x2c, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80,
-	0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd8, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42,
-	0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2d, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2f, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x63, 0x74,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x57, 0x41, 0x58, 0xaa, 0x02, 0x12,
-	0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xca, 0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x57, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x33, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x78, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x33, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x61,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a,
+	0x01, 0x42, 0xd8, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x63,
+	0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x63, 0x74, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x57, 0x41, 0x58, 0xaa, 0x02, 0x12, 0x57, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
+	0x02, 0x12, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x41, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x41, 0x63,
+	0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a,
+	0x41, 0x63, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7277,49 +14148,81 @@ func file_warden_act_v1beta1_tx_proto_rawDescGZIP() []byte {
 	return file_warden_act_v1beta1_tx_proto_rawDescData
 }
 
-var file_warden_act_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_warden_act_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_warden_act_v1beta1_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),           // 0: warden.act.v1beta1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),   // 1: warden.act.v1beta1.MsgUpdateParamsResponse
-	(*MsgNewAction)(nil),              // 2: warden.act.v1beta1.MsgNewAction
-	(*MsgNewActionResponse)(nil),      // 3: warden.act.v1beta1.MsgNewActionResponse
-	(*MsgNewTemplate)(nil),            // 4: warden.act.v1beta1.MsgNewTemplate
-	(*MsgNewTemplateResponse)(nil),    // 5: warden.act.v1beta1.MsgNewTemplateResponse
-	(*MsgUpdateTemplate)(nil),         // 6: warden.act.v1beta1.MsgUpdateTemplate
-	(*MsgUpdateTemplateResponse)(nil), // 7: warden.act.v1beta1.MsgUpdateTemplateResponse
-	(*MsgRevokeAction)(nil),           // 8: warden.act.v1beta1.MsgRevokeAction
-	(*MsgRevokeActionResponse)(nil),   // 9: warden.act.v1beta1.MsgRevokeActionResponse
-	(*MsgCheckAction)(nil),            // 10: warden.act.v1beta1.MsgCheckAction
-	(*MsgCheckActionResponse)(nil),    // 11: warden.act.v1beta1.MsgCheckActionResponse
-	(*MsgVoteForAction)(nil),          // 12: warden.act.v1beta1.MsgVoteForAction
-	(*MsgVoteForActionResponse)(nil),  // 13: warden.act.v1beta1.MsgVoteForActionResponse
-	(*Params)(nil),                    // 14: warden.act.v1beta1.Params
-	(*anypb.Any)(nil),                 // 15: google.protobuf.Any
-	(ActionVoteType)(0),               // 16: warden.act.v1beta1.ActionVoteType
+	(*MsgUpdateParams)(nil),                      // 0: warden.act.v1beta1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),              // 1: warden.act.v1beta1.MsgUpdateParamsResponse
+	(*MsgNewAction)(nil),                         // 2: warden.act.v1beta1.MsgNewAction
+	(*MsgNewActionResponse)(nil),                 // 3: warden.act.v1beta1.MsgNewActionResponse
+	(*MsgNewTemplate)(nil),                       // 4: warden.act.v1beta1.MsgNewTemplate
+	(*MsgNewTemplateResponse)(nil),               // 5: warden.act.v1beta1.MsgNewTemplateResponse
+	(*MsgUpdateTemplate)(nil),                    // 6: warden.act.v1beta1.MsgUpdateTemplate
+	(*MsgUpdateTemplateResponse)(nil),            // 7: warden.act.v1beta1.MsgUpdateTemplateResponse
+	(*MsgTransferTemplateOwnership)(nil),         // 8: warden.act.v1beta1.MsgTransferTemplateOwnership
+	(*MsgTransferTemplateOwnershipResponse)(nil), // 9: warden.act.v1beta1.MsgTransferTemplateOwnershipResponse
+	(*MsgRevokeAction)(nil),                      // 10: warden.act.v1beta1.MsgRevokeAction
+	(*MsgRevokeActionResponse)(nil),              // 11: warden.act.v1beta1.MsgRevokeActionResponse
+	(*MsgCheckAction)(nil),                       // 12: warden.act.v1beta1.MsgCheckAction
+	(*MsgCheckActionResponse)(nil),               // 13: warden.act.v1beta1.MsgCheckActionResponse
+	(*MsgVoteForAction)(nil),                     // 14: warden.act.v1beta1.MsgVoteForAction
+	(*MsgVoteForActionResponse)(nil),             // 15: warden.act.v1beta1.MsgVoteForActionResponse
+	(*MsgRetryAction)(nil),                       // 16: warden.act.v1beta1.MsgRetryAction
+	(*MsgRetryActionResponse)(nil),               // 17: warden.act.v1beta1.MsgRetryActionResponse
+	(*MsgSubmitSignedVotes)(nil),                 // 18: warden.act.v1beta1.MsgSubmitSignedVotes
+	(*MsgSubmitSignedVotesResponse)(nil),         // 19: warden.act.v1beta1.MsgSubmitSignedVotesResponse
+	(*MsgDelegateVote)(nil),                      // 20: warden.act.v1beta1.MsgDelegateVote
+	(*MsgDelegateVoteResponse)(nil),              // 21: warden.act.v1beta1.MsgDelegateVoteResponse
+	(*MsgRevokeVoteDelegation)(nil),              // 22: warden.act.v1beta1.MsgRevokeVoteDelegation
+	(*MsgRevokeVoteDelegationResponse)(nil),      // 23: warden.act.v1beta1.MsgRevokeVoteDelegationResponse
+	(*MsgSetContractTemplates)(nil),              // 24: warden.act.v1beta1.MsgSetContractTemplates
+	(*MsgSetContractTemplatesResponse)(nil),      // 25: warden.act.v1beta1.MsgSetContractTemplatesResponse
+	(*Params)(nil),                               // 26: warden.act.v1beta1.Params
+	(*anypb.Any)(nil),                            // 27: google.protobuf.Any
+	(*durationpb.Duration)(nil),                  // 28: google.protobuf.Duration
+	(ActionVoteType)(0),                          // 29: warden.act.v1beta1.ActionVoteType
+	(*SignedVote)(nil),                           // 30: warden.act.v1beta1.SignedVote
+	(*timestamppb.Timestamp)(nil),                // 31: google.protobuf.Timestamp
 }
 var file_warden_act_v1beta1_tx_proto_depIdxs = []int32{
-	14, // 0: warden.act.v1beta1.MsgUpdateParams.params:type_name -> warden.act.v1beta1.Params
-	15, // 1: warden.act.v1beta1.MsgNewAction.message:type_name -> google.protobuf.Any
-	16, // 2: warden.act.v1beta1.MsgVoteForAction.vote_type:type_name -> warden.act.v1beta1.ActionVoteType
-	0,  // 3: warden.act.v1beta1.Msg.UpdateParams:input_type -> warden.act.v1beta1.MsgUpdateParams
-	2,  // 4: warden.act.v1beta1.Msg.NewAction:input_type -> warden.act.v1beta1.MsgNewAction
-	10, // 5: warden.act.v1beta1.Msg.CheckAction:input_type -> warden.act.v1beta1.MsgCheckAction
-	4,  // 6: warden.act.v1beta1.Msg.NewTemplate:input_type -> warden.act.v1beta1.MsgNewTemplate
-	6,  // 7: warden.act.v1beta1.Msg.UpdateTemplate:input_type -> warden.act.v1beta1.MsgUpdateTemplate
-	8,  // 8: warden.act.v1beta1.Msg.RevokeAction:input_type -> warden.act.v1beta1.MsgRevokeAction
-	12, // 9: warden.act.v1beta1.Msg.VoteForAction:input_type -> warden.act.v1beta1.MsgVoteForAction
-	1,  // 10: warden.act.v1beta1.Msg.UpdateParams:output_type -> warden.act.v1beta1.MsgUpdateParamsResponse
-	3,  // 11: warden.act.v1beta1.Msg.NewAction:output_type -> warden.act.v1beta1.MsgNewActionResponse
-	11, // 12: warden.act.v1beta1.Msg.CheckAction:output_type -> warden.act.v1beta1.MsgCheckActionResponse
-	5,  // 13: warden.act.v1beta1.Msg.NewTemplate:output_type -> warden.act.v1beta1.MsgNewTemplateResponse
-	7,  // 14: warden.act.v1beta1.Msg.UpdateTemplate:output_type -> warden.act.v1beta1.MsgUpdateTemplateResponse
-	9,  // 15: warden.act.v1beta1.Msg.RevokeAction:output_type -> warden.act.v1beta1.MsgRevokeActionResponse
-	13, // 16: warden.act.v1beta1.Msg.VoteForAction:output_type -> warden.act.v1beta1.MsgVoteForActionResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	26, // 0: warden.act.v1beta1.MsgUpdateParams.params:type_name -> warden.act.v1beta1.Params
+	27, // 1: warden.act.v1beta1.MsgNewAction.message:type_name -> google.protobuf.Any
+	27, // 2: warden.act.v1beta1.MsgNewAction.messages:type_name -> google.protobuf.Any
+	28, // 3: warden.act.v1beta1.MsgNewTemplate.execution_delay:type_name -> google.protobuf.Duration
+	28, // 4: warden.act.v1beta1.MsgUpdateTemplate.execution_delay:type_name -> google.protobuf.Duration
+	29, // 5: warden.act.v1beta1.MsgVoteForAction.vote_type:type_name -> warden.act.v1beta1.ActionVoteType
+	30, // 6: warden.act.v1beta1.MsgSubmitSignedVotes.votes:type_name -> warden.act.v1beta1.SignedVote
+	31, // 7: warden.act.v1beta1.MsgDelegateVote.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: warden.act.v1beta1.Msg.UpdateParams:input_type -> warden.act.v1beta1.MsgUpdateParams
+	2,  // 9: warden.act.v1beta1.Msg.NewAction:input_type -> warden.act.v1beta1.MsgNewAction
+	12, // 10: warden.act.v1beta1.Msg.CheckAction:input_type -> warden.act.v1beta1.MsgCheckAction
+	4,  // 11: warden.act.v1beta1.Msg.NewTemplate:input_type -> warden.act.v1beta1.MsgNewTemplate
+	6,  // 12: warden.act.v1beta1.Msg.UpdateTemplate:input_type -> warden.act.v1beta1.MsgUpdateTemplate
+	8,  // 13: warden.act.v1beta1.Msg.TransferTemplateOwnership:input_type -> warden.act.v1beta1.MsgTransferTemplateOwnership
+	10, // 14: warden.act.v1beta1.Msg.RevokeAction:input_type -> warden.act.v1beta1.MsgRevokeAction
+	14, // 15: warden.act.v1beta1.Msg.VoteForAction:input_type -> warden.act.v1beta1.MsgVoteForAction
+	16, // 16: warden.act.v1beta1.Msg.RetryAction:input_type -> warden.act.v1beta1.MsgRetryAction
+	18, // 17: warden.act.v1beta1.Msg.SubmitSignedVotes:input_type -> warden.act.v1beta1.MsgSubmitSignedVotes
+	20, // 18: warden.act.v1beta1.Msg.DelegateVote:input_type -> warden.act.v1beta1.MsgDelegateVote
+	22, // 19: warden.act.v1beta1.Msg.RevokeVoteDelegation:input_type -> warden.act.v1beta1.MsgRevokeVoteDelegation
+	24, // 20: warden.act.v1beta1.Msg.SetContractTemplates:input_type -> warden.act.v1beta1.MsgSetContractTemplates
+	1,  // 21: warden.act.v1beta1.Msg.UpdateParams:output_type -> warden.act.v1beta1.MsgUpdateParamsResponse
+	3,  // 22: warden.act.v1beta1.Msg.NewAction:output_type -> warden.act.v1beta1.MsgNewActionResponse
+	13, // 23: warden.act.v1beta1.Msg.CheckAction:output_type -> warden.act.v1beta1.MsgCheckActionResponse
+	5,  // 24: warden.act.v1beta1.Msg.NewTemplate:output_type -> warden.act.v1beta1.MsgNewTemplateResponse
+	7,  // 25: warden.act.v1beta1.Msg.UpdateTemplate:output_type -> warden.act.v1beta1.MsgUpdateTemplateResponse
+	9,  // 26: warden.act.v1beta1.Msg.TransferTemplateOwnership:output_type -> warden.act.v1beta1.MsgTransferTemplateOwnershipResponse
+	11, // 27: warden.act.v1beta1.Msg.RevokeAction:output_type -> warden.act.v1beta1.MsgRevokeActionResponse
+	15, // 28: warden.act.v1beta1.Msg.VoteForAction:output_type -> warden.act.v1beta1.MsgVoteForActionResponse
+	17, // 29: warden.act.v1beta1.Msg.RetryAction:output_type -> warden.act.v1beta1.MsgRetryActionResponse
+	19, // 30: warden.act.v1beta1.Msg.SubmitSignedVotes:output_type -> warden.act.v1beta1.MsgSubmitSignedVotesResponse
+	21, // 31: warden.act.v1beta1.Msg.DelegateVote:output_type -> warden.act.v1beta1.MsgDelegateVoteResponse
+	23, // 32: warden.act.v1beta1.Msg.RevokeVoteDelegation:output_type -> warden.act.v1beta1.MsgRevokeVoteDelegationResponse
+	25, // 33: warden.act.v1beta1.Msg.SetContractTemplates:output_type -> warden.act.v1beta1.MsgSetContractTemplatesResponse
+	21, // [21:34] is the sub-list for method output_type
+	8,  // [8:21] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_warden_act_v1beta1_tx_proto_init() }
@@ -7427,7 +14330,7 @@ func file_warden_act_v1beta1_tx_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevokeAction); i {
+			switch v := v.(*MsgTransferTemplateOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7439,7 +14342,7 @@ func file_warden_act_v1beta1_tx_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevokeActionResponse); i {
+			switch v := v.(*MsgTransferTemplateOwnershipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7451,7 +14354,7 @@ func file_warden_act_v1beta1_tx_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCheckAction); i {
+			switch v := v.(*MsgRevokeAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7463,7 +14366,7 @@ func file_warden_act_v1beta1_tx_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCheckActionResponse); i {
+			switch v := v.(*MsgRevokeActionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7475,7 +14378,7 @@ func file_warden_act_v1beta1_tx_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVoteForAction); i {
+			switch v := v.(*MsgCheckAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7487,6 +14390,30 @@ func file_warden_act_v1beta1_tx_proto_init() {
 			}
 		}
 		file_warden_act_v1beta1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCheckActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgVoteForAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgVoteForActionResponse); i {
 			case 0:
 				return &v.state
@@ -7498,6 +14425,126 @@ func file_warden_act_v1beta1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRetryAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRetryActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitSignedVotes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitSignedVotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDelegateVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDelegateVoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokeVoteDelegation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokeVoteDelegationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetContractTemplates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_act_v1beta1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetContractTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7505,7 +14552,7 @@ func file_warden_act_v1beta1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_warden_act_v1beta1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
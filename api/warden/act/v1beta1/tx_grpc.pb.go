@@ -19,13 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName   = "/warden.act.v1beta1.Msg/UpdateParams"
-	Msg_NewAction_FullMethodName      = "/warden.act.v1beta1.Msg/NewAction"
-	Msg_CheckAction_FullMethodName    = "/warden.act.v1beta1.Msg/CheckAction"
-	Msg_NewTemplate_FullMethodName    = "/warden.act.v1beta1.Msg/NewTemplate"
-	Msg_UpdateTemplate_FullMethodName = "/warden.act.v1beta1.Msg/UpdateTemplate"
-	Msg_RevokeAction_FullMethodName   = "/warden.act.v1beta1.Msg/RevokeAction"
-	Msg_VoteForAction_FullMethodName  = "/warden.act.v1beta1.Msg/VoteForAction"
+	Msg_UpdateParams_FullMethodName              = "/warden.act.v1beta1.Msg/UpdateParams"
+	Msg_NewAction_FullMethodName                 = "/warden.act.v1beta1.Msg/NewAction"
+	Msg_CheckAction_FullMethodName               = "/warden.act.v1beta1.Msg/CheckAction"
+	Msg_NewTemplate_FullMethodName               = "/warden.act.v1beta1.Msg/NewTemplate"
+	Msg_UpdateTemplate_FullMethodName            = "/warden.act.v1beta1.Msg/UpdateTemplate"
+	Msg_TransferTemplateOwnership_FullMethodName = "/warden.act.v1beta1.Msg/TransferTemplateOwnership"
+	Msg_RevokeAction_FullMethodName              = "/warden.act.v1beta1.Msg/RevokeAction"
+	Msg_VoteForAction_FullMethodName             = "/warden.act.v1beta1.Msg/VoteForAction"
+	Msg_RetryAction_FullMethodName               = "/warden.act.v1beta1.Msg/RetryAction"
+	Msg_SubmitSignedVotes_FullMethodName         = "/warden.act.v1beta1.Msg/SubmitSignedVotes"
+	Msg_DelegateVote_FullMethodName              = "/warden.act.v1beta1.Msg/DelegateVote"
+	Msg_RevokeVoteDelegation_FullMethodName      = "/warden.act.v1beta1.Msg/RevokeVoteDelegation"
+	Msg_SetContractTemplates_FullMethodName      = "/warden.act.v1beta1.Msg/SetContractTemplates"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,10 +49,22 @@ type MsgClient interface {
 	NewTemplate(ctx context.Context, in *MsgNewTemplate, opts ...grpc.CallOption) (*MsgNewTemplateResponse, error)
 	// Update an existing act name and definition.
 	UpdateTemplate(ctx context.Context, in *MsgUpdateTemplate, opts ...grpc.CallOption) (*MsgUpdateTemplateResponse, error)
+	// Transfer the ownership of an existing Template.
+	TransferTemplateOwnership(ctx context.Context, in *MsgTransferTemplateOwnership, opts ...grpc.CallOption) (*MsgTransferTemplateOwnershipResponse, error)
 	// Revoke an existing Action while in pending state.
 	RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error)
 	// Vote for or against a particular Action.
 	VoteForAction(ctx context.Context, in *MsgVoteForAction, opts ...grpc.CallOption) (*MsgVoteForActionResponse, error)
+	// Retry the execution of a failed Action.
+	RetryAction(ctx context.Context, in *MsgRetryAction, opts ...grpc.CallOption) (*MsgRetryActionResponse, error)
+	// Submit a batch of votes signed off-chain for a particular Action.
+	SubmitSignedVotes(ctx context.Context, in *MsgSubmitSignedVotes, opts ...grpc.CallOption) (*MsgSubmitSignedVotesResponse, error)
+	// Delegate the votes of an address to another address.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// Revoke a vote delegation.
+	RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error)
+	// Set the templates of the Actions executing a CosmWasm contract.
+	SetContractTemplates(ctx context.Context, in *MsgSetContractTemplates, opts ...grpc.CallOption) (*MsgSetContractTemplatesResponse, error)
 }
 
 type msgClient struct {
@@ -102,6 +120,15 @@ func (c *msgClient) UpdateTemplate(ctx context.Context, in *MsgUpdateTemplate, o
 	return out, nil
 }
 
+func (c *msgClient) TransferTemplateOwnership(ctx context.Context, in *MsgTransferTemplateOwnership, opts ...grpc.CallOption) (*MsgTransferTemplateOwnershipResponse, error) {
+	out := new(MsgTransferTemplateOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTemplateOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error) {
 	out := new(MsgRevokeActionResponse)
 	err := c.cc.Invoke(ctx, Msg_RevokeAction_FullMethodName, in, out, opts...)
@@ -120,6 +147,51 @@ func (c *msgClient) VoteForAction(ctx context.Context, in *MsgVoteForAction, opt
 	return out, nil
 }
 
+func (c *msgClient) RetryAction(ctx context.Context, in *MsgRetryAction, opts ...grpc.CallOption) (*MsgRetryActionResponse, error) {
+	out := new(MsgRetryActionResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitSignedVotes(ctx context.Context, in *MsgSubmitSignedVotes, opts ...grpc.CallOption) (*MsgSubmitSignedVotesResponse, error) {
+	out := new(MsgSubmitSignedVotesResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitSignedVotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error) {
+	out := new(MsgRevokeVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeVoteDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetContractTemplates(ctx context.Context, in *MsgSetContractTemplates, opts ...grpc.CallOption) (*MsgSetContractTemplatesResponse, error) {
+	out := new(MsgSetContractTemplatesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetContractTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -135,10 +207,22 @@ type MsgServer interface {
 	NewTemplate(context.Context, *MsgNewTemplate) (*MsgNewTemplateResponse, error)
 	// Update an existing act name and definition.
 	UpdateTemplate(context.Context, *MsgUpdateTemplate) (*MsgUpdateTemplateResponse, error)
+	// Transfer the ownership of an existing Template.
+	TransferTemplateOwnership(context.Context, *MsgTransferTemplateOwnership) (*MsgTransferTemplateOwnershipResponse, error)
 	// Revoke an existing Action while in pending state.
 	RevokeAction(context.Context, *MsgRevokeAction) (*MsgRevokeActionResponse, error)
 	// Vote for or against a particular Action.
 	VoteForAction(context.Context, *MsgVoteForAction) (*MsgVoteForActionResponse, error)
+	// Retry the execution of a failed Action.
+	RetryAction(context.Context, *MsgRetryAction) (*MsgRetryActionResponse, error)
+	// Submit a batch of votes signed off-chain for a particular Action.
+	SubmitSignedVotes(context.Context, *MsgSubmitSignedVotes) (*MsgSubmitSignedVotesResponse, error)
+	// Delegate the votes of an address to another address.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// Revoke a vote delegation.
+	RevokeVoteDelegation(context.Context, *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error)
+	// Set the templates of the Actions executing a CosmWasm contract.
+	SetContractTemplates(context.Context, *MsgSetContractTemplates) (*MsgSetContractTemplatesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -161,12 +245,30 @@ func (UnimplementedMsgServer) NewTemplate(context.Context, *MsgNewTemplate) (*Ms
 func (UnimplementedMsgServer) UpdateTemplate(context.Context, *MsgUpdateTemplate) (*MsgUpdateTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
 }
+func (UnimplementedMsgServer) TransferTemplateOwnership(context.Context, *MsgTransferTemplateOwnership) (*MsgTransferTemplateOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTemplateOwnership not implemented")
+}
 func (UnimplementedMsgServer) RevokeAction(context.Context, *MsgRevokeAction) (*MsgRevokeActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAction not implemented")
 }
 func (UnimplementedMsgServer) VoteForAction(context.Context, *MsgVoteForAction) (*MsgVoteForActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteForAction not implemented")
 }
+func (UnimplementedMsgServer) RetryAction(context.Context, *MsgRetryAction) (*MsgRetryActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryAction not implemented")
+}
+func (UnimplementedMsgServer) SubmitSignedVotes(context.Context, *MsgSubmitSignedVotes) (*MsgSubmitSignedVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignedVotes not implemented")
+}
+func (UnimplementedMsgServer) DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (UnimplementedMsgServer) RevokeVoteDelegation(context.Context, *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVoteDelegation not implemented")
+}
+func (UnimplementedMsgServer) SetContractTemplates(context.Context, *MsgSetContractTemplates) (*MsgSetContractTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractTemplates not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -270,6 +372,24 @@ func _Msg_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferTemplateOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTemplateOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTemplateOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTemplateOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTemplateOwnership(ctx, req.(*MsgTransferTemplateOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RevokeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRevokeAction)
 	if err := dec(in); err != nil {
@@ -306,6 +426,96 @@ func _Msg_VoteForAction_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetryAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryAction(ctx, req.(*MsgRetryAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSignedVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSignedVotes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSignedVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitSignedVotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSignedVotes(ctx, req.(*MsgSubmitSignedVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeVoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVoteDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeVoteDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, req.(*MsgRevokeVoteDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractTemplates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetContractTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractTemplates(ctx, req.(*MsgSetContractTemplates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -333,6 +543,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTemplate",
 			Handler:    _Msg_UpdateTemplate_Handler,
 		},
+		{
+			MethodName: "TransferTemplateOwnership",
+			Handler:    _Msg_TransferTemplateOwnership_Handler,
+		},
 		{
 			MethodName: "RevokeAction",
 			Handler:    _Msg_RevokeAction_Handler,
@@ -341,6 +555,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VoteForAction",
 			Handler:    _Msg_VoteForAction_Handler,
 		},
+		{
+			MethodName: "RetryAction",
+			Handler:    _Msg_RetryAction_Handler,
+		},
+		{
+			MethodName: "SubmitSignedVotes",
+			Handler:    _Msg_SubmitSignedVotes_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "RevokeVoteDelegation",
+			Handler:    _Msg_RevokeVoteDelegation_Handler,
+		},
+		{
+			MethodName: "SetContractTemplates",
+			Handler:    _Msg_SetContractTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "warden/act/v1beta1/tx.proto",
@@ -1318,6 +1318,474 @@ func (x *fastReflection_EventUpdateSpace) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_EventNewSpaceTreasury          protoreflect.MessageDescriptor
+	fd_EventNewSpaceTreasury_space_id protoreflect.FieldDescriptor
+	fd_EventNewSpaceTreasury_address  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_events_proto_init()
+	md_EventNewSpaceTreasury = File_warden_warden_v1beta3_events_proto.Messages().ByName("EventNewSpaceTreasury")
+	fd_EventNewSpaceTreasury_space_id = md_EventNewSpaceTreasury.Fields().ByName("space_id")
+	fd_EventNewSpaceTreasury_address = md_EventNewSpaceTreasury.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventNewSpaceTreasury)(nil)
+
+type fastReflection_EventNewSpaceTreasury EventNewSpaceTreasury
+
+func (x *EventNewSpaceTreasury) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventNewSpaceTreasury)(x)
+}
+
+func (x *EventNewSpaceTreasury) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventNewSpaceTreasury_messageType fastReflection_EventNewSpaceTreasury_messageType
+var _ protoreflect.MessageType = fastReflection_EventNewSpaceTreasury_messageType{}
+
+type fastReflection_EventNewSpaceTreasury_messageType struct{}
+
+func (x fastReflection_EventNewSpaceTreasury_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventNewSpaceTreasury)(nil)
+}
+func (x fastReflection_EventNewSpaceTreasury_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventNewSpaceTreasury)
+}
+func (x fastReflection_EventNewSpaceTreasury_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNewSpaceTreasury
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventNewSpaceTreasury) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNewSpaceTreasury
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventNewSpaceTreasury) Type() protoreflect.MessageType {
+	return _fastReflection_EventNewSpaceTreasury_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventNewSpaceTreasury) New() protoreflect.Message {
+	return new(fastReflection_EventNewSpaceTreasury)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventNewSpaceTreasury) Interface() protoreflect.ProtoMessage {
+	return (*EventNewSpaceTreasury)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventNewSpaceTreasury) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SpaceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpaceId)
+		if !f(fd_EventNewSpaceTreasury_space_id, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_EventNewSpaceTreasury_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventNewSpaceTreasury) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.space_id":
+		return x.SpaceId != uint64(0)
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.EventNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.EventNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNewSpaceTreasury) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.space_id":
+		x.SpaceId = uint64(0)
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.EventNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.EventNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventNewSpaceTreasury) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.space_id":
+		value := x.SpaceId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.EventNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.EventNewSpaceTreasury does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNewSpaceTreasury) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.space_id":
+		x.SpaceId = value.Uint()
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.EventNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.EventNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNewSpaceTreasury) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.space_id":
+		panic(fmt.Errorf("field space_id of message warden.warden.v1beta3.EventNewSpaceTreasury is not mutable"))
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.address":
+		panic(fmt.Errorf("field address of message warden.warden.v1beta3.EventNewSpaceTreasury is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.EventNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.EventNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventNewSpaceTreasury) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.space_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.EventNewSpaceTreasury.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.EventNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.EventNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventNewSpaceTreasury) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.EventNewSpaceTreasury", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventNewSpaceTreasury) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNewSpaceTreasury) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventNewSpaceTreasury) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventNewSpaceTreasury) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventNewSpaceTreasury)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SpaceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpaceId))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventNewSpaceTreasury)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SpaceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpaceId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventNewSpaceTreasury)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNewSpaceTreasury: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNewSpaceTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				}
+				x.SpaceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpaceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_EventAddSpaceOwner              protoreflect.MessageDescriptor
 	fd_EventAddSpaceOwner_space_id     protoreflect.FieldDescriptor
@@ -1342,7 +1810,7 @@ func (x *EventAddSpaceOwner) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventAddSpaceOwner) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[2]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,7 +2326,7 @@ func (x *EventRemoveSpaceOwner) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRemoveSpaceOwner) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[3]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2382,7 +2850,7 @@ func (x *EventNewKeyRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventNewKeyRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[4]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3088,7 +3556,7 @@ func (x *EventNewKey) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventNewKey) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[5]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3722,7 +4190,7 @@ func (x *EventRejectKeyRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRejectKeyRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[6]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4130,7 +4598,7 @@ func (x *EventUpdateKey) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventUpdateKey) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[7]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4630,7 +5098,7 @@ func (x *EventNewSignRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventNewSignRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[8]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5142,7 +5610,7 @@ func (x *EventFulfilSignRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventFulfilSignRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[9]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5546,7 +6014,7 @@ func (x *EventRejectSignRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRejectSignRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[10]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5954,7 +6422,7 @@ func (x *EventNewKeychain) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventNewKeychain) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[11]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6499,7 +6967,7 @@ func (x *EventUpdateKeychain) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventUpdateKeychain) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[12]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6984,7 +7452,7 @@ func (x *EventAddKeychainWriter) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventAddKeychainWriter) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[13]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7500,7 +7968,7 @@ func (x *EventAddKeychainAdmin) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventAddKeychainAdmin) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[14]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8016,7 +8484,7 @@ func (x *EventRemoveKeychainAdmin) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRemoveKeychainAdmin) slowProtoReflect() protoreflect.Message {
-	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[15]
+	mi := &file_warden_warden_v1beta3_events_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8685,6 +9153,52 @@ func (x *EventUpdateSpace) GetRejectSignTemplateId() uint64 {
 	return 0
 }
 
+// EventNewSpaceTreasury is emitted when the treasury of a Space is created
+type EventNewSpaceTreasury struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the space
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// address of the treasury account
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *EventNewSpaceTreasury) Reset() {
+	*x = EventNewSpaceTreasury{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventNewSpaceTreasury) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventNewSpaceTreasury) ProtoMessage() {}
+
+// Deprecated: Use EventNewSpaceTreasury.ProtoReflect.Descriptor instead.
+func (*EventNewSpaceTreasury) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventNewSpaceTreasury) GetSpaceId() uint64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
+func (x *EventNewSpaceTreasury) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 // EventAddSpaceOwner is emitted when a new owner is added
 type EventAddSpaceOwner struct {
 	state         protoimpl.MessageState
@@ -8702,7 +9216,7 @@ type EventAddSpaceOwner struct {
 func (x *EventAddSpaceOwner) Reset() {
 	*x = EventAddSpaceOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[2]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8716,7 +9230,7 @@ func (*EventAddSpaceOwner) ProtoMessage() {}
 
 // Deprecated: Use EventAddSpaceOwner.ProtoReflect.Descriptor instead.
 func (*EventAddSpaceOwner) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{2}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *EventAddSpaceOwner) GetSpaceId() uint64 {
@@ -8757,7 +9271,7 @@ type EventRemoveSpaceOwner struct {
 func (x *EventRemoveSpaceOwner) Reset() {
 	*x = EventRemoveSpaceOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[3]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8771,7 +9285,7 @@ func (*EventRemoveSpaceOwner) ProtoMessage() {}
 
 // Deprecated: Use EventRemoveSpaceOwner.ProtoReflect.Descriptor instead.
 func (*EventRemoveSpaceOwner) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{3}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EventRemoveSpaceOwner) GetSpaceId() uint64 {
@@ -8820,7 +9334,7 @@ type EventNewKeyRequest struct {
 func (x *EventNewKeyRequest) Reset() {
 	*x = EventNewKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[4]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8834,7 +9348,7 @@ func (*EventNewKeyRequest) ProtoMessage() {}
 
 // Deprecated: Use EventNewKeyRequest.ProtoReflect.Descriptor instead.
 func (*EventNewKeyRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{4}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EventNewKeyRequest) GetId() uint64 {
@@ -8909,7 +9423,7 @@ type EventNewKey struct {
 func (x *EventNewKey) Reset() {
 	*x = EventNewKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[5]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8923,7 +9437,7 @@ func (*EventNewKey) ProtoMessage() {}
 
 // Deprecated: Use EventNewKey.ProtoReflect.Descriptor instead.
 func (*EventNewKey) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{5}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EventNewKey) GetId() uint64 {
@@ -8981,7 +9495,7 @@ type EventRejectKeyRequest struct {
 func (x *EventRejectKeyRequest) Reset() {
 	*x = EventRejectKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[6]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8995,7 +9509,7 @@ func (*EventRejectKeyRequest) ProtoMessage() {}
 
 // Deprecated: Use EventRejectKeyRequest.ProtoReflect.Descriptor instead.
 func (*EventRejectKeyRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{6}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventRejectKeyRequest) GetId() uint64 {
@@ -9022,7 +9536,7 @@ type EventUpdateKey struct {
 func (x *EventUpdateKey) Reset() {
 	*x = EventUpdateKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[7]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9036,7 +9550,7 @@ func (*EventUpdateKey) ProtoMessage() {}
 
 // Deprecated: Use EventUpdateKey.ProtoReflect.Descriptor instead.
 func (*EventUpdateKey) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{7}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EventUpdateKey) GetId() uint64 {
@@ -9077,7 +9591,7 @@ type EventNewSignRequest struct {
 func (x *EventNewSignRequest) Reset() {
 	*x = EventNewSignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[8]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9091,7 +9605,7 @@ func (*EventNewSignRequest) ProtoMessage() {}
 
 // Deprecated: Use EventNewSignRequest.ProtoReflect.Descriptor instead.
 func (*EventNewSignRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{8}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EventNewSignRequest) GetId() uint64 {
@@ -9128,7 +9642,7 @@ type EventFulfilSignRequest struct {
 func (x *EventFulfilSignRequest) Reset() {
 	*x = EventFulfilSignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[9]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9142,7 +9656,7 @@ func (*EventFulfilSignRequest) ProtoMessage() {}
 
 // Deprecated: Use EventFulfilSignRequest.ProtoReflect.Descriptor instead.
 func (*EventFulfilSignRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{9}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EventFulfilSignRequest) GetId() uint64 {
@@ -9165,7 +9679,7 @@ type EventRejectSignRequest struct {
 func (x *EventRejectSignRequest) Reset() {
 	*x = EventRejectSignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[10]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9179,7 +9693,7 @@ func (*EventRejectSignRequest) ProtoMessage() {}
 
 // Deprecated: Use EventRejectSignRequest.ProtoReflect.Descriptor instead.
 func (*EventRejectSignRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{10}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EventRejectSignRequest) GetId() uint64 {
@@ -9206,7 +9720,7 @@ type EventNewKeychain struct {
 func (x *EventNewKeychain) Reset() {
 	*x = EventNewKeychain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[11]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9220,7 +9734,7 @@ func (*EventNewKeychain) ProtoMessage() {}
 
 // Deprecated: Use EventNewKeychain.ProtoReflect.Descriptor instead.
 func (*EventNewKeychain) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{11}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EventNewKeychain) GetId() uint64 {
@@ -9259,7 +9773,7 @@ type EventUpdateKeychain struct {
 func (x *EventUpdateKeychain) Reset() {
 	*x = EventUpdateKeychain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[12]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9273,7 +9787,7 @@ func (*EventUpdateKeychain) ProtoMessage() {}
 
 // Deprecated: Use EventUpdateKeychain.ProtoReflect.Descriptor instead.
 func (*EventUpdateKeychain) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{12}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EventUpdateKeychain) GetId() uint64 {
@@ -9307,7 +9821,7 @@ type EventAddKeychainWriter struct {
 func (x *EventAddKeychainWriter) Reset() {
 	*x = EventAddKeychainWriter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[13]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9321,7 +9835,7 @@ func (*EventAddKeychainWriter) ProtoMessage() {}
 
 // Deprecated: Use EventAddKeychainWriter.ProtoReflect.Descriptor instead.
 func (*EventAddKeychainWriter) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{13}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *EventAddKeychainWriter) GetId() uint64 {
@@ -9362,7 +9876,7 @@ type EventAddKeychainAdmin struct {
 func (x *EventAddKeychainAdmin) Reset() {
 	*x = EventAddKeychainAdmin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[14]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9376,7 +9890,7 @@ func (*EventAddKeychainAdmin) ProtoMessage() {}
 
 // Deprecated: Use EventAddKeychainAdmin.ProtoReflect.Descriptor instead.
 func (*EventAddKeychainAdmin) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{14}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *EventAddKeychainAdmin) GetId() uint64 {
@@ -9417,7 +9931,7 @@ type EventRemoveKeychainAdmin struct {
 func (x *EventRemoveKeychainAdmin) Reset() {
 	*x = EventRemoveKeychainAdmin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[15]
+		mi := &file_warden_warden_v1beta3_events_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9431,7 +9945,7 @@ func (*EventRemoveKeychainAdmin) ProtoMessage() {}
 
 // Deprecated: Use EventRemoveKeychainAdmin.ProtoReflect.Descriptor instead.
 func (*EventRemoveKeychainAdmin) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{15}
+	return file_warden_warden_v1beta3_events_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EventRemoveKeychainAdmin) GetId() uint64 {
@@ -9506,131 +10020,136 @@ var file_warden_warden_v1beta3_events_proto_rawDesc = []byte{
 	0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
 	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
 	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53,
-	0x69, 0x67, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x6f, 0x0a,
-	0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7a,
-	0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x12, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a,
-	0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a,
-	0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x6b,
-	0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
-	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b,
-	0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0xf2, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6b, 0x65, 0x79,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7e,
-	0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x2e, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64,
-	0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x56,
-	0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x28, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53,
-	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x69, 0x67, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a,
+	0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x12, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x15,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x12, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x0d, 0x6b, 0x65, 0x79,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6b, 0x65, 0x79,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x13, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x4e, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e,
-	0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73,
-	0x22, 0x6c, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
-	0x77, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x65, 0x77, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81,
-	0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65,
+	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xf2,
+	0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7e, 0x0a, 0x0e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x13,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28,
+	0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e,
+	0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4b, 0x65,
+	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x6b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x22, 0x6c,
+	0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65,
+	0x77, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a,
+	0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x7d, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4b,
+	0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x05,
 	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e,
-	0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0xf1, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x42,
-	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x33, 0x3b, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x33, 0xa2, 0x02, 0x03, 0x57, 0x57, 0x58, 0xaa, 0x02, 0x15, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x2e, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0xca,
-	0x02, 0x15, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0xe2, 0x02, 0x21, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e,
-	0x5c, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x57, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0xf1, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x42, 0x0b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x33, 0x3b, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0xa2,
+	0x02, 0x03, 0x57, 0x57, 0x58, 0xaa, 0x02, 0x15, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x57,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0xca, 0x02, 0x15,
+	0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x33, 0xe2, 0x02, 0x21, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x57,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x57, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x3a, 0x3a, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9645,32 +10164,33 @@ func file_warden_warden_v1beta3_events_proto_rawDescGZIP() []byte {
 	return file_warden_warden_v1beta3_events_proto_rawDescData
 }
 
-var file_warden_warden_v1beta3_events_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_warden_warden_v1beta3_events_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_warden_warden_v1beta3_events_proto_goTypes = []interface{}{
 	(*EventCreateSpace)(nil),         // 0: warden.warden.v1beta3.EventCreateSpace
 	(*EventUpdateSpace)(nil),         // 1: warden.warden.v1beta3.EventUpdateSpace
-	(*EventAddSpaceOwner)(nil),       // 2: warden.warden.v1beta3.EventAddSpaceOwner
-	(*EventRemoveSpaceOwner)(nil),    // 3: warden.warden.v1beta3.EventRemoveSpaceOwner
-	(*EventNewKeyRequest)(nil),       // 4: warden.warden.v1beta3.EventNewKeyRequest
-	(*EventNewKey)(nil),              // 5: warden.warden.v1beta3.EventNewKey
-	(*EventRejectKeyRequest)(nil),    // 6: warden.warden.v1beta3.EventRejectKeyRequest
-	(*EventUpdateKey)(nil),           // 7: warden.warden.v1beta3.EventUpdateKey
-	(*EventNewSignRequest)(nil),      // 8: warden.warden.v1beta3.EventNewSignRequest
-	(*EventFulfilSignRequest)(nil),   // 9: warden.warden.v1beta3.EventFulfilSignRequest
-	(*EventRejectSignRequest)(nil),   // 10: warden.warden.v1beta3.EventRejectSignRequest
-	(*EventNewKeychain)(nil),         // 11: warden.warden.v1beta3.EventNewKeychain
-	(*EventUpdateKeychain)(nil),      // 12: warden.warden.v1beta3.EventUpdateKeychain
-	(*EventAddKeychainWriter)(nil),   // 13: warden.warden.v1beta3.EventAddKeychainWriter
-	(*EventAddKeychainAdmin)(nil),    // 14: warden.warden.v1beta3.EventAddKeychainAdmin
-	(*EventRemoveKeychainAdmin)(nil), // 15: warden.warden.v1beta3.EventRemoveKeychainAdmin
-	(KeyType)(0),                     // 16: warden.warden.v1beta3.KeyType
-	(*KeychainFees)(nil),             // 17: warden.warden.v1beta3.KeychainFees
+	(*EventNewSpaceTreasury)(nil),    // 2: warden.warden.v1beta3.EventNewSpaceTreasury
+	(*EventAddSpaceOwner)(nil),       // 3: warden.warden.v1beta3.EventAddSpaceOwner
+	(*EventRemoveSpaceOwner)(nil),    // 4: warden.warden.v1beta3.EventRemoveSpaceOwner
+	(*EventNewKeyRequest)(nil),       // 5: warden.warden.v1beta3.EventNewKeyRequest
+	(*EventNewKey)(nil),              // 6: warden.warden.v1beta3.EventNewKey
+	(*EventRejectKeyRequest)(nil),    // 7: warden.warden.v1beta3.EventRejectKeyRequest
+	(*EventUpdateKey)(nil),           // 8: warden.warden.v1beta3.EventUpdateKey
+	(*EventNewSignRequest)(nil),      // 9: warden.warden.v1beta3.EventNewSignRequest
+	(*EventFulfilSignRequest)(nil),   // 10: warden.warden.v1beta3.EventFulfilSignRequest
+	(*EventRejectSignRequest)(nil),   // 11: warden.warden.v1beta3.EventRejectSignRequest
+	(*EventNewKeychain)(nil),         // 12: warden.warden.v1beta3.EventNewKeychain
+	(*EventUpdateKeychain)(nil),      // 13: warden.warden.v1beta3.EventUpdateKeychain
+	(*EventAddKeychainWriter)(nil),   // 14: warden.warden.v1beta3.EventAddKeychainWriter
+	(*EventAddKeychainAdmin)(nil),    // 15: warden.warden.v1beta3.EventAddKeychainAdmin
+	(*EventRemoveKeychainAdmin)(nil), // 16: warden.warden.v1beta3.EventRemoveKeychainAdmin
+	(KeyType)(0),                     // 17: warden.warden.v1beta3.KeyType
+	(*KeychainFees)(nil),             // 18: warden.warden.v1beta3.KeychainFees
 }
 var file_warden_warden_v1beta3_events_proto_depIdxs = []int32{
-	16, // 0: warden.warden.v1beta3.EventNewKeyRequest.key_type:type_name -> warden.warden.v1beta3.KeyType
-	16, // 1: warden.warden.v1beta3.EventNewKey.key_type:type_name -> warden.warden.v1beta3.KeyType
-	17, // 2: warden.warden.v1beta3.EventNewKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
-	17, // 3: warden.warden.v1beta3.EventUpdateKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
+	17, // 0: warden.warden.v1beta3.EventNewKeyRequest.key_type:type_name -> warden.warden.v1beta3.KeyType
+	17, // 1: warden.warden.v1beta3.EventNewKey.key_type:type_name -> warden.warden.v1beta3.KeyType
+	18, // 2: warden.warden.v1beta3.EventNewKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
+	18, // 3: warden.warden.v1beta3.EventUpdateKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
 	4,  // [4:4] is the sub-list for method output_type
 	4,  // [4:4] is the sub-list for method input_type
 	4,  // [4:4] is the sub-list for extension type_name
@@ -9711,7 +10231,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAddSpaceOwner); i {
+			switch v := v.(*EventNewSpaceTreasury); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9723,7 +10243,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRemoveSpaceOwner); i {
+			switch v := v.(*EventAddSpaceOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9735,7 +10255,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventNewKeyRequest); i {
+			switch v := v.(*EventRemoveSpaceOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9747,7 +10267,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventNewKey); i {
+			switch v := v.(*EventNewKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9759,7 +10279,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRejectKeyRequest); i {
+			switch v := v.(*EventNewKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9771,7 +10291,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateKey); i {
+			switch v := v.(*EventRejectKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9783,7 +10303,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventNewSignRequest); i {
+			switch v := v.(*EventUpdateKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9795,7 +10315,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventFulfilSignRequest); i {
+			switch v := v.(*EventNewSignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9807,7 +10327,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRejectSignRequest); i {
+			switch v := v.(*EventFulfilSignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9819,7 +10339,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventNewKeychain); i {
+			switch v := v.(*EventRejectSignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9831,7 +10351,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateKeychain); i {
+			switch v := v.(*EventNewKeychain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9843,7 +10363,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAddKeychainWriter); i {
+			switch v := v.(*EventUpdateKeychain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9855,7 +10375,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAddKeychainAdmin); i {
+			switch v := v.(*EventAddKeychainWriter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9867,6 +10387,18 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			}
 		}
 		file_warden_warden_v1beta3_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAddKeychainAdmin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventRemoveKeychainAdmin); i {
 			case 0:
 				return &v.state
@@ -9885,7 +10417,7 @@ func file_warden_warden_v1beta3_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_warden_warden_v1beta3_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,6 +3,7 @@ package wardenv1beta3
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -68,6 +69,7 @@ var (
 	fd_Space_reject_admin_template_id  protoreflect.FieldDescriptor
 	fd_Space_approve_sign_template_id  protoreflect.FieldDescriptor
 	fd_Space_reject_sign_template_id   protoreflect.FieldDescriptor
+	fd_Space_treasury_address          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -81,6 +83,7 @@ func init() {
 	fd_Space_reject_admin_template_id = md_Space.Fields().ByName("reject_admin_template_id")
 	fd_Space_approve_sign_template_id = md_Space.Fields().ByName("approve_sign_template_id")
 	fd_Space_reject_sign_template_id = md_Space.Fields().ByName("reject_sign_template_id")
+	fd_Space_treasury_address = md_Space.Fields().ByName("treasury_address")
 }
 
 var _ protoreflect.Message = (*fastReflection_Space)(nil)
@@ -196,6 +199,12 @@ func (x *fastReflection_Space) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.TreasuryAddress != "" {
+		value := protoreflect.ValueOfString(x.TreasuryAddress)
+		if !f(fd_Space_treasury_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -227,6 +236,8 @@ func (x *fastReflection_Space) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ApproveSignTemplateId != uint64(0)
 	case "warden.warden.v1beta3.Space.reject_sign_template_id":
 		return x.RejectSignTemplateId != uint64(0)
+	case "warden.warden.v1beta3.Space.treasury_address":
+		return x.TreasuryAddress != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.Space"))
@@ -259,6 +270,8 @@ func (x *fastReflection_Space) Clear(fd protoreflect.FieldDescriptor) {
 		x.ApproveSignTemplateId = uint64(0)
 	case "warden.warden.v1beta3.Space.reject_sign_template_id":
 		x.RejectSignTemplateId = uint64(0)
+	case "warden.warden.v1beta3.Space.treasury_address":
+		x.TreasuryAddress = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.Space"))
@@ -302,6 +315,9 @@ func (x *fastReflection_Space) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "warden.warden.v1beta3.Space.reject_sign_template_id":
 		value := x.RejectSignTemplateId
 		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.Space.treasury_address":
+		value := x.TreasuryAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.Space"))
@@ -340,6 +356,8 @@ func (x *fastReflection_Space) Set(fd protoreflect.FieldDescriptor, value protor
 		x.ApproveSignTemplateId = value.Uint()
 	case "warden.warden.v1beta3.Space.reject_sign_template_id":
 		x.RejectSignTemplateId = value.Uint()
+	case "warden.warden.v1beta3.Space.treasury_address":
+		x.TreasuryAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.Space"))
@@ -380,6 +398,8 @@ func (x *fastReflection_Space) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field approve_sign_template_id of message warden.warden.v1beta3.Space is not mutable"))
 	case "warden.warden.v1beta3.Space.reject_sign_template_id":
 		panic(fmt.Errorf("field reject_sign_template_id of message warden.warden.v1beta3.Space is not mutable"))
+	case "warden.warden.v1beta3.Space.treasury_address":
+		panic(fmt.Errorf("field treasury_address of message warden.warden.v1beta3.Space is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.Space"))
@@ -410,6 +430,8 @@ func (x *fastReflection_Space) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "warden.warden.v1beta3.Space.reject_sign_template_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.Space.treasury_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.Space"))
@@ -507,6 +529,10 @@ func (x *fastReflection_Space) ProtoMethods() *protoiface.Methods {
 		if x.RejectSignTemplateId != 0 {
 			n += 1 + runtime.Sov(uint64(x.RejectSignTemplateId))
 		}
+		l = len(x.TreasuryAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -536,6 +562,13 @@ func (x *fastReflection_Space) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TreasuryAddress) > 0 {
+			i -= len(x.TreasuryAddress)
+			copy(dAtA[i:], x.TreasuryAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TreasuryAddress)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if x.RejectSignTemplateId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.RejectSignTemplateId))
 			i--
@@ -809,6 +842,38 @@ func (x *fastReflection_Space) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -915,6 +980,17 @@ type Space struct {
 	// The default Template is to allow any operation when at least one of its
 	// owner approves it.
 	RejectSignTemplateId uint64 `protobuf:"varint,8,opt,name=reject_sign_template_id,json=rejectSignTemplateId,proto3" json:"reject_sign_template_id,omitempty"`
+	// Address of the treasury account of the space, empty if the space
+	// doesn't have a treasury.
+	//
+	// The treasury address is derived from the x/warden module address and the
+	// ID of the space, so no private key controls it. Funds can only be moved
+	// out of the treasury by Actions evaluated with the *sign* Templates of the
+	// space:
+	// - warden.warden.Msg.TreasurySend
+	// - warden.warden.Msg.TreasuryIBCTransfer
+	// - warden.warden.Msg.TreasuryExecuteContract
+	TreasuryAddress string `protobuf:"bytes,9,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
 }
 
 func (x *Space) Reset() {
@@ -993,33 +1069,46 @@ func (x *Space) GetRejectSignTemplateId() uint64 {
 	return 0
 }
 
+func (x *Space) GetTreasuryAddress() string {
+	if x != nil {
+		return x.TreasuryAddress
+	}
+	return ""
+}
+
 var File_warden_warden_v1beta3_space_proto protoreflect.FileDescriptor
 
 var file_warden_warden_v1beta3_space_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x15, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x22, 0xc3, 0x02, 0x0a, 0x05, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x19,
-	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x16, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64,
-	0x12, 0x37, 0x0a, 0x18, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e,
-	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x15, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x03, 0x0a, 0x05, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x74,
+	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x42, 0xf0, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
 	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x42, 0x0a,
 	0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51, 0x67, 0x69,
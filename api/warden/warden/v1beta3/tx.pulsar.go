@@ -16397,1026 +16397,5853 @@ func (x *fastReflection_MsgFulfilSignRequestResponse) ProtoMethods() *protoiface
 	}
 }
 
+var (
+	md_MsgNewSpaceTreasury           protoreflect.MessageDescriptor
+	fd_MsgNewSpaceTreasury_authority protoreflect.FieldDescriptor
+	fd_MsgNewSpaceTreasury_space_id  protoreflect.FieldDescriptor
+	fd_MsgNewSpaceTreasury_nonce     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgNewSpaceTreasury = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgNewSpaceTreasury")
+	fd_MsgNewSpaceTreasury_authority = md_MsgNewSpaceTreasury.Fields().ByName("authority")
+	fd_MsgNewSpaceTreasury_space_id = md_MsgNewSpaceTreasury.Fields().ByName("space_id")
+	fd_MsgNewSpaceTreasury_nonce = md_MsgNewSpaceTreasury.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgNewSpaceTreasury)(nil)
+
+type fastReflection_MsgNewSpaceTreasury MsgNewSpaceTreasury
+
+func (x *MsgNewSpaceTreasury) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgNewSpaceTreasury)(x)
+}
+
+func (x *MsgNewSpaceTreasury) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgNewSpaceTreasury_messageType fastReflection_MsgNewSpaceTreasury_messageType
+var _ protoreflect.MessageType = fastReflection_MsgNewSpaceTreasury_messageType{}
+
+type fastReflection_MsgNewSpaceTreasury_messageType struct{}
+
+func (x fastReflection_MsgNewSpaceTreasury_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgNewSpaceTreasury)(nil)
+}
+func (x fastReflection_MsgNewSpaceTreasury_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgNewSpaceTreasury)
+}
+func (x fastReflection_MsgNewSpaceTreasury_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgNewSpaceTreasury
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgNewSpaceTreasury) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgNewSpaceTreasury
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgNewSpaceTreasury) Type() protoreflect.MessageType {
+	return _fastReflection_MsgNewSpaceTreasury_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgNewSpaceTreasury) New() protoreflect.Message {
+	return new(fastReflection_MsgNewSpaceTreasury)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgNewSpaceTreasury) Interface() protoreflect.ProtoMessage {
+	return (*MsgNewSpaceTreasury)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgNewSpaceTreasury) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgNewSpaceTreasury_authority, value) {
+			return
+		}
+	}
+	if x.SpaceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpaceId)
+		if !f(fd_MsgNewSpaceTreasury_space_id, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_MsgNewSpaceTreasury_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgNewSpaceTreasury) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.authority":
+		return x.Authority != ""
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.space_id":
+		return x.SpaceId != uint64(0)
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.nonce":
+		return x.Nonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasury) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.authority":
+		x.Authority = ""
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.space_id":
+		x.SpaceId = uint64(0)
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.nonce":
+		x.Nonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgNewSpaceTreasury) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.space_id":
+		value := x.SpaceId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasury does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasury) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.authority":
+		x.Authority = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.space_id":
+		x.SpaceId = value.Uint()
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.nonce":
+		x.Nonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasury) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.authority":
+		panic(fmt.Errorf("field authority of message warden.warden.v1beta3.MsgNewSpaceTreasury is not mutable"))
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.space_id":
+		panic(fmt.Errorf("field space_id of message warden.warden.v1beta3.MsgNewSpaceTreasury is not mutable"))
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.nonce":
+		panic(fmt.Errorf("field nonce of message warden.warden.v1beta3.MsgNewSpaceTreasury is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgNewSpaceTreasury) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.authority":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.space_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.MsgNewSpaceTreasury.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasury"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgNewSpaceTreasury) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgNewSpaceTreasury", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgNewSpaceTreasury) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasury) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgNewSpaceTreasury) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgNewSpaceTreasury) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgNewSpaceTreasury)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpaceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpaceId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgNewSpaceTreasury)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SpaceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpaceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgNewSpaceTreasury)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgNewSpaceTreasury: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgNewSpaceTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				}
+				x.SpaceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpaceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgNewSpaceTreasuryResponse         protoreflect.MessageDescriptor
+	fd_MsgNewSpaceTreasuryResponse_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgNewSpaceTreasuryResponse = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgNewSpaceTreasuryResponse")
+	fd_MsgNewSpaceTreasuryResponse_address = md_MsgNewSpaceTreasuryResponse.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgNewSpaceTreasuryResponse)(nil)
+
+type fastReflection_MsgNewSpaceTreasuryResponse MsgNewSpaceTreasuryResponse
+
+func (x *MsgNewSpaceTreasuryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgNewSpaceTreasuryResponse)(x)
+}
+
+func (x *MsgNewSpaceTreasuryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgNewSpaceTreasuryResponse_messageType fastReflection_MsgNewSpaceTreasuryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgNewSpaceTreasuryResponse_messageType{}
+
+type fastReflection_MsgNewSpaceTreasuryResponse_messageType struct{}
+
+func (x fastReflection_MsgNewSpaceTreasuryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgNewSpaceTreasuryResponse)(nil)
+}
+func (x fastReflection_MsgNewSpaceTreasuryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgNewSpaceTreasuryResponse)
+}
+func (x fastReflection_MsgNewSpaceTreasuryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgNewSpaceTreasuryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgNewSpaceTreasuryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgNewSpaceTreasuryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgNewSpaceTreasuryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgNewSpaceTreasuryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MsgNewSpaceTreasuryResponse_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasuryResponse.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasuryResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasuryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasuryResponse.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasuryResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasuryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasuryResponse.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasuryResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasuryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasuryResponse.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasuryResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasuryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasuryResponse.address":
+		panic(fmt.Errorf("field address of message warden.warden.v1beta3.MsgNewSpaceTreasuryResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasuryResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasuryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgNewSpaceTreasuryResponse.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgNewSpaceTreasuryResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgNewSpaceTreasuryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgNewSpaceTreasuryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgNewSpaceTreasuryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgNewSpaceTreasuryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgNewSpaceTreasuryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgNewSpaceTreasuryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgNewSpaceTreasuryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgNewSpaceTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgTreasurySend_4_list)(nil)
+
+type _MsgTreasurySend_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgTreasurySend_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgTreasurySend_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgTreasurySend_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgTreasurySend_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgTreasurySend_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTreasurySend_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgTreasurySend_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTreasurySend_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgTreasurySend            protoreflect.MessageDescriptor
+	fd_MsgTreasurySend_authority  protoreflect.FieldDescriptor
+	fd_MsgTreasurySend_space_id   protoreflect.FieldDescriptor
+	fd_MsgTreasurySend_to_address protoreflect.FieldDescriptor
+	fd_MsgTreasurySend_amount     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgTreasurySend = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgTreasurySend")
+	fd_MsgTreasurySend_authority = md_MsgTreasurySend.Fields().ByName("authority")
+	fd_MsgTreasurySend_space_id = md_MsgTreasurySend.Fields().ByName("space_id")
+	fd_MsgTreasurySend_to_address = md_MsgTreasurySend.Fields().ByName("to_address")
+	fd_MsgTreasurySend_amount = md_MsgTreasurySend.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTreasurySend)(nil)
+
+type fastReflection_MsgTreasurySend MsgTreasurySend
+
+func (x *MsgTreasurySend) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTreasurySend)(x)
+}
+
+func (x *MsgTreasurySend) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTreasurySend_messageType fastReflection_MsgTreasurySend_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTreasurySend_messageType{}
+
+type fastReflection_MsgTreasurySend_messageType struct{}
+
+func (x fastReflection_MsgTreasurySend_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTreasurySend)(nil)
+}
+func (x fastReflection_MsgTreasurySend_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasurySend)
+}
+func (x fastReflection_MsgTreasurySend_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasurySend
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTreasurySend) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasurySend
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTreasurySend) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTreasurySend_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTreasurySend) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasurySend)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTreasurySend) Interface() protoreflect.ProtoMessage {
+	return (*MsgTreasurySend)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTreasurySend) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgTreasurySend_authority, value) {
+			return
+		}
+	}
+	if x.SpaceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpaceId)
+		if !f(fd_MsgTreasurySend_space_id, value) {
+			return
+		}
+	}
+	if x.ToAddress != "" {
+		value := protoreflect.ValueOfString(x.ToAddress)
+		if !f(fd_MsgTreasurySend_to_address, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgTreasurySend_4_list{list: &x.Amount})
+		if !f(fd_MsgTreasurySend_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTreasurySend) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasurySend.authority":
+		return x.Authority != ""
+	case "warden.warden.v1beta3.MsgTreasurySend.space_id":
+		return x.SpaceId != uint64(0)
+	case "warden.warden.v1beta3.MsgTreasurySend.to_address":
+		return x.ToAddress != ""
+	case "warden.warden.v1beta3.MsgTreasurySend.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySend"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySend) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasurySend.authority":
+		x.Authority = ""
+	case "warden.warden.v1beta3.MsgTreasurySend.space_id":
+		x.SpaceId = uint64(0)
+	case "warden.warden.v1beta3.MsgTreasurySend.to_address":
+		x.ToAddress = ""
+	case "warden.warden.v1beta3.MsgTreasurySend.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySend"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTreasurySend) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.MsgTreasurySend.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasurySend.space_id":
+		value := x.SpaceId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.MsgTreasurySend.to_address":
+		value := x.ToAddress
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasurySend.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgTreasurySend_4_list{})
+		}
+		listValue := &_MsgTreasurySend_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySend"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySend does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySend) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasurySend.authority":
+		x.Authority = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasurySend.space_id":
+		x.SpaceId = value.Uint()
+	case "warden.warden.v1beta3.MsgTreasurySend.to_address":
+		x.ToAddress = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasurySend.amount":
+		lv := value.List()
+		clv := lv.(*_MsgTreasurySend_4_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySend"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySend) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasurySend.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgTreasurySend_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "warden.warden.v1beta3.MsgTreasurySend.authority":
+		panic(fmt.Errorf("field authority of message warden.warden.v1beta3.MsgTreasurySend is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasurySend.space_id":
+		panic(fmt.Errorf("field space_id of message warden.warden.v1beta3.MsgTreasurySend is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasurySend.to_address":
+		panic(fmt.Errorf("field to_address of message warden.warden.v1beta3.MsgTreasurySend is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySend"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySend does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTreasurySend) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasurySend.authority":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasurySend.space_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.MsgTreasurySend.to_address":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasurySend.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgTreasurySend_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySend"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySend does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTreasurySend) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgTreasurySend", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTreasurySend) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySend) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTreasurySend) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTreasurySend) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTreasurySend)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpaceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpaceId))
+		}
+		l = len(x.ToAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasurySend)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ToAddress) > 0 {
+			i -= len(x.ToAddress)
+			copy(dAtA[i:], x.ToAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SpaceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpaceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasurySend)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasurySend: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasurySend: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				}
+				x.SpaceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpaceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTreasurySendResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgTreasurySendResponse = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgTreasurySendResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTreasurySendResponse)(nil)
+
+type fastReflection_MsgTreasurySendResponse MsgTreasurySendResponse
+
+func (x *MsgTreasurySendResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTreasurySendResponse)(x)
+}
+
+func (x *MsgTreasurySendResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTreasurySendResponse_messageType fastReflection_MsgTreasurySendResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTreasurySendResponse_messageType{}
+
+type fastReflection_MsgTreasurySendResponse_messageType struct{}
+
+func (x fastReflection_MsgTreasurySendResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTreasurySendResponse)(nil)
+}
+func (x fastReflection_MsgTreasurySendResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasurySendResponse)
+}
+func (x fastReflection_MsgTreasurySendResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasurySendResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTreasurySendResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasurySendResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTreasurySendResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTreasurySendResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTreasurySendResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasurySendResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTreasurySendResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTreasurySendResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTreasurySendResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTreasurySendResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySendResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySendResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySendResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTreasurySendResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySendResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySendResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySendResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySendResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySendResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySendResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTreasurySendResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasurySendResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasurySendResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTreasurySendResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgTreasurySendResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTreasurySendResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasurySendResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTreasurySendResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTreasurySendResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTreasurySendResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasurySendResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasurySendResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasurySendResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasurySendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTreasuryIBCTransfer                         protoreflect.MessageDescriptor
+	fd_MsgTreasuryIBCTransfer_authority               protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_space_id                protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_source_port             protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_source_channel          protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_token                   protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_receiver                protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_timeout_revision_number protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_timeout_revision_height protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_timeout_timestamp       protoreflect.FieldDescriptor
+	fd_MsgTreasuryIBCTransfer_memo                    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgTreasuryIBCTransfer = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgTreasuryIBCTransfer")
+	fd_MsgTreasuryIBCTransfer_authority = md_MsgTreasuryIBCTransfer.Fields().ByName("authority")
+	fd_MsgTreasuryIBCTransfer_space_id = md_MsgTreasuryIBCTransfer.Fields().ByName("space_id")
+	fd_MsgTreasuryIBCTransfer_source_port = md_MsgTreasuryIBCTransfer.Fields().ByName("source_port")
+	fd_MsgTreasuryIBCTransfer_source_channel = md_MsgTreasuryIBCTransfer.Fields().ByName("source_channel")
+	fd_MsgTreasuryIBCTransfer_token = md_MsgTreasuryIBCTransfer.Fields().ByName("token")
+	fd_MsgTreasuryIBCTransfer_receiver = md_MsgTreasuryIBCTransfer.Fields().ByName("receiver")
+	fd_MsgTreasuryIBCTransfer_timeout_revision_number = md_MsgTreasuryIBCTransfer.Fields().ByName("timeout_revision_number")
+	fd_MsgTreasuryIBCTransfer_timeout_revision_height = md_MsgTreasuryIBCTransfer.Fields().ByName("timeout_revision_height")
+	fd_MsgTreasuryIBCTransfer_timeout_timestamp = md_MsgTreasuryIBCTransfer.Fields().ByName("timeout_timestamp")
+	fd_MsgTreasuryIBCTransfer_memo = md_MsgTreasuryIBCTransfer.Fields().ByName("memo")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTreasuryIBCTransfer)(nil)
+
+type fastReflection_MsgTreasuryIBCTransfer MsgTreasuryIBCTransfer
+
+func (x *MsgTreasuryIBCTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryIBCTransfer)(x)
+}
+
+func (x *MsgTreasuryIBCTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTreasuryIBCTransfer_messageType fastReflection_MsgTreasuryIBCTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTreasuryIBCTransfer_messageType{}
+
+type fastReflection_MsgTreasuryIBCTransfer_messageType struct{}
+
+func (x fastReflection_MsgTreasuryIBCTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryIBCTransfer)(nil)
+}
+func (x fastReflection_MsgTreasuryIBCTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryIBCTransfer)
+}
+func (x fastReflection_MsgTreasuryIBCTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryIBCTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryIBCTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTreasuryIBCTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTreasuryIBCTransfer) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryIBCTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Interface() protoreflect.ProtoMessage {
+	return (*MsgTreasuryIBCTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgTreasuryIBCTransfer_authority, value) {
+			return
+		}
+	}
+	if x.SpaceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpaceId)
+		if !f(fd_MsgTreasuryIBCTransfer_space_id, value) {
+			return
+		}
+	}
+	if x.SourcePort != "" {
+		value := protoreflect.ValueOfString(x.SourcePort)
+		if !f(fd_MsgTreasuryIBCTransfer_source_port, value) {
+			return
+		}
+	}
+	if x.SourceChannel != "" {
+		value := protoreflect.ValueOfString(x.SourceChannel)
+		if !f(fd_MsgTreasuryIBCTransfer_source_channel, value) {
+			return
+		}
+	}
+	if x.Token != nil {
+		value := protoreflect.ValueOfMessage(x.Token.ProtoReflect())
+		if !f(fd_MsgTreasuryIBCTransfer_token, value) {
+			return
+		}
+	}
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_MsgTreasuryIBCTransfer_receiver, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionNumber)
+		if !f(fd_MsgTreasuryIBCTransfer_timeout_revision_number, value) {
+			return
+		}
+	}
+	if x.TimeoutRevisionHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutRevisionHeight)
+		if !f(fd_MsgTreasuryIBCTransfer_timeout_revision_height, value) {
+			return
+		}
+	}
+	if x.TimeoutTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutTimestamp)
+		if !f(fd_MsgTreasuryIBCTransfer_timeout_timestamp, value) {
+			return
+		}
+	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_MsgTreasuryIBCTransfer_memo, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.authority":
+		return x.Authority != ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.space_id":
+		return x.SpaceId != uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_port":
+		return x.SourcePort != ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_channel":
+		return x.SourceChannel != ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.token":
+		return x.Token != nil
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.receiver":
+		return x.Receiver != ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_number":
+		return x.TimeoutRevisionNumber != uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_height":
+		return x.TimeoutRevisionHeight != uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_timestamp":
+		return x.TimeoutTimestamp != uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.memo":
+		return x.Memo != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransfer"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.authority":
+		x.Authority = ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.space_id":
+		x.SpaceId = uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_port":
+		x.SourcePort = ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_channel":
+		x.SourceChannel = ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.token":
+		x.Token = nil
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.receiver":
+		x.Receiver = ""
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_number":
+		x.TimeoutRevisionNumber = uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_height":
+		x.TimeoutRevisionHeight = uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_timestamp":
+		x.TimeoutTimestamp = uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.memo":
+		x.Memo = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransfer"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.space_id":
+		value := x.SpaceId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_port":
+		value := x.SourcePort
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_channel":
+		value := x.SourceChannel
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.token":
+		value := x.Token
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_number":
+		value := x.TimeoutRevisionNumber
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_height":
+		value := x.TimeoutRevisionHeight
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_timestamp":
+		value := x.TimeoutTimestamp
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransfer"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.authority":
+		x.Authority = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.space_id":
+		x.SpaceId = value.Uint()
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_port":
+		x.SourcePort = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_channel":
+		x.SourceChannel = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.token":
+		x.Token = value.Message().Interface().(*v1beta1.Coin)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.receiver":
+		x.Receiver = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_number":
+		x.TimeoutRevisionNumber = value.Uint()
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_height":
+		x.TimeoutRevisionHeight = value.Uint()
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_timestamp":
+		x.TimeoutTimestamp = value.Uint()
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.memo":
+		x.Memo = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransfer"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.token":
+		if x.Token == nil {
+			x.Token = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Token.ProtoReflect())
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.authority":
+		panic(fmt.Errorf("field authority of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.space_id":
+		panic(fmt.Errorf("field space_id of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_port":
+		panic(fmt.Errorf("field source_port of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_channel":
+		panic(fmt.Errorf("field source_channel of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.receiver":
+		panic(fmt.Errorf("field receiver of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_number":
+		panic(fmt.Errorf("field timeout_revision_number of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_height":
+		panic(fmt.Errorf("field timeout_revision_height of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_timestamp":
+		panic(fmt.Errorf("field timeout_timestamp of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.memo":
+		panic(fmt.Errorf("field memo of message warden.warden.v1beta3.MsgTreasuryIBCTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransfer"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTreasuryIBCTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.authority":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.space_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_port":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.source_channel":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.token":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.receiver":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_revision_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.timeout_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransfer.memo":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransfer"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTreasuryIBCTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgTreasuryIBCTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTreasuryIBCTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTreasuryIBCTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTreasuryIBCTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTreasuryIBCTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpaceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpaceId))
+		}
+		l = len(x.SourcePort)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Token != nil {
+			l = options.Size(x.Token)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionNumber))
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutRevisionHeight))
+		}
+		if x.TimeoutTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutTimestamp))
+		}
+		l = len(x.Memo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryIBCTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.TimeoutTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutTimestamp))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.TimeoutRevisionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.TimeoutRevisionNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutRevisionNumber))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Token != nil {
+			encoded, err := options.Marshal(x.Token)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SourceChannel) > 0 {
+			i -= len(x.SourceChannel)
+			copy(dAtA[i:], x.SourceChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceChannel)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SourcePort) > 0 {
+			i -= len(x.SourcePort)
+			copy(dAtA[i:], x.SourcePort)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourcePort)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SpaceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpaceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryIBCTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryIBCTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryIBCTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				}
+				x.SpaceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpaceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourcePort = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Token == nil {
+					x.Token = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Token); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
+				}
+				x.TimeoutRevisionNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
+				}
+				x.TimeoutRevisionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				}
+				x.TimeoutTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeoutTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Memo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTreasuryIBCTransferResponse          protoreflect.MessageDescriptor
+	fd_MsgTreasuryIBCTransferResponse_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgTreasuryIBCTransferResponse = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgTreasuryIBCTransferResponse")
+	fd_MsgTreasuryIBCTransferResponse_sequence = md_MsgTreasuryIBCTransferResponse.Fields().ByName("sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTreasuryIBCTransferResponse)(nil)
+
+type fastReflection_MsgTreasuryIBCTransferResponse MsgTreasuryIBCTransferResponse
+
+func (x *MsgTreasuryIBCTransferResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryIBCTransferResponse)(x)
+}
+
+func (x *MsgTreasuryIBCTransferResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTreasuryIBCTransferResponse_messageType fastReflection_MsgTreasuryIBCTransferResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTreasuryIBCTransferResponse_messageType{}
+
+type fastReflection_MsgTreasuryIBCTransferResponse_messageType struct{}
+
+func (x fastReflection_MsgTreasuryIBCTransferResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryIBCTransferResponse)(nil)
+}
+func (x fastReflection_MsgTreasuryIBCTransferResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryIBCTransferResponse)
+}
+func (x fastReflection_MsgTreasuryIBCTransferResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryIBCTransferResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryIBCTransferResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTreasuryIBCTransferResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryIBCTransferResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTreasuryIBCTransferResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_MsgTreasuryIBCTransferResponse_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransferResponse.sequence":
+		return x.Sequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransferResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransferResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransferResponse.sequence":
+		x.Sequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransferResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransferResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransferResponse.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransferResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransferResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransferResponse.sequence":
+		x.Sequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransferResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransferResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransferResponse.sequence":
+		panic(fmt.Errorf("field sequence of message warden.warden.v1beta3.MsgTreasuryIBCTransferResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransferResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransferResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryIBCTransferResponse.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryIBCTransferResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryIBCTransferResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgTreasuryIBCTransferResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTreasuryIBCTransferResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTreasuryIBCTransferResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryIBCTransferResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryIBCTransferResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryIBCTransferResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryIBCTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgTreasuryExecuteContract_5_list)(nil)
+
+type _MsgTreasuryExecuteContract_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTreasuryExecuteContract_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgTreasuryExecuteContract           protoreflect.MessageDescriptor
+	fd_MsgTreasuryExecuteContract_authority protoreflect.FieldDescriptor
+	fd_MsgTreasuryExecuteContract_space_id  protoreflect.FieldDescriptor
+	fd_MsgTreasuryExecuteContract_contract  protoreflect.FieldDescriptor
+	fd_MsgTreasuryExecuteContract_msg       protoreflect.FieldDescriptor
+	fd_MsgTreasuryExecuteContract_funds     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgTreasuryExecuteContract = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgTreasuryExecuteContract")
+	fd_MsgTreasuryExecuteContract_authority = md_MsgTreasuryExecuteContract.Fields().ByName("authority")
+	fd_MsgTreasuryExecuteContract_space_id = md_MsgTreasuryExecuteContract.Fields().ByName("space_id")
+	fd_MsgTreasuryExecuteContract_contract = md_MsgTreasuryExecuteContract.Fields().ByName("contract")
+	fd_MsgTreasuryExecuteContract_msg = md_MsgTreasuryExecuteContract.Fields().ByName("msg")
+	fd_MsgTreasuryExecuteContract_funds = md_MsgTreasuryExecuteContract.Fields().ByName("funds")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTreasuryExecuteContract)(nil)
+
+type fastReflection_MsgTreasuryExecuteContract MsgTreasuryExecuteContract
+
+func (x *MsgTreasuryExecuteContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryExecuteContract)(x)
+}
+
+func (x *MsgTreasuryExecuteContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTreasuryExecuteContract_messageType fastReflection_MsgTreasuryExecuteContract_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTreasuryExecuteContract_messageType{}
+
+type fastReflection_MsgTreasuryExecuteContract_messageType struct{}
+
+func (x fastReflection_MsgTreasuryExecuteContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryExecuteContract)(nil)
+}
+func (x fastReflection_MsgTreasuryExecuteContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryExecuteContract)
+}
+func (x fastReflection_MsgTreasuryExecuteContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryExecuteContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTreasuryExecuteContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryExecuteContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTreasuryExecuteContract) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTreasuryExecuteContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTreasuryExecuteContract) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryExecuteContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTreasuryExecuteContract) Interface() protoreflect.ProtoMessage {
+	return (*MsgTreasuryExecuteContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTreasuryExecuteContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgTreasuryExecuteContract_authority, value) {
+			return
+		}
+	}
+	if x.SpaceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SpaceId)
+		if !f(fd_MsgTreasuryExecuteContract_space_id, value) {
+			return
+		}
+	}
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_MsgTreasuryExecuteContract_contract, value) {
+			return
+		}
+	}
+	if len(x.Msg) != 0 {
+		value := protoreflect.ValueOfBytes(x.Msg)
+		if !f(fd_MsgTreasuryExecuteContract_msg, value) {
+			return
+		}
+	}
+	if len(x.Funds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgTreasuryExecuteContract_5_list{list: &x.Funds})
+		if !f(fd_MsgTreasuryExecuteContract_funds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTreasuryExecuteContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.authority":
+		return x.Authority != ""
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.space_id":
+		return x.SpaceId != uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.contract":
+		return x.Contract != ""
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.msg":
+		return len(x.Msg) != 0
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.funds":
+		return len(x.Funds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContract"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.authority":
+		x.Authority = ""
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.space_id":
+		x.SpaceId = uint64(0)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.contract":
+		x.Contract = ""
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.msg":
+		x.Msg = nil
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.funds":
+		x.Funds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContract"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTreasuryExecuteContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.space_id":
+		value := x.SpaceId
+		return protoreflect.ValueOfUint64(value)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.msg":
+		value := x.Msg
+		return protoreflect.ValueOfBytes(value)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.funds":
+		if len(x.Funds) == 0 {
+			return protoreflect.ValueOfList(&_MsgTreasuryExecuteContract_5_list{})
+		}
+		listValue := &_MsgTreasuryExecuteContract_5_list{list: &x.Funds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContract"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.authority":
+		x.Authority = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.space_id":
+		x.SpaceId = value.Uint()
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.contract":
+		x.Contract = value.Interface().(string)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.msg":
+		x.Msg = value.Bytes()
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.funds":
+		lv := value.List()
+		clv := lv.(*_MsgTreasuryExecuteContract_5_list)
+		x.Funds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContract"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.funds":
+		if x.Funds == nil {
+			x.Funds = []*v1beta1.Coin{}
+		}
+		value := &_MsgTreasuryExecuteContract_5_list{list: &x.Funds}
+		return protoreflect.ValueOfList(value)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.authority":
+		panic(fmt.Errorf("field authority of message warden.warden.v1beta3.MsgTreasuryExecuteContract is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.space_id":
+		panic(fmt.Errorf("field space_id of message warden.warden.v1beta3.MsgTreasuryExecuteContract is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.contract":
+		panic(fmt.Errorf("field contract of message warden.warden.v1beta3.MsgTreasuryExecuteContract is not mutable"))
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.msg":
+		panic(fmt.Errorf("field msg of message warden.warden.v1beta3.MsgTreasuryExecuteContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContract"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTreasuryExecuteContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.authority":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.space_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.contract":
+		return protoreflect.ValueOfString("")
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.msg":
+		return protoreflect.ValueOfBytes(nil)
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContract.funds":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgTreasuryExecuteContract_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContract"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTreasuryExecuteContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgTreasuryExecuteContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTreasuryExecuteContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTreasuryExecuteContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTreasuryExecuteContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTreasuryExecuteContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpaceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SpaceId))
+		}
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Msg)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Funds) > 0 {
+			for _, e := range x.Funds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryExecuteContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Funds) > 0 {
+			for iNdEx := len(x.Funds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Funds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Msg) > 0 {
+			i -= len(x.Msg)
+			copy(dAtA[i:], x.Msg)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Msg)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SpaceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SpaceId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryExecuteContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryExecuteContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				}
+				x.SpaceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SpaceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Msg = append(x.Msg[:0], dAtA[iNdEx:postIndex]...)
+				if x.Msg == nil {
+					x.Msg = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Funds = append(x.Funds, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Funds[len(x.Funds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTreasuryExecuteContractResponse      protoreflect.MessageDescriptor
+	fd_MsgTreasuryExecuteContractResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_warden_warden_v1beta3_tx_proto_init()
+	md_MsgTreasuryExecuteContractResponse = File_warden_warden_v1beta3_tx_proto.Messages().ByName("MsgTreasuryExecuteContractResponse")
+	fd_MsgTreasuryExecuteContractResponse_data = md_MsgTreasuryExecuteContractResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTreasuryExecuteContractResponse)(nil)
+
+type fastReflection_MsgTreasuryExecuteContractResponse MsgTreasuryExecuteContractResponse
+
+func (x *MsgTreasuryExecuteContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryExecuteContractResponse)(x)
+}
+
+func (x *MsgTreasuryExecuteContractResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTreasuryExecuteContractResponse_messageType fastReflection_MsgTreasuryExecuteContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTreasuryExecuteContractResponse_messageType{}
+
+type fastReflection_MsgTreasuryExecuteContractResponse_messageType struct{}
+
+func (x fastReflection_MsgTreasuryExecuteContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTreasuryExecuteContractResponse)(nil)
+}
+func (x fastReflection_MsgTreasuryExecuteContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryExecuteContractResponse)
+}
+func (x fastReflection_MsgTreasuryExecuteContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryExecuteContractResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTreasuryExecuteContractResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTreasuryExecuteContractResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTreasuryExecuteContractResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTreasuryExecuteContractResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_MsgTreasuryExecuteContractResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContractResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContractResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContractResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContractResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContractResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContractResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContractResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContractResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContractResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContractResponse.data":
+		panic(fmt.Errorf("field data of message warden.warden.v1beta3.MsgTreasuryExecuteContractResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContractResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "warden.warden.v1beta3.MsgTreasuryExecuteContractResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: warden.warden.v1beta3.MsgTreasuryExecuteContractResponse"))
+		}
+		panic(fmt.Errorf("message warden.warden.v1beta3.MsgTreasuryExecuteContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in warden.warden.v1beta3.MsgTreasuryExecuteContractResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTreasuryExecuteContractResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTreasuryExecuteContractResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryExecuteContractResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTreasuryExecuteContractResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryExecuteContractResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTreasuryExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: warden/warden/v1beta3/tx.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{1}
+}
+
+type MsgNewSpace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator                string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ApproveAdminTemplateId uint64   `protobuf:"varint,2,opt,name=approve_admin_template_id,json=approveAdminTemplateId,proto3" json:"approve_admin_template_id,omitempty"`
+	RejectAdminTemplateId  uint64   `protobuf:"varint,3,opt,name=reject_admin_template_id,json=rejectAdminTemplateId,proto3" json:"reject_admin_template_id,omitempty"`
+	ApproveSignTemplateId  uint64   `protobuf:"varint,4,opt,name=approve_sign_template_id,json=approveSignTemplateId,proto3" json:"approve_sign_template_id,omitempty"`
+	RejectSignTemplateId   uint64   `protobuf:"varint,5,opt,name=reject_sign_template_id,json=rejectSignTemplateId,proto3" json:"reject_sign_template_id,omitempty"`
+	AdditionalOwners       []string `protobuf:"bytes,6,rep,name=additional_owners,json=additionalOwners,proto3" json:"additional_owners,omitempty"`
+}
+
+func (x *MsgNewSpace) Reset() {
+	*x = MsgNewSpace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewSpace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewSpace) ProtoMessage() {}
+
+// Deprecated: Use MsgNewSpace.ProtoReflect.Descriptor instead.
+func (*MsgNewSpace) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgNewSpace) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgNewSpace) GetApproveAdminTemplateId() uint64 {
+	if x != nil {
+		return x.ApproveAdminTemplateId
+	}
+	return 0
+}
+
+func (x *MsgNewSpace) GetRejectAdminTemplateId() uint64 {
+	if x != nil {
+		return x.RejectAdminTemplateId
+	}
+	return 0
+}
+
+func (x *MsgNewSpace) GetApproveSignTemplateId() uint64 {
+	if x != nil {
+		return x.ApproveSignTemplateId
+	}
+	return 0
+}
+
+func (x *MsgNewSpace) GetRejectSignTemplateId() uint64 {
+	if x != nil {
+		return x.RejectSignTemplateId
+	}
+	return 0
+}
+
+func (x *MsgNewSpace) GetAdditionalOwners() []string {
+	if x != nil {
+		return x.AdditionalOwners
+	}
+	return nil
+}
+
+type MsgNewSpaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgNewSpaceResponse) Reset() {
+	*x = MsgNewSpaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewSpaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewSpaceResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgNewSpaceResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewSpaceResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgNewSpaceResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgAddSpaceOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	NewOwner  string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Nonce     uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *MsgAddSpaceOwner) Reset() {
+	*x = MsgAddSpaceOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddSpaceOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddSpaceOwner) ProtoMessage() {}
+
+// Deprecated: Use MsgAddSpaceOwner.ProtoReflect.Descriptor instead.
+func (*MsgAddSpaceOwner) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{4}
+}
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
+func (x *MsgAddSpaceOwner) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgAddSpaceOwner) GetSpaceId() uint64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
+func (x *MsgAddSpaceOwner) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *MsgAddSpaceOwner) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type MsgAddSpaceOwnerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAddSpaceOwnerResponse) Reset() {
+	*x = MsgAddSpaceOwnerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddSpaceOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddSpaceOwnerResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAddSpaceOwnerResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddSpaceOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{5}
+}
+
+type MsgRemoveSpaceOwner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the module parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Owner     string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Nonce     uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgRemoveSpaceOwner) Reset() {
+	*x = MsgRemoveSpaceOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[0]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
+func (x *MsgRemoveSpaceOwner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgRemoveSpaceOwner) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgRemoveSpaceOwner.ProtoReflect.Descriptor instead.
+func (*MsgRemoveSpaceOwner) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgRemoveSpaceOwner) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgRemoveSpaceOwner) GetSpaceId() uint64 {
 	if x != nil {
-		return x.Params
+		return x.SpaceId
 	}
-	return nil
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+func (x *MsgRemoveSpaceOwner) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *MsgRemoveSpaceOwner) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type MsgRemoveSpaceOwnerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgRemoveSpaceOwnerResponse) Reset() {
+	*x = MsgRemoveSpaceOwnerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[1]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgRemoveSpaceOwnerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgRemoveSpaceOwnerResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgRemoveSpaceOwnerResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveSpaceOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{7}
 }
 
-type MsgNewSpace struct {
+type MsgNewKeychain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator                string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ApproveAdminTemplateId uint64   `protobuf:"varint,2,opt,name=approve_admin_template_id,json=approveAdminTemplateId,proto3" json:"approve_admin_template_id,omitempty"`
-	RejectAdminTemplateId  uint64   `protobuf:"varint,3,opt,name=reject_admin_template_id,json=rejectAdminTemplateId,proto3" json:"reject_admin_template_id,omitempty"`
-	ApproveSignTemplateId  uint64   `protobuf:"varint,4,opt,name=approve_sign_template_id,json=approveSignTemplateId,proto3" json:"approve_sign_template_id,omitempty"`
-	RejectSignTemplateId   uint64   `protobuf:"varint,5,opt,name=reject_sign_template_id,json=rejectSignTemplateId,proto3" json:"reject_sign_template_id,omitempty"`
-	AdditionalOwners       []string `protobuf:"bytes,6,rep,name=additional_owners,json=additionalOwners,proto3" json:"additional_owners,omitempty"`
+	Creator      string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name         string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	KeychainFees *KeychainFees `protobuf:"bytes,4,opt,name=keychain_fees,json=keychainFees,proto3" json:"keychain_fees,omitempty"`
+	Description  string        `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Url          string        `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	KeybaseId    string        `protobuf:"bytes,7,opt,name=keybase_id,json=keybaseId,proto3" json:"keybase_id,omitempty"`
 }
 
-func (x *MsgNewSpace) Reset() {
-	*x = MsgNewSpace{}
+func (x *MsgNewKeychain) Reset() {
+	*x = MsgNewKeychain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[2]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewSpace) String() string {
+func (x *MsgNewKeychain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewSpace) ProtoMessage() {}
+func (*MsgNewKeychain) ProtoMessage() {}
 
-// Deprecated: Use MsgNewSpace.ProtoReflect.Descriptor instead.
-func (*MsgNewSpace) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgNewKeychain.ProtoReflect.Descriptor instead.
+func (*MsgNewKeychain) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MsgNewSpace) GetCreator() string {
+func (x *MsgNewKeychain) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgNewSpace) GetApproveAdminTemplateId() uint64 {
+func (x *MsgNewKeychain) GetName() string {
 	if x != nil {
-		return x.ApproveAdminTemplateId
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgNewKeychain) GetKeychainFees() *KeychainFees {
+	if x != nil {
+		return x.KeychainFees
+	}
+	return nil
+}
+
+func (x *MsgNewKeychain) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MsgNewKeychain) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MsgNewKeychain) GetKeybaseId() string {
+	if x != nil {
+		return x.KeybaseId
+	}
+	return ""
+}
+
+type MsgNewKeychainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgNewKeychainResponse) Reset() {
+	*x = MsgNewKeychainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewKeychainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewKeychainResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgNewKeychainResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewKeychainResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgNewKeychainResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgNewSpace) GetRejectAdminTemplateId() uint64 {
-	if x != nil {
-		return x.RejectAdminTemplateId
-	}
-	return 0
+type MsgAddKeychainWriter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeychainId uint64 `protobuf:"varint,2,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
+	Writer     string `protobuf:"bytes,3,opt,name=writer,proto3" json:"writer,omitempty"`
+}
+
+func (x *MsgAddKeychainWriter) Reset() {
+	*x = MsgAddKeychainWriter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddKeychainWriter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddKeychainWriter) ProtoMessage() {}
+
+// Deprecated: Use MsgAddKeychainWriter.ProtoReflect.Descriptor instead.
+func (*MsgAddKeychainWriter) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgNewSpace) GetApproveSignTemplateId() uint64 {
+func (x *MsgAddKeychainWriter) GetCreator() string {
 	if x != nil {
-		return x.ApproveSignTemplateId
+		return x.Creator
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgNewSpace) GetRejectSignTemplateId() uint64 {
+func (x *MsgAddKeychainWriter) GetKeychainId() uint64 {
 	if x != nil {
-		return x.RejectSignTemplateId
+		return x.KeychainId
 	}
 	return 0
 }
 
-func (x *MsgNewSpace) GetAdditionalOwners() []string {
+func (x *MsgAddKeychainWriter) GetWriter() string {
 	if x != nil {
-		return x.AdditionalOwners
+		return x.Writer
 	}
-	return nil
+	return ""
 }
 
-type MsgNewSpaceResponse struct {
+type MsgAddKeychainWriterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgNewSpaceResponse) Reset() {
-	*x = MsgNewSpaceResponse{}
+func (x *MsgAddKeychainWriterResponse) Reset() {
+	*x = MsgAddKeychainWriterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[3]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewSpaceResponse) String() string {
+func (x *MsgAddKeychainWriterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewSpaceResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgNewSpaceResponse.ProtoReflect.Descriptor instead.
-func (*MsgNewSpaceResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{3}
-}
+func (*MsgAddKeychainWriterResponse) ProtoMessage() {}
 
-func (x *MsgNewSpaceResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use MsgAddKeychainWriterResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddKeychainWriterResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{11}
 }
 
-type MsgAddSpaceOwner struct {
+type MsgUpdateSpace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	NewOwner  string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
-	Nonce     uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Authority              string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId                uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Nonce                  uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ApproveAdminTemplateId uint64 `protobuf:"varint,4,opt,name=approve_admin_template_id,json=approveAdminTemplateId,proto3" json:"approve_admin_template_id,omitempty"`
+	RejectAdminTemplateId  uint64 `protobuf:"varint,5,opt,name=reject_admin_template_id,json=rejectAdminTemplateId,proto3" json:"reject_admin_template_id,omitempty"`
+	ApproveSignTemplateId  uint64 `protobuf:"varint,6,opt,name=approve_sign_template_id,json=approveSignTemplateId,proto3" json:"approve_sign_template_id,omitempty"`
+	RejectSignTemplateId   uint64 `protobuf:"varint,7,opt,name=reject_sign_template_id,json=rejectSignTemplateId,proto3" json:"reject_sign_template_id,omitempty"`
 }
 
-func (x *MsgAddSpaceOwner) Reset() {
-	*x = MsgAddSpaceOwner{}
+func (x *MsgUpdateSpace) Reset() {
+	*x = MsgUpdateSpace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[4]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddSpaceOwner) String() string {
+func (x *MsgUpdateSpace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddSpaceOwner) ProtoMessage() {}
+func (*MsgUpdateSpace) ProtoMessage() {}
 
-// Deprecated: Use MsgAddSpaceOwner.ProtoReflect.Descriptor instead.
-func (*MsgAddSpaceOwner) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgUpdateSpace.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSpace) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgAddSpaceOwner) GetAuthority() string {
+func (x *MsgUpdateSpace) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgAddSpaceOwner) GetSpaceId() uint64 {
+func (x *MsgUpdateSpace) GetSpaceId() uint64 {
 	if x != nil {
 		return x.SpaceId
 	}
 	return 0
 }
 
-func (x *MsgAddSpaceOwner) GetNewOwner() string {
+func (x *MsgUpdateSpace) GetNonce() uint64 {
 	if x != nil {
-		return x.NewOwner
+		return x.Nonce
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgAddSpaceOwner) GetNonce() uint64 {
+func (x *MsgUpdateSpace) GetApproveAdminTemplateId() uint64 {
 	if x != nil {
-		return x.Nonce
+		return x.ApproveAdminTemplateId
 	}
 	return 0
 }
 
-type MsgAddSpaceOwnerResponse struct {
+func (x *MsgUpdateSpace) GetRejectAdminTemplateId() uint64 {
+	if x != nil {
+		return x.RejectAdminTemplateId
+	}
+	return 0
+}
+
+func (x *MsgUpdateSpace) GetApproveSignTemplateId() uint64 {
+	if x != nil {
+		return x.ApproveSignTemplateId
+	}
+	return 0
+}
+
+func (x *MsgUpdateSpace) GetRejectSignTemplateId() uint64 {
+	if x != nil {
+		return x.RejectSignTemplateId
+	}
+	return 0
+}
+
+type MsgUpdateSpaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgAddSpaceOwnerResponse) Reset() {
-	*x = MsgAddSpaceOwnerResponse{}
+func (x *MsgUpdateSpaceResponse) Reset() {
+	*x = MsgUpdateSpaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[5]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddSpaceOwnerResponse) String() string {
+func (x *MsgUpdateSpaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddSpaceOwnerResponse) ProtoMessage() {}
+func (*MsgUpdateSpaceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgAddSpaceOwnerResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddSpaceOwnerResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgUpdateSpaceResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateSpaceResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{13}
 }
 
-type MsgRemoveSpaceOwner struct {
+type MsgUpdateKeychain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	Owner     string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	Nonce     uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Creator      string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeychainId   uint64        `protobuf:"varint,2,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
+	Name         string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	KeychainFees *KeychainFees `protobuf:"bytes,5,opt,name=keychain_fees,json=keychainFees,proto3" json:"keychain_fees,omitempty"`
+	Description  string        `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Url          string        `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	KeybaseId    string        `protobuf:"bytes,8,opt,name=keybase_id,json=keybaseId,proto3" json:"keybase_id,omitempty"`
 }
 
-func (x *MsgRemoveSpaceOwner) Reset() {
-	*x = MsgRemoveSpaceOwner{}
+func (x *MsgUpdateKeychain) Reset() {
+	*x = MsgUpdateKeychain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[6]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveSpaceOwner) String() string {
+func (x *MsgUpdateKeychain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveSpaceOwner) ProtoMessage() {}
+func (*MsgUpdateKeychain) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveSpaceOwner.ProtoReflect.Descriptor instead.
-func (*MsgRemoveSpaceOwner) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgUpdateKeychain.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKeychain) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgRemoveSpaceOwner) GetAuthority() string {
+func (x *MsgUpdateKeychain) GetCreator() string {
 	if x != nil {
-		return x.Authority
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRemoveSpaceOwner) GetSpaceId() uint64 {
+func (x *MsgUpdateKeychain) GetKeychainId() uint64 {
 	if x != nil {
-		return x.SpaceId
+		return x.KeychainId
 	}
 	return 0
 }
 
-func (x *MsgRemoveSpaceOwner) GetOwner() string {
+func (x *MsgUpdateKeychain) GetName() string {
 	if x != nil {
-		return x.Owner
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgRemoveSpaceOwner) GetNonce() uint64 {
+func (x *MsgUpdateKeychain) GetKeychainFees() *KeychainFees {
 	if x != nil {
-		return x.Nonce
+		return x.KeychainFees
 	}
-	return 0
+	return nil
 }
 
-type MsgRemoveSpaceOwnerResponse struct {
+func (x *MsgUpdateKeychain) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MsgUpdateKeychain) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MsgUpdateKeychain) GetKeybaseId() string {
+	if x != nil {
+		return x.KeybaseId
+	}
+	return ""
+}
+
+type MsgUpdateKeychainResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRemoveSpaceOwnerResponse) Reset() {
-	*x = MsgRemoveSpaceOwnerResponse{}
+func (x *MsgUpdateKeychainResponse) Reset() {
+	*x = MsgUpdateKeychainResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[7]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveSpaceOwnerResponse) String() string {
+func (x *MsgUpdateKeychainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveSpaceOwnerResponse) ProtoMessage() {}
+func (*MsgUpdateKeychainResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveSpaceOwnerResponse.ProtoReflect.Descriptor instead.
-func (*MsgRemoveSpaceOwnerResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgUpdateKeychainResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKeychainResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{15}
 }
 
-type MsgNewKeychain struct {
+type MsgAddKeychainAdminRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Name         string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	KeychainFees *KeychainFees `protobuf:"bytes,4,opt,name=keychain_fees,json=keychainFees,proto3" json:"keychain_fees,omitempty"`
-	Description  string        `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Url          string        `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	KeybaseId    string        `protobuf:"bytes,7,opt,name=keybase_id,json=keybaseId,proto3" json:"keybase_id,omitempty"`
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	KeychainId uint64 `protobuf:"varint,2,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
+	NewAdmin   string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
 }
 
-func (x *MsgNewKeychain) Reset() {
-	*x = MsgNewKeychain{}
+func (x *MsgAddKeychainAdminRequest) Reset() {
+	*x = MsgAddKeychainAdminRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[8]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewKeychain) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgNewKeychain) ProtoMessage() {}
-
-// Deprecated: Use MsgNewKeychain.ProtoReflect.Descriptor instead.
-func (*MsgNewKeychain) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *MsgNewKeychain) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
-}
-
-func (x *MsgNewKeychain) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *MsgAddKeychainAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgNewKeychain) GetKeychainFees() *KeychainFees {
-	if x != nil {
-		return x.KeychainFees
-	}
-	return nil
+func (*MsgAddKeychainAdminRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgAddKeychainAdminRequest.ProtoReflect.Descriptor instead.
+func (*MsgAddKeychainAdminRequest) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgNewKeychain) GetDescription() string {
+func (x *MsgAddKeychainAdminRequest) GetAuthority() string {
 	if x != nil {
-		return x.Description
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgNewKeychain) GetUrl() string {
+func (x *MsgAddKeychainAdminRequest) GetKeychainId() uint64 {
 	if x != nil {
-		return x.Url
+		return x.KeychainId
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgNewKeychain) GetKeybaseId() string {
+func (x *MsgAddKeychainAdminRequest) GetNewAdmin() string {
 	if x != nil {
-		return x.KeybaseId
+		return x.NewAdmin
 	}
 	return ""
 }
 
-type MsgNewKeychainResponse struct {
+type MsgAddKeychainAdminResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgNewKeychainResponse) Reset() {
-	*x = MsgNewKeychainResponse{}
+func (x *MsgAddKeychainAdminResponse) Reset() {
+	*x = MsgAddKeychainAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[9]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewKeychainResponse) String() string {
+func (x *MsgAddKeychainAdminResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewKeychainResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgNewKeychainResponse.ProtoReflect.Descriptor instead.
-func (*MsgNewKeychainResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{9}
-}
+func (*MsgAddKeychainAdminResponse) ProtoMessage() {}
 
-func (x *MsgNewKeychainResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use MsgAddKeychainAdminResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddKeychainAdminResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{17}
 }
 
-type MsgAddKeychainWriter struct {
+type MsgRemoveKeychainAdminRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	KeychainId uint64 `protobuf:"varint,2,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
-	Writer     string `protobuf:"bytes,3,opt,name=writer,proto3" json:"writer,omitempty"`
+	Admin      string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
-func (x *MsgAddKeychainWriter) Reset() {
-	*x = MsgAddKeychainWriter{}
+func (x *MsgRemoveKeychainAdminRequest) Reset() {
+	*x = MsgRemoveKeychainAdminRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[10]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddKeychainWriter) String() string {
+func (x *MsgRemoveKeychainAdminRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddKeychainWriter) ProtoMessage() {}
+func (*MsgRemoveKeychainAdminRequest) ProtoMessage() {}
 
-// Deprecated: Use MsgAddKeychainWriter.ProtoReflect.Descriptor instead.
-func (*MsgAddKeychainWriter) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgRemoveKeychainAdminRequest.ProtoReflect.Descriptor instead.
+func (*MsgRemoveKeychainAdminRequest) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgAddKeychainWriter) GetCreator() string {
+func (x *MsgRemoveKeychainAdminRequest) GetAuthority() string {
 	if x != nil {
-		return x.Creator
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgAddKeychainWriter) GetKeychainId() uint64 {
+func (x *MsgRemoveKeychainAdminRequest) GetKeychainId() uint64 {
 	if x != nil {
 		return x.KeychainId
 	}
 	return 0
 }
 
-func (x *MsgAddKeychainWriter) GetWriter() string {
+func (x *MsgRemoveKeychainAdminRequest) GetAdmin() string {
 	if x != nil {
-		return x.Writer
+		return x.Admin
 	}
 	return ""
 }
 
-type MsgAddKeychainWriterResponse struct {
+type MsgRemoveKeychainAdminResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgAddKeychainWriterResponse) Reset() {
-	*x = MsgAddKeychainWriterResponse{}
+func (x *MsgRemoveKeychainAdminResponse) Reset() {
+	*x = MsgRemoveKeychainAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[11]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddKeychainWriterResponse) String() string {
+func (x *MsgRemoveKeychainAdminResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddKeychainWriterResponse) ProtoMessage() {}
+func (*MsgRemoveKeychainAdminResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgAddKeychainWriterResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddKeychainWriterResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgRemoveKeychainAdminResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveKeychainAdminResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{19}
 }
 
-type MsgUpdateSpace struct {
+type MsgNewKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority              string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	SpaceId                uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	Nonce                  uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	ApproveAdminTemplateId uint64 `protobuf:"varint,4,opt,name=approve_admin_template_id,json=approveAdminTemplateId,proto3" json:"approve_admin_template_id,omitempty"`
-	RejectAdminTemplateId  uint64 `protobuf:"varint,5,opt,name=reject_admin_template_id,json=rejectAdminTemplateId,proto3" json:"reject_admin_template_id,omitempty"`
-	ApproveSignTemplateId  uint64 `protobuf:"varint,6,opt,name=approve_sign_template_id,json=approveSignTemplateId,proto3" json:"approve_sign_template_id,omitempty"`
-	RejectSignTemplateId   uint64 `protobuf:"varint,7,opt,name=reject_sign_template_id,json=rejectSignTemplateId,proto3" json:"reject_sign_template_id,omitempty"`
+	Authority         string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId           uint64          `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	KeychainId        uint64          `protobuf:"varint,3,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
+	KeyType           KeyType         `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=warden.warden.v1beta3.KeyType" json:"key_type,omitempty"`
+	ApproveTemplateId uint64          `protobuf:"varint,5,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
+	RejectTemplateId  uint64          `protobuf:"varint,6,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
+	MaxKeychainFees   []*v1beta1.Coin `protobuf:"bytes,7,rep,name=max_keychain_fees,json=maxKeychainFees,proto3" json:"max_keychain_fees,omitempty"`
+	Nonce             uint64          `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *MsgUpdateSpace) Reset() {
-	*x = MsgUpdateSpace{}
+func (x *MsgNewKeyRequest) Reset() {
+	*x = MsgNewKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[12]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateSpace) String() string {
+func (x *MsgNewKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateSpace) ProtoMessage() {}
+func (*MsgNewKeyRequest) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateSpace.ProtoReflect.Descriptor instead.
-func (*MsgUpdateSpace) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgNewKeyRequest.ProtoReflect.Descriptor instead.
+func (*MsgNewKeyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgUpdateSpace) GetAuthority() string {
+func (x *MsgNewKeyRequest) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateSpace) GetSpaceId() uint64 {
+func (x *MsgNewKeyRequest) GetSpaceId() uint64 {
 	if x != nil {
 		return x.SpaceId
 	}
 	return 0
 }
 
-func (x *MsgUpdateSpace) GetNonce() uint64 {
+func (x *MsgNewKeyRequest) GetKeychainId() uint64 {
 	if x != nil {
-		return x.Nonce
+		return x.KeychainId
 	}
 	return 0
 }
 
-func (x *MsgUpdateSpace) GetApproveAdminTemplateId() uint64 {
+func (x *MsgNewKeyRequest) GetKeyType() KeyType {
 	if x != nil {
-		return x.ApproveAdminTemplateId
+		return x.KeyType
 	}
-	return 0
+	return KeyType_KEY_TYPE_UNSPECIFIED
 }
 
-func (x *MsgUpdateSpace) GetRejectAdminTemplateId() uint64 {
+func (x *MsgNewKeyRequest) GetApproveTemplateId() uint64 {
 	if x != nil {
-		return x.RejectAdminTemplateId
+		return x.ApproveTemplateId
 	}
 	return 0
 }
 
-func (x *MsgUpdateSpace) GetApproveSignTemplateId() uint64 {
+func (x *MsgNewKeyRequest) GetRejectTemplateId() uint64 {
 	if x != nil {
-		return x.ApproveSignTemplateId
+		return x.RejectTemplateId
 	}
 	return 0
 }
 
-func (x *MsgUpdateSpace) GetRejectSignTemplateId() uint64 {
+func (x *MsgNewKeyRequest) GetMaxKeychainFees() []*v1beta1.Coin {
 	if x != nil {
-		return x.RejectSignTemplateId
+		return x.MaxKeychainFees
 	}
-	return 0
-}
-
-type MsgUpdateSpaceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return nil
 }
 
-func (x *MsgUpdateSpaceResponse) Reset() {
-	*x = MsgUpdateSpaceResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MsgNewKeyRequest) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
 	}
+	return 0
 }
 
-func (x *MsgUpdateSpaceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgUpdateSpaceResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgUpdateSpaceResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateSpaceResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{13}
-}
-
-type MsgUpdateKeychain struct {
+type MsgNewKeyRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	KeychainId   uint64        `protobuf:"varint,2,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
-	Name         string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	KeychainFees *KeychainFees `protobuf:"bytes,5,opt,name=keychain_fees,json=keychainFees,proto3" json:"keychain_fees,omitempty"`
-	Description  string        `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Url          string        `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
-	KeybaseId    string        `protobuf:"bytes,8,opt,name=keybase_id,json=keybaseId,proto3" json:"keybase_id,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgUpdateKeychain) Reset() {
-	*x = MsgUpdateKeychain{}
+func (x *MsgNewKeyRequestResponse) Reset() {
+	*x = MsgNewKeyRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[14]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateKeychain) String() string {
+func (x *MsgNewKeyRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateKeychain) ProtoMessage() {}
-
-// Deprecated: Use MsgUpdateKeychain.ProtoReflect.Descriptor instead.
-func (*MsgUpdateKeychain) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *MsgUpdateKeychain) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
-}
-
-func (x *MsgUpdateKeychain) GetKeychainId() uint64 {
-	if x != nil {
-		return x.KeychainId
-	}
-	return 0
-}
-
-func (x *MsgUpdateKeychain) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *MsgUpdateKeychain) GetKeychainFees() *KeychainFees {
-	if x != nil {
-		return x.KeychainFees
-	}
-	return nil
-}
-
-func (x *MsgUpdateKeychain) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
+func (*MsgNewKeyRequestResponse) ProtoMessage() {}
 
-func (x *MsgUpdateKeychain) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+// Deprecated: Use MsgNewKeyRequestResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewKeyRequestResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *MsgUpdateKeychain) GetKeybaseId() string {
+func (x *MsgNewKeyRequestResponse) GetId() uint64 {
 	if x != nil {
-		return x.KeybaseId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type MsgUpdateKeychainResponse struct {
+type MsgNewKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 }
 
-func (x *MsgUpdateKeychainResponse) Reset() {
-	*x = MsgUpdateKeychainResponse{}
+func (x *MsgNewKey) Reset() {
+	*x = MsgNewKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[15]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateKeychainResponse) String() string {
+func (x *MsgNewKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateKeychainResponse) ProtoMessage() {}
+func (*MsgNewKey) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateKeychainResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateKeychainResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MsgNewKey.ProtoReflect.Descriptor instead.
+func (*MsgNewKey) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{22}
 }
 
-type MsgAddKeychainAdminRequest struct {
+func (x *MsgNewKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type MsgFulfilKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	KeychainId uint64 `protobuf:"varint,2,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
-	NewAdmin   string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	Creator   string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	RequestId uint64           `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Status    KeyRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=warden.warden.v1beta3.KeyRequestStatus" json:"status,omitempty"`
+	// Types that are assignable to Result:
+	//	*MsgFulfilKeyRequest_Key
+	//	*MsgFulfilKeyRequest_RejectReason
+	Result isMsgFulfilKeyRequest_Result `protobuf_oneof:"result"`
 }
 
-func (x *MsgAddKeychainAdminRequest) Reset() {
-	*x = MsgAddKeychainAdminRequest{}
+func (x *MsgFulfilKeyRequest) Reset() {
+	*x = MsgFulfilKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[16]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddKeychainAdminRequest) String() string {
+func (x *MsgFulfilKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddKeychainAdminRequest) ProtoMessage() {}
+func (*MsgFulfilKeyRequest) ProtoMessage() {}
 
-// Deprecated: Use MsgAddKeychainAdminRequest.ProtoReflect.Descriptor instead.
-func (*MsgAddKeychainAdminRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MsgFulfilKeyRequest.ProtoReflect.Descriptor instead.
+func (*MsgFulfilKeyRequest) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MsgAddKeychainAdminRequest) GetAuthority() string {
+func (x *MsgFulfilKeyRequest) GetCreator() string {
 	if x != nil {
-		return x.Authority
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgAddKeychainAdminRequest) GetKeychainId() uint64 {
+func (x *MsgFulfilKeyRequest) GetRequestId() uint64 {
 	if x != nil {
-		return x.KeychainId
+		return x.RequestId
 	}
 	return 0
 }
 
-func (x *MsgAddKeychainAdminRequest) GetNewAdmin() string {
+func (x *MsgFulfilKeyRequest) GetStatus() KeyRequestStatus {
 	if x != nil {
-		return x.NewAdmin
+		return x.Status
+	}
+	return KeyRequestStatus_KEY_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *MsgFulfilKeyRequest) GetResult() isMsgFulfilKeyRequest_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *MsgFulfilKeyRequest) GetKey() *MsgNewKey {
+	if x, ok := x.GetResult().(*MsgFulfilKeyRequest_Key); ok {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *MsgFulfilKeyRequest) GetRejectReason() string {
+	if x, ok := x.GetResult().(*MsgFulfilKeyRequest_RejectReason); ok {
+		return x.RejectReason
 	}
 	return ""
 }
 
-type MsgAddKeychainAdminResponse struct {
+type isMsgFulfilKeyRequest_Result interface {
+	isMsgFulfilKeyRequest_Result()
+}
+
+type MsgFulfilKeyRequest_Key struct {
+	Key *MsgNewKey `protobuf:"bytes,4,opt,name=key,proto3,oneof"`
+}
+
+type MsgFulfilKeyRequest_RejectReason struct {
+	RejectReason string `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3,oneof"`
+}
+
+func (*MsgFulfilKeyRequest_Key) isMsgFulfilKeyRequest_Result() {}
+
+func (*MsgFulfilKeyRequest_RejectReason) isMsgFulfilKeyRequest_Result() {}
+
+type MsgFulfilKeyRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgAddKeychainAdminResponse) Reset() {
-	*x = MsgAddKeychainAdminResponse{}
+func (x *MsgFulfilKeyRequestResponse) Reset() {
+	*x = MsgFulfilKeyRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[17]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddKeychainAdminResponse) String() string {
+func (x *MsgFulfilKeyRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddKeychainAdminResponse) ProtoMessage() {}
+func (*MsgFulfilKeyRequestResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgAddKeychainAdminResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddKeychainAdminResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MsgFulfilKeyRequestResponse.ProtoReflect.Descriptor instead.
+func (*MsgFulfilKeyRequestResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{24}
 }
 
-type MsgRemoveKeychainAdminRequest struct {
+type MsgUpdateKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	KeychainId uint64 `protobuf:"varint,2,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
-	Admin      string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	Authority         string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	KeyId             uint64 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ApproveTemplateId uint64 `protobuf:"varint,4,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
+	RejectTemplateId  uint64 `protobuf:"varint,5,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
 }
 
-func (x *MsgRemoveKeychainAdminRequest) Reset() {
-	*x = MsgRemoveKeychainAdminRequest{}
+func (x *MsgUpdateKey) Reset() {
+	*x = MsgUpdateKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[18]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveKeychainAdminRequest) String() string {
+func (x *MsgUpdateKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveKeychainAdminRequest) ProtoMessage() {}
+func (*MsgUpdateKey) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveKeychainAdminRequest.ProtoReflect.Descriptor instead.
-func (*MsgRemoveKeychainAdminRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MsgUpdateKey.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKey) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MsgRemoveKeychainAdminRequest) GetAuthority() string {
+func (x *MsgUpdateKey) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgRemoveKeychainAdminRequest) GetKeychainId() uint64 {
+func (x *MsgUpdateKey) GetKeyId() uint64 {
 	if x != nil {
-		return x.KeychainId
+		return x.KeyId
 	}
 	return 0
 }
 
-func (x *MsgRemoveKeychainAdminRequest) GetAdmin() string {
+func (x *MsgUpdateKey) GetApproveTemplateId() uint64 {
 	if x != nil {
-		return x.Admin
+		return x.ApproveTemplateId
 	}
-	return ""
+	return 0
 }
 
-type MsgRemoveKeychainAdminResponse struct {
+func (x *MsgUpdateKey) GetRejectTemplateId() uint64 {
+	if x != nil {
+		return x.RejectTemplateId
+	}
+	return 0
+}
+
+type MsgUpdateKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRemoveKeychainAdminResponse) Reset() {
-	*x = MsgRemoveKeychainAdminResponse{}
+func (x *MsgUpdateKeyResponse) Reset() {
+	*x = MsgUpdateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[19]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveKeychainAdminResponse) String() string {
+func (x *MsgUpdateKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveKeychainAdminResponse) ProtoMessage() {}
+func (*MsgUpdateKeyResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveKeychainAdminResponse.ProtoReflect.Descriptor instead.
-func (*MsgRemoveKeychainAdminResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use MsgUpdateKeyResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKeyResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{26}
 }
 
-type MsgNewKeyRequest struct {
+type MsgNewSignRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority         string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	SpaceId           uint64          `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	KeychainId        uint64          `protobuf:"varint,3,opt,name=keychain_id,json=keychainId,proto3" json:"keychain_id,omitempty"`
-	KeyType           KeyType         `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=warden.warden.v1beta3.KeyType" json:"key_type,omitempty"`
-	ApproveTemplateId uint64          `protobuf:"varint,5,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
-	RejectTemplateId  uint64          `protobuf:"varint,6,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
-	MaxKeychainFees   []*v1beta1.Coin `protobuf:"bytes,7,rep,name=max_keychain_fees,json=maxKeychainFees,proto3" json:"max_keychain_fees,omitempty"`
-	Nonce             uint64          `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Authority       string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	KeyId           uint64          `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Input           []byte          `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Analyzers       []string        `protobuf:"bytes,4,rep,name=analyzers,proto3" json:"analyzers,omitempty"`
+	EncryptionKey   []byte          `protobuf:"bytes,5,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	MaxKeychainFees []*v1beta1.Coin `protobuf:"bytes,6,rep,name=max_keychain_fees,json=maxKeychainFees,proto3" json:"max_keychain_fees,omitempty"`
+	Nonce           uint64          `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *MsgNewKeyRequest) Reset() {
-	*x = MsgNewKeyRequest{}
+func (x *MsgNewSignRequest) Reset() {
+	*x = MsgNewSignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[20]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewKeyRequest) String() string {
+func (x *MsgNewSignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewKeyRequest) ProtoMessage() {}
+func (*MsgNewSignRequest) ProtoMessage() {}
 
-// Deprecated: Use MsgNewKeyRequest.ProtoReflect.Descriptor instead.
-func (*MsgNewKeyRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MsgNewSignRequest.ProtoReflect.Descriptor instead.
+func (*MsgNewSignRequest) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *MsgNewKeyRequest) GetAuthority() string {
+func (x *MsgNewSignRequest) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgNewKeyRequest) GetSpaceId() uint64 {
-	if x != nil {
-		return x.SpaceId
-	}
-	return 0
-}
-
-func (x *MsgNewKeyRequest) GetKeychainId() uint64 {
+func (x *MsgNewSignRequest) GetKeyId() uint64 {
 	if x != nil {
-		return x.KeychainId
+		return x.KeyId
 	}
 	return 0
 }
 
-func (x *MsgNewKeyRequest) GetKeyType() KeyType {
+func (x *MsgNewSignRequest) GetInput() []byte {
 	if x != nil {
-		return x.KeyType
+		return x.Input
 	}
-	return KeyType_KEY_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *MsgNewKeyRequest) GetApproveTemplateId() uint64 {
+func (x *MsgNewSignRequest) GetAnalyzers() []string {
 	if x != nil {
-		return x.ApproveTemplateId
+		return x.Analyzers
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgNewKeyRequest) GetRejectTemplateId() uint64 {
+func (x *MsgNewSignRequest) GetEncryptionKey() []byte {
 	if x != nil {
-		return x.RejectTemplateId
+		return x.EncryptionKey
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgNewKeyRequest) GetMaxKeychainFees() []*v1beta1.Coin {
+func (x *MsgNewSignRequest) GetMaxKeychainFees() []*v1beta1.Coin {
 	if x != nil {
 		return x.MaxKeychainFees
 	}
 	return nil
 }
 
-func (x *MsgNewKeyRequest) GetNonce() uint64 {
+func (x *MsgNewSignRequest) GetNonce() uint64 {
 	if x != nil {
 		return x.Nonce
 	}
 	return 0
 }
 
-type MsgNewKeyRequestResponse struct {
+type MsgNewSignRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -17424,546 +22251,612 @@ type MsgNewKeyRequestResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgNewKeyRequestResponse) Reset() {
-	*x = MsgNewKeyRequestResponse{}
+func (x *MsgNewSignRequestResponse) Reset() {
+	*x = MsgNewSignRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[21]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewKeyRequestResponse) String() string {
+func (x *MsgNewSignRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewKeyRequestResponse) ProtoMessage() {}
+func (*MsgNewSignRequestResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgNewKeyRequestResponse.ProtoReflect.Descriptor instead.
-func (*MsgNewKeyRequestResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MsgNewSignRequestResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewSignRequestResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MsgNewKeyRequestResponse) GetId() uint64 {
+func (x *MsgNewSignRequestResponse) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type MsgNewKey struct {
+type MsgSignedData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SignedData []byte `protobuf:"bytes,1,opt,name=signed_data,json=signedData,proto3" json:"signed_data,omitempty"`
 }
 
-func (x *MsgNewKey) Reset() {
-	*x = MsgNewKey{}
+func (x *MsgSignedData) Reset() {
+	*x = MsgSignedData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[22]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewKey) String() string {
+func (x *MsgSignedData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewKey) ProtoMessage() {}
+func (*MsgSignedData) ProtoMessage() {}
 
-// Deprecated: Use MsgNewKey.ProtoReflect.Descriptor instead.
-func (*MsgNewKey) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use MsgSignedData.ProtoReflect.Descriptor instead.
+func (*MsgSignedData) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MsgNewKey) GetPublicKey() []byte {
+func (x *MsgSignedData) GetSignedData() []byte {
 	if x != nil {
-		return x.PublicKey
+		return x.SignedData
 	}
 	return nil
 }
 
-type MsgFulfilKeyRequest struct {
+type MsgFulfilSignRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator   string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	RequestId uint64           `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Status    KeyRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=warden.warden.v1beta3.KeyRequestStatus" json:"status,omitempty"`
-	// Types that are assignable to Result:
+	Creator   string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	RequestId uint64            `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Status    SignRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=warden.warden.v1beta3.SignRequestStatus" json:"status,omitempty"`
+	// Holds the result of the request. If status is approved, the result will
+	// contain the signed data that was requested
+	// If status is rejected, the result will contain the reason.
 	//
-	//	*MsgFulfilKeyRequest_Key
-	//	*MsgFulfilKeyRequest_RejectReason
-	Result isMsgFulfilKeyRequest_Result `protobuf_oneof:"result"`
+	// Types that are assignable to Result:
+	//	*MsgFulfilSignRequest_Payload
+	//	*MsgFulfilSignRequest_RejectReason
+	Result isMsgFulfilSignRequest_Result `protobuf_oneof:"result"`
 }
 
-func (x *MsgFulfilKeyRequest) Reset() {
-	*x = MsgFulfilKeyRequest{}
+func (x *MsgFulfilSignRequest) Reset() {
+	*x = MsgFulfilSignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[23]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgFulfilKeyRequest) String() string {
+func (x *MsgFulfilSignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgFulfilKeyRequest) ProtoMessage() {}
+func (*MsgFulfilSignRequest) ProtoMessage() {}
 
-// Deprecated: Use MsgFulfilKeyRequest.ProtoReflect.Descriptor instead.
-func (*MsgFulfilKeyRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MsgFulfilSignRequest.ProtoReflect.Descriptor instead.
+func (*MsgFulfilSignRequest) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MsgFulfilKeyRequest) GetCreator() string {
+func (x *MsgFulfilSignRequest) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgFulfilKeyRequest) GetRequestId() uint64 {
+func (x *MsgFulfilSignRequest) GetRequestId() uint64 {
 	if x != nil {
 		return x.RequestId
 	}
 	return 0
 }
 
-func (x *MsgFulfilKeyRequest) GetStatus() KeyRequestStatus {
+func (x *MsgFulfilSignRequest) GetStatus() SignRequestStatus {
 	if x != nil {
 		return x.Status
 	}
-	return KeyRequestStatus_KEY_REQUEST_STATUS_UNSPECIFIED
+	return SignRequestStatus_SIGN_REQUEST_STATUS_UNSPECIFIED
 }
 
-func (x *MsgFulfilKeyRequest) GetResult() isMsgFulfilKeyRequest_Result {
+func (x *MsgFulfilSignRequest) GetResult() isMsgFulfilSignRequest_Result {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-func (x *MsgFulfilKeyRequest) GetKey() *MsgNewKey {
-	if x, ok := x.GetResult().(*MsgFulfilKeyRequest_Key); ok {
-		return x.Key
+func (x *MsgFulfilSignRequest) GetPayload() *MsgSignedData {
+	if x, ok := x.GetResult().(*MsgFulfilSignRequest_Payload); ok {
+		return x.Payload
 	}
 	return nil
 }
 
-func (x *MsgFulfilKeyRequest) GetRejectReason() string {
-	if x, ok := x.GetResult().(*MsgFulfilKeyRequest_RejectReason); ok {
+func (x *MsgFulfilSignRequest) GetRejectReason() string {
+	if x, ok := x.GetResult().(*MsgFulfilSignRequest_RejectReason); ok {
 		return x.RejectReason
 	}
 	return ""
 }
 
-type isMsgFulfilKeyRequest_Result interface {
-	isMsgFulfilKeyRequest_Result()
+type isMsgFulfilSignRequest_Result interface {
+	isMsgFulfilSignRequest_Result()
 }
 
-type MsgFulfilKeyRequest_Key struct {
-	Key *MsgNewKey `protobuf:"bytes,4,opt,name=key,proto3,oneof"`
+type MsgFulfilSignRequest_Payload struct {
+	Payload *MsgSignedData `protobuf:"bytes,4,opt,name=payload,proto3,oneof"`
 }
 
-type MsgFulfilKeyRequest_RejectReason struct {
+type MsgFulfilSignRequest_RejectReason struct {
 	RejectReason string `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3,oneof"`
 }
 
-func (*MsgFulfilKeyRequest_Key) isMsgFulfilKeyRequest_Result() {}
+func (*MsgFulfilSignRequest_Payload) isMsgFulfilSignRequest_Result() {}
 
-func (*MsgFulfilKeyRequest_RejectReason) isMsgFulfilKeyRequest_Result() {}
+func (*MsgFulfilSignRequest_RejectReason) isMsgFulfilSignRequest_Result() {}
 
-type MsgFulfilKeyRequestResponse struct {
+type MsgFulfilSignRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgFulfilKeyRequestResponse) Reset() {
-	*x = MsgFulfilKeyRequestResponse{}
+func (x *MsgFulfilSignRequestResponse) Reset() {
+	*x = MsgFulfilSignRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[24]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgFulfilKeyRequestResponse) String() string {
+func (x *MsgFulfilSignRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgFulfilKeyRequestResponse) ProtoMessage() {}
+func (*MsgFulfilSignRequestResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgFulfilKeyRequestResponse.ProtoReflect.Descriptor instead.
-func (*MsgFulfilKeyRequestResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use MsgFulfilSignRequestResponse.ProtoReflect.Descriptor instead.
+func (*MsgFulfilSignRequestResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{31}
 }
 
-type MsgUpdateKey struct {
+type MsgNewSpaceTreasury struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority         string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	KeyId             uint64 `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
-	ApproveTemplateId uint64 `protobuf:"varint,4,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
-	RejectTemplateId  uint64 `protobuf:"varint,5,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Nonce     uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *MsgUpdateKey) Reset() {
-	*x = MsgUpdateKey{}
+func (x *MsgNewSpaceTreasury) Reset() {
+	*x = MsgNewSpaceTreasury{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[25]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateKey) String() string {
+func (x *MsgNewSpaceTreasury) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateKey) ProtoMessage() {}
+func (*MsgNewSpaceTreasury) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateKey.ProtoReflect.Descriptor instead.
-func (*MsgUpdateKey) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MsgNewSpaceTreasury.ProtoReflect.Descriptor instead.
+func (*MsgNewSpaceTreasury) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *MsgUpdateKey) GetAuthority() string {
+func (x *MsgNewSpaceTreasury) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateKey) GetKeyId() uint64 {
+func (x *MsgNewSpaceTreasury) GetSpaceId() uint64 {
 	if x != nil {
-		return x.KeyId
+		return x.SpaceId
 	}
 	return 0
 }
 
-func (x *MsgUpdateKey) GetApproveTemplateId() uint64 {
+func (x *MsgNewSpaceTreasury) GetNonce() uint64 {
 	if x != nil {
-		return x.ApproveTemplateId
+		return x.Nonce
 	}
 	return 0
 }
 
-func (x *MsgUpdateKey) GetRejectTemplateId() uint64 {
+type MsgNewSpaceTreasuryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address of the treasury account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *MsgNewSpaceTreasuryResponse) Reset() {
+	*x = MsgNewSpaceTreasuryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgNewSpaceTreasuryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgNewSpaceTreasuryResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgNewSpaceTreasuryResponse.ProtoReflect.Descriptor instead.
+func (*MsgNewSpaceTreasuryResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MsgNewSpaceTreasuryResponse) GetAddress() string {
 	if x != nil {
-		return x.RejectTemplateId
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-type MsgUpdateKeyResponse struct {
+type MsgTreasurySend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Authority string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId   uint64          `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	ToAddress string          `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount    []*v1beta1.Coin `protobuf:"bytes,4,rep,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgUpdateKeyResponse) Reset() {
-	*x = MsgUpdateKeyResponse{}
+func (x *MsgTreasurySend) Reset() {
+	*x = MsgTreasurySend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[26]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateKeyResponse) String() string {
+func (x *MsgTreasurySend) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateKeyResponse) ProtoMessage() {}
+func (*MsgTreasurySend) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateKeyResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateKeyResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MsgTreasurySend.ProtoReflect.Descriptor instead.
+func (*MsgTreasurySend) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{34}
 }
 
-type MsgNewSignRequest struct {
+func (x *MsgTreasurySend) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgTreasurySend) GetSpaceId() uint64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
+func (x *MsgTreasurySend) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *MsgTreasurySend) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type MsgTreasurySendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Authority       string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	KeyId           uint64          `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
-	Input           []byte          `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
-	Analyzers       []string        `protobuf:"bytes,4,rep,name=analyzers,proto3" json:"analyzers,omitempty"`
-	EncryptionKey   []byte          `protobuf:"bytes,5,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
-	MaxKeychainFees []*v1beta1.Coin `protobuf:"bytes,6,rep,name=max_keychain_fees,json=maxKeychainFees,proto3" json:"max_keychain_fees,omitempty"`
-	Nonce           uint64          `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (x *MsgTreasurySendResponse) Reset() {
+	*x = MsgTreasurySendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgNewSignRequest) Reset() {
-	*x = MsgNewSignRequest{}
+func (x *MsgTreasurySendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTreasurySendResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTreasurySendResponse.ProtoReflect.Descriptor instead.
+func (*MsgTreasurySendResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{35}
+}
+
+type MsgTreasuryIBCTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority     string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId       uint64        `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	SourcePort    string        `protobuf:"bytes,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel string        `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	Token         *v1beta1.Coin `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	// address of the receiver on the counterparty chain
+	Receiver string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout height on the counterparty chain, zero values disable the timeout
+	// height
+	TimeoutRevisionNumber uint64 `protobuf:"varint,7,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	TimeoutRevisionHeight uint64 `protobuf:"varint,8,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	// timeout timestamp in nanoseconds on the counterparty chain, zero
+	// disables the timeout timestamp
+	TimeoutTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Memo             string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *MsgTreasuryIBCTransfer) Reset() {
+	*x = MsgTreasuryIBCTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[27]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgNewSignRequest) String() string {
+func (x *MsgTreasuryIBCTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgNewSignRequest) ProtoMessage() {}
+func (*MsgTreasuryIBCTransfer) ProtoMessage() {}
 
-// Deprecated: Use MsgNewSignRequest.ProtoReflect.Descriptor instead.
-func (*MsgNewSignRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use MsgTreasuryIBCTransfer.ProtoReflect.Descriptor instead.
+func (*MsgTreasuryIBCTransfer) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MsgNewSignRequest) GetAuthority() string {
+func (x *MsgTreasuryIBCTransfer) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgNewSignRequest) GetKeyId() uint64 {
+func (x *MsgTreasuryIBCTransfer) GetSpaceId() uint64 {
 	if x != nil {
-		return x.KeyId
+		return x.SpaceId
 	}
 	return 0
 }
 
-func (x *MsgNewSignRequest) GetInput() []byte {
+func (x *MsgTreasuryIBCTransfer) GetSourcePort() string {
 	if x != nil {
-		return x.Input
+		return x.SourcePort
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgNewSignRequest) GetAnalyzers() []string {
+func (x *MsgTreasuryIBCTransfer) GetSourceChannel() string {
 	if x != nil {
-		return x.Analyzers
+		return x.SourceChannel
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgNewSignRequest) GetEncryptionKey() []byte {
+func (x *MsgTreasuryIBCTransfer) GetToken() *v1beta1.Coin {
 	if x != nil {
-		return x.EncryptionKey
+		return x.Token
 	}
 	return nil
 }
 
-func (x *MsgNewSignRequest) GetMaxKeychainFees() []*v1beta1.Coin {
+func (x *MsgTreasuryIBCTransfer) GetReceiver() string {
 	if x != nil {
-		return x.MaxKeychainFees
+		return x.Receiver
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgNewSignRequest) GetNonce() uint64 {
+func (x *MsgTreasuryIBCTransfer) GetTimeoutRevisionNumber() uint64 {
 	if x != nil {
-		return x.Nonce
+		return x.TimeoutRevisionNumber
 	}
 	return 0
 }
 
-type MsgNewSignRequestResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *MsgNewSignRequestResponse) Reset() {
-	*x = MsgNewSignRequestResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MsgTreasuryIBCTransfer) GetTimeoutRevisionHeight() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionHeight
 	}
+	return 0
 }
 
-func (x *MsgNewSignRequestResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgNewSignRequestResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgNewSignRequestResponse.ProtoReflect.Descriptor instead.
-func (*MsgNewSignRequestResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{28}
+func (x *MsgTreasuryIBCTransfer) GetTimeoutTimestamp() uint64 {
+	if x != nil {
+		return x.TimeoutTimestamp
+	}
+	return 0
 }
 
-func (x *MsgNewSignRequestResponse) GetId() uint64 {
+func (x *MsgTreasuryIBCTransfer) GetMemo() string {
 	if x != nil {
-		return x.Id
+		return x.Memo
 	}
-	return 0
+	return ""
 }
 
-type MsgSignedData struct {
+type MsgTreasuryIBCTransferResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignedData []byte `protobuf:"bytes,1,opt,name=signed_data,json=signedData,proto3" json:"signed_data,omitempty"`
+	// sequence number of the IBC packet sent
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (x *MsgSignedData) Reset() {
-	*x = MsgSignedData{}
+func (x *MsgTreasuryIBCTransferResponse) Reset() {
+	*x = MsgTreasuryIBCTransferResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[29]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSignedData) String() string {
+func (x *MsgTreasuryIBCTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSignedData) ProtoMessage() {}
+func (*MsgTreasuryIBCTransferResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSignedData.ProtoReflect.Descriptor instead.
-func (*MsgSignedData) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use MsgTreasuryIBCTransferResponse.ProtoReflect.Descriptor instead.
+func (*MsgTreasuryIBCTransferResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *MsgSignedData) GetSignedData() []byte {
+func (x *MsgTreasuryIBCTransferResponse) GetSequence() uint64 {
 	if x != nil {
-		return x.SignedData
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-type MsgFulfilSignRequest struct {
+type MsgTreasuryExecuteContract struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator   string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	RequestId uint64            `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Status    SignRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=warden.warden.v1beta3.SignRequestStatus" json:"status,omitempty"`
-	// Holds the result of the request. If status is approved, the result will
-	// contain the signed data that was requested
-	// If status is rejected, the result will contain the reason.
-	//
-	// Types that are assignable to Result:
-	//
-	//	*MsgFulfilSignRequest_Payload
-	//	*MsgFulfilSignRequest_RejectReason
-	Result isMsgFulfilSignRequest_Result `protobuf_oneof:"result"`
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Contract  string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// JSON encoded message passed to the contract
+	Msg   []byte          `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Funds []*v1beta1.Coin `protobuf:"bytes,5,rep,name=funds,proto3" json:"funds,omitempty"`
 }
 
-func (x *MsgFulfilSignRequest) Reset() {
-	*x = MsgFulfilSignRequest{}
+func (x *MsgTreasuryExecuteContract) Reset() {
+	*x = MsgTreasuryExecuteContract{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[30]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgFulfilSignRequest) String() string {
+func (x *MsgTreasuryExecuteContract) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgFulfilSignRequest) ProtoMessage() {}
+func (*MsgTreasuryExecuteContract) ProtoMessage() {}
 
-// Deprecated: Use MsgFulfilSignRequest.ProtoReflect.Descriptor instead.
-func (*MsgFulfilSignRequest) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use MsgTreasuryExecuteContract.ProtoReflect.Descriptor instead.
+func (*MsgTreasuryExecuteContract) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *MsgFulfilSignRequest) GetCreator() string {
+func (x *MsgTreasuryExecuteContract) GetAuthority() string {
 	if x != nil {
-		return x.Creator
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgFulfilSignRequest) GetRequestId() uint64 {
+func (x *MsgTreasuryExecuteContract) GetSpaceId() uint64 {
 	if x != nil {
-		return x.RequestId
+		return x.SpaceId
 	}
 	return 0
 }
 
-func (x *MsgFulfilSignRequest) GetStatus() SignRequestStatus {
+func (x *MsgTreasuryExecuteContract) GetContract() string {
 	if x != nil {
-		return x.Status
+		return x.Contract
 	}
-	return SignRequestStatus_SIGN_REQUEST_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *MsgFulfilSignRequest) GetResult() isMsgFulfilSignRequest_Result {
+func (x *MsgTreasuryExecuteContract) GetMsg() []byte {
 	if x != nil {
-		return x.Result
+		return x.Msg
 	}
 	return nil
 }
 
-func (x *MsgFulfilSignRequest) GetPayload() *MsgSignedData {
-	if x, ok := x.GetResult().(*MsgFulfilSignRequest_Payload); ok {
-		return x.Payload
+func (x *MsgTreasuryExecuteContract) GetFunds() []*v1beta1.Coin {
+	if x != nil {
+		return x.Funds
 	}
 	return nil
 }
 
-func (x *MsgFulfilSignRequest) GetRejectReason() string {
-	if x, ok := x.GetResult().(*MsgFulfilSignRequest_RejectReason); ok {
-		return x.RejectReason
-	}
-	return ""
-}
-
-type isMsgFulfilSignRequest_Result interface {
-	isMsgFulfilSignRequest_Result()
-}
-
-type MsgFulfilSignRequest_Payload struct {
-	Payload *MsgSignedData `protobuf:"bytes,4,opt,name=payload,proto3,oneof"`
-}
-
-type MsgFulfilSignRequest_RejectReason struct {
-	RejectReason string `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3,oneof"`
-}
-
-func (*MsgFulfilSignRequest_Payload) isMsgFulfilSignRequest_Result() {}
-
-func (*MsgFulfilSignRequest_RejectReason) isMsgFulfilSignRequest_Result() {}
-
-type MsgFulfilSignRequestResponse struct {
+type MsgTreasuryExecuteContractResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// data returned by the contract
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *MsgFulfilSignRequestResponse) Reset() {
-	*x = MsgFulfilSignRequestResponse{}
+func (x *MsgTreasuryExecuteContractResponse) Reset() {
+	*x = MsgTreasuryExecuteContractResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[31]
+		mi := &file_warden_warden_v1beta3_tx_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgFulfilSignRequestResponse) String() string {
+func (x *MsgTreasuryExecuteContractResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgFulfilSignRequestResponse) ProtoMessage() {}
+func (*MsgTreasuryExecuteContractResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgFulfilSignRequestResponse.ProtoReflect.Descriptor instead.
-func (*MsgFulfilSignRequestResponse) Descriptor() ([]byte, []int) {
-	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use MsgTreasuryExecuteContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgTreasuryExecuteContractResponse) Descriptor() ([]byte, []int) {
+	return file_warden_warden_v1beta3_tx_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MsgTreasuryExecuteContractResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
 var File_warden_warden_v1beta3_tx_proto protoreflect.FileDescriptor
@@ -18257,126 +23150,239 @@ var file_warden_warden_v1beta3_tx_proto_rawDesc = []byte{
 	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
 	0x73, 0x75, 0x6c, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69,
 	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x81, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x66, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x77,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x37, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xad, 0x03, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x49,
+	0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x3a,
+	0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0x3c, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x49, 0x42,
+	0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa6, 0x02,
+	0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x77, 0x0a,
+	0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x38, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x32, 0xe4, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x66, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x1a, 0x2e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x77,
 	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x22, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x1a, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x1a, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67,
-	0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x69, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x27, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x1a, 0x2f, 0x2e, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x1a, 0x32, 0x2e, 0x77, 0x61,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0d,
+	0x41, 0x64, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x1a, 0x2f, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d,
+	0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x77, 0x61,
 	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x63, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x25,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x2d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77,
+	0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x1a, 0x32, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x4e,
+	0x65, 0x77, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x2e, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x1a, 0x2d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77,
+	0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77,
 	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73,
-	0x67, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x33, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x1a, 0x33, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x67, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x1a, 0x33, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
 	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d,
-	0x73, 0x67, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x61, 0x63,
-	0x65, 0x1a, 0x2d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x6c, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x12, 0x28, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x30, 0x2e, 0x77,
-	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65,
-	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
-	0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x27, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33,
-	0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x46, 0x75, 0x6c,
-	0x66, 0x69, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x2d, 0x2e,
 	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x33, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x1a,
-	0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0e,
-	0x4e, 0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x28,
 	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x53, 0x69, 0x67,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
 	0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33,
-	0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x11, 0x46, 0x75,
-	0x6c, 0x66, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2b, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69,
-	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77,
-	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x79, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0d, 0x4e, 0x65,
+	0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67,
+	0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x10, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x33, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77,
 	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73,
-	0x67, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x67, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x09, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x2b, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x53,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67,
+	0x4e, 0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x11, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
 	0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33,
-	0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a,
-	0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x34, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61,
+	0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
+	0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61,
 	0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xed, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
-	0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x3b, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x33, 0xa2, 0x02, 0x03, 0x57, 0x57, 0x58, 0xaa, 0x02, 0x15, 0x57, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x2e, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x33, 0xca, 0x02, 0x15, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x57, 0x61, 0x72, 0x64,
-	0x65, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0xe2, 0x02, 0x21, 0x57, 0x61, 0x72,
-	0x64, 0x65, 0x6e, 0x5c, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x17, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x12, 0x34, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
+	0x10, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x79, 0x12, 0x2a, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x1a, 0x32, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x66, 0x0a, 0x0c, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x53, 0x65, 0x6e,
+	0x64, 0x12, 0x26, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x2e, 0x2e, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x33, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x13, 0x54, 0x72, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x79, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x12, 0x2d, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x79, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x1a,
+	0x35, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x17, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x31, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x39, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x2e, 0x4d, 0x73,
+	0x67, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xed, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x33, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x33, 0x3b, 0x77, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x33, 0xa2, 0x02, 0x03, 0x57, 0x57, 0x58, 0xaa, 0x02, 0x15, 0x57, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x2e, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x33, 0xca, 0x02, 0x15, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x57, 0x61, 0x72, 0x64, 0x65,
+	0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0xe2, 0x02, 0x21, 0x57, 0x61, 0x72, 0x64,
+	0x65, 0x6e, 0x5c, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
+	0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a, 0x57, 0x61, 0x72, 0x64, 0x65, 0x6e, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -18391,93 +23397,112 @@ func file_warden_warden_v1beta3_tx_proto_rawDescGZIP() []byte {
 	return file_warden_warden_v1beta3_tx_proto_rawDescData
 }
 
-var file_warden_warden_v1beta3_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_warden_warden_v1beta3_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_warden_warden_v1beta3_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),                // 0: warden.warden.v1beta3.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),        // 1: warden.warden.v1beta3.MsgUpdateParamsResponse
-	(*MsgNewSpace)(nil),                    // 2: warden.warden.v1beta3.MsgNewSpace
-	(*MsgNewSpaceResponse)(nil),            // 3: warden.warden.v1beta3.MsgNewSpaceResponse
-	(*MsgAddSpaceOwner)(nil),               // 4: warden.warden.v1beta3.MsgAddSpaceOwner
-	(*MsgAddSpaceOwnerResponse)(nil),       // 5: warden.warden.v1beta3.MsgAddSpaceOwnerResponse
-	(*MsgRemoveSpaceOwner)(nil),            // 6: warden.warden.v1beta3.MsgRemoveSpaceOwner
-	(*MsgRemoveSpaceOwnerResponse)(nil),    // 7: warden.warden.v1beta3.MsgRemoveSpaceOwnerResponse
-	(*MsgNewKeychain)(nil),                 // 8: warden.warden.v1beta3.MsgNewKeychain
-	(*MsgNewKeychainResponse)(nil),         // 9: warden.warden.v1beta3.MsgNewKeychainResponse
-	(*MsgAddKeychainWriter)(nil),           // 10: warden.warden.v1beta3.MsgAddKeychainWriter
-	(*MsgAddKeychainWriterResponse)(nil),   // 11: warden.warden.v1beta3.MsgAddKeychainWriterResponse
-	(*MsgUpdateSpace)(nil),                 // 12: warden.warden.v1beta3.MsgUpdateSpace
-	(*MsgUpdateSpaceResponse)(nil),         // 13: warden.warden.v1beta3.MsgUpdateSpaceResponse
-	(*MsgUpdateKeychain)(nil),              // 14: warden.warden.v1beta3.MsgUpdateKeychain
-	(*MsgUpdateKeychainResponse)(nil),      // 15: warden.warden.v1beta3.MsgUpdateKeychainResponse
-	(*MsgAddKeychainAdminRequest)(nil),     // 16: warden.warden.v1beta3.MsgAddKeychainAdminRequest
-	(*MsgAddKeychainAdminResponse)(nil),    // 17: warden.warden.v1beta3.MsgAddKeychainAdminResponse
-	(*MsgRemoveKeychainAdminRequest)(nil),  // 18: warden.warden.v1beta3.MsgRemoveKeychainAdminRequest
-	(*MsgRemoveKeychainAdminResponse)(nil), // 19: warden.warden.v1beta3.MsgRemoveKeychainAdminResponse
-	(*MsgNewKeyRequest)(nil),               // 20: warden.warden.v1beta3.MsgNewKeyRequest
-	(*MsgNewKeyRequestResponse)(nil),       // 21: warden.warden.v1beta3.MsgNewKeyRequestResponse
-	(*MsgNewKey)(nil),                      // 22: warden.warden.v1beta3.MsgNewKey
-	(*MsgFulfilKeyRequest)(nil),            // 23: warden.warden.v1beta3.MsgFulfilKeyRequest
-	(*MsgFulfilKeyRequestResponse)(nil),    // 24: warden.warden.v1beta3.MsgFulfilKeyRequestResponse
-	(*MsgUpdateKey)(nil),                   // 25: warden.warden.v1beta3.MsgUpdateKey
-	(*MsgUpdateKeyResponse)(nil),           // 26: warden.warden.v1beta3.MsgUpdateKeyResponse
-	(*MsgNewSignRequest)(nil),              // 27: warden.warden.v1beta3.MsgNewSignRequest
-	(*MsgNewSignRequestResponse)(nil),      // 28: warden.warden.v1beta3.MsgNewSignRequestResponse
-	(*MsgSignedData)(nil),                  // 29: warden.warden.v1beta3.MsgSignedData
-	(*MsgFulfilSignRequest)(nil),           // 30: warden.warden.v1beta3.MsgFulfilSignRequest
-	(*MsgFulfilSignRequestResponse)(nil),   // 31: warden.warden.v1beta3.MsgFulfilSignRequestResponse
-	(*Params)(nil),                         // 32: warden.warden.v1beta3.Params
-	(*KeychainFees)(nil),                   // 33: warden.warden.v1beta3.KeychainFees
-	(KeyType)(0),                           // 34: warden.warden.v1beta3.KeyType
-	(*v1beta1.Coin)(nil),                   // 35: cosmos.base.v1beta1.Coin
-	(KeyRequestStatus)(0),                  // 36: warden.warden.v1beta3.KeyRequestStatus
-	(SignRequestStatus)(0),                 // 37: warden.warden.v1beta3.SignRequestStatus
+	(*MsgUpdateParams)(nil),                    // 0: warden.warden.v1beta3.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),            // 1: warden.warden.v1beta3.MsgUpdateParamsResponse
+	(*MsgNewSpace)(nil),                        // 2: warden.warden.v1beta3.MsgNewSpace
+	(*MsgNewSpaceResponse)(nil),                // 3: warden.warden.v1beta3.MsgNewSpaceResponse
+	(*MsgAddSpaceOwner)(nil),                   // 4: warden.warden.v1beta3.MsgAddSpaceOwner
+	(*MsgAddSpaceOwnerResponse)(nil),           // 5: warden.warden.v1beta3.MsgAddSpaceOwnerResponse
+	(*MsgRemoveSpaceOwner)(nil),                // 6: warden.warden.v1beta3.MsgRemoveSpaceOwner
+	(*MsgRemoveSpaceOwnerResponse)(nil),        // 7: warden.warden.v1beta3.MsgRemoveSpaceOwnerResponse
+	(*MsgNewKeychain)(nil),                     // 8: warden.warden.v1beta3.MsgNewKeychain
+	(*MsgNewKeychainResponse)(nil),             // 9: warden.warden.v1beta3.MsgNewKeychainResponse
+	(*MsgAddKeychainWriter)(nil),               // 10: warden.warden.v1beta3.MsgAddKeychainWriter
+	(*MsgAddKeychainWriterResponse)(nil),       // 11: warden.warden.v1beta3.MsgAddKeychainWriterResponse
+	(*MsgUpdateSpace)(nil),                     // 12: warden.warden.v1beta3.MsgUpdateSpace
+	(*MsgUpdateSpaceResponse)(nil),             // 13: warden.warden.v1beta3.MsgUpdateSpaceResponse
+	(*MsgUpdateKeychain)(nil),                  // 14: warden.warden.v1beta3.MsgUpdateKeychain
+	(*MsgUpdateKeychainResponse)(nil),          // 15: warden.warden.v1beta3.MsgUpdateKeychainResponse
+	(*MsgAddKeychainAdminRequest)(nil),         // 16: warden.warden.v1beta3.MsgAddKeychainAdminRequest
+	(*MsgAddKeychainAdminResponse)(nil),        // 17: warden.warden.v1beta3.MsgAddKeychainAdminResponse
+	(*MsgRemoveKeychainAdminRequest)(nil),      // 18: warden.warden.v1beta3.MsgRemoveKeychainAdminRequest
+	(*MsgRemoveKeychainAdminResponse)(nil),     // 19: warden.warden.v1beta3.MsgRemoveKeychainAdminResponse
+	(*MsgNewKeyRequest)(nil),                   // 20: warden.warden.v1beta3.MsgNewKeyRequest
+	(*MsgNewKeyRequestResponse)(nil),           // 21: warden.warden.v1beta3.MsgNewKeyRequestResponse
+	(*MsgNewKey)(nil),                          // 22: warden.warden.v1beta3.MsgNewKey
+	(*MsgFulfilKeyRequest)(nil),                // 23: warden.warden.v1beta3.MsgFulfilKeyRequest
+	(*MsgFulfilKeyRequestResponse)(nil),        // 24: warden.warden.v1beta3.MsgFulfilKeyRequestResponse
+	(*MsgUpdateKey)(nil),                       // 25: warden.warden.v1beta3.MsgUpdateKey
+	(*MsgUpdateKeyResponse)(nil),               // 26: warden.warden.v1beta3.MsgUpdateKeyResponse
+	(*MsgNewSignRequest)(nil),                  // 27: warden.warden.v1beta3.MsgNewSignRequest
+	(*MsgNewSignRequestResponse)(nil),          // 28: warden.warden.v1beta3.MsgNewSignRequestResponse
+	(*MsgSignedData)(nil),                      // 29: warden.warden.v1beta3.MsgSignedData
+	(*MsgFulfilSignRequest)(nil),               // 30: warden.warden.v1beta3.MsgFulfilSignRequest
+	(*MsgFulfilSignRequestResponse)(nil),       // 31: warden.warden.v1beta3.MsgFulfilSignRequestResponse
+	(*MsgNewSpaceTreasury)(nil),                // 32: warden.warden.v1beta3.MsgNewSpaceTreasury
+	(*MsgNewSpaceTreasuryResponse)(nil),        // 33: warden.warden.v1beta3.MsgNewSpaceTreasuryResponse
+	(*MsgTreasurySend)(nil),                    // 34: warden.warden.v1beta3.MsgTreasurySend
+	(*MsgTreasurySendResponse)(nil),            // 35: warden.warden.v1beta3.MsgTreasurySendResponse
+	(*MsgTreasuryIBCTransfer)(nil),             // 36: warden.warden.v1beta3.MsgTreasuryIBCTransfer
+	(*MsgTreasuryIBCTransferResponse)(nil),     // 37: warden.warden.v1beta3.MsgTreasuryIBCTransferResponse
+	(*MsgTreasuryExecuteContract)(nil),         // 38: warden.warden.v1beta3.MsgTreasuryExecuteContract
+	(*MsgTreasuryExecuteContractResponse)(nil), // 39: warden.warden.v1beta3.MsgTreasuryExecuteContractResponse
+	(*Params)(nil),                             // 40: warden.warden.v1beta3.Params
+	(*KeychainFees)(nil),                       // 41: warden.warden.v1beta3.KeychainFees
+	(KeyType)(0),                               // 42: warden.warden.v1beta3.KeyType
+	(*v1beta1.Coin)(nil),                       // 43: cosmos.base.v1beta1.Coin
+	(KeyRequestStatus)(0),                      // 44: warden.warden.v1beta3.KeyRequestStatus
+	(SignRequestStatus)(0),                     // 45: warden.warden.v1beta3.SignRequestStatus
 }
 var file_warden_warden_v1beta3_tx_proto_depIdxs = []int32{
-	32, // 0: warden.warden.v1beta3.MsgUpdateParams.params:type_name -> warden.warden.v1beta3.Params
-	33, // 1: warden.warden.v1beta3.MsgNewKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
-	33, // 2: warden.warden.v1beta3.MsgUpdateKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
-	34, // 3: warden.warden.v1beta3.MsgNewKeyRequest.key_type:type_name -> warden.warden.v1beta3.KeyType
-	35, // 4: warden.warden.v1beta3.MsgNewKeyRequest.max_keychain_fees:type_name -> cosmos.base.v1beta1.Coin
-	36, // 5: warden.warden.v1beta3.MsgFulfilKeyRequest.status:type_name -> warden.warden.v1beta3.KeyRequestStatus
+	40, // 0: warden.warden.v1beta3.MsgUpdateParams.params:type_name -> warden.warden.v1beta3.Params
+	41, // 1: warden.warden.v1beta3.MsgNewKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
+	41, // 2: warden.warden.v1beta3.MsgUpdateKeychain.keychain_fees:type_name -> warden.warden.v1beta3.KeychainFees
+	42, // 3: warden.warden.v1beta3.MsgNewKeyRequest.key_type:type_name -> warden.warden.v1beta3.KeyType
+	43, // 4: warden.warden.v1beta3.MsgNewKeyRequest.max_keychain_fees:type_name -> cosmos.base.v1beta1.Coin
+	44, // 5: warden.warden.v1beta3.MsgFulfilKeyRequest.status:type_name -> warden.warden.v1beta3.KeyRequestStatus
 	22, // 6: warden.warden.v1beta3.MsgFulfilKeyRequest.key:type_name -> warden.warden.v1beta3.MsgNewKey
-	35, // 7: warden.warden.v1beta3.MsgNewSignRequest.max_keychain_fees:type_name -> cosmos.base.v1beta1.Coin
-	37, // 8: warden.warden.v1beta3.MsgFulfilSignRequest.status:type_name -> warden.warden.v1beta3.SignRequestStatus
+	43, // 7: warden.warden.v1beta3.MsgNewSignRequest.max_keychain_fees:type_name -> cosmos.base.v1beta1.Coin
+	45, // 8: warden.warden.v1beta3.MsgFulfilSignRequest.status:type_name -> warden.warden.v1beta3.SignRequestStatus
 	29, // 9: warden.warden.v1beta3.MsgFulfilSignRequest.payload:type_name -> warden.warden.v1beta3.MsgSignedData
-	0,  // 10: warden.warden.v1beta3.Msg.UpdateParams:input_type -> warden.warden.v1beta3.MsgUpdateParams
-	2,  // 11: warden.warden.v1beta3.Msg.NewSpace:input_type -> warden.warden.v1beta3.MsgNewSpace
-	4,  // 12: warden.warden.v1beta3.Msg.AddSpaceOwner:input_type -> warden.warden.v1beta3.MsgAddSpaceOwner
-	6,  // 13: warden.warden.v1beta3.Msg.RemoveSpaceOwner:input_type -> warden.warden.v1beta3.MsgRemoveSpaceOwner
-	8,  // 14: warden.warden.v1beta3.Msg.NewKeychain:input_type -> warden.warden.v1beta3.MsgNewKeychain
-	10, // 15: warden.warden.v1beta3.Msg.AddKeychainWriter:input_type -> warden.warden.v1beta3.MsgAddKeychainWriter
-	12, // 16: warden.warden.v1beta3.Msg.UpdateSpace:input_type -> warden.warden.v1beta3.MsgUpdateSpace
-	14, // 17: warden.warden.v1beta3.Msg.UpdateKeychain:input_type -> warden.warden.v1beta3.MsgUpdateKeychain
-	20, // 18: warden.warden.v1beta3.Msg.NewKeyRequest:input_type -> warden.warden.v1beta3.MsgNewKeyRequest
-	23, // 19: warden.warden.v1beta3.Msg.FulfilKeyRequest:input_type -> warden.warden.v1beta3.MsgFulfilKeyRequest
-	25, // 20: warden.warden.v1beta3.Msg.UpdateKey:input_type -> warden.warden.v1beta3.MsgUpdateKey
-	27, // 21: warden.warden.v1beta3.Msg.NewSignRequest:input_type -> warden.warden.v1beta3.MsgNewSignRequest
-	30, // 22: warden.warden.v1beta3.Msg.FulfilSignRequest:input_type -> warden.warden.v1beta3.MsgFulfilSignRequest
-	16, // 23: warden.warden.v1beta3.Msg.AddKeychainAdmin:input_type -> warden.warden.v1beta3.MsgAddKeychainAdminRequest
-	18, // 24: warden.warden.v1beta3.Msg.RemoveKeychainAdmin:input_type -> warden.warden.v1beta3.MsgRemoveKeychainAdminRequest
-	1,  // 25: warden.warden.v1beta3.Msg.UpdateParams:output_type -> warden.warden.v1beta3.MsgUpdateParamsResponse
-	3,  // 26: warden.warden.v1beta3.Msg.NewSpace:output_type -> warden.warden.v1beta3.MsgNewSpaceResponse
-	5,  // 27: warden.warden.v1beta3.Msg.AddSpaceOwner:output_type -> warden.warden.v1beta3.MsgAddSpaceOwnerResponse
-	7,  // 28: warden.warden.v1beta3.Msg.RemoveSpaceOwner:output_type -> warden.warden.v1beta3.MsgRemoveSpaceOwnerResponse
-	9,  // 29: warden.warden.v1beta3.Msg.NewKeychain:output_type -> warden.warden.v1beta3.MsgNewKeychainResponse
-	11, // 30: warden.warden.v1beta3.Msg.AddKeychainWriter:output_type -> warden.warden.v1beta3.MsgAddKeychainWriterResponse
-	13, // 31: warden.warden.v1beta3.Msg.UpdateSpace:output_type -> warden.warden.v1beta3.MsgUpdateSpaceResponse
-	15, // 32: warden.warden.v1beta3.Msg.UpdateKeychain:output_type -> warden.warden.v1beta3.MsgUpdateKeychainResponse
-	21, // 33: warden.warden.v1beta3.Msg.NewKeyRequest:output_type -> warden.warden.v1beta3.MsgNewKeyRequestResponse
-	24, // 34: warden.warden.v1beta3.Msg.FulfilKeyRequest:output_type -> warden.warden.v1beta3.MsgFulfilKeyRequestResponse
-	26, // 35: warden.warden.v1beta3.Msg.UpdateKey:output_type -> warden.warden.v1beta3.MsgUpdateKeyResponse
-	28, // 36: warden.warden.v1beta3.Msg.NewSignRequest:output_type -> warden.warden.v1beta3.MsgNewSignRequestResponse
-	31, // 37: warden.warden.v1beta3.Msg.FulfilSignRequest:output_type -> warden.warden.v1beta3.MsgFulfilSignRequestResponse
-	17, // 38: warden.warden.v1beta3.Msg.AddKeychainAdmin:output_type -> warden.warden.v1beta3.MsgAddKeychainAdminResponse
-	19, // 39: warden.warden.v1beta3.Msg.RemoveKeychainAdmin:output_type -> warden.warden.v1beta3.MsgRemoveKeychainAdminResponse
-	25, // [25:40] is the sub-list for method output_type
-	10, // [10:25] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	43, // 10: warden.warden.v1beta3.MsgTreasurySend.amount:type_name -> cosmos.base.v1beta1.Coin
+	43, // 11: warden.warden.v1beta3.MsgTreasuryIBCTransfer.token:type_name -> cosmos.base.v1beta1.Coin
+	43, // 12: warden.warden.v1beta3.MsgTreasuryExecuteContract.funds:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 13: warden.warden.v1beta3.Msg.UpdateParams:input_type -> warden.warden.v1beta3.MsgUpdateParams
+	2,  // 14: warden.warden.v1beta3.Msg.NewSpace:input_type -> warden.warden.v1beta3.MsgNewSpace
+	4,  // 15: warden.warden.v1beta3.Msg.AddSpaceOwner:input_type -> warden.warden.v1beta3.MsgAddSpaceOwner
+	6,  // 16: warden.warden.v1beta3.Msg.RemoveSpaceOwner:input_type -> warden.warden.v1beta3.MsgRemoveSpaceOwner
+	8,  // 17: warden.warden.v1beta3.Msg.NewKeychain:input_type -> warden.warden.v1beta3.MsgNewKeychain
+	10, // 18: warden.warden.v1beta3.Msg.AddKeychainWriter:input_type -> warden.warden.v1beta3.MsgAddKeychainWriter
+	12, // 19: warden.warden.v1beta3.Msg.UpdateSpace:input_type -> warden.warden.v1beta3.MsgUpdateSpace
+	14, // 20: warden.warden.v1beta3.Msg.UpdateKeychain:input_type -> warden.warden.v1beta3.MsgUpdateKeychain
+	20, // 21: warden.warden.v1beta3.Msg.NewKeyRequest:input_type -> warden.warden.v1beta3.MsgNewKeyRequest
+	23, // 22: warden.warden.v1beta3.Msg.FulfilKeyRequest:input_type -> warden.warden.v1beta3.MsgFulfilKeyRequest
+	25, // 23: warden.warden.v1beta3.Msg.UpdateKey:input_type -> warden.warden.v1beta3.MsgUpdateKey
+	27, // 24: warden.warden.v1beta3.Msg.NewSignRequest:input_type -> warden.warden.v1beta3.MsgNewSignRequest
+	30, // 25: warden.warden.v1beta3.Msg.FulfilSignRequest:input_type -> warden.warden.v1beta3.MsgFulfilSignRequest
+	16, // 26: warden.warden.v1beta3.Msg.AddKeychainAdmin:input_type -> warden.warden.v1beta3.MsgAddKeychainAdminRequest
+	18, // 27: warden.warden.v1beta3.Msg.RemoveKeychainAdmin:input_type -> warden.warden.v1beta3.MsgRemoveKeychainAdminRequest
+	32, // 28: warden.warden.v1beta3.Msg.NewSpaceTreasury:input_type -> warden.warden.v1beta3.MsgNewSpaceTreasury
+	34, // 29: warden.warden.v1beta3.Msg.TreasurySend:input_type -> warden.warden.v1beta3.MsgTreasurySend
+	36, // 30: warden.warden.v1beta3.Msg.TreasuryIBCTransfer:input_type -> warden.warden.v1beta3.MsgTreasuryIBCTransfer
+	38, // 31: warden.warden.v1beta3.Msg.TreasuryExecuteContract:input_type -> warden.warden.v1beta3.MsgTreasuryExecuteContract
+	1,  // 32: warden.warden.v1beta3.Msg.UpdateParams:output_type -> warden.warden.v1beta3.MsgUpdateParamsResponse
+	3,  // 33: warden.warden.v1beta3.Msg.NewSpace:output_type -> warden.warden.v1beta3.MsgNewSpaceResponse
+	5,  // 34: warden.warden.v1beta3.Msg.AddSpaceOwner:output_type -> warden.warden.v1beta3.MsgAddSpaceOwnerResponse
+	7,  // 35: warden.warden.v1beta3.Msg.RemoveSpaceOwner:output_type -> warden.warden.v1beta3.MsgRemoveSpaceOwnerResponse
+	9,  // 36: warden.warden.v1beta3.Msg.NewKeychain:output_type -> warden.warden.v1beta3.MsgNewKeychainResponse
+	11, // 37: warden.warden.v1beta3.Msg.AddKeychainWriter:output_type -> warden.warden.v1beta3.MsgAddKeychainWriterResponse
+	13, // 38: warden.warden.v1beta3.Msg.UpdateSpace:output_type -> warden.warden.v1beta3.MsgUpdateSpaceResponse
+	15, // 39: warden.warden.v1beta3.Msg.UpdateKeychain:output_type -> warden.warden.v1beta3.MsgUpdateKeychainResponse
+	21, // 40: warden.warden.v1beta3.Msg.NewKeyRequest:output_type -> warden.warden.v1beta3.MsgNewKeyRequestResponse
+	24, // 41: warden.warden.v1beta3.Msg.FulfilKeyRequest:output_type -> warden.warden.v1beta3.MsgFulfilKeyRequestResponse
+	26, // 42: warden.warden.v1beta3.Msg.UpdateKey:output_type -> warden.warden.v1beta3.MsgUpdateKeyResponse
+	28, // 43: warden.warden.v1beta3.Msg.NewSignRequest:output_type -> warden.warden.v1beta3.MsgNewSignRequestResponse
+	31, // 44: warden.warden.v1beta3.Msg.FulfilSignRequest:output_type -> warden.warden.v1beta3.MsgFulfilSignRequestResponse
+	17, // 45: warden.warden.v1beta3.Msg.AddKeychainAdmin:output_type -> warden.warden.v1beta3.MsgAddKeychainAdminResponse
+	19, // 46: warden.warden.v1beta3.Msg.RemoveKeychainAdmin:output_type -> warden.warden.v1beta3.MsgRemoveKeychainAdminResponse
+	33, // 47: warden.warden.v1beta3.Msg.NewSpaceTreasury:output_type -> warden.warden.v1beta3.MsgNewSpaceTreasuryResponse
+	35, // 48: warden.warden.v1beta3.Msg.TreasurySend:output_type -> warden.warden.v1beta3.MsgTreasurySendResponse
+	37, // 49: warden.warden.v1beta3.Msg.TreasuryIBCTransfer:output_type -> warden.warden.v1beta3.MsgTreasuryIBCTransferResponse
+	39, // 50: warden.warden.v1beta3.Msg.TreasuryExecuteContract:output_type -> warden.warden.v1beta3.MsgTreasuryExecuteContractResponse
+	32, // [32:51] is the sub-list for method output_type
+	13, // [13:32] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_warden_warden_v1beta3_tx_proto_init() }
@@ -18874,6 +23899,102 @@ func file_warden_warden_v1beta3_tx_proto_init() {
 				return nil
 			}
 		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgNewSpaceTreasury); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgNewSpaceTreasuryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTreasurySend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTreasurySendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTreasuryIBCTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTreasuryIBCTransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTreasuryExecuteContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_warden_warden_v1beta3_tx_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTreasuryExecuteContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_warden_warden_v1beta3_tx_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*MsgFulfilKeyRequest_Key)(nil),
@@ -18889,7 +24010,7 @@ func file_warden_warden_v1beta3_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_warden_warden_v1beta3_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,21 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName        = "/warden.warden.v1beta3.Msg/UpdateParams"
-	Msg_NewSpace_FullMethodName            = "/warden.warden.v1beta3.Msg/NewSpace"
-	Msg_AddSpaceOwner_FullMethodName       = "/warden.warden.v1beta3.Msg/AddSpaceOwner"
-	Msg_RemoveSpaceOwner_FullMethodName    = "/warden.warden.v1beta3.Msg/RemoveSpaceOwner"
-	Msg_NewKeychain_FullMethodName         = "/warden.warden.v1beta3.Msg/NewKeychain"
-	Msg_AddKeychainWriter_FullMethodName   = "/warden.warden.v1beta3.Msg/AddKeychainWriter"
-	Msg_UpdateSpace_FullMethodName         = "/warden.warden.v1beta3.Msg/UpdateSpace"
-	Msg_UpdateKeychain_FullMethodName      = "/warden.warden.v1beta3.Msg/UpdateKeychain"
-	Msg_NewKeyRequest_FullMethodName       = "/warden.warden.v1beta3.Msg/NewKeyRequest"
-	Msg_FulfilKeyRequest_FullMethodName    = "/warden.warden.v1beta3.Msg/FulfilKeyRequest"
-	Msg_UpdateKey_FullMethodName           = "/warden.warden.v1beta3.Msg/UpdateKey"
-	Msg_NewSignRequest_FullMethodName      = "/warden.warden.v1beta3.Msg/NewSignRequest"
-	Msg_FulfilSignRequest_FullMethodName   = "/warden.warden.v1beta3.Msg/FulfilSignRequest"
-	Msg_AddKeychainAdmin_FullMethodName    = "/warden.warden.v1beta3.Msg/AddKeychainAdmin"
-	Msg_RemoveKeychainAdmin_FullMethodName = "/warden.warden.v1beta3.Msg/RemoveKeychainAdmin"
+	Msg_UpdateParams_FullMethodName            = "/warden.warden.v1beta3.Msg/UpdateParams"
+	Msg_NewSpace_FullMethodName                = "/warden.warden.v1beta3.Msg/NewSpace"
+	Msg_AddSpaceOwner_FullMethodName           = "/warden.warden.v1beta3.Msg/AddSpaceOwner"
+	Msg_RemoveSpaceOwner_FullMethodName        = "/warden.warden.v1beta3.Msg/RemoveSpaceOwner"
+	Msg_NewKeychain_FullMethodName             = "/warden.warden.v1beta3.Msg/NewKeychain"
+	Msg_AddKeychainWriter_FullMethodName       = "/warden.warden.v1beta3.Msg/AddKeychainWriter"
+	Msg_UpdateSpace_FullMethodName             = "/warden.warden.v1beta3.Msg/UpdateSpace"
+	Msg_UpdateKeychain_FullMethodName          = "/warden.warden.v1beta3.Msg/UpdateKeychain"
+	Msg_NewKeyRequest_FullMethodName           = "/warden.warden.v1beta3.Msg/NewKeyRequest"
+	Msg_FulfilKeyRequest_FullMethodName        = "/warden.warden.v1beta3.Msg/FulfilKeyRequest"
+	Msg_UpdateKey_FullMethodName               = "/warden.warden.v1beta3.Msg/UpdateKey"
+	Msg_NewSignRequest_FullMethodName          = "/warden.warden.v1beta3.Msg/NewSignRequest"
+	Msg_FulfilSignRequest_FullMethodName       = "/warden.warden.v1beta3.Msg/FulfilSignRequest"
+	Msg_AddKeychainAdmin_FullMethodName        = "/warden.warden.v1beta3.Msg/AddKeychainAdmin"
+	Msg_RemoveKeychainAdmin_FullMethodName     = "/warden.warden.v1beta3.Msg/RemoveKeychainAdmin"
+	Msg_NewSpaceTreasury_FullMethodName        = "/warden.warden.v1beta3.Msg/NewSpaceTreasury"
+	Msg_TreasurySend_FullMethodName            = "/warden.warden.v1beta3.Msg/TreasurySend"
+	Msg_TreasuryIBCTransfer_FullMethodName     = "/warden.warden.v1beta3.Msg/TreasuryIBCTransfer"
+	Msg_TreasuryExecuteContract_FullMethodName = "/warden.warden.v1beta3.Msg/TreasuryExecuteContract"
 )
 
 // MsgClient is the client API for Msg service.
@@ -69,8 +73,16 @@ type MsgClient interface {
 	FulfilSignRequest(ctx context.Context, in *MsgFulfilSignRequest, opts ...grpc.CallOption) (*MsgFulfilSignRequestResponse, error)
 	// Add a new admin to a Keychain
 	AddKeychainAdmin(ctx context.Context, in *MsgAddKeychainAdminRequest, opts ...grpc.CallOption) (*MsgAddKeychainAdminResponse, error)
-	// / Remove admin from a Keychain
+	/// Remove admin from a Keychain
 	RemoveKeychainAdmin(ctx context.Context, in *MsgRemoveKeychainAdminRequest, opts ...grpc.CallOption) (*MsgRemoveKeychainAdminResponse, error)
+	// Create the treasury account of a Space.
+	NewSpaceTreasury(ctx context.Context, in *MsgNewSpaceTreasury, opts ...grpc.CallOption) (*MsgNewSpaceTreasuryResponse, error)
+	// Send coins from the treasury of a Space.
+	TreasurySend(ctx context.Context, in *MsgTreasurySend, opts ...grpc.CallOption) (*MsgTreasurySendResponse, error)
+	// Transfer coins from the treasury of a Space to another chain over IBC.
+	TreasuryIBCTransfer(ctx context.Context, in *MsgTreasuryIBCTransfer, opts ...grpc.CallOption) (*MsgTreasuryIBCTransferResponse, error)
+	// Execute a CosmWasm contract on behalf of the treasury of a Space.
+	TreasuryExecuteContract(ctx context.Context, in *MsgTreasuryExecuteContract, opts ...grpc.CallOption) (*MsgTreasuryExecuteContractResponse, error)
 }
 
 type msgClient struct {
@@ -216,6 +228,42 @@ func (c *msgClient) RemoveKeychainAdmin(ctx context.Context, in *MsgRemoveKeycha
 	return out, nil
 }
 
+func (c *msgClient) NewSpaceTreasury(ctx context.Context, in *MsgNewSpaceTreasury, opts ...grpc.CallOption) (*MsgNewSpaceTreasuryResponse, error) {
+	out := new(MsgNewSpaceTreasuryResponse)
+	err := c.cc.Invoke(ctx, Msg_NewSpaceTreasury_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TreasurySend(ctx context.Context, in *MsgTreasurySend, opts ...grpc.CallOption) (*MsgTreasurySendResponse, error) {
+	out := new(MsgTreasurySendResponse)
+	err := c.cc.Invoke(ctx, Msg_TreasurySend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TreasuryIBCTransfer(ctx context.Context, in *MsgTreasuryIBCTransfer, opts ...grpc.CallOption) (*MsgTreasuryIBCTransferResponse, error) {
+	out := new(MsgTreasuryIBCTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_TreasuryIBCTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TreasuryExecuteContract(ctx context.Context, in *MsgTreasuryExecuteContract, opts ...grpc.CallOption) (*MsgTreasuryExecuteContractResponse, error) {
+	out := new(MsgTreasuryExecuteContractResponse)
+	err := c.cc.Invoke(ctx, Msg_TreasuryExecuteContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -249,8 +297,16 @@ type MsgServer interface {
 	FulfilSignRequest(context.Context, *MsgFulfilSignRequest) (*MsgFulfilSignRequestResponse, error)
 	// Add a new admin to a Keychain
 	AddKeychainAdmin(context.Context, *MsgAddKeychainAdminRequest) (*MsgAddKeychainAdminResponse, error)
-	// / Remove admin from a Keychain
+	/// Remove admin from a Keychain
 	RemoveKeychainAdmin(context.Context, *MsgRemoveKeychainAdminRequest) (*MsgRemoveKeychainAdminResponse, error)
+	// Create the treasury account of a Space.
+	NewSpaceTreasury(context.Context, *MsgNewSpaceTreasury) (*MsgNewSpaceTreasuryResponse, error)
+	// Send coins from the treasury of a Space.
+	TreasurySend(context.Context, *MsgTreasurySend) (*MsgTreasurySendResponse, error)
+	// Transfer coins from the treasury of a Space to another chain over IBC.
+	TreasuryIBCTransfer(context.Context, *MsgTreasuryIBCTransfer) (*MsgTreasuryIBCTransferResponse, error)
+	// Execute a CosmWasm contract on behalf of the treasury of a Space.
+	TreasuryExecuteContract(context.Context, *MsgTreasuryExecuteContract) (*MsgTreasuryExecuteContractResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -303,6 +359,18 @@ func (UnimplementedMsgServer) AddKeychainAdmin(context.Context, *MsgAddKeychainA
 func (UnimplementedMsgServer) RemoveKeychainAdmin(context.Context, *MsgRemoveKeychainAdminRequest) (*MsgRemoveKeychainAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeychainAdmin not implemented")
 }
+func (UnimplementedMsgServer) NewSpaceTreasury(context.Context, *MsgNewSpaceTreasury) (*MsgNewSpaceTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewSpaceTreasury not implemented")
+}
+func (UnimplementedMsgServer) TreasurySend(context.Context, *MsgTreasurySend) (*MsgTreasurySendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasurySend not implemented")
+}
+func (UnimplementedMsgServer) TreasuryIBCTransfer(context.Context, *MsgTreasuryIBCTransfer) (*MsgTreasuryIBCTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryIBCTransfer not implemented")
+}
+func (UnimplementedMsgServer) TreasuryExecuteContract(context.Context, *MsgTreasuryExecuteContract) (*MsgTreasuryExecuteContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryExecuteContract not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -586,6 +654,78 @@ func _Msg_RemoveKeychainAdmin_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_NewSpaceTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewSpaceTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).NewSpaceTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_NewSpaceTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewSpaceTreasury(ctx, req.(*MsgNewSpaceTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TreasurySend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTreasurySend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TreasurySend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TreasurySend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TreasurySend(ctx, req.(*MsgTreasurySend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TreasuryIBCTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTreasuryIBCTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TreasuryIBCTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TreasuryIBCTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TreasuryIBCTransfer(ctx, req.(*MsgTreasuryIBCTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TreasuryExecuteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTreasuryExecuteContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TreasuryExecuteContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TreasuryExecuteContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TreasuryExecuteContract(ctx, req.(*MsgTreasuryExecuteContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -653,6 +793,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveKeychainAdmin",
 			Handler:    _Msg_RemoveKeychainAdmin_Handler,
 		},
+		{
+			MethodName: "NewSpaceTreasury",
+			Handler:    _Msg_NewSpaceTreasury_Handler,
+		},
+		{
+			MethodName: "TreasurySend",
+			Handler:    _Msg_TreasurySend_Handler,
+		},
+		{
+			MethodName: "TreasuryIBCTransfer",
+			Handler:    _Msg_TreasuryIBCTransfer_Handler,
+		},
+		{
+			MethodName: "TreasuryExecuteContract",
+			Handler:    _Msg_TreasuryExecuteContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "warden/warden/v1beta3/tx.proto",
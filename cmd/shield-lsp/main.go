@@ -0,0 +1,17 @@
+// shield-lsp is a language server for Shield expressions. It communicates
+// with the editor over stdin and stdout.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/warden-protocol/wardenprotocol/shield/lsp"
+)
+
+func main() {
+	if err := lsp.NewServer(os.Stdin, os.Stdout).Run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+)
+
+// runFmt formats the expressions contained in the files passed as arguments,
+// or in the standard input if there are none.
+func runFmt(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: shield fmt [-s] [-l] [-w] [file ...]")
+		fs.PrintDefaults()
+	}
+	simplify := fs.Bool("s", false, "simplify expressions")
+	list := fs.Bool("l", false, "list files whose formatting differs")
+	write := fs.Bool("w", false, "write result to the source file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		if *write {
+			return errors.New("cannot use -w with standard input")
+		}
+
+		src, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+
+		return processFile("<standard input>", src, stdout, *simplify, *list, false)
+	}
+
+	for _, path := range fs.Args() {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if err := processFile(path, src, stdout, *simplify, *list, *write); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func processFile(path string, src []byte, stdout io.Writer, simplify, list, write bool) error {
+	res, err := formatSource(src, simplify)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	changed := !bytes.Equal(src, res)
+	if list && changed {
+		fmt.Fprintln(stdout, path)
+	}
+
+	if write {
+		if !changed {
+			return nil
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		return os.WriteFile(path, res, info.Mode().Perm())
+	}
+
+	if !list {
+		_, err = stdout.Write(res)
+	}
+
+	return err
+}
+
+// formatSource returns the canonical text of the expression in src,
+// terminated by a newline.
+func formatSource(src []byte, simplify bool) ([]byte, error) {
+	root, err := shield.Parse(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	if simplify {
+		root = shield.Simplify(root)
+	}
+
+	return []byte(shield.Format(root) + "\n"), nil
+}
@@ -0,0 +1,45 @@
+// shield is a tool for working with Shield expressions.
+//
+// Usage:
+//
+//	shield fmt [-s] [-l] [-w] [file ...]
+//	shield test [-v] [-run regexp] file ...
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `usage: shield <command> [arguments]
+
+commands:
+  fmt    format Shield expressions
+  test   run the test cases of Shield spec files
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "fmt":
+		err = runFmt(args, os.Stdin, os.Stdout)
+	case "test":
+		err = runTest(args, os.Stdout)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "shield: unknown command %q\n\n%s", cmd, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "shield %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
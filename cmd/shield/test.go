@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/warden-protocol/wardenprotocol/shield/shieldtest"
+)
+
+// runTest runs the test cases of the spec files passed as arguments and
+// reports the failures.
+func runTest(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: shield test [-v] [-run regexp] file ...")
+		fs.PrintDefaults()
+	}
+	verbose := fs.Bool("v", false, "print the passing test cases too")
+	run := fs.String("run", "", "run only the test cases whose name matches the regular expression")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("no spec files")
+	}
+
+	filter, err := regexp.Compile(*run)
+	if err != nil {
+		return fmt.Errorf("invalid -run: %w", err)
+	}
+
+	ctx := context.Background()
+	passed, failed := 0, 0
+	for _, path := range fs.Args() {
+		spec, err := shieldtest.Load(path)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range spec.Tests {
+			if !filter.MatchString(c.Name) {
+				continue
+			}
+
+			if err := spec.RunCase(ctx, c); err != nil {
+				failed++
+				fmt.Fprintf(stdout, "--- FAIL: %s: %s\n    %s\n", path, c.Name, strings.ReplaceAll(err.Error(), "\n", "\n    "))
+				continue
+			}
+
+			passed++
+			if *verbose {
+				fmt.Fprintf(stdout, "--- PASS: %s: %s\n", path, c.Name)
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Fprintf(stdout, "FAIL (%d passed, %d failed)\n", passed, failed)
+		return fmt.Errorf("%d test cases failed", failed)
+	}
+
+	fmt.Fprintf(stdout, "ok (%d passed)\n", passed)
+	return nil
+}
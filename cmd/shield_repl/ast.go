@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+)
+
+// printAST writes exp to w as an indented tree, one node per line.
+func printAST(w io.Writer, exp *ast.Expression) {
+	printNode(w, exp, 0, "")
+}
+
+func printNode(w io.Writer, exp *ast.Expression, depth int, label string) {
+	indent := strings.Repeat("  ", depth)
+
+	switch n := exp.Value.(type) {
+	case *ast.Expression_Identifier:
+		fmt.Fprintf(w, "%s%sIdentifier %s\n", indent, label, n.Identifier.Value)
+	case *ast.Expression_IntegerLiteral:
+		fmt.Fprintf(w, "%s%sIntegerLiteral %s\n", indent, label, n.IntegerLiteral.Value)
+	case *ast.Expression_BooleanLiteral:
+		fmt.Fprintf(w, "%s%sBooleanLiteral %t\n", indent, label, n.BooleanLiteral.Value)
+	case *ast.Expression_StringLiteral:
+		fmt.Fprintf(w, "%s%sStringLiteral %q\n", indent, label, n.StringLiteral.Value)
+	case *ast.Expression_ArrayLiteral:
+		fmt.Fprintf(w, "%s%sArrayLiteral (%d elements)\n", indent, label, len(n.ArrayLiteral.Elements))
+		for _, e := range n.ArrayLiteral.Elements {
+			printNode(w, e, depth+1, "")
+		}
+	case *ast.Expression_ObjectLiteral:
+		fmt.Fprintf(w, "%s%sObjectLiteral (%d entries)\n", indent, label, len(n.ObjectLiteral.Entries))
+		for _, e := range n.ObjectLiteral.Entries {
+			printNode(w, e.Value, depth+1, fmt.Sprintf("%q: ", e.Key))
+		}
+	case *ast.Expression_IndexExpression:
+		fmt.Fprintf(w, "%s%sIndexExpression\n", indent, label)
+		printNode(w, n.IndexExpression.Left, depth+1, "left: ")
+		printNode(w, n.IndexExpression.Index, depth+1, "index: ")
+	case *ast.Expression_PrefixExpression:
+		fmt.Fprintf(w, "%s%sPrefixExpression %s\n", indent, label, n.PrefixExpression.Operator)
+		printNode(w, n.PrefixExpression.Right, depth+1, "")
+	case *ast.Expression_InfixExpression:
+		fmt.Fprintf(w, "%s%sInfixExpression %s\n", indent, label, n.InfixExpression.Operator)
+		printNode(w, n.InfixExpression.Left, depth+1, "")
+		printNode(w, n.InfixExpression.Right, depth+1, "")
+	case *ast.Expression_CallExpression:
+		fmt.Fprintf(w, "%s%sCallExpression %s (%d arguments)\n", indent, label, n.CallExpression.Function.Value, len(n.CallExpression.Arguments))
+		for _, a := range n.CallExpression.Arguments {
+			printNode(w, a, depth+1, "")
+		}
+	default:
+		fmt.Fprintf(w, "%s%s%T\n", indent, label, exp.Value)
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+// environment resolves identifiers to the values defined with :set and
+// :load, dotted identifiers select the fields of objects. If a vote set is
+// loaded, the other identifiers resolve to true if they are part of it and to
+// false otherwise, like in x/act.
+type environment struct {
+	bindings map[string]object.Object
+	votes    map[string]bool
+}
+
+func newEnvironment() *environment {
+	return &environment{bindings: make(map[string]object.Object)}
+}
+
+func (e *environment) Get(name string) (object.Object, bool) {
+	if v, ok := e.lookup(name); ok {
+		return v, true
+	}
+
+	if e.votes == nil {
+		return nil, false
+	}
+
+	if e.votes[name] {
+		return object.TRUE, true
+	}
+	return object.FALSE, true
+}
+
+// lookup resolves name to a binding. Dotted identifiers, such as "tx.to",
+// select the fields of the objects bound to their longest prefix.
+func (e *environment) lookup(name string) (object.Object, bool) {
+	parts := strings.Split(name, ".")
+	for i := len(parts); i > 0; i-- {
+		v, ok := e.bindings[strings.Join(parts[:i], ".")]
+		if !ok {
+			continue
+		}
+
+		for _, field := range parts[i:] {
+			m, ok := v.(*object.Map)
+			if !ok {
+				return nil, false
+			}
+			if v, ok = m.Pairs[field]; !ok {
+				return nil, false
+			}
+		}
+		return v, true
+	}
+
+	return nil, false
+}
+
+func (e *environment) Set(name string, value object.Object) {
+	e.bindings[name] = value
+}
+
+func (e *environment) Unset(name string) {
+	delete(e.bindings, name)
+}
+
+// Names returns the names of the bindings, sorted.
+func (e *environment) Names() []string {
+	names := make([]string, 0, len(e.bindings))
+	for name := range e.bindings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Voters returns the addresses of the vote set, sorted.
+func (e *environment) Voters() []string {
+	voters := make([]string, 0, len(e.votes))
+	for v := range e.votes {
+		voters = append(voters, v)
+	}
+	sort.Strings(voters)
+	return voters
+}
+
+// LoadJSON adds the fields of the JSON object in path to the bindings.
+func (e *environment) LoadJSON(path string) (int, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var fields map[string]any
+	if err := dec.Decode(&fields); err != nil {
+		return 0, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	values := make(map[string]object.Object, len(fields))
+	for name, v := range fields {
+		obj, err := jsonToObject(v)
+		if err != nil {
+			return 0, fmt.Errorf("parsing %s: %s: %w", path, name, err)
+		}
+		values[name] = obj
+	}
+
+	for name, obj := range values {
+		e.Set(name, obj)
+	}
+
+	return len(values), nil
+}
+
+// LoadVotes replaces the vote set with the addresses in path. The file
+// contains either a JSON array of addresses or one address per line, lines
+// starting with # are ignored.
+func (e *environment) LoadVotes(path string) (int, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var voters []string
+	if trimmed := bytes.TrimSpace(bz); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &voters); err != nil {
+			return 0, fmt.Errorf("parsing %s: %w", path, err)
+		}
+	} else {
+		scanner := bufio.NewScanner(bytes.NewReader(bz))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			voters = append(voters, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return 0, fmt.Errorf("reading %s: %w", path, err)
+		}
+	}
+
+	e.votes = make(map[string]bool, len(voters))
+	for _, v := range voters {
+		e.votes[v] = true
+	}
+
+	return len(e.votes), nil
+}
+
+// ClearVotes removes the vote set, unknown identifiers become errors again.
+func (e *environment) ClearVotes() {
+	e.votes = nil
+}
+
+// jsonToObject converts a value decoded from JSON, with numbers decoded as
+// json.Number, into a Shield object.
+func jsonToObject(v any) (object.Object, error) {
+	switch v := v.(type) {
+	case string:
+		return &object.String{Value: v}, nil
+	case bool:
+		if v {
+			return object.TRUE, nil
+		}
+		return object.FALSE, nil
+	case json.Number:
+		i, ok := new(big.Int).SetString(v.String(), 10)
+		if !ok {
+			return nil, fmt.Errorf("floating point numbers are not supported: %s", v)
+		}
+		return &object.Integer{Value: i}, nil
+	case []any:
+		elements := make([]object.Object, 0, len(v))
+		for _, el := range v {
+			obj, err := jsonToObject(el)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, obj)
+		}
+		return &object.Array{Elements: elements}, nil
+	case map[string]any:
+		pairs := make(map[string]object.Object, len(v))
+		for key, el := range v {
+			obj, err := jsonToObject(el)
+			if err != nil {
+				return nil, err
+			}
+			pairs[key] = obj
+		}
+		return &object.Map{Pairs: pairs}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %T", v)
+	}
+}
@@ -1,42 +1,142 @@
+// shield_repl is an interactive shell to evaluate Shield expressions.
+//
+// Usage:
+//
+//	shield_repl [-env file] [-votes file] [-history file]
+//
+// Type :help in the shell for the list of commands.
 package main
 
 import (
-	"bufio"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
-	"github.com/warden-protocol/wardenprotocol/shield"
-	"github.com/warden-protocol/wardenprotocol/shield/object"
+	"github.com/chzyer/readline"
+)
+
+const (
+	prompt             = "> "
+	continuationPrompt = ". "
 )
 
 func main() {
-	scanner := bufio.NewScanner(os.Stdin)
-	env := object.NewEnvironment()
+	envFile := flag.String("env", "", "JSON file with the initial bindings")
+	votesFile := flag.String("votes", "", "file with the initial vote set")
+	historyFile := flag.String("history", defaultHistoryFile(), "file where the history is saved, empty to disable")
+	flag.Parse()
 
-	for {
-		fmt.Print("> ")
-		sn := scanner.Scan()
-		if !sn {
-			return
+	if err := run(*envFile, *votesFile, *historyFile); err != nil {
+		fmt.Fprintf(os.Stderr, "shield_repl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(envFile, votesFile, historyFile string) error {
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:                 prompt,
+		HistoryFile:            historyFile,
+		DisableAutoSaveHistory: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer rl.Close()
+
+	r := newREPL(rl.Stdout())
+	if envFile != "" {
+		if _, err := r.env.LoadJSON(envFile); err != nil {
+			return err
 		}
+	}
+	if votesFile != "" {
+		if _, err := r.env.LoadVotes(votesFile); err != nil {
+			return err
+		}
+	}
 
-		line := scanner.Text()
-		if len(line) == 0 {
+	ctx := context.Background()
+	var lines []string
+	for {
+		line, err := rl.Readline()
+		switch {
+		case errors.Is(err, readline.ErrInterrupt):
+			// discard the current input
+			lines = nil
+			rl.SetPrompt(prompt)
 			continue
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return err
 		}
 
-		exp, err := shield.Parse(line)
+		lines = append(lines, line)
+		input := strings.Join(lines, "\n")
+		if incomplete(input) {
+			rl.SetPrompt(continuationPrompt)
+			continue
+		}
 
-		if err != nil {
-			io.WriteString(os.Stderr, err.Error())
+		lines = nil
+		rl.SetPrompt(prompt)
+		if strings.TrimSpace(input) == "" {
 			continue
 		}
 
-		evaluated := shield.Eval(exp, env)
-		if evaluated != nil {
-			io.WriteString(os.Stdout, evaluated.Inspect())
-			io.WriteString(os.Stdout, "\n")
+		// save multi-line inputs as a single history entry
+		if err := rl.SaveHistory(strings.ReplaceAll(input, "\n", " ")); err != nil {
+			return err
+		}
+
+		if !r.Handle(ctx, strings.ReplaceAll(input, "\\\n", "\n")) {
+			return nil
+		}
+	}
+}
+
+// incomplete returns true if input continues on the next line: it has
+// unclosed brackets or strings, or it ends with a binary operator, a comma
+// or a backslash.
+func incomplete(input string) bool {
+	depth := 0
+	inString := false
+	for _, ch := range input {
+		switch {
+		case ch == '"':
+			inString = !inString
+		case inString:
+		case ch == '(' || ch == '[' || ch == '{':
+			depth++
+		case ch == ')' || ch == ']' || ch == '}':
+			depth--
 		}
 	}
+
+	if inString || depth > 0 {
+		return true
+	}
+
+	trimmed := strings.TrimRight(input, " \t")
+	for _, suffix := range []string{"&&", "||", ",", "\\"} {
+		if strings.HasSuffix(trimmed, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func defaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".shield_history")
 }
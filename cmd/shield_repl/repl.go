@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+const help = `Enter a Shield expression to evaluate it, or one of the following commands:
+
+  :set <name> <expression>     bind name to the result of expression
+  :unset <name>                remove the binding of name
+  :load <file>                 bind the fields of a JSON object
+  :votes [<file>]              load a vote set (a JSON array or one address
+                               per line), without file clear it
+  :expand <name> [<expression>]
+                               expand the identifier name into expression
+                               during preprocessing, without expression
+                               remove the expansion
+  :env                         print bindings, expansions and vote set
+  :pre <expression>            print expression after preprocessing and
+                               simplification, as stored by x/act
+  :ast <expression>            print the AST of expression
+  :meta <expression>           print the identifiers and functions used by
+                               expression
+  :help                        print this help
+  :quit                        exit
+
+Expressions are preprocessed with the expansions before being evaluated.
+Unknown identifiers are an error, unless a vote set is loaded: then they are
+true if part of the vote set and false otherwise, like in x/act.
+
+An input continues on the next line while it has unclosed brackets or ends
+with "&&", "||", "," or "\".
+`
+
+type repl struct {
+	env        *environment
+	expansions map[string]*ast.Expression
+	out        io.Writer
+}
+
+func newREPL(out io.Writer) *repl {
+	return &repl{
+		env:        newEnvironment(),
+		expansions: make(map[string]*ast.Expression),
+		out:        out,
+	}
+}
+
+// Handle executes input, a command or an expression, and returns false if
+// the REPL must exit.
+func (r *repl) Handle(ctx context.Context, input string) bool {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return true
+	}
+
+	if !strings.HasPrefix(input, ":") {
+		r.eval(ctx, input)
+		return true
+	}
+
+	cmd, args := cutSpace(input[1:])
+	args = strings.TrimSpace(args)
+
+	var err error
+	switch cmd {
+	case "set":
+		err = r.set(ctx, args)
+	case "unset":
+		r.env.Unset(args)
+	case "load":
+		var n int
+		if n, err = r.env.LoadJSON(args); err == nil {
+			fmt.Fprintf(r.out, "loaded %d bindings\n", n)
+		}
+	case "votes":
+		if args == "" {
+			r.env.ClearVotes()
+			break
+		}
+		var n int
+		if n, err = r.env.LoadVotes(args); err == nil {
+			fmt.Fprintf(r.out, "loaded %d votes\n", n)
+		}
+	case "expand":
+		err = r.expand(args)
+	case "env":
+		r.printEnv()
+	case "pre":
+		var exp *ast.Expression
+		if exp, err = r.preprocess(ctx, args); err == nil {
+			fmt.Fprintln(r.out, shield.Format(shield.Simplify(exp)))
+		}
+	case "ast":
+		var exp *ast.Expression
+		if exp, err = shield.Parse(args); err == nil {
+			printAST(r.out, exp)
+		}
+	case "meta":
+		err = r.printMetadata(args)
+	case "help":
+		fmt.Fprint(r.out, help)
+	case "quit", "q", "exit":
+		return false
+	default:
+		err = fmt.Errorf("unknown command :%s, type :help for the list of commands", cmd)
+	}
+
+	if err != nil {
+		fmt.Fprintf(r.out, "error: %v\n", err)
+	}
+
+	return true
+}
+
+func (r *repl) eval(ctx context.Context, input string) {
+	exp, err := r.preprocess(ctx, input)
+	if err != nil {
+		fmt.Fprintf(r.out, "error: %v\n", err)
+		return
+	}
+
+	if evaluated := shield.Eval(exp, r.env); evaluated != nil {
+		fmt.Fprintln(r.out, evaluated.Inspect())
+	}
+}
+
+func (r *repl) set(ctx context.Context, args string) error {
+	name, input := cutSpace(args)
+	if name == "" || strings.TrimSpace(input) == "" {
+		return fmt.Errorf("usage: :set <name> <expression>")
+	}
+
+	exp, err := r.preprocess(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	value := shield.Eval(exp, r.env)
+	if value == nil {
+		return fmt.Errorf("expression has no value")
+	}
+	if errObj, ok := value.(*object.Error); ok {
+		return fmt.Errorf("%s", errObj.Message)
+	}
+
+	r.env.Set(name, value)
+	return nil
+}
+
+func (r *repl) expand(args string) error {
+	name, input := cutSpace(args)
+	if name == "" {
+		return fmt.Errorf("usage: :expand <name> [<expression>]")
+	}
+
+	if strings.TrimSpace(input) == "" {
+		delete(r.expansions, name)
+		return nil
+	}
+
+	exp, err := shield.Parse(input)
+	if err != nil {
+		return err
+	}
+
+	r.expansions[name] = exp
+	return nil
+}
+
+func (r *repl) preprocess(ctx context.Context, input string) (*ast.Expression, error) {
+	exp, err := shield.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return shield.Preprocess(ctx, exp, mockExpander(r.expansions))
+}
+
+func (r *repl) printEnv() {
+	for _, name := range r.env.Names() {
+		value, _ := r.env.Get(name)
+		fmt.Fprintf(r.out, "%s = %s\n", name, value.Inspect())
+	}
+
+	names := make([]string, 0, len(r.expansions))
+	for name := range r.expansions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(r.out, "%s => %s\n", name, shield.Format(r.expansions[name]))
+	}
+
+	if r.env.votes != nil {
+		fmt.Fprintf(r.out, "votes: [%s]\n", strings.Join(r.env.Voters(), ", "))
+	}
+}
+
+func (r *repl) printMetadata(input string) error {
+	exp, err := shield.Parse(input)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := shield.ExtractMetadata(exp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(r.out, "identifiers: [%s]\n", strings.Join(metadata.Identifiers, ", "))
+	fmt.Fprintf(r.out, "functions: [%s]\n", strings.Join(metadata.FunctionIdentifiers, ", "))
+	return nil
+}
+
+// cutSpace slices s around the first whitespace character.
+func cutSpace(s string) (before, after string) {
+	i := strings.IndexFunc(s, unicode.IsSpace)
+	if i < 0 {
+		return s, ""
+	}
+
+	return s[:i], strings.TrimSpace(s[i:])
+}
+
+// mockExpander expands identifiers into the expressions defined with
+// :expand, the other identifiers are left unchanged.
+type mockExpander map[string]*ast.Expression
+
+func (e mockExpander) Expand(_ context.Context, ident *ast.Identifier) (*ast.Expression, error) {
+	if exp, ok := e[ident.Value]; ok {
+		return proto.Clone(exp).(*ast.Expression), nil
+	}
+
+	return ast.NewIdentifier(ident), nil
+}
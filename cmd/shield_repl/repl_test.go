@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCutSpace(t *testing.T) {
+	tests := []struct {
+		input         string
+		before, after string
+	}{
+		{"set", "set", ""},
+		{"set x 1", "set", "x 1"},
+		{"set\tx   1 + 2", "set", "x   1 + 2"},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		before, after := cutSpace(tt.input)
+		require.Equal(t, tt.before, before, tt.input)
+		require.Equal(t, tt.after, after, tt.input)
+	}
+}
+
+func TestIncomplete(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"alice", false},
+		{"any(2, [alice,", true},
+		{"any(2, [alice, bob])", false},
+		{"alice &&", true},
+		{"alice ||", true},
+		{"alice \\", true},
+		{`"unclosed`, true},
+		{`"(" == "("`, false},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, incomplete(tt.input), tt.input)
+	}
+}
+
+func TestREPL(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   []string
+		expected string
+	}{
+		{
+			name:     "eval",
+			inputs:   []string{"1 + 2"},
+			expected: "3\n",
+		},
+		{
+			name:     "set and eval",
+			inputs:   []string{":set x 40 + 2", "x"},
+			expected: "42\n",
+		},
+		{
+			name:     "unset",
+			inputs:   []string{":set x 1", ":unset x", "x"},
+			expected: "ERROR: identifier not found: x\n",
+		},
+		{
+			name:     "set usage",
+			inputs:   []string{":set x"},
+			expected: "error: usage: :set <name> <expression>\n",
+		},
+		{
+			name:     "set error",
+			inputs:   []string{`:set x 1 + "a"`},
+			expected: "error: unknown operator: INTEGER + STRING\n",
+		},
+		{
+			name:     "expand",
+			inputs:   []string{":expand owners [alice, bob]", ":set alice true", ":set bob false", "any(1, owners)"},
+			expected: "true\n",
+		},
+		{
+			name:     "remove expansion",
+			inputs:   []string{":expand owners [alice]", ":expand owners", ":env"},
+			expected: "",
+		},
+		{
+			name:     "env",
+			inputs:   []string{":set b 2", ":set a 1", ":expand x [a]", ":env"},
+			expected: "a = 1\nb = 2\nx => [a]\n",
+		},
+		{
+			name:     "pre",
+			inputs:   []string{":expand x 1 > 2", ":pre x || alice"},
+			expected: "alice || false\n",
+		},
+		{
+			name:     "meta",
+			inputs:   []string{":meta any(1, [alice, bob])"},
+			expected: "identifiers: [alice, bob]\nfunctions: [any]\n",
+		},
+		{
+			name:     "unknown command",
+			inputs:   []string{":foo"},
+			expected: "error: unknown command :foo, type :help for the list of commands\n",
+		},
+		{
+			name:     "empty input",
+			inputs:   []string{"   "},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			r := newREPL(&out)
+			for _, input := range tt.inputs {
+				require.True(t, r.Handle(context.Background(), input))
+			}
+			require.Equal(t, tt.expected, out.String())
+		})
+	}
+}
+
+func TestREPLQuit(t *testing.T) {
+	for _, input := range []string{":quit", ":q", ":exit"} {
+		require.False(t, newREPL(&bytes.Buffer{}).Handle(context.Background(), input), input)
+	}
+}
+
+func TestEnvironment(t *testing.T) {
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, "env.json")
+	require.NoError(t, os.WriteFile(envFile, []byte(`{"tx": {"to": "0xabc", "value": 100}, "ok": true, "list": [1, 2]}`), 0o600))
+	votesFile := filepath.Join(dir, "votes.txt")
+	require.NoError(t, os.WriteFile(votesFile, []byte("# voters\nalice\n\nbob\n"), 0o600))
+	votesJSON := filepath.Join(dir, "votes.json")
+	require.NoError(t, os.WriteFile(votesJSON, []byte(`["carol"]`), 0o600))
+	floatFile := filepath.Join(dir, "float.json")
+	require.NoError(t, os.WriteFile(floatFile, []byte(`{"price": 1.5}`), 0o600))
+
+	e := newEnvironment()
+	n, err := e.LoadJSON(envFile)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.Equal(t, []string{"list", "ok", "tx"}, e.Names())
+
+	_, err = e.LoadJSON(floatFile)
+	require.ErrorContains(t, err, "floating point numbers are not supported")
+
+	tests := []struct {
+		name     string
+		expected string
+		found    bool
+	}{
+		{"tx.to", `"0xabc"`, true},
+		{"tx.value", "100", true},
+		{"tx.missing", "", false},
+		{"ok.field", "", false},
+		{"list", "[1, 2]", true},
+		{"alice", "", false},
+	}
+	for _, tt := range tests {
+		v, found := e.Get(tt.name)
+		require.Equal(t, tt.found, found, tt.name)
+		if found {
+			require.Equal(t, tt.expected, v.Inspect(), tt.name)
+		}
+	}
+
+	// with a vote set, unknown identifiers are votes
+	n, err = e.LoadVotes(votesFile)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"alice", "bob"}, e.Voters())
+
+	v, found := e.Get("alice")
+	require.True(t, found)
+	require.Equal(t, "true", v.Inspect())
+	v, found = e.Get("carol")
+	require.True(t, found)
+	require.Equal(t, "false", v.Inspect())
+
+	_, err = e.LoadVotes(votesJSON)
+	require.NoError(t, err)
+	require.Equal(t, []string{"carol"}, e.Voters())
+
+	e.ClearVotes()
+	_, found = e.Get("carol")
+	require.False(t, found)
+
+	e.Unset("tx")
+	_, found = e.Get("tx.to")
+	require.False(t, found)
+}
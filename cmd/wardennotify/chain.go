@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// Chain is the view of the chain used by the Notifier.
+type Chain interface {
+	// LatestHeight returns the height of the latest block.
+	LatestHeight(ctx context.Context) (int64, error)
+
+	// BlockEvents returns the events emitted by the successful transactions
+	// of the block at height, followed by the events of the block itself
+	// (e.g. emitted by EndBlockers).
+	BlockEvents(ctx context.Context, height int64) ([]abci.Event, error)
+
+	// Action returns the Action with the given id as of height.
+	Action(ctx context.Context, id uint64, height int64) (*acttypes.Action, error)
+}
+
+type rpcChain struct {
+	client *rpchttp.HTTP
+}
+
+// NewRPCChain returns a Chain backed by the CometBFT RPC endpoint of node.
+func NewRPCChain(node string) (Chain, error) {
+	client, err := rpchttp.New(node, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+	return &rpcChain{client: client}, nil
+}
+
+func (c *rpcChain) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := c.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (c *rpcChain) BlockEvents(ctx context.Context, height int64) ([]abci.Event, error) {
+	res, err := c.client.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []abci.Event
+	for _, tx := range res.TxsResults {
+		if tx.IsOK() {
+			events = append(events, tx.Events...)
+		}
+	}
+	return append(events, res.FinalizeBlockEvents...), nil
+}
+
+func (c *rpcChain) Action(ctx context.Context, id uint64, height int64) (*acttypes.Action, error) {
+	req := &acttypes.QueryActionByIdRequest{Id: id}
+	data, err := req.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.ABCIQueryWithOptions(ctx, "/warden.act.v1beta1.Query/ActionById", data, rpcclient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Response.IsOK() {
+		return nil, fmt.Errorf("query action %d: %s", id, res.Response.Log)
+	}
+
+	var resp acttypes.QueryActionByIdResponse
+	if err := resp.Unmarshal(res.Response.Value); err != nil {
+		return nil, err
+	}
+	if resp.Action == nil {
+		return nil, fmt.Errorf("action %d not found", id)
+	}
+	return resp.Action, nil
+}
@@ -0,0 +1,197 @@
+// wardennotify follows the events of a Warden chain and notifies the
+// addresses mentioned by Actions when their vote is needed.
+//
+// Usage:
+//
+//	wardennotify run
+//	wardennotify subscribe <address> <sink> <target>
+//	wardennotify unsubscribe <address> <sink> <target>
+//	wardennotify subscriptions [address]
+//
+// Subscriptions are kept in a local SQLite database. The available sinks are
+// "webhook" (the target is a URL receiving the notifications as JSON),
+// "slack" (the target is a Slack-compatible incoming webhook URL) and
+// "smtp" (the target is an email address, requires SMTP_ADDR and SMTP_FROM).
+// Failed deliveries are kept in the database too, and retried with an
+// exponential backoff.
+//
+// The daemon is configured with environment variables, see Config.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sethvargo/go-envconfig"
+)
+
+const usage = `usage: wardennotify <command> [arguments]
+
+commands:
+  run                                     follow the chain and send notifications
+  subscribe <address> <sink> <target>     subscribe a target to the notifications of an address
+  unsubscribe <address> <sink> <target>   remove a subscription
+  subscriptions [address]                 list the subscriptions
+
+sinks: webhook, slack, smtp
+`
+
+type Config struct {
+	Node         string        `env:"NODE, default=http://localhost:26657"`
+	DBPath       string        `env:"DB_PATH, default=wardennotify.db"`
+	PollInterval time.Duration `env:"POLL_INTERVAL, default=2s"`
+
+	// StartHeight is the first height processed when the database has no
+	// processed height yet. If zero, the daemon starts from the latest
+	// height of the chain.
+	StartHeight int64 `env:"START_HEIGHT"`
+
+	// ActionURL, if set, is formatted with the id of the Action to link
+	// notifications to it, e.g. "https://example.com/actions/%d".
+	ActionURL string `env:"ACTION_URL"`
+
+	HTTPTimeout time.Duration `env:"HTTP_TIMEOUT, default=10s"`
+
+	SMTPAddr     string `env:"SMTP_ADDR"`
+	SMTPFrom     string `env:"SMTP_FROM"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var c Config
+	if err := envconfig.Process(ctx, &c); err != nil {
+		fmt.Fprintf(os.Stderr, "wardennotify: %v\n", err)
+		os.Exit(1)
+	}
+
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "run":
+		err = run(ctx, c)
+	case "subscribe", "unsubscribe", "subscriptions":
+		err = runSubscriptions(ctx, c, cmd, args, os.Stdout)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "wardennotify: unknown command %q\n\n%s", cmd, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "wardennotify %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, c Config) error {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+
+	store, err := OpenStore(c.DBPath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	chain, err := NewRPCChain(c.Node)
+	if err != nil {
+		return err
+	}
+
+	n := &Notifier{
+		Logger:    logger,
+		Chain:     chain,
+		Store:     store,
+		Sinks:     newSinks(c),
+		ActionURL: c.ActionURL,
+	}
+
+	logger.Info("wardennotify started", "node", c.Node, "db", c.DBPath)
+	return n.Run(ctx, c.StartHeight, c.PollInterval)
+}
+
+func newSinks(c Config) map[string]Sink {
+	client := &http.Client{Timeout: c.HTTPTimeout}
+	sinks := map[string]Sink{
+		SinkWebhook: &WebhookSink{Client: client},
+		SinkSlack:   &SlackSink{Client: client},
+	}
+
+	if c.SMTPAddr != "" {
+		var auth smtp.Auth
+		if c.SMTPUsername != "" {
+			host, _, _ := net.SplitHostPort(c.SMTPAddr)
+			auth = smtp.PlainAuth("", c.SMTPUsername, c.SMTPPassword, host)
+		}
+		sinks[SinkSMTP] = NewSMTPSink(c.SMTPAddr, c.SMTPFrom, auth)
+	}
+
+	return sinks
+}
+
+func runSubscriptions(ctx context.Context, c Config, cmd string, args []string, w io.Writer) error {
+	store, err := OpenStore(c.DBPath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	switch cmd {
+	case "subscribe", "unsubscribe":
+		if len(args) != 3 {
+			return fmt.Errorf("expected <address> <sink> <target>")
+		}
+		sub := Subscription{Address: args[0], Sink: args[1], Target: args[2]}
+		if err := sub.Validate(); err != nil {
+			return err
+		}
+
+		if cmd == "subscribe" {
+			return store.Subscribe(ctx, sub)
+		}
+
+		removed, err := store.Unsubscribe(ctx, sub)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return fmt.Errorf("subscription not found")
+		}
+		return nil
+	default:
+		if len(args) > 1 {
+			return fmt.Errorf("expected at most one address")
+		}
+		var address string
+		if len(args) == 1 {
+			address = args[0]
+		}
+
+		subs, err := store.Subscriptions(ctx, address)
+		if err != nil {
+			return err
+		}
+		for _, sub := range subs {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", sub.Address, sub.Sink, sub.Target)
+		}
+		return nil
+	}
+}
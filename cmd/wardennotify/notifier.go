@@ -0,0 +1,398 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+type Kind string
+
+const (
+	// KindVoteNeeded is sent to the mentioned addresses that didn't vote
+	// yet when an Action is created.
+	KindVoteNeeded Kind = "vote_needed"
+
+	// KindActionVoted is sent to the creator and to the mentioned addresses
+	// that didn't vote yet when a vote is added to an Action.
+	KindActionVoted Kind = "action_voted"
+
+	// KindStatusChanged is sent to the creator and to the mentioned
+	// addresses when the status of an Action changes.
+	KindStatusChanged Kind = "status_changed"
+)
+
+// Notification is sent to the subscriptions of Address.
+type Notification struct {
+	Kind        Kind   `json:"kind"`
+	Address     string `json:"address"`
+	Height      int64  `json:"height"`
+	ActionID    uint64 `json:"action_id"`
+	Creator     string `json:"creator"`
+	Status      string `json:"status"`
+	Participant string `json:"participant,omitempty"`
+	VoteType    string `json:"vote_type,omitempty"`
+	Error       string `json:"error,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+// Subject returns a one-line summary of the notification.
+func (n Notification) Subject() string {
+	switch n.Kind {
+	case KindVoteNeeded:
+		return fmt.Sprintf("Your vote is needed on action %d", n.ActionID)
+	case KindActionVoted:
+		return fmt.Sprintf("New vote on action %d", n.ActionID)
+	default:
+		return fmt.Sprintf("Action %d is now %s", n.ActionID, statusName(n.Status))
+	}
+}
+
+// Text returns the notification as human-readable text.
+func (n Notification) Text() string {
+	var b strings.Builder
+	b.WriteString(n.Subject())
+	b.WriteString("\n\n")
+
+	switch n.Kind {
+	case KindVoteNeeded:
+		fmt.Fprintf(&b, "Action %d created by %s mentions %s and is waiting for votes.\n", n.ActionID, n.Creator, n.Address)
+	case KindActionVoted:
+		fmt.Fprintf(&b, "%s voted %s on action %d created by %s, the action is %s.\n", n.Participant, voteName(n.VoteType), n.ActionID, n.Creator, statusName(n.Status))
+	default:
+		fmt.Fprintf(&b, "Action %d created by %s is now %s.\n", n.ActionID, n.Creator, statusName(n.Status))
+		if n.Error != "" {
+			fmt.Fprintf(&b, "Error: %s\n", n.Error)
+		}
+	}
+
+	if n.URL != "" {
+		fmt.Fprintf(&b, "\n%s\n", n.URL)
+	}
+	return b.String()
+}
+
+func statusName(status string) string {
+	return strings.ToLower(strings.TrimPrefix(status, "ACTION_STATUS_"))
+}
+
+func voteName(voteType string) string {
+	return strings.ToLower(strings.TrimPrefix(voteType, "VOTE_TYPE_"))
+}
+
+const (
+	// retryDelay is the delay before the first retry of a failed delivery,
+	// doubled after each attempt up to maxRetryDelay.
+	retryDelay    = time.Minute
+	maxRetryDelay = time.Hour
+
+	// maxDeliveryAttempts is the number of attempts after which a failed
+	// delivery is dropped.
+	maxDeliveryAttempts = 10
+)
+
+// retryBackoff returns the delay before the next attempt of a delivery that
+// failed attempts times.
+func retryBackoff(attempts int) time.Duration {
+	delay := retryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// Notifier follows the blocks of a Chain and sends notifications about the
+// Actions to the subscriptions of the addresses they mention. Failed
+// deliveries are kept in the Store and retried with an exponential backoff.
+type Notifier struct {
+	Logger *slog.Logger
+	Chain  Chain
+	Store  *Store
+
+	// Sinks by name, as used by Subscription.Sink.
+	Sinks map[string]Sink
+
+	// ActionURL, if set, is formatted with the id of the Action to set
+	// Notification.URL.
+	ActionURL string
+
+	// Now returns the current time, defaults to time.Now.
+	Now func() time.Time
+}
+
+func (n *Notifier) now() time.Time {
+	if n.Now != nil {
+		return n.Now()
+	}
+	return time.Now()
+}
+
+// Run processes the new blocks and retries the failed deliveries every
+// interval until ctx is done. If no block has been processed yet, it starts
+// from startHeight, or from the latest block if startHeight is zero.
+func (n *Notifier) Run(ctx context.Context, startHeight int64, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := n.Sync(ctx, startHeight); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			n.Logger.Error("sync failed", "error", err)
+		}
+
+		if err := n.RetryDeliveries(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			n.Logger.Error("retry failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync processes the blocks following the last processed height up to the
+// latest one, storing the last processed height after each block.
+func (n *Notifier) Sync(ctx context.Context, startHeight int64) error {
+	latest, err := n.Chain.LatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	height, err := n.Store.Height(ctx)
+	if err != nil {
+		return err
+	}
+	if height == 0 {
+		height = latest - 1
+		if startHeight > 0 {
+			height = startHeight - 1
+		}
+	}
+
+	for h := height + 1; h <= latest; h++ {
+		if err := n.ProcessBlock(ctx, h); err != nil {
+			return fmt.Errorf("processing block %d: %w", h, err)
+		}
+		if err := n.Store.SetHeight(ctx, h); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProcessBlock sends the notifications for the x/act events of the block at
+// height. Failed deliveries are stored to be retried and don't stop the
+// processing.
+func (n *Notifier) ProcessBlock(ctx context.Context, height int64) error {
+	events, err := n.Chain.BlockEvents(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		notifications, err := n.notifications(ctx, height, e)
+		if err != nil {
+			n.Logger.Warn("skipping event", "height", height, "type", e.Type, "error", err)
+			continue
+		}
+
+		for _, notification := range notifications {
+			if err := n.deliver(ctx, notification); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (n *Notifier) notifications(ctx context.Context, height int64, e abci.Event) ([]Notification, error) {
+	if !strings.HasPrefix(e.Type, "warden.act.") {
+		return nil, nil
+	}
+
+	msg, err := sdk.ParseTypedEvent(e)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		id         uint64
+		kind       Kind
+		newVoteBy  string
+		voteType   string
+		statusErr  string
+		recipients func(act *acttypes.Action) []string
+	)
+
+	switch ev := msg.(type) {
+	case *acttypes.EventCreateAction:
+		id, kind = ev.Id, KindVoteNeeded
+		recipients = func(act *acttypes.Action) []string {
+			if !act.IsOpen() {
+				return nil
+			}
+			return pendingVoters(act, ev.Creator)
+		}
+	case *acttypes.EventActionVoted:
+		id, kind = ev.Id, KindActionVoted
+		newVoteBy, voteType = ev.Participant, ev.VoteType.String()
+		recipients = func(act *acttypes.Action) []string {
+			return appendUnique([]string{act.Creator}, pendingVoters(act, ev.Participant)...)
+		}
+	case *acttypes.EventActionStateChange:
+		id, kind = ev.Id, KindStatusChanged
+		statusErr = ev.Error
+		recipients = func(act *acttypes.Action) []string {
+			return appendUnique([]string{act.Creator}, act.Mentions...)
+		}
+	default:
+		return nil, nil
+	}
+
+	act, err := n.Chain.Action(ctx, id, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var url string
+	if n.ActionURL != "" {
+		url = fmt.Sprintf(n.ActionURL, id)
+	}
+
+	var notifications []Notification
+	for _, addr := range recipients(act) {
+		if addr == newVoteBy {
+			continue
+		}
+		notifications = append(notifications, Notification{
+			Kind:        kind,
+			Address:     addr,
+			Height:      height,
+			ActionID:    id,
+			Creator:     act.Creator,
+			Status:      act.Status.String(),
+			Participant: newVoteBy,
+			VoteType:    voteType,
+			Error:       statusErr,
+			URL:         url,
+		})
+	}
+	return notifications, nil
+}
+
+// pendingVoters returns the addresses mentioned by act that didn't vote yet,
+// except for exclude.
+func pendingVoters(act *acttypes.Action, exclude string) []string {
+	var addrs []string
+	for _, addr := range act.Mentions {
+		if addr == exclude || slices.ContainsFunc(act.Votes, func(v *acttypes.ActionVote) bool { return v.Participant == addr }) {
+			continue
+		}
+		addrs = appendUnique(addrs, addr)
+	}
+	return addrs
+}
+
+func appendUnique(addrs []string, more ...string) []string {
+	for _, addr := range more {
+		if addr != "" && !slices.Contains(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+func (n *Notifier) deliver(ctx context.Context, notification Notification) error {
+	subs, err := n.Store.Subscriptions(ctx, notification.Address)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		sink, ok := n.Sinks[sub.Sink]
+		if !ok {
+			n.Logger.Warn("sink not configured", "sink", sub.Sink, "address", sub.Address)
+			continue
+		}
+
+		if err := sink.Send(ctx, sub.Target, notification); err != nil {
+			n.Logger.Error("delivery failed", "sink", sub.Sink, "target", sub.Target, "action_id", notification.ActionID, "error", err)
+			if err := n.Store.AddDelivery(ctx, Delivery{
+				Sink:         sub.Sink,
+				Target:       sub.Target,
+				Notification: notification,
+				Attempts:     1,
+				NextAttempt:  n.now().Add(retryBackoff(1)),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+		n.Logger.Info("notification sent", "sink", sub.Sink, "kind", notification.Kind, "action_id", notification.ActionID, "address", notification.Address)
+	}
+
+	return nil
+}
+
+// RetryDeliveries retries the failed deliveries that are due. A delivery
+// that fails again is retried after a longer delay, until it's dropped after
+// maxDeliveryAttempts attempts.
+func (n *Notifier) RetryDeliveries(ctx context.Context) error {
+	deliveries, err := n.Store.DueDeliveries(ctx, n.now())
+	if err != nil {
+		return err
+	}
+
+	for _, d := range deliveries {
+		sink, ok := n.Sinks[d.Sink]
+		if !ok {
+			n.Logger.Warn("sink not configured, dropping delivery", "sink", d.Sink, "target", d.Target, "action_id", d.Notification.ActionID)
+			if err := n.Store.RemoveDelivery(ctx, d.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := sink.Send(ctx, d.Target, d.Notification); err != nil {
+			d.Attempts++
+			if d.Attempts >= maxDeliveryAttempts {
+				n.Logger.Error("delivery dropped", "sink", d.Sink, "target", d.Target, "action_id", d.Notification.ActionID, "attempts", d.Attempts, "error", err)
+				if err := n.Store.RemoveDelivery(ctx, d.ID); err != nil {
+					return err
+				}
+				continue
+			}
+
+			n.Logger.Error("delivery failed", "sink", d.Sink, "target", d.Target, "action_id", d.Notification.ActionID, "attempts", d.Attempts, "error", err)
+			d.NextAttempt = n.now().Add(retryBackoff(d.Attempts))
+			if err := n.Store.UpdateDelivery(ctx, d); err != nil {
+				return err
+			}
+			continue
+		}
+
+		n.Logger.Info("notification sent", "sink", d.Sink, "kind", d.Notification.Kind, "action_id", d.Notification.ActionID, "address", d.Notification.Address, "attempts", d.Attempts+1)
+		if err := n.Store.RemoveDelivery(ctx, d.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// fakeChain is a Chain whose blocks and actions are set by the tests.
+type fakeChain struct {
+	blocks  [][]abci.Event
+	actions map[int64]map[uint64]*acttypes.Action
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{actions: make(map[int64]map[uint64]*acttypes.Action)}
+}
+
+// addBlock adds a block emitting events, in which the actions have the given
+// state.
+func (c *fakeChain) addBlock(t *testing.T, actions []*acttypes.Action, events ...proto.Message) int64 {
+	t.Helper()
+
+	var block []abci.Event
+	for _, ev := range events {
+		e, err := sdk.TypedEventToEvent(ev)
+		require.NoError(t, err)
+		block = append(block, abci.Event(e))
+	}
+	c.blocks = append(c.blocks, block)
+
+	height := int64(len(c.blocks))
+	c.actions[height] = make(map[uint64]*acttypes.Action)
+	for _, act := range actions {
+		c.actions[height][act.Id] = act
+	}
+	return height
+}
+
+func (c *fakeChain) LatestHeight(context.Context) (int64, error) {
+	return int64(len(c.blocks)), nil
+}
+
+func (c *fakeChain) BlockEvents(_ context.Context, height int64) ([]abci.Event, error) {
+	if height < 1 || height > int64(len(c.blocks)) {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return c.blocks[height-1], nil
+}
+
+func (c *fakeChain) Action(_ context.Context, id uint64, height int64) (*acttypes.Action, error) {
+	act, ok := c.actions[height][id]
+	if !ok {
+		return nil, fmt.Errorf("action %d not found at height %d", id, height)
+	}
+	return act, nil
+}
+
+// recordingSink records the notifications sent to each target.
+type recordingSink struct {
+	sent map[string][]Notification
+}
+
+func (s *recordingSink) Send(_ context.Context, target string, n Notification) error {
+	if s.sent == nil {
+		s.sent = make(map[string][]Notification)
+	}
+	s.sent[target] = append(s.sent[target], n)
+	return nil
+}
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := OpenStore(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestNotifier(t *testing.T) {
+	ctx := context.Background()
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	carol := sdk.AccAddress("carol_address_______").String()
+
+	store := newTestStore(t)
+	for _, addr := range []string{alice, bob, carol} {
+		require.NoError(t, store.Subscribe(ctx, Subscription{Address: addr, Sink: SinkWebhook, Target: "https://example.com/" + addr}))
+	}
+
+	chain := newFakeChain()
+	sink := &recordingSink{}
+	n := &Notifier{
+		Logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Chain:     chain,
+		Store:     store,
+		Sinks:     map[string]Sink{SinkWebhook: sink},
+		ActionURL: "https://example.com/actions/%d",
+	}
+
+	pending := &acttypes.Action{
+		Id:       1,
+		Creator:  alice,
+		Status:   acttypes.ActionStatus_ACTION_STATUS_PENDING,
+		Mentions: []string{alice, bob, carol},
+		Votes:    []*acttypes.ActionVote{{Participant: alice, VoteType: acttypes.ActionVoteType_VOTE_TYPE_APPROVED}},
+	}
+	chain.addBlock(t, []*acttypes.Action{pending}, &acttypes.EventCreateAction{Id: 1, Creator: alice})
+
+	// the first sync starts from the given height
+	require.NoError(t, n.Sync(ctx, 1))
+	require.Empty(t, sink.sent["https://example.com/"+alice])
+	require.Equal(t, []Notification{{
+		Kind:     KindVoteNeeded,
+		Address:  bob,
+		Height:   1,
+		ActionID: 1,
+		Creator:  alice,
+		Status:   "ACTION_STATUS_PENDING",
+		URL:      "https://example.com/actions/1",
+	}}, sink.sent["https://example.com/"+bob])
+	require.Len(t, sink.sent["https://example.com/"+carol], 1)
+
+	// bob's vote completes the action in the same block
+	voted := *pending
+	voted.Votes = append(voted.Votes, &acttypes.ActionVote{Participant: bob, VoteType: acttypes.ActionVoteType_VOTE_TYPE_APPROVED})
+	voted.Status = acttypes.ActionStatus_ACTION_STATUS_COMPLETED
+	chain.addBlock(t, []*acttypes.Action{&voted},
+		&acttypes.EventActionVoted{Id: 1, Participant: bob, VoteType: acttypes.ActionVoteType_VOTE_TYPE_APPROVED},
+		&acttypes.EventActionStateChange{Id: 1, PreviousStatus: acttypes.ActionStatus_ACTION_STATUS_PENDING, NewStatus: acttypes.ActionStatus_ACTION_STATUS_COMPLETED},
+	)
+
+	// events of other modules and unknown actions are skipped
+	chain.addBlock(t, nil, &acttypes.EventCreateTemplate{Id: 1, Creator: alice}, &acttypes.EventCreateAction{Id: 2, Creator: alice})
+
+	require.NoError(t, n.Sync(ctx, 1))
+	height, err := store.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+
+	aliceSent := sink.sent["https://example.com/"+alice]
+	require.Len(t, aliceSent, 2)
+	require.Equal(t, KindActionVoted, aliceSent[0].Kind)
+	require.Equal(t, bob, aliceSent[0].Participant)
+	require.Equal(t, "VOTE_TYPE_APPROVED", aliceSent[0].VoteType)
+	require.Equal(t, KindStatusChanged, aliceSent[1].Kind)
+	require.Equal(t, "Action 1 is now completed", aliceSent[1].Subject())
+
+	// bob isn't notified of his own vote
+	bobSent := sink.sent["https://example.com/"+bob]
+	require.Len(t, bobSent, 2)
+	require.Equal(t, KindStatusChanged, bobSent[1].Kind)
+
+	carolSent := sink.sent["https://example.com/"+carol]
+	require.Len(t, carolSent, 3)
+	require.Equal(t, KindActionVoted, carolSent[1].Kind)
+
+	// processed blocks aren't processed again
+	require.NoError(t, n.Sync(ctx, 1))
+	require.Len(t, sink.sent["https://example.com/"+carol], 3)
+}
+
+func TestNotifierStartsFromLatestHeight(t *testing.T) {
+	ctx := context.Background()
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	store := newTestStore(t)
+	require.NoError(t, store.Subscribe(ctx, Subscription{Address: bob, Sink: SinkSlack, Target: "https://example.com/bob"}))
+
+	chain := newFakeChain()
+	act := &acttypes.Action{Id: 1, Creator: alice, Status: acttypes.ActionStatus_ACTION_STATUS_PENDING, Mentions: []string{bob}}
+	chain.addBlock(t, []*acttypes.Action{act}, &acttypes.EventCreateAction{Id: 1, Creator: alice})
+	chain.addBlock(t, []*acttypes.Action{act})
+
+	sink := &recordingSink{}
+	n := &Notifier{
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Chain:  chain,
+		Store:  store,
+		Sinks:  map[string]Sink{SinkSlack: sink},
+	}
+
+	require.NoError(t, n.Sync(ctx, 0))
+	require.Empty(t, sink.sent)
+
+	height, err := store.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+}
+
+// failingSink fails to send the notifications while failing is set.
+type failingSink struct {
+	recordingSink
+	failing bool
+}
+
+func (s *failingSink) Send(ctx context.Context, target string, n Notification) error {
+	if s.failing {
+		return fmt.Errorf("connection refused")
+	}
+	return s.recordingSink.Send(ctx, target, n)
+}
+
+func TestNotifierRetriesFailedDeliveries(t *testing.T) {
+	ctx := context.Background()
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	target := "https://example.com/bob"
+
+	store := newTestStore(t)
+	require.NoError(t, store.Subscribe(ctx, Subscription{Address: bob, Sink: SinkWebhook, Target: target}))
+
+	chain := newFakeChain()
+	act := &acttypes.Action{Id: 1, Creator: alice, Status: acttypes.ActionStatus_ACTION_STATUS_PENDING, Mentions: []string{bob}}
+	chain.addBlock(t, []*acttypes.Action{act}, &acttypes.EventCreateAction{Id: 1, Creator: alice})
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sink := &failingSink{failing: true}
+	n := &Notifier{
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Chain:  chain,
+		Store:  store,
+		Sinks:  map[string]Sink{SinkWebhook: sink},
+		Now:    func() time.Time { return now },
+	}
+
+	// the block is processed, the failed delivery is kept
+	require.NoError(t, n.Sync(ctx, 1))
+	height, err := store.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+	require.Empty(t, sink.sent)
+
+	// it isn't retried before its backoff
+	require.NoError(t, n.RetryDeliveries(ctx))
+	deliveries, err := store.DueDeliveries(ctx, now.Add(retryDelay))
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, 1, deliveries[0].Attempts)
+
+	// a failed retry doubles the backoff
+	now = now.Add(retryDelay)
+	require.NoError(t, n.RetryDeliveries(ctx))
+	deliveries, err = store.DueDeliveries(ctx, now.Add(2*retryDelay))
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, 2, deliveries[0].Attempts)
+	require.Equal(t, now.Add(2*retryDelay).Unix(), deliveries[0].NextAttempt.Unix())
+
+	// the notification is sent once the sink is back
+	sink.failing = false
+	now = now.Add(2 * retryDelay)
+	require.NoError(t, n.RetryDeliveries(ctx))
+	require.Len(t, sink.sent[target], 1)
+	require.Equal(t, KindVoteNeeded, sink.sent[target][0].Kind)
+	require.Equal(t, bob, sink.sent[target][0].Address)
+
+	deliveries, err = store.DueDeliveries(ctx, now.Add(maxRetryDelay))
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	// deliveries failing too many times are dropped
+	sink.failing = true
+	chain.addBlock(t, []*acttypes.Action{act}, &acttypes.EventCreateAction{Id: 1, Creator: alice})
+	require.NoError(t, n.Sync(ctx, 1))
+	for i := 1; i < maxDeliveryAttempts; i++ {
+		now = now.Add(maxRetryDelay)
+		require.NoError(t, n.RetryDeliveries(ctx))
+	}
+	deliveries, err = store.DueDeliveries(ctx, now.Add(maxRetryDelay))
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	require.Equal(t, retryDelay, retryBackoff(1))
+	require.Equal(t, 2*retryDelay, retryBackoff(2))
+	require.Equal(t, 8*retryDelay, retryBackoff(4))
+	require.Equal(t, maxRetryDelay, retryBackoff(maxDeliveryAttempts))
+}
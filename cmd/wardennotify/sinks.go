@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/smtp"
+	"strings"
+)
+
+// Names of the sinks, as used by Subscription.Sink.
+const (
+	SinkWebhook = "webhook"
+	SinkSlack   = "slack"
+	SinkSMTP    = "smtp"
+)
+
+// Sink delivers notifications to a target, whose format depends on the
+// sink.
+type Sink interface {
+	Send(ctx context.Context, target string, n Notification) error
+}
+
+// WebhookSink posts notifications as JSON to the target URL.
+type WebhookSink struct {
+	Client *http.Client
+}
+
+func (s *WebhookSink) Send(ctx context.Context, target string, n Notification) error {
+	return postJSON(ctx, s.Client, target, n)
+}
+
+// SlackSink posts notifications to a Slack-compatible incoming webhook URL.
+type SlackSink struct {
+	Client *http.Client
+}
+
+func (s *SlackSink) Send(ctx context.Context, target string, n Notification) error {
+	return postJSON(ctx, s.Client, target, struct {
+		Text string `json:"text"`
+	}{Text: n.Text()})
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
+
+// SMTPSink sends notifications by email to the target address.
+type SMTPSink struct {
+	addr string
+	from string
+	auth smtp.Auth
+
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPSink returns an SMTPSink sending emails from the from address
+// through the SMTP server at addr (host:port).
+func NewSMTPSink(addr, from string, auth smtp.Auth) *SMTPSink {
+	return &SMTPSink{
+		addr:     addr,
+		from:     from,
+		auth:     auth,
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (s *SMTPSink) Send(_ context.Context, target string, n Notification) error {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", target)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", n.Subject())
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(n.Text(), "\n", "\r\n"))
+
+	return s.sendMail(s.addr, s.auth, s.from, []string{target}, []byte(msg.String()))
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testNotification = Notification{
+	Kind:     KindVoteNeeded,
+	Address:  "bob",
+	Height:   1,
+	ActionID: 7,
+	Creator:  "alice",
+	Status:   "ACTION_STATUS_PENDING",
+	URL:      "https://example.com/actions/7",
+}
+
+func TestWebhookSink(t *testing.T) {
+	var got Notification
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	sink := &WebhookSink{Client: srv.Client()}
+	require.NoError(t, sink.Send(context.Background(), srv.URL, testNotification))
+	require.Equal(t, testNotification, got)
+}
+
+func TestSlackSink(t *testing.T) {
+	var got struct {
+		Text string `json:"text"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	sink := &SlackSink{Client: srv.Client()}
+	require.NoError(t, sink.Send(context.Background(), srv.URL, testNotification))
+	require.Equal(t, testNotification.Text(), got.Text)
+	require.True(t, strings.HasPrefix(got.Text, "Your vote is needed on action 7\n"))
+}
+
+func TestSinkHTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	sink := &WebhookSink{Client: srv.Client()}
+	require.ErrorContains(t, sink.Send(context.Background(), srv.URL, testNotification), "500")
+}
+
+func TestSMTPSink(t *testing.T) {
+	sink := NewSMTPSink("smtp.example.com:587", "warden@example.com", nil)
+
+	var (
+		gotTo  []string
+		gotMsg string
+	)
+	sink.sendMail = func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+		require.Equal(t, "smtp.example.com:587", addr)
+		require.Equal(t, "warden@example.com", from)
+		gotTo, gotMsg = to, string(msg)
+		return nil
+	}
+
+	require.NoError(t, sink.Send(context.Background(), "bob@example.com", testNotification))
+	require.Equal(t, []string{"bob@example.com"}, gotTo)
+	require.Contains(t, gotMsg, "To: bob@example.com\r\n")
+	require.Contains(t, gotMsg, "Subject: Your vote is needed on action 7\r\n")
+	require.Contains(t, gotMsg, "\r\n\r\nYour vote is needed on action 7\r\n")
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS subscriptions (
+	address TEXT NOT NULL,
+	sink    TEXT NOT NULL,
+	target  TEXT NOT NULL,
+	PRIMARY KEY (address, sink, target)
+);
+
+CREATE TABLE IF NOT EXISTS cursor (
+	id     INTEGER PRIMARY KEY CHECK (id = 0),
+	height INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS deliveries (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	sink         TEXT NOT NULL,
+	target       TEXT NOT NULL,
+	notification TEXT NOT NULL,
+	attempts     INTEGER NOT NULL,
+	next_attempt INTEGER NOT NULL
+);
+`
+
+// Subscription delivers the notifications of Address to Target through the
+// Sink with the given name.
+type Subscription struct {
+	Address string
+	Sink    string
+	Target  string
+}
+
+// Validate checks that the address is a bech32 address and that the target
+// is valid for the sink.
+func (s Subscription) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(s.Address); err != nil {
+		return fmt.Errorf("invalid address %q: %w", s.Address, err)
+	}
+
+	switch s.Sink {
+	case SinkWebhook, SinkSlack:
+		u, err := url.Parse(s.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid URL %q", s.Target)
+		}
+	case SinkSMTP:
+		if _, err := mail.ParseAddress(s.Target); err != nil {
+			return fmt.Errorf("invalid email address %q: %w", s.Target, err)
+		}
+	default:
+		return fmt.Errorf("unknown sink %q", s.Sink)
+	}
+
+	return nil
+}
+
+// Delivery is a notification whose delivery to Target through the Sink with
+// the given name failed, kept to be retried at NextAttempt.
+type Delivery struct {
+	ID           int64
+	Sink         string
+	Target       string
+	Notification Notification
+	Attempts     int
+	NextAttempt  time.Time
+}
+
+// Store keeps the subscriptions, the last processed height and the failed
+// deliveries in a SQLite database.
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens the SQLite database at path, creating it if needed.
+func OpenStore(path string) (*Store, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite doesn't support concurrent writers
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating schema: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Subscribe adds a subscription. Adding an existing subscription is a no-op.
+func (s *Store) Subscribe(ctx context.Context, sub Subscription) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO subscriptions (address, sink, target) VALUES (?, ?, ?)`,
+		sub.Address, sub.Sink, sub.Target,
+	)
+	return err
+}
+
+// Unsubscribe removes a subscription, returning false if it didn't exist.
+func (s *Store) Unsubscribe(ctx context.Context, sub Subscription) (bool, error) {
+	res, err := s.db.ExecContext(ctx,
+		`DELETE FROM subscriptions WHERE address = ? AND sink = ? AND target = ?`,
+		sub.Address, sub.Sink, sub.Target,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// Subscriptions returns the subscriptions of address, or all of them if
+// address is empty.
+func (s *Store) Subscriptions(ctx context.Context, address string) ([]Subscription, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT address, sink, target FROM subscriptions WHERE ? = '' OR address = ? ORDER BY address, sink, target`,
+		address, address,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []Subscription
+	for rows.Next() {
+		var sub Subscription
+		if err := rows.Scan(&sub.Address, &sub.Sink, &sub.Target); err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, rows.Err()
+}
+
+// Height returns the last processed height, or zero if no height has been
+// processed yet.
+func (s *Store) Height(ctx context.Context) (int64, error) {
+	var height int64
+	err := s.db.QueryRowContext(ctx, `SELECT height FROM cursor WHERE id = 0`).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// SetHeight sets the last processed height.
+func (s *Store) SetHeight(ctx context.Context, height int64) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO cursor (id, height) VALUES (0, ?) ON CONFLICT (id) DO UPDATE SET height = excluded.height`,
+		height,
+	)
+	return err
+}
+
+// AddDelivery stores a failed delivery.
+func (s *Store) AddDelivery(ctx context.Context, d Delivery) error {
+	bz, err := json.Marshal(d.Notification)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO deliveries (sink, target, notification, attempts, next_attempt) VALUES (?, ?, ?, ?, ?)`,
+		d.Sink, d.Target, string(bz), d.Attempts, d.NextAttempt.Unix(),
+	)
+	return err
+}
+
+// DueDeliveries returns the failed deliveries whose NextAttempt is not after
+// now, oldest first.
+func (s *Store) DueDeliveries(ctx context.Context, now time.Time) ([]Delivery, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, sink, target, notification, attempts, next_attempt FROM deliveries WHERE next_attempt <= ? ORDER BY id`,
+		now.Unix(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []Delivery
+	for rows.Next() {
+		var (
+			d            Delivery
+			notification string
+			nextAttempt  int64
+		)
+		if err := rows.Scan(&d.ID, &d.Sink, &d.Target, &notification, &d.Attempts, &nextAttempt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(notification), &d.Notification); err != nil {
+			return nil, fmt.Errorf("delivery %d: %w", d.ID, err)
+		}
+		d.NextAttempt = time.Unix(nextAttempt, 0)
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// UpdateDelivery updates the attempts and the next attempt of a failed
+// delivery.
+func (s *Store) UpdateDelivery(ctx context.Context, d Delivery) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE deliveries SET attempts = ?, next_attempt = ? WHERE id = ?`,
+		d.Attempts, d.NextAttempt.Unix(), d.ID,
+	)
+	return err
+}
+
+// RemoveDelivery removes a failed delivery, once sent or dropped.
+func (s *Store) RemoveDelivery(ctx context.Context, id int64) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM deliveries WHERE id = ?`, id)
+	return err
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	store, err := OpenStore(path)
+	require.NoError(t, err)
+
+	height, err := store.Height(ctx)
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	webhook := Subscription{Address: alice, Sink: SinkWebhook, Target: "https://example.com/hook"}
+	email := Subscription{Address: alice, Sink: SinkSMTP, Target: "alice@example.com"}
+	slack := Subscription{Address: bob, Sink: SinkSlack, Target: "https://hooks.example.com/bob"}
+	for _, sub := range []Subscription{webhook, email, slack, webhook} {
+		require.NoError(t, store.Subscribe(ctx, sub))
+	}
+	require.NoError(t, store.SetHeight(ctx, 10))
+	require.NoError(t, store.SetHeight(ctx, 11))
+	require.NoError(t, store.Close())
+
+	// the state persists across restarts
+	store, err = OpenStore(path)
+	require.NoError(t, err)
+	defer store.Close()
+
+	height, err = store.Height(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+
+	subs, err := store.Subscriptions(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []Subscription{email, webhook}, subs)
+
+	subs, err = store.Subscriptions(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, subs, 3)
+
+	removed, err := store.Unsubscribe(ctx, webhook)
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	removed, err = store.Unsubscribe(ctx, webhook)
+	require.NoError(t, err)
+	require.False(t, removed)
+
+	subs, err = store.Subscriptions(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []Subscription{email}, subs)
+}
+
+func TestSubscriptionValidate(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+
+	tests := []struct {
+		name    string
+		sub     Subscription
+		wantErr bool
+	}{
+		{name: "webhook", sub: Subscription{Address: alice, Sink: SinkWebhook, Target: "https://example.com/hook"}},
+		{name: "slack", sub: Subscription{Address: alice, Sink: SinkSlack, Target: "http://localhost:8080/"}},
+		{name: "smtp", sub: Subscription{Address: alice, Sink: SinkSMTP, Target: "alice@example.com"}},
+		{name: "invalid address", sub: Subscription{Address: "alice", Sink: SinkSMTP, Target: "alice@example.com"}, wantErr: true},
+		{name: "invalid URL", sub: Subscription{Address: alice, Sink: SinkWebhook, Target: "example.com"}, wantErr: true},
+		{name: "invalid email", sub: Subscription{Address: alice, Sink: SinkSMTP, Target: "alice"}, wantErr: true},
+		{name: "unknown sink", sub: Subscription{Address: alice, Sink: "pager", Target: "alice"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sub.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -58,6 +58,18 @@ func NewCallExpression(call *CallExpression) *Expression {
 	})
 }
 
+func NewObjectLiteral(object *ObjectLiteral) *Expression {
+	return NewExpression(&Expression_ObjectLiteral{
+		ObjectLiteral: object,
+	})
+}
+
+func NewIndexExpression(index *IndexExpression) *Expression {
+	return NewExpression(&Expression_IndexExpression{
+		IndexExpression: index,
+	})
+}
+
 func UnwrapIdentifier(expr *Expression) (*Identifier, bool) {
 	if ident, ok := expr.Value.(*Expression_Identifier); ok {
 		return ident.Identifier, true
@@ -114,6 +126,30 @@ func UnwrapCallExpression(expr *Expression) (*CallExpression, bool) {
 	return nil, false
 }
 
+func UnwrapObjectLiteral(expr *Expression) (*ObjectLiteral, bool) {
+	if v, ok := expr.Value.(*Expression_ObjectLiteral); ok {
+		return v.ObjectLiteral, true
+	}
+	return nil, false
+}
+
+func UnwrapIndexExpression(expr *Expression) (*IndexExpression, bool) {
+	if v, ok := expr.Value.(*Expression_IndexExpression); ok {
+		return v.IndexExpression, true
+	}
+	return nil, false
+}
+
+// Get returns the value of the entry with the given key.
+func (o *ObjectLiteral) Get(key string) (*Expression, bool) {
+	for _, e := range o.Entries {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	return nil, false
+}
+
 func NewIdent(name string) *Identifier {
 	return &Identifier{
 		Token: token.Token{
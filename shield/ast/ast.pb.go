@@ -35,6 +35,8 @@ type Expression struct {
 	//	*Expression_CallExpression
 	//	*Expression_InfixExpression
 	//	*Expression_PrefixExpression
+	//	*Expression_ObjectLiteral
+	//	*Expression_IndexExpression
 	Value isExpression_Value `protobuf_oneof:"value"`
 }
 
@@ -101,6 +103,12 @@ type Expression_InfixExpression struct {
 type Expression_PrefixExpression struct {
 	PrefixExpression *PrefixExpression `protobuf:"bytes,8,opt,name=prefix_expression,json=prefixExpression,proto3,oneof" json:"prefix_expression,omitempty"`
 }
+type Expression_ObjectLiteral struct {
+	ObjectLiteral *ObjectLiteral `protobuf:"bytes,9,opt,name=object_literal,json=objectLiteral,proto3,oneof" json:"object_literal,omitempty"`
+}
+type Expression_IndexExpression struct {
+	IndexExpression *IndexExpression `protobuf:"bytes,10,opt,name=index_expression,json=indexExpression,proto3,oneof" json:"index_expression,omitempty"`
+}
 
 func (*Expression_Identifier) isExpression_Value()       {}
 func (*Expression_IntegerLiteral) isExpression_Value()   {}
@@ -110,6 +118,8 @@ func (*Expression_ArrayLiteral) isExpression_Value()     {}
 func (*Expression_CallExpression) isExpression_Value()   {}
 func (*Expression_InfixExpression) isExpression_Value()  {}
 func (*Expression_PrefixExpression) isExpression_Value() {}
+func (*Expression_ObjectLiteral) isExpression_Value()    {}
+func (*Expression_IndexExpression) isExpression_Value()  {}
 
 func (m *Expression) GetValue() isExpression_Value {
 	if m != nil {
@@ -174,6 +184,20 @@ func (m *Expression) GetPrefixExpression() *PrefixExpression {
 	return nil
 }
 
+func (m *Expression) GetObjectLiteral() *ObjectLiteral {
+	if x, ok := m.GetValue().(*Expression_ObjectLiteral); ok {
+		return x.ObjectLiteral
+	}
+	return nil
+}
+
+func (m *Expression) GetIndexExpression() *IndexExpression {
+	if x, ok := m.GetValue().(*Expression_IndexExpression); ok {
+		return x.IndexExpression
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expression) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -185,6 +209,8 @@ func (*Expression) XXX_OneofWrappers() []interface{} {
 		(*Expression_CallExpression)(nil),
 		(*Expression_InfixExpression)(nil),
 		(*Expression_PrefixExpression)(nil),
+		(*Expression_ObjectLiteral)(nil),
+		(*Expression_IndexExpression)(nil),
 	}
 }
 
@@ -636,6 +662,172 @@ func (m *PrefixExpression) GetRight() *Expression {
 	return nil
 }
 
+type ObjectLiteral struct {
+	Token   token.Token    `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Entries []*ObjectEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *ObjectLiteral) Reset()         { *m = ObjectLiteral{} }
+func (m *ObjectLiteral) String() string { return proto.CompactTextString(m) }
+func (*ObjectLiteral) ProtoMessage()    {}
+func (*ObjectLiteral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8efe25b47b2c2b2, []int{9}
+}
+func (m *ObjectLiteral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectLiteral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectLiteral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectLiteral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectLiteral.Merge(m, src)
+}
+func (m *ObjectLiteral) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectLiteral) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectLiteral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectLiteral proto.InternalMessageInfo
+
+func (m *ObjectLiteral) GetToken() token.Token {
+	if m != nil {
+		return m.Token
+	}
+	return token.Token{}
+}
+
+func (m *ObjectLiteral) GetEntries() []*ObjectEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type ObjectEntry struct {
+	Key   string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *Expression `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ObjectEntry) Reset()         { *m = ObjectEntry{} }
+func (m *ObjectEntry) String() string { return proto.CompactTextString(m) }
+func (*ObjectEntry) ProtoMessage()    {}
+func (*ObjectEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8efe25b47b2c2b2, []int{10}
+}
+func (m *ObjectEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectEntry.Merge(m, src)
+}
+func (m *ObjectEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectEntry proto.InternalMessageInfo
+
+func (m *ObjectEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ObjectEntry) GetValue() *Expression {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// IndexExpression is either a member access (`left.field`) or an index
+// access (`left[index]`).
+type IndexExpression struct {
+	Token token.Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Left  *Expression `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	Index *Expression `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *IndexExpression) Reset()         { *m = IndexExpression{} }
+func (m *IndexExpression) String() string { return proto.CompactTextString(m) }
+func (*IndexExpression) ProtoMessage()    {}
+func (*IndexExpression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8efe25b47b2c2b2, []int{11}
+}
+func (m *IndexExpression) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexExpression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexExpression.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexExpression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexExpression.Merge(m, src)
+}
+func (m *IndexExpression) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexExpression) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexExpression.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexExpression proto.InternalMessageInfo
+
+func (m *IndexExpression) GetToken() token.Token {
+	if m != nil {
+		return m.Token
+	}
+	return token.Token{}
+}
+
+func (m *IndexExpression) GetLeft() *Expression {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *IndexExpression) GetIndex() *Expression {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Expression)(nil), "shield.ast.Expression")
 	proto.RegisterType((*Identifier)(nil), "shield.ast.Identifier")
@@ -646,52 +838,62 @@ func init() {
 	proto.RegisterType((*CallExpression)(nil), "shield.ast.CallExpression")
 	proto.RegisterType((*InfixExpression)(nil), "shield.ast.InfixExpression")
 	proto.RegisterType((*PrefixExpression)(nil), "shield.ast.PrefixExpression")
+	proto.RegisterType((*ObjectLiteral)(nil), "shield.ast.ObjectLiteral")
+	proto.RegisterType((*ObjectEntry)(nil), "shield.ast.ObjectEntry")
+	proto.RegisterType((*IndexExpression)(nil), "shield.ast.IndexExpression")
 }
 
 func init() { proto.RegisterFile("shield/ast/ast.proto", fileDescriptor_a8efe25b47b2c2b2) }
 
 var fileDescriptor_a8efe25b47b2c2b2 = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x93, 0x76, 0xdd, 0xba, 0x8f, 0x6d, 0xdd, 0xbc, 0x82, 0x4a, 0x41, 0x61, 0xea, 0x09,
-	0x4d, 0xd0, 0x4a, 0x63, 0x2f, 0x40, 0x11, 0x52, 0x26, 0x21, 0x84, 0x3c, 0x34, 0xa4, 0x1d, 0x98,
-	0xdc, 0xce, 0xcd, 0x2c, 0xbc, 0xb8, 0x72, 0x5c, 0x18, 0x0f, 0x81, 0xc4, 0x7b, 0x70, 0x41, 0x9c,
-	0x78, 0x84, 0x1d, 0x77, 0xe4, 0x84, 0xd0, 0x86, 0xc4, 0x6b, 0x20, 0x3b, 0x69, 0x62, 0x67, 0x6c,
-	0x62, 0xb0, 0x43, 0xa3, 0xf8, 0xef, 0x7f, 0x7f, 0xdf, 0x27, 0xfb, 0x6f, 0x07, 0x9a, 0xc9, 0x01,
-	0xa3, 0x7c, 0xbf, 0x47, 0x12, 0xa5, 0x7f, 0xdd, 0xb1, 0x14, 0x4a, 0x20, 0x48, 0xd5, 0x2e, 0x49,
-	0x54, 0x7b, 0x85, 0x1c, 0xb2, 0x58, 0xf4, 0xcc, 0x33, 0x9d, 0x6e, 0x37, 0x23, 0x11, 0x09, 0xf3,
-	0xda, 0xd3, 0x6f, 0x99, 0xda, 0xca, 0x50, 0x4a, 0xbc, 0xa1, 0x71, 0xfa, 0x4c, 0x67, 0x3a, 0x3f,
-	0x6b, 0x00, 0x4f, 0x8f, 0xc6, 0x92, 0x26, 0x09, 0x13, 0x31, 0xda, 0x02, 0x60, 0xfb, 0x34, 0x56,
-	0x6c, 0xc4, 0xa8, 0x6c, 0xf9, 0x6b, 0xfe, 0xfd, 0x1b, 0x1b, 0xb7, 0xba, 0x45, 0xc9, 0xee, 0x56,
-	0x3e, 0xdb, 0x6f, 0x7c, 0xf9, 0xf5, 0x79, 0x1d, 0x0a, 0x21, 0xf4, 0xb0, 0xf5, 0x67, 0xb4, 0x0b,
-	0x0d, 0x16, 0x2b, 0x1a, 0x51, 0xb9, 0xc7, 0x99, 0xa2, 0x92, 0xf0, 0x56, 0xc5, 0xf0, 0xda, 0x0e,
-	0x2f, 0xb5, 0x3c, 0x4b, 0x1d, 0xfd, 0x55, 0xcd, 0x5c, 0x72, 0xc5, 0xd0, 0xc3, 0x4b, 0xcc, 0x51,
-	0x34, 0x7b, 0x20, 0x04, 0xa7, 0x24, 0xce, 0xd9, 0xd5, 0xf3, 0xec, 0x7e, 0x6a, 0x71, 0xd9, 0xae,
-	0xa8, 0xd9, 0x03, 0x47, 0x41, 0x3b, 0xb0, 0x94, 0x28, 0xc9, 0xe2, 0x28, 0x47, 0xcf, 0x18, 0xf4,
-	0x6d, 0x1b, 0xbd, 0x6d, 0x1c, 0x53, 0x32, 0xd2, 0xe4, 0x45, 0x47, 0x0b, 0x3d, 0xbc, 0x98, 0xd8,
-	0x02, 0xc2, 0xb0, 0x48, 0xa4, 0x24, 0xef, 0x73, 0x6c, 0xcd, 0x60, 0x5b, 0x36, 0xf6, 0xb1, 0x36,
-	0x4c, 0xa9, 0x2b, 0x9a, 0xba, 0x60, 0x4b, 0xa1, 0x87, 0x17, 0x88, 0x35, 0xd6, 0xeb, 0x30, 0x24,
-	0x9c, 0xef, 0xd1, 0x7c, 0x07, 0x5b, 0xb3, 0xe7, 0xd7, 0xe1, 0x09, 0xe1, 0xbc, 0xd8, 0xe3, 0x6c,
-	0x1d, 0x5c, 0x51, 0xaf, 0xc3, 0xd0, 0x51, 0xd0, 0x6b, 0x58, 0x66, 0xf1, 0x88, 0x1d, 0xd9, 0xf0,
-	0x39, 0x03, 0xbf, 0xe3, 0x6e, 0xe0, 0x88, 0x1d, 0x59, 0xf4, 0xa6, 0xa6, 0x37, 0x4a, 0x6a, 0xe8,
-	0xe1, 0x06, 0x73, 0x25, 0x34, 0x80, 0x95, 0xb1, 0xa4, 0xa5, 0x02, 0x75, 0x53, 0xe0, 0xae, 0x5d,
-	0xe0, 0x85, 0x31, 0x59, 0x15, 0x6e, 0xea, 0x0a, 0xcb, 0x65, 0x39, 0xf4, 0xf0, 0xf2, 0xb8, 0x6c,
-	0x9d, 0x83, 0xda, 0x5b, 0xc2, 0x27, 0xb4, 0xb3, 0x0d, 0x56, 0x50, 0x51, 0x0f, 0x6a, 0xe6, 0x0c,
-	0x64, 0x01, 0x5f, 0x9d, 0x96, 0x4b, 0x0f, 0xc6, 0x4b, 0xfd, 0xec, 0xcf, 0x1c, 0x7f, 0xbf, 0xe7,
-	0xe1, 0xd4, 0x87, 0x9a, 0x19, 0xc7, 0x24, 0x78, 0x1e, 0x67, 0xd0, 0x57, 0x50, 0x4a, 0xea, 0x35,
-	0x82, 0xdd, 0x98, 0xfe, 0x27, 0xb8, 0x3e, 0x05, 0xef, 0x80, 0x9b, 0xd2, 0xeb, 0x6a, 0x38, 0x01,
-	0x27, 0xa7, 0x57, 0xc7, 0x6e, 0x40, 0x9d, 0x72, 0x7a, 0x48, 0x63, 0x95, 0xb4, 0x2a, 0x6b, 0xd5,
-	0xf2, 0xad, 0x53, 0x6c, 0x29, 0xce, 0x7d, 0x9d, 0x4f, 0x3e, 0x94, 0x52, 0xfc, 0x4f, 0x75, 0x47,
-	0x93, 0x78, 0xa8, 0x74, 0xf6, 0x2a, 0x97, 0xdd, 0x76, 0x38, 0xf7, 0xa1, 0x4d, 0x98, 0x27, 0x32,
-	0x9a, 0xa4, 0xcd, 0x56, 0x2f, 0x6d, 0xb6, 0x30, 0x76, 0xbe, 0xfa, 0x50, 0x3e, 0x15, 0x57, 0x6f,
-	0x77, 0x1d, 0x66, 0x38, 0x1d, 0xa9, 0x3f, 0xb5, 0x6a, 0x55, 0x35, 0x1e, 0xd4, 0x86, 0xba, 0x18,
-	0x53, 0x49, 0x94, 0x90, 0xe6, 0x72, 0x9c, 0xc7, 0xf9, 0x18, 0x3d, 0x80, 0x9a, 0x64, 0xd1, 0x81,
-	0xca, 0xae, 0xb6, 0x8b, 0x40, 0xa9, 0xa9, 0xf3, 0xc1, 0x87, 0x73, 0xc7, 0xed, 0xea, 0xbd, 0xdb,
-	0xfd, 0x54, 0x2e, 0xea, 0xa7, 0xfa, 0x17, 0xfd, 0xf4, 0x9f, 0x1f, 0x9f, 0x06, 0xfe, 0xc9, 0x69,
-	0xe0, 0xff, 0x38, 0x0d, 0xfc, 0x8f, 0x67, 0x81, 0x77, 0x72, 0x16, 0x78, 0xdf, 0xce, 0x02, 0x6f,
-	0x77, 0x33, 0x62, 0xea, 0x60, 0x32, 0xe8, 0x0e, 0xc5, 0x61, 0xef, 0x1d, 0x91, 0xfb, 0x34, 0x7e,
-	0x68, 0x3e, 0x73, 0x43, 0xc1, 0xb3, 0x71, 0x3e, 0x2c, 0x3e, 0xae, 0x83, 0x59, 0x23, 0x3e, 0xfa,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0xea, 0xb5, 0xf0, 0x11, 0x71, 0x07, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xdf, 0x6e, 0x12, 0x4f,
+	0x14, 0xc7, 0x77, 0xf9, 0x53, 0xe0, 0xf4, 0x0f, 0x74, 0xca, 0xef, 0x27, 0xa2, 0xc1, 0x86, 0x2b,
+	0xd3, 0x54, 0x88, 0xb5, 0x2f, 0x20, 0xa6, 0x09, 0x4d, 0xfc, 0x97, 0xa9, 0xa9, 0x49, 0x2f, 0x6c,
+	0x06, 0x18, 0xe8, 0xd8, 0xed, 0x0e, 0x99, 0x1d, 0xb4, 0x7d, 0x08, 0x13, 0x1f, 0xa0, 0x6f, 0xe0,
+	0x8d, 0xf1, 0xca, 0x47, 0xe8, 0x65, 0x2f, 0xbd, 0x32, 0xa6, 0xbd, 0xf0, 0x35, 0xcc, 0xcc, 0xc2,
+	0x32, 0xb3, 0x54, 0x14, 0xad, 0x17, 0x6c, 0x76, 0xbf, 0xfb, 0xe5, 0x73, 0x0e, 0xe7, 0xcc, 0x39,
+	0x01, 0x8a, 0xc1, 0x01, 0xa3, 0x5e, 0xa7, 0x4e, 0x02, 0xa9, 0x3e, 0xb5, 0xbe, 0xe0, 0x92, 0x23,
+	0x08, 0xd5, 0x1a, 0x09, 0x64, 0x79, 0x99, 0x1c, 0x31, 0x9f, 0xd7, 0xf5, 0x35, 0x7c, 0x5d, 0x2e,
+	0xf6, 0x78, 0x8f, 0xeb, 0xdb, 0xba, 0xba, 0x1b, 0xaa, 0xa5, 0x21, 0x4a, 0xf2, 0x43, 0xea, 0x87,
+	0xd7, 0xf0, 0x4d, 0xf5, 0x34, 0x03, 0xb0, 0x75, 0xdc, 0x17, 0x34, 0x08, 0x18, 0xf7, 0xd1, 0x36,
+	0x00, 0xeb, 0x50, 0x5f, 0xb2, 0x2e, 0xa3, 0xa2, 0xe4, 0xae, 0xba, 0x77, 0xe7, 0x37, 0xfe, 0xaf,
+	0x8d, 0x43, 0xd6, 0xb6, 0xa3, 0xb7, 0x8d, 0xfc, 0xa7, 0xef, 0x1f, 0xd7, 0x60, 0x2c, 0x34, 0x1d,
+	0x6c, 0x7c, 0x19, 0xed, 0x41, 0x9e, 0xf9, 0x92, 0xf6, 0xa8, 0xd8, 0xf7, 0x98, 0xa4, 0x82, 0x78,
+	0xa5, 0x84, 0xe6, 0x95, 0x2d, 0x5e, 0x68, 0x79, 0x1c, 0x3a, 0x1a, 0x2b, 0x8a, 0xb9, 0x64, 0x8b,
+	0x4d, 0x07, 0x2f, 0x31, 0x4b, 0x51, 0xec, 0x16, 0xe7, 0x1e, 0x25, 0x7e, 0xc4, 0x4e, 0x4e, 0xb2,
+	0x1b, 0xa1, 0xc5, 0x66, 0xdb, 0xa2, 0x62, 0xb7, 0x2c, 0x05, 0xed, 0xc2, 0x52, 0x20, 0x05, 0xf3,
+	0x7b, 0x11, 0x3a, 0xa5, 0xd1, 0x37, 0x4d, 0xf4, 0x8e, 0x76, 0x8c, 0xc8, 0x48, 0x91, 0x17, 0x2d,
+	0xad, 0xe9, 0xe0, 0xc5, 0xc0, 0x14, 0x10, 0x86, 0x45, 0x22, 0x04, 0x39, 0x89, 0xb0, 0x69, 0x8d,
+	0x2d, 0x99, 0xd8, 0x87, 0xca, 0x30, 0xa2, 0x2e, 0x2b, 0xea, 0x82, 0x29, 0x35, 0x1d, 0xbc, 0x40,
+	0x8c, 0x67, 0x55, 0x87, 0x36, 0xf1, 0xbc, 0x7d, 0x1a, 0x75, 0xb0, 0x34, 0x37, 0x59, 0x87, 0x47,
+	0xc4, 0xf3, 0xc6, 0x3d, 0x1e, 0xd6, 0xc1, 0x16, 0x55, 0x1d, 0xda, 0x96, 0x82, 0x5e, 0x41, 0x81,
+	0xf9, 0x5d, 0x76, 0x6c, 0xc2, 0x33, 0x1a, 0x7e, 0xcb, 0x6e, 0x60, 0x97, 0x1d, 0x1b, 0xf4, 0xa2,
+	0xa2, 0xe7, 0x63, 0x6a, 0xd3, 0xc1, 0x79, 0x66, 0x4b, 0xa8, 0x05, 0xcb, 0x7d, 0x41, 0x63, 0x01,
+	0xb2, 0x3a, 0xc0, 0x6d, 0x33, 0xc0, 0x73, 0x41, 0xad, 0x2f, 0x36, 0xfe, 0x53, 0x11, 0x0a, 0x71,
+	0xb9, 0xe9, 0xe0, 0x42, 0x3f, 0xa6, 0xa9, 0x5e, 0xf2, 0xd6, 0x6b, 0xda, 0x96, 0x51, 0xd1, 0x73,
+	0x93, 0xbd, 0x7c, 0xa6, 0x1d, 0x76, 0x2f, 0x2d, 0x4d, 0xf5, 0x92, 0x9b, 0x42, 0x58, 0x9b, 0x0e,
+	0xb5, 0x52, 0x87, 0xab, 0x6a, 0xd3, 0xa1, 0x57, 0xd5, 0xa6, 0x43, 0x27, 0x6a, 0x63, 0x1b, 0x33,
+	0x90, 0x7e, 0x43, 0xbc, 0x01, 0xad, 0xee, 0x80, 0x31, 0x60, 0xa8, 0x0e, 0x69, 0x3d, 0xbb, 0xc3,
+	0xc1, 0x5c, 0x19, 0xc5, 0xd2, 0x62, 0xed, 0x85, 0xba, 0x36, 0x52, 0x67, 0x5f, 0xef, 0x38, 0x38,
+	0xf4, 0xa1, 0xe2, 0x90, 0xa3, 0x27, 0x2f, 0x87, 0x87, 0xd0, 0x97, 0x10, 0x9b, 0xb0, 0x6b, 0x04,
+	0xdb, 0xe3, 0xf5, 0x97, 0xe0, 0xec, 0x08, 0xbc, 0x0b, 0xf6, 0x74, 0x5d, 0x57, 0xc2, 0x01, 0x58,
+	0xf3, 0x35, 0x3b, 0x76, 0x03, 0xb2, 0xd4, 0xa3, 0x47, 0xd4, 0x97, 0x41, 0x29, 0xb1, 0x9a, 0x8c,
+	0x6f, 0xcb, 0x71, 0x4b, 0x71, 0xe4, 0xab, 0x7e, 0x70, 0x21, 0x36, 0x7d, 0x7f, 0x14, 0xb7, 0x3b,
+	0xf0, 0xdb, 0x52, 0x1d, 0xbc, 0xc4, 0xb4, 0x2d, 0x8d, 0x23, 0x1f, 0xda, 0x84, 0x1c, 0x11, 0xbd,
+	0x41, 0x98, 0x6c, 0x72, 0x6a, 0xb2, 0x63, 0x63, 0xf5, 0xb3, 0x0b, 0xf1, 0x69, 0x9e, 0x3d, 0xdd,
+	0x35, 0x48, 0x79, 0xb4, 0x2b, 0xaf, 0x4a, 0xd5, 0x88, 0xaa, 0x3d, 0xa8, 0x0c, 0x59, 0xde, 0xa7,
+	0x82, 0x48, 0x2e, 0xf4, 0x52, 0xcf, 0xe1, 0xe8, 0x19, 0xad, 0x43, 0x5a, 0xb0, 0xde, 0x81, 0x2c,
+	0xa5, 0xa6, 0x82, 0x42, 0x53, 0xf5, 0x9d, 0x0b, 0x13, 0x6b, 0x62, 0xf6, 0xdc, 0xcd, 0x7c, 0x12,
+	0x3f, 0xcb, 0x27, 0xf9, 0x3b, 0xf9, 0x04, 0x60, 0xef, 0x95, 0xd9, 0x73, 0xb9, 0x0f, 0x19, 0xea,
+	0x4b, 0xc1, 0xe8, 0xe8, 0xb4, 0xdd, 0x98, 0x5c, 0x64, 0x5b, 0xbe, 0x14, 0x27, 0x78, 0xe4, 0xab,
+	0x3e, 0x81, 0x79, 0x43, 0x47, 0x05, 0x48, 0x1e, 0xd2, 0x13, 0x1d, 0x30, 0x87, 0xd5, 0xad, 0xfa,
+	0x0d, 0xe3, 0xc9, 0x98, 0xf2, 0x1b, 0xc2, 0x89, 0x39, 0xd5, 0xc7, 0xc1, 0xda, 0x56, 0xff, 0xf6,
+	0x38, 0xac, 0x43, 0x5a, 0x6f, 0xc7, 0x5f, 0x95, 0x58, 0x9b, 0x1a, 0x4f, 0xcf, 0x2e, 0x2a, 0xee,
+	0xf9, 0x45, 0xc5, 0xfd, 0x76, 0x51, 0x71, 0xdf, 0x5f, 0x56, 0x9c, 0xf3, 0xcb, 0x8a, 0xf3, 0xe5,
+	0xb2, 0xe2, 0xec, 0x6d, 0xf6, 0x98, 0x3c, 0x18, 0xb4, 0x6a, 0x6d, 0x7e, 0x54, 0x7f, 0x4b, 0x44,
+	0x87, 0xfa, 0xf7, 0xf4, 0x3f, 0xa0, 0x36, 0xf7, 0x86, 0xcf, 0xd1, 0xe3, 0xf8, 0x7f, 0x57, 0x6b,
+	0x4e, 0x8b, 0x0f, 0x7e, 0x0c, 0x00, 0x40, 0x00, 0xb4, 0x1f, 0x8c, 0x09, 0x00, 0x00,
 }
 
 func (m *Expression) Marshal() (dAtA []byte, err error) {
@@ -894,6 +1096,48 @@ func (m *Expression_PrefixExpression) MarshalToSizedBuffer(dAtA []byte) (int, er
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Expression_ObjectLiteral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Expression_ObjectLiteral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ObjectLiteral != nil {
+		{
+			size, err := m.ObjectLiteral.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAst(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Expression_IndexExpression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Expression_IndexExpression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IndexExpression != nil {
+		{
+			size, err := m.IndexExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAst(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Identifier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1279,55 +1523,201 @@ func (m *PrefixExpression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAst(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAst(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ObjectLiteral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Expression) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ObjectLiteral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectLiteral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		n += m.Value.Size()
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAst(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAst(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Expression_Identifier) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ObjectEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Identifier != nil {
-		l = m.Identifier.Size()
-		n += 1 + l + sovAst(uint64(l))
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAst(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAst(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Expression_IntegerLiteral) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *IndexExpression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexExpression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexExpression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IntegerLiteral != nil {
-		l = m.IntegerLiteral.Size()
-		n += 1 + l + sovAst(uint64(l))
-	}
-	return n
-}
-func (m *Expression_BooleanLiteral) Size() (n int) {
-	if m == nil {
+	if m.Index != nil {
+		{
+			size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAst(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Left != nil {
+		{
+			size, err := m.Left.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAst(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAst(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAst(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAst(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Expression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *Expression_Identifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Identifier != nil {
+		l = m.Identifier.Size()
+		n += 1 + l + sovAst(uint64(l))
+	}
+	return n
+}
+func (m *Expression_IntegerLiteral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntegerLiteral != nil {
+		l = m.IntegerLiteral.Size()
+		n += 1 + l + sovAst(uint64(l))
+	}
+	return n
+}
+func (m *Expression_BooleanLiteral) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1398,6 +1788,30 @@ func (m *Expression_PrefixExpression) Size() (n int) {
 	}
 	return n
 }
+func (m *Expression_ObjectLiteral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectLiteral != nil {
+		l = m.ObjectLiteral.Size()
+		n += 1 + l + sovAst(uint64(l))
+	}
+	return n
+}
+func (m *Expression_IndexExpression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexExpression != nil {
+		l = m.IndexExpression.Size()
+		n += 1 + l + sovAst(uint64(l))
+	}
+	return n
+}
 func (m *Identifier) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1537,6 +1951,59 @@ func (m *PrefixExpression) Size() (n int) {
 	return n
 }
 
+func (m *ObjectLiteral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovAst(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAst(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ObjectEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAst(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovAst(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexExpression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovAst(uint64(l))
+	if m.Left != nil {
+		l = m.Left.Size()
+		n += 1 + l + sovAst(uint64(l))
+	}
+	if m.Index != nil {
+		l = m.Index.Size()
+		n += 1 + l + sovAst(uint64(l))
+	}
+	return n
+}
+
 func sovAst(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1852,6 +2319,76 @@ func (m *Expression) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Expression_PrefixExpression{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLiteral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ObjectLiteral{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Expression_ObjectLiteral{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IndexExpression{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Expression_IndexExpression{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAst(dAtA[iNdEx:])
@@ -2929,6 +3466,396 @@ func (m *PrefixExpression) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ObjectLiteral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAst
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectLiteral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectLiteral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &ObjectEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAst(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAst
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAst
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &Expression{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAst(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAst
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexExpression) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAst
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexExpression: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Left == nil {
+				m.Left = &Expression{}
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAst
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAst
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAst
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Index == nil {
+				m.Index = &Expression{}
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAst(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAst
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAst(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,14 @@ func Stringify(exp *Expression) string {
 		return fmt.Sprintf("\"%s\"", n.StringLiteral.Value)
 	case *Expression_ArrayLiteral:
 		return fmt.Sprintf("[%s]", stringifyExpressions(n.ArrayLiteral.Elements))
+	case *Expression_ObjectLiteral:
+		entries := make([]string, 0, len(n.ObjectLiteral.Entries))
+		for _, e := range n.ObjectLiteral.Entries {
+			entries = append(entries, fmt.Sprintf("\"%s\": %s", e.Key, Stringify(e.Value)))
+		}
+		return fmt.Sprintf("{%s}", strings.Join(entries, ", "))
+	case *Expression_IndexExpression:
+		return fmt.Sprintf("(%s[%s])", Stringify(n.IndexExpression.Left), Stringify(n.IndexExpression.Index))
 	case *Expression_CallExpression:
 		return fmt.Sprintf("%s(%s)", n.CallExpression.Function.Value, stringifyExpressions(n.CallExpression.Arguments))
 	case *Expression_PrefixExpression:
@@ -0,0 +1,215 @@
+// Package code defines the bytecode instructions executed by the Shield
+// virtual machine.
+package code
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+type Instructions []byte
+
+func (ins Instructions) String() string {
+	var out bytes.Buffer
+
+	i := 0
+	for i < len(ins) {
+		def, err := Lookup(ins[i])
+		if err != nil {
+			fmt.Fprintf(&out, "ERROR: %s\n", err)
+			return out.String()
+		}
+
+		operands, read, err := ReadOperands(def, ins[i+1:])
+		if err != nil {
+			fmt.Fprintf(&out, "ERROR: %s\n", err)
+			return out.String()
+		}
+
+		fmt.Fprintf(&out, "%04d %s\n", i, fmtInstruction(def, operands))
+
+		i += 1 + read
+	}
+
+	return out.String()
+}
+
+func fmtInstruction(def *Definition, operands []int) string {
+	switch len(def.OperandWidths) {
+	case 0:
+		return def.Name
+	case 1:
+		return fmt.Sprintf("%s %d", def.Name, operands[0])
+	}
+
+	return fmt.Sprintf("ERROR: unhandled operand count for %s", def.Name)
+}
+
+type Opcode byte
+
+const (
+	// OpConstant pushes the constant at the given index.
+	OpConstant Opcode = iota
+	// OpTrue pushes true.
+	OpTrue
+	// OpFalse pushes false.
+	OpFalse
+	// OpIdentifier resolves the identifier whose name is the string constant
+	// at the given index and pushes its value.
+	OpIdentifier
+	// OpArray pops the given number of elements and pushes an array
+	// containing them.
+	OpArray
+	// OpCall pops the given number of arguments and the function, and pushes
+	// the result of the call.
+	OpCall
+	// OpNeg negates the top of the stack.
+	OpNeg
+
+	// Infix operators, pop the right and the left operands and push the
+	// result.
+	OpOr
+	OpAnd
+	OpEqual
+	OpNotEqual
+	OpGreaterThan
+	OpGreaterThanOrEqual
+	OpLessThan
+	OpLessThanOrEqual
+	OpAdd
+	OpSub
+	OpMul
+	OpDiv
+
+	// OpObject pops the given number of key and value pairs, pushed in this
+	// order, and pushes a map containing them.
+	OpObject
+	// OpIndex pops the index and the indexed value, and pushes the element
+	// at the index.
+	OpIndex
+)
+
+type Definition struct {
+	Name          string
+	OperandWidths []int
+}
+
+var definitions = map[Opcode]*Definition{
+	OpConstant:           {"OpConstant", []int{2}},
+	OpTrue:               {"OpTrue", []int{}},
+	OpFalse:              {"OpFalse", []int{}},
+	OpIdentifier:         {"OpIdentifier", []int{2}},
+	OpArray:              {"OpArray", []int{2}},
+	OpCall:               {"OpCall", []int{2}},
+	OpNeg:                {"OpNeg", []int{}},
+	OpOr:                 {"OpOr", []int{}},
+	OpAnd:                {"OpAnd", []int{}},
+	OpEqual:              {"OpEqual", []int{}},
+	OpNotEqual:           {"OpNotEqual", []int{}},
+	OpGreaterThan:        {"OpGreaterThan", []int{}},
+	OpGreaterThanOrEqual: {"OpGreaterThanOrEqual", []int{}},
+	OpLessThan:           {"OpLessThan", []int{}},
+	OpLessThanOrEqual:    {"OpLessThanOrEqual", []int{}},
+	OpAdd:                {"OpAdd", []int{}},
+	OpSub:                {"OpSub", []int{}},
+	OpMul:                {"OpMul", []int{}},
+	OpDiv:                {"OpDiv", []int{}},
+	OpObject:             {"OpObject", []int{2}},
+	OpIndex:              {"OpIndex", []int{}},
+}
+
+// infixOperators maps the infix opcodes to the operators of the language.
+var infixOperators = map[Opcode]string{
+	OpOr:                 "||",
+	OpAnd:                "&&",
+	OpEqual:              "==",
+	OpNotEqual:           "!=",
+	OpGreaterThan:        ">",
+	OpGreaterThanOrEqual: ">=",
+	OpLessThan:           "<",
+	OpLessThanOrEqual:    "<=",
+	OpAdd:                "+",
+	OpSub:                "-",
+	OpMul:                "*",
+	OpDiv:                "/",
+}
+
+func Lookup(op byte) (*Definition, error) {
+	def, ok := definitions[Opcode(op)]
+	if !ok {
+		return nil, fmt.Errorf("opcode %d undefined", op)
+	}
+
+	return def, nil
+}
+
+// InfixOpcode returns the opcode for the infix operator.
+func InfixOpcode(operator string) (Opcode, bool) {
+	for op, s := range infixOperators {
+		if s == operator {
+			return op, true
+		}
+	}
+	return 0, false
+}
+
+// InfixOperator returns the infix operator for the opcode.
+func InfixOperator(op Opcode) (string, bool) {
+	s, ok := infixOperators[op]
+	return s, ok
+}
+
+// Make encodes the instruction op with its operands.
+func Make(op Opcode, operands ...int) []byte {
+	def, ok := definitions[op]
+	if !ok {
+		return []byte{}
+	}
+
+	instructionLen := 1
+	for _, w := range def.OperandWidths {
+		instructionLen += w
+	}
+
+	instruction := make([]byte, instructionLen)
+	instruction[0] = byte(op)
+
+	offset := 1
+	for i, o := range operands {
+		width := def.OperandWidths[i]
+		switch width {
+		case 2:
+			binary.BigEndian.PutUint16(instruction[offset:], uint16(o))
+		}
+		offset += width
+	}
+
+	return instruction
+}
+
+// ReadOperands decodes the operands of an instruction and returns them
+// together with the number of bytes read.
+func ReadOperands(def *Definition, ins Instructions) ([]int, int, error) {
+	operands := make([]int, len(def.OperandWidths))
+	offset := 0
+
+	for i, width := range def.OperandWidths {
+		if offset+width > len(ins) {
+			return nil, 0, fmt.Errorf("truncated operands for %s", def.Name)
+		}
+
+		switch width {
+		case 2:
+			operands[i] = int(ReadUint16(ins[offset:]))
+		}
+
+		offset += width
+	}
+
+	return operands, offset, nil
+}
+
+func ReadUint16(ins Instructions) uint16 {
+	return binary.BigEndian.Uint16(ins)
+}
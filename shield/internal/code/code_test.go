@@ -0,0 +1,117 @@
+package code
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		op       Opcode
+		operands []int
+		expected []byte
+	}{
+		{OpConstant, []int{65534}, []byte{byte(OpConstant), 255, 254}},
+		{OpTrue, []int{}, []byte{byte(OpTrue)}},
+		{OpCall, []int{2}, []byte{byte(OpCall), 0, 2}},
+		{OpAnd, []int{}, []byte{byte(OpAnd)}},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, Make(tt.op, tt.operands...))
+	}
+}
+
+func TestInstructionsString(t *testing.T) {
+	instructions := []Instructions{
+		Make(OpIdentifier, 0),
+		Make(OpConstant, 1),
+		Make(OpConstant, 65535),
+		Make(OpArray, 2),
+		Make(OpCall, 2),
+		Make(OpNeg),
+		Make(OpGreaterThanOrEqual),
+	}
+
+	expected := `0000 OpIdentifier 0
+0003 OpConstant 1
+0006 OpConstant 65535
+0009 OpArray 2
+0012 OpCall 2
+0015 OpNeg
+0016 OpGreaterThanOrEqual
+`
+
+	var concatted Instructions
+	for _, ins := range instructions {
+		concatted = append(concatted, ins...)
+	}
+
+	require.Equal(t, expected, concatted.String())
+}
+
+func TestReadOperands(t *testing.T) {
+	def, err := Lookup(byte(OpConstant))
+	require.NoError(t, err)
+
+	ins := Make(OpConstant, 65535)
+	operands, read, err := ReadOperands(def, ins[1:])
+	require.NoError(t, err)
+	require.Equal(t, 2, read)
+	require.Equal(t, []int{65535}, operands)
+
+	_, _, err = ReadOperands(def, ins[1:2])
+	require.Error(t, err)
+}
+
+func TestProgramMarshalBinary(t *testing.T) {
+	program := &Program{
+		Instructions: append(append(Make(OpConstant, 0), Make(OpConstant, 1)...), Make(OpIdentifier, 2)...),
+		Constants: []Constant{
+			{Kind: ConstantInteger, Value: "-18446744073709551616"},
+			{Kind: ConstantInteger, Value: "0"},
+			{Kind: ConstantString, Value: "warden1abc"},
+		},
+	}
+
+	bz, err := program.MarshalBinary()
+	require.NoError(t, err)
+
+	bz2, err := program.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, bz, bz2)
+
+	var decoded Program
+	require.NoError(t, decoded.UnmarshalBinary(bz))
+	require.Equal(t, program.Constants, decoded.Constants)
+	require.Equal(t, program.Instructions, decoded.Instructions)
+}
+
+func TestProgramUnmarshalBinaryInvalid(t *testing.T) {
+	valid, err := (&Program{
+		Instructions: Make(OpConstant, 0),
+		Constants:    []Constant{{Kind: ConstantString, Value: "foo"}},
+	}).MarshalBinary()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{"empty", nil},
+		{"unknown version", append([]byte{Version + 1}, valid[1:]...)},
+		{"truncated", valid[:len(valid)-1]},
+		{"trailing bytes", append(valid[:len(valid):len(valid)], 0)},
+		{"unknown opcode", []byte{Version, 0, 1, 255}},
+		{"constant out of range", []byte{Version, 0, 3, byte(OpConstant), 0, 0}},
+		{"integer identifier", []byte{Version, 1, byte(ConstantInteger), 1, 0, 3, byte(OpIdentifier), 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Program
+			require.Error(t, p.UnmarshalBinary(tt.input))
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package code
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Version is the version of the bytecode format, stored as the first byte of
+// an encoded Program.
+const Version byte = 1
+
+// MaxOperand is the maximum value that can be encoded as an operand.
+const MaxOperand = 1<<16 - 1
+
+type ConstantKind byte
+
+const (
+	// ConstantInteger is an integer, encoded as its sign (0 or 1) followed by
+	// its absolute value as big-endian bytes.
+	ConstantInteger ConstantKind = iota + 1
+	// ConstantString is a string, used for string literals and names of
+	// identifiers.
+	ConstantString
+)
+
+type Constant struct {
+	Kind ConstantKind
+	// Value is the decimal representation of an integer or the string.
+	Value string
+}
+
+// Program is a compiled expression.
+type Program struct {
+	Instructions Instructions
+	Constants    []Constant
+}
+
+// MarshalBinary encodes p. The encoding is deterministic, the same Program
+// always results in the same bytes.
+func (p *Program) MarshalBinary() ([]byte, error) {
+	bz := []byte{Version}
+	bz = binary.AppendUvarint(bz, uint64(len(p.Constants)))
+
+	for _, c := range p.Constants {
+		var value []byte
+		switch c.Kind {
+		case ConstantInteger:
+			i, ok := new(big.Int).SetString(c.Value, 10)
+			if !ok {
+				return nil, fmt.Errorf("invalid integer constant: %s", c.Value)
+			}
+			sign := byte(0)
+			if i.Sign() < 0 {
+				sign = 1
+			}
+			value = append([]byte{sign}, i.Bytes()...)
+		case ConstantString:
+			value = []byte(c.Value)
+		default:
+			return nil, fmt.Errorf("unknown constant kind: %d", c.Kind)
+		}
+
+		bz = append(bz, byte(c.Kind))
+		bz = binary.AppendUvarint(bz, uint64(len(value)))
+		bz = append(bz, value...)
+	}
+
+	bz = binary.AppendUvarint(bz, uint64(len(p.Instructions)))
+	bz = append(bz, p.Instructions...)
+
+	return bz, nil
+}
+
+// UnmarshalBinary decodes bz into p and validates the instructions.
+func (p *Program) UnmarshalBinary(bz []byte) error {
+	if len(bz) == 0 {
+		return errors.New("empty bytecode")
+	}
+
+	if bz[0] != Version {
+		return fmt.Errorf("unsupported bytecode version: %d", bz[0])
+	}
+	r := reader{bz: bz[1:]}
+
+	n, err := r.uvarint()
+	if err != nil {
+		return err
+	}
+	if n > uint64(len(r.bz)) {
+		return errors.New("invalid constants count")
+	}
+
+	constants := make([]Constant, 0, n)
+	for i := uint64(0); i < n; i++ {
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+
+		value, err := r.bytes()
+		if err != nil {
+			return err
+		}
+
+		c := Constant{Kind: ConstantKind(kind)}
+		switch c.Kind {
+		case ConstantInteger:
+			if len(value) == 0 || value[0] > 1 {
+				return fmt.Errorf("invalid integer constant %d", i)
+			}
+			v := new(big.Int).SetBytes(value[1:])
+			if value[0] == 1 {
+				v.Neg(v)
+			}
+			c.Value = v.String()
+		case ConstantString:
+			c.Value = string(value)
+		default:
+			return fmt.Errorf("unknown constant kind: %d", kind)
+		}
+
+		constants = append(constants, c)
+	}
+
+	instructions, err := r.bytes()
+	if err != nil {
+		return err
+	}
+
+	if len(r.bz) > 0 {
+		return errors.New("trailing bytes after instructions")
+	}
+
+	program := Program{
+		Instructions: instructions,
+		Constants:    constants,
+	}
+	if err := program.Validate(); err != nil {
+		return err
+	}
+
+	*p = program
+	return nil
+}
+
+// Validate checks that all the instructions of p are defined and that they
+// reference existing constants.
+func (p *Program) Validate() error {
+	ins := p.Instructions
+	for i := 0; i < len(ins); {
+		def, err := Lookup(ins[i])
+		if err != nil {
+			return err
+		}
+
+		operands, read, err := ReadOperands(def, ins[i+1:])
+		if err != nil {
+			return err
+		}
+
+		switch Opcode(ins[i]) {
+		case OpConstant:
+			if operands[0] >= len(p.Constants) {
+				return fmt.Errorf("constant %d out of range", operands[0])
+			}
+		case OpIdentifier:
+			if operands[0] >= len(p.Constants) || p.Constants[operands[0]].Kind != ConstantString {
+				return fmt.Errorf("invalid identifier constant %d", operands[0])
+			}
+		}
+
+		i += 1 + read
+	}
+
+	return nil
+}
+
+type reader struct {
+	bz []byte
+}
+
+func (r *reader) byte() (byte, error) {
+	if len(r.bz) == 0 {
+		return 0, errors.New("unexpected end of bytecode")
+	}
+	b := r.bz[0]
+	r.bz = r.bz[1:]
+	return b, nil
+}
+
+func (r *reader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.bz)
+	if n <= 0 {
+		return 0, errors.New("invalid varint")
+	}
+	r.bz = r.bz[n:]
+	return v, nil
+}
+
+func (r *reader) bytes() ([]byte, error) {
+	n, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.bz)) {
+		return nil, errors.New("unexpected end of bytecode")
+	}
+	b := r.bz[:n]
+	r.bz = r.bz[n:]
+	return b, nil
+}
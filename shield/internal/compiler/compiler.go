@@ -0,0 +1,164 @@
+// Package compiler compiles Shield expressions into bytecode that can be
+// executed by the vm package.
+package compiler
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/code"
+)
+
+type Compiler struct {
+	instructions code.Instructions
+	constants    []code.Constant
+	constantsIdx map[code.Constant]int
+}
+
+func New() *Compiler {
+	return &Compiler{
+		instructions: code.Instructions{},
+		constantsIdx: make(map[code.Constant]int),
+	}
+}
+
+// Compile compiles exp into a Program.
+func Compile(exp *ast.Expression) (*code.Program, error) {
+	c := New()
+	if err := c.Compile(exp); err != nil {
+		return nil, err
+	}
+	return c.Program(), nil
+}
+
+func (c *Compiler) Program() *code.Program {
+	return &code.Program{
+		Instructions: c.instructions,
+		Constants:    c.constants,
+	}
+}
+
+func (c *Compiler) Compile(exp *ast.Expression) error {
+	if exp == nil {
+		return fmt.Errorf("empty expression")
+	}
+
+	switch exp := exp.Value.(type) {
+	case *ast.Expression_IntegerLiteral:
+		value, ok := new(big.Int).SetString(exp.IntegerLiteral.Value, 10)
+		if !ok {
+			return fmt.Errorf("invalid IntegerLiteral value: %s", exp.IntegerLiteral.Value)
+		}
+		return c.emitConstant(code.OpConstant, code.Constant{Kind: code.ConstantInteger, Value: value.String()})
+	case *ast.Expression_BooleanLiteral:
+		if exp.BooleanLiteral.Value {
+			c.emit(code.OpTrue)
+		} else {
+			c.emit(code.OpFalse)
+		}
+		return nil
+	case *ast.Expression_StringLiteral:
+		return c.emitConstant(code.OpConstant, code.Constant{Kind: code.ConstantString, Value: exp.StringLiteral.Value})
+	case *ast.Expression_Identifier:
+		return c.emitConstant(code.OpIdentifier, code.Constant{Kind: code.ConstantString, Value: exp.Identifier.Value})
+	case *ast.Expression_ArrayLiteral:
+		if err := c.compileList(exp.ArrayLiteral.Elements); err != nil {
+			return err
+		}
+		c.emit(code.OpArray, len(exp.ArrayLiteral.Elements))
+		return nil
+	case *ast.Expression_ObjectLiteral:
+		entries := exp.ObjectLiteral.Entries
+		if len(entries) > code.MaxOperand {
+			return fmt.Errorf("too many entries: %d (max %d)", len(entries), code.MaxOperand)
+		}
+		for _, e := range entries {
+			if err := c.emitConstant(code.OpConstant, code.Constant{Kind: code.ConstantString, Value: e.Key}); err != nil {
+				return err
+			}
+			if err := c.Compile(e.Value); err != nil {
+				return err
+			}
+		}
+		c.emit(code.OpObject, len(entries))
+		return nil
+	case *ast.Expression_IndexExpression:
+		if err := c.Compile(exp.IndexExpression.Left); err != nil {
+			return err
+		}
+		if err := c.Compile(exp.IndexExpression.Index); err != nil {
+			return err
+		}
+		c.emit(code.OpIndex)
+		return nil
+	case *ast.Expression_PrefixExpression:
+		if exp.PrefixExpression.Operator != "-" {
+			return fmt.Errorf("unknown operator: %s", exp.PrefixExpression.Operator)
+		}
+		if err := c.Compile(exp.PrefixExpression.Right); err != nil {
+			return err
+		}
+		c.emit(code.OpNeg)
+		return nil
+	case *ast.Expression_InfixExpression:
+		op, ok := code.InfixOpcode(exp.InfixExpression.Operator)
+		if !ok {
+			return fmt.Errorf("unknown operator: %s", exp.InfixExpression.Operator)
+		}
+		if err := c.Compile(exp.InfixExpression.Left); err != nil {
+			return err
+		}
+		if err := c.Compile(exp.InfixExpression.Right); err != nil {
+			return err
+		}
+		c.emit(op)
+		return nil
+	case *ast.Expression_CallExpression:
+		if err := c.emitConstant(code.OpIdentifier, code.Constant{Kind: code.ConstantString, Value: exp.CallExpression.Function.Value}); err != nil {
+			return err
+		}
+		if err := c.compileList(exp.CallExpression.Arguments); err != nil {
+			return err
+		}
+		c.emit(code.OpCall, len(exp.CallExpression.Arguments))
+		return nil
+	}
+
+	return fmt.Errorf("unknown expression: %s (type %T)", exp, exp)
+}
+
+func (c *Compiler) compileList(exps []*ast.Expression) error {
+	if len(exps) > code.MaxOperand {
+		return fmt.Errorf("too many elements: %d (max %d)", len(exps), code.MaxOperand)
+	}
+
+	for _, e := range exps {
+		if err := c.Compile(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emitConstant adds constant to the constants pool, if not already present,
+// and emits op referencing it.
+func (c *Compiler) emitConstant(op code.Opcode, constant code.Constant) error {
+	idx, ok := c.constantsIdx[constant]
+	if !ok {
+		idx = len(c.constants)
+		if idx > code.MaxOperand {
+			return fmt.Errorf("too many constants (max %d)", code.MaxOperand+1)
+		}
+		c.constants = append(c.constants, constant)
+		c.constantsIdx[constant] = idx
+	}
+
+	c.emit(op, idx)
+	return nil
+}
+
+func (c *Compiler) emit(op code.Opcode, operands ...int) {
+	c.instructions = append(c.instructions, code.Make(op, operands...)...)
+}
@@ -0,0 +1,143 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/code"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/lexer"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/parser"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedConstants    []code.Constant
+		expectedInstructions []code.Instructions
+	}{
+		{
+			input:             "1 + 2",
+			expectedConstants: []code.Constant{{Kind: code.ConstantInteger, Value: "1"}, {Kind: code.ConstantInteger, Value: "2"}},
+			expectedInstructions: []code.Instructions{
+				code.Make(code.OpConstant, 0),
+				code.Make(code.OpConstant, 1),
+				code.Make(code.OpAdd),
+			},
+		},
+		{
+			input:             `-5 == "5"`,
+			expectedConstants: []code.Constant{{Kind: code.ConstantInteger, Value: "5"}, {Kind: code.ConstantString, Value: "5"}},
+			expectedInstructions: []code.Instructions{
+				code.Make(code.OpConstant, 0),
+				code.Make(code.OpNeg),
+				code.Make(code.OpConstant, 1),
+				code.Make(code.OpEqual),
+			},
+		},
+		{
+			input: "any(2, [warden1a, warden1b]) || (warden1a && true)",
+			expectedConstants: []code.Constant{
+				{Kind: code.ConstantString, Value: "any"},
+				{Kind: code.ConstantInteger, Value: "2"},
+				{Kind: code.ConstantString, Value: "warden1a"},
+				{Kind: code.ConstantString, Value: "warden1b"},
+			},
+			expectedInstructions: []code.Instructions{
+				code.Make(code.OpIdentifier, 0),
+				code.Make(code.OpConstant, 1),
+				code.Make(code.OpIdentifier, 2),
+				code.Make(code.OpIdentifier, 3),
+				code.Make(code.OpArray, 2),
+				code.Make(code.OpCall, 2),
+				code.Make(code.OpIdentifier, 2),
+				code.Make(code.OpTrue),
+				code.Make(code.OpAnd),
+				code.Make(code.OpOr),
+			},
+		},
+		{
+			input: `{to: tx, value: 1}.value`,
+			expectedConstants: []code.Constant{
+				{Kind: code.ConstantString, Value: "to"},
+				{Kind: code.ConstantString, Value: "tx"},
+				{Kind: code.ConstantString, Value: "value"},
+				{Kind: code.ConstantInteger, Value: "1"},
+			},
+			expectedInstructions: []code.Instructions{
+				code.Make(code.OpConstant, 0),
+				code.Make(code.OpIdentifier, 1),
+				code.Make(code.OpConstant, 2),
+				code.Make(code.OpConstant, 3),
+				code.Make(code.OpObject, 2),
+				code.Make(code.OpConstant, 2),
+				code.Make(code.OpIndex),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, err := Compile(parse(t, tt.input))
+			require.NoError(t, err)
+
+			var expected code.Instructions
+			for _, ins := range tt.expectedInstructions {
+				expected = append(expected, ins...)
+			}
+
+			require.Equal(t, expected.String(), program.Instructions.String())
+			require.Equal(t, tt.expectedConstants, program.Constants)
+		})
+	}
+}
+
+func TestCompileDeterministic(t *testing.T) {
+	input := `all([warden1a, warden1b]) && contains("foo", ["bar", "foo"]) && 10 > 9 / 3`
+
+	first, err := Compile(parse(t, input))
+	require.NoError(t, err)
+	firstBz, err := first.MarshalBinary()
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		p, err := Compile(parse(t, input))
+		require.NoError(t, err)
+		bz, err := p.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, firstBz, bz)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		exp  *ast.Expression
+	}{
+		{"nil", nil},
+		{"invalid integer", ast.NewIntegerLiteral(&ast.IntegerLiteral{Value: "abc"})},
+		{"unknown infix operator", ast.NewInfixExpression(&ast.InfixExpression{
+			Operator: "%",
+			Left:     ast.NewIntegerLiteral(&ast.IntegerLiteral{Value: "1"}),
+			Right:    ast.NewIntegerLiteral(&ast.IntegerLiteral{Value: "2"}),
+		})},
+		{"unknown prefix operator", ast.NewPrefixExpression(&ast.PrefixExpression{
+			Operator: "!",
+			Right:    ast.NewBooleanLiteral(&ast.BooleanLiteral{Value: true}),
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.exp)
+			require.Error(t, err)
+		})
+	}
+}
+
+func parse(t *testing.T, input string) *ast.Expression {
+	p := parser.New(lexer.New(input))
+	exp := p.Parse()
+	require.Empty(t, p.Errors())
+	return exp
+}
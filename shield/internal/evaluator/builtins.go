@@ -1,11 +1,19 @@
 package evaluator
 
 import (
+	"encoding/hex"
 	"math/big"
+	"strings"
 
 	"github.com/warden-protocol/wardenprotocol/shield/object"
 )
 
+// IsBuiltin returns true if name is the name of a builtin function.
+func IsBuiltin(name string) bool {
+	_, ok := builtins[name]
+	return ok
+}
+
 var builtins = map[string]*object.Builtin{
 	"any": {
 		Fn: func(args ...object.Object) object.Object {
@@ -13,7 +21,17 @@ var builtins = map[string]*object.Builtin{
 				return newError("wrong number of arguments. got=%d, want=2", len(args))
 			}
 
-			threshold := args[0].(*object.Integer).Value
+			if args[0].Type() != object.INTEGER_OBJ {
+				return newError("invalid first argument type. got=%s, want=%s", args[0].Type(), object.INTEGER_OBJ)
+			}
+
+			if args[1].Type() != object.ARRAY_OBJ {
+				return newError("invalid second argument type. got=%s, want=%s", args[1].Type(), object.ARRAY_OBJ)
+			}
+
+			// copy the threshold, the arguments must not be modified as they
+			// can be shared (e.g. the constants of a compiled program)
+			threshold := new(big.Int).Set(args[0].(*object.Integer).Value)
 			elements := args[1].(*object.Array).Elements
 
 			for _, el := range elements {
@@ -40,6 +58,10 @@ var builtins = map[string]*object.Builtin{
 				return newError("wrong number of arguments. got=%d, want=1", len(args))
 			}
 
+			if args[0].Type() != object.ARRAY_OBJ {
+				return newError("invalid argument type. got=%s, want=%s", args[0].Type(), object.ARRAY_OBJ)
+			}
+
 			elements := args[0].(*object.Array).Elements
 
 			for _, el := range elements {
@@ -56,6 +78,53 @@ var builtins = map[string]*object.Builtin{
 		},
 	},
 
+	"weighted": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 2 {
+				return newError("wrong number of arguments. got=%d, want=2", len(args))
+			}
+
+			if args[0].Type() != object.INTEGER_OBJ {
+				return newError("invalid first argument type. got=%s, want=%s", args[0].Type(), object.INTEGER_OBJ)
+			}
+
+			if args[1].Type() != object.ARRAY_OBJ {
+				return newError("invalid second argument type. got=%s, want=%s", args[1].Type(), object.ARRAY_OBJ)
+			}
+
+			threshold := args[0].(*object.Integer).Value
+			elements := args[1].(*object.Array).Elements
+			total := new(big.Int)
+
+			for _, el := range elements {
+				pair, ok := el.(*object.Array)
+				if !ok || len(pair.Elements) != 2 {
+					return newError("argument to `weighted` not supported, got %s (%s), want [vote, weight]", el.Type(), el.Inspect())
+				}
+
+				vote, weight := pair.Elements[0], pair.Elements[1]
+				if vote.Type() != object.BOOLEAN_OBJ {
+					return newError("argument to `weighted` not supported, got %s (%s) as vote", vote.Type(), vote.Inspect())
+				}
+
+				if weight.Type() != object.INTEGER_OBJ {
+					return newError("argument to `weighted` not supported, got %s (%s) as weight", weight.Type(), weight.Inspect())
+				}
+
+				w := weight.(*object.Integer).Value
+				if w.Sign() < 0 {
+					return newError("negative weight in `weighted`: %s", w)
+				}
+
+				if vote.(*object.Boolean).Value {
+					total.Add(total, w)
+				}
+			}
+
+			return nativeBoolToBooleanObject(total.Cmp(threshold) >= 0)
+		},
+	},
+
 	"contains": {
 		Fn: func(args ...object.Object) object.Object {
 			if len(args) != 2 {
@@ -70,7 +139,7 @@ var builtins = map[string]*object.Builtin{
 			}
 
 			if array.Type() != object.ARRAY_OBJ {
-				return newError("invalid second argument type. got=%s, want=%s", item.Type(), object.ARRAY_OBJ)
+				return newError("invalid second argument type. got=%s, want=%s", array.Type(), object.ARRAY_OBJ)
 			}
 
 			elements := array.(*object.Array).Elements
@@ -84,6 +153,317 @@ var builtins = map[string]*object.Builtin{
 			return object.FALSE
 		},
 	},
+
+	"len": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return newError("wrong number of arguments. got=%d, want=1", len(args))
+			}
+
+			switch arg := args[0].(type) {
+			case *object.String:
+				return &object.Integer{Value: big.NewInt(int64(len(arg.Value)))}
+			case *object.Array:
+				return &object.Integer{Value: big.NewInt(int64(len(arg.Elements)))}
+			case *object.Map:
+				return &object.Integer{Value: big.NewInt(int64(len(arg.Pairs)))}
+			}
+
+			return newError("argument to `len` not supported, got %s", args[0].Type())
+		},
+	},
+
+	"lower": {
+		Fn: func(args ...object.Object) object.Object {
+			s, err := stringArgument("lower", args)
+			if err != nil {
+				return err
+			}
+			return &object.String{Value: mapASCII(s, 'A', 'Z', 'a'-'A')}
+		},
+	},
+
+	"upper": {
+		Fn: func(args ...object.Object) object.Object {
+			s, err := stringArgument("upper", args)
+			if err != nil {
+				return err
+			}
+			return &object.String{Value: mapASCII(s, 'a', 'z', 'A'-'a')}
+		},
+	},
+
+	"startsWith": {
+		Fn: func(args ...object.Object) object.Object {
+			s, prefix, err := stringArguments("startsWith", args)
+			if err != nil {
+				return err
+			}
+			return nativeBoolToBooleanObject(strings.HasPrefix(s, prefix))
+		},
+	},
+
+	"endsWith": {
+		Fn: func(args ...object.Object) object.Object {
+			s, suffix, err := stringArguments("endsWith", args)
+			if err != nil {
+				return err
+			}
+			return nativeBoolToBooleanObject(strings.HasSuffix(s, suffix))
+		},
+	},
+
+	"hex": {
+		Fn: func(args ...object.Object) object.Object {
+			s, err := stringArgument("hex", args)
+			if err != nil {
+				return err
+			}
+			return &object.String{Value: hex.EncodeToString([]byte(s))}
+		},
+	},
+
+	"bytes": {
+		Fn: func(args ...object.Object) object.Object {
+			s, err := stringArgument("bytes", args)
+			if err != nil {
+				return err
+			}
+
+			bz, decodeErr := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+			if decodeErr != nil {
+				return newError("argument to `bytes` is not a hex string: %s", decodeErr)
+			}
+
+			elements := make([]object.Object, 0, len(bz))
+			for _, b := range bz {
+				elements = append(elements, &object.Integer{Value: big.NewInt(int64(b))})
+			}
+			return &object.Array{Elements: elements}
+		},
+	},
+
+	"min": {
+		Fn: func(args ...object.Object) object.Object {
+			values, err := integerArrayArgument("min", args)
+			if err != nil {
+				return err
+			}
+			if len(values) == 0 {
+				return newError("argument to `min` is empty")
+			}
+
+			result := values[0]
+			for _, v := range values[1:] {
+				if v.Cmp(result) < 0 {
+					result = v
+				}
+			}
+			return &object.Integer{Value: new(big.Int).Set(result)}
+		},
+	},
+
+	"max": {
+		Fn: func(args ...object.Object) object.Object {
+			values, err := integerArrayArgument("max", args)
+			if err != nil {
+				return err
+			}
+			if len(values) == 0 {
+				return newError("argument to `max` is empty")
+			}
+
+			result := values[0]
+			for _, v := range values[1:] {
+				if v.Cmp(result) > 0 {
+					result = v
+				}
+			}
+			return &object.Integer{Value: new(big.Int).Set(result)}
+		},
+	},
+
+	"sum": {
+		Fn: func(args ...object.Object) object.Object {
+			values, err := integerArrayArgument("sum", args)
+			if err != nil {
+				return err
+			}
+
+			total := new(big.Int)
+			for _, v := range values {
+				total.Add(total, v)
+			}
+			return &object.Integer{Value: total}
+		},
+	},
+
+	"units": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 2 {
+				return newError("wrong number of arguments. got=%d, want=2", len(args))
+			}
+
+			decimals, ok := args[1].(*object.Integer)
+			if !ok {
+				return newError("invalid second argument type. got=%s, want=%s", args[1].Type(), object.INTEGER_OBJ)
+			}
+			if decimals.Value.Sign() < 0 || decimals.Value.Cmp(big.NewInt(maxDecimals)) > 0 {
+				return newError("invalid number of decimals: %s (max %d)", decimals.Value, maxDecimals)
+			}
+
+			var amount *big.Rat
+			switch arg := args[0].(type) {
+			case *object.Integer:
+				amount = new(big.Rat).SetInt(arg.Value)
+			case *object.String:
+				var err *object.Error
+				amount, err = parseDecimal(arg.Value)
+				if err != nil {
+					return err
+				}
+			default:
+				return newError("invalid first argument type. got=%s, want=%s or %s", args[0].Type(), object.STRING_OBJ, object.INTEGER_OBJ)
+			}
+
+			scaled, err := scaleDecimal(amount, decimals.Value.Int64())
+			if err != nil {
+				return err
+			}
+			return &object.Integer{Value: scaled}
+		},
+	},
+
+	"amountOf": {
+		Fn: func(args ...object.Object) object.Object {
+			// the message expander turns lists of coins into arrays of
+			// strings, join them as a single list
+			if len(args) == 2 {
+				if arr, ok := args[0].(*object.Array); ok {
+					coins := make([]string, 0, len(arr.Elements))
+					for _, el := range arr.Elements {
+						s, ok := el.(*object.String)
+						if !ok {
+							return newError("elements of the coins of `amountOf` must be %s, got %s", object.STRING_OBJ, el.Type())
+						}
+						coins = append(coins, s.Value)
+					}
+					args = []object.Object{&object.String{Value: strings.Join(coins, ",")}, args[1]}
+				}
+			}
+
+			coins, denom, err := stringArguments("amountOf", args)
+			if err != nil {
+				return err
+			}
+
+			amount, err := parseCoinsAmount(coins, denom)
+			if err != nil {
+				return err
+			}
+			return &object.Integer{Value: amount}
+		},
+	},
+}
+
+// stringArgument returns the value of the only argument of the builtin name,
+// that must be a string.
+func stringArgument(name string, args []object.Object) (string, *object.Error) {
+	if len(args) != 1 {
+		return "", newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	s, ok := args[0].(*object.String)
+	if !ok {
+		return "", newError("argument to `%s` must be %s, got %s", name, object.STRING_OBJ, args[0].Type())
+	}
+
+	return s.Value, nil
+}
+
+// stringArguments returns the values of the two arguments of the builtin
+// name, that must be strings.
+func stringArguments(name string, args []object.Object) (string, string, *object.Error) {
+	if len(args) != 2 {
+		return "", "", newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	values := make([]string, 2)
+	for i, arg := range args {
+		s, ok := arg.(*object.String)
+		if !ok {
+			return "", "", newError("arguments to `%s` must be %s, got %s", name, object.STRING_OBJ, arg.Type())
+		}
+		values[i] = s.Value
+	}
+
+	return values[0], values[1], nil
+}
+
+// integerArrayArgument returns the values of the only argument of the
+// builtin name, that must be an array of integers.
+func integerArrayArgument(name string, args []object.Object) ([]*big.Int, *object.Error) {
+	if len(args) != 1 {
+		return nil, newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	array, ok := args[0].(*object.Array)
+	if !ok {
+		return nil, newError("argument to `%s` must be %s, got %s", name, object.ARRAY_OBJ, args[0].Type())
+	}
+
+	values := make([]*big.Int, 0, len(array.Elements))
+	for _, el := range array.Elements {
+		i, ok := el.(*object.Integer)
+		if !ok {
+			return nil, newError("argument to `%s` not supported, got %s (%s)", name, el.Type(), el.Inspect())
+		}
+		values = append(values, i.Value)
+	}
+
+	return values, nil
+}
+
+// mapASCII shifts the ASCII letters between from and to by delta. Other
+// characters are left untouched, so that the result doesn't depend on the
+// Unicode tables of the Go version.
+func mapASCII(s string, from, to byte, delta int) string {
+	bz := []byte(s)
+	for i, b := range bz {
+		if from <= b && b <= to {
+			bz[i] = byte(int(b) + delta)
+		}
+	}
+	return string(bz)
+}
+
+// parseCoinsAmount returns the amount of denom in coins, formatted like
+// "100award" or "100award,5uatom". If denom is not present, it returns 0.
+func parseCoinsAmount(coins, denom string) (*big.Int, *object.Error) {
+	total := new(big.Int)
+	if coins == "" {
+		return total, nil
+	}
+
+	for _, coin := range strings.Split(coins, ",") {
+		coin = strings.TrimSpace(coin)
+		i := strings.IndexFunc(coin, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 || coin[i] == '.' {
+			return nil, newError("invalid coin: %q", coin)
+		}
+
+		if coin[i:] != denom {
+			continue
+		}
+
+		amount, ok := new(big.Int).SetString(coin[:i], 10)
+		if !ok {
+			return nil, newError("invalid coin: %q", coin)
+		}
+		total.Add(total, amount)
+	}
+
+	return total, nil
 }
 
 func compare(a, b object.Object) bool {
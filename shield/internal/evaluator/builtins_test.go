@@ -2,6 +2,8 @@ package evaluator
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 type anyTestCaseSuccess struct {
@@ -44,6 +46,8 @@ var anyTestCasesSuccess = []anyTestCaseSuccess{
 
 var anyTestCasesError = []anyTestCaseError{
 	{`any(2, [0, true, false])`, nil}, // integer instead of bool
+	{`any(true, [true, false])`, nil}, // bool instead of integer threshold
+	{`any(1, true)`, nil},             // bool instead of array
 	{`any(2, [warden123, warden456, warden789])`, map[string]bool{
 		"warden123": true,
 		"warden789": true,
@@ -81,6 +85,7 @@ var allTestCasesError = []anyTestCaseError{
 	{`all(123, [0, true, false])`, nil}, // wrong number of arguments
 	{`all([], [0, true, false])`, nil},  // wrong number of arguments
 	{`all([0, true, false])`, nil},      // integer instead of bool
+	{`all(true)`, nil},                  // bool instead of array
 	{`all([warden123, warden456, warden789])`, map[string]bool{
 		"warden123": true,
 		"warden789": true,
@@ -113,6 +118,46 @@ var containsTestCasesError = []containsTestCaseError{
 	{`contains([1, 2, 3], [[], [1, 2, 3]])`},
 }
 
+var weightedTestCasesSuccess = []anyTestCaseSuccess{
+	{`weighted(0, [])`, true, nil},
+	{`weighted(1, [])`, false, nil},
+	{`weighted(3, [[true, 1], [true, 2], [false, 3]])`, true, nil},
+	{`weighted(4, [[true, 1], [true, 2], [false, 3]])`, false, nil},
+	{`weighted(60, [[warden123, 40], [warden456, 20], [warden789, 40]])`, true, map[string]bool{
+		"warden123": true,
+		"warden456": true,
+		"warden789": false,
+	}},
+	{`weighted(60, [[warden123, 40], [warden456, 20], [warden789, 40]])`, false, map[string]bool{
+		"warden123": true,
+		"warden456": false,
+		"warden789": false,
+	}},
+	// the CFO counts double
+	{`weighted(3, [[cfo, 2], [warden123, 1], [warden456, 1]])`, true, map[string]bool{
+		"cfo":       true,
+		"warden123": true,
+		"warden456": false,
+	}},
+	// weights bigger than 64 bits
+	{`weighted(36893488147419103232, [[warden123, 18446744073709551616], [warden456, 18446744073709551616]])`, true, map[string]bool{
+		"warden123": true,
+		"warden456": true,
+	}},
+}
+
+var weightedTestCasesError = []anyTestCaseError{
+	{`weighted(1)`, nil},                                 // wrong number of arguments
+	{`weighted(true, [[true, 1]])`, nil},                 // threshold is not an integer
+	{`weighted(1, true)`, nil},                           // second argument is not an array
+	{`weighted(1, [true])`, nil},                         // element is not a pair
+	{`weighted(1, [[true, 1, 2]])`, nil},                 // element is not a pair
+	{`weighted(1, [[1, 1]])`, nil},                       // vote is not a boolean
+	{`weighted(1, [[true, "1"]])`, nil},                  // weight is not an integer
+	{`weighted(1, [[true, -1], [true, 2]])`, nil},        // negative weight
+	{`weighted(1, [[warden123, 1]])`, map[string]bool{}}, // undefined variable
+}
+
 func TestAny(t *testing.T) {
 	for _, tt := range anyTestCasesSuccess {
 		evaluated := testEval(tt.input, tt.env)
@@ -148,3 +193,98 @@ func TestContains(t *testing.T) {
 		testErrorObject(t, evaluated, "input: %s", tt.input)
 	}
 }
+
+func TestWeighted(t *testing.T) {
+	for _, tt := range weightedTestCasesSuccess {
+		evaluated := testEval(tt.input, tt.env)
+		testBooleanObject(t, evaluated, tt.expected, "input: %s", tt.input)
+	}
+
+	for _, tt := range weightedTestCasesError {
+		evaluated := testEval(tt.input, tt.env)
+		testErrorObject(t, evaluated, "input: %s", tt.input)
+	}
+}
+
+// stdlibTestCases contains the expected Inspect() output of builtins that
+// don't return booleans.
+var stdlibTestCases = []struct {
+	input    string
+	expected string
+}{
+	{`len("")`, "0"},
+	{`len("warden")`, "6"},
+	{`len("é")`, "2"}, // bytes, not characters
+	{`len([1, [2, 3]])`, "2"},
+	{`len({a: 1, b: 2})`, "2"},
+	{`len(1)`, "ERROR: argument to `len` not supported, got INTEGER"},
+	{`len("a", "b")`, "ERROR: wrong number of arguments. got=2, want=1"},
+
+	{`lower("Warden1ABC")`, `"warden1abc"`},
+	{`upper("warden1abc")`, `"WARDEN1ABC"`},
+	{`lower("ÀB")`, `"Àb"`}, // only ASCII letters are mapped
+	{`lower(1)`, "ERROR: argument to `lower` must be STRING, got INTEGER"},
+
+	{`startsWith("warden1abc", "warden1")`, "true"},
+	{`startsWith("warden1abc", "")`, "true"},
+	{`startsWith("warden1abc", "cosmos1")`, "false"},
+	{`endsWith("100uward", "uward")`, "true"},
+	{`endsWith("100uward", "award")`, "false"},
+	{`endsWith("100uward", 1)`, "ERROR: arguments to `endsWith` must be STRING, got INTEGER"},
+	{`startsWith("a")`, "ERROR: wrong number of arguments. got=1, want=2"},
+
+	{`hex("")`, `""`},
+	{`hex("warden")`, `"77617264656e"`},
+	{`bytes("")`, "[]"},
+	{`bytes("00ff10")`, "[0, 255, 16]"},
+	{`bytes("0xFF10")`, "[255, 16]"},
+	{`bytes(hex("ab"))`, "[97, 98]"},
+	{`bytes("0xf")`, "ERROR: argument to `bytes` is not a hex string: encoding/hex: odd length hex string"},
+	{`bytes("zz")`, "ERROR: argument to `bytes` is not a hex string: encoding/hex: invalid byte: U+007A 'z'"},
+
+	{`min([3, -1, 2])`, "-1"},
+	{`max([3, -1, 2])`, "3"},
+	{`max([18446744073709551616, 1])`, "18446744073709551616"},
+	{`sum([3, -1, 2])`, "4"},
+	{`sum([])`, "0"},
+	{`min([])`, "ERROR: argument to `min` is empty"},
+	{`max(1)`, "ERROR: argument to `max` must be ARRAY, got INTEGER"},
+	{`sum([1, "2"])`, `ERROR: argument to ` + "`sum`" + ` not supported, got STRING ("2")`},
+
+	{`units("1.5", 6)`, "1500000"},
+	{`units("0.000001", 6)`, "1"},
+	{`units("-2", 2)`, "-200"},
+	{`units(3, 18)`, "3000000000000000000"},
+	{`units("1", 0)`, "1"},
+	{`units("0.0000001", 6)`, "ERROR: amount has more than 6 decimals"},
+	{`units("1e6", 6)`, "ERROR: invalid string number: 1e6"},
+	{`units("1", -1)`, "ERROR: invalid number of decimals: -1 (max 255)"},
+	{`units("1", 256)`, "ERROR: invalid number of decimals: 256 (max 255)"},
+	{`units(true, 6)`, "ERROR: invalid first argument type. got=BOOLEAN, want=STRING or INTEGER"},
+
+	{`amountOf("100uward", "uward")`, "100"},
+	{`amountOf("100uward,5uatom", "uatom")`, "5"},
+	{`amountOf("100uward, 5uatom", "uatom")`, "5"},
+	{`amountOf("100uward", "uatom")`, "0"},
+	{`amountOf("", "uatom")`, "0"},
+	{`amountOf("uward", "uward")`, `ERROR: invalid coin: "uward"`},
+	{`amountOf("1.5uward", "uward")`, `ERROR: invalid coin: "1.5uward"`},
+	{`amountOf("100uward", 1)`, "ERROR: arguments to `amountOf` must be STRING, got INTEGER"},
+	{`amountOf(["100uward", "5uatom"], "uatom")`, "5"},
+	{`amountOf(["100uward", "5uward"], "uward")`, "105"},
+	{`amountOf([], "uward")`, "0"},
+	{`amountOf([100], "uward")`, "ERROR: elements of the coins of `amountOf` must be STRING, got INTEGER"},
+
+	{`units("1.5", 6) <= amountOf("2000000uward", "uward")`, "true"},
+	{`"1.5" > "1.25"`, "true"},
+	{`"1.5" > "abc"`, "ERROR: invalid string number: abc"},
+	{`"1." > "1"`, "ERROR: invalid string number: 1."},
+	{`"--1" > "1"`, "ERROR: invalid string number: --1"},
+}
+
+func TestStdlib(t *testing.T) {
+	for _, tt := range stdlibTestCases {
+		evaluated := testEval(tt.input, nil)
+		require.Equal(t, tt.expected, evaluated.Inspect(), "input: %s", tt.input)
+	}
+}
@@ -0,0 +1,69 @@
+package evaluator
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+// maxDecimals is the maximum number of decimals accepted by `units`.
+const maxDecimals = 255
+
+// parseDecimal parses a decimal number such as "42", "-1.5" or "0.000001".
+// Exponents ("1e6") and fractions ("1/2"), accepted by big.Rat, are
+// rejected: amounts are always written in plain decimal notation.
+func parseDecimal(s string) (*big.Rat, *object.Error) {
+	digits := strings.TrimLeft(s, "+-")
+	if len(s)-len(digits) > 1 {
+		return nil, newError("invalid string number: %s", s)
+	}
+
+	intPart, fracPart, hasDot := strings.Cut(digits, ".")
+	if intPart == "" || (hasDot && fracPart == "") || !isDigits(intPart) || !isDigits(fracPart) {
+		return nil, newError("invalid string number: %s", s)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, newError("invalid string number: %s", s)
+	}
+
+	return r, nil
+}
+
+func isDigits(s string) bool {
+	for _, ch := range s {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// cmpDecimal compares two strings containing decimal numbers.
+func cmpDecimal(left, right object.Object) (int, *object.Error) {
+	l, errL := parseDecimal(left.(*object.String).Value)
+	if errL != nil {
+		return 0, errL
+	}
+
+	r, errR := parseDecimal(right.(*object.String).Value)
+	if errR != nil {
+		return 0, errR
+	}
+
+	return l.Cmp(r), nil
+}
+
+// scaleDecimal returns amount multiplied by 10^decimals, failing if the
+// result is not an integer.
+func scaleDecimal(amount *big.Rat, decimals int64) (*big.Int, *object.Error) {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil)
+	scaled := new(big.Rat).Mul(amount, new(big.Rat).SetInt(scale))
+	if !scaled.IsInt() {
+		return nil, newError("amount has more than %d decimals", decimals)
+	}
+
+	return new(big.Int).Set(scaled.Num()), nil
+}
@@ -30,20 +30,20 @@ func Eval(exp *ast.Expression, env env.Environment) object.Object {
 			elements = append(elements, Eval(el, env))
 		}
 		return &object.Array{Elements: elements}
-	case *ast.Expression_Identifier:
-		if v, ok := builtins[exp.Identifier.Value]; ok {
-			return v
-		}
-
-		if env == nil {
-			return newError("unknown identifier '%s': passed environment is nil", exp.Identifier.Value)
+	case *ast.Expression_ObjectLiteral:
+		pairs := make(map[string]object.Object, len(exp.ObjectLiteral.Entries))
+		for _, e := range exp.ObjectLiteral.Entries {
+			pairs[e.Key] = Eval(e.Value, env)
 		}
-
-		if v, ok := env.Get(exp.Identifier.Value); ok {
-			return v
+		return &object.Map{Pairs: pairs}
+	case *ast.Expression_Identifier:
+		return EvalIdentifier(exp.Identifier.Value, env)
+	case *ast.Expression_IndexExpression:
+		left := Eval(exp.IndexExpression.Left, env)
+		if isError(left) {
+			return left
 		}
-
-		return newError("identifier not found: " + exp.Identifier.Value)
+		return EvalIndex(left, Eval(exp.IndexExpression.Index, env))
 	case *ast.Expression_InfixExpression:
 		return evalInfixExpression(exp.InfixExpression, env)
 	case *ast.Expression_PrefixExpression:
@@ -51,11 +51,29 @@ func Eval(exp *ast.Expression, env env.Environment) object.Object {
 	case *ast.Expression_CallExpression:
 		fn := Eval(ast.NewIdentifier(exp.CallExpression.Function), env)
 		args := evalExpressions(exp.CallExpression.Arguments, env)
-		return applyFunction(fn, args)
+		return ApplyFunction(fn, args)
 	}
 	return newError("unknown expression: %s (type %T)", exp, exp)
 }
 
+// EvalIdentifier resolves name as a builtin function or, if no builtin
+// exists with that name, through env.
+func EvalIdentifier(name string, env env.Environment) object.Object {
+	if v, ok := builtins[name]; ok {
+		return v
+	}
+
+	if env == nil {
+		return newError("unknown identifier '%s': passed environment is nil", name)
+	}
+
+	if v, ok := env.Get(name); ok {
+		return v
+	}
+
+	return newError("identifier not found: " + name)
+}
+
 func evalExpressions(exps []*ast.Expression, env env.Environment) []object.Object {
 	result := make([]object.Object, 0, len(exps))
 	for _, e := range exps {
@@ -65,7 +83,8 @@ func evalExpressions(exps []*ast.Expression, env env.Environment) []object.Objec
 	return result
 }
 
-func applyFunction(fn object.Object, args []object.Object) object.Object {
+// ApplyFunction calls fn with args. fn must be a builtin function.
+func ApplyFunction(fn object.Object, args []object.Object) object.Object {
 	switch fn := fn.(type) {
 	case *object.Builtin:
 		return fn.Fn(args...)
@@ -78,9 +97,44 @@ func newError(format string, a ...interface{}) *object.Error {
 	return &object.Error{Message: fmt.Sprintf(format, a...)}
 }
 
+// EvalIndex returns the element of the array left at the integer index, or
+// the value of the map left at the string index.
+func EvalIndex(left, index object.Object) object.Object {
+	if isError(left) {
+		return left
+	}
+
+	if isError(index) {
+		return index
+	}
+
+	switch {
+	case left.Type() == object.ARRAY_OBJ && index.Type() == object.INTEGER_OBJ:
+		elements := left.(*object.Array).Elements
+		i := index.(*object.Integer).Value
+		if i.Sign() < 0 || !i.IsInt64() || i.Int64() >= int64(len(elements)) {
+			return newError("index out of range: %s (length %d)", i, len(elements))
+		}
+		return elements[i.Int64()]
+	case left.Type() == object.MAP_OBJ && index.Type() == object.STRING_OBJ:
+		key := index.(*object.String).Value
+		v, ok := left.(*object.Map).Pairs[key]
+		if !ok {
+			return newError("key not found: %s", key)
+		}
+		return v
+	}
+
+	return newError("index operator not supported: %s[%s]", left.Type(), index.Type())
+}
+
 func evalPrefixExpression(exp *ast.PrefixExpression, env env.Environment) object.Object {
-	op, right := exp.Operator, Eval(exp.Right, env)
+	return EvalPrefix(exp.Operator, Eval(exp.Right, env))
+}
 
+// EvalPrefix applies the prefix operator op to the already evaluated right
+// operand.
+func EvalPrefix(op string, right object.Object) object.Object {
 	if isError(right) {
 		return right
 	}
@@ -108,104 +162,117 @@ func evalInfixExpression(exp *ast.InfixExpression, env env.Environment) object.O
 		return left
 	}
 
-	right := Eval(exp.Right, env)
+	return EvalInfix(exp.Operator, left, Eval(exp.Right, env))
+}
+
+// EvalInfix applies the infix operator op to the already evaluated left and
+// right operands.
+func EvalInfix(op string, left, right object.Object) object.Object {
+	if isError(left) {
+		return left
+	}
+
 	if isError(right) {
 		return right
 	}
 
 	switch {
 	// boolean operators
-	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && exp.Operator == "||":
+	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && op == "||":
 		return nativeBoolToBooleanObject(
 			left.(*object.Boolean).Value || right.(*object.Boolean).Value,
 		)
-	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && exp.Operator == "&&":
+	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && op == "&&":
 		return nativeBoolToBooleanObject(
 			left.(*object.Boolean).Value && right.(*object.Boolean).Value,
 		)
 
 	// boolean comparison operators
-	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && exp.Operator == "==":
+	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && op == "==":
 		return nativeBoolToBooleanObject(
 			left.(*object.Boolean).Value == right.(*object.Boolean).Value,
 		)
-	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && exp.Operator == "!=":
+	case left.Type() == object.BOOLEAN_OBJ && right.Type() == object.BOOLEAN_OBJ && op == "!=":
 		return nativeBoolToBooleanObject(
 			left.(*object.Boolean).Value != right.(*object.Boolean).Value,
 		)
 
 	// integer comparison operators
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "==":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "==":
 		return nativeBoolToBooleanObject(
 			left.(*object.Integer).Value.Cmp(right.(*object.Integer).Value) == 0,
 		)
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "!=":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "!=":
 		return nativeBoolToBooleanObject(
 			left.(*object.Integer).Value.Cmp(right.(*object.Integer).Value) != 0,
 		)
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == ">":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == ">":
 		return nativeBoolToBooleanObject(
 			left.(*object.Integer).Value.Cmp(right.(*object.Integer).Value) > 0,
 		)
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "<":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "<":
 		return nativeBoolToBooleanObject(
 			left.(*object.Integer).Value.Cmp(right.(*object.Integer).Value) < 0,
 		)
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == ">=":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == ">=":
 		return nativeBoolToBooleanObject(
 			left.(*object.Integer).Value.Cmp(right.(*object.Integer).Value) >= 0,
 		)
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "<=":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "<=":
 		return nativeBoolToBooleanObject(
 			left.(*object.Integer).Value.Cmp(right.(*object.Integer).Value) <= 0,
 		)
 
-	// string comparisons operators
-	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && exp.Operator == "==":
+	// string comparisons operators, the strings are compared as decimal
+	// numbers
+	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && op == "==":
 		return nativeBoolToBooleanObject(
 			left.(*object.String).Value == right.(*object.String).Value,
 		)
-	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && exp.Operator == "!=":
+	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && op == "!=":
 		return nativeBoolToBooleanObject(
 			left.(*object.String).Value != right.(*object.String).Value,
 		)
-	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && exp.Operator == ">":
-		sign, err := cmpBigInt(left, right)
+	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && op == ">":
+		sign, err := cmpDecimal(left, right)
 		if err != nil {
 			return err
 		}
 		return nativeBoolToBooleanObject(sign > 0)
-	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && exp.Operator == "<":
-		sign, err := cmpBigInt(left, right)
+	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && op == "<":
+		sign, err := cmpDecimal(left, right)
 		if err != nil {
 			return err
 		}
 		return nativeBoolToBooleanObject(sign < 0)
-	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && exp.Operator == ">=":
-		sign, err := cmpBigInt(left, right)
+	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && op == ">=":
+		sign, err := cmpDecimal(left, right)
 		if err != nil {
 			return err
 		}
 		return nativeBoolToBooleanObject(sign >= 0)
-	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && exp.Operator == "<=":
-		sign, err := cmpBigInt(left, right)
+	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ && op == "<=":
+		sign, err := cmpDecimal(left, right)
 		if err != nil {
 			return err
 		}
 		return nativeBoolToBooleanObject(sign <= 0)
 
 	// arithmetic operators
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "+":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "+":
 		return &object.Integer{Value: new(big.Int).Add(left.(*object.Integer).Value, right.(*object.Integer).Value)}
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "-":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "-":
 		return &object.Integer{Value: new(big.Int).Sub(left.(*object.Integer).Value, right.(*object.Integer).Value)}
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "*":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "*":
 		return &object.Integer{Value: new(big.Int).Mul(left.(*object.Integer).Value, right.(*object.Integer).Value)}
-	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && exp.Operator == "/":
+	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ && op == "/":
+		if right.(*object.Integer).Value.Sign() == 0 {
+			return newError("division by zero")
+		}
 		return &object.Integer{Value: new(big.Int).Div(left.(*object.Integer).Value, right.(*object.Integer).Value)}
 	}
 
-	return newError("unknown operator: %s %s %s", left.Type(), exp.Operator, right.Type())
+	return newError("unknown operator: %s %s %s", left.Type(), op, right.Type())
 }
 
 func isError(obj object.Object) bool {
@@ -218,27 +285,3 @@ func nativeBoolToBooleanObject(input bool) *object.Boolean {
 	}
 	return object.FALSE
 }
-
-func parseBigInt(s string) (*big.Int, *object.Error) {
-	bigInt := new(big.Int)
-	bigInt, ok := bigInt.SetString(s, 10)
-	if !ok {
-		return nil, newError("invalid string number: %s", s)
-	}
-	return bigInt, nil
-}
-
-func cmpBigInt(left, right object.Object) (int, *object.Error) {
-	l, errL := parseBigInt(left.(*object.String).Value)
-	if errL != nil {
-		return 0, errL
-	}
-
-	r, errR := parseBigInt(right.(*object.String).Value)
-	if errR != nil {
-		return 0, errR
-	}
-
-	z := new(big.Int)
-	return z.Sub(l, r).Sign(), nil
-}
@@ -61,6 +61,11 @@ func TestEvalBooleanExpression(t *testing.T) {
 		{`"10" >= "999"`, false},
 		{`"999" <= "999"`, true},
 		{`"999" <= "10"`, false},
+		{`"1.5" > "1.25"`, true},
+		{`"0.10" == "0.1"`, false},
+		{`"0.10" >= "0.1"`, true},
+		{`"-2" < "1"`, true},
+		{`"100000000000000000000000000000.000001" > "100000000000000000000000000000"`, true},
 		{"2 + 2 == 2 * 2", true},
 		{"20 / 2 > 3 + 7", false},
 		{"-1 > 2", false},
@@ -104,6 +109,36 @@ func TestArithmeticInteger(t *testing.T) {
 	}
 }
 
+func TestEvalIndexExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{}`, "{}"},
+		{`{b: 1, a: {c: "x"}}`, `{"a": {"c": "x"}, "b": 1}`},
+		{`{to: "warden1a", value: 100}.value`, "100"},
+		{`{"max value": 5}["max value"] * 2`, "10"},
+		{`{tx: {to: "warden1a"}}.tx.to == "warden1a"`, "true"},
+		{`[1, 2, 3][1]`, "2"},
+		{`[[1, 2], [3]][0][1 + 0]`, "2"},
+		{`[{a: true}, {a: false}][1].a`, "false"},
+		{`{a: warden1a}.a`, "true"},
+		{`[1, 2][2]`, "ERROR: index out of range: 2 (length 2)"},
+		{`[1, 2][-1]`, "ERROR: index out of range: -1 (length 2)"},
+		{`{a: 1}.b`, "ERROR: key not found: b"},
+		{`{a: 1}[0]`, "ERROR: index operator not supported: MAP[INTEGER]"},
+		{`"abc"[0]`, "ERROR: index operator not supported: STRING[INTEGER]"},
+		{`unknown[0]`, "ERROR: identifier not found: unknown"},
+		{`{a: 1} == {a: 1}`, "ERROR: unknown operator: MAP == MAP"},
+		{`1 / 0`, "ERROR: division by zero"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input, map[string]bool{"warden1a": true})
+		require.Equal(t, tt.expected, evaluated.Inspect(), tt.input)
+	}
+}
+
 func TestNilEnv(t *testing.T) {
 	input := "testNilEnv"
 	l := lexer.New(input)
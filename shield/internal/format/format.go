@@ -0,0 +1,152 @@
+// Package format prints Shield expressions in their canonical form.
+//
+// The canonical form uses a single space around infix operators, ", " to
+// separate arguments and array elements, and only the parentheses required
+// by the precedence of the operators.
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/token"
+)
+
+const (
+	precLowest = iota
+	precOr
+	precAnd
+	precEquals
+	precCompare
+	precSum
+	precProduct
+	precPrefix
+	precIndex
+)
+
+var infixPrecedences = map[string]int{
+	"||": precOr,
+	"&&": precAnd,
+	"==": precEquals,
+	"!=": precEquals,
+	">":  precCompare,
+	">=": precCompare,
+	"<":  precCompare,
+	"<=": precCompare,
+	"+":  precSum,
+	"-":  precSum,
+	"*":  precProduct,
+	"/":  precProduct,
+}
+
+// Format returns the canonical text of exp.
+func Format(exp *ast.Expression) string {
+	var sb strings.Builder
+	formatExpression(&sb, exp, precLowest, false)
+	return sb.String()
+}
+
+// formatExpression writes exp to sb. parent is the precedence of the
+// enclosing operator and right is true if exp is its right operand: infix
+// operators are left associative.
+func formatExpression(sb *strings.Builder, exp *ast.Expression, parent int, right bool) {
+	switch n := exp.Value.(type) {
+	case *ast.Expression_Identifier:
+		sb.WriteString(n.Identifier.Value)
+	case *ast.Expression_IntegerLiteral:
+		sb.WriteString(n.IntegerLiteral.Value)
+	case *ast.Expression_BooleanLiteral:
+		fmt.Fprintf(sb, "%t", n.BooleanLiteral.Value)
+	case *ast.Expression_StringLiteral:
+		fmt.Fprintf(sb, "\"%s\"", n.StringLiteral.Value)
+	case *ast.Expression_ArrayLiteral:
+		sb.WriteString("[")
+		formatList(sb, n.ArrayLiteral.Elements)
+		sb.WriteString("]")
+	case *ast.Expression_ObjectLiteral:
+		sb.WriteString("{")
+		for i, e := range n.ObjectLiteral.Entries {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if isSimpleKey(e.Key) {
+				sb.WriteString(e.Key)
+			} else {
+				fmt.Fprintf(sb, "\"%s\"", e.Key)
+			}
+			sb.WriteString(": ")
+			formatExpression(sb, e.Value, precLowest, false)
+		}
+		sb.WriteString("}")
+	case *ast.Expression_IndexExpression:
+		left := n.IndexExpression.Left
+		formatExpression(sb, left, precIndex, false)
+		// a dot after an identifier would be read as part of the identifier
+		_, isIdent := ast.UnwrapIdentifier(left)
+		if key, ok := ast.UnwrapStringLiteral(n.IndexExpression.Index); ok && isSimpleKey(key.Value) && !isIdent {
+			sb.WriteString(".")
+			sb.WriteString(key.Value)
+		} else {
+			sb.WriteString("[")
+			formatExpression(sb, n.IndexExpression.Index, precLowest, false)
+			sb.WriteString("]")
+		}
+	case *ast.Expression_CallExpression:
+		sb.WriteString(n.CallExpression.Function.Value)
+		sb.WriteString("(")
+		formatList(sb, n.CallExpression.Arguments)
+		sb.WriteString(")")
+	case *ast.Expression_PrefixExpression:
+		parens := precPrefix < parent
+		if parens {
+			sb.WriteString("(")
+		}
+		sb.WriteString(n.PrefixExpression.Operator)
+		formatExpression(sb, n.PrefixExpression.Right, precPrefix, true)
+		if parens {
+			sb.WriteString(")")
+		}
+	case *ast.Expression_InfixExpression:
+		prec := infixPrecedences[n.InfixExpression.Operator]
+		parens := prec < parent || (prec == parent && right)
+		if parens {
+			sb.WriteString("(")
+		}
+		formatExpression(sb, n.InfixExpression.Left, prec, false)
+		fmt.Fprintf(sb, " %s ", n.InfixExpression.Operator)
+		formatExpression(sb, n.InfixExpression.Right, prec, true)
+		if parens {
+			sb.WriteString(")")
+		}
+	default:
+		sb.WriteString(ast.Stringify(exp))
+	}
+}
+
+// isSimpleKey returns true if key can be written as an identifier, i.e.
+// without quotes in object literals and after a dot in member expressions.
+func isSimpleKey(key string) bool {
+	if key == "" || token.LookupIdent(key) != token.Type_IDENT {
+		return false
+	}
+
+	for i, ch := range key {
+		isLetter := 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+		isDigit := '0' <= ch && ch <= '9'
+		if !isLetter && !(isDigit && i > 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatList(sb *strings.Builder, exps []*ast.Expression) {
+	for i, e := range exps {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		formatExpression(sb, e, precLowest, false)
+	}
+}
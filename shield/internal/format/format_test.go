@@ -0,0 +1,48 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/lexer"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/parser"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a", "a"},
+		{`"foo"`, `"foo"`},
+		{"(a && b) || c", "a && b || c"},
+		{"a && (b || c)", "a && (b || c)"},
+		{"(1 - 2) - 3", "1 - 2 - 3"},
+		{"1 - (2 - 3)", "1 - (2 - 3)"},
+		{"1 + 2 * 3", "1 + 2 * 3"},
+		{"(1 + 2) * 3", "(1 + 2) * 3"},
+		{"-(1 + 2)", "-(1 + 2)"},
+		{"- 5 * 2", "-5 * 2"},
+		{"any( 2,[a ,b,c] )&&all([])", "any(2, [a, b, c]) && all([])"},
+		{"weighted(3, [[a, 2], [b, (1)]])", "weighted(3, [[a, 2], [b, 1]])"},
+		{`{ "to":a,"max value" : 1+1, "true": {}}`, `{to: a, "max value": 1 + 1, "true": {}}`},
+		{`x[0] .to.value`, `x[0].to.value`},
+		{`(x).to`, `x["to"]`},
+		{`f(x)["a b"]["c"]`, `f(x)["a b"].c`},
+		{`(-x)[0] + -x[0]`, `(-x)[0] + -x[0]`},
+		{`(a || b)[0]`, `(a || b)[0]`},
+	}
+
+	for _, tt := range tests {
+		p := parser.New(lexer.New(tt.input))
+		exp := p.Parse()
+		require.Empty(t, p.Errors())
+
+		formatted := Format(exp)
+		require.Equal(t, tt.expected, formatted, tt.input)
+
+		// formatting is idempotent
+		p = parser.New(lexer.New(formatted))
+		require.Equal(t, formatted, Format(p.Parse()))
+	}
+}
@@ -9,6 +9,7 @@ type Lexer struct {
 	position     int  // current position in input (points to current char)
 	readPosition int  // current reading position in input (after current char)
 	ch           byte // current char under examination
+	tokenStart   int  // position in input of the last token returned
 }
 
 func New(input string) *Lexer {
@@ -21,6 +22,7 @@ func (l *Lexer) NextToken() token.Token {
 	var tok token.Token
 
 	l.skipWhitespace()
+	l.tokenStart = l.position
 
 	switch l.ch {
 	case '(':
@@ -33,6 +35,15 @@ func (l *Lexer) NextToken() token.Token {
 		tok = newToken(token.Type_LBRACKET, l.ch)
 	case ']':
 		tok = newToken(token.Type_RBRACKET, l.ch)
+	case '{':
+		tok = newToken(token.Type_LBRACE, l.ch)
+	case '}':
+		tok = newToken(token.Type_RBRACE, l.ch)
+	case ':':
+		tok = newToken(token.Type_COLON, l.ch)
+	case '.':
+		// dots following a letter are part of the identifier
+		tok = newToken(token.Type_DOT, l.ch)
 	case ';':
 		tok = newToken(token.Type_SEMICOLON, l.ch)
 	case '&':
@@ -115,6 +126,18 @@ func (l *Lexer) NextToken() token.Token {
 	return tok
 }
 
+// TokenStart returns the byte offset in the input of the last token returned
+// by NextToken.
+func (l *Lexer) TokenStart() int {
+	return l.tokenStart
+}
+
+// Offset returns the current byte offset in the input, i.e. the end of the
+// last token returned by NextToken.
+func (l *Lexer) Offset() int {
+	return min(l.position, len(l.input))
+}
+
 func (l *Lexer) readChar() {
 	if l.readPosition >= len(l.input) {
 		l.ch = 0
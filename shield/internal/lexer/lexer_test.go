@@ -8,7 +8,7 @@ import (
 )
 
 func TestNextToken(t *testing.T) {
-	input := `any(2, [warden123, wardenXXX]) true false && || 1 > 1 < 1 >= 1 <= 1 == 1 != 1 "some string""" + - * /;`
+	input := `any(2, [warden123, wardenXXX]) true false && || 1 > 1 < 1 >= 1 <= 1 == 1 != 1 "some string""" + - * /; {key: tx.to}[0].value;`
 
 	tests := []struct {
 		expectedType    token.Type
@@ -48,6 +48,17 @@ func TestNextToken(t *testing.T) {
 		{token.Type_MUL, "*"},
 		{token.Type_DIV, "/"},
 		{token.Type_SEMICOLON, ";"},
+		{token.Type_LBRACE, "{"},
+		{token.Type_IDENT, "key"},
+		{token.Type_COLON, ":"},
+		{token.Type_IDENT, "tx.to"},
+		{token.Type_RBRACE, "}"},
+		{token.Type_LBRACKET, "["},
+		{token.Type_INT, "0"},
+		{token.Type_RBRACKET, "]"},
+		{token.Type_DOT, "."},
+		{token.Type_IDENT, "value"},
+		{token.Type_SEMICOLON, ";"},
 		{token.Type_EOF, ""},
 	}
 
@@ -73,3 +84,33 @@ func TestUnterminatedString(t *testing.T) {
 	tok := l.NextToken()
 	require.Equal(t, token.Token{Type: token.Type_ILLEGAL, Literal: `"`}, tok)
 }
+
+func TestTokenOffsets(t *testing.T) {
+	input := `any(1, [warden1a,  "foo"])`
+
+	tests := []struct {
+		expectedType  token.Type
+		expectedStart int
+		expectedEnd   int
+	}{
+		{token.Type_IDENT, 0, 3},
+		{token.Type_LPAREN, 3, 4},
+		{token.Type_INT, 4, 5},
+		{token.Type_COMMA, 5, 6},
+		{token.Type_LBRACKET, 7, 8},
+		{token.Type_IDENT, 8, 16},
+		{token.Type_COMMA, 16, 17},
+		{token.Type_STRING, 19, 24},
+		{token.Type_RBRACKET, 24, 25},
+		{token.Type_RPAREN, 25, 26},
+		{token.Type_EOF, 26, 26},
+	}
+
+	l := New(input)
+	for _, tt := range tests {
+		tok := l.NextToken()
+		require.Equal(t, tt.expectedType, tok.Type)
+		require.Equal(t, tt.expectedStart, l.TokenStart(), tok.Literal)
+		require.Equal(t, tt.expectedEnd, l.Offset(), tok.Literal)
+	}
+}
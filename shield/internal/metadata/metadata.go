@@ -47,5 +47,12 @@ func processNode(node *ast.Expression, metadata *Metadata) {
 		for _, e := range n.ArrayLiteral.Elements {
 			processNode(e, metadata)
 		}
+	case *ast.Expression_ObjectLiteral:
+		for _, e := range n.ObjectLiteral.Entries {
+			processNode(e.Value, metadata)
+		}
+	case *ast.Expression_IndexExpression:
+		processNode(n.IndexExpression.Left, metadata)
+		processNode(n.IndexExpression.Index, metadata)
 	}
 }
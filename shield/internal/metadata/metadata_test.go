@@ -80,6 +80,16 @@ func TestExtractMetadata(t *testing.T) {
 			identifiers: []string{"foo"},
 			functions:   nil,
 		},
+		{
+			code:        "weighted(3, [[foo, 2], [bar, 1]])",
+			identifiers: []string{"foo", "bar"},
+			functions:   []string{"weighted"},
+		},
+		{
+			code:        "{to: foo, value: bar(baz)}[qux].value",
+			identifiers: []string{"foo", "baz", "qux"},
+			functions:   []string{"bar"},
+		},
 	}
 
 	for _, tt := range tests {
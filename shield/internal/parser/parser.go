@@ -3,6 +3,7 @@ package parser
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/warden-protocol/wardenprotocol/shield/ast"
 	"github.com/warden-protocol/wardenprotocol/shield/internal/lexer"
@@ -20,22 +21,25 @@ const (
 	MUL_DIV
 	PREFIX
 	CALL
+	INDEX
 )
 
 var precedences = map[token.Type]int{
-	token.Type_OR:     OR,
-	token.Type_AND:    AND,
-	token.Type_EQ:     EQ,
-	token.Type_NEQ:    EQ,
-	token.Type_GT:     LT_GT,
-	token.Type_GTE:    LT_GT,
-	token.Type_LT:     LT_GT,
-	token.Type_LTE:    LT_GT,
-	token.Type_ADD:    ADD_SUB,
-	token.Type_SUB:    ADD_SUB,
-	token.Type_MUL:    MUL_DIV,
-	token.Type_DIV:    MUL_DIV,
-	token.Type_LPAREN: CALL,
+	token.Type_OR:       OR,
+	token.Type_AND:      AND,
+	token.Type_EQ:       EQ,
+	token.Type_NEQ:      EQ,
+	token.Type_GT:       LT_GT,
+	token.Type_GTE:      LT_GT,
+	token.Type_LT:       LT_GT,
+	token.Type_LTE:      LT_GT,
+	token.Type_ADD:      ADD_SUB,
+	token.Type_SUB:      ADD_SUB,
+	token.Type_MUL:      MUL_DIV,
+	token.Type_DIV:      MUL_DIV,
+	token.Type_LPAREN:   CALL,
+	token.Type_LBRACKET: INDEX,
+	token.Type_DOT:      INDEX,
 }
 
 type (
@@ -43,15 +47,26 @@ type (
 	infixParseFn  func(*ast.Expression) *ast.Expression
 )
 
+// Error is a syntax error found while parsing.
+type Error struct {
+	Message string
+
+	// Offset is the byte offset in the input of the token that caused the
+	// error.
+	Offset int
+}
+
 type Parser struct {
 	l *lexer.Lexer
 
-	curToken  token.Token
-	peekToken token.Token
+	curToken   token.Token
+	curOffset  int
+	peekToken  token.Token
+	peekOffset int
 
 	prefixParseFns map[token.Type]prefixParseFn
 	infixParseFns  map[token.Type]infixParseFn
-	errors         []string
+	errors         []Error
 }
 
 func New(l *lexer.Lexer) *Parser {
@@ -67,6 +82,7 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerPrefix(token.Type_TRUE, p.parseBooleanLiteral)
 	p.registerPrefix(token.Type_FALSE, p.parseBooleanLiteral)
 	p.registerPrefix(token.Type_LBRACKET, p.parseArrayLiteral)
+	p.registerPrefix(token.Type_LBRACE, p.parseObjectLiteral)
 	p.registerPrefix(token.Type_LPAREN, p.parseGroupedExpression)
 	p.registerPrefix(token.Type_SUB, p.parsePrefixExpression)
 
@@ -83,6 +99,8 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerInfix(token.Type_MUL, p.parseInfixExpression)
 	p.registerInfix(token.Type_DIV, p.parseInfixExpression)
 	p.registerInfix(token.Type_LPAREN, p.parseCallExpression)
+	p.registerInfix(token.Type_LBRACKET, p.parseIndexExpression)
+	p.registerInfix(token.Type_DOT, p.parseMemberExpression)
 
 	// Read two tokens, so curToken and peekToken are both set
 	p.nextToken()
@@ -93,7 +111,9 @@ func New(l *lexer.Lexer) *Parser {
 
 func (p *Parser) nextToken() {
 	p.curToken = p.peekToken
+	p.curOffset = p.peekOffset
 	p.peekToken = p.l.NextToken()
+	p.peekOffset = p.l.TokenStart()
 }
 
 func (p *Parser) registerPrefix(tokenType token.Type, fn prefixParseFn) {
@@ -111,7 +131,7 @@ func (p *Parser) Parse() *ast.Expression {
 func (p *Parser) parseExpression(precedence int) *ast.Expression {
 	prefix := p.prefixParseFns[p.curToken.Type]
 	if prefix == nil {
-		p.errors = append(p.errors, fmt.Sprintf("no prefix parse function for %s found", p.curToken.Type))
+		p.addError(p.curOffset, "no prefix parse function for %s found", p.curToken.Type)
 		return nil
 	}
 	leftExp := prefix()
@@ -140,8 +160,7 @@ func (p *Parser) parseIdentifier() *ast.Expression {
 func (p *Parser) parseIntegerLiteral() *ast.Expression {
 	v, success := new(big.Int).SetString(p.curToken.Literal, 10)
 	if !success {
-		msg := "could not parse %q as integer"
-		p.errors = append(p.errors, fmt.Sprintf(msg, p.curToken.Literal))
+		p.addError(p.curOffset, "could not parse %q as integer", p.curToken.Literal)
 		return nil
 	}
 	return ast.NewIntegerLiteral(&ast.IntegerLiteral{
@@ -157,7 +176,7 @@ func (p *Parser) parseBooleanLiteral() *ast.Expression {
 	case token.Type_FALSE:
 		return ast.NewBooleanLiteral(&ast.BooleanLiteral{Token: p.curToken, Value: false})
 	default:
-		p.errors = append(p.errors, "expected true or false")
+		p.addError(p.curOffset, "expected true or false")
 		return nil
 	}
 }
@@ -175,6 +194,69 @@ func (p *Parser) parseArrayLiteral() *ast.Expression {
 	return ast.NewArrayLiteral(al)
 }
 
+func (p *Parser) parseObjectLiteral() *ast.Expression {
+	obj := &ast.ObjectLiteral{Token: p.curToken}
+
+	if p.peekTokenIs(token.Type_RBRACE) {
+		p.nextToken()
+		return ast.NewObjectLiteral(obj)
+	}
+
+	seen := make(map[string]bool)
+	for {
+		p.nextToken()
+		keyOffset := p.curOffset
+		entry := p.parseObjectEntry()
+		if entry == nil {
+			return nil
+		}
+
+		if seen[entry.Key] {
+			p.addError(keyOffset, "duplicate key %q in object literal", entry.Key)
+			return nil
+		}
+		seen[entry.Key] = true
+		obj.Entries = append(obj.Entries, entry)
+
+		if !p.peekTokenIs(token.Type_COMMA) {
+			break
+		}
+		p.nextToken()
+	}
+
+	if !p.expectPeek(token.Type_RBRACE) {
+		return nil
+	}
+
+	return ast.NewObjectLiteral(obj)
+}
+
+// parseObjectEntry parses a `key: value` pair, the key is either an
+// identifier without dots or a string.
+func (p *Parser) parseObjectEntry() *ast.ObjectEntry {
+	key := p.curToken.Literal
+
+	switch {
+	case p.curTokenIs(token.Type_STRING):
+	case p.curTokenIs(token.Type_IDENT) && !strings.Contains(key, "."):
+	default:
+		p.addError(p.curOffset, "expected object key, got %s instead", p.curToken.Type)
+		return nil
+	}
+
+	if !p.expectPeek(token.Type_COLON) {
+		return nil
+	}
+
+	p.nextToken()
+	value := p.parseExpression(LOWEST)
+	if value == nil {
+		return nil
+	}
+
+	return &ast.ObjectEntry{Key: key, Value: value}
+}
+
 func (p *Parser) parseGroupedExpression() *ast.Expression {
 	p.nextToken()
 
@@ -214,7 +296,7 @@ func (p *Parser) parseInfixExpression(left *ast.Expression) *ast.Expression {
 func (p *Parser) parseCallExpression(function *ast.Expression) *ast.Expression {
 	ident, ok := ast.UnwrapIdentifier(function)
 	if !ok {
-		p.errors = append(p.errors, "expected identifier")
+		p.addError(p.curOffset, "expected identifier")
 		return nil
 	}
 
@@ -223,6 +305,47 @@ func (p *Parser) parseCallExpression(function *ast.Expression) *ast.Expression {
 	return ast.NewCallExpression(exp)
 }
 
+func (p *Parser) parseIndexExpression(left *ast.Expression) *ast.Expression {
+	exp := &ast.IndexExpression{Token: p.curToken, Left: left}
+
+	p.nextToken()
+	exp.Index = p.parseExpression(LOWEST)
+	if !p.expectPeek(token.Type_RBRACKET) {
+		return nil
+	}
+
+	return ast.NewIndexExpression(exp)
+}
+
+// parseMemberExpression parses `left.field` as `left["field"]`.
+//
+// The lexer includes the dots in the identifiers, so `left.a.b` results in
+// the single identifier `a.b` after the first dot.
+func (p *Parser) parseMemberExpression(left *ast.Expression) *ast.Expression {
+	dot := p.curToken
+	if !p.expectPeek(token.Type_IDENT) {
+		return nil
+	}
+
+	for _, field := range strings.Split(p.curToken.Literal, ".") {
+		if field == "" {
+			p.addError(p.curOffset, "invalid member name %q", p.curToken.Literal)
+			return nil
+		}
+
+		left = ast.NewIndexExpression(&ast.IndexExpression{
+			Token: dot,
+			Left:  left,
+			Index: ast.NewStringLiteral(&ast.StringLiteral{
+				Token: token.Token{Type: token.Type_STRING, Literal: field},
+				Value: field,
+			}),
+		})
+	}
+
+	return left
+}
+
 func (p *Parser) parseExpressionList(end token.Type) []*ast.Expression {
 	args := []*ast.Expression{}
 
@@ -247,6 +370,10 @@ func (p *Parser) parseExpressionList(end token.Type) []*ast.Expression {
 	return args
 }
 
+func (p *Parser) curTokenIs(t token.Type) bool {
+	return p.curToken.Type == t
+}
+
 func (p *Parser) peekTokenIs(t token.Type) bool {
 	return p.peekToken.Type == t
 }
@@ -275,10 +402,30 @@ func (p *Parser) expectPeek(t token.Type) bool {
 }
 
 func (p *Parser) peekError(t token.Type) {
-	msg := "expected next token to be %s, got %s instead"
-	p.errors = append(p.errors, fmt.Sprintf(msg, t, p.peekToken.Type))
+	p.addError(p.peekOffset, "expected next token to be %s, got %s instead", t, p.peekToken.Type)
+}
+
+func (p *Parser) addError(offset int, format string, a ...any) {
+	p.errors = append(p.errors, Error{
+		Message: fmt.Sprintf(format, a...),
+		Offset:  offset,
+	})
 }
 
 func (p *Parser) Errors() []string {
+	if len(p.errors) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(p.errors))
+	for _, e := range p.errors {
+		msgs = append(msgs, e.Message)
+	}
+	return msgs
+}
+
+// ErrorDetails returns the errors found while parsing, together with their
+// position in the input.
+func (p *Parser) ErrorDetails() []Error {
 	return p.errors
 }
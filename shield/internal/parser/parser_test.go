@@ -242,6 +242,38 @@ func TestParser(t *testing.T) {
 			"-2 < 0 == true",
 			"(((-2) < 0) == true)",
 		},
+		{
+			`{}`,
+			"{}",
+		},
+		{
+			`{to: warden1a, "max value": 1 + 2, nested: {a: [1]}}`,
+			`{"to": warden1a, "max value": (1 + 2), "nested": {"a": [1]}}`,
+		},
+		{
+			`x[0]`,
+			"(x[0])",
+		},
+		{
+			`x[0][1 + 1]`,
+			"((x[0])[(1 + 1)])",
+		},
+		{
+			`tx.to`,
+			"tx.to",
+		},
+		{
+			`x[0].to.amount > 1`,
+			`((((x[0])["to"])["amount"]) > 1)`,
+		},
+		{
+			`f(x).y`,
+			`(f(x)["y"])`,
+		},
+		{
+			`-{a: 1}.a`,
+			`(-({"a": 1}["a"]))`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -254,3 +286,24 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorDetails(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []Error
+	}{
+		{"any(1, [a, b)", []Error{{Message: "expected next token to be RBRACKET, got RPAREN instead", Offset: 12}}},
+		{"a &&\n  )", []Error{{Message: "no prefix parse function for RPAREN found", Offset: 7}}},
+		{"a && b", nil},
+		{"{a: 1, a: 2}", []Error{{Message: `duplicate key "a" in object literal`, Offset: 7}}},
+		{"{a.b: 1}", []Error{{Message: "expected object key, got IDENT instead", Offset: 1}}},
+		{"{a 1}", []Error{{Message: "expected next token to be COLON, got INT instead", Offset: 3}}},
+		{"x[0].", []Error{{Message: "expected next token to be IDENT, got EOF instead", Offset: 5}}},
+	}
+
+	for _, tt := range tests {
+		p := New(lexer.New(tt.input))
+		p.Parse()
+		require.Equal(t, tt.expected, p.ErrorDetails(), tt.input)
+	}
+}
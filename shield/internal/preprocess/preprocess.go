@@ -12,6 +12,10 @@ func Preprocess(ctx context.Context, node *ast.Expression, expander ast.Expander
 		return expander.Expand(ctx, n.Identifier)
 	case *ast.Expression_ArrayLiteral:
 		return node, preprocessElements(ctx, n.ArrayLiteral.Elements, expander)
+	case *ast.Expression_ObjectLiteral:
+		return node, preprocessObjectLiteral(ctx, n.ObjectLiteral, expander)
+	case *ast.Expression_IndexExpression:
+		return node, preprocessIndexExpression(ctx, n.IndexExpression, expander)
 	case *ast.Expression_CallExpression:
 		return node, preprocessCallExpression(ctx, n.CallExpression, expander)
 	case *ast.Expression_PrefixExpression:
@@ -34,6 +38,28 @@ func preprocessElements(ctx context.Context, elements []*ast.Expression, expande
 	return nil
 }
 
+func preprocessObjectLiteral(ctx context.Context, obj *ast.ObjectLiteral, expander ast.Expander) error {
+	for _, e := range obj.Entries {
+		var err error
+		e.Value, err = Preprocess(ctx, e.Value, expander)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func preprocessIndexExpression(ctx context.Context, index *ast.IndexExpression, expander ast.Expander) error {
+	var err error
+	index.Left, err = Preprocess(ctx, index.Left, expander)
+	if err != nil {
+		return err
+	}
+
+	index.Index, err = Preprocess(ctx, index.Index, expander)
+	return err
+}
+
 func preprocessPrefixExpression(ctx context.Context, prefix *ast.PrefixExpression, expander ast.Expander) error {
 	var err error
 	prefix.Right, err = Preprocess(ctx, prefix.Right, expander)
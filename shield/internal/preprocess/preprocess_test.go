@@ -129,3 +129,25 @@ func TestPreprocessCallExpression(t *testing.T) {
 	require.Equal(t, len(call2.Arguments), 1)
 	require.Equal(t, call2.Arguments[0].GetIntegerLiteral().Value, big.NewInt(int64(235)).String())
 }
+
+type integerExpander struct{}
+
+func (integerExpander) Expand(ctx context.Context, ident *ast.Identifier) (*ast.Expression, error) {
+	return ast.NewIntegerLiteral(&ast.IntegerLiteral{Value: "42"}), nil
+}
+
+func TestPreprocessObjectAndIndexExpression(t *testing.T) {
+	ctx := context.Background()
+	expression := parseExpression(t, "{a: foo, b: [bar]}[baz]")
+
+	proc, err := Preprocess(ctx, expression, integerExpander{})
+	require.NoError(t, err)
+
+	index := proc.GetIndexExpression()
+	require.Equal(t, "42", index.Index.GetIntegerLiteral().Value)
+
+	obj := index.Left.GetObjectLiteral()
+	require.Len(t, obj.Entries, 2)
+	require.Equal(t, "42", obj.Entries[0].Value.GetIntegerLiteral().Value)
+	require.Equal(t, "42", obj.Entries[1].Value.GetArrayLiteral().Elements[0].GetIntegerLiteral().Value)
+}
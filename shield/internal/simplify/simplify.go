@@ -0,0 +1,275 @@
+// Package simplify rewrites Shield expressions into smaller expressions that
+// evaluate to the same result.
+package simplify
+
+import (
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/evaluator"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/format"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+	"github.com/warden-protocol/wardenprotocol/shield/token"
+)
+
+// Simplify rewrites exp in place, bottom-up, and returns the new root:
+//
+//   - subexpressions without identifiers are folded into literals, if their
+//     evaluation succeeds (e.g. `1 + 2` becomes `3`, `{a: 1}.a` becomes `1`);
+//   - chains of `&&` and `||` are flattened (e.g. `a && (b && c)` becomes
+//     `a && b && c`), and their duplicated operands are removed together
+//     with the neutral ones (`true` for `&&`, `false` for `||`);
+//   - duplicated elements are removed from the arrays passed to `all`,
+//     `contains` and `any` with a threshold of 1, where they can't change
+//     the result.
+//
+// A neutral operand is kept if removing it would leave an operand that is
+// not known to evaluate to a boolean, e.g. `a && true` is left untouched
+// while `any(1, [a]) && true` becomes `any(1, [a])`.
+func Simplify(exp *ast.Expression) *ast.Expression {
+	if exp == nil {
+		return nil
+	}
+
+	switch n := exp.Value.(type) {
+	case *ast.Expression_ArrayLiteral:
+		simplifyList(n.ArrayLiteral.Elements)
+		return exp
+	case *ast.Expression_ObjectLiteral:
+		for _, e := range n.ObjectLiteral.Entries {
+			e.Value = Simplify(e.Value)
+		}
+		return exp
+	case *ast.Expression_IndexExpression:
+		n.IndexExpression.Left = Simplify(n.IndexExpression.Left)
+		n.IndexExpression.Index = Simplify(n.IndexExpression.Index)
+		return fold(exp)
+	case *ast.Expression_PrefixExpression:
+		n.PrefixExpression.Right = Simplify(n.PrefixExpression.Right)
+		return fold(exp)
+	case *ast.Expression_InfixExpression:
+		n.InfixExpression.Left = Simplify(n.InfixExpression.Left)
+		n.InfixExpression.Right = Simplify(n.InfixExpression.Right)
+		if folded := fold(exp); folded != exp {
+			return folded
+		}
+		if isLogical(n.InfixExpression.Operator) {
+			return simplifyChain(n.InfixExpression)
+		}
+		return exp
+	case *ast.Expression_CallExpression:
+		simplifyList(n.CallExpression.Arguments)
+		dedupCallArguments(n.CallExpression)
+		return fold(exp)
+	default:
+		return exp
+	}
+}
+
+func simplifyList(exps []*ast.Expression) {
+	for i, e := range exps {
+		exps[i] = Simplify(e)
+	}
+}
+
+// fold evaluates exp if it doesn't contain identifiers and returns the
+// resulting literal. If the evaluation fails, exp is returned unchanged.
+func fold(exp *ast.Expression) (result *ast.Expression) {
+	if hasIdentifiers(exp) {
+		return exp
+	}
+
+	defer func() {
+		// builtins don't validate all their arguments, leave the expression
+		// as is and let the evaluation fail later
+		if r := recover(); r != nil {
+			result = exp
+		}
+	}()
+
+	switch obj := evaluator.Eval(exp, nil).(type) {
+	case *object.Integer:
+		return ast.NewIntegerLiteral(&ast.IntegerLiteral{
+			Token: token.Token{Type: token.Type_INT, Literal: obj.Value.String()},
+			Value: obj.Value.String(),
+		})
+	case *object.Boolean:
+		return newBooleanLiteral(obj.Value)
+	case *object.String:
+		return ast.NewStringLiteral(&ast.StringLiteral{
+			Token: token.Token{Type: token.Type_STRING, Literal: obj.Value},
+			Value: obj.Value,
+		})
+	default:
+		return exp
+	}
+}
+
+// simplifyChain flattens a chain of the same logical operator and removes
+// duplicated and neutral operands.
+func simplifyChain(infix *ast.InfixExpression) *ast.Expression {
+	op := infix.Operator
+	neutral := op == "||"
+
+	var operands []*ast.Expression
+	collectChain(ast.NewInfixExpression(infix), op, &operands)
+
+	seen := make(map[string]bool, len(operands))
+	kept := make([]*ast.Expression, 0, len(operands))
+	for _, o := range operands {
+		if b, ok := ast.UnwrapBooleanLiteral(o); ok && b.Value == !neutral {
+			continue
+		}
+
+		key := format.Format(o)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		kept = append(kept, o)
+	}
+
+	switch {
+	case len(kept) == 0:
+		return newBooleanLiteral(!neutral)
+	case len(kept) == 1 && !isBoolean(kept[0]):
+		// keep the operation, so that non-boolean values still result in
+		// an error
+		kept = append(kept, newBooleanLiteral(!neutral))
+	}
+
+	root := kept[0]
+	for _, o := range kept[1:] {
+		root = ast.NewInfixExpression(&ast.InfixExpression{
+			Token:    infix.Token,
+			Operator: op,
+			Left:     root,
+			Right:    o,
+		})
+	}
+
+	return root
+}
+
+func collectChain(exp *ast.Expression, op string, operands *[]*ast.Expression) {
+	if infix, ok := ast.UnwrapInfixExpression(exp); ok && infix.Operator == op {
+		collectChain(infix.Left, op, operands)
+		collectChain(infix.Right, op, operands)
+		return
+	}
+
+	*operands = append(*operands, exp)
+}
+
+// dedupCallArguments removes the duplicated elements of the array literals
+// passed to the builtins for which duplicates don't change the result.
+func dedupCallArguments(call *ast.CallExpression) {
+	var array *ast.Expression
+
+	switch call.Function.Value {
+	case "all":
+		if len(call.Arguments) == 1 {
+			array = call.Arguments[0]
+		}
+	case "contains":
+		if len(call.Arguments) == 2 {
+			array = call.Arguments[1]
+		}
+	case "any":
+		// with a threshold of 1 the first true element determines the
+		// result, following duplicates are never reached
+		if len(call.Arguments) == 2 {
+			if threshold, ok := ast.UnwrapIntegerLiteral(call.Arguments[0]); ok && threshold.Value == "1" {
+				array = call.Arguments[1]
+			}
+		}
+	}
+
+	if array == nil {
+		return
+	}
+
+	lit, ok := ast.UnwrapArrayLiteral(array)
+	if !ok {
+		return
+	}
+
+	seen := make(map[string]bool, len(lit.Elements))
+	elements := make([]*ast.Expression, 0, len(lit.Elements))
+	for _, e := range lit.Elements {
+		key := format.Format(e)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		elements = append(elements, e)
+	}
+	lit.Elements = elements
+}
+
+// isBoolean returns true if exp is known to evaluate to a boolean (or to an
+// error).
+func isBoolean(exp *ast.Expression) bool {
+	switch n := exp.Value.(type) {
+	case *ast.Expression_BooleanLiteral:
+		return true
+	case *ast.Expression_InfixExpression:
+		switch n.InfixExpression.Operator {
+		case "&&", "||", "==", "!=", ">", ">=", "<", "<=":
+			return true
+		}
+	case *ast.Expression_CallExpression:
+		switch n.CallExpression.Function.Value {
+		case "any", "all", "contains", "weighted", "startsWith", "endsWith":
+			return true
+		}
+	}
+
+	return false
+}
+
+func isLogical(op string) bool {
+	return op == "&&" || op == "||"
+}
+
+func hasIdentifiers(exp *ast.Expression) bool {
+	switch n := exp.Value.(type) {
+	case *ast.Expression_Identifier:
+		return true
+	case *ast.Expression_ArrayLiteral:
+		return anyHasIdentifiers(n.ArrayLiteral.Elements)
+	case *ast.Expression_ObjectLiteral:
+		for _, e := range n.ObjectLiteral.Entries {
+			if hasIdentifiers(e.Value) {
+				return true
+			}
+		}
+		return false
+	case *ast.Expression_IndexExpression:
+		return hasIdentifiers(n.IndexExpression.Left) || hasIdentifiers(n.IndexExpression.Index)
+	case *ast.Expression_PrefixExpression:
+		return hasIdentifiers(n.PrefixExpression.Right)
+	case *ast.Expression_InfixExpression:
+		return hasIdentifiers(n.InfixExpression.Left) || hasIdentifiers(n.InfixExpression.Right)
+	case *ast.Expression_CallExpression:
+		return anyHasIdentifiers(n.CallExpression.Arguments)
+	default:
+		return false
+	}
+}
+
+func anyHasIdentifiers(exps []*ast.Expression) bool {
+	for _, e := range exps {
+		if hasIdentifiers(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func newBooleanLiteral(value bool) *ast.Expression {
+	tok := token.Token{Type: token.Type_FALSE, Literal: "false"}
+	if value {
+		tok = token.Token{Type: token.Type_TRUE, Literal: "true"}
+	}
+
+	return ast.NewBooleanLiteral(&ast.BooleanLiteral{Token: tok, Value: value})
+}
@@ -0,0 +1,84 @@
+package simplify
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/evaluator"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/format"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/lexer"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/parser"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+func TestSimplify(t *testing.T) {
+	env := object.NewEnvironment()
+	env.Set("warden1a", object.TRUE)
+	env.Set("warden1b", object.FALSE)
+	env.Set("warden1c", object.TRUE)
+	env.Set("block.height", &object.Integer{Value: big.NewInt(100)})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// constant folding
+		{"1 + 2 * 3", "7"},
+		{"-(2 - 5)", "3"},
+		{`"a" == "a"`, "true"},
+		{"block.height > 10 * 5", "block.height > 50"},
+		{"any(1, [true, false])", "true"},
+		{"1 && 2", "1 && 2"},
+		{"{a: {b: 2 + 3}}.a.b", "5"},
+		{"{a: 1 + 1, b: warden1a}", "{a: 2, b: warden1a}"},
+		{"{a: warden1a}.a && true", "{a: warden1a}.a && true"},
+		{"[1, 2][5]", "[1, 2][5]"},
+
+		// flattening
+		{"warden1a && (warden1b && warden1c)", "warden1a && warden1b && warden1c"},
+		{"(warden1a || warden1b) || (warden1c || warden1a)", "warden1a || warden1b || warden1c"},
+		{"warden1a && (warden1b || warden1c)", "warden1a && (warden1b || warden1c)"},
+
+		// neutral and duplicated operands
+		{"any(1, [warden1a]) && true", "any(1, [warden1a])"},
+		{"false || all([warden1a]) || false", "all([warden1a])"},
+		{"warden1a && true", "warden1a && true"},
+		{"warden1a && warden1a", "warden1a && true"},
+		{"true && (1 == 1)", "true"},
+		{"block.height > 1 && true && block.height > 1", "block.height > 1"},
+		{"warden1a && false", "warden1a && false"},
+		{"true || warden1a", "true || warden1a"},
+
+		// duplicated array elements
+		{"any(1, [warden1a, warden1b, warden1a])", "any(1, [warden1a, warden1b])"},
+		{"any(2, [warden1a, warden1b, warden1a])", "any(2, [warden1a, warden1b, warden1a])"},
+		{"all([warden1a, warden1a])", "all([warden1a])"},
+		{"contains(warden1a, [warden1b, warden1b])", "contains(warden1a, [warden1b])"},
+		{"weighted(1, [[warden1a, 1], [warden1a, 1]])", "weighted(1, [[warden1a, 1], [warden1a, 1]])"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			p := parser.New(lexer.New(tt.input))
+			exp := p.Parse()
+			require.Empty(t, p.Errors())
+
+			original := proto.Clone(exp).(*ast.Expression)
+			simplified := Simplify(exp)
+			require.Equal(t, tt.expected, format.Format(simplified))
+
+			// simplification doesn't change the result
+			require.Equal(t, evaluator.Eval(original, env).Inspect(), evaluator.Eval(simplified, env).Inspect())
+
+			// simplification is idempotent
+			require.Equal(t, tt.expected, format.Format(Simplify(simplified)))
+		})
+	}
+}
+
+func TestSimplifyNil(t *testing.T) {
+	require.Nil(t, Simplify(nil))
+}
@@ -0,0 +1,182 @@
+// Package vm implements a stack based virtual machine that executes the
+// bytecode produced by the compiler package.
+//
+// The VM shares the semantics of the operators and of the builtin functions
+// with the tree-walking evaluator, running a compiled expression always gives
+// the same result as evaluating its AST.
+package vm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/warden-protocol/wardenprotocol/shield/env"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/code"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/evaluator"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+// StackSize is the maximum number of values on the stack.
+const StackSize = 2048
+
+type VM struct {
+	constants    []object.Object
+	names        []string
+	instructions code.Instructions
+
+	stack []object.Object
+}
+
+func New(p *code.Program) (*VM, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	constants := make([]object.Object, len(p.Constants))
+	names := make([]string, len(p.Constants))
+	for i, c := range p.Constants {
+		switch c.Kind {
+		case code.ConstantInteger:
+			v, ok := new(big.Int).SetString(c.Value, 10)
+			if !ok {
+				return nil, fmt.Errorf("invalid integer constant: %s", c.Value)
+			}
+			constants[i] = &object.Integer{Value: v}
+		case code.ConstantString:
+			constants[i] = &object.String{Value: c.Value}
+			names[i] = c.Value
+		default:
+			return nil, fmt.Errorf("unknown constant kind: %d", c.Kind)
+		}
+	}
+
+	return &VM{
+		constants:    constants,
+		names:        names,
+		instructions: p.Instructions,
+	}, nil
+}
+
+// Run executes the program using env to resolve identifiers and returns the
+// result. In case of a runtime error, the resulting object will be an error
+// object.
+func (vm *VM) Run(env env.Environment) object.Object {
+	vm.stack = vm.stack[:0]
+	ins := vm.instructions
+
+	for ip := 0; ip < len(ins); ip++ {
+		op := code.Opcode(ins[ip])
+
+		var err error
+		switch op {
+		case code.OpConstant:
+			idx := code.ReadUint16(ins[ip+1:])
+			ip += 2
+			err = vm.push(vm.constants[idx])
+		case code.OpTrue:
+			err = vm.push(object.TRUE)
+		case code.OpFalse:
+			err = vm.push(object.FALSE)
+		case code.OpIdentifier:
+			idx := code.ReadUint16(ins[ip+1:])
+			ip += 2
+			err = vm.push(evaluator.EvalIdentifier(vm.names[idx], env))
+		case code.OpArray:
+			n := int(code.ReadUint16(ins[ip+1:]))
+			ip += 2
+			var elements []object.Object
+			elements, err = vm.popN(n)
+			if err == nil {
+				err = vm.push(&object.Array{Elements: elements})
+			}
+		case code.OpObject:
+			n := int(code.ReadUint16(ins[ip+1:]))
+			ip += 2
+			var pairs []object.Object
+			pairs, err = vm.popN(2 * n)
+			if err == nil {
+				err = vm.pushMap(pairs)
+			}
+		case code.OpIndex:
+			var operands []object.Object
+			operands, err = vm.popN(2)
+			if err == nil {
+				err = vm.push(evaluator.EvalIndex(operands[0], operands[1]))
+			}
+		case code.OpCall:
+			n := int(code.ReadUint16(ins[ip+1:]))
+			ip += 2
+			var args []object.Object
+			args, err = vm.popN(n + 1)
+			if err == nil {
+				err = vm.push(evaluator.ApplyFunction(args[0], args[1:]))
+			}
+		case code.OpNeg:
+			var right []object.Object
+			right, err = vm.popN(1)
+			if err == nil {
+				err = vm.push(evaluator.EvalPrefix("-", right[0]))
+			}
+		default:
+			operator, ok := code.InfixOperator(op)
+			if !ok {
+				return newError("unknown opcode: %d", op)
+			}
+			var operands []object.Object
+			operands, err = vm.popN(2)
+			if err == nil {
+				err = vm.push(evaluator.EvalInfix(operator, operands[0], operands[1]))
+			}
+		}
+
+		if err != nil {
+			return newError("%s", err)
+		}
+	}
+
+	if len(vm.stack) != 1 {
+		return newError("invalid bytecode: %d values left on the stack", len(vm.stack))
+	}
+
+	return vm.stack[0]
+}
+
+func (vm *VM) push(o object.Object) error {
+	if len(vm.stack) >= StackSize {
+		return fmt.Errorf("stack overflow")
+	}
+
+	vm.stack = append(vm.stack, o)
+	return nil
+}
+
+// pushMap pushes a map built from a list of alternating keys and values.
+func (vm *VM) pushMap(pairs []object.Object) error {
+	m := &object.Map{Pairs: make(map[string]object.Object, len(pairs)/2)}
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(*object.String)
+		if !ok {
+			return fmt.Errorf("invalid map key: %s", pairs[i].Type())
+		}
+		m.Pairs[key.Value] = pairs[i+1]
+	}
+
+	return vm.push(m)
+}
+
+// popN pops the top n values and returns them in the order they were pushed.
+func (vm *VM) popN(n int) ([]object.Object, error) {
+	if n > len(vm.stack) {
+		return nil, fmt.Errorf("stack underflow")
+	}
+
+	sp := len(vm.stack) - n
+	values := make([]object.Object, n)
+	copy(values, vm.stack[sp:])
+	vm.stack = vm.stack[:sp]
+	return values, nil
+}
+
+func newError(format string, a ...interface{}) *object.Error {
+	return &object.Error{Message: fmt.Sprintf(format, a...)}
+}
@@ -0,0 +1,197 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/code"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/compiler"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/evaluator"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/lexer"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/parser"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+func TestRun(t *testing.T) {
+	env := object.NewEnvironment()
+	env.Set("warden1a", object.TRUE)
+	env.Set("warden1b", object.FALSE)
+	env.Set("warden1c", object.TRUE)
+	env.Set("block.height", &object.Integer{Value: big.NewInt(100)})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"5", "5"},
+		{"18446744073709551616", "18446744073709551616"},
+		{"-5 + 10 * 2", "15"},
+		{"(1 + 2) * 3 / 2", "4"},
+		{`"foo"`, `"foo"`},
+		{`"10" > "9"`, "true"},
+		{"true || true && false", "true"},
+		{"warden1a && warden1b", "false"},
+		{"warden1a || warden1b", "true"},
+		{"any(2, [warden1a, warden1b, warden1c])", "true"},
+		{"all([warden1a, warden1b])", "false"},
+		{"any(1, [warden1a]) && any(1, [warden1c])", "true"},
+		{"weighted(3, [[warden1a, 2], [warden1b, 5], [warden1c, 1]])", "true"},
+		{`contains("foo", ["bar", "foo"])`, "true"},
+		{"block.height >= 100 && warden1a", "true"},
+		{"warden1unknown", "ERROR: identifier not found: warden1unknown"},
+		{"warden1unknown || warden1a", "ERROR: identifier not found: warden1unknown"},
+		{"foo(1)", "ERROR: not a function: ERROR"},
+		{"-true", "ERROR: unknown operator: -BOOLEAN"},
+		{"1 + true", "ERROR: unknown operator: INTEGER + BOOLEAN"},
+		{"any(1)", "ERROR: wrong number of arguments. got=1, want=2"},
+		{`{to: "warden1a", value: block.height}`, `{"to": "warden1a", "value": 100}`},
+		{`{tx: {value: 5 * 2}}.tx.value > 9`, "true"},
+		{`[warden1b, warden1c][1] && {"a b": warden1a}["a b"]`, "true"},
+		{`[1][1]`, "ERROR: index out of range: 1 (length 1)"},
+		{`{a: 1}.b`, "ERROR: key not found: b"},
+		{`warden1unknown.a`, "ERROR: identifier not found: warden1unknown.a"},
+		{`(warden1unknown)[{}]`, "ERROR: identifier not found: warden1unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			exp := parse(t, tt.input)
+
+			result := run(t, exp, env)
+			require.Equal(t, tt.expected, result.Inspect())
+
+			// the VM must behave exactly like the tree-walking evaluator
+			require.Equal(t, evaluator.Eval(exp, env).Inspect(), result.Inspect())
+		})
+	}
+}
+
+func TestRunNilEnvironment(t *testing.T) {
+	result := run(t, parse(t, "warden1a"), nil)
+	require.Equal(t, "ERROR: unknown identifier 'warden1a': passed environment is nil", result.Inspect())
+}
+
+func TestRunInvalidProgram(t *testing.T) {
+	tests := []struct {
+		name    string
+		program *code.Program
+	}{
+		{"empty", &code.Program{}},
+		{"stack underflow", &code.Program{Instructions: code.Make(code.OpAnd)}},
+		{"values left on the stack", &code.Program{Instructions: append(code.Make(code.OpTrue), code.Make(code.OpTrue)...)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine, err := New(tt.program)
+			require.NoError(t, err)
+			require.Equal(t, object.ERROR_OBJ, string(machine.Run(nil).Type()))
+		})
+	}
+
+	_, err := New(&code.Program{Instructions: code.Make(code.OpConstant, 0)})
+	require.Error(t, err)
+}
+
+func run(t *testing.T, exp *ast.Expression, env *object.Environment) object.Object {
+	program, err := compiler.Compile(exp)
+	require.NoError(t, err)
+
+	machine, err := New(program)
+	require.NoError(t, err)
+
+	if env == nil {
+		return machine.Run(nil)
+	}
+	return machine.Run(env)
+}
+
+func parse(t testing.TB, input string) *ast.Expression {
+	p := parser.New(lexer.New(input))
+	exp := p.Parse()
+	require.Empty(t, p.Errors())
+	return exp
+}
+
+const benchmarkInput = `any(2, [warden1a, warden1b, warden1c, warden1d, warden1e]) && ` +
+	`weighted(60, [[warden1a, 50], [warden1b, 25], [warden1c, 25]]) && ` +
+	`(block.height > 1000 || all([warden1a, warden1c]))`
+
+func benchmarkEnv() *object.Environment {
+	env := object.NewEnvironment()
+	env.Set("warden1a", object.TRUE)
+	env.Set("warden1b", object.FALSE)
+	env.Set("warden1c", object.TRUE)
+	env.Set("warden1d", object.FALSE)
+	env.Set("warden1e", object.TRUE)
+	env.Set("block.height", &object.Integer{Value: big.NewInt(500)})
+	return env
+}
+
+// BenchmarkEval measures the tree-walking evaluator.
+func BenchmarkEval(b *testing.B) {
+	exp := parse(b, benchmarkInput)
+	env := benchmarkEnv()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		evaluator.Eval(exp, env)
+	}
+}
+
+// BenchmarkRun measures the VM running an already decoded program.
+func BenchmarkRun(b *testing.B) {
+	program, err := compiler.Compile(parse(b, benchmarkInput))
+	require.NoError(b, err)
+	machine, err := New(program)
+	require.NoError(b, err)
+	env := benchmarkEnv()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		machine.Run(env)
+	}
+}
+
+// BenchmarkDecodeAndRun measures the VM including the decoding of the
+// bytecode, i.e. evaluating the bytecode stored alongside an Action.
+func BenchmarkDecodeAndRun(b *testing.B) {
+	program, err := compiler.Compile(parse(b, benchmarkInput))
+	require.NoError(b, err)
+	bz, err := program.MarshalBinary()
+	require.NoError(b, err)
+	env := benchmarkEnv()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var p code.Program
+		if err := p.UnmarshalBinary(bz); err != nil {
+			b.Fatal(err)
+		}
+		machine, err := New(&p)
+		if err != nil {
+			b.Fatal(err)
+		}
+		machine.Run(env)
+	}
+}
+
+// BenchmarkUnmarshalAndEval measures the tree-walking evaluator including
+// the decoding of the protobuf AST, i.e. evaluating the AST stored alongside
+// an Action.
+func BenchmarkUnmarshalAndEval(b *testing.B) {
+	bz, err := parse(b, benchmarkInput).Marshal()
+	require.NoError(b, err)
+	env := benchmarkEnv()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var exp ast.Expression
+		if err := exp.Unmarshal(bz); err != nil {
+			b.Fatal(err)
+		}
+		evaluator.Eval(&exp, env)
+	}
+}
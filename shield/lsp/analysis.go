@@ -0,0 +1,252 @@
+package lsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/evaluator"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/format"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/lexer"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/metadata"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/parser"
+	"github.com/warden-protocol/wardenprotocol/shield/token"
+)
+
+const diagnosticSource = "shield"
+
+// tokenSpan is a token together with its position in the document.
+type tokenSpan struct {
+	token.Token
+	Start int
+	End   int
+}
+
+func tokenize(text string) []tokenSpan {
+	l := lexer.New(text)
+
+	var tokens []tokenSpan
+	for {
+		tok := l.NextToken()
+		tokens = append(tokens, tokenSpan{Token: tok, Start: l.TokenStart(), End: l.Offset()})
+		if tok.Type == token.Type_EOF {
+			return tokens
+		}
+	}
+}
+
+// document is an open text document and the results of its analysis.
+type document struct {
+	text   string
+	tokens []tokenSpan
+	root   *ast.Expression
+	errors []parser.Error
+}
+
+func newDocument(text string) *document {
+	p := parser.New(lexer.New(text))
+	root := p.Parse()
+
+	return &document{
+		text:   text,
+		tokens: tokenize(text),
+		root:   root,
+		errors: p.ErrorDetails(),
+	}
+}
+
+// Diagnostics returns the syntax errors of the document and, if there are
+// none, the calls to unknown functions.
+func (d *document) Diagnostics() []Diagnostic {
+	diagnostics := []Diagnostic{}
+
+	for _, tok := range d.tokens {
+		if tok.Type == token.Type_ILLEGAL {
+			diagnostics = append(diagnostics, d.newDiagnostic(tok.Start, tok.End, SeverityError, fmt.Sprintf("illegal token %q", tok.Literal)))
+		}
+	}
+
+	for _, e := range d.errors {
+		tok := d.tokenAt(e.Offset)
+		diagnostics = append(diagnostics, d.newDiagnostic(tok.Start, tok.End, SeverityError, e.Message))
+	}
+
+	if len(diagnostics) > 0 || d.root == nil {
+		return diagnostics
+	}
+
+	for i, tok := range d.tokens[:len(d.tokens)-1] {
+		if tok.Type != token.Type_IDENT || d.tokens[i+1].Type != token.Type_LPAREN {
+			continue
+		}
+
+		if _, ok := functions[tok.Literal]; !ok && !evaluator.IsBuiltin(tok.Literal) {
+			diagnostics = append(diagnostics, d.newDiagnostic(tok.Start, tok.End, SeverityError, fmt.Sprintf("unknown function %q", tok.Literal)))
+		}
+	}
+
+	return diagnostics
+}
+
+// Hover returns the documentation of the function or identifier at offset.
+func (d *document) Hover(offset int) *Hover {
+	tok := d.tokenAt(offset)
+	if tok.Type != token.Type_IDENT {
+		return nil
+	}
+
+	doc, ok := functions[tok.Literal]
+	if !ok {
+		doc, ok = lookupIdentifier(tok.Literal)
+	}
+	if !ok {
+		return nil
+	}
+
+	r := d.newRange(tok.Start, tok.End)
+	return &Hover{
+		Contents: MarkupContent{
+			Kind:  "markdown",
+			Value: fmt.Sprintf("```\n%s\n```\n%s", doc.Signature, doc.Description),
+		},
+		Range: &r,
+	}
+}
+
+// Completion returns the functions, the well-known identifiers and the
+// identifiers already used in the document.
+func (d *document) Completion() CompletionList {
+	items := make([]CompletionItem, 0, len(functions)+len(identifiers))
+
+	for _, name := range sortedKeys(functions) {
+		doc := functions[name]
+		items = append(items, CompletionItem{
+			Label:            name,
+			Kind:             CompletionKindFunction,
+			Detail:           doc.Signature,
+			Documentation:    doc.Description,
+			InsertText:       doc.Snippet,
+			InsertTextFormat: InsertTextFormatSnippet,
+		})
+	}
+
+	for _, doc := range identifiers {
+		items = append(items, CompletionItem{
+			Label:            doc.Signature,
+			Kind:             CompletionKindVariable,
+			Documentation:    doc.Description,
+			InsertText:       doc.Snippet,
+			InsertTextFormat: InsertTextFormatSnippet,
+		})
+	}
+
+	if d.root != nil {
+		seen := make(map[string]bool)
+		for _, ident := range metadata.ExtractMetadata(d.root).Identifiers {
+			if _, known := lookupIdentifier(ident); known || seen[ident] {
+				continue
+			}
+			seen[ident] = true
+			items = append(items, CompletionItem{
+				Label: ident,
+				Kind:  CompletionKindVariable,
+			})
+		}
+	}
+
+	return CompletionList{Items: items}
+}
+
+// Format returns the edits that replace the document with its formatted
+// version. Documents with syntax errors are not formatted.
+func (d *document) Format() []TextEdit {
+	if len(d.errors) > 0 || d.root == nil {
+		return []TextEdit{}
+	}
+
+	formatted := format.Format(d.root)
+	if strings.HasSuffix(d.text, "\n") {
+		formatted += "\n"
+	}
+
+	if formatted == d.text {
+		return []TextEdit{}
+	}
+
+	return []TextEdit{{
+		Range:   d.newRange(0, len(d.text)),
+		NewText: formatted,
+	}}
+}
+
+// tokenAt returns the token containing offset, or the closest token
+// following it.
+func (d *document) tokenAt(offset int) tokenSpan {
+	for _, tok := range d.tokens {
+		if offset < tok.End || tok.Type == token.Type_EOF {
+			return tok
+		}
+	}
+	return d.tokens[len(d.tokens)-1]
+}
+
+func (d *document) newDiagnostic(start, end int, severity DiagnosticSeverity, msg string) Diagnostic {
+	return Diagnostic{
+		Range:    d.newRange(start, end),
+		Severity: severity,
+		Source:   diagnosticSource,
+		Message:  msg,
+	}
+}
+
+func (d *document) newRange(start, end int) Range {
+	return Range{
+		Start: offsetToPosition(d.text, start),
+		End:   offsetToPosition(d.text, end),
+	}
+}
+
+// offsetToPosition converts a byte offset into a line and a character
+// offset, counted in UTF-16 code units as required by the protocol.
+func offsetToPosition(text string, offset int) Position {
+	offset = min(offset, len(text))
+	lineStart := strings.LastIndexByte(text[:offset], '\n') + 1
+
+	return Position{
+		Line:      strings.Count(text[:lineStart], "\n"),
+		Character: len(utf16.Encode([]rune(text[lineStart:offset]))),
+	}
+}
+
+// positionToOffset converts a Position into a byte offset.
+func positionToOffset(text string, pos Position) int {
+	offset := 0
+	for line := 0; line < pos.Line; line++ {
+		i := strings.IndexByte(text[offset:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		offset += i + 1
+	}
+
+	units := 0
+	for i, r := range text[offset:] {
+		if units >= pos.Character || r == '\n' {
+			return offset + i
+		}
+		units += len(utf16.Encode([]rune{r}))
+	}
+
+	return len(text)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,28 @@
+package lsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionConversion(t *testing.T) {
+	text := "a &&\n\"é😀\" == b\n"
+
+	tests := []struct {
+		offset   int
+		position Position
+	}{
+		{0, Position{Line: 0, Character: 0}},
+		{4, Position{Line: 0, Character: 4}},
+		{5, Position{Line: 1, Character: 0}},
+		{8, Position{Line: 1, Character: 2}},
+		{12, Position{Line: 1, Character: 4}},
+		{len(text), Position{Line: 2, Character: 0}},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.position, offsetToPosition(text, tt.offset), tt.offset)
+		require.Equal(t, tt.offset, positionToOffset(text, tt.position), tt.offset)
+	}
+}
@@ -0,0 +1,153 @@
+package lsp
+
+import "strings"
+
+// symbolDoc documents a function or an identifier.
+type symbolDoc struct {
+	Signature   string
+	Description string
+	// Snippet is inserted on completion, using the snippet syntax.
+	Snippet string
+}
+
+// functions documents the builtin functions, plus the functions expanded by
+// x/act before evaluation.
+var functions = map[string]symbolDoc{
+	"any": {
+		Signature:   "any(threshold, [vote, ...]) bool",
+		Description: "Returns true if at least `threshold` elements of the array are true.",
+		Snippet:     "any(${1:threshold}, [${2}])",
+	},
+	"all": {
+		Signature:   "all([vote, ...]) bool",
+		Description: "Returns true if all the elements of the array are true.",
+		Snippet:     "all([${1}])",
+	},
+	"contains": {
+		Signature:   "contains(item, [item, ...]) bool",
+		Description: "Returns true if the array contains `item`. Items can be integers, booleans or strings.",
+		Snippet:     "contains(${1:item}, [${2}])",
+	},
+	"weighted": {
+		Signature:   "weighted(threshold, [[vote, weight], ...]) bool",
+		Description: "Returns true if the sum of the weights of the true votes is at least `threshold`.",
+		Snippet:     "weighted(${1:threshold}, [[${2:vote}, ${3:weight}]])",
+	},
+	"len": {
+		Signature:   "len(value) int",
+		Description: "Returns the length of a string (in bytes), of an array or of an object.",
+		Snippet:     "len(${1:value})",
+	},
+	"lower": {
+		Signature:   "lower(s) string",
+		Description: "Returns `s` with the ASCII letters mapped to lower case.",
+		Snippet:     "lower(${1:s})",
+	},
+	"upper": {
+		Signature:   "upper(s) string",
+		Description: "Returns `s` with the ASCII letters mapped to upper case.",
+		Snippet:     "upper(${1:s})",
+	},
+	"startsWith": {
+		Signature:   "startsWith(s, prefix) bool",
+		Description: "Returns true if `s` begins with `prefix`.",
+		Snippet:     "startsWith(${1:s}, ${2:prefix})",
+	},
+	"endsWith": {
+		Signature:   "endsWith(s, suffix) bool",
+		Description: "Returns true if `s` ends with `suffix`.",
+		Snippet:     "endsWith(${1:s}, ${2:suffix})",
+	},
+	"hex": {
+		Signature:   "hex(s) string",
+		Description: "Returns the lowercase hex encoding of the bytes of `s`.",
+		Snippet:     "hex(${1:s})",
+	},
+	"bytes": {
+		Signature:   "bytes(hex) [int, ...]",
+		Description: "Decodes a hex string, with an optional `0x` prefix, into an array of bytes.",
+		Snippet:     "bytes(${1:hex})",
+	},
+	"min": {
+		Signature:   "min([int, ...]) int",
+		Description: "Returns the smallest integer of a non-empty array.",
+		Snippet:     "min([${1}])",
+	},
+	"max": {
+		Signature:   "max([int, ...]) int",
+		Description: "Returns the largest integer of a non-empty array.",
+		Snippet:     "max([${1}])",
+	},
+	"sum": {
+		Signature:   "sum([int, ...]) int",
+		Description: "Returns the sum of the integers of an array, 0 if empty.",
+		Snippet:     "sum([${1}])",
+	},
+	"units": {
+		Signature:   "units(amount, decimals) int",
+		Description: "Converts a decimal amount, such as `\"1.5\"`, to an integer amount of base units. Fails if `amount` has more than `decimals` decimals.",
+		Snippet:     "units(${1:amount}, ${2:decimals})",
+	},
+	"amountOf": {
+		Signature:   "amountOf(coins, denom) int",
+		Description: "Returns the amount of `denom` in a list of coins such as `\"100uward,5uatom\"` or `[\"100uward\", \"5uatom\"]`, 0 if not present.",
+		Snippet:     "amountOf(${1:coins}, ${2:denom})",
+	},
+	"template": {
+		Signature:   "template(id)",
+		Description: "Includes the expression of the Template with the given id. Expanded when an Action is created.",
+		Snippet:     "template(${1:id})",
+	},
+}
+
+// identifiers documents the identifiers that are expanded or resolved by
+// the chain. Identifiers whose signature ends with a `<placeholder>` match
+// any identifier with the same prefix.
+var identifiers = []symbolDoc{
+	{
+		Signature:   "warden.space.owners",
+		Description: "The owners of the Space the message refers to, as an array of addresses.",
+		Snippet:     "warden.space.owners",
+	},
+	{
+		Signature:   "warden.spaces.<id>.<field>",
+		Description: "The `owners` of the Space with the given id, or one of its Rules (`approve_admin`, `reject_admin`, `approve_sign`, `reject_sign`), expanded in the context of that Space.",
+		Snippet:     "warden.spaces.${1:id}.${2:owners}",
+	},
+	{
+		Signature:   "warden.analyzers.<contract>.<key>",
+		Description: "A value returned by the analyzer `contract` for the message. The fields of objects can be selected by appending `.<field>`.",
+		Snippet:     "warden.analyzers.${1:contract}.${2:key}",
+	},
+	{
+		Signature:   "msg.<field>",
+		Description: "A field of the message being approved.",
+		Snippet:     "msg.${1:field}",
+	},
+	{
+		Signature:   "block.height",
+		Description: "The height of the current block.",
+		Snippet:     "block.height",
+	},
+	{
+		Signature:   "block.time",
+		Description: "The time of the current block, in seconds since the Unix epoch.",
+		Snippet:     "block.time",
+	},
+	{
+		Signature:   "action.age",
+		Description: "The seconds elapsed since the Action was created.",
+		Snippet:     "action.age",
+	},
+}
+
+func lookupIdentifier(name string) (symbolDoc, bool) {
+	for _, doc := range identifiers {
+		prefix, _, isPattern := strings.Cut(doc.Signature, "<")
+		if name == doc.Signature || (isPattern && strings.HasPrefix(name, prefix) && len(name) > len(prefix)) {
+			return doc, true
+		}
+	}
+
+	return symbolDoc{}, false
+}
@@ -0,0 +1,203 @@
+// Package lsp implements a Language Server Protocol server for Shield
+// expressions, providing diagnostics, hover, completion and formatting.
+//
+// The server communicates over a pair of streams (usually stdin and stdout)
+// using the JSON-RPC 2.0 base protocol. Documents are synchronized in full.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const ServerName = "shield-lsp"
+
+// ErrExitWithoutShutdown is returned by Run when the client sends the exit
+// notification without a prior shutdown request.
+var ErrExitWithoutShutdown = errors.New("exit notification received before shutdown")
+
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	documents map[string]*document
+	shutdown  bool
+}
+
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		in:        bufio.NewReader(in),
+		out:       out,
+		documents: make(map[string]*document),
+	}
+}
+
+// Run serves requests until the client sends the exit notification or the
+// input is closed.
+func (s *Server) Run() error {
+	for {
+		content, err := readMessage(s.in)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var msg message
+		if err := json.Unmarshal(content, &msg); err != nil {
+			if err := s.replyError(nil, codeParseError, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return ErrExitWithoutShutdown
+			}
+			return nil
+		}
+
+		if err := s.handle(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg message) error {
+	if msg.ID == nil {
+		return s.handleNotification(msg)
+	}
+
+	if s.shutdown {
+		return s.replyError(msg.ID, codeInvalidRequest, "server is shutting down")
+	}
+
+	var (
+		result any
+		err    error
+	)
+
+	switch msg.Method {
+	case "initialize":
+		result = s.initialize()
+	case "shutdown":
+		s.shutdown = true
+	case "textDocument/hover":
+		result, err = s.withPosition(msg.Params, func(d *document, offset int) any {
+			if hover := d.Hover(offset); hover != nil {
+				return hover
+			}
+			return nil
+		})
+	case "textDocument/completion":
+		result, err = s.withPosition(msg.Params, func(d *document, _ int) any {
+			return d.Completion()
+		})
+	case "textDocument/formatting":
+		var params DocumentFormattingParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.replyError(msg.ID, codeInvalidParams, err.Error())
+		}
+		d, ok := s.documents[params.TextDocument.URI]
+		if !ok {
+			return s.replyError(msg.ID, codeInvalidParams, fmt.Sprintf("unknown document: %s", params.TextDocument.URI))
+		}
+		result = d.Format()
+	default:
+		return s.replyError(msg.ID, codeMethodNotFound, fmt.Sprintf("method not found: %s", msg.Method))
+	}
+
+	if err != nil {
+		return s.replyError(msg.ID, codeInvalidParams, err.Error())
+	}
+
+	return writeMessage(s.out, response{JSONRPC: "2.0", ID: msg.ID, Result: result})
+}
+
+func (s *Server) handleNotification(msg message) error {
+	switch msg.Method {
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		return s.update(params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil || len(params.ContentChanges) == 0 {
+			return nil
+		}
+		// full synchronization, the last change contains the whole document
+		return s.update(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil
+		}
+		delete(s.documents, params.TextDocument.URI)
+		return s.publishDiagnostics(params.TextDocument.URI, []Diagnostic{})
+	}
+
+	// other notifications (initialized, $/cancelRequest, ...) are ignored
+	return nil
+}
+
+func (s *Server) initialize() InitializeResult {
+	return InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: TextDocumentSyncFull,
+			HoverProvider:    true,
+			CompletionProvider: &CompletionOptions{
+				TriggerCharacters: []string{"."},
+			},
+			DocumentFormattingProvider: true,
+		},
+		ServerInfo: ServerInfo{Name: ServerName},
+	}
+}
+
+func (s *Server) update(uri, text string) error {
+	d := newDocument(text)
+	s.documents[uri] = d
+	return s.publishDiagnostics(uri, d.Diagnostics())
+}
+
+func (s *Server) publishDiagnostics(uri string, diagnostics []Diagnostic) error {
+	return writeMessage(s.out, notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: PublishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics,
+		},
+	})
+}
+
+func (s *Server) replyError(id *json.RawMessage, code int, msg string) error {
+	return writeMessage(s.out, errorResponse{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error:   responseError{Code: code, Message: msg},
+	})
+}
+
+// withPosition decodes TextDocumentPositionParams and calls fn with the
+// referenced document and the byte offset of the position.
+func (s *Server) withPosition(raw json.RawMessage, fn func(d *document, offset int) any) (any, error) {
+	var params TextDocumentPositionParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, err
+	}
+
+	d, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil, fmt.Errorf("unknown document: %s", params.TextDocument.URI)
+	}
+
+	return fn(d, positionToOffset(d.text, params.Position)), nil
+}
@@ -0,0 +1,169 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testURI = "file:///rule.shield"
+
+type testClient struct {
+	t     *testing.T
+	input bytes.Buffer
+	id    int
+}
+
+func (c *testClient) request(method string, params any) int {
+	c.id++
+	c.write(map[string]any{"jsonrpc": "2.0", "id": c.id, "method": method, "params": params})
+	return c.id
+}
+
+func (c *testClient) notify(method string, params any) {
+	c.write(map[string]any{"jsonrpc": "2.0", "method": method, "params": params})
+}
+
+func (c *testClient) write(v any) {
+	require.NoError(c.t, writeMessage(&c.input, v))
+}
+
+// run runs a server over the messages written so far and returns the
+// messages sent by the server.
+func (c *testClient) run() []map[string]json.RawMessage {
+	var output bytes.Buffer
+	require.NoError(c.t, NewServer(&c.input, &output).Run())
+
+	var messages []map[string]json.RawMessage
+	r := bufio.NewReader(&output)
+	for r.Buffered() > 0 || output.Len() > 0 {
+		content, err := readMessage(r)
+		require.NoError(c.t, err)
+
+		var msg map[string]json.RawMessage
+		require.NoError(c.t, json.Unmarshal(content, &msg))
+		messages = append(messages, msg)
+	}
+
+	return messages
+}
+
+func decode[T any](t *testing.T, raw json.RawMessage) T {
+	var v T
+	require.NoError(t, json.Unmarshal(raw, &v))
+	return v
+}
+
+func TestServer(t *testing.T) {
+	c := &testClient{t: t}
+	doc := TextDocumentIdentifier{URI: testURI}
+
+	c.request("initialize", map[string]any{})
+	c.notify("initialized", map[string]any{})
+	c.notify("textDocument/didOpen", DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: testURI, LanguageID: "shield", Version: 1, Text: "any(1, [warden1a, warden1b)"},
+	})
+	c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: testURI, Version: 2},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: "(any(1, [warden1a,warden1b]))  &&\n  foo(warden.space.owners)"}},
+	})
+	c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: testURI, Version: 3},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: "(any(1, [warden1a,warden1b]))  &&\n  all(warden.space.owners)\n"}},
+	})
+	hoverID := c.request("textDocument/hover", TextDocumentPositionParams{TextDocument: doc, Position: Position{Line: 0, Character: 2}})
+	hoverNoneID := c.request("textDocument/hover", TextDocumentPositionParams{TextDocument: doc, Position: Position{Line: 0, Character: 10}})
+	hoverIdentID := c.request("textDocument/hover", TextDocumentPositionParams{TextDocument: doc, Position: Position{Line: 1, Character: 10}})
+	completionID := c.request("textDocument/completion", TextDocumentPositionParams{TextDocument: doc, Position: Position{Line: 1, Character: 2}})
+	formattingID := c.request("textDocument/formatting", DocumentFormattingParams{TextDocument: doc})
+	unknownID := c.request("textDocument/definition", TextDocumentPositionParams{TextDocument: doc})
+	c.request("shutdown", nil)
+	c.notify("exit", nil)
+
+	messages := c.run()
+	require.Len(t, messages, 11)
+
+	// initialize
+	initResult := decode[InitializeResult](t, messages[0]["result"])
+	require.True(t, initResult.Capabilities.HoverProvider)
+	require.True(t, initResult.Capabilities.DocumentFormattingProvider)
+	require.Equal(t, TextDocumentSyncFull, initResult.Capabilities.TextDocumentSync)
+
+	// syntax error on open
+	diagnostics := decode[PublishDiagnosticsParams](t, messages[1]["params"])
+	require.Equal(t, testURI, diagnostics.URI)
+	require.Equal(t, []Diagnostic{{
+		Range:    Range{Start: Position{Line: 0, Character: 26}, End: Position{Line: 0, Character: 27}},
+		Severity: SeverityError,
+		Source:   diagnosticSource,
+		Message:  "expected next token to be RBRACKET, got RPAREN instead",
+	}}, diagnostics.Diagnostics)
+
+	// unknown function
+	diagnostics = decode[PublishDiagnosticsParams](t, messages[2]["params"])
+	require.Equal(t, []Diagnostic{{
+		Range:    Range{Start: Position{Line: 1, Character: 2}, End: Position{Line: 1, Character: 5}},
+		Severity: SeverityError,
+		Source:   diagnosticSource,
+		Message:  `unknown function "foo"`,
+	}}, diagnostics.Diagnostics)
+
+	// fixed
+	diagnostics = decode[PublishDiagnosticsParams](t, messages[3]["params"])
+	require.Empty(t, diagnostics.Diagnostics)
+	require.NotNil(t, diagnostics.Diagnostics)
+
+	// hover on a builtin
+	require.Equal(t, hoverID, decode[int](t, messages[4]["id"]))
+	hover := decode[Hover](t, messages[4]["result"])
+	require.Contains(t, hover.Contents.Value, "any(threshold, [vote, ...]) bool")
+	require.Equal(t, &Range{Start: Position{Line: 0, Character: 1}, End: Position{Line: 0, Character: 4}}, hover.Range)
+
+	// hover on an unknown identifier
+	require.Equal(t, hoverNoneID, decode[int](t, messages[5]["id"]))
+	require.Equal(t, json.RawMessage("null"), messages[5]["result"])
+
+	// hover on a well-known identifier
+	require.Equal(t, hoverIdentID, decode[int](t, messages[6]["id"]))
+	hover = decode[Hover](t, messages[6]["result"])
+	require.Contains(t, hover.Contents.Value, "warden.space.owners")
+
+	// completion
+	require.Equal(t, completionID, decode[int](t, messages[7]["id"]))
+	completion := decode[CompletionList](t, messages[7]["result"])
+	labels := make(map[string]CompletionItemKind)
+	for _, item := range completion.Items {
+		labels[item.Label] = item.Kind
+	}
+	require.Equal(t, CompletionKindFunction, labels["any"])
+	require.Equal(t, CompletionKindFunction, labels["weighted"])
+	require.Equal(t, CompletionKindVariable, labels["warden.space.owners"])
+	require.Equal(t, CompletionKindVariable, labels["warden.analyzers.<contract>.<key>"])
+	require.Equal(t, CompletionKindVariable, labels["warden1a"])
+
+	// formatting
+	require.Equal(t, formattingID, decode[int](t, messages[8]["id"]))
+	edits := decode[[]TextEdit](t, messages[8]["result"])
+	require.Equal(t, []TextEdit{{
+		Range:   Range{Start: Position{Line: 0, Character: 0}, End: Position{Line: 2, Character: 0}},
+		NewText: "any(1, [warden1a, warden1b]) && all(warden.space.owners)\n",
+	}}, edits)
+
+	// unknown method
+	require.Equal(t, unknownID, decode[int](t, messages[9]["id"]))
+	require.Equal(t, codeMethodNotFound, decode[responseError](t, messages[9]["error"]).Code)
+
+	// shutdown
+	require.Equal(t, json.RawMessage("null"), messages[10]["result"])
+}
+
+func TestServerExitWithoutShutdown(t *testing.T) {
+	c := &testClient{t: t}
+	c.notify("exit", nil)
+
+	var output bytes.Buffer
+	require.ErrorIs(t, NewServer(&c.input, &output).Run(), ErrExitWithoutShutdown)
+}
@@ -3,6 +3,8 @@ package object
 import (
 	"fmt"
 	"math/big"
+	"sort"
+	"strings"
 )
 
 type ObjectType string
@@ -12,6 +14,7 @@ const (
 	BOOLEAN_OBJ = "BOOLEAN"
 	STRING_OBJ  = "STRING"
 	ARRAY_OBJ   = "ARRAY"
+	MAP_OBJ     = "MAP"
 	ERROR_OBJ   = "ERROR"
 	BUILTIN_OBJ = "BUILTIN"
 )
@@ -52,11 +55,38 @@ type Array struct {
 }
 
 func (ao *Array) Inspect() string {
-	return fmt.Sprintf("%v", ao.Elements)
+	elements := make([]string, 0, len(ao.Elements))
+	for _, e := range ao.Elements {
+		elements = append(elements, e.Inspect())
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
 }
 
 func (ao *Array) Type() ObjectType { return ARRAY_OBJ }
 
+// Map is an object value, mapping keys to values.
+type Map struct {
+	Pairs map[string]Object
+}
+
+func (m *Map) Inspect() string {
+	keys := make([]string, 0, len(m.Pairs))
+	for k := range m.Pairs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("\"%s\": %s", k, m.Pairs[k].Inspect()))
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
+}
+
+func (m *Map) Type() ObjectType { return MAP_OBJ }
+
 type Error struct {
 	Message string
 }
@@ -4,13 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/warden-protocol/wardenprotocol/shield/ast"
 	"github.com/warden-protocol/wardenprotocol/shield/env"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/code"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/compiler"
 	"github.com/warden-protocol/wardenprotocol/shield/internal/evaluator"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/format"
 	"github.com/warden-protocol/wardenprotocol/shield/internal/lexer"
 	"github.com/warden-protocol/wardenprotocol/shield/internal/metadata"
 	"github.com/warden-protocol/wardenprotocol/shield/internal/parser"
 	"github.com/warden-protocol/wardenprotocol/shield/internal/preprocess"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/simplify"
+	"github.com/warden-protocol/wardenprotocol/shield/internal/vm"
 	"github.com/warden-protocol/wardenprotocol/shield/object"
 )
 
@@ -34,12 +41,58 @@ func Preprocess(ctx context.Context, root *ast.Expression, expander ast.Expander
 	return preprocess.Preprocess(ctx, root, expander)
 }
 
+// Format returns the canonical text of the AST. Parsing the result returns
+// an equivalent AST.
+func Format(root *ast.Expression) string {
+	return format.Format(root)
+}
+
+// Simplify returns a smaller AST that evaluates to the same result, by folding
+// constant subexpressions, flattening chains of `&&` and `||` and removing
+// duplicated operands. The input AST is not modified.
+func Simplify(root *ast.Expression) *ast.Expression {
+	if root == nil {
+		return nil
+	}
+
+	return simplify.Simplify(proto.Clone(root).(*ast.Expression))
+}
+
 // Eval evaluates the AST and returns the result of the evaluation.
 // In case of a runtime error, the resulting object will be an error object.
 func Eval(root *ast.Expression, env Environment) object.Object {
 	return evaluator.Eval(root, env)
 }
 
+// Compile compiles the AST into bytecode that can be evaluated with
+// EvalBytecode. The same AST always results in the same bytecode.
+func Compile(root *ast.Expression) ([]byte, error) {
+	program, err := compiler.Compile(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return program.MarshalBinary()
+}
+
+// EvalBytecode evaluates bytecode returned by Compile. The result is the same
+// as evaluating the AST with Eval.
+// In case of invalid bytecode or of a runtime error, the resulting object
+// will be an error object.
+func EvalBytecode(bytecode []byte, env Environment) object.Object {
+	var program code.Program
+	if err := program.UnmarshalBinary(bytecode); err != nil {
+		return &object.Error{Message: fmt.Sprintf("invalid bytecode: %v", err)}
+	}
+
+	machine, err := vm.New(&program)
+	if err != nil {
+		return &object.Error{Message: fmt.Sprintf("invalid bytecode: %v", err)}
+	}
+
+	return machine.Run(env)
+}
+
 type Metadata = metadata.Metadata
 
 // ExtractMetadata extracts metadata from the given expression.
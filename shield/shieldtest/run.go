@@ -0,0 +1,168 @@
+package shieldtest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+// Result is the outcome of a test case.
+type Result struct {
+	Name string
+	// Err is nil if the test case passed.
+	Err error
+}
+
+// Failure is returned when the result of a test case doesn't match the
+// expected one.
+type Failure struct {
+	// Expression is the preprocessed expression, in its canonical form.
+	Expression string
+	Expected   string
+	Actual     string
+}
+
+func (f *Failure) Error() string {
+	return fmt.Sprintf("unexpected result\n  expression: %s\n  --- expected\n  +++ actual\n  -%s\n  +%s", f.Expression, f.Expected, f.Actual)
+}
+
+// Run runs all the test cases of s.
+func (s *Spec) Run(ctx context.Context) []Result {
+	results := make([]Result, 0, len(s.Tests))
+	for _, c := range s.Tests {
+		results = append(results, Result{Name: c.Name, Err: s.RunCase(ctx, c)})
+	}
+	return results
+}
+
+// RunCase runs the test case c. The expression is parsed, preprocessed using
+// the expansions and evaluated both from its AST and, as x/act does, from
+// the bytecode of its simplified AST. The two results must match each other
+// and the expected result.
+func (s *Spec) RunCase(ctx context.Context, c Case) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("evaluation panicked: %v", r)
+		}
+	}()
+
+	expander, err := newExpander(s.Expand, c.Expand)
+	if err != nil {
+		return err
+	}
+
+	root, err := shield.Parse(s.Expression)
+	if err != nil {
+		return err
+	}
+
+	root, err = shield.Preprocess(ctx, root, expander)
+	if err != nil {
+		return fmt.Errorf("preprocessing: %w", err)
+	}
+
+	env := caseEnv{spec: s.Env, test: c.Env}
+	if c.Votes != nil {
+		env.votes = make(map[string]bool, len(*c.Votes))
+		for _, v := range *c.Votes {
+			env.votes[v] = true
+		}
+	}
+
+	result := shield.Eval(root, env)
+	if result == nil {
+		return fmt.Errorf("expression has no value")
+	}
+
+	bytecode, err := shield.Compile(shield.Simplify(root))
+	if err != nil {
+		return fmt.Errorf("compiling: %w", err)
+	}
+
+	if bcResult := shield.EvalBytecode(bytecode, env); bcResult.Inspect() != result.Inspect() {
+		return fmt.Errorf("AST and bytecode evaluations differ: %s != %s", result.Inspect(), bcResult.Inspect())
+	}
+
+	if c.ExpectError != "" {
+		if errObj, ok := result.(*object.Error); ok && strings.Contains(errObj.Message, c.ExpectError) {
+			return nil
+		}
+
+		return &Failure{
+			Expression: shield.Format(root),
+			Expected:   fmt.Sprintf("ERROR: ...%s...", c.ExpectError),
+			Actual:     result.Inspect(),
+		}
+	}
+
+	if expected := c.Expect.Object.Inspect(); expected != result.Inspect() {
+		return &Failure{
+			Expression: shield.Format(root),
+			Expected:   expected,
+			Actual:     result.Inspect(),
+		}
+	}
+
+	return nil
+}
+
+// caseEnv is the environment of a test case.
+type caseEnv struct {
+	spec  Env
+	test  Env
+	votes map[string]bool
+}
+
+func (e caseEnv) Get(name string) (object.Object, bool) {
+	if obj, ok := e.test.lookup(name); ok {
+		return obj, true
+	}
+
+	if obj, ok := e.spec.lookup(name); ok {
+		return obj, true
+	}
+
+	if e.votes == nil {
+		return nil, false
+	}
+
+	if e.votes[name] {
+		return object.TRUE, true
+	}
+	return object.FALSE, true
+}
+
+// expander expands identifiers into the expressions of a spec, the other
+// identifiers are left unchanged.
+type expander map[string]*ast.Expression
+
+// newExpander parses the expansions, the ones of overrides replace the ones
+// of base with the same name.
+func newExpander(base, overrides map[string]string) (expander, error) {
+	e := make(expander, len(base)+len(overrides))
+	for _, m := range []map[string]string{base, overrides} {
+		for name, input := range m {
+			exp, err := shield.Parse(input)
+			if err != nil {
+				return nil, fmt.Errorf("expansion of %s: %w", name, err)
+			}
+			e[name] = exp
+		}
+	}
+
+	return e, nil
+}
+
+func (e expander) Expand(_ context.Context, ident *ast.Identifier) (*ast.Expression, error) {
+	if exp, ok := e[ident.Value]; ok {
+		return proto.Clone(exp).(*ast.Expression), nil
+	}
+
+	return ast.NewIdentifier(ident), nil
+}
@@ -0,0 +1,121 @@
+package shieldtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSpecs(t *testing.T) {
+	for _, path := range []string{"testdata/treasury.yaml", "testdata/limits.json"} {
+		spec, err := Load(path)
+		require.NoError(t, err)
+
+		for _, r := range spec.Run(context.Background()) {
+			require.NoError(t, r.Err, "%s: %s", path, r.Name)
+		}
+	}
+}
+
+func TestRunFailures(t *testing.T) {
+	spec, err := Load("testdata/failing.yaml")
+	require.NoError(t, err)
+
+	results := spec.Run(context.Background())
+	require.Len(t, results, 3)
+
+	var failure *Failure
+	require.ErrorAs(t, results[0].Err, &failure)
+	require.Equal(t, &Failure{
+		Expression: `[len(owners), owners]`,
+		Expected:   `[2, ["alice"]]`,
+		Actual:     `[2, ["alice", "bob"]]`,
+	}, failure)
+
+	require.ErrorAs(t, results[1].Err, &failure)
+	require.Equal(t, `[2, ["alice", "bob"]]`, failure.Actual)
+
+	require.NoError(t, results[2].Err)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		err  string
+	}{
+		{
+			name: "valid",
+			spec: "expression: a\ntests:\n  - name: t\n    expect: 0x10",
+		},
+		{
+			name: "missing expression",
+			spec: "tests:\n  - name: t\n    expect: true",
+			err:  "exactly one of expression and expression_file must be set",
+		},
+		{
+			name: "no tests",
+			spec: "expression: a",
+			err:  "no tests",
+		},
+		{
+			name: "missing expectation",
+			spec: "expression: a\ntests:\n  - name: t",
+			err:  "t: exactly one of expect and expect_error must be set",
+		},
+		{
+			name: "unknown field",
+			spec: "expression: a\nexpected: true\ntests:\n  - name: t\n    expect: true",
+			err:  "field expected not found",
+		},
+		{
+			name: "float",
+			spec: "expression: a\ntests:\n  - name: t\n    expect: 1.5",
+			err:  "floating point numbers are not supported: 1.5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.spec))
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestRunCase(t *testing.T) {
+	spec, err := Parse([]byte(`
+expression: owners
+expand:
+  owners: "[alice, bob]"
+tests:
+  - name: spec expansion
+    votes: [alice]
+    expect: [true, false]
+  - name: case expansion
+    votes: [alice]
+    expand:
+      owners: "[bob]"
+    expect: [false]
+  - name: division by zero
+    expand:
+      owners: "1 / 0"
+    expect_error: "division by zero"
+  - name: invalid expansion
+    expand:
+      owners: "["
+    expect: true
+`))
+	require.NoError(t, err)
+
+	results := spec.Run(context.Background())
+	require.NoError(t, results[0].Err)
+	require.NoError(t, results[1].Err)
+	require.NoError(t, results[2].Err)
+	require.ErrorContains(t, results[3].Err, "expansion of owners")
+}
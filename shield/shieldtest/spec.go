@@ -0,0 +1,140 @@
+// Package shieldtest runs regression tests for Shield expressions, described
+// by YAML (or JSON) spec files.
+//
+// A spec contains an expression, the expansions used to preprocess it and a
+// list of test cases, each one with its own environment and the expected
+// result:
+//
+//	expression: any(2, warden.space.owners) || (cfo && action.age > 86400)
+//	expand:
+//	  warden.space.owners: "[alice, bob, carol]"
+//	env:
+//	  action.age: 0
+//	tests:
+//	  - name: two owners approve
+//	    votes: [alice, carol]
+//	    expect: true
+//	  - name: the CFO alone must wait a day
+//	    votes: [cfo]
+//	    expect: false
+//	  - name: the CFO alone after a day
+//	    votes: [cfo]
+//	    env:
+//	      action.age: 86401
+//	    expect: true
+//
+// Identifiers are resolved using the env of the test case, then the env of
+// the spec. If the test case has votes, the other identifiers resolve to true
+// if they are part of the votes and to false otherwise, like in x/act.
+// Without votes, unknown identifiers result in an error.
+package shieldtest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is the content of a test spec file.
+type Spec struct {
+	// Path is the file the spec was loaded from, if any.
+	Path string `yaml:"-"`
+
+	// Expression is the Shield expression under test.
+	Expression string `yaml:"expression"`
+	// ExpressionFile is the path of a file containing the expression,
+	// relative to the spec file. It's an alternative to Expression.
+	ExpressionFile string `yaml:"expression_file"`
+	// Expand maps identifiers to the expressions they are expanded to
+	// during preprocessing.
+	Expand map[string]string `yaml:"expand"`
+	// Env contains the values of the identifiers shared by all the test
+	// cases.
+	Env Env `yaml:"env"`
+	// Tests are the test cases.
+	Tests []Case `yaml:"tests"`
+}
+
+// Case is a single test case of a Spec.
+type Case struct {
+	Name string `yaml:"name"`
+	// Votes are the addresses that voted. If present, unknown identifiers
+	// resolve to false.
+	Votes *[]string `yaml:"votes"`
+	// Env contains the values of the identifiers for this test case, in
+	// addition to the ones of the Spec.
+	Env Env `yaml:"env"`
+	// Expand contains expansions for this test case, in addition to the
+	// ones of the Spec.
+	Expand map[string]string `yaml:"expand"`
+	// Expect is the expected result of the evaluation.
+	Expect *Value `yaml:"expect"`
+	// ExpectError is a substring of the expected error.
+	ExpectError string `yaml:"expect_error"`
+}
+
+// Load reads the spec file at path.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	spec.Path = path
+
+	if spec.ExpressionFile != "" {
+		exp, err := os.ReadFile(filepath.Join(filepath.Dir(path), spec.ExpressionFile))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		spec.Expression = string(exp)
+	}
+
+	return spec, nil
+}
+
+// Parse parses a spec from its YAML or JSON encoding. Fields of
+// ExpressionFile are not resolved, see Load.
+func Parse(data []byte) (*Spec, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var spec Spec
+	if err := dec.Decode(&spec); err != nil {
+		return nil, err
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+func (s *Spec) validate() error {
+	if (s.Expression == "") == (s.ExpressionFile == "") {
+		return fmt.Errorf("exactly one of expression and expression_file must be set")
+	}
+
+	if len(s.Tests) == 0 {
+		return fmt.Errorf("no tests")
+	}
+
+	for i, c := range s.Tests {
+		if c.Name == "" {
+			return fmt.Errorf("tests[%d]: missing name", i)
+		}
+		if (c.Expect == nil) == (c.ExpectError == "") {
+			return fmt.Errorf("%s: exactly one of expect and expect_error must be set", c.Name)
+		}
+	}
+
+	return nil
+}
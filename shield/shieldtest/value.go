@@ -0,0 +1,118 @@
+package shieldtest
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+)
+
+// Value is a Shield value decoded from YAML: integers, booleans, strings,
+// sequences (arrays) and mappings (objects). Integers are arbitrary
+// precision, floating point numbers are not supported.
+type Value struct {
+	Object object.Object
+}
+
+func (v *Value) UnmarshalYAML(node *yaml.Node) error {
+	obj, err := nodeToObject(node)
+	if err != nil {
+		return err
+	}
+
+	v.Object = obj
+	return nil
+}
+
+// Env maps identifiers to their values.
+type Env map[string]Value
+
+// lookup resolves name to a value. Dotted identifiers, such as "tx.to",
+// select the fields of the objects bound to their longest prefix.
+func (e Env) lookup(name string) (object.Object, bool) {
+	parts := strings.Split(name, ".")
+	for i := len(parts); i > 0; i-- {
+		v, ok := e[strings.Join(parts[:i], ".")]
+		if !ok {
+			continue
+		}
+
+		obj := v.Object
+		for _, field := range parts[i:] {
+			m, ok := obj.(*object.Map)
+			if !ok {
+				return nil, false
+			}
+			if obj, ok = m.Pairs[field]; !ok {
+				return nil, false
+			}
+		}
+		return obj, true
+	}
+
+	return nil, false
+}
+
+func nodeToObject(node *yaml.Node) (object.Object, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return nodeToObject(node.Alias)
+	case yaml.ScalarNode:
+		return scalarToObject(node)
+	case yaml.SequenceNode:
+		elements := make([]object.Object, 0, len(node.Content))
+		for _, n := range node.Content {
+			obj, err := nodeToObject(n)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, obj)
+		}
+		return &object.Array{Elements: elements}, nil
+	case yaml.MappingNode:
+		pairs := make(map[string]object.Object, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			obj, err := nodeToObject(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			pairs[node.Content[i].Value] = obj
+		}
+		return &object.Map{Pairs: pairs}, nil
+	default:
+		return nil, fmt.Errorf("line %d: unsupported value", node.Line)
+	}
+}
+
+func scalarToObject(node *yaml.Node) (object.Object, error) {
+	switch node.ShortTag() {
+	case "!!str":
+		return &object.String{Value: node.Value}, nil
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return nil, err
+		}
+		if b {
+			return object.TRUE, nil
+		}
+		return object.FALSE, nil
+	case "!!int":
+		i, ok := new(big.Int).SetString(node.Value, 0)
+		if !ok {
+			return nil, fmt.Errorf("line %d: invalid integer: %s", node.Line, node.Value)
+		}
+		return &object.Integer{Value: i}, nil
+	case "!!float":
+		// integers that don't fit in 64 bits are resolved as floats
+		if i, ok := new(big.Int).SetString(node.Value, 0); ok {
+			return &object.Integer{Value: i}, nil
+		}
+		return nil, fmt.Errorf("line %d: floating point numbers are not supported: %s", node.Line, node.Value)
+	default:
+		return nil, fmt.Errorf("line %d: unsupported value: %s", node.Line, node.Value)
+	}
+}
@@ -50,6 +50,10 @@ const (
 	Type_DIV       Type = 22
 	Type_TRUE      Type = 23
 	Type_FALSE     Type = 24
+	Type_LBRACE    Type = 25
+	Type_RBRACE    Type = 26
+	Type_COLON     Type = 27
+	Type_DOT       Type = 28
 )
 
 var Type_name = map[int32]string{
@@ -78,6 +82,10 @@ var Type_name = map[int32]string{
 	22: "DIV",
 	23: "TRUE",
 	24: "FALSE",
+	25: "LBRACE",
+	26: "RBRACE",
+	27: "COLON",
+	28: "DOT",
 }
 
 var Type_value = map[string]int32{
@@ -106,6 +114,10 @@ var Type_value = map[string]int32{
 	"DIV":       22,
 	"TRUE":      23,
 	"FALSE":     24,
+	"LBRACE":    25,
+	"RBRACE":    26,
+	"COLON":     27,
+	"DOT":       28,
 }
 
 func (x Type) String() string {
@@ -176,30 +188,32 @@ func init() {
 func init() { proto.RegisterFile("shield/token/token.proto", fileDescriptor_fae17a9db3bdc43d) }
 
 var fileDescriptor_fae17a9db3bdc43d = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x4d, 0x8f, 0x9a, 0x40,
-	0x18, 0xc7, 0x01, 0x11, 0x64, 0x7c, 0xe9, 0xd3, 0xe9, 0x1b, 0x27, 0x62, 0x7a, 0x68, 0x4c, 0x93,
-	0x62, 0xd2, 0x26, 0xbd, 0xa3, 0x8c, 0x84, 0x74, 0x00, 0x1d, 0xc6, 0x1e, 0x7a, 0xf3, 0x85, 0x54,
-	0x53, 0x2a, 0xc6, 0xd2, 0x6c, 0xfc, 0x04, 0x7b, 0xdd, 0x8f, 0xb5, 0x47, 0x8f, 0x7b, 0xdc, 0xe8,
-	0x17, 0xd9, 0x3c, 0xb8, 0x6e, 0xbc, 0xcc, 0xf3, 0xfb, 0xbf, 0x64, 0x66, 0x92, 0x87, 0xd8, 0xff,
-	0x56, 0xeb, 0x2c, 0x5f, 0xf6, 0xcb, 0xe2, 0x4f, 0xb6, 0x39, 0x9f, 0xee, 0x76, 0x57, 0x94, 0x05,
-	0x6d, 0x9d, 0x13, 0xb7, 0xf2, 0x3e, 0x86, 0xa4, 0x2e, 0x11, 0xe8, 0x27, 0xa2, 0x97, 0xfb, 0x6d,
-	0x66, 0xab, 0x5d, 0xb5, 0xd7, 0xf9, 0x4a, 0xdd, 0xeb, 0x96, 0x2b, 0xf7, 0xdb, 0x4c, 0x54, 0x39,
-	0xb5, 0x89, 0x99, 0xaf, 0xcb, 0x6c, 0x37, 0xcb, 0x6d, 0xad, 0xab, 0xf6, 0x2c, 0x71, 0x91, 0x9f,
-	0x6f, 0x35, 0xa2, 0x63, 0x91, 0x36, 0x89, 0x19, 0x72, 0xce, 0x02, 0x8f, 0x83, 0x42, 0x4d, 0x52,
-	0x63, 0xc9, 0x08, 0x54, 0x6a, 0x91, 0x7a, 0xe8, 0xb3, 0x58, 0x82, 0x86, 0x5e, 0x18, 0x4b, 0xa8,
-	0x51, 0x42, 0x8c, 0x54, 0x8a, 0x30, 0x0e, 0x40, 0xc7, 0x7c, 0x98, 0x44, 0x91, 0x07, 0x75, 0xda,
-	0x26, 0x56, 0xca, 0xa2, 0x70, 0x98, 0xf0, 0x24, 0x06, 0x03, 0x5b, 0x7c, 0xec, 0x09, 0x16, 0x83,
-	0x89, 0x2c, 0xce, 0xdc, 0xa0, 0x2d, 0xd2, 0xe0, 0x03, 0xe1, 0x0d, 0x7f, 0x30, 0x09, 0x16, 0x2a,
-	0x71, 0x51, 0x04, 0x9f, 0xf0, 0x62, 0x1f, 0x9a, 0xd4, 0x20, 0x5a, 0x22, 0xa0, 0x85, 0x93, 0x4d,
-	0xa0, 0x8d, 0x41, 0xcc, 0x26, 0xd0, 0x41, 0x23, 0x90, 0xf0, 0x0a, 0x27, 0x97, 0x00, 0x18, 0x04,
-	0x92, 0xc1, 0x6b, 0x04, 0x2e, 0x19, 0xd0, 0xea, 0x0e, 0xdf, 0x87, 0x37, 0x08, 0xe9, 0x74, 0x00,
-	0x6f, 0x11, 0xa2, 0x29, 0x87, 0x77, 0x08, 0x7e, 0xf8, 0x13, 0xde, 0xd3, 0x06, 0xd1, 0xa5, 0x98,
-	0x32, 0xf8, 0x80, 0xff, 0x1f, 0x79, 0x3c, 0x65, 0x60, 0x0f, 0xc6, 0xf7, 0x47, 0x47, 0x3d, 0x1c,
-	0x1d, 0xf5, 0xf1, 0xe8, 0xa8, 0x77, 0x27, 0x47, 0x39, 0x9c, 0x1c, 0xe5, 0xe1, 0xe4, 0x28, 0xbf,
-	0xbe, 0xff, 0x5e, 0x97, 0xab, 0xff, 0x73, 0x77, 0x51, 0xfc, 0xed, 0xdf, 0xcc, 0x76, 0xcb, 0x6c,
-	0xf3, 0xa5, 0xda, 0xca, 0xa2, 0xc8, 0x9f, 0xf5, 0x8b, 0xbc, 0x5e, 0xe0, 0xdc, 0xa8, 0xec, 0x6f,
-	0x4f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x06, 0xdf, 0xf1, 0x5c, 0xd7, 0x01, 0x00, 0x00,
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcf, 0xae, 0x93, 0x40,
+	0x14, 0xc6, 0x81, 0x4b, 0xa1, 0xcc, 0xed, 0xbd, 0x1e, 0xc7, 0x7f, 0xf8, 0x27, 0xa4, 0x71, 0x61,
+	0x1a, 0x13, 0x69, 0xa2, 0x89, 0x7b, 0x5a, 0xa6, 0x84, 0x38, 0x40, 0x3b, 0x4c, 0x5d, 0xb8, 0xeb,
+	0x1f, 0x62, 0x1b, 0xb1, 0x34, 0x15, 0x63, 0xfa, 0x16, 0xbe, 0x8b, 0x2f, 0xe1, 0xb2, 0x4b, 0x97,
+	0xa6, 0x7d, 0x11, 0x73, 0x06, 0x7b, 0xd3, 0x0d, 0xf3, 0xfb, 0xbe, 0xef, 0x64, 0xf8, 0x26, 0x87,
+	0xb8, 0xdf, 0x56, 0xeb, 0xa2, 0x5c, 0xf6, 0xeb, 0xea, 0x4b, 0xb1, 0x69, 0xbe, 0xfe, 0x76, 0x57,
+	0xd5, 0x15, 0xed, 0x34, 0x89, 0xaf, 0xbc, 0x97, 0x31, 0x69, 0x49, 0x04, 0xfa, 0x8a, 0x98, 0xf5,
+	0x7e, 0x5b, 0xb8, 0x7a, 0x57, 0xef, 0xdd, 0xbe, 0xa5, 0xfe, 0xe5, 0x94, 0x2f, 0xf7, 0xdb, 0x42,
+	0xa8, 0x9c, 0xba, 0xc4, 0x2e, 0xd7, 0x75, 0xb1, 0x9b, 0x95, 0xae, 0xd1, 0xd5, 0x7b, 0x8e, 0x38,
+	0xcb, 0xd7, 0xbf, 0x0c, 0x62, 0xe2, 0x20, 0xbd, 0x26, 0x76, 0xcc, 0x39, 0x8b, 0x02, 0x0e, 0x1a,
+	0xb5, 0xc9, 0x15, 0xcb, 0x46, 0xa0, 0x53, 0x87, 0xb4, 0xe2, 0x90, 0xa5, 0x12, 0x0c, 0xf4, 0xe2,
+	0x54, 0xc2, 0x15, 0x25, 0xc4, 0xca, 0xa5, 0x88, 0xd3, 0x08, 0x4c, 0xcc, 0x87, 0x59, 0x92, 0x04,
+	0xd0, 0xa2, 0x37, 0xc4, 0xc9, 0x59, 0x12, 0x0f, 0x33, 0x9e, 0xa5, 0x60, 0xe1, 0x14, 0x1f, 0x07,
+	0x82, 0xa5, 0x60, 0x23, 0x8b, 0x86, 0xdb, 0xb4, 0x43, 0xda, 0x7c, 0x20, 0x82, 0xe1, 0x07, 0x26,
+	0xc1, 0x41, 0x25, 0xce, 0x8a, 0xe0, 0x2f, 0x82, 0x34, 0x84, 0x6b, 0x6a, 0x11, 0x23, 0x13, 0xd0,
+	0xc1, 0x93, 0x4d, 0xe0, 0x06, 0x83, 0x94, 0x4d, 0xe0, 0x16, 0x8d, 0x48, 0xc2, 0x3d, 0x3c, 0xb9,
+	0x04, 0xc0, 0x20, 0x92, 0x0c, 0xee, 0x23, 0x70, 0xc9, 0x80, 0xaa, 0x3b, 0xc2, 0x10, 0x1e, 0x20,
+	0xe4, 0xd3, 0x01, 0x3c, 0x44, 0x48, 0xa6, 0x1c, 0x1e, 0x21, 0x84, 0xf1, 0x47, 0x78, 0x4c, 0xdb,
+	0xc4, 0x94, 0x62, 0xca, 0xe0, 0x09, 0xf6, 0x1f, 0x05, 0x3c, 0x67, 0xe0, 0xaa, 0xc2, 0x58, 0x85,
+	0xc1, 0x53, 0x55, 0xb8, 0xe1, 0x67, 0xcd, 0x13, 0xf1, 0x4d, 0xcf, 0xd5, 0x05, 0x99, 0x84, 0x17,
+	0x83, 0xf1, 0xef, 0xa3, 0xa7, 0x1f, 0x8e, 0x9e, 0xfe, 0xf7, 0xe8, 0xe9, 0x3f, 0x4f, 0x9e, 0x76,
+	0x38, 0x79, 0xda, 0x9f, 0x93, 0xa7, 0x7d, 0x7a, 0xff, 0x79, 0x5d, 0xaf, 0xbe, 0xcf, 0xfd, 0x45,
+	0xf5, 0xb5, 0xff, 0x63, 0xb6, 0x5b, 0x16, 0x9b, 0x37, 0x6a, 0x83, 0x8b, 0xaa, 0xfc, 0xaf, 0xef,
+	0xe4, 0xe5, 0xb2, 0xe7, 0x96, 0xb2, 0xdf, 0xfd, 0x1b, 0x00, 0xa8, 0xd1, 0xd0, 0x84, 0x03, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Token) Marshal() (dAtA []byte, err error) {
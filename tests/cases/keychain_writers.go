@@ -42,7 +42,7 @@ func (c *Test_KeychainWriters) Run(t *testing.T, ctx context.Context, build fram
 		// create a KeyRequest
 		newReqTx := bob.Tx(t, "warden new-action new-key-request --space-id 1 --keychain-id 1 --key-type 1 --max-keychain-fees \"1award\" --nonce 0")
 		checks.SuccessTx(t, newReqTx)
-		client.EnsureActionStatus(t, ctx, 1, v1beta1.ActionStatus_ACTION_STATUS_REVOKED)
+		client.EnsureActionStatus(t, ctx, 1, v1beta1.ActionStatus_ACTION_STATUS_FAILED)
 		client.EnsureBalanceAmount(t, ctx, wardenAddress, balance)
 
 		newReqTx = bob.Tx(t, "warden new-action new-key-request --space-id 1 --keychain-id 1 --key-type 1 --max-keychain-fees \"3award\" --nonce 0")
@@ -76,7 +76,7 @@ func (c *Test_KeychainWriters) Run(t *testing.T, ctx context.Context, build fram
 		// create a SignRequest with not enough fee
 		newReqTx := bob.Tx(t, "warden new-action new-sign-request --key-id 1 --input 'HoZ4Z+ZU7Zd08kUR5NcbtFZrmGKF18mSBJ29dg0qI44=' --max-keychain-fees \"1award\" --nonce 0")
 		checks.SuccessTx(t, newReqTx)
-		client.EnsureActionStatus(t, ctx, 3, v1beta1.ActionStatus_ACTION_STATUS_REVOKED)
+		client.EnsureActionStatus(t, ctx, 3, v1beta1.ActionStatus_ACTION_STATUS_FAILED)
 		client.EnsureBalanceAmount(t, ctx, wardenAddress, balance)
 
 		// create a SignRequest with enough fee
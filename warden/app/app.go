@@ -72,6 +72,7 @@ import (
 	gmptypes "github.com/warden-protocol/wardenprotocol/warden/x/gmp/types"
 	"github.com/warden-protocol/wardenprotocol/warden/x/ibctransfer/keeper"
 	wardenmodulekeeper "github.com/warden-protocol/wardenprotocol/warden/x/warden/keeper"
+	wardenmoduletypes "github.com/warden-protocol/wardenprotocol/warden/x/warden/types"
 	wardentypes "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
 
 	// this line is used by starport scaffolding # stargate/app/moduleImport
@@ -261,6 +262,8 @@ func New(
 				app.GetCapabilityScopedKeeper,
 				// Supply Wasm keeper, similar to what we do for IBC keeper, since it doesn't support App Wiring yet.
 				app.GetWasmKeeper,
+				// Supply the IBC transfer keeper getter, used by x/warden for Space treasuries.
+				app.GetTransferKeeper,
 				app.GetEvmKeeper,
 				app.GetFeemarketKeeper,
 				// Supply the logger
@@ -275,6 +278,10 @@ func New(
 							wardentypes.ModuleName,
 							app.WardenKeeper.ShieldExpander(),
 						),
+						cosmoshield.NewPrefixedExpander(
+							cosmoshield.MsgNamespace,
+							cosmoshield.NewMsgExpander(),
+						),
 					)
 				},
 
@@ -590,6 +597,11 @@ func (app *App) GetWasmKeeper() wasmkeeper.Keeper {
 	return app.WasmKeeper
 }
 
+// GetTransferKeeper returns the IBC transfer keeper.
+func (app *App) GetTransferKeeper() wardenmoduletypes.TransferKeeper {
+	return &app.TransferKeeper
+}
+
 // GetEvmKeeper returns the Evm keeper.
 func (app *App) GetEvmKeeper(_placeHolder int16) *evmkeeper.Keeper {
 	return app.EvmKeeper
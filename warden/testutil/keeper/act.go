@@ -10,18 +10,26 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
 	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
 	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 )
 
 func ActKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return ActKeeperWithExpander(t, nil)
+}
+
+// ActKeeperWithExpander returns an x/act keeper using shieldExpanderFunc to
+// preprocess the expressions of the Actions.
+func ActKeeperWithExpander(t testing.TB, shieldExpanderFunc func() ast.Expander) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -29,7 +37,14 @@ func ActKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
-	registry := codectypes.NewInterfaceRegistry()
+	// the address codec is needed to check the signers of the messages
+	registry := codectestutil.CodecOptions{
+		AccAddressPrefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
+		ValAddressPrefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+	}.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	// the message types bound in the Params must be known to the registry
+	banktypes.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 	actAuthority := authtypes.NewModuleAddress(types.ModuleName)
@@ -42,9 +57,11 @@ func ActKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		nil,
 		authority.String(),
 		actAuthority.String(),
-		nil,
+		shieldExpanderFunc,
 		templatesRegistry,
+		nil,
 	)
+	k.RegisterTemplates(templatesRegistry)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
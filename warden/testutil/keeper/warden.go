@@ -19,10 +19,15 @@ import (
 
 	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 	"github.com/warden-protocol/wardenprotocol/warden/x/warden/keeper"
+	wardentypes "github.com/warden-protocol/wardenprotocol/warden/x/warden/types"
 	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
 )
 
 func WardenKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return WardenKeeperWithBank(t, nil)
+}
+
+func WardenKeeperWithBank(t testing.TB, bankKeeper wardentypes.BankKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -45,9 +50,10 @@ func WardenKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		authority.String(),
 		actAuthority.String(),
 		wardenAuthority,
-		nil,
+		bankKeeper,
 		actKeeper,
 		nil,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
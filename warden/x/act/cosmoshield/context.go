@@ -2,16 +2,26 @@ package cosmoshield
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
 )
 
+// PreprocessFunc preprocesses an expression the same way x/act preprocesses
+// the expressions of new Actions.
+type PreprocessFunc func(ctx context.Context, expr *ast.Expression) (*ast.Expression, error)
+
 type Context struct {
 	context.Context
 
-	actionMsg sdk.Msg
+	actionMsg  sdk.Msg
+	preprocess PreprocessFunc
 }
 
+type contextKey struct{}
+
 func NewContext(baseContext context.Context, actionMsg sdk.Msg) Context {
 	return Context{
 		Context:   baseContext,
@@ -23,11 +33,43 @@ func (c Context) Msg() sdk.Msg {
 	return c.actionMsg
 }
 
+// WithPreprocess returns a copy of c that preprocesses the expressions using
+// f, see Preprocess.
+func (c Context) WithPreprocess(f PreprocessFunc) Context {
+	c.preprocess = f
+	return c
+}
+
+// Preprocess preprocesses expr with the function set by x/act. Expanders can
+// use it to expand identifiers into expressions that need to be
+// preprocessed themselves, e.g. the Rule of another Space.
+func (c Context) Preprocess(ctx context.Context, expr *ast.Expression) (*ast.Expression, error) {
+	if c.preprocess == nil {
+		return nil, fmt.Errorf("preprocessing is not available in this context")
+	}
+
+	return c.preprocess(ctx, expr)
+}
+
+func (c Context) Value(key any) any {
+	if key == (contextKey{}) {
+		return c
+	}
+
+	return c.Context.Value(key)
+}
+
+// UnwrapContext returns the Context wrapped by ctx, also when other values
+// have been added on top of it (e.g. with context.WithValue).
 func UnwrapContext(ctx context.Context) Context {
 	if ctx, ok := ctx.(Context); ok {
 		return ctx
 	}
 
+	if c, ok := ctx.Value(contextKey{}).(Context); ok {
+		return c
+	}
+
 	return Context{
 		Context: ctx,
 	}
@@ -0,0 +1,55 @@
+package cosmoshield
+
+import (
+	"context"
+	"slices"
+)
+
+// Dependencies records the dynamic inputs an expression depends on, i.e. the
+// values used to expand its identifiers that can change after the expansion,
+// such as the owners of a Space.
+//
+// Dependencies are strings in the form `<module>/<kind>/<id>`, e.g.
+// `warden/spaces/1`, and the module owning them notifies x/act when they
+// change.
+type Dependencies struct {
+	keys []string
+}
+
+// Add records dependency, if not already recorded.
+func (d *Dependencies) Add(dependency ...string) {
+	for _, dep := range dependency {
+		if !slices.Contains(d.keys, dep) {
+			d.keys = append(d.keys, dep)
+		}
+	}
+}
+
+// List returns the sorted list of the recorded dependencies.
+func (d *Dependencies) List() []string {
+	keys := slices.Clone(d.keys)
+	slices.Sort(keys)
+	return keys
+}
+
+// Len returns the number of recorded dependencies.
+func (d *Dependencies) Len() int {
+	return len(d.keys)
+}
+
+type dependenciesKey struct{}
+
+// WithDependencies returns a context recording into deps the dependencies
+// added with AddDependency.
+func WithDependencies(ctx context.Context, deps *Dependencies) context.Context {
+	return context.WithValue(ctx, dependenciesKey{}, deps)
+}
+
+// AddDependency records that the identifier being expanded depends on
+// dependency. Expanders call it while expanding identifiers into values that
+// can change, it has no effect if ctx isn't recording dependencies.
+func AddDependency(ctx context.Context, dependency string) {
+	if deps, ok := ctx.Value(dependenciesKey{}).(*Dependencies); ok {
+		deps.Add(dependency)
+	}
+}
@@ -0,0 +1,242 @@
+package cosmoshield
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+)
+
+// MsgNamespace is the namespace under which the MsgExpander is usually
+// registered, i.e. `msg.<field>` identifiers.
+const MsgNamespace = "msg"
+
+const (
+	coinFullName    = "cosmos.base.v1beta1.Coin"
+	cosmosIntScalar = "cosmos.Int"
+)
+
+var _ ast.Expander = MsgExpander{}
+
+// MsgExpander is an ast.Expander that expands identifiers into the values of
+// the fields of the message contained in the Context (see Context.Msg).
+//
+// Identifiers are paths of field names, e.g. `new_owner` or
+// `max_keychain_fees.award`. Both the protobuf and the JSON names of the
+// fields are accepted.
+//
+// Values are mapped to Shield literals:
+//   - booleans become boolean literals;
+//   - integers and cosmos.Int strings become integer literals;
+//   - strings, including addresses, become string literals;
+//   - enums become string literals containing the name of the value;
+//   - bytes become string literals containing their hex encoding;
+//   - coins become string literals (e.g. "100award"), and a list of coins
+//     can be indexed by denom to get the amount (e.g. `fees.award`);
+//   - repeated fields become array literals.
+type MsgExpander struct{}
+
+func NewMsgExpander() MsgExpander {
+	return MsgExpander{}
+}
+
+func (MsgExpander) Expand(ctx context.Context, ident *ast.Identifier) (*ast.Expression, error) {
+	msg := UnwrapContext(ctx).Msg()
+	if msg == nil {
+		return nil, fmt.Errorf("cannot expand %s: no message in context", ident.Value)
+	}
+
+	m, err := reflectMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return expandMessage(m, strings.Split(ident.Value, "."))
+}
+
+// reflectMsg returns a protoreflect.Message for msg. Messages generated with
+// gogoproto are converted to a dynamic message using their registered
+// descriptor.
+func reflectMsg(msg sdk.Msg) (protoreflect.Message, error) {
+	if m, ok := msg.(proto.Message); ok {
+		return m.ProtoReflect(), nil
+	}
+
+	name := gogoproto.MessageName(msg)
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("cannot find descriptor for %s: %w", name, err)
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	m := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(bz, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func expandMessage(m protoreflect.Message, path []string) (*ast.Expression, error) {
+	md := m.Descriptor()
+
+	if len(path) == 0 {
+		if md.FullName() == coinFullName {
+			return expandCoin(m), nil
+		}
+		return nil, fmt.Errorf("message %s cannot be used as a value", md.FullName())
+	}
+
+	fd := md.Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		fd = md.Fields().ByJSONName(path[0])
+	}
+	if fd == nil {
+		return nil, fmt.Errorf("unknown field %s in %s", path[0], md.FullName())
+	}
+
+	return expandField(fd, m.Get(fd), path[1:])
+}
+
+func expandField(fd protoreflect.FieldDescriptor, v protoreflect.Value, path []string) (*ast.Expression, error) {
+	switch {
+	case fd.IsMap():
+		return nil, fmt.Errorf("map field %s is not supported", fd.FullName())
+	case fd.IsList():
+		if fd.Message() != nil && fd.Message().FullName() == coinFullName && len(path) > 0 {
+			return expandCoinsAmount(v.List(), path)
+		}
+		if len(path) > 0 {
+			return nil, fmt.Errorf("cannot access %s of repeated field %s", strings.Join(path, "."), fd.FullName())
+		}
+
+		list := v.List()
+		elements := make([]*ast.Expression, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			el, err := expandValue(fd, list.Get(i), nil)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, el)
+		}
+		return ast.NewArrayLiteral(&ast.ArrayLiteral{Elements: elements}), nil
+	default:
+		return expandValue(fd, v, path)
+	}
+}
+
+func expandValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, path []string) (*ast.Expression, error) {
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return expandMessage(v.Message(), path)
+	}
+
+	if len(path) > 0 {
+		return nil, fmt.Errorf("cannot access %s of scalar field %s", strings.Join(path, "."), fd.FullName())
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return ast.NewBooleanLiteral(&ast.BooleanLiteral{Value: v.Bool()}), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return newIntegerLiteral(strconv.FormatInt(v.Int(), 10)), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return newIntegerLiteral(strconv.FormatUint(v.Uint(), 10)), nil
+	case protoreflect.StringKind:
+		if isCosmosInt(fd) {
+			return expandCosmosInt(v.String())
+		}
+		return ast.NewStringLiteral(&ast.StringLiteral{Value: v.String()}), nil
+	case protoreflect.BytesKind:
+		return ast.NewStringLiteral(&ast.StringLiteral{Value: hex.EncodeToString(v.Bytes())}), nil
+	case protoreflect.EnumKind:
+		name := strconv.Itoa(int(v.Enum()))
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			name = string(ev.Name())
+		}
+		return ast.NewStringLiteral(&ast.StringLiteral{Value: name}), nil
+	default:
+		return nil, fmt.Errorf("field %s of kind %s is not supported", fd.FullName(), fd.Kind())
+	}
+}
+
+func expandCoin(m protoreflect.Message) *ast.Expression {
+	fields := m.Descriptor().Fields()
+	denom := m.Get(fields.ByName("denom")).String()
+	amount := m.Get(fields.ByName("amount")).String()
+	if amount == "" {
+		amount = "0"
+	}
+
+	return ast.NewStringLiteral(&ast.StringLiteral{Value: amount + denom})
+}
+
+// expandCoinsAmount returns the amount of the coin with the denom specified
+// by path, or 0 if the list doesn't contain that denom.
+func expandCoinsAmount(coins protoreflect.List, path []string) (*ast.Expression, error) {
+	if len(path) != 1 {
+		return nil, fmt.Errorf("invalid coin denom: %s", strings.Join(path, "."))
+	}
+
+	for i := 0; i < coins.Len(); i++ {
+		coin := coins.Get(i).Message()
+		fields := coin.Descriptor().Fields()
+		if coin.Get(fields.ByName("denom")).String() == path[0] {
+			return expandCosmosInt(coin.Get(fields.ByName("amount")).String())
+		}
+	}
+
+	return newIntegerLiteral("0"), nil
+}
+
+func expandCosmosInt(s string) (*ast.Expression, error) {
+	if s == "" {
+		return newIntegerLiteral("0"), nil
+	}
+
+	i, ok := sdkmath.NewIntFromString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer: %s", s)
+	}
+
+	return newIntegerLiteral(i.String()), nil
+}
+
+func isCosmosInt(fd protoreflect.FieldDescriptor) bool {
+	if fd.ContainingMessage().FullName() == coinFullName && fd.Name() == "amount" {
+		return true
+	}
+
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, cosmos_proto.E_Scalar) {
+		return false
+	}
+
+	scalar, _ := proto.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	return scalar == cosmosIntScalar
+}
+
+func newIntegerLiteral(value string) *ast.Expression {
+	return ast.NewIntegerLiteral(&ast.IntegerLiteral{Value: value})
+}
@@ -0,0 +1,112 @@
+package cosmoshield_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/cosmoshield"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+func TestMsgExpander(t *testing.T) {
+	signRequest := &types.MsgNewSignRequest{
+		Authority: "warden1authority",
+		KeyId:     42,
+		Input:     []byte{0xca, 0xfe},
+		Analyzers: []string{"warden1analyzer1", "warden1analyzer2"},
+		MaxKeychainFees: sdk.NewCoins(
+			sdk.NewCoin("award", sdkmath.NewInt(1000)),
+			sdk.NewCoin("uatom", sdkmath.NewInt(5)),
+		),
+	}
+
+	tests := []struct {
+		name     string
+		msg      sdk.Msg
+		ident    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "address", msg: &types.MsgAddSpaceOwner{NewOwner: "warden1new"}, ident: "new_owner", expected: `"warden1new"`},
+		{name: "json name", msg: &types.MsgAddSpaceOwner{NewOwner: "warden1new"}, ident: "newOwner", expected: `"warden1new"`},
+		{name: "uint64", msg: signRequest, ident: "key_id", expected: "42"},
+		{name: "bytes", msg: signRequest, ident: "input", expected: `"cafe"`},
+		{name: "repeated", msg: signRequest, ident: "analyzers", expected: `["warden1analyzer1", "warden1analyzer2"]`},
+		{name: "coins", msg: signRequest, ident: "max_keychain_fees", expected: `["1000award", "5uatom"]`},
+		{name: "coin amount", msg: signRequest, ident: "max_keychain_fees.award", expected: "1000"},
+		{name: "missing coin amount", msg: signRequest, ident: "max_keychain_fees.uusdc", expected: "0"},
+		{name: "unknown field", msg: signRequest, ident: "foo", wantErr: true},
+		{name: "field of scalar", msg: signRequest, ident: "key_id.foo", wantErr: true},
+		{name: "no message", msg: nil, ident: "key_id", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := cosmoshield.NewContext(context.Background(), tt.msg)
+			expr, err := cosmoshield.NewMsgExpander().Expand(ctx, ast.NewIdent(tt.ident))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ast.Stringify(expr))
+		})
+	}
+}
+
+func TestExpanderManagerMsgNamespace(t *testing.T) {
+	m := cosmoshield.NewExpanderManager(
+		cosmoshield.NewPrefixedExpander(cosmoshield.MsgNamespace, cosmoshield.NewMsgExpander()),
+	)
+
+	ctx := cosmoshield.NewContext(context.Background(), &types.MsgAddSpaceOwner{SpaceId: 7})
+	expr, err := m.Expand(ctx, ast.NewIdent("msg.space_id"))
+	require.NoError(t, err)
+	require.Equal(t, "7", ast.Stringify(expr))
+}
+
+func TestUnwrapContextWithValues(t *testing.T) {
+	type key struct{}
+	msg := &types.MsgAddSpaceOwner{SpaceId: 7}
+	ctx := context.WithValue(cosmoshield.NewContext(context.Background(), msg), key{}, "value")
+
+	require.Equal(t, msg, cosmoshield.UnwrapContext(ctx).Msg())
+	require.Nil(t, cosmoshield.UnwrapContext(context.Background()).Msg())
+
+	m := cosmoshield.NewExpanderManager(
+		cosmoshield.NewPrefixedExpander(cosmoshield.MsgNamespace, cosmoshield.NewMsgExpander()),
+	)
+	expr, err := m.Expand(ctx, ast.NewIdent("msg.space_id"))
+	require.NoError(t, err)
+	require.Equal(t, "7", ast.Stringify(expr))
+}
+
+type emptyEnv struct{}
+
+func (emptyEnv) Get(string) (object.Object, bool) { return nil, false }
+
+func TestAmountOfExpandedCoins(t *testing.T) {
+	m := cosmoshield.NewExpanderManager(
+		cosmoshield.NewPrefixedExpander(cosmoshield.MsgNamespace, cosmoshield.NewMsgExpander()),
+	)
+	ctx := cosmoshield.NewContext(context.Background(), &types.MsgNewSignRequest{
+		MaxKeychainFees: sdk.NewCoins(
+			sdk.NewCoin("award", sdkmath.NewInt(1000)),
+			sdk.NewCoin("uatom", sdkmath.NewInt(5)),
+		),
+	})
+
+	expr, err := shield.Parse(`amountOf(msg.max_keychain_fees, "award") == 1000 && amountOf(msg.max_keychain_fees, "uward") == 0`)
+	require.NoError(t, err)
+	expr, err = shield.Preprocess(ctx, expr, m)
+	require.NoError(t, err)
+
+	require.Equal(t, object.TRUE, shield.Eval(expr, emptyEnv{}))
+}
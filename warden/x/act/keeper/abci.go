@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"context"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"errors"
+	"fmt"
 	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 )
 
 func (k Keeper) EndBlocker(ctx context.Context) error {
@@ -11,6 +17,28 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 	blockHeight := sdkCtx.BlockHeight()
 	params := k.GetParams(ctx)
 
+	if err := k.timeoutActions(ctx, blockHeight); err != nil {
+		return err
+	}
+
+	if err := k.reevaluateTimeDependentActions(ctx); err != nil {
+		return err
+	}
+
+	if err := k.reevaluateChangedActions(ctx); err != nil {
+		return err
+	}
+
+	// queued actions are executed after the re-evaluations, so that they are
+	// not executed if they have been rejected in this block
+	if err := k.executeQueuedActions(ctx); err != nil {
+		return err
+	}
+
+	if err := k.pruneVoteDelegations(ctx); err != nil {
+		return err
+	}
+
 	if params.MaxPendingTime > 0 && params.MaxCompletedTime > 0 {
 		if err := k.pruneActions(
 			ctx,
@@ -53,3 +81,184 @@ func (k Keeper) pruneActions(
 
 	return nil
 }
+
+// timeoutActions sets the status of the pending actions whose TimeoutHeight
+// has been reached to ACTION_STATUS_TIMEOUT.
+func (k Keeper) timeoutActions(ctx context.Context, blockHeight int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ids, err := k.ActionKeeper.TimedOutActions(ctx, uint64(blockHeight))
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		act, err := k.ActionKeeper.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		timedOut := act
+		if act.Status == types.ActionStatus_ACTION_STATUS_PENDING {
+			if err := timedOut.SetStatus(sdkCtx, types.ActionStatus_ACTION_STATUS_TIMEOUT); err != nil {
+				return err
+			}
+		}
+
+		if err := k.ActionKeeper.reindex(ctx, act, timedOut); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reevaluateTimeDependentActions re-evaluates the open actions that depend
+// on the block context (see ActionContextEnv), since their expressions can
+// become true without any new vote. At most
+// types.MaxTimeDependentReevaluations actions are re-evaluated per block, the
+// next ones are re-evaluated in the following blocks.
+func (k Keeper) reevaluateTimeDependentActions(ctx context.Context) error {
+	ids, err := k.ActionKeeper.NextTimeDependentActions(ctx, types.MaxTimeDependentReevaluations)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		act, err := k.ActionKeeper.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			if err := k.ActionKeeper.removeTimeDependent(ctx, id); err != nil {
+				return err
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !act.IsOpen() {
+			if err := k.ActionKeeper.removeTimeDependent(ctx, id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.reevaluateAction(ctx, &act); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reevaluateChangedActions re-evaluates the open actions whose expressions
+// were expanded again during the block, see DependencyChanged.
+func (k Keeper) reevaluateChangedActions(ctx context.Context) error {
+	ids, err := k.ActionKeeper.ChangedActions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := k.ActionKeeper.removeChanged(ctx, id); err != nil {
+			return err
+		}
+
+		act, err := k.ActionKeeper.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !act.IsOpen() {
+			continue
+		}
+
+		if err := k.reevaluateAction(ctx, &act); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reevaluateAction tries to reject, then to execute, an open action without
+// any new vote. Evaluation errors, and panics, must not halt the block: they
+// are logged and stored in the action, that is not re-evaluated at every
+// block anymore until one of its dependencies changes.
+func (k Keeper) reevaluateAction(ctx context.Context, act *types.Action) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if act.Status == types.ActionStatus_ACTION_STATUS_PENDING && act.TimeoutHeight > 0 && act.TimeoutHeight < uint64(sdkCtx.BlockHeight()) {
+		return nil
+	}
+
+	prev := *act
+	// the writes of a failed evaluation are discarded
+	cacheCtx, write := sdkCtx.CacheContext()
+	err := k.safeReevaluateAction(cacheCtx, act)
+	if err == nil {
+		write()
+		return nil
+	}
+
+	sdkCtx.Logger().Error("action re-evaluation failed", "action_id", act.Id, "err", err)
+	failed := prev
+	failed.EvaluationError = err.Error()
+	return k.ActionKeeper.reindex(ctx, prev, failed)
+}
+
+// safeReevaluateAction tries to reject, then to execute, act. A panic during
+// the evaluation of its expressions is returned as an error.
+func (k Keeper) safeReevaluateAction(ctx sdk.Context, act *types.Action) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("re-evaluation of action %d PANICKED: %v", act.Id, r)
+		}
+	}()
+
+	if err := k.TryRejectVotedAction(ctx, act); err != nil {
+		return err
+	}
+
+	if act.Status == types.ActionStatus_ACTION_STATUS_PENDING {
+		return k.TryExecuteVotedAction(ctx, act)
+	}
+
+	return nil
+}
+
+// executeQueuedActions executes the queued actions whose ExecuteAfter has
+// been reached. Execution errors are stored in the actions, since they must
+// not halt the block.
+func (k Keeper) executeQueuedActions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ids, err := k.ActionKeeper.QueuedActions(ctx, k.getBlockTime(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		act, err := k.ActionKeeper.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		executed := act
+		if act.Status == types.ActionStatus_ACTION_STATUS_QUEUED {
+			if err := k.executeAction(ctx, &executed); err != nil {
+				sdkCtx.Logger().Error("queued action execution failed", "action_id", act.Id, "err", err)
+				if err := executed.SetFailed(sdkCtx, err); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := k.ActionKeeper.reindex(ctx, act, executed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestEndBlockerTimesOutActions(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1})
+
+	expr, err := shield.Parse("warden1owner")
+	require.NoError(t, err)
+
+	newAction := func(timeoutHeight uint64) uint64 {
+		id, err := k.ActionKeeper.New(ctx, &types.Action{
+			Status:            types.ActionStatus_ACTION_STATUS_PENDING,
+			TimeoutHeight:     timeoutHeight,
+			ApproveExpression: *expr,
+			RejectExpression:  *expr,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	early := newAction(2)
+	late := newAction(3)
+	noTimeout := newAction(0)
+
+	status := func(id uint64) types.ActionStatus {
+		act, err := k.ActionKeeper.Get(ctx, id)
+		require.NoError(t, err)
+		return act.Status
+	}
+
+	// actions can still be executed at their TimeoutHeight
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2})
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, status(early))
+
+	ctx = ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3}).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_TIMEOUT, status(early))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, status(late))
+
+	var events []*types.EventActionStateChange
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		if ev, ok := msg.(*types.EventActionStateChange); ok {
+			events = append(events, ev)
+		}
+	}
+	require.Equal(t, []*types.EventActionStateChange{{
+		Id:             early,
+		PreviousStatus: types.ActionStatus_ACTION_STATUS_PENDING,
+		NewStatus:      types.ActionStatus_ACTION_STATUS_TIMEOUT,
+	}}, events)
+
+	ctx = ctx.WithBlockHeight(10).WithHeaderInfo(header.Info{Height: 10})
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_TIMEOUT, status(late))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, status(noTimeout))
+
+	ids, err := k.ActionKeeper.TimedOutActions(ctx, 100)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestEndBlockerExecutesQueuedActions(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	t0 := time.Unix(1_700_000_000, 0).UTC()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: t0}).WithBlockTime(t0)
+
+	approve, err := shield.Parse(alice)
+	require.NoError(t, err)
+	reject, err := shield.Parse(bob)
+	require.NoError(t, err)
+
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: approve, ExecutionDelay: time.Hour}, types.Template{Expression: reject}, nil
+	})
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+	})
+	ms := keeper.NewMsgServerImpl(k)
+
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	executed, err := k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	rejected, err := k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+
+	// approved actions are queued
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_QUEUED, executed.Status)
+	require.Equal(t, t0.Add(time.Hour), *executed.ExecuteAfter)
+
+	var queued []*types.EventActionQueued
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		if ev, ok := msg.(*types.EventActionQueued); ok {
+			queued = append(queued, ev)
+		}
+	}
+	require.Len(t, queued, 2)
+	require.Equal(t, &types.EventActionQueued{Id: executed.Id, ExecuteAfter: t0.Add(time.Hour)}, queued[0])
+
+	res, err := k.Actions(ctx, &types.QueryActionsRequest{Status: types.ActionStatus_ACTION_STATUS_QUEUED})
+	require.NoError(t, err)
+	require.Len(t, res.Actions, 2)
+
+	// queued actions can still be rejected
+	_, err = ms.VoteForAction(ctx, &types.MsgVoteForAction{
+		Participant: bob,
+		ActionId:    rejected.Id,
+		VoteType:    types.ActionVoteType_VOTE_TYPE_REJECTED,
+	})
+	require.NoError(t, err)
+
+	status := func(id uint64) types.ActionStatus {
+		act, err := k.ActionKeeper.Get(ctx, id)
+		require.NoError(t, err)
+		return act.Status
+	}
+
+	t1 := t0.Add(30 * time.Minute)
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, Time: t1}).WithBlockTime(t1)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_QUEUED, status(executed.Id))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED, status(rejected.Id))
+
+	t2 := t0.Add(time.Hour)
+	ctx = ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3, Time: t2}).WithBlockTime(t2)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, status(executed.Id))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED, status(rejected.Id))
+
+	ids, err := k.ActionKeeper.QueuedActions(ctx, t2.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+const (
+	// BlockHeightIdentifier resolves to the height of the current block.
+	BlockHeightIdentifier = "block.height"
+
+	// BlockTimeIdentifier resolves to the time of the current block, as
+	// seconds since the Unix epoch.
+	BlockTimeIdentifier = "block.time"
+
+	// ActionAgeIdentifier resolves to the number of seconds elapsed since the
+	// Action was created.
+	ActionAgeIdentifier = "action.age"
+)
+
+// ActionContextEnv is an environment that resolves the identifiers describing
+// the current block and the Action being evaluated. Other identifiers are
+// resolved by the wrapped environment.
+type ActionContextEnv struct {
+	base   shield.Environment
+	height int64
+	time   int64
+	age    int64
+}
+
+// NewActionContextEnv returns an ActionContextEnv for act at the current block.
+func NewActionContextEnv(ctx context.Context, act *types.Action, base shield.Environment) ActionContextEnv {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.HeaderInfo().Time
+
+	age := int64(blockTime.Sub(act.CreatedAt).Seconds())
+	if age < 0 {
+		age = 0
+	}
+
+	return ActionContextEnv{
+		base:   base,
+		height: sdkCtx.BlockHeight(),
+		time:   blockTime.Unix(),
+		age:    age,
+	}
+}
+
+// Get implements evaluator.Environment.
+func (e ActionContextEnv) Get(name string) (object.Object, bool) {
+	switch name {
+	case BlockHeightIdentifier:
+		return &object.Integer{Value: big.NewInt(e.height)}, true
+	case BlockTimeIdentifier:
+		return &object.Integer{Value: big.NewInt(e.time)}, true
+	case ActionAgeIdentifier:
+		return &object.Integer{Value: big.NewInt(e.age)}, true
+	}
+
+	return e.base.Get(name)
+}
+
+// isTimeDependent returns true if the expressions of act reference any of the
+// block context identifiers, i.e. their result can change without a new vote.
+func isTimeDependent(act types.Action) (bool, error) {
+	for _, expr := range []*ast.Expression{&act.ApproveExpression, &act.RejectExpression} {
+		metadata, err := shield.ExtractMetadata(expr)
+		if err != nil {
+			return false, err
+		}
+
+		for _, ident := range metadata.Identifiers {
+			if strings.HasPrefix(ident, "block.") || strings.HasPrefix(ident, "action.") {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
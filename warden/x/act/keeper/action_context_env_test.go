@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestActionContextEnv(t *testing.T) {
+	_, ctx := keepertest.ActKeeper(t)
+	createdAt := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockHeight(42).WithHeaderInfo(header.Info{
+		Height: 42,
+		Time:   createdAt.Add(48 * time.Hour),
+	})
+
+	act := &types.Action{CreatedAt: createdAt}
+	env := keeper.NewActionContextEnv(ctx, act, keeper.ActionApprovedVotesEnv(nil))
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{keeper.BlockHeightIdentifier, "42"},
+		{keeper.BlockTimeIdentifier, "1700172800"},
+		{keeper.ActionAgeIdentifier, "172800"},
+		{"warden1unknown", "false"},
+	}
+
+	for _, tt := range tests {
+		v, found := env.Get(tt.name)
+		require.True(t, found, tt.name)
+		require.Equal(t, tt.expected, v.Inspect(), tt.name)
+	}
+}
+
+func TestEndBlockerReevaluatesTimeDependentActions(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+	createdAt := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: createdAt})
+
+	approve, err := shield.Parse("warden1owner")
+	require.NoError(t, err)
+	timeReject, err := shield.Parse("action.age > 3600")
+	require.NoError(t, err)
+	voteReject, err := shield.Parse("warden1owner")
+	require.NoError(t, err)
+
+	timeDependentID, err := k.ActionKeeper.New(ctx, &types.Action{
+		Status:            types.ActionStatus_ACTION_STATUS_PENDING,
+		CreatedAt:         createdAt,
+		ApproveExpression: *approve,
+		RejectExpression:  *timeReject,
+	})
+	require.NoError(t, err)
+
+	otherID, err := k.ActionKeeper.New(ctx, &types.Action{
+		Status:            types.ActionStatus_ACTION_STATUS_PENDING,
+		CreatedAt:         createdAt,
+		ApproveExpression: *approve,
+		RejectExpression:  *voteReject,
+	})
+	require.NoError(t, err)
+
+	ids, err := k.ActionKeeper.TimeDependentActions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{timeDependentID}, ids)
+
+	// not enough time elapsed
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, Time: createdAt.Add(time.Hour)})
+	require.NoError(t, k.EndBlocker(ctx))
+	act, err := k.ActionKeeper.Get(ctx, timeDependentID)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+
+	// the reject expression becomes true without any vote
+	ctx = ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3, Time: createdAt.Add(time.Hour + time.Second)})
+	require.NoError(t, k.EndBlocker(ctx))
+	act, err = k.ActionKeeper.Get(ctx, timeDependentID)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED, act.Status)
+
+	other, err := k.ActionKeeper.Get(ctx, otherID)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, other.Status)
+
+	// completed actions are removed from the index
+	ctx = ctx.WithBlockHeight(4).WithHeaderInfo(header.Info{Height: 4, Time: createdAt.Add(2 * time.Hour)})
+	require.NoError(t, k.EndBlocker(ctx))
+	ids, err = k.ActionKeeper.TimeDependentActions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestEndBlockerStopsReevaluatingFailingActions(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+	createdAt := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: createdAt})
+
+	approve, err := shield.Parse("warden1owner")
+	require.NoError(t, err)
+	brokenReject, err := shield.Parse(`action.age > "soon"`)
+	require.NoError(t, err)
+
+	id, err := k.ActionKeeper.New(ctx, &types.Action{
+		Status:            types.ActionStatus_ACTION_STATUS_PENDING,
+		CreatedAt:         createdAt,
+		ApproveExpression: *approve,
+		RejectExpression:  *brokenReject,
+	})
+	require.NoError(t, err)
+
+	// the evaluation error is stored and the action leaves the time
+	// dependent index, instead of being evaluated again at every block
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, Time: createdAt.Add(time.Hour)})
+	require.NoError(t, k.EndBlocker(ctx))
+
+	act, err := k.ActionKeeper.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+	require.NotEmpty(t, act.EvaluationError)
+
+	ids, err := k.ActionKeeper.TimeDependentActions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestEndBlockerSurvivesDivisionByZero(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+	createdAt := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: createdAt})
+
+	approve, err := shield.Parse("warden1owner")
+	require.NoError(t, err)
+	reject, err := shield.Parse("1 / 0 > block.height")
+	require.NoError(t, err)
+
+	id, err := k.ActionKeeper.New(ctx, &types.Action{
+		Status:            types.ActionStatus_ACTION_STATUS_PENDING,
+		CreatedAt:         createdAt,
+		ApproveExpression: *approve,
+		RejectExpression:  *reject,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, Time: createdAt.Add(time.Second)})
+	require.NoError(t, k.EndBlocker(ctx))
+
+	act, err := k.ActionKeeper.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+	require.Contains(t, act.EvaluationError, "division by zero")
+}
+
+func TestEndBlockerLimitsTimeDependentReevaluations(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+	createdAt := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: createdAt})
+
+	approve, err := shield.Parse("warden1owner")
+	require.NoError(t, err)
+	reject, err := shield.Parse("action.age > 3600")
+	require.NoError(t, err)
+
+	total := types.MaxTimeDependentReevaluations + 5
+	for range total {
+		_, err := k.ActionKeeper.New(ctx, &types.Action{
+			Status:            types.ActionStatus_ACTION_STATUS_PENDING,
+			CreatedAt:         createdAt,
+			ApproveExpression: *approve,
+			RejectExpression:  *reject,
+		})
+		require.NoError(t, err)
+	}
+
+	countRevoked := func() int {
+		revoked := 0
+		for id := range uint64(total) {
+			act, err := k.ActionKeeper.Get(ctx, id+1)
+			require.NoError(t, err)
+			if act.Status == types.ActionStatus_ACTION_STATUS_REVOKED {
+				revoked++
+			}
+		}
+		return revoked
+	}
+
+	// the first actions are re-evaluated in this block, the others in the
+	// next one
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, Time: createdAt.Add(2 * time.Hour)})
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.MaxTimeDependentReevaluations, countRevoked())
+
+	ctx = ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3, Time: createdAt.Add(2 * time.Hour)})
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, total, countRevoked())
+}
+
+func TestNextTimeDependentActions(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+	createdAt := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: createdAt})
+
+	approve, err := shield.Parse("warden1owner")
+	require.NoError(t, err)
+	reject, err := shield.Parse("action.age > 3600")
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err := k.ActionKeeper.New(ctx, &types.Action{
+			Status:            types.ActionStatus_ACTION_STATUS_PENDING,
+			CreatedAt:         createdAt,
+			ApproveExpression: *approve,
+			RejectExpression:  *reject,
+		})
+		require.NoError(t, err)
+	}
+
+	// the ids are returned in turn, wrapping around without duplicates
+	for _, expected := range [][]uint64{{1, 2}, {3, 1}, {2, 3}, {1, 2}} {
+		ids, err := k.ActionKeeper.NextTimeDependentActions(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, expected, ids)
+	}
+
+	ids, err := k.ActionKeeper.NextTimeDependentActions(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 1, 2}, ids)
+}
@@ -3,13 +3,16 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"reflect"
 	"runtime/debug"
+	"slices"
+	"time"
 
 	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/warden-protocol/wardenprotocol/shield"
 	"github.com/warden-protocol/wardenprotocol/shield/ast"
 	"github.com/warden-protocol/wardenprotocol/shield/object"
 	"github.com/warden-protocol/wardenprotocol/warden/x/act/cosmoshield"
@@ -43,20 +46,44 @@ func (votes ActionRejectedVotesEnv) Get(name string) (object.Object, bool) {
 }
 
 // TryExecuteVotedAction checks if the action's expression is satisfied and stores the
-// result in the database.
+// result in the database. Actions with an ExecutionDelay are queued instead,
+// and executed by the EndBlocker after the delay.
 func (k Keeper) TryExecuteVotedAction(ctx context.Context, act *types.Action) error {
-	actExpression := types.ActExpression(act.ApproveExpression)
-	approved, err := actExpression.EvalExpression(ctx, ActionApprovedVotesEnv(act.Votes))
+	if act.Status != types.ActionStatus_ACTION_STATUS_PENDING {
+		return nil
+	}
+
+	votes, err := k.actionVotes(ctx, act)
+	if err != nil {
+		return err
+	}
+
+	approved, err := act.EvalApprove(ctx, NewActionContextEnv(ctx, act, ActionApprovedVotesEnv(votes)))
 
 	if err != nil {
 		return err
 	}
 
-	if approved {
-		return k.executeAction(ctx, act)
+	if !approved {
+		return nil
 	}
 
-	return nil
+	if act.ExecutionDelay > 0 {
+		return k.queueAction(ctx, act)
+	}
+
+	return k.executeAction(ctx, act)
+}
+
+// queueAction sets act to ACTION_STATUS_QUEUED and adds it to the queue of
+// the actions executed by the EndBlocker.
+func (k Keeper) queueAction(ctx context.Context, act *types.Action) error {
+	prev := *act
+	if err := act.Queue(sdk.UnwrapSDKContext(ctx)); err != nil {
+		return err
+	}
+
+	return k.ActionKeeper.reindex(ctx, prev, *act)
 }
 
 // TryRejectVotedAction checks if the action's reject expression is satisfied and updates its
@@ -64,8 +91,12 @@ func (k Keeper) TryExecuteVotedAction(ctx context.Context, act *types.Action) er
 func (k Keeper) TryRejectVotedAction(ctx context.Context, act *types.Action) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	actExpression := types.ActExpression(act.RejectExpression)
-	rejected, err := actExpression.EvalExpression(ctx, ActionRejectedVotesEnv(act.Votes))
+	votes, err := k.actionVotes(ctx, act)
+	if err != nil {
+		return err
+	}
+
+	rejected, err := act.EvalReject(ctx, NewActionContextEnv(ctx, act, ActionRejectedVotesEnv(votes)))
 
 	if err != nil {
 		return err
@@ -83,42 +114,65 @@ func (k Keeper) TryRejectVotedAction(ctx context.Context, act *types.Action) err
 	return nil
 }
 
+// executeAction executes the messages of act in order, in a single cache
+// context: if any of them fails, none of them is persisted and act is set to
+// ACTION_STATUS_FAILED, with the error returned by the message.
 func (k Keeper) executeAction(ctx context.Context, act *types.Action) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	cacheCtx, writeCache := prepareHandlerContext(sdkCtx, act.Creator)
+	cacheCtx, writeCache := prepareHandlerContext(ctxWithExecutingAction(sdkCtx, act.Id), act.Creator)
 
-	var msg sdk.Msg
-	err := k.cdc.UnpackAny(act.Msg, &msg)
-	if err != nil {
-		return errors.Wrapf(types.ErrInvalidActionMsg, "unpacking Msg: %v", err)
+	msgs := act.Messages()
+	if len(msgs) == 0 {
+		return errors.Wrapf(types.ErrInvalidActionMsg, "action %d has no messages", act.Id)
 	}
 
-	handler := k.router.Handler(msg)
-	if handler == nil {
-		return errors.Wrapf(types.ErrNoActionMsgHandler, sdk.MsgTypeURL(msg))
-	}
+	var events sdk.Events
+	results := make([]*codectypes.Any, 0, len(msgs))
+	for i, wrappedMsg := range msgs {
+		var msg sdk.Msg
+		err := k.cdc.UnpackAny(wrappedMsg, &msg)
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidActionMsg, "unpacking Msg: %v", err)
+		}
 
-	var res *sdk.Result
-	res, err = safeExecuteHandler(cacheCtx, msg, handler)
-	if err != nil {
-		// set action failed
-		sdkCtx.Logger().Error("action execution failed", "action_id", act.Id, "err", err)
-		if err := act.SetStatus(sdkCtx, types.ActionStatus_ACTION_STATUS_REVOKED); err != nil {
-			return err
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return errors.Wrapf(types.ErrNoActionMsgHandler, sdk.MsgTypeURL(msg))
 		}
-		if err := k.ActionKeeper.Set(ctx, *act); err != nil {
-			return err
+
+		res, err := safeExecuteHandler(cacheCtx, msg, handler)
+		if err != nil {
+			// set action failed
+			sdkCtx.Logger().Error("action execution failed", "action_id", act.Id, "msg_index", i, "err", err)
+			if err := act.SetFailed(sdkCtx, err); err != nil {
+				return err
+			}
+			if err := k.ActionKeeper.Set(ctx, *act); err != nil {
+				return err
+			}
+			return nil
 		}
-		return nil
+
+		events = append(events, res.GetEvents()...)
+		results = append(results, res.MsgResponses[0])
 	}
 
-	// persist message execution
+	// persist messages execution
 	writeCache()
 
-	// propagate the msg events to the current context
-	sdkCtx.EventManager().EmitEvents(res.GetEvents())
+	// propagate the msgs events to the current context
+	sdkCtx.EventManager().EmitEvents(events)
+
+	result := results[0]
+	if len(results) > 1 {
+		var err error
+		result, err = codectypes.NewAnyWithValue(&types.ActionResults{Results: results})
+		if err != nil {
+			return err
+		}
+	}
 
-	if err := act.SetResult(sdkCtx, res.MsgResponses[0]); err != nil {
+	if err := act.SetResult(sdkCtx, result); err != nil {
 		return err
 	}
 
@@ -162,58 +216,136 @@ func (k Keeper) GetActionCreator(ctx context.Context) string {
 
 type actionCreatorKey struct{}
 
-// AddAction creates a new action.
+// ctxWithExecutingAction adds id to the Actions whose messages are being
+// executed in ctx.
+func ctxWithExecutingAction(ctx sdk.Context, id uint64) sdk.Context {
+	ids, _ := ctx.Value(executingActionsKey{}).([]uint64)
+	return ctx.WithValue(executingActionsKey{}, append(slices.Clone(ids), id))
+}
+
+// isExecutingAction reports whether the messages of the Action id are being
+// executed in ctx.
+func isExecutingAction(ctx context.Context, id uint64) bool {
+	ids, _ := ctx.Value(executingActionsKey{}).([]uint64)
+	return slices.Contains(ids, id)
+}
+
+type executingActionsKey struct{}
+
+// actionMsg is a message of a new Action, with the templates returned for it
+// by the templates registry and the context to preprocess them in.
+type actionMsg struct {
+	ctx             context.Context
+	msg             sdk.Msg
+	approveTemplate types.Template
+	rejectTemplate  types.Template
+}
+
+// AddAction creates a new action executing msgs, in order and atomically.
+// The templates of a multi-message action are derived from the templates of
+// its messages: it's approved when all of their approve templates are
+// satisfied, and rejected when any of their reject templates is. The
+// execution delay of the action is the longest of its approve templates.
 // The action is created with the provided creator as the first approver.
 // This function also tries to execute the action immediately if it's ready.
-func (k Keeper) AddAction(ctx context.Context, creator string, msg sdk.Msg, timeoutHeight uint64, expectedApproveExpression *ast.Expression, expectedRejectExpression *ast.Expression) (*types.Action, error) {
-	if err := k.validateActionMsgSigners(msg); err != nil {
-		return nil, err
+func (k Keeper) AddAction(ctx context.Context, creator string, msgs []sdk.Msg, timeoutHeight uint64, expectedApproveExpression *ast.Expression, expectedRejectExpression *ast.Expression) (*types.Action, error) {
+	if len(msgs) == 0 {
+		return nil, errors.Wrapf(types.ErrInvalidActionMsgs, "no messages")
 	}
 
 	ctx = ctxWithActionCreator(sdk.UnwrapSDKContext(ctx), creator)
-	ctx, approveTemplate, rejectTemplate, err := k.templatesRegistry.Get(ctx, msg)
-	if err != nil {
-		return nil, errors.Wrapf(types.ErrNoTemplateRegistryHandler, "%v", err)
+
+	var executionDelay time.Duration
+	actionMsgs := make([]actionMsg, 0, len(msgs))
+	approveTemplates := make([]*ast.Expression, 0, len(msgs))
+	rejectTemplates := make([]*ast.Expression, 0, len(msgs))
+	approveRefs := make([]types.TemplateRef, 0, len(msgs))
+	rejectRefs := make([]types.TemplateRef, 0, len(msgs))
+	var spaceIDs []uint64
+	for _, msg := range msgs {
+		if err := k.validateActionMsgSigners(msg); err != nil {
+			return nil, err
+		}
+
+		msgCtx, approveTemplate, rejectTemplate, err := k.msgTemplates(ctx, msg)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrNoTemplateRegistryHandler, "%v", err)
+		}
+
+		actionMsgs = append(actionMsgs, actionMsg{
+			ctx:             msgCtx,
+			msg:             msg,
+			approveTemplate: approveTemplate,
+			rejectTemplate:  rejectTemplate,
+		})
+		approveTemplates = append(approveTemplates, approveTemplate.Expression)
+		rejectTemplates = append(rejectTemplates, rejectTemplate.Expression)
+		approveRefs = append(approveRefs, approveTemplate.Ref())
+		rejectRefs = append(rejectRefs, rejectTemplate.Ref())
+		if spaceID, ok := types.SpaceIDFromContext(msgCtx); ok && !slices.Contains(spaceIDs, spaceID) {
+			spaceIDs = append(spaceIDs, spaceID)
+		}
+		executionDelay = max(executionDelay, approveTemplate.ExecutionDelay)
 	}
 
-	if !reflect.DeepEqual(approveTemplate.Expression, expectedApproveExpression) {
+	if !sameExpression(combineExpressions("&&", approveTemplates), expectedApproveExpression) {
 		return nil, types.ErrApproveExpressionNotMatched
 	}
 
-	if !reflect.DeepEqual(rejectTemplate.Expression, expectedRejectExpression) {
+	if !sameExpression(combineExpressions("||", rejectTemplates), expectedRejectExpression) {
 		return nil, types.ErrRejectExpressionNotMatched
 	}
 
-	wrappedMsg, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return nil, err
-	}
+	wrappedMsgs := make([]*codectypes.Any, 0, len(msgs))
+	approves := make([]preprocessedTemplate, 0, len(msgs))
+	rejects := make([]preprocessedTemplate, 0, len(msgs))
+	for _, m := range actionMsgs {
+		wrappedMsg, err := codectypes.NewAnyWithValue(m.msg)
+		if err != nil {
+			return nil, err
+		}
 
-	ctxWithMsg := cosmoshield.NewContext(ctx, msg)
-	preprocessedApproveExpr, approveMentions, err := k.preprocessTemplate(ctxWithMsg, approveTemplate)
-	if err != nil {
-		return nil, err
-	}
+		ctxWithMsg := k.expansionContext(m.ctx, m.msg)
+		approve, err := k.preprocessTemplate(ctxWithMsg, m.approveTemplate)
+		if err != nil {
+			return nil, err
+		}
 
-	preprocessedRejectExpr, rejectMentions, err := k.preprocessTemplate(ctxWithMsg, rejectTemplate)
-	if err != nil {
-		return nil, err
-	}
+		reject, err := k.preprocessTemplate(ctxWithMsg, m.rejectTemplate)
+		if err != nil {
+			return nil, err
+		}
 
-	mentions := mergeMentions(approveMentions, rejectMentions)
+		wrappedMsgs = append(wrappedMsgs, wrappedMsg)
+		approves = append(approves, approve)
+		rejects = append(rejects, reject)
+	}
 
 	// create action object
 	timestamp := k.getBlockTime(ctx)
 	act := &types.Action{
-		Status:            types.ActionStatus_ACTION_STATUS_PENDING,
-		Mentions:          mentions,
-		Msg:               wrappedMsg,
-		Creator:           creator,
-		TimeoutHeight:     timeoutHeight,
-		CreatedAt:         timestamp,
-		UpdatedAt:         timestamp,
-		ApproveExpression: *preprocessedApproveExpr,
-		RejectExpression:  *preprocessedRejectExpr,
+		Status:         types.ActionStatus_ACTION_STATUS_PENDING,
+		Creator:        creator,
+		TimeoutHeight:  timeoutHeight,
+		CreatedAt:      timestamp,
+		UpdatedAt:      timestamp,
+		ExecutionDelay: executionDelay,
+
+		ApproveTemplates: approveRefs,
+		RejectTemplates:  rejectRefs,
+		SpaceIds:         spaceIDs,
+	}
+
+	if len(wrappedMsgs) == 1 {
+		act.Msg = wrappedMsgs[0]
+	} else {
+		act.Msgs = wrappedMsgs
+	}
+
+	setActionExpressions(act, approves, rejects, k.GetParams(ctx).SimplifyExpressions)
+
+	if err := act.Compile(); err != nil {
+		return nil, err
 	}
 
 	// add initial approver
@@ -235,6 +367,64 @@ func (k Keeper) AddAction(ctx context.Context, creator string, msg sdk.Msg, time
 	return act, nil
 }
 
+// setActionExpressions sets the expressions, mentions and dependencies of act
+// from the preprocessed templates of its messages. The expressions of a
+// multi-message action are the ones of its messages, combined with `&&`
+// (approve) and `||` (reject), and simplified if simplify is true.
+func setActionExpressions(act *types.Action, approves, rejects []preprocessedTemplate, simplify bool) {
+	var (
+		mentions []string
+		deps     cosmoshield.Dependencies
+	)
+	for i := range approves {
+		mentions = mergeMentions(mentions, mergeMentions(approves[i].Mentions, rejects[i].Mentions))
+		deps.Add(approves[i].Dependencies...)
+		deps.Add(rejects[i].Dependencies...)
+	}
+	act.Mentions = mentions
+	act.Dependencies = deps.List()
+
+	if len(approves) == 1 {
+		act.ApproveExpression = *approves[0].Expression
+		act.RejectExpression = *rejects[0].Expression
+		act.ApproveTemplateExpression = approves[0].TemplateExpression
+		act.RejectTemplateExpression = rejects[0].TemplateExpression
+		act.MsgExpressions = nil
+		return
+	}
+
+	approveExprs := make([]*ast.Expression, 0, len(approves))
+	rejectExprs := make([]*ast.Expression, 0, len(rejects))
+	act.MsgExpressions = make([]types.ActionMsgExpressions, 0, len(approves))
+	for i := range approves {
+		act.MsgExpressions = append(act.MsgExpressions, types.ActionMsgExpressions{
+			ApproveExpression:         *approves[i].Expression,
+			RejectExpression:          *rejects[i].Expression,
+			ApproveTemplateExpression: approves[i].TemplateExpression,
+			RejectTemplateExpression:  rejects[i].TemplateExpression,
+		})
+		approveExprs = append(approveExprs, proto.Clone(approves[i].Expression).(*ast.Expression))
+		rejectExprs = append(rejectExprs, proto.Clone(rejects[i].Expression).(*ast.Expression))
+	}
+
+	act.ApproveExpression = *combineExpressions("&&", approveExprs)
+	act.RejectExpression = *combineExpressions("||", rejectExprs)
+	if simplify {
+		act.ApproveExpression = *shield.Simplify(&act.ApproveExpression)
+		act.RejectExpression = *shield.Simplify(&act.RejectExpression)
+	}
+	act.ApproveTemplateExpression = nil
+	act.RejectTemplateExpression = nil
+}
+
+// expansionContext returns the context used to preprocess the expressions of
+// an Action wrapping msg.
+func (k Keeper) expansionContext(ctx context.Context, msg sdk.Msg) cosmoshield.Context {
+	return cosmoshield.NewContext(ctx, msg).WithPreprocess(func(ctx context.Context, expr *ast.Expression) (*ast.Expression, error) {
+		return k.preprocessExpression(ctx, expr, nil)
+	})
+}
+
 // assert that the x/act module account is the only signer of the message
 func (k Keeper) validateActionMsgSigners(msg sdk.Msg) error {
 	signers, _, err := k.cdc.GetMsgV1Signers(msg)
@@ -19,6 +19,44 @@ type ActionKeeper struct {
 	actions                  repo.SeqCollection[types.Action]
 	actionByAddress          collections.Map[collections.Pair[sdk.AccAddress, uint64], uint64]
 	previousPruneBlockHeight collections.Item[int64]
+
+	// timeDependentActions contains the ids of the open actions (see
+	// Action.IsOpen) whose expressions depend on the block context, see
+	// ActionContextEnv.
+	timeDependentActions collections.KeySet[uint64]
+
+	// timeDependentCursor is the id of the last time dependent action
+	// re-evaluated, see NextTimeDependentActions.
+	timeDependentCursor collections.Item[uint64]
+
+	// actionsByDependency indexes the open actions by the dependencies of
+	// their expressions, see DependencyChanged.
+	actionsByDependency collections.KeySet[collections.Pair[string, uint64]]
+
+	// changedActions contains the ids of the open actions whose expressions
+	// changed and that need to be re-evaluated at the end of the block.
+	changedActions collections.KeySet[uint64]
+
+	// actionsByTimeoutHeight indexes the pending actions by their
+	// TimeoutHeight, see TimedOutActions.
+	actionsByTimeoutHeight collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// actionsByStatus indexes the actions by status and UpdatedAt, see
+	// ExpiredActions.
+	actionsByStatus collections.KeySet[collections.Triple[int32, time.Time, uint64]]
+
+	// actionsByCreator and actionsByMsgType index the actions by creator and
+	// by the type URL of their message.
+	actionsByCreator collections.KeySet[collections.Pair[string, uint64]]
+	actionsByMsgType collections.KeySet[collections.Pair[string, uint64]]
+
+	// actionsByExecuteAfter indexes the queued actions by the time after
+	// which they are executed, see QueuedActions.
+	actionsByExecuteAfter collections.KeySet[collections.Pair[time.Time, uint64]]
+
+	// signedVotes contains the (action id, participant, vote type) of the
+	// signed votes already submitted, see UseSignedVote.
+	signedVotes collections.KeySet[collections.Triple[uint64, string, int32]]
 }
 
 func newActionKeeper(storeService store.KVStoreService, cdc codec.BinaryCodec) ActionKeeper {
@@ -41,6 +79,76 @@ func newActionKeeper(storeService store.KVStoreService, cdc codec.BinaryCodec) A
 		collections.Int64Value,
 	)
 
+	timeDependentActions := collections.NewKeySet(
+		sb,
+		TimeDependentActionPrefix,
+		"time_dependent_actions",
+		collections.Uint64Key,
+	)
+
+	timeDependentCursor := collections.NewItem(
+		sb,
+		TimeDependentCursorPrefix,
+		"time_dependent_cursor",
+		collections.Uint64Value,
+	)
+
+	actionsByDependency := collections.NewKeySet(
+		sb,
+		ActionByDependencyPrefix,
+		"actions_by_dependency",
+		collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+	)
+
+	changedActions := collections.NewKeySet(
+		sb,
+		ChangedActionPrefix,
+		"changed_actions",
+		collections.Uint64Key,
+	)
+
+	actionsByTimeoutHeight := collections.NewKeySet(
+		sb,
+		ActionByTimeoutHeightPrefix,
+		"actions_by_timeout_height",
+		collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+	)
+
+	actionsByStatus := collections.NewKeySet(
+		sb,
+		ActionByStatusPrefix,
+		"actions_by_status",
+		collections.TripleKeyCodec(collections.Int32Key, sdk.TimeKey, collections.Uint64Key),
+	)
+
+	actionsByCreator := collections.NewKeySet(
+		sb,
+		ActionByCreatorPrefix,
+		"actions_by_creator",
+		collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+	)
+
+	actionsByMsgType := collections.NewKeySet(
+		sb,
+		ActionByMsgTypePrefix,
+		"actions_by_msg_type",
+		collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+	)
+
+	actionsByExecuteAfter := collections.NewKeySet(
+		sb,
+		ActionByExecuteAfterPrefix,
+		"actions_by_execute_after",
+		collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+	)
+
+	signedVotes := collections.NewKeySet(
+		sb,
+		SignedVotePrefix,
+		"signed_votes",
+		collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Int32Key),
+	)
+
 	_, err := sb.Build()
 	if err != nil {
 		panic(fmt.Sprintf("failed to build schema: %s", err))
@@ -50,6 +158,16 @@ func newActionKeeper(storeService store.KVStoreService, cdc codec.BinaryCodec) A
 		actions:                  actions,
 		actionByAddress:          actionByAddress,
 		previousPruneBlockHeight: latestPrunedBlock,
+		timeDependentActions:     timeDependentActions,
+		timeDependentCursor:      timeDependentCursor,
+		actionsByDependency:      actionsByDependency,
+		changedActions:           changedActions,
+		actionsByTimeoutHeight:   actionsByTimeoutHeight,
+		actionsByStatus:          actionsByStatus,
+		actionsByCreator:         actionsByCreator,
+		actionsByMsgType:         actionsByMsgType,
+		actionsByExecuteAfter:    actionsByExecuteAfter,
+		signedVotes:              signedVotes,
 	}
 }
 
@@ -57,7 +175,22 @@ func (k ActionKeeper) Get(ctx context.Context, id uint64) (types.Action, error)
 	return k.actions.Get(ctx, id)
 }
 
+// Set stores action, updating its status index.
 func (k ActionKeeper) Set(ctx context.Context, action types.Action) error {
+	prev, err := k.actions.Get(ctx, action.Id)
+	switch {
+	case err == nil:
+		if err := k.actionsByStatus.Remove(ctx, statusKey(prev)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.actionsByStatus.Set(ctx, statusKey(action)); err != nil {
+		return err
+	}
+
 	return k.actions.Set(ctx, action.Id, action)
 }
 
@@ -67,14 +200,165 @@ func (k ActionKeeper) New(ctx context.Context, action *types.Action) (uint64, er
 		return 0, err
 	}
 
-	err = k.updateMentions(ctx, action, id)
-	if err != nil {
+	if err := k.index(ctx, *action); err != nil {
 		return 0, err
 	}
 
 	return id, nil
 }
 
+// index adds action to all the indexes.
+func (k ActionKeeper) index(ctx context.Context, action types.Action) error {
+	if err := k.updateMentions(ctx, &action, action.Id); err != nil {
+		return err
+	}
+
+	if err := k.updateTimeDependent(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.updateDependencies(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.updateTimeoutHeight(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.updateExecuteAfter(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.actionsByStatus.Set(ctx, statusKey(action)); err != nil {
+		return err
+	}
+
+	if err := k.actionsByCreator.Set(ctx, collections.Join(action.Creator, action.Id)); err != nil {
+		return err
+	}
+
+	for _, msg := range action.Messages() {
+		if err := k.actionsByMsgType.Set(ctx, collections.Join(msg.TypeUrl, action.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func statusKey(action types.Action) collections.Triple[int32, time.Time, uint64] {
+	return collections.Join3(int32(action.Status), action.UpdatedAt, action.Id)
+}
+
+func (k *ActionKeeper) updateTimeDependent(ctx context.Context, action types.Action) error {
+	if !action.IsOpen() || action.EvaluationError != "" {
+		return nil
+	}
+
+	timeDependent, err := isTimeDependent(action)
+	if err != nil {
+		return err
+	}
+
+	if !timeDependent {
+		return nil
+	}
+
+	return k.timeDependentActions.Set(ctx, action.Id)
+}
+
+func (k *ActionKeeper) updateDependencies(ctx context.Context, action types.Action) error {
+	if !action.IsOpen() {
+		return nil
+	}
+
+	for _, dep := range action.Dependencies {
+		if err := k.actionsByDependency.Set(ctx, collections.Join(dep, action.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *ActionKeeper) updateTimeoutHeight(ctx context.Context, action types.Action) error {
+	if action.Status != types.ActionStatus_ACTION_STATUS_PENDING || action.TimeoutHeight == 0 {
+		return nil
+	}
+
+	return k.actionsByTimeoutHeight.Set(ctx, collections.Join(action.TimeoutHeight, action.Id))
+}
+
+func (k *ActionKeeper) updateExecuteAfter(ctx context.Context, action types.Action) error {
+	if action.Status != types.ActionStatus_ACTION_STATUS_QUEUED || action.ExecuteAfter == nil {
+		return nil
+	}
+
+	return k.actionsByExecuteAfter.Set(ctx, collections.Join(*action.ExecuteAfter, action.Id))
+}
+
+// reindex updates the indexes of action, whose previous version was prev,
+// and stores it.
+func (k ActionKeeper) reindex(ctx context.Context, prev, action types.Action) error {
+	if err := k.removeIndexes(ctx, prev); err != nil {
+		return err
+	}
+
+	if err := k.updateMentions(ctx, &action, action.Id); err != nil {
+		return err
+	}
+
+	if err := k.updateTimeDependent(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.updateDependencies(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.updateTimeoutHeight(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.updateExecuteAfter(ctx, action); err != nil {
+		return err
+	}
+
+	return k.Set(ctx, action)
+}
+
+func (k ActionKeeper) removeIndexes(ctx context.Context, action types.Action) error {
+	for _, addr := range action.Mentions {
+		key := collections.Join(sdk.MustAccAddressFromBech32(addr), action.Id)
+		if err := k.actionByAddress.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	if err := k.timeDependentActions.Remove(ctx, action.Id); err != nil {
+		return err
+	}
+
+	for _, dep := range action.Dependencies {
+		if err := k.actionsByDependency.Remove(ctx, collections.Join(dep, action.Id)); err != nil {
+			return err
+		}
+	}
+
+	if action.TimeoutHeight > 0 {
+		if err := k.actionsByTimeoutHeight.Remove(ctx, collections.Join(action.TimeoutHeight, action.Id)); err != nil {
+			return err
+		}
+	}
+
+	if action.ExecuteAfter != nil {
+		if err := k.actionsByExecuteAfter.Remove(ctx, collections.Join(*action.ExecuteAfter, action.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (k *ActionKeeper) updateMentions(ctx context.Context, action *types.Action, id uint64) error {
 	for _, addr := range action.Mentions {
 		key := collections.Join(sdk.MustAccAddressFromBech32(addr), id)
@@ -99,7 +383,7 @@ func (k ActionKeeper) Import(ctx sdk.Context, actions []types.Action) error {
 	}
 
 	for _, action := range actions {
-		if err := k.updateMentions(ctx, &action, action.Id); err != nil {
+		if err := k.index(ctx, action); err != nil {
 			return err
 		}
 	}
@@ -116,13 +400,32 @@ func (k ActionKeeper) pruneAction(ctx context.Context, action types.Action, bloc
 		return err
 	}
 
-	for _, addr := range action.Mentions {
-		key := collections.Join(sdk.MustAccAddressFromBech32(addr), action.Id)
-		if err := k.actionByAddress.Remove(ctx, key); err != nil {
+	if err := k.removeIndexes(ctx, action); err != nil {
+		return err
+	}
+
+	if err := k.changedActions.Remove(ctx, action.Id); err != nil {
+		return err
+	}
+
+	if err := k.actionsByStatus.Remove(ctx, statusKey(action)); err != nil {
+		return err
+	}
+
+	if err := k.actionsByCreator.Remove(ctx, collections.Join(action.Creator, action.Id)); err != nil {
+		return err
+	}
+
+	for _, msg := range action.Messages() {
+		if err := k.actionsByMsgType.Remove(ctx, collections.Join(msg.TypeUrl, action.Id)); err != nil {
 			return err
 		}
 	}
 
+	if err := k.signedVotes.Clear(ctx, collections.NewPrefixedTripleRange[uint64, string, int32](action.Id)); err != nil {
+		return err
+	}
+
 	if err := k.previousPruneBlockHeight.Set(ctx, blockHeight); err != nil {
 		return err
 	}
@@ -136,6 +439,219 @@ func (k ActionKeeper) pruneAction(ctx context.Context, action types.Action, bloc
 	return nil
 }
 
+// TimeDependentActions returns the ids of the open actions that need to be
+// re-evaluated at every block.
+func (k ActionKeeper) TimeDependentActions(ctx context.Context) ([]uint64, error) {
+	it, err := k.timeDependentActions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	return it.Keys()
+}
+
+// NextTimeDependentActions returns the ids of at most limit time dependent
+// actions, starting after the last id returned by the previous call and
+// wrapping around, so that every action is returned in turn.
+func (k ActionKeeper) NextTimeDependentActions(ctx context.Context, limit int) ([]uint64, error) {
+	cursor, err := k.timeDependentCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	ids, err := k.timeDependentRange(ctx, new(collections.Range[uint64]).StartExclusive(cursor), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) < limit && cursor > 0 {
+		wrapped, err := k.timeDependentRange(ctx, new(collections.Range[uint64]).EndInclusive(cursor), limit-len(ids))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, wrapped...)
+	}
+
+	if len(ids) == 0 {
+		return nil, k.timeDependentCursor.Remove(ctx)
+	}
+
+	return ids, k.timeDependentCursor.Set(ctx, ids[len(ids)-1])
+}
+
+// timeDependentRange returns the ids of at most limit time dependent actions
+// in rng.
+func (k ActionKeeper) timeDependentRange(ctx context.Context, rng collections.Ranger[uint64], limit int) ([]uint64, error) {
+	it, err := k.timeDependentActions.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var ids []uint64
+	for ; it.Valid() && len(ids) < limit; it.Next() {
+		id, err := it.Key()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (k ActionKeeper) removeTimeDependent(ctx context.Context, id uint64) error {
+	return k.timeDependentActions.Remove(ctx, id)
+}
+
+// ActionsByDependency returns the ids of the open actions whose expressions
+// depend on dependency.
+func (k ActionKeeper) ActionsByDependency(ctx context.Context, dependency string) ([]uint64, error) {
+	rng := collections.NewPrefixedPairRange[string, uint64](dependency)
+	it, err := k.actionsByDependency.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.K2())
+	}
+	return ids, nil
+}
+
+func (k ActionKeeper) removeDependency(ctx context.Context, dependency string, id uint64) error {
+	return k.actionsByDependency.Remove(ctx, collections.Join(dependency, id))
+}
+
+// ChangedActions returns the ids of the open actions whose expressions
+// changed since the last block and that need to be re-evaluated.
+func (k ActionKeeper) ChangedActions(ctx context.Context) ([]uint64, error) {
+	it, err := k.changedActions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	return it.Keys()
+}
+
+func (k ActionKeeper) setChanged(ctx context.Context, id uint64) error {
+	return k.changedActions.Set(ctx, id)
+}
+
+// setChangedByAddress marks the open actions mentioning addr as changed, so
+// that they are re-evaluated at the end of the block.
+func (k ActionKeeper) setChangedByAddress(ctx context.Context, addr string) error {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](accAddr)
+	it, err := k.actionByAddress.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	ids, err := it.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		act, err := k.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if !act.IsOpen() {
+			continue
+		}
+		if err := k.setChanged(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k ActionKeeper) removeChanged(ctx context.Context, id uint64) error {
+	return k.changedActions.Remove(ctx, id)
+}
+
+// TimedOutActions returns the ids of the pending actions whose TimeoutHeight
+// is lower than blockHeight.
+func (k ActionKeeper) TimedOutActions(ctx context.Context, blockHeight uint64) ([]uint64, error) {
+	if blockHeight == 0 {
+		return nil, nil
+	}
+
+	rng := collections.NewPrefixUntilPairRange[uint64, uint64](blockHeight - 1)
+	it, err := k.actionsByTimeoutHeight.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.K2())
+	}
+	return ids, nil
+}
+
+// QueuedActions returns the ids of the queued actions whose ExecuteAfter is
+// not after blockTime.
+func (k ActionKeeper) QueuedActions(ctx context.Context, blockTime time.Time) ([]uint64, error) {
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](blockTime)
+	it, err := k.actionsByExecuteAfter.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.K2())
+	}
+	return ids, nil
+}
+
+// UseSignedVote records that the signed vote of participant for the action
+// has been submitted. It returns ErrSignedVoteReplayed if it was already
+// submitted, so that a signed vote can only be counted once, even if the
+// participant changed their vote in the meantime.
+func (k ActionKeeper) UseSignedVote(ctx context.Context, actionID uint64, participant string, voteType types.ActionVoteType) error {
+	key := collections.Join3(actionID, participant, int32(voteType))
+	used, err := k.signedVotes.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if used {
+		return fmt.Errorf("%w: %s for action %d by %s", types.ErrSignedVoteReplayed, voteType, actionID, participant)
+	}
+	return k.signedVotes.Set(ctx, key)
+}
+
 func (k ActionKeeper) GetLatestPruneHeight(ctx context.Context) (int64, error) {
 	h, err := k.previousPruneBlockHeight.Get(ctx)
 	if errors.Is(err, collections.ErrNotFound) {
@@ -144,42 +660,67 @@ func (k ActionKeeper) GetLatestPruneHeight(ctx context.Context) (int64, error) {
 	return h, err
 }
 
+// ExpiredActions returns the actions that can be pruned: the actions in
+// ACTION_STATUS_TIMEOUT, the pending actions not updated for pendingTimeout
+// and the completed, revoked or failed actions not updated for
+// completedTimeout.
 func (k ActionKeeper) ExpiredActions(ctx context.Context, blockTime time.Time, pendingTimeout, completedTimeout time.Duration) ([]types.Action, error) {
-	it, err := k.Coll().Iterate(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer it.Close()
-
 	var actions []types.Action
-	for ; it.Valid(); it.Next() {
-		act, err := it.Value()
+	for _, r := range []struct {
+		status types.ActionStatus
+		// actions updated before are expired, zero for all actions
+		before time.Time
+	}{
+		{types.ActionStatus_ACTION_STATUS_PENDING, blockTime.Add(-pendingTimeout)},
+		{types.ActionStatus_ACTION_STATUS_COMPLETED, blockTime.Add(-completedTimeout)},
+		{types.ActionStatus_ACTION_STATUS_REVOKED, blockTime.Add(-completedTimeout)},
+		{types.ActionStatus_ACTION_STATUS_FAILED, blockTime.Add(-completedTimeout)},
+		{types.ActionStatus_ACTION_STATUS_TIMEOUT, time.Time{}},
+	} {
+		expired, err := k.actionsByStatusRange(ctx, r.status, time.Time{}, r.before)
 		if err != nil {
 			return nil, err
 		}
-		if isExpired(act, blockTime, pendingTimeout, completedTimeout) {
-			actions = append(actions, act)
-		}
+		actions = append(actions, expired...)
 	}
 
 	return actions, nil
 }
 
-func isExpired(action types.Action, blockTime time.Time, pendingTimeout, completedTimeout time.Duration) bool {
-	if action.Status == types.ActionStatus_ACTION_STATUS_TIMEOUT {
-		return true
+// actionsByStatusRange returns the actions in status updated in [after,
+// before). Zero times leave the range open.
+func (k ActionKeeper) actionsByStatusRange(ctx context.Context, status types.ActionStatus, after, before time.Time) ([]types.Action, error) {
+	it, err := k.actionsByStatus.Iterate(ctx, statusRange(status, after, before))
+	if err != nil {
+		return nil, err
 	}
+	defer it.Close()
 
-	if action.Status == types.ActionStatus_ACTION_STATUS_PENDING &&
-		action.UpdatedAt.Add(pendingTimeout).Before(blockTime) {
-		return true
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
 	}
 
-	isCompletedStatus := action.Status == types.ActionStatus_ACTION_STATUS_COMPLETED ||
-		action.Status == types.ActionStatus_ACTION_STATUS_REVOKED
-	if isCompletedStatus && action.UpdatedAt.Add(completedTimeout).Before(blockTime) {
-		return true
+	actions := make([]types.Action, 0, len(keys))
+	for _, key := range keys {
+		act, err := k.actions.Get(ctx, key.K3())
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, act)
 	}
 
-	return false
+	return actions, nil
+}
+
+func statusRange(status types.ActionStatus, after, before time.Time) *collections.Range[collections.Triple[int32, time.Time, uint64]] {
+	rng := new(collections.Range[collections.Triple[int32, time.Time, uint64]]).
+		Prefix(collections.TriplePrefix[int32, time.Time, uint64](int32(status)))
+	if !after.IsZero() {
+		rng = rng.StartInclusive(collections.TripleSuperPrefix[int32, time.Time, uint64](int32(status), after))
+	}
+	if !before.IsZero() {
+		rng = rng.EndExclusive(collections.TripleSuperPrefix[int32, time.Time, uint64](int32(status), before))
+	}
+	return rng
 }
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// testRouter routes the messages to handlers by type URL.
+type testRouter map[string]baseapp.MsgServiceHandler
+
+func (r testRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r[sdk.MsgTypeURL(msg)]
+}
+
+func (r testRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return r[typeURL]
+}
+
+func TestAddActionMultipleMessages(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	carol := sdk.AccAddress("carol_address_______").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+
+	parse := func(definition string) *ast.Expression {
+		expr, err := shield.Parse(definition)
+		require.NoError(t, err)
+		return expr
+	}
+
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: parse(alice)}, types.Template{Expression: parse(bob)}, nil
+	})
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgNewTemplate) (types.Template, types.Template, error) {
+		return types.Template{Expression: parse(fmt.Sprintf("%s || %s", alice, carol))}, types.Template{Expression: parse(bob)}, nil
+	})
+
+	var failNewTemplate bool
+	params := types.DefaultParams()
+	params.MaxPendingTime++
+	router := testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			if err := k.SetParams(ctx, params); err != nil {
+				return nil, err
+			}
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+		sdk.MsgTypeURL(&types.MsgNewTemplate{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			if failNewTemplate {
+				return nil, errors.New("new template failed")
+			}
+			return sdk.WrapServiceResult(ctx, &types.MsgNewTemplateResponse{Id: 1}, nil)
+		},
+	}
+	keeper.SetRouter(&k, router)
+
+	msgs := []sdk.Msg{
+		&types.MsgUpdateParams{Authority: k.GetModuleAddress()},
+		&types.MsgNewTemplate{Creator: k.GetModuleAddress()},
+	}
+
+	// the expected expressions are the templates of the messages combined
+	_, err := k.AddAction(ctx, carol, msgs, 0, parse(alice), parse(bob))
+	require.ErrorIs(t, err, types.ErrApproveExpressionNotMatched)
+
+	approve := fmt.Sprintf("%s && (%s || %s)", alice, alice, carol)
+	reject := fmt.Sprintf("%s || %s", bob, bob)
+	_, err = k.AddAction(ctx, carol, msgs, 0, parse(approve), parse(bob))
+	require.ErrorIs(t, err, types.ErrRejectExpressionNotMatched)
+
+	act, err := k.AddAction(ctx, carol, msgs, 0, parse(approve), parse(reject))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+	require.Nil(t, act.Msg)
+	require.Len(t, act.Msgs, 2)
+	require.Len(t, act.MsgExpressions, 2)
+	require.Equal(t, alice, shield.Format(&act.MsgExpressions[0].ApproveExpression))
+	require.Equal(t, approve, shield.Format(&act.ApproveExpression))
+	require.Equal(t, reject, shield.Format(&act.RejectExpression))
+	require.ElementsMatch(t, []string{alice, bob, carol}, act.Mentions)
+
+	// the action is indexed by the type of each of its messages
+	for _, msg := range msgs {
+		res, err := k.Actions(ctx, &types.QueryActionsRequest{MsgTypeUrl: sdk.MsgTypeURL(msg)})
+		require.NoError(t, err)
+		require.Len(t, res.Actions, 1)
+	}
+
+	// if a message fails, none of them is persisted
+	failNewTemplate = true
+	act, err = k.AddAction(ctx, alice, msgs, 0, parse(approve), parse(reject))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_FAILED, act.Status)
+	require.Equal(t, "new template failed", act.Error)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// the messages are executed in order, in the same transaction
+	failNewTemplate = false
+	act, err = k.AddAction(ctx, alice, msgs, 0, parse(approve), parse(reject))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, act.Status)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	var results types.ActionResults
+	require.NoError(t, results.Unmarshal(act.Result.Value))
+	require.Len(t, results.Results, 2)
+
+	var newTemplateRes types.MsgNewTemplateResponse
+	require.NoError(t, newTemplateRes.Unmarshal(results.Results[1].Value))
+	require.Equal(t, uint64(1), newTemplateRes.Id)
+
+	// single message actions are unchanged
+	act, err = k.AddAction(ctx, carol, msgs[:1], 0, parse(alice), parse(bob))
+	require.NoError(t, err)
+	require.NotNil(t, act.Msg)
+	require.Empty(t, act.Msgs)
+	require.Empty(t, act.MsgExpressions)
+
+	_, err = k.AddAction(ctx, carol, nil, 0, parse(alice), parse(bob))
+	require.ErrorIs(t, err, types.ErrInvalidActionMsgs)
+}
+
+func TestAddActionMultipleMessagesNestedTemplates(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	carol := sdk.AccAddress("carol_address_______").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: shieldParse(t, alice)}, types.Template{Expression: shieldParse(t, alice)}, nil
+	})
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgNewTemplate) (types.Template, types.Template, error) {
+		return types.Template{Expression: shieldParse(t, fmt.Sprintf("%s && %s", bob, carol))},
+			types.Template{Expression: shieldParse(t, fmt.Sprintf("%s || %s", bob, carol))}, nil
+	})
+
+	msgs := []sdk.Msg{
+		&types.MsgUpdateParams{Authority: k.GetModuleAddress()},
+		&types.MsgNewTemplate{Creator: k.GetModuleAddress()},
+	}
+
+	// the templates are combined as `alice && (bob && carol)` and
+	// `alice || (bob || carol)`, which match the flat chains
+	approve := fmt.Sprintf("%s && %s && %s", alice, bob, carol)
+	reject := fmt.Sprintf("%s || %s || %s", alice, bob, carol)
+	act, err := k.AddAction(ctx, carol, msgs, 0, shieldParse(t, approve), shieldParse(t, reject))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+
+	// other operators still have to match
+	_, err = k.AddAction(ctx, carol, msgs, 0, shieldParse(t, approve), shieldParse(t, fmt.Sprintf("%s || %s && %s", alice, bob, carol)))
+	require.ErrorIs(t, err, types.ErrRejectExpressionNotMatched)
+
+	_, err = k.AddAction(ctx, carol, msgs, 0, shieldParse(t, fmt.Sprintf("%s && %s || %s", alice, bob, carol)), shieldParse(t, reject))
+	require.ErrorIs(t, err, types.ErrApproveExpressionNotMatched)
+
+	_, err = k.AddAction(ctx, carol, msgs, 0, shieldParse(t, approve), shieldParse(t, fmt.Sprintf("%s || %s || %s", bob, alice, carol)))
+	require.ErrorIs(t, err, types.ErrRejectExpressionNotMatched)
+}
+
+func TestAddActionSimplifyExpressions(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+
+	approve := fmt.Sprintf("%s || (%s || 1 > 2)", alice, alice)
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: shieldParse(t, approve)}, types.Template{Expression: shieldParse(t, bob)}, nil
+	})
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+
+	// expressions are stored as they are by default
+	act, err := k.AddAction(ctx, bob, msgs, 0, shieldParse(t, approve), shieldParse(t, bob))
+	require.NoError(t, err)
+	require.Equal(t, approve, shield.Format(&act.ApproveExpression))
+
+	params := types.DefaultParams()
+	params.SimplifyExpressions = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	act, err = k.AddAction(ctx, bob, msgs, 0, shieldParse(t, approve), shieldParse(t, bob))
+	require.NoError(t, err)
+	// the identifiers are kept in a logical operation, see shield.Simplify
+	require.Equal(t, alice+" || false", shield.Format(&act.ApproveExpression))
+}
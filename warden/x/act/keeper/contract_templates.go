@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// GetContractTemplates returns the templates of the Actions executing the
+// contract.
+func (k Keeper) GetContractTemplates(ctx context.Context, contract string) (types.ContractTemplates, error) {
+	templates, err := k.contractTemplates.Get(ctx, contract)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ContractTemplates{}, errorsmod.Wrapf(types.ErrNoContractTemplates, "contract %s", contract)
+	}
+	return templates, err
+}
+
+// setContractTemplates stores the templates of a contract, or removes them if
+// both template ids are zero.
+func (k Keeper) setContractTemplates(ctx context.Context, templates types.ContractTemplates) error {
+	if templates.ApproveTemplateId == 0 && templates.RejectTemplateId == 0 {
+		return k.contractTemplates.Remove(ctx, templates.Contract)
+	}
+	return k.contractTemplates.Set(ctx, templates.Contract, templates)
+}
+
+// contractTemplatesResolver is the field resolver registered as
+// types.ContractTemplatesResolver. It returns the templates set for the
+// contract address.
+func (k Keeper) contractTemplatesResolver(ctx context.Context, value any) (context.Context, types.Template, types.Template, error) {
+	contract, ok := value.(string)
+	if !ok {
+		return nil, types.Template{}, types.Template{}, fmt.Errorf("expected a contract address, got %T", value)
+	}
+
+	templates, err := k.GetContractTemplates(ctx, contract)
+	if err != nil {
+		return nil, types.Template{}, types.Template{}, err
+	}
+
+	approve, err := k.GetTemplate(ctx, templates.ApproveTemplateId)
+	if err != nil {
+		return nil, types.Template{}, types.Template{}, err
+	}
+
+	reject, err := k.GetTemplate(ctx, templates.RejectTemplateId)
+	if err != nil {
+		return nil, types.Template{}, types.Template{}, err
+	}
+
+	return ctx, approve, reject, nil
+}
+
+// executeContractTemplate returns the templates set for the contract executed
+// by msg. Since msg is signed by the x/act module account, it can't send
+// funds to the contract: they would be paid from the module account.
+func (k Keeper) executeContractTemplate(ctx context.Context, msg *wasmtypes.MsgExecuteContract) (context.Context, types.Template, types.Template, error) {
+	if !msg.Funds.Empty() {
+		return nil, types.Template{}, types.Template{}, errorsmod.Wrapf(types.ErrInvalidActionMsg, "contract %s can't be sent funds by an action", msg.Contract)
+	}
+
+	return k.contractTemplatesResolver(ctx, msg.Contract)
+}
+
+// contractAdmin returns the admin of a CosmWasm contract, or an empty string
+// if it has none or CosmWasm isn't available.
+func (k Keeper) contractAdmin(ctx context.Context, contract sdk.AccAddress) string {
+	if k.getWasmKeeper == nil {
+		return ""
+	}
+
+	info := k.getWasmKeeper().GetContractInfo(ctx, contract)
+	if info == nil {
+		return ""
+	}
+	return info.Admin
+}
+
+// msgTemplates returns the templates of an Action message: the ones of the
+// provider registered for its type or, if there is none, the ones found by the
+// templates binding of its type in the Params.
+func (k Keeper) msgTemplates(ctx context.Context, msg sdk.Msg) (context.Context, types.Template, types.Template, error) {
+	typeUrl := sdk.MsgTypeURL(msg)
+	if !k.templatesRegistry.Has(typeUrl) {
+		if b, found := k.GetParams(ctx).TemplatesBinding(typeUrl); found {
+			return k.templatesRegistry.GetByField(ctx, msg, b.Field, b.Resolver)
+		}
+	}
+	return k.templatesRegistry.Get(ctx, msg)
+}
+
+func (k Keeper) importContractTemplates(ctx context.Context, templates []types.ContractTemplates) error {
+	for _, t := range templates {
+		if err := k.contractTemplates.Set(ctx, t.Contract, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) exportContractTemplates(ctx context.Context) ([]types.ContractTemplates, error) {
+	it, err := k.contractTemplates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	return it.Values()
+}
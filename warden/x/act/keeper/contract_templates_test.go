@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestContractTemplates(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	contract := sdk.AccAddress("contract_address____").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	ms := keeper.NewMsgServerImpl(k)
+
+	approve, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "approve", Definition: alice})
+	require.NoError(t, err)
+	reject, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "reject", Definition: bob})
+	require.NoError(t, err)
+
+	execute := &wasmtypes.MsgExecuteContract{Sender: k.GetModuleAddress(), Contract: contract, Msg: []byte(`{}`)}
+
+	// contracts without templates can't be executed by actions
+	_, err = k.AddAction(ctx, bob, []sdk.Msg{execute}, 0, shieldParse(t, alice), shieldParse(t, bob))
+	require.ErrorIs(t, err, types.ErrNoTemplateRegistryHandler)
+
+	// only the contract, or its admin, sets its templates
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: bob, Contract: contract, ApproveTemplateId: approve.Id, RejectTemplateId: reject.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: contract, Contract: contract, ApproveTemplateId: approve.Id})
+	require.ErrorIs(t, err, types.ErrInvalidTemplate)
+
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: contract, Contract: contract, ApproveTemplateId: approve.Id, RejectTemplateId: 42})
+	require.ErrorIs(t, err, types.ErrInvalidTemplate)
+
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: contract, Contract: contract, ApproveTemplateId: approve.Id, RejectTemplateId: reject.Id})
+	require.NoError(t, err)
+
+	res, err := k.ContractTemplates(ctx, &types.QueryContractTemplatesRequest{Contract: contract})
+	require.NoError(t, err)
+	require.Equal(t, types.ContractTemplates{Contract: contract, ApproveTemplateId: approve.Id, RejectTemplateId: reject.Id}, res.ContractTemplates)
+
+	act, err := k.AddAction(ctx, bob, []sdk.Msg{execute}, 0, shieldParse(t, alice), shieldParse(t, bob))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+
+	// actions can't send funds from the module account to the contract
+	withFunds := *execute
+	withFunds.Funds = sdk.NewCoins(sdk.NewInt64Coin("award", 1))
+	_, err = k.AddAction(ctx, bob, []sdk.Msg{&withFunds}, 0, shieldParse(t, alice), shieldParse(t, bob))
+	require.ErrorContains(t, err, types.ErrInvalidActionMsg.Error())
+	require.Equal(t, []types.TemplateRef{{Id: approve.Id, Version: 1}}, act.ApproveTemplates)
+	require.Equal(t, []types.TemplateRef{{Id: reject.Id, Version: 1}}, act.RejectTemplates)
+
+	// the templates survive an export and import of the genesis state
+	var genState types.GenesisState
+	require.NoError(t, k.ExportState(ctx, &genState))
+	require.Len(t, genState.ContractTemplates, 1)
+
+	k2, ctx2 := keepertest.ActKeeper(t)
+	require.NoError(t, k2.ImportState(ctx2, genState))
+	res2, err := k2.ContractTemplates(ctx2, &types.QueryContractTemplatesRequest{Contract: contract})
+	require.NoError(t, err)
+	require.Equal(t, res.ContractTemplates, res2.ContractTemplates)
+
+	// setting both templates to zero removes them
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: contract, Contract: contract})
+	require.NoError(t, err)
+
+	_, err = k.ContractTemplates(ctx, &types.QueryContractTemplatesRequest{Contract: contract})
+	require.Error(t, err)
+}
+
+// fakeWasm returns the admins of the contracts, by contract address.
+type fakeWasm map[string]string
+
+func (w fakeWasm) GetContractInfo(_ context.Context, contract sdk.AccAddress) *wasmtypes.ContractInfo {
+	admin, found := w[contract.String()]
+	if !found {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{Admin: admin}
+}
+
+func TestContractTemplatesAdmin(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	contract := sdk.AccAddress("contract_address____").String()
+	other := sdk.AccAddress("other_contract______").String()
+
+	k, ctx := keepertest.ActKeeper(t)
+	keeper.SetWasmKeeper(&k, fakeWasm{contract: alice})
+	ms := keeper.NewMsgServerImpl(k)
+
+	approve, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "approve", Definition: alice})
+	require.NoError(t, err)
+	reject, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "reject", Definition: bob})
+	require.NoError(t, err)
+
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: alice, Contract: contract, ApproveTemplateId: approve.Id, RejectTemplateId: reject.Id})
+	require.NoError(t, err)
+
+	res, err := k.ContractTemplates(ctx, &types.QueryContractTemplatesRequest{Contract: contract})
+	require.NoError(t, err)
+	require.Equal(t, approve.Id, res.ContractTemplates.ApproveTemplateId)
+
+	// the admin of a contract can't set the templates of another one
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: alice, Contract: other, ApproveTemplateId: approve.Id, RejectTemplateId: reject.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: bob, Contract: contract})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.SetContractTemplates(ctx, &types.MsgSetContractTemplates{Sender: alice, Contract: contract})
+	require.NoError(t, err)
+}
+
+func TestTemplatesBindings(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	ms := keeper.NewMsgServerImpl(k)
+
+	var resolved []any
+	k.TemplatesRegistry().RegisterFieldResolver("test.sender", func(ctx context.Context, value any) (context.Context, types.Template, types.Template, error) {
+		resolved = append(resolved, value)
+		return ctx, types.Template{Expression: shieldParse(t, alice)}, types.Template{Expression: shieldParse(t, bob)}, nil
+	})
+
+	send := &banktypes.MsgSend{FromAddress: k.GetModuleAddress(), ToAddress: bob}
+
+	// message types without a provider or a binding can't be used
+	_, err := k.AddAction(ctx, bob, []sdk.Msg{send}, 0, shieldParse(t, alice), shieldParse(t, bob))
+	require.ErrorIs(t, err, types.ErrNoTemplateRegistryHandler)
+
+	params := types.DefaultParams()
+	params.TemplatesBindings = []types.TemplatesBinding{{TypeUrl: sdk.MsgTypeURL(send), Field: "from_address", Resolver: "test.unknown"}}
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.ErrorIs(t, err, types.ErrInvalidTemplate)
+
+	params.TemplatesBindings[0].Resolver = "test.sender"
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+
+	act, err := k.AddAction(ctx, bob, []sdk.Msg{send}, 0, shieldParse(t, alice), shieldParse(t, bob))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+	require.Equal(t, []any{k.GetModuleAddress()}, resolved)
+
+	// bindings on a missing field or an unknown message type are refused
+	invalid := types.DefaultParams()
+	invalid.TemplatesBindings = []types.TemplatesBinding{{TypeUrl: sdk.MsgTypeURL(send), Field: "owner", Resolver: "test.sender"}}
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidTemplate)
+
+	invalid.TemplatesBindings = []types.TemplatesBinding{{TypeUrl: "/test.MsgUnknown", Field: "sender", Resolver: "test.sender"}}
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidTemplate)
+
+	invalid.TemplatesBindings = []types.TemplatesBinding{params.TemplatesBindings[0], params.TemplatesBindings[0]}
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidTemplate)
+
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// DependencyChanged is called by the module owning dependency (see
+// cosmoshield.Dependencies) when its value changes, e.g. when the owners of a
+// Space are updated.
+//
+// The expressions of the pending and queued Actions depending on it are expanded again
+// and the Actions are re-evaluated at the end of the block. Actions whose
+// expressions can't be expanded anymore are revoked. An
+// EventActionDependencyChanged, containing reason, is emitted for every
+// Action.
+//
+// The Actions whose messages are being executed, and are changing the
+// dependency, are skipped: they are stored with their result right after.
+func (k Keeper) DependencyChanged(ctx context.Context, dependency, reason string) error {
+	ids, err := k.ActionKeeper.ActionsByDependency(ctx, dependency)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		act, err := k.ActionKeeper.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			if err := k.ActionKeeper.removeDependency(ctx, dependency, id); err != nil {
+				return err
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !act.IsOpen() {
+			if err := k.ActionKeeper.removeDependency(ctx, dependency, id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if isExecutingAction(ctx, id) {
+			continue
+		}
+
+		if err := k.reexpandAction(ctx, act, dependency, reason); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reexpandAction expands again the template expressions of act and stores the
+// result. If the expansion fails, act is revoked.
+func (k Keeper) reexpandAction(ctx context.Context, act types.Action, dependency, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := &types.EventActionDependencyChanged{
+		Id:         act.Id,
+		Dependency: dependency,
+		Reason:     reason,
+	}
+
+	updated, err := k.expandActionDependencies(ctx, act)
+	if err != nil {
+		sdkCtx.Logger().Error("action expansion failed", "action_id", act.Id, "dependency", dependency, "err", err)
+		event.Error = err.Error()
+
+		updated = act
+		if err := updated.SetStatus(sdkCtx, types.ActionStatus_ACTION_STATUS_REVOKED); err != nil {
+			return err
+		}
+	} else {
+		updated.EvaluationError = ""
+		if err := k.ActionKeeper.setChanged(ctx, act.Id); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ActionKeeper.reindex(ctx, act, updated); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(event)
+}
+
+// expandActionDependencies returns a copy of act with its expressions
+// expanded again from its template expressions. The expressions of
+// multi-message actions are expanded again for each message, then combined.
+func (k Keeper) expandActionDependencies(ctx context.Context, act types.Action) (types.Action, error) {
+	msgs := act.Messages()
+	exprs := []types.ActionMsgExpressions{{
+		ApproveExpression:         act.ApproveExpression,
+		RejectExpression:          act.RejectExpression,
+		ApproveTemplateExpression: act.ApproveTemplateExpression,
+		RejectTemplateExpression:  act.RejectTemplateExpression,
+	}}
+	if len(act.MsgExpressions) > 0 {
+		exprs = act.MsgExpressions
+	}
+	if len(exprs) != len(msgs) {
+		return types.Action{}, fmt.Errorf("action has %d messages and %d expressions", len(msgs), len(exprs))
+	}
+
+	approves := make([]preprocessedTemplate, 0, len(msgs))
+	rejects := make([]preprocessedTemplate, 0, len(msgs))
+	for i, wrappedMsg := range msgs {
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(wrappedMsg, &msg); err != nil {
+			return types.Action{}, err
+		}
+
+		ctxWithMsg := k.expansionContext(ctxWithActionCreator(sdk.UnwrapSDKContext(ctx), act.Creator), msg)
+
+		approve, err := k.reexpandExpression(ctxWithMsg, &exprs[i].ApproveExpression, exprs[i].ApproveTemplateExpression)
+		if err != nil {
+			return types.Action{}, err
+		}
+
+		reject, err := k.reexpandExpression(ctxWithMsg, &exprs[i].RejectExpression, exprs[i].RejectTemplateExpression)
+		if err != nil {
+			return types.Action{}, err
+		}
+
+		// keep the template expressions, they are only returned by
+		// preprocessTemplate
+		approve.TemplateExpression = exprs[i].ApproveTemplateExpression
+		reject.TemplateExpression = exprs[i].RejectTemplateExpression
+
+		approves = append(approves, approve)
+		rejects = append(rejects, reject)
+	}
+
+	setActionExpressions(&act, approves, rejects, k.GetParams(ctx).SimplifyExpressions)
+	if err := act.Compile(); err != nil {
+		return types.Action{}, err
+	}
+
+	return act, nil
+}
+
+// reexpandExpression expands templateExpr again. If it's nil, expr has no
+// dependencies and is returned as it is.
+func (k Keeper) reexpandExpression(ctx context.Context, expr, templateExpr *ast.Expression) (preprocessedTemplate, error) {
+	if templateExpr != nil {
+		return k.expandDependencies(ctx, templateExpr)
+	}
+
+	mentions, err := expressionMentions(expr)
+	if err != nil {
+		return preprocessedTemplate{}, err
+	}
+
+	return preprocessedTemplate{Expression: expr, Mentions: mentions}, nil
+}
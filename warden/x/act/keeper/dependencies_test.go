@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/cosmoshield"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// ownersExpander expands `owners` into the current owners, recording the
+// "test/owners" dependency, and `threshold` into a static value.
+type ownersExpander struct {
+	owners *[]string
+}
+
+func (e ownersExpander) Expand(ctx context.Context, ident *ast.Identifier) (*ast.Expression, error) {
+	switch ident.Value {
+	case "threshold":
+		return ast.NewIntegerLiteral(&ast.IntegerLiteral{Value: "1"}), nil
+	case "owners":
+		if len(*e.owners) == 0 {
+			return nil, fmt.Errorf("no owners")
+		}
+		cosmoshield.AddDependency(ctx, "test/owners")
+		elements := make([]*ast.Expression, 0, len(*e.owners))
+		for _, owner := range *e.owners {
+			elements = append(elements, ast.NewIdentifier(&ast.Identifier{Value: owner}))
+		}
+		return ast.NewArrayLiteral(&ast.ArrayLiteral{Elements: elements}), nil
+	default:
+		return ast.NewIdentifier(ident), nil
+	}
+}
+
+func TestDependencyChanged(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	owners := []string{alice}
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &owners}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1})
+
+	msg := &types.MsgUpdateParams{Authority: k.GetModuleAddress()}
+	wrappedMsg, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+
+	newAction := func() uint64 {
+		approve, err := shield.Parse("false")
+		require.NoError(t, err)
+		reject, err := shield.Parse("any(threshold, owners)")
+		require.NoError(t, err)
+
+		msgCtx := cosmoshield.NewContext(ctx, msg)
+		p, err := keeper.PreprocessTemplate(&k, msgCtx, types.Template{Expression: approve})
+		require.NoError(t, err)
+		require.Nil(t, p.TemplateExpression)
+
+		r, err := keeper.PreprocessTemplate(&k, msgCtx, types.Template{Expression: reject})
+		require.NoError(t, err)
+		require.Equal(t, "any(1, owners)", shield.Format(r.TemplateExpression))
+		require.Equal(t, []string{"test/owners"}, r.Dependencies)
+
+		id, err := k.ActionKeeper.New(ctx, &types.Action{
+			Status:                   types.ActionStatus_ACTION_STATUS_PENDING,
+			Msg:                      wrappedMsg,
+			Mentions:                 r.Mentions,
+			ApproveExpression:        *p.Expression,
+			RejectExpression:         *r.Expression,
+			Dependencies:             r.Dependencies,
+			RejectTemplateExpression: r.TemplateExpression,
+			Votes: []*types.ActionVote{
+				types.NewVote(bob, types.ActionVoteType_VOTE_TYPE_REJECTED, ctx.BlockTime()),
+			},
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	id := newAction()
+	act, err := k.ActionKeeper.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("any(1, [%s])", alice), shield.Format(&act.RejectExpression))
+
+	ids, err := k.ActionKeeper.ActionsByDependency(ctx, "test/owners")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{id}, ids)
+
+	// bob's vote doesn't count until he becomes an owner
+	require.NoError(t, k.EndBlocker(ctx))
+	act, err = k.ActionKeeper.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+
+	owners = []string{bob}
+	require.NoError(t, k.DependencyChanged(ctx, "test/owners", "bob replaced alice"))
+
+	act, err = k.ActionKeeper.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("any(1, [%s])", bob), shield.Format(&act.RejectExpression))
+	require.Equal(t, []string{bob}, act.Mentions)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+
+	changed, err := k.ActionKeeper.ChangedActions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{id}, changed)
+
+	// the action is re-evaluated at the end of the block
+	require.NoError(t, k.EndBlocker(ctx))
+	act, err = k.ActionKeeper.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED, act.Status)
+
+	changed, err = k.ActionKeeper.ChangedActions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, changed)
+
+	// actions whose expressions can't be expanded anymore are revoked
+	invalidID := newAction()
+	owners = nil
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.DependencyChanged(ctx, "test/owners", "owners removed"))
+
+	act, err = k.ActionKeeper.Get(ctx, invalidID)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED, act.Status)
+
+	var event *types.EventActionDependencyChanged
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		if ev, ok := msg.(*types.EventActionDependencyChanged); ok {
+			event = ev
+		}
+	}
+	require.Equal(t, &types.EventActionDependencyChanged{
+		Id:         invalidID,
+		Dependency: "test/owners",
+		Reason:     "owners removed",
+		Error:      "no owners",
+	}, event)
+
+	ids, err = k.ActionKeeper.ActionsByDependency(ctx, "test/owners")
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestDependencyChangedByExecutingAction(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	carol := sdk.AccAddress("carol_address_______").String()
+	owners := []string{alice}
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &owners}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1})
+
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: shieldParse(t, "any(threshold, owners)")}, types.Template{Expression: shieldParse(t, "false")}, nil
+	})
+
+	// the message of the action adds carol to the owners it depends on
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			owners = append(owners, carol)
+			if err := k.DependencyChanged(ctx, "test/owners", "carol added"); err != nil {
+				return nil, err
+			}
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+	})
+
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	act, err := k.AddAction(ctx, alice, msgs, 0, shieldParse(t, "any(threshold, owners)"), shieldParse(t, "false"))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, act.Status)
+
+	// the executing action isn't expanded again, its stored mentions match
+	// the index of the actions by address
+	stored, err := k.ActionKeeper.Get(ctx, act.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{alice}, stored.Mentions)
+
+	res, err := k.ActionsByAddress(ctx, &types.QueryActionsByAddressRequest{Address: carol})
+	require.NoError(t, err)
+	require.Empty(t, res.Actions)
+
+	changed, err := k.ActionKeeper.ChangedActions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, changed)
+}
+
+func TestActionsByAddressSkipsMissingActions(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______")
+
+	k, ctx := keepertest.ActKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	require.NoError(t, keeper.SetActionByAddress(ctx, k, alice, 42))
+
+	res, err := k.ActionsByAddress(ctx, &types.QueryActionsByAddressRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Actions)
+
+	_, err = ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: alice.String(), Delegate: sdk.AccAddress("bob_address_________").String()})
+	require.NoError(t, err)
+}
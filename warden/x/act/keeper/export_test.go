@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+var PreprocessTemplate = (*Keeper).preprocessTemplate
+
+// SetRouter replaces the router used to execute the messages of the Actions.
+func SetRouter(k *Keeper, router baseapp.MessageRouter) {
+	k.router = router
+}
+
+// SetWasmKeeper replaces the keeper used to find the admins of the contracts.
+func SetWasmKeeper(k *Keeper, wasmKeeper types.WasmKeeper) {
+	k.getWasmKeeper = func() types.WasmKeeper { return wasmKeeper }
+}
+
+// SetActionByAddress adds an entry to the index of the actions by address,
+// without checking that the action exists.
+func SetActionByAddress(ctx context.Context, k Keeper, addr sdk.AccAddress, id uint64) error {
+	return k.ActionKeeper.actionByAddress.Set(ctx, collections.Join(addr, id), id)
+}
+
+// SetLegacyTemplate stores t as the latest version of its Template, without
+// adding it to the template history.
+func SetLegacyTemplate(ctx context.Context, k Keeper, t types.Template) error {
+	return k.templates.Set(ctx, t.Id, t)
+}
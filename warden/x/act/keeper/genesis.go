@@ -14,6 +14,18 @@ func (k *Keeper) ImportState(ctx sdk.Context, genState types.GenesisState) error
 		return fmt.Errorf("failed to import templates: %w", err)
 	}
 
+	if err := k.importTemplateVersions(ctx, genState.Templates, genState.TemplateVersions); err != nil {
+		return fmt.Errorf("failed to import template versions: %w", err)
+	}
+
+	if err := k.VoteDelegationKeeper.Import(ctx, genState.VoteDelegations); err != nil {
+		return fmt.Errorf("failed to import vote delegations: %w", err)
+	}
+
+	if err := k.importContractTemplates(ctx, genState.ContractTemplates); err != nil {
+		return fmt.Errorf("failed to import contract templates: %w", err)
+	}
+
 	err = k.ActionKeeper.Import(ctx, genState.Actions)
 	if err != nil {
 		return fmt.Errorf("failed to import actions: %w", err)
@@ -29,11 +41,29 @@ func (k *Keeper) ExportState(ctx sdk.Context, genState *types.GenesisState) erro
 	}
 	genState.Templates = templates
 
+	versions, err := k.exportTemplateVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export template versions: %w", err)
+	}
+	genState.TemplateVersions = versions
+
 	actions, err := k.ActionKeeper.Coll().Export(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to export actions: %w", err)
 	}
 	genState.Actions = actions
 
+	delegations, err := k.VoteDelegationKeeper.Export(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export vote delegations: %w", err)
+	}
+	genState.VoteDelegations = delegations
+
+	contractTemplates, err := k.exportContractTemplates(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export contract templates: %w", err)
+	}
+	genState.ContractTemplates = contractTemplates
+
 	return nil
 }
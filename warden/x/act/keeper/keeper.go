@@ -28,8 +28,20 @@ type (
 		shieldExpanderFunc func() ast.Expander
 		templatesRegistry  *types.TemplatesRegistry
 
-		ActionKeeper ActionKeeper
-		templates    repo.SeqCollection[types.Template]
+		ActionKeeper         ActionKeeper
+		VoteDelegationKeeper VoteDelegationKeeper
+		templates            repo.SeqCollection[types.Template]
+
+		// templateVersions contains every version of the templates, including
+		// the latest one stored in templates.
+		templateVersions collections.Map[collections.Pair[uint64, uint64], types.Template]
+
+		// contractTemplates contains the templates of the Actions executing
+		// CosmWasm contracts, by contract address.
+		contractTemplates collections.Map[string, types.ContractTemplates]
+
+		// getWasmKeeper is used to find the admin of a contract, it can be nil.
+		getWasmKeeper func() types.WasmKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -39,10 +51,26 @@ type (
 )
 
 var (
-	ActionPrefix                   = collections.NewPrefix(0)
-	TemplatePrefix                 = collections.NewPrefix(1)
-	ActionByAddressPrefix          = collections.NewPrefix(2)
-	PreviousPruneBlockHeightPrefix = collections.NewPrefix(3)
+	ActionPrefix                    = collections.NewPrefix(0)
+	TemplatePrefix                  = collections.NewPrefix(1)
+	ActionByAddressPrefix           = collections.NewPrefix(2)
+	PreviousPruneBlockHeightPrefix  = collections.NewPrefix(3)
+	TimeDependentActionPrefix       = collections.NewPrefix(4)
+	ActionByDependencyPrefix        = collections.NewPrefix(5)
+	ChangedActionPrefix             = collections.NewPrefix(6)
+	ActionByTimeoutHeightPrefix     = collections.NewPrefix(7)
+	ActionByStatusPrefix            = collections.NewPrefix(8)
+	ActionByCreatorPrefix           = collections.NewPrefix(9)
+	ActionByMsgTypePrefix           = collections.NewPrefix(10)
+	ActionByExecuteAfterPrefix      = collections.NewPrefix(11)
+	SignedVotePrefix                = collections.NewPrefix(12)
+	TemplateVersionPrefix           = collections.NewPrefix(13)
+	VoteDelegationPrefix            = collections.NewPrefix(14)
+	VoteDelegationByDelegatorPrefix = collections.NewPrefix(15)
+	VoteDelegationByDelegatePrefix  = collections.NewPrefix(16)
+	ContractTemplatesPrefix         = collections.NewPrefix(17)
+	VoteDelegationByExpiryPrefix    = collections.NewPrefix(18)
+	TimeDependentCursorPrefix       = collections.NewPrefix(19)
 )
 
 func NewKeeper(
@@ -54,6 +82,7 @@ func NewKeeper(
 	actModuleAddress string,
 	shieldExpanderFunc func() ast.Expander,
 	templatesRegistry *types.TemplatesRegistry,
+	getWasmKeeper func() types.WasmKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -69,12 +98,20 @@ func NewKeeper(
 	templatesCount := collections.NewSequence(sb, types.KeyPrefix(types.TemplateCountKey), "templates_count")
 	templates := repo.NewSeqCollection(templatesCount, templatesStore, func(i *types.Template, u uint64) { i.Id = u })
 
+	templateVersions := collections.NewMap(
+		sb, TemplateVersionPrefix, "template_versions",
+		collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		codec.CollValue[types.Template](cdc),
+	)
+
+	contractTemplates := collections.NewMap(sb, ContractTemplatesPrefix, "contract_templates", collections.StringKey, codec.CollValue[types.ContractTemplates](cdc))
+
 	_, err := sb.Build()
 	if err != nil {
 		panic(fmt.Sprintf("failed to build schema: %s", err))
 	}
 
-	return Keeper{
+	k := Keeper{
 		cdc:              cdc,
 		storeService:     storeService,
 		authority:        authority,
@@ -85,9 +122,16 @@ func NewKeeper(
 		shieldExpanderFunc: shieldExpanderFunc,
 		templatesRegistry:  templatesRegistry,
 
-		ActionKeeper: newActionKeeper(storeService, cdc),
-		templates:    templates,
+		ActionKeeper:         newActionKeeper(storeService, cdc),
+		VoteDelegationKeeper: newVoteDelegationKeeper(storeService, cdc),
+		templates:            templates,
+
+		templateVersions:  templateVersions,
+		contractTemplates: contractTemplates,
+		getWasmKeeper:     getWasmKeeper,
 	}
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
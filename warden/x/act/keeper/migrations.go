@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 migrates the x/act store from consensus version 3 to 4, adding
+// the existing actions to the indexes by status and UpdatedAt, by creator, by
+// message type and by timeout height.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	actions, err := m.keeper.ActionKeeper.Coll().Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		if err := m.keeper.ActionKeeper.index(ctx, action); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate4to5 migrates the x/act store from consensus version 4 to 5, setting
+// the existing templates to version 1 and adding them to the template
+// history.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	templates, err := m.keeper.templates.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.keeper.importTemplateVersions(ctx, templates, nil)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func (k msgServer) DelegateVote(goCtx context.Context, msg *types.MsgDelegateVote) (*types.MsgDelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidVoteDelegation, "invalid delegate: %v", err)
+	}
+
+	if msg.Delegate == msg.Delegator {
+		return nil, errors.Wrap(types.ErrInvalidVoteDelegation, "can't delegate to self")
+	}
+
+	blockTime := k.getBlockTime(ctx)
+	if msg.ExpiresAt != nil && !msg.ExpiresAt.After(blockTime) {
+		return nil, errors.Wrap(types.ErrInvalidVoteDelegation, "expiration must be in the future")
+	}
+
+	if msg.SpaceId != 0 {
+		exists, err := k.templatesRegistry.SpaceExists(ctx, msg.SpaceId)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, errors.Wrapf(types.ErrInvalidVoteDelegation, "space %d not found", msg.SpaceId)
+		}
+	}
+
+	if err := k.IsValidTemplate(ctx, msg.TemplateId); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidVoteDelegation, "template %d: %v", msg.TemplateId, err)
+	}
+
+	delegation := types.VoteDelegation{
+		Delegator:  msg.Delegator,
+		Delegate:   msg.Delegate,
+		SpaceId:    msg.SpaceId,
+		TemplateId: msg.TemplateId,
+		ExpiresAt:  msg.ExpiresAt,
+		CreatedAt:  blockTime,
+	}
+
+	// a new delegation replaces the one with the same scope
+	existing, err := k.VoteDelegationKeeper.ByDelegator(ctx, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	active := 0
+	for _, d := range existing {
+		if d.SameScope(&delegation) {
+			if err := k.revokeVoteDelegation(ctx, d); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if d.IsActive(blockTime) {
+			active++
+		}
+	}
+
+	if active >= types.MaxVoteDelegations {
+		return nil, errors.Wrapf(types.ErrInvalidVoteDelegation, "%s already has %d delegations", msg.Delegator, active)
+	}
+
+	id, err := k.VoteDelegationKeeper.New(ctx, &delegation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ActionKeeper.setChangedByAddress(ctx, msg.Delegator); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVoteDelegated{
+		Id:        id,
+		Delegator: msg.Delegator,
+		Delegate:  msg.Delegate,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateVoteResponse{Id: id}, nil
+}
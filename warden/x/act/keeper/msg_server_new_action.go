@@ -5,16 +5,30 @@ import (
 	"fmt"
 
 	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/warden-protocol/wardenprotocol/shield"
 	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 )
 
 func (k msgServer) NewAction(ctx context.Context, msg *types.MsgNewAction) (*types.MsgNewActionResponse, error) {
-	var message sdk.Msg
-	err := k.cdc.UnpackAny(msg.Message, &message)
-	if err != nil {
-		return nil, fmt.Errorf("can't unpack any: %w", err)
+	if msg.Message != nil && len(msg.Messages) > 0 {
+		return nil, errors.Wrapf(types.ErrInvalidActionMsgs, "message and messages can't be used together")
+	}
+
+	wrappedMsgs := msg.Messages
+	if msg.Message != nil {
+		wrappedMsgs = []*codectypes.Any{msg.Message}
+	}
+
+	messages := make([]sdk.Msg, 0, len(wrappedMsgs))
+	for _, wrappedMsg := range wrappedMsgs {
+		var message sdk.Msg
+		err := k.cdc.UnpackAny(wrappedMsg, &message)
+		if err != nil {
+			return nil, fmt.Errorf("can't unpack any: %w", err)
+		}
+		messages = append(messages, message)
 	}
 
 	expectedApproveExpression, err := shield.Parse(msg.ExpectedApproveExpression)
@@ -27,7 +41,7 @@ func (k msgServer) NewAction(ctx context.Context, msg *types.MsgNewAction) (*typ
 		return nil, errors.Wrapf(types.ErrInvalidExpressionDefinition, "%v", err)
 	}
 
-	act, err := k.AddAction(ctx, msg.Creator, message, msg.ActionTimeoutHeight, expectedApproveExpression, expectedRejectExpression)
+	act, err := k.AddAction(ctx, msg.Creator, messages, msg.ActionTimeoutHeight, expectedApproveExpression, expectedRejectExpression)
 	if err != nil {
 		return nil, err
 	}
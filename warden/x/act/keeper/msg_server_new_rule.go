@@ -18,20 +18,38 @@ func (k msgServer) NewTemplate(goCtx context.Context, msg *types.MsgNewTemplate)
 	}
 
 	template := types.Template{
-		Creator:    msg.Creator,
-		Name:       msg.Name,
-		Expression: expr,
+		Creator:        msg.Creator,
+		Name:           msg.Name,
+		Expression:     expr,
+		ExecutionDelay: msg.ExecutionDelay,
+		Version:        1,
+		UpdatedBy:      msg.Creator,
+		UpdatedAt:      k.getBlockTime(ctx),
+
+		GovernanceTemplateId: msg.GovernanceTemplateId,
 	}
 
 	if err := template.Validate(); err != nil {
 		return nil, err
 	}
 
+	if err := k.validateTemplateReferences(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateGovernanceTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
 	id, err := k.templates.Append(ctx, &template)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := k.setTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
 	if err = ctx.EventManager().EmitTypedEvent(&types.EventCreateTemplate{
 		Id:      id,
 		Creator: msg.Creator,
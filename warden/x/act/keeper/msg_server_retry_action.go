@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func (k msgServer) RetryAction(goCtx context.Context, msg *types.MsgRetryAction) (*types.MsgRetryActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	act, err := k.ActionKeeper.Get(ctx, msg.ActionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if act.Creator != msg.Creator {
+		return nil, types.ErrInvalidRetrier
+	}
+
+	if act.Status != types.ActionStatus_ACTION_STATUS_FAILED {
+		return nil, errors.Wrapf(types.ErrInvalidActionStatus, "can't retry an action that's not failed")
+	}
+
+	// actions can still be executed at their TimeoutHeight
+	if act.TimeoutHeight > 0 && uint64(ctx.BlockHeight()) > act.TimeoutHeight {
+		return nil, errors.Wrapf(types.ErrInvalidActionStatus, "action timed out at height %d", act.TimeoutHeight)
+	}
+
+	// without a TimeoutHeight, the number of retries bounds the lifetime of
+	// the action
+	if act.TimeoutHeight == 0 && act.Retries >= types.MaxActionRetries {
+		return nil, errors.Wrapf(types.ErrInvalidActionStatus, "action already retried %d times", act.Retries)
+	}
+
+	// failed actions aren't expanded again when their dependencies change,
+	// e.g. when an owner of a Space is removed, so it's done before retrying
+	retried, err := k.expandActionDependencies(ctx, act)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidActionStatus, "can't expand the action again: %v", err)
+	}
+	retried.EvaluationError = ""
+	retried.Retries++
+
+	// the action isn't retried if it has been rejected since it failed
+	if err := k.TryRejectVotedAction(ctx, &retried); err != nil {
+		return nil, err
+	}
+
+	if retried.Status == types.ActionStatus_ACTION_STATUS_REVOKED {
+		if err := k.ActionKeeper.reindex(ctx, act, retried); err != nil {
+			return nil, err
+		}
+		return &types.MsgRetryActionResponse{Status: retried.Status.String()}, nil
+	}
+
+	// nor if it isn't approved anymore
+	votes, err := k.actionVotes(ctx, &retried)
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := retried.EvalApprove(ctx, NewActionContextEnv(ctx, &retried, ActionApprovedVotesEnv(votes)))
+	if err != nil {
+		return nil, err
+	}
+
+	if !approved {
+		return nil, errors.Wrapf(types.ErrInvalidActionStatus, "action %d isn't approved anymore", act.Id)
+	}
+
+	if err := k.ActionKeeper.reindex(ctx, act, retried); err != nil {
+		return nil, err
+	}
+
+	act = retried
+	if err := k.executeAction(ctx, &act); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryActionResponse{Status: act.Status.String()}, nil
+}
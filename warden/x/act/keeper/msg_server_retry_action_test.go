@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestRetryAction(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1})
+
+	approve, err := shield.Parse(alice)
+	require.NoError(t, err)
+	reject, err := shield.Parse(bob)
+	require.NoError(t, err)
+
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: approve}, types.Template{Expression: reject}, nil
+	})
+
+	var handlerErr error
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			if handlerErr != nil {
+				return nil, handlerErr
+			}
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+	})
+
+	ms := keeper.NewMsgServerImpl(k)
+
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	handlerErr = errors.New("insufficient keychain fees")
+	act, err := k.AddAction(ctx.WithEventManager(sdk.NewEventManager()), alice, msgs, 2, approve, reject)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_FAILED, act.Status)
+	require.Equal(t, "insufficient keychain fees", act.Error)
+
+	// only the creator can retry the action
+	_, err = ms.RetryAction(ctx, &types.MsgRetryAction{Creator: bob, ActionId: act.Id})
+	require.ErrorIs(t, err, types.ErrInvalidRetrier)
+
+	// the action fails again, with the new error
+	handlerErr = errors.New("keychain not found")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := ms.RetryAction(ctx, &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_FAILED.String(), res.Status)
+
+	var events []*types.EventActionStateChange
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		if ev, ok := msg.(*types.EventActionStateChange); ok {
+			events = append(events, ev)
+		}
+	}
+	require.Equal(t, []*types.EventActionStateChange{{
+		Id:             act.Id,
+		PreviousStatus: types.ActionStatus_ACTION_STATUS_FAILED,
+		NewStatus:      types.ActionStatus_ACTION_STATUS_FAILED,
+		Error:          "keychain not found",
+	}}, events)
+
+	// failed actions can't be retried after their timeout height
+	handlerErr = nil
+	_, err = ms.RetryAction(ctx.WithBlockHeight(3), &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.ErrorIs(t, err, types.ErrInvalidActionStatus)
+
+	res, err = ms.RetryAction(ctx.WithBlockHeight(2), &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED.String(), res.Status)
+
+	stored, err := k.ActionKeeper.Get(ctx, act.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, stored.Status)
+	require.Empty(t, stored.Error)
+	require.NotNil(t, stored.Result)
+
+	// only failed actions can be retried
+	_, err = ms.RetryAction(ctx, &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.ErrorIs(t, err, types.ErrInvalidActionStatus)
+}
+
+func TestRetryActionRejected(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now).WithHeaderInfo(header.Info{Time: now})
+
+	approve, err := shield.Parse(alice)
+	require.NoError(t, err)
+	reject, err := shield.Parse(bob + " || action.age > 3600")
+	require.NoError(t, err)
+
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: approve}, types.Template{Expression: reject}, nil
+	})
+
+	handlerErr := errors.New("insufficient keychain fees")
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			if handlerErr != nil {
+				return nil, handlerErr
+			}
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+	})
+
+	ms := keeper.NewMsgServerImpl(k)
+
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	act, err := k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_FAILED, act.Status)
+
+	// the reject expression is satisfied by the time of the retry: the action
+	// is revoked instead of being executed
+	handlerErr = nil
+	later := now.Add(2 * time.Hour)
+	res, err := ms.RetryAction(ctx.WithBlockTime(later).WithHeaderInfo(header.Info{Time: later}), &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED.String(), res.Status)
+
+	stored, err := k.ActionKeeper.Get(ctx, act.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED, stored.Status)
+	require.Nil(t, stored.Result)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = ms.RetryAction(ctx, &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.ErrorIs(t, err, types.ErrInvalidActionStatus)
+}
+
+func TestRetryActionNotApproved(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	owners := []string{alice}
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &owners}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1})
+
+	approve := shieldParse(t, "any(threshold, owners)")
+	reject := shieldParse(t, "false")
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: approve}, types.Template{Expression: reject}, nil
+	})
+
+	handlerErr := errors.New("insufficient keychain fees")
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			if handlerErr != nil {
+				return nil, handlerErr
+			}
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+	})
+
+	ms := keeper.NewMsgServerImpl(k)
+
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	act, err := k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_FAILED, act.Status)
+
+	// alice isn't an owner anymore, her approval doesn't count
+	handlerErr = nil
+	owners = []string{bob}
+	_, err = ms.RetryAction(ctx, &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.ErrorIs(t, err, types.ErrInvalidActionStatus)
+
+	owners = []string{alice, bob}
+	res, err := ms.RetryAction(ctx, &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED.String(), res.Status)
+
+	// the expressions expanded for the retry are stored
+	stored, err := k.ActionKeeper.Get(ctx, act.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{alice, bob}, stored.Mentions)
+	require.Equal(t, uint32(1), stored.Retries)
+}
+
+func TestRetryActionLimit(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1})
+
+	approve := shieldParse(t, alice)
+	reject := shieldParse(t, "false")
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: approve}, types.Template{Expression: reject}, nil
+	})
+
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return nil, errors.New("insufficient keychain fees")
+		},
+	})
+
+	ms := keeper.NewMsgServerImpl(k)
+
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	act, err := k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_FAILED, act.Status)
+
+	// actions without a timeout height can be retried a limited number of
+	// times
+	for range types.MaxActionRetries {
+		res, err := ms.RetryAction(ctx, &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+		require.NoError(t, err)
+		require.Equal(t, types.ActionStatus_ACTION_STATUS_FAILED.String(), res.Status)
+	}
+
+	_, err = ms.RetryAction(ctx, &types.MsgRetryAction{Creator: alice, ActionId: act.Id})
+	require.ErrorIs(t, err, types.ErrInvalidActionStatus)
+}
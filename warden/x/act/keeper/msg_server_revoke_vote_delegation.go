@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func (k msgServer) RevokeVoteDelegation(goCtx context.Context, msg *types.MsgRevokeVoteDelegation) (*types.MsgRevokeVoteDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegation, err := k.VoteDelegationKeeper.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if delegation.Delegator != msg.Delegator {
+		return nil, errors.Wrapf(types.ErrInvalidVoteDelegation, "delegation %d doesn't belong to %s", msg.Id, msg.Delegator)
+	}
+
+	if err := k.revokeVoteDelegation(ctx, delegation); err != nil {
+		return nil, err
+	}
+
+	if err := k.ActionKeeper.setChangedByAddress(ctx, msg.Delegator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeVoteDelegationResponse{}, nil
+}
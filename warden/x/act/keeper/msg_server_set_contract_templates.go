@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func (k msgServer) SetContractTemplates(goCtx context.Context, msg *types.MsgSetContractTemplates) (*types.MsgSetContractTemplatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+
+	// the contract itself, or its admin, sets its templates
+	if msg.Sender != contract.String() && msg.Sender != k.contractAdmin(ctx, contract) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the contract nor its admin", msg.Sender)
+	}
+
+	if (msg.ApproveTemplateId == 0) != (msg.RejectTemplateId == 0) {
+		return nil, errors.Wrap(types.ErrInvalidTemplate, "approve and reject templates must be both set or both zero")
+	}
+
+	if msg.ApproveTemplateId != 0 {
+		for _, id := range []uint64{msg.ApproveTemplateId, msg.RejectTemplateId} {
+			if _, err := k.GetTemplate(ctx, id); err != nil {
+				return nil, errors.Wrapf(types.ErrInvalidTemplate, "template %d: %v", id, err)
+			}
+		}
+	}
+
+	templates := types.ContractTemplates{
+		Contract:          contract.String(),
+		ApproveTemplateId: msg.ApproveTemplateId,
+		RejectTemplateId:  msg.RejectTemplateId,
+	}
+	if err := k.setContractTemplates(ctx, templates); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetContractTemplates{
+		Contract:          templates.Contract,
+		ApproveTemplateId: templates.ApproveTemplateId,
+		RejectTemplateId:  templates.RejectTemplateId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractTemplatesResponse{}, nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func (k msgServer) SubmitSignedVotes(goCtx context.Context, msg *types.MsgSubmitSignedVotes) (*types.MsgSubmitSignedVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(msg.Votes) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidSignedVote, "no votes")
+	}
+
+	act, err := k.ActionKeeper.Get(ctx, msg.ActionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// queued actions have been approved before their timeout height
+	if act.Status == types.ActionStatus_ACTION_STATUS_PENDING && act.TimeoutHeight > 0 && act.TimeoutHeight < uint64(ctx.BlockHeight()) {
+		if err := act.SetStatus(ctx, types.ActionStatus_ACTION_STATUS_TIMEOUT); err != nil {
+			return nil, err
+		}
+		if err := k.ActionKeeper.Set(ctx, act); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSubmitSignedVotesResponse{
+			Status: act.Status.String(),
+		}, nil
+	}
+
+	var approved, rejected bool
+	for _, vote := range msg.Votes {
+		if err := vote.Verify(ctx.ChainID(), act.Id); err != nil {
+			return nil, errors.Wrapf(err, "vote of %s", vote.Participant)
+		}
+
+		if err := k.ActionKeeper.UseSignedVote(ctx, act.Id, vote.Participant, vote.VoteType); err != nil {
+			return nil, err
+		}
+
+		// the signature isn't bound to a point in time: it can't override a
+		// vote of the participant that might have been cast after it
+		if act.HasVoted(vote.Participant) {
+			return nil, errors.Wrapf(types.ErrInvalidSignedVote, "%s already voted on action %d", vote.Participant, act.Id)
+		}
+
+		if err := act.AddOrUpdateVote(ctx, vote.Participant, vote.VoteType); err != nil {
+			return nil, err
+		}
+
+		approved = approved || vote.VoteType == types.ActionVoteType_VOTE_TYPE_APPROVED
+		rejected = rejected || vote.VoteType == types.ActionVoteType_VOTE_TYPE_REJECTED
+	}
+
+	if err := k.ActionKeeper.Set(ctx, act); err != nil {
+		return nil, err
+	}
+
+	// rejections are checked first, so that a batch satisfying both
+	// expressions doesn't execute the action
+	if rejected {
+		if err := k.TryRejectVotedAction(ctx, &act); err != nil {
+			return nil, err
+		}
+	}
+
+	if approved {
+		if err := k.TryExecuteVotedAction(ctx, &act); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSubmitSignedVotesResponse{Status: act.Status.String()}, nil
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestSubmitSignedVotes(t *testing.T) {
+	const chainID = "warden_1"
+
+	aliceKey := secp256k1.GenPrivKey()
+	alice := sdk.AccAddress(aliceKey.PubKey().Address()).String()
+	bobKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	bob := sdk.AccAddress(ethcrypto.PubkeyToAddress(bobKey.PublicKey).Bytes()).String()
+	relayer := sdk.AccAddress("relayer_address_____").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1}).WithChainID(chainID)
+
+	approve, err := shield.Parse(alice + " && " + bob)
+	require.NoError(t, err)
+	reject, err := shield.Parse(alice)
+	require.NoError(t, err)
+
+	types.Register(k.TemplatesRegistry(), func(_ context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		return types.Template{Expression: approve}, types.Template{Expression: reject}, nil
+	})
+
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+	})
+
+	ms := keeper.NewMsgServerImpl(k)
+
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	act, err := k.AddAction(ctx, relayer, msgs, 0, approve, reject)
+	require.NoError(t, err)
+
+	aliceVote := func(actionID uint64, voteType types.ActionVoteType) types.SignedVote {
+		sig, err := aliceKey.Sign(types.ADR036SignBytes(alice, types.VoteSignData(chainID, actionID, voteType)))
+		require.NoError(t, err)
+		return types.SignedVote{
+			Participant: alice,
+			VoteType:    voteType,
+			Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_ADR036,
+			PubKey:      aliceKey.PubKey().Bytes(),
+			Signature:   sig,
+		}
+	}
+	bobVote := func(actionID uint64, voteType types.ActionVoteType) types.SignedVote {
+		hash, err := types.EIP712VoteHash(chainID, actionID, voteType)
+		require.NoError(t, err)
+		sig, err := ethcrypto.Sign(hash, bobKey)
+		require.NoError(t, err)
+		return types.SignedVote{
+			Participant: bob,
+			VoteType:    voteType,
+			Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+			Signature:   sig,
+		}
+	}
+
+	// votes signed for another action are rejected
+	_, err = ms.SubmitSignedVotes(ctx, &types.MsgSubmitSignedVotes{
+		Relayer:  relayer,
+		ActionId: act.Id,
+		Votes:    []types.SignedVote{aliceVote(act.Id+1, types.ActionVoteType_VOTE_TYPE_APPROVED)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSignedVote)
+
+	res, err := ms.SubmitSignedVotes(ctx, &types.MsgSubmitSignedVotes{
+		Relayer:  relayer,
+		ActionId: act.Id,
+		Votes:    []types.SignedVote{aliceVote(act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING.String(), res.Status)
+
+	// the same signed vote can't be submitted twice, even by another relayer
+	_, err = ms.SubmitSignedVotes(ctx, &types.MsgSubmitSignedVotes{
+		Relayer:  bob,
+		ActionId: act.Id,
+		Votes:    []types.SignedVote{aliceVote(act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED)},
+	})
+	require.ErrorIs(t, err, types.ErrSignedVoteReplayed)
+
+	// a signed vote can't override a vote already cast by the participant,
+	// it could have been signed before it
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = ms.SubmitSignedVotes(cacheCtx, &types.MsgSubmitSignedVotes{
+		Relayer:  relayer,
+		ActionId: act.Id,
+		Votes:    []types.SignedVote{aliceVote(act.Id, types.ActionVoteType_VOTE_TYPE_REJECTED)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSignedVote)
+
+	// a batch is rejected as a whole, the cache context discards its writes
+	// like a failed transaction
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = ms.SubmitSignedVotes(cacheCtx, &types.MsgSubmitSignedVotes{
+		Relayer:  relayer,
+		ActionId: act.Id,
+		Votes: []types.SignedVote{
+			bobVote(act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED),
+			bobVote(act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED),
+		},
+	})
+	require.ErrorIs(t, err, types.ErrSignedVoteReplayed)
+
+	res, err = ms.SubmitSignedVotes(ctx, &types.MsgSubmitSignedVotes{
+		Relayer:  relayer,
+		ActionId: act.Id,
+		Votes:    []types.SignedVote{bobVote(act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED.String(), res.Status)
+
+	stored, err := k.ActionKeeper.Get(ctx, act.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, stored.Status)
+	// the creator approved the action when creating it
+	require.Len(t, stored.Votes, 3)
+
+	// rejections are relayed the same way
+	act, err = k.AddAction(ctx, relayer, msgs, 0, approve, reject)
+	require.NoError(t, err)
+
+	res, err = ms.SubmitSignedVotes(ctx, &types.MsgSubmitSignedVotes{
+		Relayer:  relayer,
+		ActionId: act.Id,
+		Votes: []types.SignedVote{
+			bobVote(act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED),
+			aliceVote(act.Id, types.ActionVoteType_VOTE_TYPE_REJECTED),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED.String(), res.Status)
+}
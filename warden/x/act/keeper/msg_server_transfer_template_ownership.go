@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func (k msgServer) TransferTemplateOwnership(ctx context.Context, msg *types.MsgTransferTemplateOwnership) (*types.MsgTransferTemplateOwnershipResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return nil, err
+	}
+
+	template, err := k.templates.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.authorizeTemplateChange(template, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	previousOwner := template.Creator
+
+	template.Creator = msg.NewOwner
+	template.Version++
+	template.UpdatedBy = k.templateChangedBy(ctx, msg.Creator)
+	template.UpdatedAt = k.getBlockTime(ctx)
+
+	if err := k.setTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventTransferTemplateOwnership{
+		Id:            template.Id,
+		PreviousOwner: previousOwner,
+		NewOwner:      msg.NewOwner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferTemplateOwnershipResponse{}, nil
+}
@@ -14,6 +14,26 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
 	}
 
+	if err := req.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTemplate, err.Error())
+	}
+
+	for _, b := range req.Params.TemplatesBindings {
+		if !k.templatesRegistry.HasFieldResolver(b.Resolver) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidTemplate, "no field resolver registered with name %s", b.Resolver)
+		}
+
+		// the bound field must exist in the message type, the zero value
+		// of the message is enough to look it up
+		msg, err := k.cdc.InterfaceRegistry().Resolve(b.TypeUrl)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidTemplate, "unknown message type %s", b.TypeUrl)
+		}
+		if _, err := types.MsgFieldValue(msg, b.Field); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidTemplate, err.Error())
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
@@ -20,24 +20,47 @@ func (k msgServer) UpdateTemplate(ctx context.Context, msg *types.MsgUpdateTempl
 		return nil, err
 	}
 
-	if template.Creator != msg.Creator {
-		return nil, types.ErrInvalidUpdateTemplateAccount
+	if err := k.authorizeTemplateChange(template, msg.Creator); err != nil {
+		return nil, err
 	}
 
+	oldExpression := template.Expression
+
 	template.Expression = expr
 	template.Name = msg.Name
+	if msg.UpdateExecutionDelay {
+		template.ExecutionDelay = msg.ExecutionDelay
+	}
+	if msg.UpdateGovernanceTemplateId {
+		template.GovernanceTemplateId = msg.GovernanceTemplateId
+	}
+	template.Version++
+	template.UpdatedBy = k.templateChangedBy(ctx, msg.Creator)
+	template.UpdatedAt = k.getBlockTime(ctx)
 
 	if err := template.Validate(); err != nil {
 		return nil, err
 	}
 
-	if err := k.templates.Set(ctx, template.Id, template); err != nil {
+	if err := k.validateTemplateReferences(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateGovernanceTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	if err := k.setTemplate(ctx, template); err != nil {
 		return nil, err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateTemplate{
-		Id: template.Id,
+		Id:            template.Id,
+		Version:       template.Version,
+		UpdatedBy:     template.UpdatedBy,
+		OldExpression: shield.Format(oldExpression),
+		NewExpression: shield.Format(template.Expression),
 	}); err != nil {
 		return nil, err
 	}
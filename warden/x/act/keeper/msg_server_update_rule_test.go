@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestUpdateTemplateExecutionDelay(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	k, ctx := keepertest.ActKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	res, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "approve", Definition: alice, ExecutionDelay: time.Hour})
+	require.NoError(t, err)
+
+	// the delay is kept unless the update replaces it
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: alice, Id: res.Id, Name: "approve", Definition: bob})
+	require.NoError(t, err)
+
+	template, err := k.GetTemplate(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, template.ExecutionDelay)
+
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: alice, Id: res.Id, Name: "approve", Definition: bob, ExecutionDelay: time.Minute, UpdateExecutionDelay: true})
+	require.NoError(t, err)
+
+	template, err = k.GetTemplate(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, template.ExecutionDelay)
+
+	// the delay is removed by replacing it with zero
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: alice, Id: res.Id, Name: "approve", Definition: bob, UpdateExecutionDelay: true})
+	require.NoError(t, err)
+
+	template, err = k.GetTemplate(ctx, res.Id)
+	require.NoError(t, err)
+	require.Zero(t, template.ExecutionDelay)
+	require.Equal(t, uint64(4), template.Version)
+
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: alice, Id: res.Id, Name: "approve", Definition: bob, ExecutionDelay: -time.Minute, UpdateExecutionDelay: true})
+	require.Error(t, err)
+}
@@ -16,7 +16,8 @@ func (k msgServer) VoteForAction(goCtx context.Context, msg *types.MsgVoteForAct
 		return nil, err
 	}
 
-	if act.TimeoutHeight > 0 && act.TimeoutHeight < uint64(ctx.BlockHeight()) {
+	// queued actions have been approved before their timeout height
+	if act.Status == types.ActionStatus_ACTION_STATUS_PENDING && act.TimeoutHeight > 0 && act.TimeoutHeight < uint64(ctx.BlockHeight()) {
 		if err := act.SetStatus(ctx, types.ActionStatus_ACTION_STATUS_TIMEOUT); err != nil {
 			return nil, err
 		}
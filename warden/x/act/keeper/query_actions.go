@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
@@ -16,10 +18,71 @@ func (k Keeper) Actions(goCtx context.Context, req *types.QueryActionsRequest) (
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	filter := actionsFilter{
+		status:  req.Status,
+		creator: req.Creator,
+		msgType: req.MsgTypeUrl,
+		after:   req.UpdatedAfter,
+		before:  req.UpdatedBefore,
+	}
+
+	getAction := func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Action, error) {
+		return k.ActionKeeper.Get(ctx, key.K2())
+	}
+	matchAction := func(key collections.Pair[string, uint64], _ collections.NoValue) (bool, error) {
+		act, err := k.ActionKeeper.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+		return filter.match(act), nil
+	}
 
-	actions, pageRes, err := query.CollectionPaginate(ctx, k.ActionKeeper.Coll(), req.Pagination, func(key uint64, action types.Action) (types.Action, error) {
-		return action, nil
-	})
+	var (
+		actions []types.Action
+		pageRes *query.PageResponse
+		err     error
+	)
+
+	// use the most selective index available, then filter the actions on the
+	// remaining fields
+	switch {
+	case req.Creator != "":
+		actions, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, k.ActionKeeper.actionsByCreator, req.Pagination, matchAction, getAction,
+			query.WithCollectionPaginationPairPrefix[string, uint64](req.Creator),
+		)
+	case req.MsgTypeUrl != "":
+		actions, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, k.ActionKeeper.actionsByMsgType, req.Pagination, matchAction, getAction,
+			query.WithCollectionPaginationPairPrefix[string, uint64](req.MsgTypeUrl),
+		)
+	case req.Status != types.ActionStatus_ACTION_STATUS_UNSPECIFIED:
+		// the status index contains UpdatedAt, actions are only fetched
+		// when they are in the time range
+		actions, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, k.ActionKeeper.actionsByStatus, req.Pagination,
+			func(key collections.Triple[int32, time.Time, uint64], _ collections.NoValue) (bool, error) {
+				return filter.matchTime(key.K2()), nil
+			},
+			func(key collections.Triple[int32, time.Time, uint64], _ collections.NoValue) (types.Action, error) {
+				return k.ActionKeeper.Get(ctx, key.K3())
+			},
+			func(o *query.CollectionsPaginateOptions[collections.Triple[int32, time.Time, uint64]]) {
+				prefix := collections.TriplePrefix[int32, time.Time, uint64](int32(req.Status))
+				o.Prefix = &prefix
+			},
+		)
+	default:
+		actions, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, k.ActionKeeper.Coll(), req.Pagination,
+			func(_ uint64, action types.Action) (bool, error) {
+				return filter.match(action), nil
+			},
+			func(_ uint64, action types.Action) (types.Action, error) {
+				return action, nil
+			},
+		)
+	}
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -30,3 +93,50 @@ func (k Keeper) Actions(goCtx context.Context, req *types.QueryActionsRequest) (
 		Pagination: pageRes,
 	}, nil
 }
+
+// actionsFilter matches the actions against the filters of a query, empty
+// fields match all the actions.
+type actionsFilter struct {
+	status  types.ActionStatus
+	creator string
+	msgType string
+	after   *time.Time
+	before  *time.Time
+}
+
+func (f actionsFilter) match(action types.Action) bool {
+	if f.status != types.ActionStatus_ACTION_STATUS_UNSPECIFIED && action.Status != f.status {
+		return false
+	}
+
+	if f.creator != "" && action.Creator != f.creator {
+		return false
+	}
+
+	if f.msgType != "" && !f.matchMsgType(action) {
+		return false
+	}
+
+	return f.matchTime(action.UpdatedAt)
+}
+
+func (f actionsFilter) matchMsgType(action types.Action) bool {
+	for _, msg := range action.Messages() {
+		if msg.TypeUrl == f.msgType {
+			return true
+		}
+	}
+	return false
+}
+
+func (f actionsFilter) matchTime(updatedAt time.Time) bool {
+	if f.after != nil && updatedAt.Before(*f.after) {
+		return false
+	}
+
+	if f.before != nil && !updatedAt.Before(*f.before) {
+		return false
+	}
+
+	return true
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,8 +24,18 @@ func (k Keeper) ActionsByAddress(goCtx context.Context, req *types.QueryActionsB
 		return nil, status.Error(codes.InvalidArgument, "invalid address")
 	}
 
-	actions, pageRes, err := query.CollectionPaginate(
+	actions, pageRes, err := query.CollectionFilteredPaginate(
 		ctx, k.ActionKeeper.ActionsByAddress(), req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], value uint64) (bool, error) {
+			action, err := k.ActionKeeper.Get(ctx, value)
+			if errors.Is(err, collections.ErrNotFound) {
+				// skip the index entries left by pruned actions
+				return false, nil
+			} else if err != nil {
+				return false, err
+			}
+			return req.Status == types.ActionStatus_ACTION_STATUS_UNSPECIFIED || action.Status == req.Status, nil
+		},
 		func(key collections.Pair[sdk.AccAddress, uint64], value uint64) (types.Action, error) {
 			return k.ActionKeeper.Get(ctx, value)
 		},
@@ -34,16 +45,8 @@ func (k Keeper) ActionsByAddress(goCtx context.Context, req *types.QueryActionsB
 		return nil, err
 	}
 
-	var result []types.Action
-	for _, action := range actions {
-		if req.Status != types.ActionStatus_ACTION_STATUS_UNSPECIFIED && action.Status != req.Status {
-			continue
-		}
-		result = append(result, action)
-	}
-
 	return &types.QueryActionsByAddressResponse{
-		Actions:    result,
+		Actions:    actions,
 		Pagination: pageRes,
 	}, nil
 }
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestActionsQueryFilters(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+	t0 := time.Unix(1_700_000_000, 0).UTC()
+
+	updateParams, err := codectypes.NewAnyWithValue(&types.MsgUpdateParams{})
+	require.NoError(t, err)
+	newTemplate, err := codectypes.NewAnyWithValue(&types.MsgNewTemplate{})
+	require.NoError(t, err)
+
+	actions := []types.Action{
+		{Creator: "alice", Status: types.ActionStatus_ACTION_STATUS_PENDING, Msg: updateParams, UpdatedAt: t0},
+		{Creator: "bob", Status: types.ActionStatus_ACTION_STATUS_PENDING, Msg: newTemplate, UpdatedAt: t0.Add(time.Hour)},
+		{Creator: "alice", Status: types.ActionStatus_ACTION_STATUS_COMPLETED, Msg: newTemplate, UpdatedAt: t0.Add(2 * time.Hour)},
+		{Creator: "alice", Status: types.ActionStatus_ACTION_STATUS_PENDING, Msg: newTemplate, UpdatedAt: t0.Add(3 * time.Hour)},
+	}
+	for i := range actions {
+		_, err := k.ActionKeeper.New(ctx, &actions[i])
+		require.NoError(t, err)
+	}
+
+	// the status index follows the updates of the actions
+	act := actions[3]
+	act.Status = types.ActionStatus_ACTION_STATUS_REVOKED
+	require.NoError(t, k.ActionKeeper.Set(ctx, act))
+
+	after, before := t0.Add(time.Hour), t0.Add(3*time.Hour)
+	tests := []struct {
+		name     string
+		req      *types.QueryActionsRequest
+		expected []uint64
+	}{
+		{name: "all", req: &types.QueryActionsRequest{}, expected: []uint64{1, 2, 3, 4}},
+		{name: "status", req: &types.QueryActionsRequest{Status: types.ActionStatus_ACTION_STATUS_PENDING}, expected: []uint64{1, 2}},
+		{name: "revoked", req: &types.QueryActionsRequest{Status: types.ActionStatus_ACTION_STATUS_REVOKED}, expected: []uint64{4}},
+		{name: "creator", req: &types.QueryActionsRequest{Creator: "alice"}, expected: []uint64{1, 3, 4}},
+		{name: "creator and status", req: &types.QueryActionsRequest{Creator: "alice", Status: types.ActionStatus_ACTION_STATUS_PENDING}, expected: []uint64{1}},
+		{name: "msg type", req: &types.QueryActionsRequest{MsgTypeUrl: newTemplate.TypeUrl}, expected: []uint64{2, 3, 4}},
+		{name: "time range", req: &types.QueryActionsRequest{UpdatedAfter: &after, UpdatedBefore: &before}, expected: []uint64{2, 3}},
+		{name: "status and time range", req: &types.QueryActionsRequest{Status: types.ActionStatus_ACTION_STATUS_PENDING, UpdatedAfter: &after}, expected: []uint64{2}},
+		{name: "unknown creator", req: &types.QueryActionsRequest{Creator: "carol"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := k.Actions(ctx, tt.req)
+			require.NoError(t, err)
+
+			var ids []uint64
+			for _, act := range res.Actions {
+				ids = append(ids, act.Id)
+			}
+			require.Equal(t, tt.expected, ids)
+		})
+	}
+
+	// filters are applied before pagination
+	res, err := k.Actions(ctx, &types.QueryActionsRequest{
+		Creator:    "alice",
+		Status:     types.ActionStatus_ACTION_STATUS_PENDING,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Actions, 1)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+
+	// expired actions are found through the status index
+	expired, err := k.ActionKeeper.ExpiredActions(ctx, t0.Add(4*time.Hour), 3*time.Hour, 90*time.Minute)
+	require.NoError(t, err)
+	var ids []uint64
+	for _, act := range expired {
+		ids = append(ids, act.Id)
+	}
+	require.Equal(t, []uint64{1, 3}, ids)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+
+	// actions stored before the indexes existed
+	for id := uint64(1); id <= 3; id++ {
+		require.NoError(t, k.ActionKeeper.Coll().Set(ctx, id, types.Action{
+			Id:            id,
+			Creator:       "alice",
+			Status:        types.ActionStatus_ACTION_STATUS_PENDING,
+			TimeoutHeight: 10,
+		}))
+	}
+
+	res, err := k.Actions(ctx, &types.QueryActionsRequest{Creator: "alice"})
+	require.NoError(t, err)
+	require.Empty(t, res.Actions)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	res, err = k.Actions(ctx, &types.QueryActionsRequest{Creator: "alice", Status: types.ActionStatus_ACTION_STATUS_PENDING})
+	require.NoError(t, err)
+	require.Len(t, res.Actions, 3)
+
+	ids, err := k.ActionKeeper.TimedOutActions(ctx, 11)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, ids)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func (k Keeper) ContractTemplates(ctx context.Context, req *types.QueryContractTemplatesRequest) (*types.QueryContractTemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	templates, err := k.GetContractTemplates(ctx, req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryContractTemplatesResponse{ContractTemplates: templates}, nil
+}
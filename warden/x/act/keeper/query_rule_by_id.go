@@ -18,7 +18,15 @@ func (k Keeper) TemplateById(goCtx context.Context, req *types.QueryTemplateById
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	templatePb, err := k.GetTemplate(ctx, req.Id)
+	var (
+		templatePb types.Template
+		err        error
+	)
+	if req.Version == 0 {
+		templatePb, err = k.GetTemplate(ctx, req.Id)
+	} else {
+		templatePb, err = k.GetTemplateVersion(ctx, req.Id, req.Version)
+	}
 	if err != nil {
 		return nil, err
 	}
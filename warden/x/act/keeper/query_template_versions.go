@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TemplateVersions(goCtx context.Context, req *types.QueryTemplateVersionsRequest) (*types.QueryTemplateVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetTemplate(ctx, req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	templates, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.templateVersions,
+		req.Pagination,
+		func(key collections.Pair[uint64, uint64], value types.Template) (types.Template, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTemplateVersionsResponse{
+		Templates:  templates,
+		Pagination: pageRes,
+	}, nil
+}
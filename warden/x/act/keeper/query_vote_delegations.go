@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) VoteDelegations(goCtx context.Context, req *types.QueryVoteDelegationsRequest) (*types.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := k.getBlockTime(ctx)
+
+	match := func(d types.VoteDelegation) bool {
+		return d.IsActive(blockTime) &&
+			(req.Delegator == "" || d.Delegator == req.Delegator) &&
+			(req.Delegate == "" || d.Delegate == req.Delegate)
+	}
+
+	getDelegation := func(_ collections.Pair[string, uint64], id uint64) (types.VoteDelegation, error) {
+		return k.VoteDelegationKeeper.Get(ctx, id)
+	}
+	matchDelegation := func(_ collections.Pair[string, uint64], id uint64) (bool, error) {
+		d, err := k.VoteDelegationKeeper.Get(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return match(d), nil
+	}
+
+	var (
+		delegations []types.VoteDelegation
+		pageRes     *query.PageResponse
+		err         error
+	)
+
+	switch {
+	case req.Delegator != "":
+		delegations, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, k.VoteDelegationKeeper.delegationsByDelegator, req.Pagination, matchDelegation, getDelegation,
+			query.WithCollectionPaginationPairPrefix[string, uint64](req.Delegator),
+		)
+	case req.Delegate != "":
+		delegations, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, k.VoteDelegationKeeper.delegationsByDelegate, req.Pagination, matchDelegation, getDelegation,
+			query.WithCollectionPaginationPairPrefix[string, uint64](req.Delegate),
+		)
+	default:
+		delegations, pageRes, err = query.CollectionFilteredPaginate(
+			ctx, k.VoteDelegationKeeper.delegations, req.Pagination,
+			func(_ uint64, d types.VoteDelegation) (bool, error) {
+				return match(d), nil
+			},
+			func(_ uint64, d types.VoteDelegation) (types.VoteDelegation, error) {
+				return d, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVoteDelegationsResponse{
+		Delegations: delegations,
+		Pagination:  pageRes,
+	}, nil
+}
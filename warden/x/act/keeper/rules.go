@@ -5,8 +5,11 @@ import (
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/warden-protocol/wardenprotocol/shield"
 	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	"github.com/warden-protocol/wardenprotocol/shield/token"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/cosmoshield"
 	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 )
 
@@ -23,19 +26,157 @@ func (k Keeper) GetTemplate(ctx context.Context, id uint64) (types.Template, err
 	return k.templates.Get(ctx, id)
 }
 
-// preprocessTemplate preprocesses an template and returns the root AST and a list
-// of addresses that are referenced in the expression.
-func (k *Keeper) preprocessTemplate(ctx context.Context, template types.Template) (*ast.Expression, []string, error) {
-	expander := k.shieldExpanderFunc()
+// preprocessedTemplate is a Template preprocessed for a new Action.
+type preprocessedTemplate struct {
+	// Expression is the preprocessed expression, simplified to reduce its
+	// size.
+	Expression *ast.Expression
+	// Mentions are the addresses referenced in Expression.
+	Mentions []string
+	// TemplateExpression is the expression before the expansion of its
+	// dependencies, nil if it has none.
+	TemplateExpression *ast.Expression
+	// Dependencies are the dynamic inputs of Expression, see
+	// cosmoshield.Dependencies.
+	Dependencies []string
+}
+
+// preprocessTemplate preprocesses a template for a new Action.
+//
+// The identifiers whose expansion doesn't record any dependency (e.g. the
+// fields of the message, the values returned by the analyzers) are expanded
+// once and for all, the others are kept in the TemplateExpression so that
+// they can be expanded again when their dependencies change, see
+// expandDependencies.
+func (k *Keeper) preprocessTemplate(ctx context.Context, template types.Template) (preprocessedTemplate, error) {
+	var visiting []uint64
+	if template.Id > 0 {
+		visiting = append(visiting, template.Id)
+	}
+
+	expr, err := k.expandTemplateReferences(ctx, template.Expression, visiting)
+	if err != nil {
+		return preprocessedTemplate{}, err
+	}
 
-	rootAst, err := shield.Preprocess(ctx, template.Expression, expander)
+	var deps cosmoshield.Dependencies
+	templateExpr, err := shield.Preprocess(ctx, expr, staticInputsExpander{
+		base: k.shieldExpanderFunc(),
+		deps: &deps,
+	})
 	if err != nil {
-		return nil, nil, err
+		return preprocessedTemplate{}, err
 	}
 
-	metadata, err := shield.ExtractMetadata(rootAst)
+	p, err := k.expandDependencies(ctx, templateExpr)
 	if err != nil {
-		return nil, nil, err
+		return preprocessedTemplate{}, err
+	}
+
+	if deps.Len() > 0 {
+		p.TemplateExpression = templateExpr
+	}
+
+	return p, nil
+}
+
+// expandDependencies expands the identifiers left in the template expression
+// of an Action, see preprocessTemplate.
+func (k *Keeper) expandDependencies(ctx context.Context, templateExpr *ast.Expression) (preprocessedTemplate, error) {
+	var deps cosmoshield.Dependencies
+	ctx = cosmoshield.WithDependencies(ctx, &deps)
+
+	rootAst, err := shield.Preprocess(ctx, proto.Clone(templateExpr).(*ast.Expression), k.shieldExpanderFunc())
+	if err != nil {
+		return preprocessedTemplate{}, err
+	}
+	if k.GetParams(ctx).SimplifyExpressions {
+		rootAst = shield.Simplify(rootAst)
+	}
+
+	mentions, err := expressionMentions(rootAst)
+	if err != nil {
+		return preprocessedTemplate{}, err
+	}
+
+	return preprocessedTemplate{
+		Expression:   rootAst,
+		Mentions:     mentions,
+		Dependencies: deps.List(),
+	}, nil
+}
+
+// combineExpressions joins exprs, in order, with the logical operator op
+// ("&&" or "||"). A single expression is returned as it is.
+func combineExpressions(op string, exprs []*ast.Expression) *ast.Expression {
+	tok := token.Token{Type: token.Type_AND, Literal: op}
+	if op == "||" {
+		tok.Type = token.Type_OR
+	}
+
+	root := exprs[0]
+	for _, expr := range exprs[1:] {
+		root = ast.NewInfixExpression(&ast.InfixExpression{
+			Token:    tok,
+			Operator: op,
+			Left:     root,
+			Right:    expr,
+		})
+	}
+
+	return root
+}
+
+// sameExpression reports whether a and b are the same expression, regardless
+// of how chains of `&&` and `||` are nested.
+func sameExpression(a, b *ast.Expression) bool {
+	return shield.Format(flattenLogical(a)) == shield.Format(flattenLogical(b))
+}
+
+// flattenLogical rebuilds every chain of the same `&&` or `||` operator in
+// expr as a left-associative chain, so that `a || (b || c)` and
+// `(a || b) || c` share the same shape.
+func flattenLogical(expr *ast.Expression) *ast.Expression {
+	infix := expr.GetInfixExpression()
+	if infix == nil {
+		return expr
+	}
+
+	if infix.Operator != "&&" && infix.Operator != "||" {
+		return ast.NewInfixExpression(&ast.InfixExpression{
+			Token:    infix.Token,
+			Operator: infix.Operator,
+			Left:     flattenLogical(infix.Left),
+			Right:    flattenLogical(infix.Right),
+		})
+	}
+
+	operands := logicalOperands(infix.Operator, expr, nil)
+	for i, operand := range operands {
+		operands[i] = flattenLogical(operand)
+	}
+
+	return combineExpressions(infix.Operator, operands)
+}
+
+// logicalOperands appends to acc the operands of the chain of op rooted at
+// expr, from left to right.
+func logicalOperands(op string, expr *ast.Expression, acc []*ast.Expression) []*ast.Expression {
+	infix := expr.GetInfixExpression()
+	if infix == nil || infix.Operator != op {
+		return append(acc, expr)
+	}
+
+	acc = logicalOperands(op, infix.Left, acc)
+	return logicalOperands(op, infix.Right, acc)
+}
+
+// expressionMentions returns the sorted list of the addresses referenced in
+// expr.
+func expressionMentions(expr *ast.Expression) ([]string, error) {
+	metadata, err := shield.ExtractMetadata(expr)
+	if err != nil {
+		return nil, err
 	}
 
 	// map addresses into bech32 strings
@@ -46,7 +187,42 @@ func (k *Keeper) preprocessTemplate(ctx context.Context, template types.Template
 	}
 	sort.Strings(addressesBech32)
 
-	return rootAst, addressesBech32, nil
+	return addressesBech32, nil
+}
+
+// staticInputsExpander expands the identifiers whose expansion doesn't record
+// any dependency. The other identifiers are left as they are and their
+// dependencies are recorded in deps.
+type staticInputsExpander struct {
+	base ast.Expander
+	deps *cosmoshield.Dependencies
+}
+
+func (e staticInputsExpander) Expand(ctx context.Context, ident *ast.Identifier) (*ast.Expression, error) {
+	var deps cosmoshield.Dependencies
+	expr, err := e.base.Expand(cosmoshield.WithDependencies(ctx, &deps), ident)
+	if err != nil {
+		return nil, err
+	}
+
+	if deps.Len() == 0 {
+		return expr, nil
+	}
+
+	e.deps.Add(deps.List()...)
+	return ast.NewIdentifier(ident), nil
+}
+
+// preprocessExpression expands the template references of expr, then its
+// identifiers using the injected expander. The ids in visiting are the
+// Templates being expanded, see expandTemplateReferences.
+func (k *Keeper) preprocessExpression(ctx context.Context, expr *ast.Expression, visiting []uint64) (*ast.Expression, error) {
+	expr, err := k.expandTemplateReferences(ctx, expr, visiting)
+	if err != nil {
+		return nil, err
+	}
+
+	return shield.Preprocess(ctx, expr, k.shieldExpanderFunc())
 }
 
 // resolveAddresses filters a list of string by returning only the ones that are valid bech32 addresses.
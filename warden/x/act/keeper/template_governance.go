@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// RegisterTemplates registers the templates of the x/act messages that can be
+// wrapped in Actions, the updates of governed templates, and the templates of
+// the CosmWasm contracts executed by Actions, see ContractTemplates.
+func (k Keeper) RegisterTemplates(reg *types.TemplatesRegistry) {
+	types.Register(reg, k.updateTemplateTemplate)
+	types.Register(reg, k.transferTemplateOwnershipTemplate)
+
+	reg.RegisterFieldResolver(types.ContractTemplatesResolver, k.contractTemplatesResolver)
+	types.RegisterCtx(reg, k.executeContractTemplate)
+}
+
+func (k Keeper) updateTemplateTemplate(ctx context.Context, msg *types.MsgUpdateTemplate) (types.Template, types.Template, error) {
+	return k.governanceTemplates(ctx, msg.Id)
+}
+
+func (k Keeper) transferTemplateOwnershipTemplate(ctx context.Context, msg *types.MsgTransferTemplateOwnership) (types.Template, types.Template, error) {
+	return k.governanceTemplates(ctx, msg.Id)
+}
+
+// governanceTemplates returns the templates of the Actions changing the
+// Template with the given id: the governing template is used both to
+// approve and to reject them.
+func (k Keeper) governanceTemplates(ctx context.Context, id uint64) (types.Template, types.Template, error) {
+	template, err := k.GetTemplate(ctx, id)
+	if err != nil {
+		return types.Template{}, types.Template{}, err
+	}
+
+	if template.GovernanceTemplateId == 0 {
+		return types.Template{}, types.Template{}, errors.Wrapf(types.ErrTemplateNotGoverned, "template %d", id)
+	}
+
+	governance, err := k.GetTemplate(ctx, template.GovernanceTemplateId)
+	if err != nil {
+		return types.Template{}, types.Template{}, err
+	}
+
+	return governance, governance, nil
+}
+
+// authorizeTemplateChange checks that signer can update or transfer
+// template: its owner if the template isn't governed, the x/act module
+// account (i.e. an Action approved by the governing template) otherwise.
+func (k Keeper) authorizeTemplateChange(template types.Template, signer string) error {
+	if template.GovernanceTemplateId != 0 {
+		if signer != k.GetModuleAddress() {
+			return errors.Wrapf(types.ErrInvalidUpdateTemplateAccount, "template %d is governed by template %d, it can only be changed by an action", template.Id, template.GovernanceTemplateId)
+		}
+		return nil
+	}
+
+	if template.Creator != signer {
+		return types.ErrInvalidUpdateTemplateAccount
+	}
+
+	return nil
+}
+
+// validateGovernanceTemplate checks that the governing template of template
+// exists. A template can govern itself.
+func (k Keeper) validateGovernanceTemplate(ctx context.Context, template types.Template) error {
+	if template.GovernanceTemplateId == 0 || template.GovernanceTemplateId == template.Id {
+		return nil
+	}
+
+	if _, err := k.GetTemplate(ctx, template.GovernanceTemplateId); err != nil {
+		return errors.Wrapf(types.ErrInvalidTemplate, "governance template %d: %v", template.GovernanceTemplateId, err)
+	}
+
+	return nil
+}
+
+// templateChangedBy returns the address recorded as the author of a change
+// made by signer: the creator of the Action if the change is executed by an
+// Action, signer otherwise.
+func (k Keeper) templateChangedBy(ctx context.Context, signer string) string {
+	if creator := k.GetActionCreator(ctx); signer == k.GetModuleAddress() && creator != "" {
+		return creator
+	}
+	return signer
+}
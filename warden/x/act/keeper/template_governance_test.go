@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestGovernedTemplates(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	carol := sdk.AccAddress("carol_address_______").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+
+	var ms types.MsgServer
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateTemplate{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			res, err := ms.UpdateTemplate(ctx, msg.(*types.MsgUpdateTemplate))
+			return sdk.WrapServiceResult(ctx, res, err)
+		},
+		sdk.MsgTypeURL(&types.MsgTransferTemplateOwnership{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			res, err := ms.TransferTemplateOwnership(ctx, msg.(*types.MsgTransferTemplateOwnership))
+			return sdk.WrapServiceResult(ctx, res, err)
+		},
+	})
+	ms = keeper.NewMsgServerImpl(k)
+
+	governance, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "board", Definition: alice})
+	require.NoError(t, err)
+
+	_, err = ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "policy", Definition: alice, GovernanceTemplateId: 42})
+	require.ErrorIs(t, err, types.ErrInvalidTemplate)
+
+	policy, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "policy", Definition: alice, GovernanceTemplateId: governance.Id})
+	require.NoError(t, err)
+
+	// the owner of a governed template can't update it directly
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: alice, Id: policy.Id, Name: "policy", Definition: bob})
+	require.ErrorIs(t, err, types.ErrInvalidUpdateTemplateAccount)
+
+	// ungoverned templates can't be changed by actions
+	_, err = k.AddAction(ctx, alice, []sdk.Msg{&types.MsgUpdateTemplate{Creator: k.GetModuleAddress(), Id: governance.Id, Name: "board", Definition: bob}}, 0, shieldParse(t, alice), shieldParse(t, alice))
+	require.ErrorIs(t, err, types.ErrNoTemplateRegistryHandler)
+
+	// bob proposes to make the policy govern itself, the board approves
+	update := &types.MsgUpdateTemplate{
+		Creator:                    k.GetModuleAddress(),
+		Id:                         policy.Id,
+		Name:                       "policy",
+		Definition:                 bob,
+		GovernanceTemplateId:       policy.Id,
+		UpdateGovernanceTemplateId: true,
+	}
+	act, err := k.AddAction(ctx, bob, []sdk.Msg{update}, 0, shieldParse(t, alice), shieldParse(t, alice))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, act.Status)
+
+	res, err := ms.VoteForAction(ctx, &types.MsgVoteForAction{Participant: alice, ActionId: act.Id, VoteType: types.ActionVoteType_VOTE_TYPE_APPROVED})
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED.String(), res.Status)
+
+	template, err := k.GetTemplate(ctx, policy.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), template.Version)
+	require.Equal(t, policy.Id, template.GovernanceTemplateId)
+	require.Equal(t, bob, template.UpdatedBy)
+
+	// the self-governed policy now requires bob to transfer it
+	transfer := &types.MsgTransferTemplateOwnership{Creator: k.GetModuleAddress(), Id: policy.Id, NewOwner: carol}
+	act, err = k.AddAction(ctx, bob, []sdk.Msg{transfer}, 0, shieldParse(t, bob), shieldParse(t, bob))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, act.Status)
+
+	template, err = k.GetTemplate(ctx, policy.Id)
+	require.NoError(t, err)
+	require.Equal(t, carol, template.Creator)
+	require.Equal(t, uint64(3), template.Version)
+
+	// updates that don't replace the governance template keep it
+	update = &types.MsgUpdateTemplate{Creator: k.GetModuleAddress(), Id: policy.Id, Name: "policy", Definition: bob}
+	act, err = k.AddAction(ctx, bob, []sdk.Msg{update}, 0, shieldParse(t, bob), shieldParse(t, bob))
+	require.NoError(t, err)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, act.Status)
+
+	template, err = k.GetTemplate(ctx, policy.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), template.Version)
+	require.Equal(t, policy.Id, template.GovernanceTemplateId)
+
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: carol, Id: policy.Id, Name: "policy", Definition: carol})
+	require.ErrorIs(t, err, types.ErrInvalidUpdateTemplateAccount)
+
+	// the owner of an ungoverned template transfers it directly
+	_, err = ms.TransferTemplateOwnership(ctx, &types.MsgTransferTemplateOwnership{Creator: bob, Id: governance.Id, NewOwner: carol})
+	require.ErrorIs(t, err, types.ErrInvalidUpdateTemplateAccount)
+
+	_, err = ms.TransferTemplateOwnership(ctx, &types.MsgTransferTemplateOwnership{Creator: alice, Id: governance.Id, NewOwner: carol})
+	require.NoError(t, err)
+
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: carol, Id: governance.Id, Name: "board", Definition: carol})
+	require.NoError(t, err)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// TemplateReferenceFunction is the name of the Shield function that can be
+// used to inline the expression of another Template, e.g. `template(42)`.
+const TemplateReferenceFunction = "template"
+
+// MaxTemplateReferenceDepth is the maximum number of nested template
+// references that will be expanded.
+const MaxTemplateReferenceDepth = 8
+
+// expandTemplateReferences replaces every `template(<id>)` call found in expr
+// with the expression of the referenced Template.
+//
+// References are resolved recursively. The ids in visiting are considered
+// already being expanded, referencing one of them results in a cycle error.
+//
+// The expansion happens when an Action is created (see preprocessTemplate),
+// updating a referenced Template doesn't affect pending Actions.
+func (k Keeper) expandTemplateReferences(ctx context.Context, expr *ast.Expression, visiting []uint64) (*ast.Expression, error) {
+	if expr == nil {
+		return nil, nil
+	}
+
+	switch n := expr.Value.(type) {
+	case *ast.Expression_CallExpression:
+		if n.CallExpression.Function.Value == TemplateReferenceFunction {
+			return k.expandTemplateReference(ctx, n.CallExpression, visiting)
+		}
+		return expr, k.expandTemplateReferencesList(ctx, n.CallExpression.Arguments, visiting)
+	case *ast.Expression_ArrayLiteral:
+		return expr, k.expandTemplateReferencesList(ctx, n.ArrayLiteral.Elements, visiting)
+	case *ast.Expression_ObjectLiteral:
+		for _, e := range n.ObjectLiteral.Entries {
+			var err error
+			e.Value, err = k.expandTemplateReferences(ctx, e.Value, visiting)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return expr, nil
+	case *ast.Expression_IndexExpression:
+		var err error
+		n.IndexExpression.Left, err = k.expandTemplateReferences(ctx, n.IndexExpression.Left, visiting)
+		if err != nil {
+			return nil, err
+		}
+		n.IndexExpression.Index, err = k.expandTemplateReferences(ctx, n.IndexExpression.Index, visiting)
+		return expr, err
+	case *ast.Expression_PrefixExpression:
+		var err error
+		n.PrefixExpression.Right, err = k.expandTemplateReferences(ctx, n.PrefixExpression.Right, visiting)
+		return expr, err
+	case *ast.Expression_InfixExpression:
+		var err error
+		n.InfixExpression.Left, err = k.expandTemplateReferences(ctx, n.InfixExpression.Left, visiting)
+		if err != nil {
+			return nil, err
+		}
+		n.InfixExpression.Right, err = k.expandTemplateReferences(ctx, n.InfixExpression.Right, visiting)
+		return expr, err
+	default:
+		return expr, nil
+	}
+}
+
+func (k Keeper) expandTemplateReferencesList(ctx context.Context, exprs []*ast.Expression, visiting []uint64) error {
+	for i, e := range exprs {
+		var err error
+		exprs[i], err = k.expandTemplateReferences(ctx, e, visiting)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) expandTemplateReference(ctx context.Context, call *ast.CallExpression, visiting []uint64) (*ast.Expression, error) {
+	id, err := templateReferenceID(call)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range visiting {
+		if v == id {
+			return nil, errors.Wrapf(types.ErrTemplateReferenceCycle, "template %d references itself through %v", id, visiting)
+		}
+	}
+
+	if len(visiting) >= MaxTemplateReferenceDepth {
+		return nil, errors.Wrapf(types.ErrInvalidTemplateReference, "too many nested template references (max %d)", MaxTemplateReferenceDepth)
+	}
+
+	template, err := k.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidTemplateReference, "template %d: %v", id, err)
+	}
+
+	return k.expandTemplateReferences(ctx, template.Expression, append(visiting[:len(visiting):len(visiting)], id))
+}
+
+// validateTemplateReferences checks that all the templates referenced by
+// template exist and that they don't reference template back.
+func (k Keeper) validateTemplateReferences(ctx context.Context, template types.Template) error {
+	var visiting []uint64
+	if template.Id > 0 {
+		visiting = append(visiting, template.Id)
+	}
+
+	expr := proto.Clone(template.Expression).(*ast.Expression)
+	_, err := k.expandTemplateReferences(ctx, expr, visiting)
+	return err
+}
+
+// templateReferenceID returns the id of the Template referenced by a
+// `template(<id>)` call.
+func templateReferenceID(call *ast.CallExpression) (uint64, error) {
+	if len(call.Arguments) != 1 {
+		return 0, errors.Wrapf(types.ErrInvalidTemplateReference, "wrong number of arguments. got=%d, want=1", len(call.Arguments))
+	}
+
+	lit, ok := ast.UnwrapIntegerLiteral(call.Arguments[0])
+	if !ok {
+		return 0, errors.Wrapf(types.ErrInvalidTemplateReference, "argument must be an integer literal, got %s", ast.Stringify(call.Arguments[0]))
+	}
+
+	id, err := strconv.ParseUint(lit.Value, 10, 64)
+	if err != nil || id == 0 {
+		return 0, errors.Wrapf(types.ErrInvalidTemplateReference, "invalid template id: %s", lit.Value)
+	}
+
+	return id, nil
+}
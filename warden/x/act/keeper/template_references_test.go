@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestTemplateReferences(t *testing.T) {
+	_, ms, ctx := setupMsgServer(t)
+	creator := "warden1creator"
+
+	finance, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{
+		Creator:    creator,
+		Name:       "finance",
+		Definition: "any(2, [warden1a, warden1b, warden1c])",
+	})
+	require.NoError(t, err)
+
+	treasury, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{
+		Creator:    creator,
+		Name:       "treasury",
+		Definition: "template(1) && warden1cfo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), treasury.Id)
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{
+			Creator:    creator,
+			Name:       "unknown",
+			Definition: "template(42)",
+		})
+		require.ErrorIs(t, err, types.ErrInvalidTemplateReference)
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		_, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{
+			Creator:    creator,
+			Name:       "invalid",
+			Definition: "template(warden1a)",
+		})
+		require.ErrorIs(t, err, types.ErrInvalidTemplateReference)
+	})
+
+	t.Run("self reference", func(t *testing.T) {
+		_, err := ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{
+			Creator:    creator,
+			Id:         finance.Id,
+			Name:       "finance",
+			Definition: "template(1)",
+		})
+		require.ErrorIs(t, err, types.ErrTemplateReferenceCycle)
+	})
+
+	t.Run("indirect cycle", func(t *testing.T) {
+		_, err := ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{
+			Creator:    creator,
+			Id:         finance.Id,
+			Name:       "finance",
+			Definition: "template(2) || warden1a",
+		})
+		require.ErrorIs(t, err, types.ErrTemplateReferenceCycle)
+	})
+
+	t.Run("same template referenced twice", func(t *testing.T) {
+		_, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{
+			Creator:    creator,
+			Name:       "twice",
+			Definition: "template(1) || template(1)",
+		})
+		require.NoError(t, err)
+	})
+}
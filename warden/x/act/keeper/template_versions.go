@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// GetTemplateVersion returns a version of the Template with the given id.
+func (k Keeper) GetTemplateVersion(ctx context.Context, id, version uint64) (types.Template, error) {
+	return k.templateVersions.Get(ctx, collections.Join(id, version))
+}
+
+// setTemplate stores template as the latest version of its Template and adds
+// it to the template history. Stored versions are never modified.
+func (k Keeper) setTemplate(ctx context.Context, template types.Template) error {
+	if err := k.templates.Set(ctx, template.Id, template); err != nil {
+		return err
+	}
+
+	return k.templateVersions.Set(ctx, collections.Join(template.Id, template.Version), template)
+}
+
+// importTemplateVersions stores the versions of the templates and adds the
+// latest versions to the template history. Templates without a version,
+// created before templates were versioned, are set to version 1.
+func (k Keeper) importTemplateVersions(ctx context.Context, templates, versions []types.Template) error {
+	for _, version := range versions {
+		if err := k.templateVersions.Set(ctx, collections.Join(version.Id, version.Version), version); err != nil {
+			return err
+		}
+	}
+
+	for _, template := range templates {
+		if template.Version == 0 {
+			template.Version = 1
+			template.UpdatedBy = template.Creator
+		}
+
+		if err := k.setTemplate(ctx, template); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportTemplateVersions returns the versions of the templates that are not
+// the latest one, oldest first.
+func (k Keeper) exportTemplateVersions(ctx context.Context) ([]types.Template, error) {
+	it, err := k.templateVersions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var versions []types.Template
+	for ; it.Valid(); it.Next() {
+		version, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		latest, err := k.templates.Get(ctx, version.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if version.Version != latest.Version {
+			versions = append(versions, version)
+		}
+	}
+
+	return versions, nil
+}
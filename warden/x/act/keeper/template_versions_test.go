@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestTemplateVersions(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: created})
+	ms := keeper.NewMsgServerImpl(k)
+
+	res, err := ms.NewTemplate(ctx, &types.MsgNewTemplate{Creator: alice, Name: "approve", Definition: alice})
+	require.NoError(t, err)
+
+	// actions record the version of the templates they were created with
+	types.Register(k.TemplatesRegistry(), func(ctx context.Context, _ *types.MsgUpdateParams) (types.Template, types.Template, error) {
+		approve, err := k.GetTemplate(ctx, res.Id)
+		return approve, types.Template{Expression: shieldParse(t, bob)}, err
+	})
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+	pending, err := k.AddAction(ctx, bob, msgs, 0, shieldParse(t, alice), shieldParse(t, bob))
+	require.NoError(t, err)
+	require.Equal(t, []types.TemplateRef{{Id: res.Id, Version: 1}}, pending.ApproveTemplates)
+	require.Equal(t, []types.TemplateRef{{}}, pending.RejectTemplates)
+
+	updated := created.Add(time.Hour)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: updated}).WithEventManager(sdk.NewEventManager())
+	_, err = ms.UpdateTemplate(ctx, &types.MsgUpdateTemplate{Creator: alice, Id: res.Id, Name: "approve", Definition: alice + " || " + bob})
+	require.NoError(t, err)
+
+	var events []*types.EventUpdateTemplate
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		if ev, ok := msg.(*types.EventUpdateTemplate); ok {
+			events = append(events, ev)
+		}
+	}
+	require.Equal(t, []*types.EventUpdateTemplate{{
+		Id:            res.Id,
+		Version:       2,
+		UpdatedBy:     alice,
+		OldExpression: alice,
+		NewExpression: alice + " || " + bob,
+	}}, events)
+
+	latest, err := k.TemplateById(ctx, &types.QueryTemplateByIdRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest.Template.Version)
+	require.Equal(t, updated, latest.Template.UpdatedAt)
+
+	first, err := k.TemplateById(ctx, &types.QueryTemplateByIdRequest{Id: res.Id, Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, alice, shield.Format(first.Template.Expression))
+	require.Equal(t, created, first.Template.UpdatedAt)
+
+	history, err := k.TemplateVersions(ctx, &types.QueryTemplateVersionsRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Len(t, history.Templates, 2)
+	require.Equal(t, uint64(1), history.Templates[0].Version)
+	require.Equal(t, uint64(2), history.Templates[1].Version)
+
+	_, err = k.TemplateVersions(ctx, &types.QueryTemplateVersionsRequest{Id: res.Id + 1})
+	require.Error(t, err)
+
+	// the history survives an export and import of the genesis state
+	var genState types.GenesisState
+	require.NoError(t, k.ExportState(ctx, &genState))
+	require.Len(t, genState.TemplateVersions, 1)
+
+	k2, ctx2 := keepertest.ActKeeper(t)
+	require.NoError(t, k2.ImportState(ctx2, genState))
+	history2, err := k2.TemplateVersions(ctx2, &types.QueryTemplateVersionsRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, history.Templates, history2.Templates)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	k, ctx := keepertest.ActKeeper(t)
+
+	// template stored before templates were versioned
+	require.NoError(t, keeper.SetLegacyTemplate(ctx, k, types.Template{
+		Id:         1,
+		Creator:    "alice",
+		Name:       "legacy",
+		Expression: shieldParse(t, "alice"),
+	}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate4to5(ctx))
+
+	template, err := k.GetTemplate(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), template.Version)
+	require.Equal(t, "alice", template.UpdatedBy)
+
+	version, err := k.GetTemplateVersion(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, template, version)
+}
+
+func shieldParse(t *testing.T, definition string) *ast.Expression {
+	t.Helper()
+	expr, err := shield.Parse(definition)
+	require.NoError(t, err)
+	return expr
+}
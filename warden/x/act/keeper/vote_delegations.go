@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+// actionVotes returns the votes used to evaluate act: its votes, plus the
+// votes of the delegates of the addresses mentioned by act that didn't vote.
+// A delegated vote counts as a vote of the delegator, with the type of the
+// vote of the delegate. Delegations are not transitive.
+func (k Keeper) actionVotes(ctx context.Context, act *types.Action) ([]*types.ActionVote, error) {
+	voted := make(map[string]*types.ActionVote, len(act.Votes))
+	for _, v := range act.Votes {
+		voted[v.Participant] = v
+	}
+
+	votes := act.Votes
+	blockTime := k.getBlockTime(ctx)
+	for _, addr := range act.Mentions {
+		if _, ok := voted[addr]; ok {
+			continue
+		}
+
+		delegation, found, err := k.VoteDelegationKeeper.ActiveDelegation(ctx, addr, act, blockTime)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		if v, ok := voted[delegation.Delegate]; ok {
+			votes = append(votes[:len(votes):len(votes)], types.NewVote(addr, v.VoteType, v.VotedAt))
+		}
+	}
+
+	return votes, nil
+}
+
+// revokeVoteDelegation removes delegation and emits
+// EventVoteDelegationRevoked.
+func (k Keeper) revokeVoteDelegation(ctx context.Context, delegation types.VoteDelegation) error {
+	if err := k.VoteDelegationKeeper.Remove(ctx, delegation); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventVoteDelegationRevoked{
+		Id:        delegation.Id,
+		Delegator: delegation.Delegator,
+	})
+}
+
+// pruneVoteDelegations removes the delegations expired at the block time, so
+// that they aren't scanned anymore when evaluating Actions.
+func (k Keeper) pruneVoteDelegations(ctx context.Context) error {
+	ids, err := k.VoteDelegationKeeper.Expired(ctx, k.getBlockTime(ctx))
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		delegation, err := k.VoteDelegationKeeper.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := k.VoteDelegationKeeper.Remove(ctx, delegation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/warden-protocol/wardenprotocol/warden/repo"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+type VoteDelegationKeeper struct {
+	delegations repo.SeqCollection[types.VoteDelegation]
+
+	// delegationsByDelegator and delegationsByDelegate index the delegations
+	// by their delegator and by their delegate.
+	delegationsByDelegator collections.Map[collections.Pair[string, uint64], uint64]
+	delegationsByDelegate  collections.Map[collections.Pair[string, uint64], uint64]
+
+	// delegationsByExpiry indexes the delegations with an expiration by
+	// their ExpiresAt, see Expired.
+	delegationsByExpiry collections.KeySet[collections.Pair[time.Time, uint64]]
+}
+
+func newVoteDelegationKeeper(storeService store.KVStoreService, cdc codec.BinaryCodec) VoteDelegationKeeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	delegationsStore := collections.NewMap(sb, VoteDelegationPrefix, "vote_delegation", collections.Uint64Key, codec.CollValue[types.VoteDelegation](cdc))
+	delegationsCount := collections.NewSequence(sb, types.KeyPrefix(types.VoteDelegationCountKey), "vote_delegations_count")
+	delegations := repo.NewSeqCollection(delegationsCount, delegationsStore, func(d *types.VoteDelegation, u uint64) { d.Id = u })
+
+	delegationsByDelegator := collections.NewMap(
+		sb, VoteDelegationByDelegatorPrefix, "vote_delegations_by_delegator",
+		collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		collections.Uint64Value,
+	)
+
+	delegationsByDelegate := collections.NewMap(
+		sb, VoteDelegationByDelegatePrefix, "vote_delegations_by_delegate",
+		collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		collections.Uint64Value,
+	)
+
+	delegationsByExpiry := collections.NewKeySet(
+		sb, VoteDelegationByExpiryPrefix, "vote_delegations_by_expiry",
+		collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+	)
+
+	_, err := sb.Build()
+	if err != nil {
+		panic(fmt.Sprintf("failed to build schema: %s", err))
+	}
+
+	return VoteDelegationKeeper{
+		delegations:            delegations,
+		delegationsByDelegator: delegationsByDelegator,
+		delegationsByDelegate:  delegationsByDelegate,
+		delegationsByExpiry:    delegationsByExpiry,
+	}
+}
+
+func (k VoteDelegationKeeper) Get(ctx context.Context, id uint64) (types.VoteDelegation, error) {
+	return k.delegations.Get(ctx, id)
+}
+
+// New stores a new delegation and indexes it.
+func (k VoteDelegationKeeper) New(ctx context.Context, delegation *types.VoteDelegation) (uint64, error) {
+	id, err := k.delegations.Append(ctx, delegation)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, k.index(ctx, *delegation)
+}
+
+func (k VoteDelegationKeeper) index(ctx context.Context, delegation types.VoteDelegation) error {
+	if err := k.delegationsByDelegator.Set(ctx, collections.Join(delegation.Delegator, delegation.Id), delegation.Id); err != nil {
+		return err
+	}
+
+	if err := k.delegationsByDelegate.Set(ctx, collections.Join(delegation.Delegate, delegation.Id), delegation.Id); err != nil {
+		return err
+	}
+
+	if delegation.ExpiresAt == nil {
+		return nil
+	}
+
+	return k.delegationsByExpiry.Set(ctx, collections.Join(*delegation.ExpiresAt, delegation.Id))
+}
+
+// Remove removes a delegation and its indexes.
+func (k VoteDelegationKeeper) Remove(ctx context.Context, delegation types.VoteDelegation) error {
+	if err := k.delegations.Remove(ctx, delegation.Id); err != nil {
+		return err
+	}
+
+	if err := k.delegationsByDelegator.Remove(ctx, collections.Join(delegation.Delegator, delegation.Id)); err != nil {
+		return err
+	}
+
+	if err := k.delegationsByDelegate.Remove(ctx, collections.Join(delegation.Delegate, delegation.Id)); err != nil {
+		return err
+	}
+
+	if delegation.ExpiresAt == nil {
+		return nil
+	}
+
+	return k.delegationsByExpiry.Remove(ctx, collections.Join(*delegation.ExpiresAt, delegation.Id))
+}
+
+// Expired returns the ids of the delegations whose ExpiresAt is not after t.
+func (k VoteDelegationKeeper) Expired(ctx context.Context, t time.Time) ([]uint64, error) {
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](t)
+	it, err := k.delegationsByExpiry.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.K2())
+	}
+	return ids, nil
+}
+
+// ByDelegator returns the delegations of delegator, including the expired
+// ones that haven't been pruned yet, see Keeper.pruneVoteDelegations.
+func (k VoteDelegationKeeper) ByDelegator(ctx context.Context, delegator string) ([]types.VoteDelegation, error) {
+	rng := collections.NewPrefixedPairRange[string, uint64](delegator)
+	it, err := k.delegationsByDelegator.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	ids, err := it.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	delegations := make([]types.VoteDelegation, 0, len(ids))
+	for _, id := range ids {
+		delegation, err := k.delegations.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, delegation)
+	}
+	return delegations, nil
+}
+
+// ActiveDelegation returns the delegation of delegator that applies to act
+// at time t. If more than one applies, the most specific one is returned,
+// see VoteDelegation.Specificity.
+func (k VoteDelegationKeeper) ActiveDelegation(ctx context.Context, delegator string, act *types.Action, t time.Time) (types.VoteDelegation, bool, error) {
+	delegations, err := k.ByDelegator(ctx, delegator)
+	if err != nil {
+		return types.VoteDelegation{}, false, err
+	}
+
+	var (
+		best  types.VoteDelegation
+		found bool
+	)
+	for _, d := range delegations {
+		if !d.IsActive(t) || !d.AppliesTo(act) {
+			continue
+		}
+		if !found || d.Specificity() > best.Specificity() {
+			best, found = d, true
+		}
+	}
+	return best, found, nil
+}
+
+func (k VoteDelegationKeeper) Import(ctx context.Context, delegations []types.VoteDelegation) error {
+	err := k.delegations.Import(ctx, delegations, func(d types.VoteDelegation) uint64 {
+		return d.Id
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		if err := k.index(ctx, delegation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k VoteDelegationKeeper) Export(ctx context.Context) ([]types.VoteDelegation, error) {
+	return k.delegations.Export(ctx)
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestVoteDelegations(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+	carol := sdk.AccAddress("carol_address_______").String()
+	t0 := time.Unix(1_700_000_000, 0).UTC()
+
+	k, ctx := keepertest.ActKeeperWithExpander(t, func() ast.Expander {
+		return ownersExpander{owners: &[]string{}}
+	})
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: t0}).WithBlockTime(t0)
+
+	approve := shieldParse(t, alice+" && "+bob)
+	reject := shieldParse(t, bob)
+	types.RegisterCtx(k.TemplatesRegistry(), func(ctx context.Context, _ *types.MsgUpdateParams) (context.Context, types.Template, types.Template, error) {
+		return types.WithSpaceID(ctx, 7), types.Template{Expression: approve}, types.Template{Expression: reject}, nil
+	})
+	k.TemplatesRegistry().RegisterSpaces(func(_ context.Context, spaceID uint64) (bool, error) {
+		return spaceID == 7 || spaceID == 8, nil
+	})
+	keeper.SetRouter(&k, testRouter{
+		sdk.MsgTypeURL(&types.MsgUpdateParams{}): func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return sdk.WrapServiceResult(ctx, &types.MsgUpdateParamsResponse{}, nil)
+		},
+	})
+	ms := keeper.NewMsgServerImpl(k)
+	msgs := []sdk.Msg{&types.MsgUpdateParams{Authority: k.GetModuleAddress()}}
+
+	status := func(id uint64) types.ActionStatus {
+		act, err := k.ActionKeeper.Get(ctx, id)
+		require.NoError(t, err)
+		return act.Status
+	}
+	vote := func(participant string, id uint64, voteType types.ActionVoteType) {
+		_, err := ms.VoteForAction(ctx, &types.MsgVoteForAction{Participant: participant, ActionId: id, VoteType: voteType})
+		require.NoError(t, err)
+	}
+
+	_, err := ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: bob})
+	require.ErrorIs(t, err, types.ErrInvalidVoteDelegation)
+
+	past := t0.Add(-time.Hour)
+	_, err = ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, ExpiresAt: &past})
+	require.ErrorIs(t, err, types.ErrInvalidVoteDelegation)
+
+	_, err = ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: 9})
+	require.ErrorIs(t, err, types.ErrInvalidVoteDelegation)
+
+	// a delegation scoped to another Space doesn't apply
+	other, err := ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: 8})
+	require.NoError(t, err)
+
+	act, err := k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7}, act.SpaceIds)
+
+	vote(carol, act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, status(act.Id))
+
+	// delegating re-evaluates the open actions of the delegator
+	expires := t0.Add(time.Hour)
+	delegated, err := ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: 7, ExpiresAt: &expires})
+	require.NoError(t, err)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, status(act.Id))
+
+	// the delegator's own vote wins over the delegate's
+	act, err = k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	vote(bob, act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_COMPLETED, status(act.Id))
+
+	act, err = k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	vote(carol, act.Id, types.ActionVoteType_VOTE_TYPE_REJECTED)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_REVOKED, status(act.Id))
+
+	res, err := k.VoteDelegations(ctx, &types.QueryVoteDelegationsRequest{Delegate: carol})
+	require.NoError(t, err)
+	require.Len(t, res.Delegations, 2)
+
+	// only the delegator can revoke a delegation
+	_, err = ms.RevokeVoteDelegation(ctx, &types.MsgRevokeVoteDelegation{Delegator: carol, Id: other.Id})
+	require.ErrorIs(t, err, types.ErrInvalidVoteDelegation)
+	_, err = ms.RevokeVoteDelegation(ctx, &types.MsgRevokeVoteDelegation{Delegator: bob, Id: other.Id})
+	require.NoError(t, err)
+
+	// expired delegations are ignored
+	t1 := expires
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, Time: t1}).WithBlockTime(t1)
+	act, err = k.AddAction(ctx, alice, msgs, 0, approve, reject)
+	require.NoError(t, err)
+	vote(carol, act.Id, types.ActionVoteType_VOTE_TYPE_APPROVED)
+	require.Equal(t, types.ActionStatus_ACTION_STATUS_PENDING, status(act.Id))
+
+	res, err = k.VoteDelegations(ctx, &types.QueryVoteDelegationsRequest{Delegator: bob})
+	require.NoError(t, err)
+	require.Empty(t, res.Delegations)
+
+	// expired delegations are pruned at the end of the block
+	var genState types.GenesisState
+	require.NoError(t, k.ExportState(ctx, &genState))
+	require.Len(t, genState.VoteDelegations, 1)
+	require.Equal(t, delegated.Id, genState.VoteDelegations[0].Id)
+
+	require.NoError(t, k.EndBlocker(ctx))
+	_, err = k.VoteDelegationKeeper.Get(ctx, delegated.Id)
+	require.Error(t, err)
+
+	genState = types.GenesisState{}
+	require.NoError(t, k.ExportState(ctx, &genState))
+	require.Empty(t, genState.VoteDelegations)
+}
+
+func TestVoteDelegationsLimit(t *testing.T) {
+	bob := sdk.AccAddress("bob_address_________").String()
+	carol := sdk.AccAddress("carol_address_______").String()
+	t0 := time.Unix(1_700_000_000, 0).UTC()
+
+	k, ctx := keepertest.ActKeeper(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: t0}).WithBlockTime(t0)
+	k.TemplatesRegistry().RegisterSpaces(func(_ context.Context, _ uint64) (bool, error) {
+		return true, nil
+	})
+	ms := keeper.NewMsgServerImpl(k)
+
+	// the first delegation expires, the others don't
+	expires := t0.Add(time.Hour)
+	_, err := ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: 1, ExpiresAt: &expires})
+	require.NoError(t, err)
+	for i := 2; i <= types.MaxVoteDelegations; i++ {
+		_, err := ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: uint64(i)})
+		require.NoError(t, err)
+	}
+
+	_, err = ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: types.MaxVoteDelegations + 1})
+	require.ErrorIs(t, err, types.ErrInvalidVoteDelegation)
+
+	// replacing a delegation doesn't add one
+	_, err = ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: 2})
+	require.NoError(t, err)
+
+	// expired delegations don't count
+	ctx = ctx.WithHeaderInfo(header.Info{Time: expires}).WithBlockTime(expires)
+	_, err = ms.DelegateVote(ctx, &types.MsgDelegateVote{Delegator: bob, Delegate: carol, SpaceId: types.MaxVoteDelegations + 1})
+	require.NoError(t, err)
+}
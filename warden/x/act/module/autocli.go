@@ -22,6 +22,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "templates",
 					Short:     "List all templates",
 				},
+				{
+					RpcMethod:      "TemplateVersions",
+					Use:            "template-versions [id]",
+					Short:          "List the versions of a template",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "VoteDelegations",
+					Use:       "vote-delegations",
+					Short:     "List the active vote delegations",
+				},
+				{
+					RpcMethod:      "ContractTemplates",
+					Use:            "contract-templates [contract]",
+					Short:          "Shows the templates of the actions executing a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
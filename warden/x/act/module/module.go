@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/log"
 	"encoding/json"
 	"fmt"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -120,6 +121,14 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -143,7 +152,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -187,7 +196,8 @@ type ModuleInputs struct {
 	Logger       log.Logger
 	Router       baseapp.MessageRouter
 
-	ShieldExpanderFunc func() ast.Expander `optional:"true"`
+	ShieldExpanderFunc func() ast.Expander      `optional:"true"`
+	GetWasmKeeper      func() wasmkeeper.Keeper `optional:"true"`
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
@@ -211,12 +221,19 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	actModuleAddress := authtypes.NewModuleAddress(types.ModuleName)
 
 	shieldExpanderFunc := func() ast.Expander {
-		return cosmoshield.NewExpanderManager()
+		return cosmoshield.NewExpanderManager(
+			cosmoshield.NewPrefixedExpander(cosmoshield.MsgNamespace, cosmoshield.NewMsgExpander()),
+		)
 	}
 	if in.ShieldExpanderFunc != nil {
 		shieldExpanderFunc = in.ShieldExpanderFunc
 	}
 
+	var getWasmKeeper func() types.WasmKeeper
+	if in.GetWasmKeeper != nil {
+		getWasmKeeper = func() types.WasmKeeper { return in.GetWasmKeeper() }
+	}
+
 	r := types.NewTemplatesRegistry()
 	k := keeper.NewKeeper(
 		in.Cdc,
@@ -227,7 +244,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		actModuleAddress.String(),
 		shieldExpanderFunc,
 		r,
+		getWasmKeeper,
 	)
+	k.RegisterTemplates(r)
+
 	m := NewAppModule(
 		in.Cdc,
 		k,
@@ -1,13 +1,23 @@
 package v1beta1
 
 import (
+	"context"
 	"time"
 
 	"cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/warden-protocol/wardenprotocol/shield"
 )
 
+// MaxActionRetries is the maximum number of times the execution of an action
+// without a TimeoutHeight can be retried, see MsgRetryAction.
+const MaxActionRetries = 10
+
+// MaxTimeDependentReevaluations is the maximum number of time dependent
+// actions re-evaluated by the EndBlocker in a block.
+const MaxTimeDependentReevaluations = 100
+
 func NewVote(participant string, voteType ActionVoteType, timestamp time.Time) *ActionVote {
 	return &ActionVote{
 		Participant: participant,
@@ -18,6 +28,58 @@ func NewVote(participant string, voteType ActionVoteType, timestamp time.Time) *
 
 func (a *Action) SetId(id uint64) { a.Id = id }
 
+// Messages returns the messages of the Action in execution order: Msgs for
+// multi-message actions, Msg otherwise.
+func (a *Action) Messages() []*codectypes.Any {
+	if len(a.Msgs) > 0 {
+		return a.Msgs
+	}
+	if a.Msg == nil {
+		return nil
+	}
+	return []*codectypes.Any{a.Msg}
+}
+
+// Compile compiles the approve and reject expressions of the Action, so that
+// they don't need to be walked every time the Action is evaluated.
+func (a *Action) Compile() error {
+	approve, err := shield.Compile(&a.ApproveExpression)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidExpressionDefinition, "approve expression: %v", err)
+	}
+
+	reject, err := shield.Compile(&a.RejectExpression)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidExpressionDefinition, "reject expression: %v", err)
+	}
+
+	a.ApproveBytecode = approve
+	a.RejectBytecode = reject
+	return nil
+}
+
+// EvalApprove evaluates the approve expression of the Action, using its
+// bytecode if the Action has been compiled.
+func (a *Action) EvalApprove(ctx context.Context, env shield.Environment) (bool, error) {
+	if len(a.ApproveBytecode) > 0 {
+		return ActBytecode(a.ApproveBytecode).EvalExpression(ctx, env)
+	}
+
+	expr := ActExpression(a.ApproveExpression)
+	return expr.EvalExpression(ctx, env)
+}
+
+// EvalReject evaluates the reject expression of the Action, using its
+// bytecode if the Action has been compiled.
+func (a *Action) EvalReject(ctx context.Context, env shield.Environment) (bool, error) {
+	if len(a.RejectBytecode) > 0 {
+		return ActBytecode(a.RejectBytecode).EvalExpression(ctx, env)
+	}
+
+	expr := ActExpression(a.RejectExpression)
+	return expr.EvalExpression(ctx, env)
+}
+
 func (a *Action) SetResult(ctx sdk.Context, result *codectypes.Any) error {
 	if err := a.SetStatus(ctx, ActionStatus_ACTION_STATUS_COMPLETED); err != nil {
 		return err
@@ -27,23 +89,86 @@ func (a *Action) SetResult(ctx sdk.Context, result *codectypes.Any) error {
 	return nil
 }
 
+// SetFailed sets the status of the Action to ACTION_STATUS_FAILED, storing the
+// error returned by the execution of its messages.
+func (a *Action) SetFailed(ctx sdk.Context, execErr error) error {
+	return a.setStatus(ctx, ActionStatus_ACTION_STATUS_FAILED, execErr.Error())
+}
+
 func (a *Action) SetStatus(ctx sdk.Context, status ActionStatus) error {
-	if a.Status != ActionStatus_ACTION_STATUS_PENDING {
+	return a.setStatus(ctx, status, "")
+}
+
+func (a *Action) setStatus(ctx sdk.Context, status ActionStatus, execErr string) error {
+	if !a.canChangeStatus(status) {
 		return errors.Wrapf(ErrInvalidActionStatusChange, "from %s to %s", a.Status.String(), status.String())
 	}
 	prevStatus := a.Status
 	a.Status = status
+	a.Error = execErr
 	a.UpdatedAt = ctx.BlockTime()
 	return ctx.EventManager().EmitTypedEvent(&EventActionStateChange{
 		Id:             a.Id,
 		PreviousStatus: prevStatus,
 		NewStatus:      status,
+		Error:          execErr,
+	})
+}
+
+// canChangeStatus returns true if the Action can go from its current status
+// to status. Pending Actions can change to any status, queued Actions can be
+// executed or revoked, failed Actions can be retried or revoked.
+func (a *Action) canChangeStatus(status ActionStatus) bool {
+	switch a.Status {
+	case ActionStatus_ACTION_STATUS_PENDING:
+		return true
+	case ActionStatus_ACTION_STATUS_QUEUED:
+		return status == ActionStatus_ACTION_STATUS_COMPLETED ||
+			status == ActionStatus_ACTION_STATUS_FAILED ||
+			status == ActionStatus_ACTION_STATUS_REVOKED
+	case ActionStatus_ACTION_STATUS_FAILED:
+		return status == ActionStatus_ACTION_STATUS_COMPLETED ||
+			status == ActionStatus_ACTION_STATUS_FAILED ||
+			status == ActionStatus_ACTION_STATUS_REVOKED
+	default:
+		return false
+	}
+}
+
+// IsOpen returns true if the Action can still be voted and rejected: it's
+// pending, or queued for execution.
+func (a *Action) IsOpen() bool {
+	return a.Status == ActionStatus_ACTION_STATUS_PENDING || a.Status == ActionStatus_ACTION_STATUS_QUEUED
+}
+
+// Queue sets the status of the Action to ACTION_STATUS_QUEUED, to be executed
+// after its ExecutionDelay.
+func (a *Action) Queue(ctx sdk.Context) error {
+	if err := a.SetStatus(ctx, ActionStatus_ACTION_STATUS_QUEUED); err != nil {
+		return err
+	}
+
+	executeAfter := ctx.BlockTime().Add(a.ExecutionDelay)
+	a.ExecuteAfter = &executeAfter
+	return ctx.EventManager().EmitTypedEvent(&EventActionQueued{
+		Id:           a.Id,
+		ExecuteAfter: executeAfter,
 	})
 }
 
+// HasVoted returns true if participant has a vote on the Action.
+func (a *Action) HasVoted(participant string) bool {
+	for _, v := range a.Votes {
+		if v.Participant == participant {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *Action) AddOrUpdateVote(ctx sdk.Context, participant string, voteType ActionVoteType) error {
-	if a.Status != ActionStatus_ACTION_STATUS_PENDING {
-		return errors.Wrapf(ErrInvalidActionStatus, "can't add a vote to an action that's not pending")
+	if !a.IsOpen() {
+		return errors.Wrapf(ErrInvalidActionStatus, "can't add a vote to an action that's not pending or queued")
 	}
 
 	updated := false
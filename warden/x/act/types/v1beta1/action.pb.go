@@ -11,6 +11,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	ast "github.com/warden-protocol/wardenprotocol/shield/ast"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -44,6 +45,12 @@ const (
 	ActionStatus_ACTION_STATUS_REVOKED ActionStatus = 3
 	// Action has been rejected since TimeoutHeight has been reached.
 	ActionStatus_ACTION_STATUS_TIMEOUT ActionStatus = 4
+	// Action has been approved but the execution of its messages failed. It can
+	// be retried until TimeoutHeight is reached.
+	ActionStatus_ACTION_STATUS_FAILED ActionStatus = 5
+	// Action has been approved and its execution is delayed until
+	// execute_after. It can still be rejected.
+	ActionStatus_ACTION_STATUS_QUEUED ActionStatus = 6
 )
 
 var ActionStatus_name = map[int32]string{
@@ -52,6 +59,8 @@ var ActionStatus_name = map[int32]string{
 	2: "ACTION_STATUS_COMPLETED",
 	3: "ACTION_STATUS_REVOKED",
 	4: "ACTION_STATUS_TIMEOUT",
+	5: "ACTION_STATUS_FAILED",
+	6: "ACTION_STATUS_QUEUED",
 }
 
 var ActionStatus_value = map[string]int32{
@@ -60,6 +69,8 @@ var ActionStatus_value = map[string]int32{
 	"ACTION_STATUS_COMPLETED":   2,
 	"ACTION_STATUS_REVOKED":     3,
 	"ACTION_STATUS_TIMEOUT":     4,
+	"ACTION_STATUS_FAILED":      5,
+	"ACTION_STATUS_QUEUED":      6,
 }
 
 func (x ActionStatus) String() string {
@@ -95,6 +106,50 @@ type Action struct {
 	RejectExpression ast.Expression `protobuf:"bytes,11,opt,name=reject_expression,json=rejectExpression,proto3" json:"reject_expression"`
 	// The votes accepted from the voting participants.
 	Votes []*ActionVote `protobuf:"bytes,12,rep,name=votes,proto3" json:"votes,omitempty"`
+	// The compiled approve_expression, evaluated instead of the expression when
+	// present.
+	ApproveBytecode []byte `protobuf:"bytes,13,opt,name=approve_bytecode,json=approveBytecode,proto3" json:"approve_bytecode,omitempty"`
+	// The compiled reject_expression, evaluated instead of the expression when
+	// present.
+	RejectBytecode []byte `protobuf:"bytes,14,opt,name=reject_bytecode,json=rejectBytecode,proto3" json:"reject_bytecode,omitempty"`
+	// The dynamic inputs, such as the owners of a Space, the expressions depend
+	// on. When one of them changes, the expressions are expanded again.
+	Dependencies []string `protobuf:"bytes,15,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// The approve expression before the expansion of its dependencies, set when
+	// it has any.
+	ApproveTemplateExpression *ast.Expression `protobuf:"bytes,16,opt,name=approve_template_expression,json=approveTemplateExpression,proto3" json:"approve_template_expression,omitempty"`
+	// The reject expression before the expansion of its dependencies, set when
+	// it has any.
+	RejectTemplateExpression *ast.Expression `protobuf:"bytes,17,opt,name=reject_template_expression,json=rejectTemplateExpression,proto3" json:"reject_template_expression,omitempty"`
+	// The messages of a multi-message action, executed in order and atomically
+	// when the action is approved. msg is not set when msgs is.
+	Msgs []*types.Any `protobuf:"bytes,18,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// The expressions of each message of msgs, approve_expression and
+	// reject_expression are derived by combining them.
+	MsgExpressions []ActionMsgExpressions `protobuf:"bytes,19,rep,name=msg_expressions,json=msgExpressions,proto3" json:"msg_expressions"`
+	// The error returned by the execution of the messages, set when the action
+	// is in ACTION_STATUS_FAILED.
+	Error string `protobuf:"bytes,20,opt,name=error,proto3" json:"error,omitempty"`
+	// The delay between the approval of the action and its execution, taken
+	// from the approve templates of its messages.
+	ExecutionDelay time.Duration `protobuf:"bytes,21,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	// The time after which a queued action is executed, set when the action is
+	// in ACTION_STATUS_QUEUED.
+	ExecuteAfter *time.Time `protobuf:"bytes,22,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after,omitempty"`
+	// The versions of the approve and reject templates the action was created
+	// with, one for each message in execution order.
+	ApproveTemplates []TemplateRef `protobuf:"bytes,23,rep,name=approve_templates,json=approveTemplates,proto3" json:"approve_templates"`
+	RejectTemplates  []TemplateRef `protobuf:"bytes,24,rep,name=reject_templates,json=rejectTemplates,proto3" json:"reject_templates"`
+	// The ids of the Spaces the messages of the action belong to, as reported
+	// by the templates registry.
+	SpaceIds []uint64 `protobuf:"varint,25,rep,packed,name=space_ids,json=spaceIds,proto3" json:"space_ids,omitempty"`
+	// The error returned by the last re-evaluation of the expressions in
+	// EndBlocker. While it is set, the action is not re-evaluated at every block
+	// anymore, until one of its dependencies changes.
+	EvaluationError string `protobuf:"bytes,26,opt,name=evaluation_error,json=evaluationError,proto3" json:"evaluation_error,omitempty"`
+	// The number of times the creator retried the execution of the action
+	// after it failed, see MsgRetryAction.
+	Retries uint32 `protobuf:"varint,27,opt,name=retries,proto3" json:"retries,omitempty"`
 }
 
 func (m *Action) Reset()         { *m = Action{} }
@@ -214,53 +269,306 @@ func (m *Action) GetVotes() []*ActionVote {
 	return nil
 }
 
+func (m *Action) GetApproveBytecode() []byte {
+	if m != nil {
+		return m.ApproveBytecode
+	}
+	return nil
+}
+
+func (m *Action) GetRejectBytecode() []byte {
+	if m != nil {
+		return m.RejectBytecode
+	}
+	return nil
+}
+
+func (m *Action) GetDependencies() []string {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *Action) GetApproveTemplateExpression() *ast.Expression {
+	if m != nil {
+		return m.ApproveTemplateExpression
+	}
+	return nil
+}
+
+func (m *Action) GetRejectTemplateExpression() *ast.Expression {
+	if m != nil {
+		return m.RejectTemplateExpression
+	}
+	return nil
+}
+
+func (m *Action) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *Action) GetMsgExpressions() []ActionMsgExpressions {
+	if m != nil {
+		return m.MsgExpressions
+	}
+	return nil
+}
+
+func (m *Action) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Action) GetExecutionDelay() time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *Action) GetExecuteAfter() *time.Time {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return nil
+}
+
+func (m *Action) GetApproveTemplates() []TemplateRef {
+	if m != nil {
+		return m.ApproveTemplates
+	}
+	return nil
+}
+
+func (m *Action) GetRejectTemplates() []TemplateRef {
+	if m != nil {
+		return m.RejectTemplates
+	}
+	return nil
+}
+
+func (m *Action) GetSpaceIds() []uint64 {
+	if m != nil {
+		return m.SpaceIds
+	}
+	return nil
+}
+
+func (m *Action) GetEvaluationError() string {
+	if m != nil {
+		return m.EvaluationError
+	}
+	return ""
+}
+
+func (m *Action) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+// ActionMsgExpressions contains the expressions of a single message of a
+// multi-message action.
+type ActionMsgExpressions struct {
+	// The expression to be evaluated for approval.
+	ApproveExpression ast.Expression `protobuf:"bytes,1,opt,name=approve_expression,json=approveExpression,proto3" json:"approve_expression"`
+	// The expression to be evaluated for rejection.
+	RejectExpression ast.Expression `protobuf:"bytes,2,opt,name=reject_expression,json=rejectExpression,proto3" json:"reject_expression"`
+	// The approve expression before the expansion of its dependencies, set when
+	// it has any.
+	ApproveTemplateExpression *ast.Expression `protobuf:"bytes,3,opt,name=approve_template_expression,json=approveTemplateExpression,proto3" json:"approve_template_expression,omitempty"`
+	// The reject expression before the expansion of its dependencies, set when
+	// it has any.
+	RejectTemplateExpression *ast.Expression `protobuf:"bytes,4,opt,name=reject_template_expression,json=rejectTemplateExpression,proto3" json:"reject_template_expression,omitempty"`
+}
+
+func (m *ActionMsgExpressions) Reset()         { *m = ActionMsgExpressions{} }
+func (m *ActionMsgExpressions) String() string { return proto.CompactTextString(m) }
+func (*ActionMsgExpressions) ProtoMessage()    {}
+func (*ActionMsgExpressions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed852fba5dd71480, []int{1}
+}
+func (m *ActionMsgExpressions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionMsgExpressions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionMsgExpressions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionMsgExpressions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionMsgExpressions.Merge(m, src)
+}
+func (m *ActionMsgExpressions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionMsgExpressions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionMsgExpressions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionMsgExpressions proto.InternalMessageInfo
+
+func (m *ActionMsgExpressions) GetApproveExpression() ast.Expression {
+	if m != nil {
+		return m.ApproveExpression
+	}
+	return ast.Expression{}
+}
+
+func (m *ActionMsgExpressions) GetRejectExpression() ast.Expression {
+	if m != nil {
+		return m.RejectExpression
+	}
+	return ast.Expression{}
+}
+
+func (m *ActionMsgExpressions) GetApproveTemplateExpression() *ast.Expression {
+	if m != nil {
+		return m.ApproveTemplateExpression
+	}
+	return nil
+}
+
+func (m *ActionMsgExpressions) GetRejectTemplateExpression() *ast.Expression {
+	if m != nil {
+		return m.RejectTemplateExpression
+	}
+	return nil
+}
+
+// ActionResults is the result of a multi-message action, it contains the
+// response of each message in the same order as Action.msgs.
+type ActionResults struct {
+	Results []*types.Any `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *ActionResults) Reset()         { *m = ActionResults{} }
+func (m *ActionResults) String() string { return proto.CompactTextString(m) }
+func (*ActionResults) ProtoMessage()    {}
+func (*ActionResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed852fba5dd71480, []int{2}
+}
+func (m *ActionResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionResults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionResults.Merge(m, src)
+}
+func (m *ActionResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionResults proto.InternalMessageInfo
+
+func (m *ActionResults) GetResults() []*types.Any {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("warden.act.v1beta1.ActionStatus", ActionStatus_name, ActionStatus_value)
 	proto.RegisterType((*Action)(nil), "warden.act.v1beta1.Action")
+	proto.RegisterType((*ActionMsgExpressions)(nil), "warden.act.v1beta1.ActionMsgExpressions")
+	proto.RegisterType((*ActionResults)(nil), "warden.act.v1beta1.ActionResults")
 }
 
 func init() { proto.RegisterFile("warden/act/v1beta1/action.proto", fileDescriptor_ed852fba5dd71480) }
 
 var fileDescriptor_ed852fba5dd71480 = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x53, 0xd3, 0x40,
-	0x14, 0xef, 0xb6, 0xa5, 0xd0, 0xe5, 0xcf, 0x94, 0x1d, 0xd4, 0x50, 0xc7, 0x34, 0x3a, 0xea, 0x74,
-	0x18, 0x4d, 0x06, 0xf4, 0xe0, 0xb5, 0xd0, 0x28, 0x1d, 0xa4, 0x65, 0xd2, 0xc0, 0xc1, 0x83, 0x9d,
-	0x6d, 0xb2, 0xa6, 0x71, 0x9a, 0x6c, 0x26, 0xbb, 0x41, 0xf8, 0x16, 0xdc, 0xfd, 0x02, 0x1e, 0xfd,
-	0x18, 0x1c, 0x39, 0x7a, 0x52, 0x07, 0x0e, 0x7c, 0x0d, 0x27, 0x9b, 0x0d, 0x16, 0x2a, 0x8e, 0x1e,
-	0xda, 0xd9, 0xf7, 0x7e, 0x7f, 0x5e, 0xde, 0xdb, 0xb7, 0xb0, 0xf1, 0x09, 0xc7, 0x2e, 0x09, 0x0d,
-	0xec, 0x70, 0xe3, 0x70, 0x7d, 0x48, 0x38, 0x5e, 0x4f, 0xcf, 0x3e, 0x0d, 0xf5, 0x28, 0xa6, 0x9c,
-	0x22, 0x94, 0x11, 0x74, 0xec, 0x70, 0x5d, 0x12, 0xea, 0xcb, 0x38, 0xf0, 0x43, 0x6a, 0x88, 0xff,
-	0x8c, 0x56, 0x5f, 0xf1, 0xa8, 0x47, 0xc5, 0xd1, 0x48, 0x4f, 0x32, 0xbb, 0xea, 0x51, 0xea, 0x8d,
-	0x89, 0x21, 0xa2, 0x61, 0xf2, 0xc1, 0xc0, 0xe1, 0xb1, 0x84, 0x1a, 0x37, 0x21, 0xee, 0x07, 0x84,
-	0x71, 0x1c, 0x44, 0xb9, 0x23, 0x1b, 0xf9, 0x64, 0xec, 0x1a, 0x98, 0xf1, 0xf4, 0x27, 0xb3, 0x0f,
-	0xff, 0xf0, 0xbd, 0x9c, 0x04, 0xd1, 0x18, 0x73, 0x22, 0x29, 0x8f, 0x6f, 0x6d, 0x69, 0x70, 0x48,
-	0x73, 0xd6, 0xa3, 0xcb, 0x32, 0xac, 0xb4, 0x44, 0x16, 0x2d, 0xc1, 0xa2, 0xef, 0x2a, 0x40, 0x03,
-	0xcd, 0xb2, 0x55, 0xf4, 0x5d, 0xf4, 0x0a, 0x56, 0x18, 0xc7, 0x3c, 0x61, 0x4a, 0x51, 0x03, 0xcd,
-	0xa5, 0x0d, 0x4d, 0x9f, 0x9e, 0x81, 0x9e, 0x69, 0xfb, 0x82, 0x67, 0x49, 0x3e, 0x7a, 0x0a, 0x4b,
-	0x01, 0xf3, 0x94, 0x92, 0x06, 0x9a, 0xf3, 0x1b, 0x2b, 0x7a, 0xd6, 0xa2, 0x9e, 0xb7, 0xa8, 0xb7,
-	0xc2, 0x63, 0x2b, 0x25, 0xa0, 0x67, 0xb0, 0x12, 0x13, 0x96, 0x8c, 0xb9, 0x52, 0xfe, 0x0b, 0x55,
-	0x72, 0x90, 0x02, 0x67, 0x9d, 0x98, 0x60, 0x4e, 0x63, 0x65, 0x46, 0x03, 0xcd, 0xaa, 0x95, 0x87,
-	0xe8, 0x09, 0x5c, 0x4a, 0xc7, 0x46, 0x13, 0x3e, 0x18, 0x11, 0xdf, 0x1b, 0x71, 0xa5, 0x22, 0xba,
-	0x58, 0x94, 0xd9, 0x6d, 0x91, 0x44, 0xdb, 0x10, 0x0a, 0x05, 0x71, 0x07, 0x98, 0x2b, 0xb3, 0xa2,
-	0x64, 0x7d, 0xaa, 0xa4, 0x9d, 0x5f, 0xc0, 0xe6, 0xe2, 0xe9, 0xf7, 0x46, 0xe1, 0xe4, 0x47, 0x03,
-	0x7c, 0xb9, 0xfc, 0xba, 0x06, 0xac, 0xaa, 0x14, 0xb7, 0x84, 0x53, 0x12, 0xb9, 0xb9, 0xd3, 0xdc,
-	0x7f, 0x3b, 0x49, 0x71, 0x8b, 0xa3, 0x3a, 0x9c, 0x0b, 0x48, 0x98, 0xce, 0x90, 0x29, 0x55, 0xad,
-	0xd4, 0xac, 0x5a, 0x57, 0x31, 0xda, 0x81, 0x08, 0x47, 0x51, 0x4c, 0x0f, 0xc9, 0x80, 0x1c, 0x45,
-	0x31, 0x61, 0xcc, 0xa7, 0xa1, 0x02, 0x45, 0xb5, 0xbb, 0x7a, 0xb6, 0x17, 0x7a, 0xba, 0x13, 0xe6,
-	0x15, 0xba, 0x59, 0x4e, 0x2b, 0x59, 0xcb, 0x52, 0xf7, 0x1b, 0x40, 0x1d, 0xb8, 0x1c, 0x93, 0x8f,
-	0xc4, 0xe1, 0x93, 0x5e, 0xf3, 0xff, 0xe0, 0x55, 0xcb, 0x64, 0x13, 0x56, 0x2f, 0xe1, 0x4c, 0xba,
-	0x41, 0x4c, 0x59, 0xd0, 0x4a, 0xcd, 0xf9, 0x0d, 0xf5, 0xf6, 0xbd, 0x38, 0xa0, 0x9c, 0x58, 0x19,
-	0x79, 0xed, 0x33, 0x80, 0x0b, 0x93, 0xdb, 0x82, 0x1e, 0xc0, 0xd5, 0xd6, 0x96, 0xdd, 0xe9, 0x75,
-	0x07, 0x7d, 0xbb, 0x65, 0xef, 0xf7, 0x07, 0xfb, 0xdd, 0xfe, 0x9e, 0xb9, 0xd5, 0x79, 0xdd, 0x31,
-	0xdb, 0xb5, 0x02, 0x5a, 0x85, 0x77, 0xae, 0xc3, 0x7b, 0x66, 0xb7, 0xdd, 0xe9, 0xbe, 0xa9, 0x01,
-	0x74, 0x1f, 0xde, 0xbb, 0x0e, 0x6d, 0xf5, 0x76, 0xf7, 0xde, 0x9a, 0xb6, 0xd9, 0xae, 0x15, 0xa7,
-	0x75, 0x96, 0x79, 0xd0, 0xdb, 0x31, 0xdb, 0xb5, 0xd2, 0x34, 0x64, 0x77, 0x76, 0xcd, 0xde, 0xbe,
-	0x5d, 0x2b, 0x6f, 0xbe, 0x3f, 0x3d, 0x57, 0xc1, 0xd9, 0xb9, 0x0a, 0x7e, 0x9e, 0xab, 0xe0, 0xe4,
-	0x42, 0x2d, 0x9c, 0x5d, 0xa8, 0x85, 0x6f, 0x17, 0x6a, 0xe1, 0x5d, 0xdb, 0xf3, 0xf9, 0x28, 0x19,
-	0xea, 0x0e, 0x0d, 0x8c, 0xac, 0xd1, 0xe7, 0xe2, 0x86, 0x1d, 0x3a, 0x96, 0xf1, 0x8d, 0xd0, 0x38,
-	0x12, 0x6f, 0x8e, 0x1f, 0x47, 0x84, 0xe5, 0x2f, 0x6f, 0x58, 0x11, 0xa4, 0x17, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x6c, 0x25, 0x0c, 0xad, 0x69, 0x04, 0x00, 0x00,
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x72, 0xe3, 0x44,
+	0x10, 0xb6, 0x6c, 0xc7, 0x89, 0x27, 0xfe, 0x91, 0x07, 0xef, 0xee, 0xd8, 0x29, 0x6c, 0x91, 0xe2,
+	0x47, 0x6c, 0x81, 0x5c, 0x1b, 0x38, 0x70, 0xa3, 0xec, 0x58, 0xcb, 0xba, 0x36, 0x7f, 0x28, 0x76,
+	0xa8, 0xe2, 0x80, 0x4b, 0x96, 0x26, 0x8a, 0x28, 0xcb, 0xa3, 0xd2, 0x8c, 0x43, 0xfc, 0x16, 0x7b,
+	0xe4, 0x11, 0x38, 0xf2, 0x0e, 0x5c, 0xf6, 0xc6, 0x1e, 0x39, 0x50, 0x2c, 0x95, 0x1c, 0x78, 0x0d,
+	0x4a, 0xa3, 0x91, 0xe3, 0xdf, 0x2c, 0x9b, 0x62, 0x0f, 0x76, 0xcd, 0x74, 0x7f, 0x5f, 0xb7, 0xfa,
+	0xeb, 0xe9, 0x91, 0x40, 0xfd, 0x27, 0x33, 0xb0, 0xf1, 0xa8, 0x61, 0x5a, 0xac, 0x71, 0xf9, 0x64,
+	0x80, 0x99, 0xf9, 0x24, 0x5c, 0xbb, 0x64, 0xa4, 0xf9, 0x01, 0x61, 0x04, 0xc2, 0x08, 0xa0, 0x99,
+	0x16, 0xd3, 0x04, 0xa0, 0x5a, 0x32, 0x3d, 0x77, 0x44, 0x1a, 0xfc, 0x3f, 0x82, 0x55, 0xcb, 0x0e,
+	0x71, 0x08, 0x5f, 0x36, 0xc2, 0x95, 0xb0, 0x56, 0x1c, 0x42, 0x9c, 0x21, 0x6e, 0xf0, 0xdd, 0x60,
+	0x7c, 0xde, 0x30, 0x47, 0x13, 0xe1, 0xaa, 0x2d, 0xba, 0xec, 0x71, 0x60, 0xde, 0xe6, 0xad, 0xd6,
+	0x17, 0xfd, 0xcc, 0xf5, 0x30, 0x65, 0xa6, 0xe7, 0xc7, 0x19, 0xe9, 0x85, 0x8b, 0x87, 0x76, 0xc3,
+	0xa4, 0x2c, 0xfc, 0x09, 0xeb, 0x07, 0x2b, 0xea, 0x61, 0xd8, 0xf3, 0x87, 0x26, 0xc3, 0x02, 0xf2,
+	0xe1, 0xda, 0x92, 0xfb, 0x97, 0x24, 0x46, 0xed, 0xfe, 0xb6, 0x0d, 0x32, 0x4d, 0x6e, 0x85, 0x05,
+	0x90, 0x74, 0x6d, 0x24, 0x29, 0x92, 0x9a, 0x36, 0x92, 0xae, 0x0d, 0xbf, 0x02, 0x19, 0xca, 0x4c,
+	0x36, 0xa6, 0x28, 0xa9, 0x48, 0x6a, 0x61, 0x4f, 0xd1, 0x96, 0x35, 0xd2, 0x22, 0xee, 0x29, 0xc7,
+	0x19, 0x02, 0x0f, 0x3f, 0x06, 0x29, 0x8f, 0x3a, 0x28, 0xa5, 0x48, 0xea, 0xf6, 0x5e, 0x59, 0x8b,
+	0x4a, 0xd4, 0xe2, 0x12, 0xb5, 0xe6, 0x68, 0x62, 0x84, 0x00, 0xf8, 0x19, 0xc8, 0x04, 0x98, 0x8e,
+	0x87, 0x0c, 0xa5, 0xef, 0x80, 0x0a, 0x0c, 0x44, 0x60, 0xd3, 0x0a, 0xb0, 0xc9, 0x48, 0x80, 0x36,
+	0x14, 0x49, 0xcd, 0x1a, 0xf1, 0x16, 0x7e, 0x04, 0x0a, 0xa1, 0x6c, 0x64, 0xcc, 0xfa, 0x17, 0xd8,
+	0x75, 0x2e, 0x18, 0xca, 0xf0, 0x2a, 0xf2, 0xc2, 0xfa, 0x8c, 0x1b, 0xe1, 0x33, 0x00, 0x38, 0x03,
+	0xdb, 0x7d, 0x93, 0xa1, 0x4d, 0x9e, 0xb2, 0xba, 0x94, 0xb2, 0x1b, 0x37, 0xa0, 0x95, 0x7f, 0xf9,
+	0x57, 0x3d, 0xf1, 0xe2, 0x75, 0x5d, 0xfa, 0xe5, 0x9f, 0x5f, 0x1f, 0x4b, 0x46, 0x56, 0x90, 0x9b,
+	0x3c, 0xd2, 0xd8, 0xb7, 0xe3, 0x48, 0x5b, 0x6f, 0x1d, 0x49, 0x90, 0x9b, 0x0c, 0x56, 0xc1, 0x96,
+	0x87, 0x47, 0xa1, 0x86, 0x14, 0x65, 0x95, 0x94, 0x9a, 0x35, 0xa6, 0x7b, 0xf8, 0x1c, 0x40, 0xd3,
+	0xf7, 0x03, 0x72, 0x89, 0xfb, 0xf8, 0xca, 0x0f, 0x30, 0xa5, 0x2e, 0x19, 0x21, 0xc0, 0xb3, 0x3d,
+	0xd4, 0xa2, 0x73, 0xa1, 0x85, 0x67, 0x42, 0x9f, 0x7a, 0x5b, 0xe9, 0x30, 0x93, 0x51, 0x12, 0xbc,
+	0x5b, 0x07, 0xec, 0x80, 0x52, 0x80, 0x7f, 0xc4, 0x16, 0x9b, 0x8d, 0xb5, 0xfd, 0x1f, 0x62, 0xc9,
+	0x11, 0x6d, 0x26, 0xd4, 0x97, 0x60, 0x23, 0x3c, 0x41, 0x14, 0xe5, 0x94, 0x94, 0xba, 0xbd, 0x57,
+	0x5b, 0x7f, 0x2e, 0xce, 0x08, 0xc3, 0x46, 0x04, 0x86, 0x9f, 0x02, 0x39, 0xae, 0x66, 0x30, 0x61,
+	0xd8, 0x22, 0x36, 0x46, 0x79, 0x45, 0x52, 0x73, 0x46, 0x51, 0xd8, 0x5b, 0xc2, 0x0c, 0x3f, 0x01,
+	0x45, 0xf1, 0xac, 0x53, 0x64, 0x81, 0x23, 0x0b, 0x91, 0x79, 0x0a, 0xdc, 0x05, 0x39, 0x1b, 0xfb,
+	0x78, 0x64, 0xe3, 0x91, 0xe5, 0x62, 0x8a, 0x8a, 0x5c, 0xc1, 0x39, 0x1b, 0x3c, 0x03, 0x3b, 0x71,
+	0xde, 0x78, 0x42, 0x66, 0x25, 0x90, 0xef, 0x92, 0xc0, 0xa8, 0x08, 0x6a, 0x57, 0x30, 0x67, 0x54,
+	0xe8, 0x82, 0xaa, 0x78, 0xc8, 0x55, 0x61, 0x4b, 0x77, 0x86, 0x45, 0x11, 0x73, 0x45, 0x54, 0x15,
+	0xa4, 0x3d, 0xea, 0x50, 0x04, 0x95, 0xd4, 0xda, 0x81, 0xe0, 0x08, 0xf8, 0x1d, 0x28, 0x7a, 0xd4,
+	0x99, 0xc9, 0x49, 0xd1, 0x7b, 0x9c, 0xa4, 0xae, 0xef, 0xc7, 0x21, 0x75, 0x6e, 0x73, 0x51, 0xd1,
+	0xe0, 0x82, 0x37, 0x67, 0x85, 0x65, 0xb0, 0x81, 0x83, 0x80, 0x04, 0xa8, 0xcc, 0xa7, 0x2c, 0xda,
+	0xc0, 0x03, 0x50, 0xc4, 0x57, 0xd8, 0x1a, 0xf3, 0x0b, 0xc4, 0xc6, 0x43, 0x73, 0x82, 0x1e, 0xf0,
+	0x1a, 0x2b, 0x4b, 0xcf, 0xd8, 0x16, 0x57, 0x5c, 0x6b, 0x2b, 0x8c, 0xff, 0xf3, 0xeb, 0xba, 0x64,
+	0x14, 0xa6, 0xdc, 0x76, 0x48, 0x85, 0x3a, 0xc8, 0x47, 0x16, 0xdc, 0x37, 0xcf, 0x19, 0x0e, 0xd0,
+	0xc3, 0x37, 0xce, 0x50, 0x3a, 0x9c, 0x1f, 0x23, 0x27, 0x68, 0xcd, 0x90, 0x05, 0x0d, 0x50, 0x5a,
+	0xec, 0x2d, 0x45, 0x8f, 0xb8, 0x0a, 0xf5, 0x55, 0x2a, 0xc4, 0x82, 0x1b, 0xf8, 0x3c, 0x3e, 0xdd,
+	0x0b, 0x0d, 0xa6, 0xf0, 0x04, 0xc8, 0x0b, 0x7d, 0xa5, 0x08, 0xbd, 0x4d, 0xc8, 0xe2, 0x7c, 0x73,
+	0x29, 0xdc, 0x01, 0x59, 0xea, 0x9b, 0x16, 0xee, 0xbb, 0x36, 0x45, 0x15, 0x25, 0xa5, 0xa6, 0x8d,
+	0x2d, 0x6e, 0xe8, 0xd8, 0x7c, 0x2c, 0xf0, 0xa5, 0x39, 0x1c, 0x73, 0xc5, 0xfa, 0x91, 0xf0, 0x55,
+	0x2e, 0x7c, 0xf1, 0xd6, 0xae, 0xf3, 0x16, 0x20, 0xb0, 0x19, 0x60, 0x16, 0x84, 0x07, 0x7d, 0x47,
+	0x91, 0xd4, 0xbc, 0x11, 0x6f, 0x77, 0xff, 0x4c, 0x82, 0xf2, 0xaa, 0x0e, 0xaf, 0xb9, 0x42, 0xa4,
+	0xff, 0xf1, 0x0a, 0x49, 0xde, 0xeb, 0x0a, 0x79, 0xc3, 0x50, 0xa6, 0xde, 0xcd, 0x50, 0xa6, 0xef,
+	0x37, 0x94, 0xbb, 0x5f, 0x83, 0x7c, 0xa4, 0xae, 0xc1, 0xdf, 0x44, 0x14, 0x6a, 0x61, 0x27, 0xf8,
+	0x12, 0x49, 0x77, 0x0c, 0x6a, 0x0c, 0x7a, 0xfc, 0xbb, 0x04, 0x72, 0xb3, 0x6f, 0x4a, 0xf8, 0x3e,
+	0xa8, 0x34, 0xf7, 0xbb, 0x9d, 0xe3, 0xa3, 0xfe, 0x69, 0xb7, 0xd9, 0xed, 0x9d, 0xf6, 0x7b, 0x47,
+	0xa7, 0x27, 0xfa, 0x7e, 0xe7, 0x69, 0x47, 0x6f, 0xcb, 0x09, 0x58, 0x01, 0x0f, 0xe6, 0xdd, 0x27,
+	0xfa, 0x51, 0xbb, 0x73, 0xf4, 0x8d, 0x2c, 0xc1, 0x1d, 0xf0, 0x68, 0xde, 0xb5, 0x7f, 0x7c, 0x78,
+	0x72, 0xa0, 0x77, 0xf5, 0xb6, 0x9c, 0x5c, 0xe6, 0x19, 0xfa, 0xd9, 0xf1, 0x73, 0xbd, 0x2d, 0xa7,
+	0x96, 0x5d, 0xdd, 0xce, 0xa1, 0x7e, 0xdc, 0xeb, 0xca, 0x69, 0x88, 0x40, 0x79, 0xde, 0xf5, 0xb4,
+	0xd9, 0x39, 0xd0, 0xdb, 0xf2, 0xc6, 0xb2, 0xe7, 0xdb, 0x9e, 0xde, 0xd3, 0xdb, 0x72, 0xa6, 0xf5,
+	0xc3, 0xcb, 0xeb, 0x9a, 0xf4, 0xea, 0xba, 0x26, 0xfd, 0x7d, 0x5d, 0x93, 0x5e, 0xdc, 0xd4, 0x12,
+	0xaf, 0x6e, 0x6a, 0x89, 0x3f, 0x6e, 0x6a, 0x89, 0xef, 0xdb, 0x8e, 0xcb, 0x2e, 0xc6, 0x03, 0xcd,
+	0x22, 0x5e, 0x23, 0x9a, 0x97, 0xcf, 0xb9, 0x28, 0x16, 0x19, 0x8a, 0xfd, 0xc2, 0xb6, 0x71, 0xc5,
+	0xbf, 0x51, 0xd8, 0xc4, 0xc7, 0x34, 0xfe, 0x52, 0x19, 0x64, 0x38, 0xe8, 0x8b, 0x7f, 0x07, 0x00,
+	0x82, 0x35, 0x0b, 0x02, 0xb9, 0x09, 0x00, 0x00,
 }
 
 func (m *Action) Marshal() (dAtA []byte, err error) {
@@ -283,6 +591,188 @@ func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintAction(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.EvaluationError) > 0 {
+		i -= len(m.EvaluationError)
+		copy(dAtA[i:], m.EvaluationError)
+		i = encodeVarintAction(dAtA, i, uint64(len(m.EvaluationError)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.SpaceIds) > 0 {
+		dAtA2 := make([]byte, len(m.SpaceIds)*10)
+		var j1 int
+		for _, num := range m.SpaceIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAction(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.RejectTemplates) > 0 {
+		for iNdEx := len(m.RejectTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RejectTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.ApproveTemplates) > 0 {
+		for iNdEx := len(m.ApproveTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApproveTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.ExecuteAfter != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecuteAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecuteAfter):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAction(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAction(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAction(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.MsgExpressions) > 0 {
+		for iNdEx := len(m.MsgExpressions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgExpressions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.RejectTemplateExpression != nil {
+		{
+			size, err := m.RejectTemplateExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ApproveTemplateExpression != nil {
+		{
+			size, err := m.ApproveTemplateExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintAction(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RejectBytecode) > 0 {
+		i -= len(m.RejectBytecode)
+		copy(dAtA[i:], m.RejectBytecode)
+		i = encodeVarintAction(dAtA, i, uint64(len(m.RejectBytecode)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ApproveBytecode) > 0 {
+		i -= len(m.ApproveBytecode)
+		copy(dAtA[i:], m.ApproveBytecode)
+		i = encodeVarintAction(dAtA, i, uint64(len(m.ApproveBytecode)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,20 +816,20 @@ func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x4a
 		}
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
-	if err3 != nil {
-		return 0, err3
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n3
-	i = encodeVarintAction(dAtA, i, uint64(n3))
+	i -= n9
+	i = encodeVarintAction(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x42
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err4 != nil {
-		return 0, err4
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n4
-	i = encodeVarintAction(dAtA, i, uint64(n4))
+	i -= n10
+	i = encodeVarintAction(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.TimeoutHeight != 0 {
@@ -391,17 +881,121 @@ func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *ActionMsgExpressions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionMsgExpressions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionMsgExpressions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectTemplateExpression != nil {
+		{
+			size, err := m.RejectTemplateExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ApproveTemplateExpression != nil {
+		{
+			size, err := m.ApproveTemplateExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.RejectExpression.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApproveExpression.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *Action) Size() (n int) {
 	if m == nil {
 		return 0
@@ -449,6 +1043,112 @@ func (m *Action) Size() (n int) {
 			n += 1 + l + sovAction(uint64(l))
 		}
 	}
+	l = len(m.ApproveBytecode)
+	if l > 0 {
+		n += 1 + l + sovAction(uint64(l))
+	}
+	l = len(m.RejectBytecode)
+	if l > 0 {
+		n += 1 + l + sovAction(uint64(l))
+	}
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 1 + l + sovAction(uint64(l))
+		}
+	}
+	if m.ApproveTemplateExpression != nil {
+		l = m.ApproveTemplateExpression.Size()
+		n += 2 + l + sovAction(uint64(l))
+	}
+	if m.RejectTemplateExpression != nil {
+		l = m.RejectTemplateExpression.Size()
+		n += 2 + l + sovAction(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 2 + l + sovAction(uint64(l))
+		}
+	}
+	if len(m.MsgExpressions) > 0 {
+		for _, e := range m.MsgExpressions {
+			l = e.Size()
+			n += 2 + l + sovAction(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovAction(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 2 + l + sovAction(uint64(l))
+	if m.ExecuteAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecuteAfter)
+		n += 2 + l + sovAction(uint64(l))
+	}
+	if len(m.ApproveTemplates) > 0 {
+		for _, e := range m.ApproveTemplates {
+			l = e.Size()
+			n += 2 + l + sovAction(uint64(l))
+		}
+	}
+	if len(m.RejectTemplates) > 0 {
+		for _, e := range m.RejectTemplates {
+			l = e.Size()
+			n += 2 + l + sovAction(uint64(l))
+		}
+	}
+	if len(m.SpaceIds) > 0 {
+		l = 0
+		for _, e := range m.SpaceIds {
+			l += sovAction(uint64(e))
+		}
+		n += 2 + sovAction(uint64(l)) + l
+	}
+	l = len(m.EvaluationError)
+	if l > 0 {
+		n += 2 + l + sovAction(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 2 + sovAction(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *ActionMsgExpressions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApproveExpression.Size()
+	n += 1 + l + sovAction(uint64(l))
+	l = m.RejectExpression.Size()
+	n += 1 + l + sovAction(uint64(l))
+	if m.ApproveTemplateExpression != nil {
+		l = m.ApproveTemplateExpression.Size()
+		n += 1 + l + sovAction(uint64(l))
+	}
+	if m.RejectTemplateExpression != nil {
+		l = m.RejectTemplateExpression.Size()
+		n += 1 + l + sovAction(uint64(l))
+	}
+	return n
+}
+
+func (m *ActionResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovAction(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -491,7 +1191,572 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Id = 0
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ActionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Any{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mentions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mentions = append(m.Mentions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApproveExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RejectExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &ActionVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveBytecode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApproveBytecode = append(m.ApproveBytecode[:0], dAtA[iNdEx:postIndex]...)
+			if m.ApproveBytecode == nil {
+				m.ApproveBytecode = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectBytecode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectBytecode = append(m.RejectBytecode[:0], dAtA[iNdEx:postIndex]...)
+			if m.RejectBytecode == nil {
+				m.RejectBytecode = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApproveTemplateExpression == nil {
+				m.ApproveTemplateExpression = &ast.Expression{}
+			}
+			if err := m.ApproveTemplateExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RejectTemplateExpression == nil {
+				m.RejectTemplateExpression = &ast.Expression{}
+			}
+			if err := m.RejectTemplateExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgExpressions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAction
@@ -501,16 +1766,31 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAction
 			}
-			m.Status = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgExpressions = append(m.MsgExpressions, ActionMsgExpressions{})
+			if err := m.MsgExpressions[len(m.MsgExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAction
@@ -520,14 +1800,27 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ActionStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -554,16 +1847,13 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &types.Any{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -590,18 +1880,18 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &types.Any{}
+			if m.ExecuteAfter == nil {
+				m.ExecuteAfter = new(time.Time)
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAction
@@ -611,29 +1901,31 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ApproveTemplates = append(m.ApproveTemplates, TemplateRef{})
+			if err := m.ApproveTemplates[len(m.ApproveTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplates", wireType)
 			}
-			m.TimeoutHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAction
@@ -643,16 +1935,107 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectTemplates = append(m.RejectTemplates, TemplateRef{})
+			if err := m.RejectTemplates[len(m.RejectTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAction
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SpaceIds = append(m.SpaceIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAction
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAction
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAction
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SpaceIds) == 0 {
+					m.SpaceIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SpaceIds = append(m.SpaceIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceIds", wireType)
+			}
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAction
@@ -662,28 +2045,96 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.EvaluationError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAction(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionMsgExpressions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionMsgExpressions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionMsgExpressions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveExpression", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -710,15 +2161,15 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApproveExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mentions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectExpression", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAction
@@ -728,27 +2179,28 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mentions = append(m.Mentions, string(dAtA[iNdEx:postIndex]))
+			if err := m.RejectExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveExpression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateExpression", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -775,13 +2227,16 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApproveExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ApproveTemplateExpression == nil {
+				m.ApproveTemplateExpression = &ast.Expression{}
+			}
+			if err := m.ApproveTemplateExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectExpression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateExpression", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -808,13 +2263,66 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RejectExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RejectTemplateExpression == nil {
+				m.RejectTemplateExpression = &ast.Expression{}
+			}
+			if err := m.RejectTemplateExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -841,8 +2349,8 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, &ActionVote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &types.Any{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
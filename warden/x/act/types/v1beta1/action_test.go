@@ -0,0 +1,52 @@
+package v1beta1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/object"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestActionCompile(t *testing.T) {
+	approve, err := shield.Parse("any(2, [warden1a, warden1b, warden1c])")
+	require.NoError(t, err)
+	reject, err := shield.Parse("warden1a && warden1b")
+	require.NoError(t, err)
+
+	env := object.NewEnvironment()
+	env.Set("warden1a", object.TRUE)
+	env.Set("warden1b", object.FALSE)
+	env.Set("warden1c", object.TRUE)
+
+	act := types.Action{
+		ApproveExpression: *approve,
+		RejectExpression:  *reject,
+	}
+
+	approvedAST, err := act.EvalApprove(context.Background(), env)
+	require.NoError(t, err)
+	rejectedAST, err := act.EvalReject(context.Background(), env)
+	require.NoError(t, err)
+
+	require.NoError(t, act.Compile())
+	require.NotEmpty(t, act.ApproveBytecode)
+	require.NotEmpty(t, act.RejectBytecode)
+
+	approved, err := act.EvalApprove(context.Background(), env)
+	require.NoError(t, err)
+	require.True(t, approved)
+	require.Equal(t, approvedAST, approved)
+
+	rejected, err := act.EvalReject(context.Background(), env)
+	require.NoError(t, err)
+	require.False(t, rejected)
+	require.Equal(t, rejectedAST, rejected)
+
+	// the bytecode takes precedence over the expression
+	act.ApproveBytecode = []byte("invalid")
+	_, err = act.EvalApprove(context.Background(), env)
+	require.ErrorIs(t, err, types.ErrTemplateEvaluationFailed)
+}
@@ -60,6 +60,39 @@ func (ActionVoteType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b33d294255f825be, []int{0}
 }
 
+// Scheme used to sign a SignedVote.
+type SignatureScheme int32
+
+const (
+	// Unspecified signature scheme.
+	SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED SignatureScheme = 0
+	// ADR-036 arbitrary message signature, as produced by Cosmos wallets. Both
+	// secp256k1 and Ethereum secp256k1 keys are supported.
+	SignatureScheme_SIGNATURE_SCHEME_ADR036 SignatureScheme = 1
+	// EIP-712 typed data signature, as produced by Ethereum wallets.
+	SignatureScheme_SIGNATURE_SCHEME_EIP712 SignatureScheme = 2
+)
+
+var SignatureScheme_name = map[int32]string{
+	0: "SIGNATURE_SCHEME_UNSPECIFIED",
+	1: "SIGNATURE_SCHEME_ADR036",
+	2: "SIGNATURE_SCHEME_EIP712",
+}
+
+var SignatureScheme_value = map[string]int32{
+	"SIGNATURE_SCHEME_UNSPECIFIED": 0,
+	"SIGNATURE_SCHEME_ADR036":      1,
+	"SIGNATURE_SCHEME_EIP712":      2,
+}
+
+func (x SignatureScheme) String() string {
+	return proto.EnumName(SignatureScheme_name, int32(x))
+}
+
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b33d294255f825be, []int{1}
+}
+
 type ActionVote struct {
 	// participant is the address of the voter.
 	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
@@ -123,9 +156,98 @@ func (m *ActionVote) GetVoteType() ActionVoteType {
 	return ActionVoteType_VOTE_TYPE_UNSPECIFIED
 }
 
+// SignedVote is a vote signed off-chain by its participant, that can be
+// submitted on-chain by anyone with MsgSubmitSignedVotes.
+//
+// The signed payload binds the vote to a chain ID, an action ID and a vote
+// type, see the x/act documentation for its exact encoding.
+type SignedVote struct {
+	// participant is the address of the voter.
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// vote_type is the type of the vote.
+	VoteType ActionVoteType `protobuf:"varint,2,opt,name=vote_type,json=voteType,proto3,enum=warden.act.v1beta1.ActionVoteType" json:"vote_type,omitempty"`
+	// scheme is the scheme used to produce the signature.
+	Scheme SignatureScheme `protobuf:"varint,3,opt,name=scheme,proto3,enum=warden.act.v1beta1.SignatureScheme" json:"scheme,omitempty"`
+	// pub_key is the compressed secp256k1 public key of the participant,
+	// required by SIGNATURE_SCHEME_ADR036.
+	PubKey []byte `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signature of the vote.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedVote) Reset()         { *m = SignedVote{} }
+func (m *SignedVote) String() string { return proto.CompactTextString(m) }
+func (*SignedVote) ProtoMessage()    {}
+func (*SignedVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b33d294255f825be, []int{1}
+}
+func (m *SignedVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedVote.Merge(m, src)
+}
+func (m *SignedVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedVote proto.InternalMessageInfo
+
+func (m *SignedVote) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *SignedVote) GetVoteType() ActionVoteType {
+	if m != nil {
+		return m.VoteType
+	}
+	return ActionVoteType_VOTE_TYPE_UNSPECIFIED
+}
+
+func (m *SignedVote) GetScheme() SignatureScheme {
+	if m != nil {
+		return m.Scheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
+func (m *SignedVote) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SignedVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("warden.act.v1beta1.ActionVoteType", ActionVoteType_name, ActionVoteType_value)
+	proto.RegisterEnum("warden.act.v1beta1.SignatureScheme", SignatureScheme_name, SignatureScheme_value)
 	proto.RegisterType((*ActionVote)(nil), "warden.act.v1beta1.ActionVote")
+	proto.RegisterType((*SignedVote)(nil), "warden.act.v1beta1.SignedVote")
 }
 
 func init() {
@@ -133,31 +255,38 @@ func init() {
 }
 
 var fileDescriptor_b33d294255f825be = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4f, 0x2c, 0x4a,
-	0x49, 0xcd, 0xd3, 0x4f, 0x4c, 0x2e, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0x04, 0xb1,
-	0x33, 0xf3, 0xf3, 0xe2, 0xcb, 0xf2, 0x4b, 0x52, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84,
-	0x20, 0xaa, 0xf4, 0x12, 0x93, 0x4b, 0xf4, 0xa0, 0xaa, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2,
-	0xf5, 0xc1, 0x24, 0x44, 0x99, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41,
-	0x45, 0xe5, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x92,
-	0xcc, 0xdc, 0xd4, 0xe2, 0x92, 0xc4, 0xdc, 0x02, 0x88, 0x02, 0xa5, 0xad, 0x8c, 0x5c, 0x5c, 0x8e,
-	0x60, 0x3b, 0xc3, 0xf2, 0x4b, 0x52, 0x85, 0x14, 0xb8, 0xb8, 0x0b, 0x12, 0x8b, 0x4a, 0x32, 0x93,
-	0x33, 0x0b, 0x12, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x90, 0x85, 0x84, 0x5c,
-	0xb8, 0x38, 0x40, 0x8e, 0x4b, 0x89, 0x4f, 0x2c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92,
-	0xd2, 0x83, 0x58, 0xa2, 0x07, 0xb3, 0x44, 0x2f, 0x04, 0x66, 0x89, 0x13, 0xef, 0x89, 0x7b, 0xf2,
-	0x0c, 0x13, 0xee, 0xcb, 0x33, 0xae, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x88, 0x1d, 0xac, 0xd5, 0xb1,
-	0x44, 0xc8, 0x9e, 0x8b, 0x13, 0xc4, 0x8c, 0x2f, 0xa9, 0x2c, 0x48, 0x95, 0x60, 0x56, 0x60, 0xd4,
-	0xe0, 0x33, 0x52, 0xd2, 0xc3, 0xf4, 0xa8, 0x1e, 0xc2, 0x69, 0x21, 0x95, 0x05, 0xa9, 0x41, 0x60,
-	0xab, 0x41, 0x2c, 0xad, 0x68, 0x2e, 0x3e, 0x54, 0x39, 0x21, 0x49, 0x2e, 0xd1, 0x30, 0xff, 0x10,
-	0xd7, 0xf8, 0x90, 0xc8, 0x00, 0xd7, 0xf8, 0x50, 0xbf, 0xe0, 0x00, 0x57, 0x67, 0x4f, 0x37, 0x4f,
-	0x57, 0x17, 0x01, 0x06, 0x21, 0x31, 0x2e, 0x21, 0x84, 0x94, 0x63, 0x40, 0x40, 0x90, 0x7f, 0x98,
-	0xab, 0x8b, 0x00, 0x23, 0xaa, 0x78, 0x90, 0xab, 0x97, 0xab, 0x73, 0x88, 0xab, 0x8b, 0x00, 0x93,
-	0x53, 0xdc, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xb9, 0xa4, 0x67, 0x96,
-	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x9c, 0xab, 0x0b, 0xf6, 0x75, 0x72, 0x7e,
-	0x0e, 0x94, 0x8f, 0xc6, 0xd5, 0xaf, 0x00, 0x47, 0x2f, 0xc8, 0x9f, 0xc5, 0xb0, 0x48, 0x4e, 0x62,
-	0x03, 0x2b, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x64, 0xe6, 0x0a, 0x01, 0x02, 0x00,
-	0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0xcc, 0x06, 0x68, 0x9b, 0x2d, 0x94, 0xb0, 0x02, 0x1a, 0x42, 0xe5, 0x58, 0x81, 0x43, 0x14,
+	0x09, 0x9b, 0xa4, 0x12, 0x1c, 0x38, 0x20, 0x37, 0x5e, 0x20, 0x20, 0x5a, 0xcb, 0x76, 0x23, 0x01,
+	0x12, 0xd6, 0xda, 0x59, 0x5c, 0x8b, 0xda, 0x6b, 0x25, 0xeb, 0x40, 0xfe, 0xa2, 0x9f, 0xc1, 0x91,
+	0x0b, 0xff, 0xd0, 0x63, 0x8f, 0x9c, 0x00, 0x25, 0x07, 0x7e, 0x03, 0x79, 0x6d, 0x2b, 0x24, 0xed,
+	0x01, 0x2e, 0xd6, 0xbc, 0x79, 0x33, 0x9a, 0xb1, 0xbd, 0x0b, 0xef, 0x7f, 0x22, 0xa3, 0x21, 0x8d,
+	0x54, 0xe2, 0x71, 0x75, 0xd2, 0x71, 0x29, 0x27, 0x9d, 0x14, 0x07, 0x2c, 0x72, 0x26, 0x8c, 0x53,
+	0x25, 0x1e, 0x31, 0xce, 0x10, 0xca, 0x54, 0x0a, 0xf1, 0xb8, 0x92, 0xab, 0xea, 0x37, 0x48, 0x18,
+	0x44, 0x4c, 0x15, 0xcf, 0x4c, 0x56, 0xbf, 0xe9, 0x33, 0x9f, 0x09, 0xa8, 0xa6, 0x28, 0x67, 0x1b,
+	0x3e, 0x63, 0xfe, 0x31, 0x55, 0xc5, 0xe4, 0x26, 0x1f, 0x54, 0x1e, 0x84, 0x74, 0xcc, 0x49, 0x18,
+	0x67, 0x82, 0xe6, 0x37, 0x00, 0xa1, 0x26, 0x32, 0x07, 0x8c, 0x53, 0x24, 0xc3, 0xcd, 0x98, 0x8c,
+	0x78, 0xe0, 0x05, 0x31, 0x89, 0x78, 0x0d, 0xc8, 0xa0, 0x55, 0x31, 0xff, 0xa6, 0x90, 0x0e, 0x37,
+	0xd2, 0x72, 0x43, 0x87, 0xf0, 0x5a, 0x59, 0x06, 0xad, 0xcd, 0x6e, 0x5d, 0xc9, 0x42, 0x94, 0x22,
+	0x44, 0xb1, 0x8b, 0x90, 0xbd, 0x6b, 0xa7, 0x3f, 0x1a, 0xa5, 0x93, 0x9f, 0x0d, 0xf0, 0xe5, 0xf7,
+	0xd7, 0x36, 0x30, 0xd7, 0x85, 0x55, 0xe3, 0xe8, 0x29, 0xac, 0xa4, 0xd0, 0xe1, 0xd3, 0x98, 0xd6,
+	0x2e, 0xc9, 0xa0, 0xb5, 0xd5, 0x6d, 0x2a, 0xe7, 0x5f, 0x54, 0x59, 0x54, 0xb3, 0xa7, 0x31, 0x35,
+	0x37, 0x26, 0x39, 0x6a, 0xce, 0x01, 0x84, 0x56, 0xe0, 0x47, 0x74, 0xf8, 0x8f, 0xbd, 0x97, 0x12,
+	0xcb, 0xff, 0x9f, 0x88, 0x9e, 0xc0, 0xb5, 0xb1, 0x77, 0x44, 0xc3, 0xa2, 0xef, 0xbd, 0x8b, 0xdc,
+	0x69, 0x25, 0xc2, 0x93, 0x11, 0xb5, 0x84, 0xd4, 0xcc, 0x2d, 0x68, 0x1b, 0xae, 0xc7, 0x89, 0xeb,
+	0x7c, 0xa4, 0xd3, 0xda, 0x65, 0x19, 0xb4, 0xae, 0x9a, 0x6b, 0x71, 0xe2, 0xbe, 0xa2, 0x53, 0xb4,
+	0x03, 0x2b, 0xe3, 0xc2, 0x53, 0xbb, 0x22, 0x56, 0x0b, 0xa2, 0xfd, 0x0e, 0x6e, 0x2d, 0xf7, 0x41,
+	0x77, 0xe0, 0xad, 0xc1, 0x81, 0x8d, 0x1d, 0xfb, 0x8d, 0x81, 0x9d, 0xc3, 0x7d, 0xcb, 0xc0, 0xbd,
+	0xfe, 0xb3, 0x3e, 0xd6, 0xab, 0x25, 0x74, 0x1b, 0xa2, 0xc5, 0x4a, 0x33, 0x0c, 0xf3, 0x60, 0x80,
+	0xf5, 0x2a, 0x58, 0xe6, 0x4d, 0xfc, 0x12, 0xf7, 0x6c, 0xac, 0x57, 0xcb, 0xed, 0x10, 0x5e, 0x5f,
+	0xa9, 0x8b, 0x64, 0xb8, 0x63, 0xf5, 0x9f, 0xef, 0x6b, 0xf6, 0xa1, 0x89, 0x1d, 0xab, 0xf7, 0x02,
+	0xbf, 0x5e, 0x0d, 0xb9, 0x0b, 0xb7, 0xcf, 0x29, 0x34, 0xdd, 0x7c, 0xb8, 0xfb, 0xa8, 0x0a, 0x2e,
+	0x5c, 0xe2, 0xbe, 0xf1, 0xb8, 0xd3, 0xad, 0x96, 0xf7, 0xde, 0x9f, 0xce, 0x24, 0x70, 0x36, 0x93,
+	0xc0, 0xaf, 0x99, 0x04, 0x4e, 0xe6, 0x52, 0xe9, 0x6c, 0x2e, 0x95, 0xbe, 0xcf, 0xa5, 0xd2, 0x5b,
+	0xdd, 0x0f, 0xf8, 0x51, 0xe2, 0x2a, 0x1e, 0x0b, 0xd5, 0xec, 0x9b, 0x3e, 0x10, 0x47, 0xc9, 0x63,
+	0xc7, 0xf9, 0xbc, 0x32, 0xaa, 0x9f, 0xc5, 0x9d, 0x49, 0x7f, 0xe5, 0xb8, 0xb8, 0x39, 0xee, 0x9a,
+	0x10, 0xed, 0xfe, 0x19, 0x00, 0x1c, 0x6e, 0x85, 0xe6, 0x56, 0x03, 0x00, 0x00,
 }
 
 func (m *ActionVote) Marshal() (dAtA []byte, err error) {
@@ -203,6 +332,60 @@ func (m *ActionVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignedVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintActionVote(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintActionVote(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Scheme != 0 {
+		i = encodeVarintActionVote(dAtA, i, uint64(m.Scheme))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VoteType != 0 {
+		i = encodeVarintActionVote(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintActionVote(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintActionVote(dAtA []byte, offset int, v uint64) int {
 	offset -= sovActionVote(v)
 	base := offset
@@ -232,6 +415,33 @@ func (m *ActionVote) Size() (n int) {
 	return n
 }
 
+func (m *SignedVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovActionVote(uint64(l))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovActionVote(uint64(m.VoteType))
+	}
+	if m.Scheme != 0 {
+		n += 1 + sovActionVote(uint64(m.Scheme))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovActionVote(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovActionVote(uint64(l))
+	}
+	return n
+}
+
 func sovActionVote(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -372,6 +582,194 @@ func (m *ActionVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SignedVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActionVote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActionVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActionVote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActionVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActionVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= ActionVoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			m.Scheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActionVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActionVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActionVote
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActionVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActionVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthActionVote
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActionVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActionVote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActionVote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipActionVote(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
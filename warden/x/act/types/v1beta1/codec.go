@@ -4,14 +4,21 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	// this line is used by starport scaffolding # 1
 )
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateVote{},
 		&MsgNewAction{},
 		&MsgNewTemplate{},
+		&MsgRetryAction{},
 		&MsgRevokeAction{},
+		&MsgRevokeVoteDelegation{},
+		&MsgSetContractTemplates{},
+		&MsgSubmitSignedVotes{},
+		&MsgTransferTemplateOwnership{},
 		&MsgUpdateTemplate{},
 		&MsgVoteForAction{},
 	)
@@ -21,4 +28,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+
+	// the result of multi-message actions, see Action.Result
+	registry.RegisterImplementations((*tx.MsgResponse)(nil),
+		&ActionResults{},
+	)
 }
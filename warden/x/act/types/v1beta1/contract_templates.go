@@ -0,0 +1,5 @@
+package v1beta1
+
+// ContractTemplatesResolver is the name of the field resolver returning the
+// ContractTemplates of a contract, given its address.
+const ContractTemplatesResolver = "act.contract"
@@ -23,4 +23,13 @@ var (
 	ErrInvalidUpdateTemplateAccount = sdkerrors.Register(ModuleName, 1114, "this account can't update this template")
 	ErrApproveExpressionNotMatched  = sdkerrors.Register(ModuleName, 1115, "approve expression not matched with expected")
 	ErrRejectExpressionNotMatched   = sdkerrors.Register(ModuleName, 1116, "reject expression not matched with expected")
+	ErrInvalidTemplateReference     = sdkerrors.Register(ModuleName, 1117, "invalid template reference")
+	ErrTemplateReferenceCycle       = sdkerrors.Register(ModuleName, 1118, "template reference cycle")
+	ErrInvalidActionMsgs            = sdkerrors.Register(ModuleName, 1119, "invalid action messages")
+	ErrInvalidRetrier               = sdkerrors.Register(ModuleName, 1120, "this account can't retry this action")
+	ErrInvalidSignedVote            = sdkerrors.Register(ModuleName, 1121, "invalid signed vote")
+	ErrSignedVoteReplayed           = sdkerrors.Register(ModuleName, 1122, "signed vote already submitted")
+	ErrTemplateNotGoverned          = sdkerrors.Register(ModuleName, 1123, "template is not governed")
+	ErrInvalidVoteDelegation        = sdkerrors.Register(ModuleName, 1124, "invalid vote delegation")
+	ErrNoContractTemplates          = sdkerrors.Register(ModuleName, 1125, "no templates set for contract")
 )
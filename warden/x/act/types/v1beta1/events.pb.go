@@ -5,16 +5,21 @@ package v1beta1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -81,6 +86,14 @@ func (m *EventCreateTemplate) GetCreator() string {
 type EventUpdateTemplate struct {
 	// id of updated intent
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version is the new version of the template
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// updated_by is the address that updated the template
+	UpdatedBy string `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// old_expression is the expression of the previous version, formatted
+	OldExpression string `protobuf:"bytes,4,opt,name=old_expression,json=oldExpression,proto3" json:"old_expression,omitempty"`
+	// new_expression is the expression of the new version, formatted
+	NewExpression string `protobuf:"bytes,5,opt,name=new_expression,json=newExpression,proto3" json:"new_expression,omitempty"`
 }
 
 func (m *EventUpdateTemplate) Reset()         { *m = EventUpdateTemplate{} }
@@ -123,6 +136,164 @@ func (m *EventUpdateTemplate) GetId() uint64 {
 	return 0
 }
 
+func (m *EventUpdateTemplate) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventUpdateTemplate) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func (m *EventUpdateTemplate) GetOldExpression() string {
+	if m != nil {
+		return m.OldExpression
+	}
+	return ""
+}
+
+func (m *EventUpdateTemplate) GetNewExpression() string {
+	if m != nil {
+		return m.NewExpression
+	}
+	return ""
+}
+
+// EventTransferTemplateOwnership is emitted when the ownership of a Template
+// is transferred
+type EventTransferTemplateOwnership struct {
+	// id of the template
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// previous_owner is the address of the previous owner
+	PreviousOwner string `protobuf:"bytes,2,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	// new_owner is the address of the new owner
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *EventTransferTemplateOwnership) Reset()         { *m = EventTransferTemplateOwnership{} }
+func (m *EventTransferTemplateOwnership) String() string { return proto.CompactTextString(m) }
+func (*EventTransferTemplateOwnership) ProtoMessage()    {}
+func (*EventTransferTemplateOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_912b51dfb11e99b6, []int{2}
+}
+func (m *EventTransferTemplateOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTransferTemplateOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTransferTemplateOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTransferTemplateOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTransferTemplateOwnership.Merge(m, src)
+}
+func (m *EventTransferTemplateOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTransferTemplateOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTransferTemplateOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTransferTemplateOwnership proto.InternalMessageInfo
+
+func (m *EventTransferTemplateOwnership) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventTransferTemplateOwnership) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
+	}
+	return ""
+}
+
+func (m *EventTransferTemplateOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// EventSetContractTemplates is emitted when the templates of a contract are
+// set or removed
+type EventSetContractTemplates struct {
+	// contract is the address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// approve_template_id is the id of the approve template, 0 if removed
+	ApproveTemplateId uint64 `protobuf:"varint,2,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
+	// reject_template_id is the id of the reject template, 0 if removed
+	RejectTemplateId uint64 `protobuf:"varint,3,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
+}
+
+func (m *EventSetContractTemplates) Reset()         { *m = EventSetContractTemplates{} }
+func (m *EventSetContractTemplates) String() string { return proto.CompactTextString(m) }
+func (*EventSetContractTemplates) ProtoMessage()    {}
+func (*EventSetContractTemplates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_912b51dfb11e99b6, []int{3}
+}
+func (m *EventSetContractTemplates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetContractTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetContractTemplates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetContractTemplates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetContractTemplates.Merge(m, src)
+}
+func (m *EventSetContractTemplates) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetContractTemplates) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetContractTemplates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetContractTemplates proto.InternalMessageInfo
+
+func (m *EventSetContractTemplates) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventSetContractTemplates) GetApproveTemplateId() uint64 {
+	if m != nil {
+		return m.ApproveTemplateId
+	}
+	return 0
+}
+
+func (m *EventSetContractTemplates) GetRejectTemplateId() uint64 {
+	if m != nil {
+		return m.RejectTemplateId
+	}
+	return 0
+}
+
 // EventCreateAction is emitted when an Action is created
 type EventCreateAction struct {
 	// id of the new action
@@ -135,7 +306,7 @@ func (m *EventCreateAction) Reset()         { *m = EventCreateAction{} }
 func (m *EventCreateAction) String() string { return proto.CompactTextString(m) }
 func (*EventCreateAction) ProtoMessage()    {}
 func (*EventCreateAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_912b51dfb11e99b6, []int{2}
+	return fileDescriptor_912b51dfb11e99b6, []int{4}
 }
 func (m *EventCreateAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +363,7 @@ func (m *EventActionVoted) Reset()         { *m = EventActionVoted{} }
 func (m *EventActionVoted) String() string { return proto.CompactTextString(m) }
 func (*EventActionVoted) ProtoMessage()    {}
 func (*EventActionVoted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_912b51dfb11e99b6, []int{3}
+	return fileDescriptor_912b51dfb11e99b6, []int{5}
 }
 func (m *EventActionVoted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,13 +421,16 @@ type EventActionStateChange struct {
 	PreviousStatus ActionStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=warden.act.v1beta1.ActionStatus" json:"previous_status,omitempty"`
 	// new_status is the new status of the action
 	NewStatus ActionStatus `protobuf:"varint,3,opt,name=new_status,json=newStatus,proto3,enum=warden.act.v1beta1.ActionStatus" json:"new_status,omitempty"`
+	// error returned by the execution of the action, set when new_status is
+	// ACTION_STATUS_FAILED
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (m *EventActionStateChange) Reset()         { *m = EventActionStateChange{} }
 func (m *EventActionStateChange) String() string { return proto.CompactTextString(m) }
 func (*EventActionStateChange) ProtoMessage()    {}
 func (*EventActionStateChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_912b51dfb11e99b6, []int{4}
+	return fileDescriptor_912b51dfb11e99b6, []int{6}
 }
 func (m *EventActionStateChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +480,14 @@ func (m *EventActionStateChange) GetNewStatus() ActionStatus {
 	return ActionStatus_ACTION_STATUS_UNSPECIFIED
 }
 
-// EventActionPruned is emitted when an Action is pruned in `Completed`, `Revoked`, `Pending` or `Timeout`
+func (m *EventActionStateChange) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EventActionPruned is emitted when an Action is pruned in `Completed`, `Revoked`, `Failed`, `Pending` or `Timeout`
 // states and won't be processed further
 type EventActionPruned struct {
 	// id of action
@@ -317,7 +498,7 @@ func (m *EventActionPruned) Reset()         { *m = EventActionPruned{} }
 func (m *EventActionPruned) String() string { return proto.CompactTextString(m) }
 func (*EventActionPruned) ProtoMessage()    {}
 func (*EventActionPruned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_912b51dfb11e99b6, []int{5}
+	return fileDescriptor_912b51dfb11e99b6, []int{7}
 }
 func (m *EventActionPruned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,43 +534,321 @@ func (m *EventActionPruned) GetId() uint64 {
 	return 0
 }
 
+// EventActionDependencyChanged is emitted when the expressions of a pending
+// Action are expanded again because one of their dependencies changed
+type EventActionDependencyChanged struct {
+	// id of action
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// dependency that changed, e.g. `warden/spaces/1`
+	Dependency string `protobuf:"bytes,2,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	// reason of the change, as reported by the module owning the dependency
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// error is set when the expressions couldn't be expanded again, in which
+	// case the action is revoked
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventActionDependencyChanged) Reset()         { *m = EventActionDependencyChanged{} }
+func (m *EventActionDependencyChanged) String() string { return proto.CompactTextString(m) }
+func (*EventActionDependencyChanged) ProtoMessage()    {}
+func (*EventActionDependencyChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_912b51dfb11e99b6, []int{8}
+}
+func (m *EventActionDependencyChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventActionDependencyChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActionDependencyChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventActionDependencyChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActionDependencyChanged.Merge(m, src)
+}
+func (m *EventActionDependencyChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventActionDependencyChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActionDependencyChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActionDependencyChanged proto.InternalMessageInfo
+
+func (m *EventActionDependencyChanged) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventActionDependencyChanged) GetDependency() string {
+	if m != nil {
+		return m.Dependency
+	}
+	return ""
+}
+
+func (m *EventActionDependencyChanged) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventActionDependencyChanged) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EventActionQueued is emitted when an approved Action is queued for a delayed
+// execution
+type EventActionQueued struct {
+	// id of action
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// execute_after is the time after which the action is executed
+	ExecuteAfter time.Time `protobuf:"bytes,2,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after"`
+}
+
+func (m *EventActionQueued) Reset()         { *m = EventActionQueued{} }
+func (m *EventActionQueued) String() string { return proto.CompactTextString(m) }
+func (*EventActionQueued) ProtoMessage()    {}
+func (*EventActionQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_912b51dfb11e99b6, []int{9}
+}
+func (m *EventActionQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventActionQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActionQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventActionQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActionQueued.Merge(m, src)
+}
+func (m *EventActionQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventActionQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActionQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActionQueued proto.InternalMessageInfo
+
+func (m *EventActionQueued) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventActionQueued) GetExecuteAfter() time.Time {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return time.Time{}
+}
+
+// EventVoteDelegated is emitted when an address delegates its votes
+type EventVoteDelegated struct {
+	// id of the delegation
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator is the address delegating its votes
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address voting on behalf of the delegator
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *EventVoteDelegated) Reset()         { *m = EventVoteDelegated{} }
+func (m *EventVoteDelegated) String() string { return proto.CompactTextString(m) }
+func (*EventVoteDelegated) ProtoMessage()    {}
+func (*EventVoteDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_912b51dfb11e99b6, []int{10}
+}
+func (m *EventVoteDelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteDelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVoteDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteDelegated.Merge(m, src)
+}
+func (m *EventVoteDelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteDelegated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVoteDelegated proto.InternalMessageInfo
+
+func (m *EventVoteDelegated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventVoteDelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventVoteDelegated) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// EventVoteDelegationRevoked is emitted when a vote delegation is revoked
+type EventVoteDelegationRevoked struct {
+	// id of the delegation
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator is the address that delegated its votes
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *EventVoteDelegationRevoked) Reset()         { *m = EventVoteDelegationRevoked{} }
+func (m *EventVoteDelegationRevoked) String() string { return proto.CompactTextString(m) }
+func (*EventVoteDelegationRevoked) ProtoMessage()    {}
+func (*EventVoteDelegationRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_912b51dfb11e99b6, []int{11}
+}
+func (m *EventVoteDelegationRevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteDelegationRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteDelegationRevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVoteDelegationRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteDelegationRevoked.Merge(m, src)
+}
+func (m *EventVoteDelegationRevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteDelegationRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteDelegationRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVoteDelegationRevoked proto.InternalMessageInfo
+
+func (m *EventVoteDelegationRevoked) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventVoteDelegationRevoked) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateTemplate)(nil), "warden.act.v1beta1.EventCreateTemplate")
 	proto.RegisterType((*EventUpdateTemplate)(nil), "warden.act.v1beta1.EventUpdateTemplate")
+	proto.RegisterType((*EventTransferTemplateOwnership)(nil), "warden.act.v1beta1.EventTransferTemplateOwnership")
+	proto.RegisterType((*EventSetContractTemplates)(nil), "warden.act.v1beta1.EventSetContractTemplates")
 	proto.RegisterType((*EventCreateAction)(nil), "warden.act.v1beta1.EventCreateAction")
 	proto.RegisterType((*EventActionVoted)(nil), "warden.act.v1beta1.EventActionVoted")
 	proto.RegisterType((*EventActionStateChange)(nil), "warden.act.v1beta1.EventActionStateChange")
 	proto.RegisterType((*EventActionPruned)(nil), "warden.act.v1beta1.EventActionPruned")
+	proto.RegisterType((*EventActionDependencyChanged)(nil), "warden.act.v1beta1.EventActionDependencyChanged")
+	proto.RegisterType((*EventActionQueued)(nil), "warden.act.v1beta1.EventActionQueued")
+	proto.RegisterType((*EventVoteDelegated)(nil), "warden.act.v1beta1.EventVoteDelegated")
+	proto.RegisterType((*EventVoteDelegationRevoked)(nil), "warden.act.v1beta1.EventVoteDelegationRevoked")
 }
 
 func init() { proto.RegisterFile("warden/act/v1beta1/events.proto", fileDescriptor_912b51dfb11e99b6) }
 
 var fileDescriptor_912b51dfb11e99b6 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xce, 0x26, 0x55, 0xdb, 0x6c, 0x25, 0xb7, 0x75, 0xa5, 0xca, 0xca, 0xc1, 0xb5, 0xdc, 0x56,
-	0xca, 0x05, 0x5b, 0x09, 0x67, 0x14, 0x41, 0xe0, 0xc0, 0x0d, 0x39, 0x81, 0x03, 0x07, 0xa2, 0x8d,
-	0x3d, 0x4a, 0x2c, 0x25, 0xbb, 0x2b, 0x7b, 0xec, 0x90, 0x07, 0xe0, 0xce, 0xc3, 0xf0, 0x10, 0x1c,
-	0x73, 0xe4, 0x88, 0x92, 0x17, 0x41, 0xf6, 0xda, 0x22, 0xc2, 0x04, 0xc1, 0x6d, 0x3e, 0xfb, 0xfb,
-	0x99, 0xfd, 0x34, 0xf4, 0xcf, 0x82, 0x45, 0x01, 0x70, 0x97, 0xf9, 0xe8, 0xa6, 0x9d, 0x31, 0x20,
-	0xeb, 0xb8, 0x90, 0x02, 0xc7, 0xd8, 0x91, 0x91, 0x40, 0xa1, 0xeb, 0x8a, 0xe0, 0x30, 0x1f, 0x9d,
-	0x82, 0xd0, 0x7a, 0x4d, 0xc4, 0x7c, 0x0c, 0x05, 0x57, 0xa2, 0xd6, 0xbf, 0x9d, 0x84, 0x51, 0x2a,
-	0x10, 0x14, 0xcb, 0xee, 0xd1, 0x5f, 0x27, 0x59, 0x54, 0x3f, 0x02, 0x86, 0x30, 0x84, 0xb9, 0x9c,
-	0x31, 0x04, 0x5d, 0xa3, 0xf5, 0x30, 0x30, 0x88, 0x45, 0xda, 0x9f, 0xbc, 0x7a, 0x18, 0xe8, 0x06,
-	0xfd, 0xe2, 0x67, 0x0c, 0x11, 0x19, 0x75, 0x8b, 0xb4, 0x9b, 0x5e, 0x09, 0xed, 0xff, 0x85, 0xc1,
-	0xb9, 0x0c, 0xde, 0x30, 0xb0, 0x0f, 0xe8, 0xcf, 0xad, 0x9c, 0xc3, 0x7c, 0x8f, 0x0f, 0xa4, 0xdc,
-	0x10, 0xfa, 0x23, 0xd7, 0x2b, 0xe5, 0x85, 0x40, 0x08, 0x2a, 0x72, 0x8b, 0x7e, 0x93, 0x2c, 0xc2,
-	0xd0, 0x0f, 0x25, 0xe3, 0x58, 0x58, 0x6c, 0x7f, 0xd2, 0x7b, 0xb4, 0x99, 0xbd, 0x7d, 0x84, 0x4b,
-	0x09, 0x46, 0xc3, 0x22, 0x6d, 0xad, 0x6b, 0x3b, 0xd5, 0x72, 0x9d, 0xe7, 0x94, 0xe1, 0x52, 0x82,
-	0xf7, 0x35, 0x2d, 0x26, 0xfb, 0x8e, 0xd0, 0xdf, 0x5b, 0x7b, 0x0c, 0x90, 0x21, 0xf4, 0xa7, 0x8c,
-	0x4f, 0xaa, 0x95, 0x9d, 0xd2, 0xef, 0x32, 0x82, 0x34, 0x14, 0x49, 0x3c, 0x8a, 0x91, 0x61, 0x12,
-	0xe7, 0x1b, 0x69, 0x5d, 0x6b, 0x77, 0xe2, 0x20, 0xe7, 0x79, 0x5a, 0x29, 0x54, 0x58, 0xef, 0x51,
-	0xca, 0x61, 0x51, 0xba, 0x34, 0xde, 0xe9, 0xd2, 0xe4, 0xb0, 0x50, 0xa3, 0xfd, 0xb7, 0x68, 0x5f,
-	0xfd, 0x3f, 0x8b, 0x12, 0x5e, 0xad, 0xef, 0xe8, 0xea, 0x7e, 0x6d, 0x92, 0xd5, 0xda, 0x24, 0x8f,
-	0x6b, 0x93, 0xdc, 0x6e, 0xcc, 0xda, 0x6a, 0x63, 0xd6, 0x1e, 0x36, 0x66, 0xed, 0xf2, 0x78, 0x12,
-	0xe2, 0x34, 0x19, 0x3b, 0xbe, 0x98, 0xbb, 0x2a, 0x75, 0x2f, 0xbf, 0x1e, 0x5f, 0xcc, 0x0a, 0xfc,
-	0x02, 0xba, 0xd7, 0xf9, 0xd9, 0x65, 0x35, 0xc7, 0xe5, 0xf1, 0x8d, 0x3f, 0xe7, 0xa4, 0xfd, 0xa7,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xfb, 0x84, 0x5f, 0xef, 0x02, 0x00, 0x00,
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0x63, 0xbe, 0x6e, 0x72, 0xb8, 0xe4, 0x82, 0x41, 0x28, 0x37, 0x97, 0xeb, 0x44, 0x6e,
+	0x91, 0x58, 0xb4, 0xb6, 0xa0, 0xeb, 0x0a, 0xf1, 0xb5, 0xa0, 0x9b, 0xb6, 0x26, 0xed, 0xa2, 0x0b,
+	0xac, 0x89, 0x7d, 0x08, 0x6e, 0x93, 0x19, 0x6b, 0x3c, 0x76, 0x88, 0xd4, 0x6d, 0xf7, 0x6c, 0xfa,
+	0x16, 0x7d, 0x10, 0xd4, 0x15, 0xcb, 0xae, 0xda, 0x0a, 0x5e, 0xa4, 0xf2, 0xcc, 0x38, 0x09, 0x04,
+	0xd4, 0xb2, 0xf3, 0xff, 0xcc, 0xef, 0x7c, 0x78, 0xce, 0x99, 0x03, 0x8d, 0x3e, 0xe1, 0x21, 0x52,
+	0x97, 0x04, 0xc2, 0xcd, 0x36, 0xdb, 0x28, 0xc8, 0xa6, 0x8b, 0x19, 0x52, 0x91, 0x38, 0x31, 0x67,
+	0x82, 0x99, 0xa6, 0x02, 0x1c, 0x12, 0x08, 0x47, 0x03, 0xf5, 0x95, 0x0e, 0xeb, 0x30, 0x79, 0xec,
+	0xe6, 0x5f, 0x8a, 0xac, 0x37, 0x3a, 0x8c, 0x75, 0xba, 0xe8, 0x4a, 0xd5, 0x4e, 0x4f, 0x5c, 0x11,
+	0xf5, 0x30, 0x11, 0xa4, 0x17, 0x17, 0xc0, 0x1d, 0xb9, 0x48, 0x20, 0x22, 0x46, 0x35, 0xf0, 0xf8,
+	0x5e, 0xc0, 0xcf, 0x98, 0x40, 0x45, 0xd9, 0xdb, 0xb0, 0x7c, 0x90, 0x57, 0xb8, 0xc7, 0x91, 0x08,
+	0x6c, 0x61, 0x2f, 0xee, 0x12, 0x81, 0x66, 0x15, 0xa6, 0xa2, 0xb0, 0x66, 0x34, 0x8d, 0x8d, 0x19,
+	0x6f, 0x2a, 0x0a, 0xcd, 0x1a, 0xfc, 0x15, 0xe4, 0x04, 0xe3, 0xb5, 0xa9, 0xa6, 0xb1, 0x51, 0xf1,
+	0x0a, 0x69, 0x7f, 0x31, 0x74, 0x84, 0x37, 0x71, 0xf8, 0x9b, 0x08, 0x19, 0xf2, 0x24, 0x62, 0x54,
+	0x46, 0x98, 0xf1, 0x0a, 0x69, 0xfe, 0x0f, 0x90, 0x4a, 0xdf, 0xd0, 0x6f, 0x0f, 0x6a, 0xd3, 0x32,
+	0x7c, 0x45, 0x5b, 0x76, 0x07, 0xe6, 0x3a, 0x54, 0x59, 0x37, 0xf4, 0xf1, 0x2c, 0xe6, 0x98, 0x48,
+	0xff, 0x19, 0x89, 0x2c, 0xb0, 0x6e, 0x78, 0x30, 0x34, 0xe6, 0x18, 0xc5, 0xfe, 0x38, 0x36, 0xab,
+	0x30, 0x8a, 0xfd, 0x11, 0x66, 0x0b, 0xb0, 0x64, 0xb5, 0x2d, 0x4e, 0x68, 0x72, 0x82, 0xbc, 0xa8,
+	0xf7, 0x65, 0x9f, 0x22, 0x4f, 0x4e, 0xa3, 0x78, 0xa2, 0xf0, 0x75, 0xa8, 0xc6, 0x1c, 0xb3, 0x88,
+	0xa5, 0x89, 0xcf, 0x72, 0x4a, 0xdf, 0xc0, 0x42, 0x61, 0x95, 0xae, 0xe6, 0x7f, 0x50, 0xc9, 0xf3,
+	0x2b, 0x42, 0xfd, 0x44, 0x99, 0x62, 0x5f, 0x1e, 0xda, 0x9f, 0x0d, 0xf8, 0x57, 0xa6, 0x3d, 0x42,
+	0xb1, 0xc7, 0xa8, 0xe0, 0x24, 0x10, 0x45, 0xe6, 0xc4, 0xac, 0x43, 0x39, 0xd0, 0x46, 0x99, 0xb7,
+	0xe2, 0x0d, 0xb5, 0xe9, 0xc0, 0x32, 0x89, 0x63, 0xce, 0x32, 0xf4, 0x85, 0x76, 0xf0, 0xa3, 0x50,
+	0x5f, 0xe1, 0x92, 0x3e, 0x2a, 0x42, 0x1d, 0x86, 0xe6, 0x13, 0x30, 0x39, 0xbe, 0xc7, 0x40, 0xdc,
+	0xc0, 0xa7, 0x25, 0xbe, 0xa8, 0x4e, 0x46, 0xb4, 0xfd, 0x1c, 0x96, 0xc6, 0xba, 0xbf, 0x23, 0xa7,
+	0xe3, 0x01, 0xbd, 0xff, 0x64, 0xc0, 0xa2, 0xf4, 0x57, 0x9e, 0x6f, 0x99, 0xc0, 0x70, 0xc2, 0xbd,
+	0x09, 0xf3, 0x31, 0xe1, 0x22, 0x0a, 0xa2, 0x98, 0x50, 0xa1, 0x43, 0x8c, 0x9b, 0xcc, 0x6d, 0xa8,
+	0xe4, 0x13, 0xe9, 0x8b, 0x41, 0x8c, 0xb2, 0xd4, 0xea, 0x96, 0xed, 0x4c, 0xbe, 0x14, 0x67, 0x94,
+	0xa5, 0x35, 0x88, 0xd1, 0x2b, 0x67, 0xfa, 0xcb, 0xfe, 0x6a, 0xc0, 0xea, 0x58, 0x1d, 0x47, 0x82,
+	0x08, 0xdc, 0x3b, 0x25, 0xb4, 0x33, 0x39, 0x86, 0x87, 0xf0, 0xcf, 0xb0, 0x9b, 0x89, 0x20, 0x22,
+	0x4d, 0x64, 0x45, 0xd5, 0xad, 0xe6, 0xfd, 0x19, 0x8f, 0x24, 0xe7, 0x0d, 0xc7, 0x40, 0x69, 0x73,
+	0x1b, 0x20, 0xef, 0xb8, 0x8e, 0x32, 0xfd, 0x87, 0x51, 0xf2, 0x29, 0xd1, 0x01, 0x56, 0x60, 0x16,
+	0x39, 0x67, 0x5c, 0x0f, 0xb4, 0x12, 0xf6, 0x23, 0xdd, 0x13, 0xe5, 0xf5, 0x8a, 0xa7, 0x74, 0xf2,
+	0x52, 0xed, 0x8f, 0xb0, 0x36, 0x06, 0xed, 0x63, 0x8c, 0x34, 0x44, 0x1a, 0x0c, 0xd4, 0x5f, 0x4f,
+	0x36, 0xc1, 0x02, 0x08, 0x87, 0x90, 0xee, 0xc1, 0x98, 0xc5, 0x5c, 0x85, 0x39, 0x8e, 0x24, 0x61,
+	0x54, 0x8f, 0xae, 0x56, 0xf7, 0x94, 0x48, 0x6f, 0x94, 0xf8, 0x3a, 0xc5, 0xf4, 0x8e, 0x94, 0x87,
+	0xb0, 0x80, 0x67, 0x18, 0xa4, 0x02, 0x7d, 0x72, 0x22, 0xf4, 0xb3, 0x99, 0xdf, 0xaa, 0x3b, 0x6a,
+	0xb3, 0x39, 0xc5, 0x66, 0x73, 0x5a, 0xc5, 0x66, 0xdb, 0x2d, 0x5f, 0x7c, 0x6f, 0x94, 0xce, 0x7f,
+	0x34, 0x0c, 0xef, 0x6f, 0xed, 0xba, 0x93, 0x7b, 0xda, 0xc7, 0x60, 0xca, 0x7c, 0x79, 0xeb, 0xf7,
+	0xb1, 0x8b, 0x1d, 0x72, 0xd7, 0xa0, 0xad, 0x41, 0x25, 0x54, 0x87, 0xc3, 0x49, 0x1d, 0x19, 0xf2,
+	0x47, 0xa6, 0x05, 0x16, 0xcf, 0xb3, 0xd0, 0xf6, 0x0b, 0xa8, 0xdf, 0x8e, 0x1f, 0x31, 0xea, 0x61,
+	0xc6, 0x3e, 0x3c, 0x34, 0xcf, 0xee, 0xf1, 0xc5, 0x95, 0x65, 0x5c, 0x5e, 0x59, 0xc6, 0xcf, 0x2b,
+	0xcb, 0x38, 0xbf, 0xb6, 0x4a, 0x97, 0xd7, 0x56, 0xe9, 0xdb, 0xb5, 0x55, 0x7a, 0xb7, 0xdf, 0x89,
+	0xc4, 0x69, 0xda, 0x76, 0x02, 0xd6, 0x73, 0xd5, 0x94, 0x3c, 0x95, 0x77, 0x10, 0xb0, 0xae, 0xd6,
+	0xb7, 0xa4, 0x7b, 0x26, 0x97, 0x77, 0xfe, 0x2c, 0x92, 0x62, 0x85, 0xb7, 0xe7, 0x24, 0xf4, 0xec,
+	0xd7, 0x00, 0xe0, 0xfe, 0x3a, 0xa3, 0x6c, 0x06, 0x00, 0x00,
 }
 
 func (m *EventCreateTemplate) Marshal() (dAtA []byte, err error) {
@@ -447,12 +906,120 @@ func (m *EventUpdateTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+	if len(m.NewExpression) > 0 {
+		i -= len(m.NewExpression)
+		copy(dAtA[i:], m.NewExpression)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewExpression)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
+	if len(m.OldExpression) > 0 {
+		i -= len(m.OldExpression)
+		copy(dAtA[i:], m.OldExpression)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldExpression)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTransferTemplateOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransferTemplateOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransferTemplateOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetContractTemplates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetContractTemplates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetContractTemplates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectTemplateId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RejectTemplateId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ApproveTemplateId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ApproveTemplateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *EventCreateAction) Marshal() (dAtA []byte, err error) {
@@ -550,6 +1117,13 @@ func (m *EventActionStateChange) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NewStatus != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.NewStatus))
 		i--
@@ -596,6 +1170,168 @@ func (m *EventActionPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventActionDependencyChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActionDependencyChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActionDependencyChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Dependency) > 0 {
+		i -= len(m.Dependency)
+		copy(dAtA[i:], m.Dependency)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Dependency)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventActionQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActionQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActionQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecuteAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteDelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteDelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteDelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteDelegationRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteDelegationRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteDelegationRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -632,6 +1368,60 @@ func (m *EventUpdateTemplate) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovEvents(uint64(m.Id))
 	}
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldExpression)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewExpression)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTransferTemplateOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetContractTemplates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ApproveTemplateId != 0 {
+		n += 1 + sovEvents(uint64(m.ApproveTemplateId))
+	}
+	if m.RejectTemplateId != 0 {
+		n += 1 + sovEvents(uint64(m.RejectTemplateId))
+	}
 	return n
 }
 
@@ -685,28 +1475,865 @@ func (m *EventActionStateChange) Size() (n int) {
 	if m.NewStatus != 0 {
 		n += 1 + sovEvents(uint64(m.NewStatus))
 	}
-	return n
-}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventActionPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EventActionDependencyChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Dependency)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventActionQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventVoteDelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVoteDelegationRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTransferTemplateOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTransferTemplateOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTransferTemplateOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetContractTemplates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetContractTemplates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetContractTemplates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
+			}
+			m.ApproveTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproveTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
+			}
+			m.RejectTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventActionVoted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventActionVoted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventActionVoted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= ActionVoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventActionPruned) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCreateTemplate) Unmarshal(dAtA []byte) error {
+func (m *EventActionStateChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -729,10 +2356,10 @@ func (m *EventCreateTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActionStateChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActionStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -755,8 +2382,46 @@ func (m *EventCreateTemplate) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+			}
+			m.PreviousStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStatus |= ActionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= ActionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -784,7 +2449,7 @@ func (m *EventCreateTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -807,7 +2472,7 @@ func (m *EventCreateTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateTemplate) Unmarshal(dAtA []byte) error {
+func (m *EventActionPruned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,10 +2495,10 @@ func (m *EventUpdateTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActionPruned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActionPruned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -876,7 +2541,7 @@ func (m *EventUpdateTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateAction) Unmarshal(dAtA []byte) error {
+func (m *EventActionDependencyChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -899,10 +2564,10 @@ func (m *EventCreateAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActionDependencyChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActionDependencyChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -926,7 +2591,7 @@ func (m *EventCreateAction) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependency", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -954,7 +2619,71 @@ func (m *EventCreateAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Dependency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -977,7 +2706,7 @@ func (m *EventCreateAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventActionVoted) Unmarshal(dAtA []byte) error {
+func (m *EventActionQueued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1000,10 +2729,10 @@ func (m *EventActionVoted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventActionVoted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActionQueued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventActionVoted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActionQueued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1027,9 +2756,9 @@ func (m *EventActionVoted) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1039,43 +2768,25 @@ func (m *EventActionVoted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
-			}
-			m.VoteType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteType |= ActionVoteType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1097,7 +2808,7 @@ func (m *EventActionVoted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventActionStateChange) Unmarshal(dAtA []byte) error {
+func (m *EventVoteDelegated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,10 +2831,10 @@ func (m *EventActionStateChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventActionStateChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVoteDelegated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventActionStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVoteDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1146,10 +2857,10 @@ func (m *EventActionStateChange) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.PreviousStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1159,16 +2870,29 @@ func (m *EventActionStateChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousStatus |= ActionStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
-			m.NewStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1178,11 +2902,24 @@ func (m *EventActionStateChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewStatus |= ActionStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1204,7 +2941,7 @@ func (m *EventActionStateChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventActionPruned) Unmarshal(dAtA []byte) error {
+func (m *EventVoteDelegationRevoked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1227,10 +2964,10 @@ func (m *EventActionPruned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventActionPruned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVoteDelegationRevoked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventActionPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVoteDelegationRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1252,6 +2989,38 @@ func (m *EventActionPruned) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"context"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +19,11 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// WasmKeeper defines the expected interface for the Wasm module.
+type WasmKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -12,8 +12,17 @@ import (
 type ActExpression ast.Expression
 
 func (r *ActExpression) EvalExpression(ctx context.Context, env shield.Environment) (bool, error) {
-	obj := shield.Eval((*ast.Expression)(r), env)
+	return evalResult(shield.Eval((*ast.Expression)(r), env))
+}
+
+// ActBytecode is an ActExpression compiled with shield.Compile.
+type ActBytecode []byte
+
+func (r ActBytecode) EvalExpression(ctx context.Context, env shield.Environment) (bool, error) {
+	return evalResult(shield.EvalBytecode(r, env))
+}
 
+func evalResult(obj object.Object) (bool, error) {
 	if obj.Type() == object.ERROR_OBJ {
 		return false, errors.Wrapf(ErrTemplateEvaluationFailed, "result: %s", obj.Inspect())
 	}
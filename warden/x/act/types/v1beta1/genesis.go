@@ -1,7 +1,10 @@
 package v1beta1
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default global index
@@ -20,5 +23,38 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	if err := gs.validateContractTemplates(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
+
+// validateContractTemplates checks that every contract has at most one entry,
+// with both templates set to ones of the genesis state.
+func (gs GenesisState) validateContractTemplates() error {
+	templates := make(map[uint64]bool, len(gs.Templates))
+	for _, t := range gs.Templates {
+		templates[t.Id] = true
+	}
+
+	contracts := make(map[string]bool, len(gs.ContractTemplates))
+	for _, c := range gs.ContractTemplates {
+		if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+			return fmt.Errorf("invalid contract address %s: %w", c.Contract, err)
+		}
+
+		if contracts[c.Contract] {
+			return fmt.Errorf("duplicate templates for contract %s", c.Contract)
+		}
+		contracts[c.Contract] = true
+
+		for _, id := range []uint64{c.ApproveTemplateId, c.RejectTemplateId} {
+			if !templates[id] {
+				return fmt.Errorf("contract %s: template %d not found", c.Contract, id)
+			}
+		}
+	}
+
+	return nil
+}
@@ -30,6 +30,10 @@ type GenesisState struct {
 	Params    Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Actions   []Action   `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
 	Templates []Template `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates"`
+	// template_versions are the previous versions of the templates.
+	TemplateVersions  []Template          `protobuf:"bytes,4,rep,name=template_versions,json=templateVersions,proto3" json:"template_versions"`
+	VoteDelegations   []VoteDelegation    `protobuf:"bytes,5,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations"`
+	ContractTemplates []ContractTemplates `protobuf:"bytes,6,rep,name=contract_templates,json=contractTemplates,proto3" json:"contract_templates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +90,27 @@ func (m *GenesisState) GetTemplates() []Template {
 	return nil
 }
 
+func (m *GenesisState) GetTemplateVersions() []Template {
+	if m != nil {
+		return m.TemplateVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteDelegations() []VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractTemplates() []ContractTemplates {
+	if m != nil {
+		return m.ContractTemplates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "warden.act.v1beta1.GenesisState")
 }
@@ -93,25 +118,31 @@ func init() {
 func init() { proto.RegisterFile("warden/act/v1beta1/genesis.proto", fileDescriptor_e2f5a34a355818db) }
 
 var fileDescriptor_e2f5a34a355818db = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x4f, 0x2c, 0x4a,
-	0x49, 0xcd, 0xd3, 0x4f, 0x4c, 0x2e, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0x4b, 0x4c, 0x2e, 0xd1, 0x83, 0xaa, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07,
-	0x93, 0x10, 0x65, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95,
-	0xc7, 0x62, 0x7c, 0x41, 0x62, 0x51, 0x62, 0x6e, 0x31, 0x1e, 0x05, 0x89, 0xc9, 0x25, 0x99, 0xf9,
-	0x79, 0x50, 0x05, 0x8a, 0x58, 0x14, 0x94, 0xa4, 0xe6, 0x16, 0xe4, 0x24, 0x96, 0xa4, 0x42, 0x94,
-	0x28, 0x5d, 0x65, 0xe4, 0xe2, 0x71, 0x87, 0xb8, 0x39, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x96,
-	0x8b, 0x0d, 0x62, 0x89, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x94, 0x1e, 0xa6, 0x1f, 0xf4,
-	0x02, 0xc0, 0x2a, 0x9c, 0x38, 0x4f, 0xdc, 0x93, 0x67, 0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10,
-	0x54, 0x93, 0x90, 0x3d, 0x17, 0x3b, 0xc4, 0x09, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0xb8, 0xf4, 0x3b,
-	0x82, 0x95, 0x20, 0xeb, 0x87, 0xe9, 0x12, 0x72, 0xe5, 0xe2, 0x84, 0x39, 0xb1, 0x58, 0x82, 0x19,
-	0x6c, 0x84, 0x0c, 0x36, 0x23, 0x42, 0xa0, 0x8a, 0x90, 0x0d, 0x41, 0xe8, 0x74, 0x8a, 0x3b, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x97, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x88, 0xb9, 0xba, 0xe0, 0xa0, 0x48, 0xce, 0xcf, 0x81, 0xf2, 0xd1,
-	0xb8, 0xfa, 0x15, 0xe0, 0x00, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x86, 0x05, 0x63, 0x12, 0x1b, 0x58,
-	0x91, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xde, 0x8d, 0x9e, 0x04, 0x02, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x13, 0x5b, 0x2b, 0x5d, 0x05, 0xdb, 0xc5, 0x43, 0x28, 0x92, 0xd6, 0x82, 0x50, 0x04,
+	0x13, 0xaa, 0x67, 0x11, 0xb5, 0xe2, 0x51, 0x69, 0xa5, 0x87, 0x1e, 0x2c, 0xdb, 0xed, 0x12, 0x03,
+	0x4d, 0x36, 0x64, 0xc7, 0xa8, 0x6f, 0xe1, 0x63, 0x78, 0xf4, 0x31, 0x7a, 0xec, 0x4d, 0x4f, 0x22,
+	0xed, 0xc1, 0xd7, 0x90, 0x6e, 0x36, 0xb6, 0xb6, 0x51, 0xbc, 0x84, 0x9d, 0xcd, 0xf7, 0x7f, 0x99,
+	0x09, 0x83, 0x2a, 0xf7, 0x24, 0xec, 0x33, 0xdf, 0x26, 0x14, 0xec, 0xa8, 0xde, 0x63, 0x40, 0xea,
+	0xb6, 0xc3, 0x7c, 0x26, 0x5c, 0x61, 0x05, 0x21, 0x07, 0x8e, 0x71, 0x4c, 0x58, 0x84, 0x82, 0xa5,
+	0x88, 0x52, 0x91, 0x78, 0xae, 0xcf, 0x6d, 0xf9, 0x8c, 0xb1, 0xd2, 0x96, 0xc3, 0x1d, 0x2e, 0x8f,
+	0xf6, 0xf4, 0xa4, 0x6e, 0xcb, 0x29, 0xfa, 0x80, 0x84, 0xc4, 0x13, 0x7f, 0x00, 0x84, 0x82, 0xcb,
+	0x7d, 0x05, 0xec, 0xa4, 0x00, 0xc0, 0xbc, 0x60, 0x40, 0x80, 0x29, 0xa4, 0x96, 0x82, 0x44, 0x1c,
+	0x58, 0xb7, 0xcf, 0x06, 0xcc, 0x21, 0x33, 0x59, 0xf5, 0x35, 0x83, 0x36, 0x2e, 0xe2, 0xe9, 0x5a,
+	0x40, 0x80, 0xe1, 0x23, 0x94, 0x8b, 0xdb, 0x31, 0xf4, 0x8a, 0x5e, 0x5b, 0x3f, 0x28, 0x59, 0xcb,
+	0xd3, 0x5a, 0x57, 0x92, 0x38, 0xcd, 0x0f, 0xdf, 0xcb, 0xda, 0xf3, 0xe7, 0xcb, 0x9e, 0xde, 0x54,
+	0x21, 0x7c, 0x8c, 0xd6, 0xe2, 0x66, 0x85, 0xb1, 0x52, 0xc9, 0xfc, 0x96, 0x3f, 0x91, 0xc8, 0x7c,
+	0x3e, 0x49, 0xe1, 0x73, 0x94, 0x4f, 0x86, 0x11, 0x46, 0x46, 0x2a, 0xb6, 0xd3, 0x14, 0xd7, 0x0a,
+	0x9a, 0x97, 0xcc, 0x92, 0xf8, 0x12, 0x15, 0x93, 0xa2, 0x1b, 0xb1, 0x50, 0xc8, 0x8e, 0xb2, 0xff,
+	0xd0, 0x65, 0xa7, 0xba, 0x66, 0x21, 0x09, 0xb7, 0x55, 0x16, 0xb7, 0x50, 0x61, 0xe1, 0x0f, 0x0a,
+	0x63, 0x55, 0xfa, 0xaa, 0x69, 0xbe, 0x36, 0x07, 0xd6, 0xf8, 0x46, 0x95, 0x75, 0x33, 0xfa, 0x71,
+	0x2b, 0x70, 0x07, 0x61, 0xca, 0x7d, 0x08, 0x09, 0x85, 0xee, 0x6c, 0xea, 0x9c, 0xd4, 0xee, 0xa6,
+	0x69, 0xcf, 0x14, 0x9d, 0xb4, 0x2b, 0x94, 0xb9, 0x48, 0x97, 0x5e, 0xdc, 0x0c, 0xc7, 0xa6, 0x3e,
+	0x1a, 0x9b, 0xfa, 0xc7, 0xd8, 0xd4, 0x9f, 0x26, 0xa6, 0x36, 0x9a, 0x98, 0xda, 0xdb, 0xc4, 0xd4,
+	0x3a, 0x0d, 0xc7, 0x85, 0xdb, 0xbb, 0x9e, 0x45, 0xb9, 0x67, 0xc7, 0xdf, 0xd8, 0x97, 0xcb, 0x40,
+	0xf9, 0x40, 0xd5, 0x0b, 0xa5, 0xfd, 0x20, 0x37, 0x09, 0x1e, 0x03, 0x26, 0x92, 0x7d, 0xea, 0xe5,
+	0x24, 0x74, 0xf8, 0x35, 0x00, 0xa6, 0x0a, 0x02, 0x72, 0x30, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +165,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractTemplates) > 0 {
+		for iNdEx := len(m.ContractTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TemplateVersions) > 0 {
+		for iNdEx := len(m.TemplateVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TemplateVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Templates) > 0 {
 		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -206,6 +279,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TemplateVersions) > 0 {
+		for _, e := range m.TemplateVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractTemplates) > 0 {
+		for _, e := range m.ContractTemplates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -345,6 +436,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateVersions = append(m.TemplateVersions, Template{})
+			if err := m.TemplateVersions[len(m.TemplateVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractTemplates = append(m.ContractTemplates, ContractTemplates{})
+			if err := m.ContractTemplates[len(m.ContractTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,15 @@ package v1beta1_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	contract := sdk.AccAddress("contract_address____").String()
+	templates := []types.Template{{Id: 1}, {Id: 2}}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +30,49 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid contract templates",
+			genState: &types.GenesisState{
+				Templates:         templates,
+				ContractTemplates: []types.ContractTemplates{{Contract: contract, ApproveTemplateId: 1, RejectTemplateId: 2}},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid contract address",
+			genState: &types.GenesisState{
+				Templates:         templates,
+				ContractTemplates: []types.ContractTemplates{{Contract: "contract", ApproveTemplateId: 1, RejectTemplateId: 2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate contract templates",
+			genState: &types.GenesisState{
+				Templates: templates,
+				ContractTemplates: []types.ContractTemplates{
+					{Contract: contract, ApproveTemplateId: 1, RejectTemplateId: 2},
+					{Contract: contract, ApproveTemplateId: 2, RejectTemplateId: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "contract templates without reject template",
+			genState: &types.GenesisState{
+				Templates:         templates,
+				ContractTemplates: []types.ContractTemplates{{Contract: contract, ApproveTemplateId: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "contract templates with unknown template",
+			genState: &types.GenesisState{
+				Templates:         templates,
+				ContractTemplates: []types.ContractTemplates{{Contract: contract, ApproveTemplateId: 1, RejectTemplateId: 3}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -15,6 +15,8 @@ const (
 
 	TemplateCountKey = "template/count"
 	TemplateKey      = "template/value/"
+
+	VoteDelegationCountKey = "vote_delegation/count"
 )
 
 var (
@@ -0,0 +1,45 @@
+package v1beta1
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgFieldValue returns the value of the field of msg with the given protobuf
+// name, e.g. "space_id".
+func MsgFieldValue(msg sdk.Msg, field string) (any, error) {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, fmt.Errorf("message %s is nil", sdk.MsgTypeURL(msg))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("message %s is not a struct", sdk.MsgTypeURL(msg))
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if protobufFieldName(t.Field(i).Tag.Get("protobuf")) == field {
+			return v.Field(i).Interface(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("message %s has no field %s", sdk.MsgTypeURL(msg), field)
+}
+
+// protobufFieldName returns the name in a protobuf struct tag, e.g.
+// "space_id" for "varint,1,opt,name=space_id,json=spaceId,proto3".
+func protobufFieldName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			return name
+		}
+	}
+	return ""
+}
@@ -1,8 +1,10 @@
 package v1beta1
 
 import (
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"fmt"
 	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -32,5 +34,27 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	typeUrls := make(map[string]bool, len(p.TemplatesBindings))
+	for _, b := range p.TemplatesBindings {
+		if b.TypeUrl == "" || b.Field == "" || b.Resolver == "" {
+			return fmt.Errorf("invalid templates binding %v: type_url, field and resolver are required", b)
+		}
+
+		if typeUrls[b.TypeUrl] {
+			return fmt.Errorf("duplicate templates binding for %s", b.TypeUrl)
+		}
+		typeUrls[b.TypeUrl] = true
+	}
+
 	return nil
 }
+
+// TemplatesBinding returns the templates binding for the typeUrl, if any.
+func (p Params) TemplatesBinding(typeUrl string) (TemplatesBinding, bool) {
+	for _, b := range p.TemplatesBindings {
+		if b.TypeUrl == typeUrl {
+			return b, true
+		}
+	}
+	return TemplatesBinding{}, false
+}
@@ -33,6 +33,12 @@ type Params struct {
 	MaxPendingTime           time.Duration `protobuf:"bytes,1,opt,name=max_pending_time,json=maxPendingTime,proto3,stdduration" json:"max_pending_time"`
 	MaxCompletedTime         time.Duration `protobuf:"bytes,2,opt,name=max_completed_time,json=maxCompletedTime,proto3,stdduration" json:"max_completed_time"`
 	PruneCheckBlockFrequency int64         `protobuf:"varint,3,opt,name=prune_check_block_frequency,json=pruneCheckBlockFrequency,proto3" json:"prune_check_block_frequency,omitempty"`
+	// templates_bindings bind message types without a registered template
+	// provider to the templates found from one of their fields.
+	TemplatesBindings []TemplatesBinding `protobuf:"bytes,4,rep,name=templates_bindings,json=templatesBindings,proto3" json:"templates_bindings"`
+	// simplify_expressions enables the simplification of the preprocessed
+	// expressions of the Actions before they are stored, see shield.Simplify.
+	SimplifyExpressions bool `protobuf:"varint,5,opt,name=simplify_expressions,json=simplifyExpressions,proto3" json:"simplify_expressions,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -89,36 +95,127 @@ func (m *Params) GetPruneCheckBlockFrequency() int64 {
 	return 0
 }
 
+func (m *Params) GetTemplatesBindings() []TemplatesBinding {
+	if m != nil {
+		return m.TemplatesBindings
+	}
+	return nil
+}
+
+func (m *Params) GetSimplifyExpressions() bool {
+	if m != nil {
+		return m.SimplifyExpressions
+	}
+	return false
+}
+
+// TemplatesBinding binds the messages of a type to the templates returned by
+// a field resolver for the value of one of their fields, e.g. the templates of
+// the Space identified by a space_id field.
+type TemplatesBinding struct {
+	// type_url is the type URL of the messages, e.g. "/example.v1.MsgDoSomething".
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// field is the name of the field of the messages passed to the resolver,
+	// e.g. "space_id".
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// resolver is the name of the field resolver registered in the
+	// TemplatesRegistry, e.g. "warden.space.sign".
+	Resolver string `protobuf:"bytes,3,opt,name=resolver,proto3" json:"resolver,omitempty"`
+}
+
+func (m *TemplatesBinding) Reset()         { *m = TemplatesBinding{} }
+func (m *TemplatesBinding) String() string { return proto.CompactTextString(m) }
+func (*TemplatesBinding) ProtoMessage()    {}
+func (*TemplatesBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6822c04e0d5e4355, []int{1}
+}
+func (m *TemplatesBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplatesBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TemplatesBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TemplatesBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplatesBinding.Merge(m, src)
+}
+func (m *TemplatesBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplatesBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplatesBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplatesBinding proto.InternalMessageInfo
+
+func (m *TemplatesBinding) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *TemplatesBinding) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *TemplatesBinding) GetResolver() string {
+	if m != nil {
+		return m.Resolver
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "warden.act.v1beta1.Params")
+	proto.RegisterType((*TemplatesBinding)(nil), "warden.act.v1beta1.TemplatesBinding")
 }
 
 func init() { proto.RegisterFile("warden/act/v1beta1/params.proto", fileDescriptor_6822c04e0d5e4355) }
 
 var fileDescriptor_6822c04e0d5e4355 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xe3, 0x56, 0xea, 0x90, 0xab, 0x7b, 0x75, 0x09, 0x0c, 0xa5, 0x20, 0xa7, 0x62, 0xaa,
-	0x2a, 0x61, 0xab, 0xb0, 0x21, 0xb1, 0xb4, 0x15, 0x73, 0x55, 0x21, 0x06, 0x06, 0x22, 0xc7, 0x71,
-	0xd3, 0xa8, 0xb1, 0x1d, 0x52, 0x07, 0xda, 0x57, 0x60, 0x82, 0x8d, 0x91, 0x91, 0xb1, 0x8f, 0xd1,
-	0xb1, 0x23, 0x13, 0xa0, 0x76, 0x28, 0x8f, 0x81, 0x62, 0x27, 0x0b, 0x1b, 0x4b, 0x74, 0x4e, 0xce,
-	0xe7, 0xcf, 0x47, 0xbf, 0x6d, 0xf7, 0x9e, 0xa4, 0x01, 0x13, 0x98, 0x50, 0x85, 0xef, 0x3a, 0x3e,
-	0x53, 0xa4, 0x83, 0x13, 0x92, 0x12, 0x3e, 0x45, 0x49, 0x2a, 0x95, 0x74, 0x1c, 0x03, 0x20, 0x42,
-	0x15, 0x2a, 0x80, 0xc6, 0x0e, 0xe1, 0x91, 0x90, 0x58, 0x7f, 0x0d, 0xd6, 0xd8, 0x0b, 0x65, 0x28,
-	0x75, 0x89, 0xf3, 0xaa, 0xf8, 0x0b, 0x43, 0x29, 0xc3, 0x98, 0x61, 0xdd, 0xf9, 0xd9, 0x08, 0x07,
-	0x59, 0x4a, 0x54, 0x24, 0x85, 0x99, 0x1f, 0x3d, 0x55, 0xec, 0xda, 0x40, 0xdf, 0xe6, 0x0c, 0xed,
-	0xff, 0x9c, 0xcc, 0xbc, 0x84, 0x89, 0x20, 0x12, 0xa1, 0xa7, 0x22, 0xce, 0xea, 0xa0, 0x09, 0x5a,
-	0x7f, 0x4e, 0xf6, 0x91, 0xb1, 0xa0, 0xd2, 0x82, 0xfa, 0x85, 0xa5, 0xfb, 0x77, 0xf9, 0xee, 0x5a,
-	0xcf, 0x1f, 0x2e, 0x78, 0xdd, 0x2e, 0xda, 0x60, 0xf8, 0x8f, 0x93, 0xd9, 0xc0, 0x08, 0x2e, 0x23,
-	0xce, 0x9c, 0x2b, 0xdb, 0xc9, 0x9d, 0x54, 0xf2, 0x24, 0x66, 0x8a, 0x05, 0xc6, 0x5a, 0xf9, 0xa5,
-	0x35, 0xdf, 0xab, 0x57, 0x2a, 0xb4, 0xf7, 0xdc, 0x3e, 0x48, 0xd2, 0x4c, 0x30, 0x8f, 0x8e, 0x19,
-	0x9d, 0x78, 0x7e, 0x2c, 0xe9, 0xc4, 0x1b, 0xa5, 0xec, 0x36, 0x63, 0x82, 0xce, 0xeb, 0xd5, 0x26,
-	0x68, 0x55, 0x87, 0x75, 0x8d, 0xf4, 0x72, 0xa2, 0x9b, 0x03, 0x17, 0xe5, 0xfc, 0xec, 0xf0, 0xeb,
-	0xc5, 0x05, 0x0f, 0xdb, 0x45, 0x7b, 0xb7, 0x08, 0x7f, 0xa6, 0xe3, 0x37, 0x41, 0x74, 0x6f, 0x96,
-	0x6b, 0x08, 0x56, 0x6b, 0x08, 0x3e, 0xd7, 0x10, 0x3c, 0x6e, 0xa0, 0xb5, 0xda, 0x40, 0xeb, 0x6d,
-	0x03, 0xad, 0xeb, 0x7e, 0x18, 0xa9, 0x71, 0xe6, 0x23, 0x2a, 0x39, 0x36, 0x27, 0x8f, 0xf5, 0xf2,
-	0x54, 0xc6, 0x45, 0xff, 0xa3, 0x2d, 0xc4, 0x6a, 0x9e, 0xb0, 0x69, 0xf9, 0xba, 0x7e, 0x4d, 0x43,
-	0xa7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x95, 0x05, 0x7b, 0xfa, 0x01, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x73, 0xff, 0xb4, 0xfd, 0x27, 0x57, 0x81, 0xda, 0x6b, 0x86, 0x34, 0x20, 0x27, 0xaa,
+	0x18, 0xa2, 0x4a, 0xd8, 0x4a, 0xd9, 0x2a, 0xb1, 0xa4, 0x85, 0xb9, 0xb2, 0x0a, 0x03, 0x43, 0xad,
+	0xb3, 0xfd, 0xc6, 0x3d, 0xf5, 0xce, 0x67, 0xee, 0xce, 0x25, 0xf9, 0x0a, 0x4c, 0x8c, 0x8c, 0x0c,
+	0x0c, 0x8c, 0xfd, 0x18, 0x1d, 0x3b, 0x32, 0x01, 0x4a, 0x86, 0xf2, 0x31, 0x90, 0xef, 0x6c, 0x90,
+	0xc2, 0xc4, 0x62, 0xdd, 0xe3, 0xe7, 0xd1, 0xef, 0x1e, 0xdd, 0xfb, 0xe2, 0xe1, 0x3b, 0xaa, 0x52,
+	0xc8, 0x03, 0x9a, 0x98, 0xe0, 0x7a, 0x12, 0x83, 0xa1, 0x93, 0xa0, 0xa0, 0x8a, 0x0a, 0xed, 0x17,
+	0x4a, 0x1a, 0x49, 0x88, 0x0b, 0xf8, 0x34, 0x31, 0x7e, 0x1d, 0x18, 0xec, 0x52, 0xc1, 0x72, 0x19,
+	0xd8, 0xaf, 0x8b, 0x0d, 0x7a, 0x99, 0xcc, 0xa4, 0x3d, 0x06, 0xd5, 0xa9, 0xfe, 0xeb, 0x65, 0x52,
+	0x66, 0x1c, 0x02, 0xab, 0xe2, 0x72, 0x16, 0xa4, 0xa5, 0xa2, 0x86, 0xc9, 0xdc, 0xf9, 0x07, 0x9f,
+	0xdb, 0x78, 0xeb, 0xcc, 0xde, 0x46, 0x42, 0xbc, 0x23, 0xe8, 0x3c, 0x2a, 0x20, 0x4f, 0x59, 0x9e,
+	0x45, 0x86, 0x09, 0xe8, 0xa3, 0x11, 0x1a, 0x6f, 0x1f, 0xed, 0xfb, 0x8e, 0xe2, 0x37, 0x14, 0xff,
+	0xb4, 0xa6, 0x4c, 0x1f, 0xdc, 0x7e, 0x1b, 0xb6, 0x3e, 0x7e, 0x1f, 0xa2, 0x2f, 0xf7, 0x37, 0x87,
+	0x28, 0x7c, 0x28, 0xe8, 0xfc, 0xcc, 0x01, 0xce, 0x99, 0x00, 0xf2, 0x1a, 0x93, 0x8a, 0x99, 0x48,
+	0x51, 0x70, 0x30, 0x90, 0x3a, 0xea, 0x7f, 0xff, 0x48, 0xad, 0x7a, 0x9d, 0x34, 0x08, 0xcb, 0x7d,
+	0x8e, 0x1f, 0x15, 0xaa, 0xcc, 0x21, 0x4a, 0x2e, 0x21, 0xb9, 0x8a, 0x62, 0x2e, 0x93, 0xab, 0x68,
+	0xa6, 0xe0, 0x6d, 0x09, 0x79, 0xb2, 0xe8, 0xb7, 0x47, 0x68, 0xdc, 0x0e, 0xfb, 0x36, 0x72, 0x52,
+	0x25, 0xa6, 0x55, 0xe0, 0x65, 0xe3, 0x93, 0x0b, 0x4c, 0x0c, 0x88, 0x82, 0x53, 0x03, 0x3a, 0x8a,
+	0x99, 0xed, 0xab, 0xfb, 0x1b, 0xa3, 0xf6, 0x78, 0xfb, 0xe8, 0x89, 0xff, 0xf7, 0x7b, 0xfb, 0xe7,
+	0x4d, 0x7a, 0xea, 0xc2, 0xd3, 0x6e, 0xd5, 0xd0, 0xb5, 0xdb, 0x35, 0x6b, 0xa6, 0x26, 0x13, 0xdc,
+	0xd3, 0x4c, 0x14, 0x9c, 0xcd, 0x16, 0x11, 0xcc, 0x0b, 0x05, 0x5a, 0x33, 0x99, 0xeb, 0xfe, 0xe6,
+	0x08, 0x8d, 0x3b, 0xe1, 0x5e, 0xe3, 0xbd, 0xf8, 0x63, 0x1d, 0x3f, 0xfe, 0xf9, 0x69, 0x88, 0xde,
+	0xdf, 0xdf, 0x1c, 0xee, 0xd5, 0xfb, 0x30, 0xb7, 0x1b, 0xe1, 0x66, 0x73, 0x00, 0x78, 0x67, 0xbd,
+	0x02, 0xd9, 0xc7, 0x1d, 0xb3, 0x28, 0x20, 0x2a, 0x15, 0xb7, 0x73, 0xea, 0x86, 0xff, 0x57, 0xfa,
+	0x95, 0xe2, 0xa4, 0x87, 0x37, 0x67, 0x0c, 0x78, 0x6a, 0x5f, 0xba, 0x1b, 0x3a, 0x41, 0x06, 0xb8,
+	0xa3, 0x40, 0x4b, 0x7e, 0x0d, 0xca, 0xbe, 0x50, 0x37, 0xfc, 0xad, 0x8f, 0x37, 0xaa, 0xeb, 0xa7,
+	0x17, 0xb7, 0x4b, 0x0f, 0xdd, 0x2d, 0x3d, 0xf4, 0x63, 0xe9, 0xa1, 0x0f, 0x2b, 0xaf, 0x75, 0xb7,
+	0xf2, 0x5a, 0x5f, 0x57, 0x5e, 0xeb, 0xcd, 0x69, 0xc6, 0xcc, 0x65, 0x19, 0xfb, 0x89, 0x14, 0x81,
+	0x2b, 0xf8, 0xd4, 0x8e, 0x2d, 0x91, 0xbc, 0xd6, 0x6b, 0xb2, 0xee, 0x5f, 0x35, 0xd2, 0xcd, 0x5e,
+	0xc7, 0x5b, 0x36, 0xf4, 0xec, 0xd7, 0x00, 0x19, 0xf9, 0x7a, 0x6e, 0xf4, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -149,6 +246,47 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.PruneCheckBlockFrequency != that1.PruneCheckBlockFrequency {
 		return false
 	}
+	if len(this.TemplatesBindings) != len(that1.TemplatesBindings) {
+		return false
+	}
+	for i := range this.TemplatesBindings {
+		if !this.TemplatesBindings[i].Equal(&that1.TemplatesBindings[i]) {
+			return false
+		}
+	}
+	if this.SimplifyExpressions != that1.SimplifyExpressions {
+		return false
+	}
+	return true
+}
+func (this *TemplatesBinding) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TemplatesBinding)
+	if !ok {
+		that2, ok := that.(TemplatesBinding)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TypeUrl != that1.TypeUrl {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Resolver != that1.Resolver {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -171,6 +309,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SimplifyExpressions {
+		i--
+		if m.SimplifyExpressions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TemplatesBindings) > 0 {
+		for iNdEx := len(m.TemplatesBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TemplatesBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.PruneCheckBlockFrequency != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.PruneCheckBlockFrequency))
 		i--
@@ -195,6 +357,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TemplatesBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplatesBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplatesBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resolver) > 0 {
+		i -= len(m.Resolver)
+		copy(dAtA[i:], m.Resolver)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Resolver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -219,6 +425,36 @@ func (m *Params) Size() (n int) {
 	if m.PruneCheckBlockFrequency != 0 {
 		n += 1 + sovParams(uint64(m.PruneCheckBlockFrequency))
 	}
+	if len(m.TemplatesBindings) > 0 {
+		for _, e := range m.TemplatesBindings {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SimplifyExpressions {
+		n += 2
+	}
+	return n
+}
+
+func (m *TemplatesBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Resolver)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -342,6 +578,206 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatesBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplatesBindings = append(m.TemplatesBindings, TemplatesBinding{})
+			if err := m.TemplatesBindings[len(m.TemplatesBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimplifyExpressions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SimplifyExpressions = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplatesBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplatesBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplatesBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -12,19 +12,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -117,6 +121,17 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 type QueryActionsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status filters the actions by status, if set.
+	Status ActionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=warden.act.v1beta1.ActionStatus" json:"status,omitempty"`
+	// creator filters the actions by creator, if set.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// msg_type_url filters the actions by the type URL of their message, if
+	// set, e.g. "/warden.warden.v1beta3.MsgAddSpaceOwner".
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// updated_after filters the actions updated at or after this time, if set.
+	UpdatedAfter *time.Time `protobuf:"bytes,5,opt,name=updated_after,json=updatedAfter,proto3,stdtime" json:"updated_after,omitempty"`
+	// updated_before filters the actions updated before this time, if set.
+	UpdatedBefore *time.Time `protobuf:"bytes,6,opt,name=updated_before,json=updatedBefore,proto3,stdtime" json:"updated_before,omitempty"`
 }
 
 func (m *QueryActionsRequest) Reset()         { *m = QueryActionsRequest{} }
@@ -159,6 +174,41 @@ func (m *QueryActionsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryActionsRequest) GetStatus() ActionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ActionStatus_ACTION_STATUS_UNSPECIFIED
+}
+
+func (m *QueryActionsRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryActionsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryActionsRequest) GetUpdatedAfter() *time.Time {
+	if m != nil {
+		return m.UpdatedAfter
+	}
+	return nil
+}
+
+func (m *QueryActionsRequest) GetUpdatedBefore() *time.Time {
+	if m != nil {
+		return m.UpdatedBefore
+	}
+	return nil
+}
+
 type QueryActionsResponse struct {
 	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	Actions    []Action            `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
@@ -413,6 +463,8 @@ func (m *QuerySimulateTemplateResponse) GetEvaluation() string {
 
 type QueryTemplateByIdRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version of the template, the latest one if 0.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *QueryTemplateByIdRequest) Reset()         { *m = QueryTemplateByIdRequest{} }
@@ -455,6 +507,13 @@ func (m *QueryTemplateByIdRequest) GetId() uint64 {
 	return 0
 }
 
+func (m *QueryTemplateByIdRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type QueryTemplateByIdResponse struct {
 	Template *Template `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 }
@@ -499,6 +558,310 @@ func (m *QueryTemplateByIdResponse) GetTemplate() *Template {
 	return nil
 }
 
+type QueryTemplateVersionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id         uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTemplateVersionsRequest) Reset()         { *m = QueryTemplateVersionsRequest{} }
+func (m *QueryTemplateVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplateVersionsRequest) ProtoMessage()    {}
+func (*QueryTemplateVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{10}
+}
+func (m *QueryTemplateVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplateVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplateVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplateVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplateVersionsRequest.Merge(m, src)
+}
+func (m *QueryTemplateVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplateVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplateVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplateVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryTemplateVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryTemplateVersionsRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryVoteDelegationsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Delegator  string             `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Delegate   string             `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *QueryVoteDelegationsRequest) Reset()         { *m = QueryVoteDelegationsRequest{} }
+func (m *QueryVoteDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{11}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegationsRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *QueryVoteDelegationsRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+type QueryVoteDelegationsResponse struct {
+	Pagination  *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Delegations []VoteDelegation    `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations"`
+}
+
+func (m *QueryVoteDelegationsResponse) Reset()         { *m = QueryVoteDelegationsResponse{} }
+func (m *QueryVoteDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{12}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegationsResponse) GetDelegations() []VoteDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+type QueryContractTemplatesRequest struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryContractTemplatesRequest) Reset()         { *m = QueryContractTemplatesRequest{} }
+func (m *QueryContractTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTemplatesRequest) ProtoMessage()    {}
+func (*QueryContractTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{13}
+}
+func (m *QueryContractTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTemplatesRequest.Merge(m, src)
+}
+func (m *QueryContractTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTemplatesRequest proto.InternalMessageInfo
+
+func (m *QueryContractTemplatesRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+type QueryContractTemplatesResponse struct {
+	ContractTemplates ContractTemplates `protobuf:"bytes,1,opt,name=contract_templates,json=contractTemplates,proto3" json:"contract_templates"`
+}
+
+func (m *QueryContractTemplatesResponse) Reset()         { *m = QueryContractTemplatesResponse{} }
+func (m *QueryContractTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTemplatesResponse) ProtoMessage()    {}
+func (*QueryContractTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{14}
+}
+func (m *QueryContractTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTemplatesResponse.Merge(m, src)
+}
+func (m *QueryContractTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTemplatesResponse proto.InternalMessageInfo
+
+func (m *QueryContractTemplatesResponse) GetContractTemplates() ContractTemplates {
+	if m != nil {
+		return m.ContractTemplates
+	}
+	return ContractTemplates{}
+}
+
+type QueryTemplateVersionsResponse struct {
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Templates  []Template          `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates"`
+}
+
+func (m *QueryTemplateVersionsResponse) Reset()         { *m = QueryTemplateVersionsResponse{} }
+func (m *QueryTemplateVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTemplateVersionsResponse) ProtoMessage()    {}
+func (*QueryTemplateVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{15}
+}
+func (m *QueryTemplateVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTemplateVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTemplateVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTemplateVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTemplateVersionsResponse.Merge(m, src)
+}
+func (m *QueryTemplateVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTemplateVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTemplateVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTemplateVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryTemplateVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryTemplateVersionsResponse) GetTemplates() []Template {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
 type QueryActionsByAddressRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	Address    string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -509,7 +872,7 @@ func (m *QueryActionsByAddressRequest) Reset()         { *m = QueryActionsByAddr
 func (m *QueryActionsByAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActionsByAddressRequest) ProtoMessage()    {}
 func (*QueryActionsByAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7ace4ffc8dacc6b, []int{10}
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{16}
 }
 func (m *QueryActionsByAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -568,7 +931,7 @@ func (m *QueryActionsByAddressResponse) Reset()         { *m = QueryActionsByAdd
 func (m *QueryActionsByAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActionsByAddressResponse) ProtoMessage()    {}
 func (*QueryActionsByAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7ace4ffc8dacc6b, []int{11}
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{17}
 }
 func (m *QueryActionsByAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +982,7 @@ func (m *QueryActionByIdRequest) Reset()         { *m = QueryActionByIdRequest{}
 func (m *QueryActionByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActionByIdRequest) ProtoMessage()    {}
 func (*QueryActionByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7ace4ffc8dacc6b, []int{12}
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{18}
 }
 func (m *QueryActionByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +1026,7 @@ func (m *QueryActionByIdResponse) Reset()         { *m = QueryActionByIdResponse
 func (m *QueryActionByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActionByIdResponse) ProtoMessage()    {}
 func (*QueryActionByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7ace4ffc8dacc6b, []int{13}
+	return fileDescriptor_f7ace4ffc8dacc6b, []int{19}
 }
 func (m *QueryActionByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -710,6 +1073,12 @@ func init() {
 	proto.RegisterType((*QuerySimulateTemplateResponse)(nil), "warden.act.v1beta1.QuerySimulateTemplateResponse")
 	proto.RegisterType((*QueryTemplateByIdRequest)(nil), "warden.act.v1beta1.QueryTemplateByIdRequest")
 	proto.RegisterType((*QueryTemplateByIdResponse)(nil), "warden.act.v1beta1.QueryTemplateByIdResponse")
+	proto.RegisterType((*QueryTemplateVersionsRequest)(nil), "warden.act.v1beta1.QueryTemplateVersionsRequest")
+	proto.RegisterType((*QueryVoteDelegationsRequest)(nil), "warden.act.v1beta1.QueryVoteDelegationsRequest")
+	proto.RegisterType((*QueryVoteDelegationsResponse)(nil), "warden.act.v1beta1.QueryVoteDelegationsResponse")
+	proto.RegisterType((*QueryContractTemplatesRequest)(nil), "warden.act.v1beta1.QueryContractTemplatesRequest")
+	proto.RegisterType((*QueryContractTemplatesResponse)(nil), "warden.act.v1beta1.QueryContractTemplatesResponse")
+	proto.RegisterType((*QueryTemplateVersionsResponse)(nil), "warden.act.v1beta1.QueryTemplateVersionsResponse")
 	proto.RegisterType((*QueryActionsByAddressRequest)(nil), "warden.act.v1beta1.QueryActionsByAddressRequest")
 	proto.RegisterType((*QueryActionsByAddressResponse)(nil), "warden.act.v1beta1.QueryActionsByAddressResponse")
 	proto.RegisterType((*QueryActionByIdRequest)(nil), "warden.act.v1beta1.QueryActionByIdRequest")
@@ -719,61 +1088,85 @@ func init() {
 func init() { proto.RegisterFile("warden/act/v1beta1/query.proto", fileDescriptor_f7ace4ffc8dacc6b) }
 
 var fileDescriptor_f7ace4ffc8dacc6b = []byte{
-	// 854 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6b, 0x1b, 0x47,
-	0x14, 0xc7, 0x35, 0xb2, 0x2b, 0x59, 0xaf, 0xc5, 0xb8, 0x53, 0xb7, 0x95, 0x17, 0x77, 0x2d, 0xaf,
-	0x5d, 0x5b, 0x92, 0xed, 0xdd, 0x4a, 0xa5, 0x50, 0x7a, 0x68, 0x6b, 0x51, 0x5a, 0x7a, 0x28, 0x6d,
-	0xd7, 0xed, 0xa5, 0xd0, 0x9a, 0x91, 0x76, 0xac, 0x2e, 0x48, 0xbb, 0xb2, 0x76, 0xe5, 0x56, 0xa7,
-	0x40, 0x08, 0x21, 0x47, 0x43, 0x20, 0x97, 0x40, 0x2e, 0xf1, 0x39, 0x7f, 0x42, 0xc8, 0xd1, 0x47,
-	0x43, 0x2e, 0x39, 0x85, 0x60, 0xe7, 0x0f, 0x09, 0x9a, 0x79, 0x2b, 0xad, 0x7e, 0xec, 0x4a, 0x01,
-	0x1d, 0x72, 0x31, 0xd2, 0xcc, 0xf7, 0xbd, 0xf7, 0x79, 0xdf, 0x99, 0x79, 0x32, 0xa8, 0xff, 0xb1,
-	0xb6, 0xc5, 0x1d, 0x83, 0xd5, 0x7c, 0xe3, 0xac, 0x54, 0xe5, 0x3e, 0x2b, 0x19, 0xa7, 0x1d, 0xde,
-	0xee, 0xea, 0xad, 0xb6, 0xeb, 0xbb, 0x94, 0xca, 0x7d, 0x9d, 0xd5, 0x7c, 0x1d, 0xf7, 0x95, 0x0f,
-	0x59, 0xd3, 0x76, 0x5c, 0x43, 0xfc, 0x95, 0x32, 0x65, 0xb5, 0xee, 0xd6, 0x5d, 0xf1, 0xd1, 0xe8,
-	0x7d, 0xc2, 0xd5, 0xf5, 0xba, 0xeb, 0xd6, 0x1b, 0xdc, 0x60, 0x2d, 0xdb, 0x60, 0x8e, 0xe3, 0xfa,
-	0xcc, 0xb7, 0x5d, 0xc7, 0xc3, 0xdd, 0x35, 0xdc, 0x15, 0xdf, 0xaa, 0x9d, 0x13, 0x83, 0x39, 0x58,
-	0x55, 0x29, 0xd6, 0x5c, 0xaf, 0xe9, 0x7a, 0x46, 0x95, 0x79, 0x5c, 0xe2, 0xf4, 0xe1, 0x5a, 0xac,
-	0x6e, 0x3b, 0x22, 0x0f, 0x6a, 0x37, 0x26, 0x74, 0xd0, 0x62, 0x6d, 0xd6, 0xf4, 0x62, 0x04, 0xac,
-	0x16, 0xca, 0xb0, 0x39, 0x41, 0xe0, 0xf3, 0x66, 0xab, 0xc1, 0x7c, 0x2e, 0x25, 0xda, 0x2a, 0xd0,
-	0xdf, 0x7b, 0x18, 0xbf, 0x89, 0xc4, 0x26, 0x3f, 0xed, 0x70, 0xcf, 0xd7, 0x7e, 0x85, 0x8f, 0x86,
-	0x56, 0xbd, 0x96, 0xeb, 0x78, 0x9c, 0x7e, 0x0d, 0x29, 0x09, 0x90, 0x25, 0x39, 0x92, 0x7f, 0xbf,
-	0xac, 0xe8, 0xe3, 0x26, 0xea, 0x32, 0xa6, 0xb2, 0x78, 0xf9, 0x72, 0x23, 0x61, 0xa2, 0x5e, 0xfb,
-	0x1b, 0x13, 0x1e, 0x0a, 0xbc, 0xa0, 0x0e, 0xfd, 0x11, 0x60, 0xd0, 0x36, 0x26, 0xdd, 0xd1, 0xa5,
-	0x47, 0x7a, 0xcf, 0x23, 0x5d, 0x1e, 0xd9, 0x20, 0x77, 0x9d, 0x63, 0xac, 0x19, 0x8a, 0xd4, 0x1e,
-	0x12, 0x58, 0x1d, 0xce, 0x8f, 0xc4, 0x3f, 0x4d, 0x28, 0xb0, 0x3b, 0xb5, 0x80, 0x0c, 0x0e, 0x57,
-	0xa0, 0xdf, 0x40, 0x5a, 0x5a, 0xeb, 0x65, 0x93, 0xb9, 0x85, 0xa8, 0xde, 0x65, 0x79, 0xec, 0x3d,
-	0x08, 0xd0, 0x6e, 0xc1, 0xc7, 0x02, 0xee, 0x0f, 0xb4, 0x7e, 0xde, 0xed, 0x53, 0x15, 0xd2, 0xb5,
-	0x36, 0x67, 0xbe, 0xdb, 0xce, 0x26, 0x73, 0x24, 0x9f, 0x11, 0x00, 0xc4, 0x0c, 0x16, 0xb5, 0xc7,
-	0x04, 0x3e, 0x19, 0x25, 0x98, 0xb7, 0x41, 0xdf, 0x43, 0x26, 0xb8, 0x5a, 0x81, 0x45, 0xeb, 0x93,
-	0x2c, 0x0a, 0x10, 0xd0, 0xa4, 0x41, 0x90, 0x76, 0x97, 0xc0, 0xba, 0xa0, 0x3c, 0xb2, 0x9b, 0x9d,
-	0xde, 0x52, 0x20, 0x9d, 0xbf, 0x5d, 0x60, 0xf1, 0x13, 0xdb, 0xb1, 0x45, 0x1e, 0xe1, 0x98, 0x19,
-	0x5a, 0xd1, 0xbe, 0x83, 0xcf, 0x22, 0x38, 0xd0, 0x34, 0x15, 0x80, 0x9f, 0xb1, 0x46, 0x67, 0x00,
-	0x92, 0x31, 0x43, 0x2b, 0x5a, 0x11, 0xb2, 0x43, 0x76, 0x57, 0xba, 0x3f, 0x5b, 0x41, 0x13, 0xcb,
-	0x90, 0xb4, 0x2d, 0x11, 0xb3, 0x68, 0x26, 0x6d, 0x4b, 0xfb, 0x13, 0xd6, 0x26, 0x68, 0xfb, 0x0f,
-	0x6e, 0x29, 0xf0, 0x07, 0xfb, 0x8d, 0xf5, 0xd4, 0xec, 0xab, 0xb5, 0x67, 0x81, 0x99, 0xf8, 0x22,
-	0x2a, 0xdd, 0x43, 0xcb, 0x6a, 0x73, 0x6f, 0xee, 0x77, 0x2f, 0x0b, 0x69, 0x26, 0x33, 0xa3, 0x93,
-	0xc1, 0x57, 0xfa, 0x2d, 0xa4, 0x3c, 0x9f, 0xf9, 0x1d, 0x2f, 0xbb, 0x90, 0x23, 0xf9, 0xe5, 0x72,
-	0x2e, 0xfa, 0xc5, 0x1c, 0x09, 0x1d, 0x5e, 0x5b, 0x8c, 0xd2, 0x2e, 0x08, 0x9e, 0xc3, 0x78, 0x0b,
-	0xef, 0xd2, 0xeb, 0xce, 0xe3, 0xdb, 0xc2, 0xdd, 0x98, 0xa3, 0xfe, 0x05, 0x3e, 0x1d, 0x53, 0x62,
-	0x27, 0x65, 0x48, 0xc9, 0x7c, 0x71, 0x93, 0x55, 0xc6, 0x99, 0xa8, 0x2c, 0x3f, 0x5d, 0x82, 0xf7,
-	0x44, 0x3e, 0x7a, 0x87, 0x40, 0x4a, 0x8e, 0x5d, 0xba, 0x33, 0x29, 0x70, 0x7c, 0xc2, 0x2b, 0xbb,
-	0x53, 0x75, 0x92, 0x4c, 0x2b, 0xdc, 0x7e, 0xfe, 0xfa, 0x7e, 0x72, 0x8b, 0x6e, 0x1a, 0x32, 0x40,
-	0xfc, 0x6c, 0xd4, 0xdc, 0x86, 0x11, 0xfa, 0x6d, 0x91, 0x43, 0x9e, 0xde, 0x23, 0x90, 0xc6, 0xb3,
-	0xa2, 0xd1, 0xf9, 0x87, 0x7f, 0x02, 0x94, 0xfc, 0x74, 0x21, 0x92, 0x14, 0x05, 0xc9, 0x36, 0xd5,
-	0x62, 0x48, 0xf0, 0x50, 0xe8, 0x39, 0x81, 0x4c, 0x7f, 0xd8, 0xd1, 0x42, 0x64, 0x8d, 0xd1, 0x91,
-	0xac, 0x14, 0x67, 0x91, 0x22, 0xd0, 0xbe, 0x00, 0xda, 0xa1, 0xdb, 0x31, 0x40, 0xfd, 0xf1, 0x46,
-	0x2f, 0x08, 0xac, 0x8c, 0x4e, 0x14, 0xfa, 0x45, 0x64, 0xb9, 0x88, 0x21, 0xa8, 0x94, 0xde, 0x22,
-	0x02, 0x39, 0xf7, 0x04, 0xe7, 0xe7, 0x74, 0x2b, 0x86, 0xd3, 0xc3, 0x60, 0xfa, 0x88, 0xc0, 0x07,
-	0xe1, 0x59, 0x44, 0xf7, 0xa7, 0x3a, 0x12, 0xba, 0xf3, 0xca, 0xc1, 0x8c, 0x6a, 0x44, 0x2b, 0x09,
-	0xb4, 0x3d, 0x5a, 0x98, 0xc1, 0xc2, 0xe3, 0x6a, 0xf7, 0xd8, 0xb6, 0xe8, 0x13, 0x02, 0x2b, 0xa3,
-	0x13, 0x21, 0xc6, 0xc7, 0x88, 0xf9, 0x17, 0xe3, 0x63, 0xd4, 0xb8, 0xd1, 0xbe, 0x12, 0xb0, 0x06,
-	0x3d, 0x98, 0x7e, 0x01, 0x7b, 0xac, 0xc1, 0x1c, 0x7c, 0x40, 0x00, 0x06, 0x4f, 0x9e, 0x16, 0xa7,
-	0x14, 0x0e, 0xbb, 0xb9, 0x37, 0x93, 0x16, 0xf1, 0x0c, 0x81, 0x57, 0xa0, 0xbb, 0x53, 0xf1, 0xa4,
-	0x93, 0x95, 0x7f, 0x2e, 0xaf, 0x55, 0x72, 0x75, 0xad, 0x92, 0x57, 0xd7, 0x2a, 0x39, 0xbf, 0x51,
-	0x13, 0x57, 0x37, 0x6a, 0xe2, 0xc5, 0x8d, 0x9a, 0xf8, 0xeb, 0x87, 0xba, 0xed, 0xff, 0xdb, 0xa9,
-	0xea, 0x35, 0xb7, 0x89, 0xd1, 0x07, 0x23, 0xd9, 0x46, 0x93, 0xff, 0x2f, 0x8f, 0xaa, 0xdb, 0xe2,
-	0x5e, 0xf0, 0xaf, 0x66, 0x35, 0x25, 0x44, 0x5f, 0xbe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xb8,
-	0x8d, 0xac, 0x8b, 0x0b, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xc7, 0x33, 0x4e, 0x9a, 0xc4, 0x4f, 0xf3, 0xe6, 0x4d, 0x86, 0x00, 0xee, 0x12, 0x9c, 0x74,
+	0xdb, 0x26, 0x4e, 0xd2, 0x78, 0xeb, 0x44, 0x48, 0xa5, 0x48, 0xd0, 0x98, 0x40, 0x05, 0x12, 0x02,
+	0xb6, 0x69, 0x0f, 0x3d, 0x60, 0x8d, 0xbd, 0x13, 0xb3, 0x92, 0xed, 0x75, 0x77, 0xc7, 0x01, 0xab,
+	0x54, 0x48, 0x08, 0x21, 0x8e, 0x91, 0x90, 0xb8, 0x20, 0xc1, 0x81, 0x5e, 0xe0, 0xc0, 0x01, 0xae,
+	0x1c, 0x38, 0xf6, 0x58, 0x89, 0x0b, 0xa7, 0x82, 0x12, 0xfe, 0x10, 0xe4, 0x99, 0x67, 0xfc, 0x63,
+	0xbd, 0x6b, 0x3b, 0x92, 0x0f, 0xbd, 0x54, 0xde, 0x99, 0xe7, 0x79, 0xe6, 0x33, 0xdf, 0x79, 0x9e,
+	0x79, 0xa6, 0x81, 0xf4, 0x27, 0xcc, 0x77, 0x78, 0xcd, 0x62, 0x25, 0x61, 0x1d, 0xe5, 0x8a, 0x5c,
+	0xb0, 0x9c, 0x75, 0xbf, 0xc1, 0xfd, 0x66, 0xb6, 0xee, 0x7b, 0xc2, 0xa3, 0x54, 0xcd, 0x67, 0x59,
+	0x49, 0x64, 0x71, 0xde, 0x58, 0x64, 0x55, 0xb7, 0xe6, 0x59, 0xf2, 0x5f, 0x65, 0x66, 0x2c, 0x95,
+	0xbd, 0xb2, 0x27, 0x7f, 0x5a, 0xad, 0x5f, 0x38, 0xba, 0x5c, 0xf6, 0xbc, 0x72, 0x85, 0x5b, 0xac,
+	0xee, 0x5a, 0xac, 0x56, 0xf3, 0x04, 0x13, 0xae, 0x57, 0x0b, 0x70, 0xf6, 0x02, 0xce, 0xca, 0xaf,
+	0x62, 0xe3, 0xd0, 0x62, 0x35, 0x5c, 0xd5, 0x58, 0x09, 0x4f, 0x09, 0xb7, 0xca, 0x03, 0xc1, 0xaa,
+	0x75, 0x34, 0xd8, 0x2c, 0x79, 0x41, 0xd5, 0x0b, 0xac, 0x22, 0x0b, 0xb8, 0xe2, 0x6d, 0xd3, 0xd7,
+	0x59, 0xd9, 0xad, 0xc9, 0x85, 0x74, 0xb0, 0x88, 0x2d, 0xd6, 0x99, 0xcf, 0xaa, 0xc1, 0x00, 0x03,
+	0x56, 0xea, 0x8a, 0x70, 0x31, 0xc2, 0x40, 0xf0, 0x6a, 0xbd, 0xc2, 0x04, 0x47, 0x93, 0x4c, 0x84,
+	0xc9, 0x91, 0x27, 0x78, 0xc1, 0xe1, 0x15, 0x5e, 0xee, 0xc2, 0x31, 0x97, 0x80, 0x7e, 0xd8, 0x02,
+	0xfe, 0x40, 0x22, 0xd8, 0xfc, 0x7e, 0x83, 0x07, 0xc2, 0x7c, 0x1f, 0x9e, 0xeb, 0x19, 0x0d, 0xea,
+	0x5e, 0x2d, 0xe0, 0xf4, 0x3a, 0x4c, 0x2b, 0xd4, 0x14, 0x59, 0x25, 0x99, 0xf3, 0x3b, 0x46, 0xb6,
+	0xff, 0x3c, 0xb2, 0xca, 0x27, 0x3f, 0xf5, 0xf8, 0xe9, 0xca, 0x84, 0x8d, 0xf6, 0xe6, 0x69, 0x02,
+	0x23, 0xee, 0xc9, 0x9d, 0xe8, 0x85, 0xe8, 0xdb, 0x00, 0x1d, 0x85, 0x30, 0xea, 0x5a, 0x56, 0xc9,
+	0x99, 0x6d, 0xc9, 0x99, 0x55, 0xc7, 0xdf, 0x09, 0x5e, 0xe6, 0xe8, 0x6b, 0x77, 0x79, 0xb6, 0xc8,
+	0x02, 0xc1, 0x44, 0x23, 0x48, 0x25, 0x56, 0x49, 0x66, 0x7e, 0x67, 0x35, 0x8a, 0x4c, 0xad, 0x7d,
+	0x5b, 0xda, 0xd9, 0x68, 0x4f, 0x53, 0x30, 0x53, 0xf2, 0x39, 0x13, 0x9e, 0x9f, 0x9a, 0x5c, 0x25,
+	0x99, 0xa4, 0xad, 0x3f, 0xe9, 0x2a, 0xcc, 0x55, 0x83, 0x72, 0x41, 0x34, 0xeb, 0xbc, 0xd0, 0xf0,
+	0x2b, 0xa9, 0x29, 0x39, 0x0d, 0xd5, 0xa0, 0x7c, 0xd0, 0xac, 0xf3, 0x3b, 0x7e, 0x85, 0xbe, 0x05,
+	0xff, 0x6b, 0xd4, 0x1d, 0x26, 0xb8, 0x53, 0x60, 0x87, 0x82, 0xfb, 0xa9, 0x73, 0x28, 0x8b, 0x4a,
+	0x98, 0xac, 0x4e, 0x98, 0xec, 0x81, 0x4e, 0x98, 0xfc, 0xd4, 0xf1, 0xdf, 0x2b, 0xc4, 0x9e, 0x43,
+	0xb7, 0xbd, 0x96, 0x17, 0xbd, 0x05, 0xf3, 0x3a, 0x4c, 0x91, 0x1f, 0x7a, 0x3e, 0x4f, 0x4d, 0x8f,
+	0x18, 0x47, 0x2f, 0x9f, 0x97, 0x6e, 0xe6, 0x77, 0x04, 0x96, 0x7a, 0x55, 0xc6, 0x83, 0xbb, 0x15,
+	0x21, 0xf3, 0xfa, 0x50, 0x99, 0x95, 0x73, 0x8f, 0xce, 0x37, 0x60, 0x46, 0xe5, 0x62, 0x4b, 0xe8,
+	0xc9, 0xb8, 0x14, 0x50, 0xcb, 0x63, 0x0a, 0x68, 0x07, 0xf3, 0x73, 0x78, 0x5e, 0xc2, 0x1d, 0x60,
+	0xae, 0x8e, 0x3d, 0x09, 0xd2, 0x9d, 0xa3, 0x6c, 0x65, 0x41, 0x52, 0x02, 0x90, 0xf6, 0x81, 0x9a,
+	0x3f, 0x12, 0x78, 0x21, 0x4c, 0x30, 0x6e, 0x81, 0x6e, 0x42, 0x52, 0xd7, 0xa2, 0x96, 0x68, 0x39,
+	0x4a, 0x22, 0x8d, 0x80, 0x22, 0x75, 0x9c, 0xcc, 0xaf, 0x08, 0x2c, 0x4b, 0xca, 0xdb, 0x6e, 0xb5,
+	0xd1, 0x1a, 0xd2, 0xa6, 0xe3, 0x97, 0x0b, 0x1c, 0x7e, 0xe8, 0xd6, 0x5c, 0x19, 0x27, 0xa1, 0xb2,
+	0xbb, 0x33, 0x62, 0xbe, 0x01, 0x2f, 0xc7, 0x70, 0xa0, 0x68, 0x69, 0x00, 0x7e, 0xc4, 0x2a, 0x8d,
+	0x0e, 0x48, 0xd2, 0xee, 0x1a, 0x31, 0xf7, 0x21, 0xd5, 0x23, 0x77, 0xbe, 0xf9, 0x8e, 0xa3, 0x37,
+	0x31, 0x0f, 0x09, 0xd7, 0x91, 0x3e, 0x53, 0x76, 0xc2, 0x75, 0x5a, 0x65, 0x78, 0xc4, 0xfd, 0x40,
+	0x93, 0x4c, 0xd9, 0xfa, 0xd3, 0xbc, 0x03, 0x17, 0x22, 0xa2, 0xb4, 0x6f, 0xa4, 0x59, 0xad, 0x1c,
+	0x2a, 0x31, 0x50, 0x6d, 0xbb, 0x6d, 0x6d, 0x1e, 0xa1, 0xca, 0x7a, 0xea, 0xae, 0x5a, 0x6e, 0xec,
+	0x49, 0xa9, 0x36, 0x9a, 0xd0, 0x1b, 0x35, 0x7f, 0x20, 0xf0, 0x92, 0x5c, 0xf8, 0xae, 0x27, 0xf8,
+	0x7e, 0xfb, 0x3a, 0x1e, 0xfb, 0xba, 0xcb, 0x90, 0xc4, 0xcb, 0x5e, 0x97, 0x83, 0xdd, 0x19, 0xa0,
+	0x06, 0xcc, 0xe2, 0x07, 0xc7, 0x6b, 0xaf, 0xfd, 0x6d, 0xfe, 0xa6, 0x13, 0xb0, 0x8f, 0x70, 0xdc,
+	0xc5, 0xf2, 0x2e, 0x9c, 0xef, 0x34, 0x24, 0x5d, 0x2e, 0x66, 0xd4, 0x01, 0xf6, 0xa2, 0x60, 0xd1,
+	0x74, 0x3b, 0x9b, 0xaf, 0x61, 0xb6, 0xbe, 0xe9, 0xd5, 0x84, 0xcf, 0x4a, 0xa2, 0xef, 0x96, 0x31,
+	0x60, 0xb6, 0x84, 0x73, 0x98, 0xab, 0xed, 0x6f, 0xf3, 0x33, 0x48, 0xc7, 0x39, 0xe3, 0x9e, 0xef,
+	0x01, 0xd5, 0xd6, 0x85, 0x4e, 0x81, 0xab, 0xbd, 0x5f, 0x89, 0x22, 0xee, 0x0b, 0x85, 0xd0, 0x8b,
+	0xa5, 0xf0, 0x84, 0xf9, 0x33, 0x41, 0xf6, 0xfe, 0x5c, 0x7c, 0xf6, 0xae, 0xa7, 0x3f, 0x74, 0x76,
+	0x60, 0x8f, 0xc9, 0x37, 0xf7, 0x1c, 0xc7, 0xe7, 0xc1, 0xd8, 0x13, 0x38, 0x05, 0x33, 0x4c, 0x45,
+	0xc6, 0xf4, 0xd5, 0x9f, 0xf4, 0xf5, 0x76, 0xb3, 0x9f, 0x1c, 0xad, 0xd9, 0x63, 0x23, 0x40, 0x2f,
+	0xf3, 0x91, 0xd6, 0xbb, 0x7f, 0x0b, 0xcf, 0x52, 0xbf, 0xcc, 0x60, 0xb7, 0xc2, 0xd9, 0xf8, 0xcb,
+	0xd3, 0x7c, 0x0f, 0x5e, 0xec, 0xb3, 0xc4, 0x9d, 0xec, 0xc0, 0xb4, 0x8a, 0x37, 0xe8, 0xc9, 0xa6,
+	0xfc, 0x6c, 0xb4, 0xdc, 0x79, 0x3a, 0x07, 0xe7, 0x64, 0x3c, 0xfa, 0x25, 0x81, 0x69, 0xf5, 0x9e,
+	0xa3, 0x6b, 0x51, 0x8e, 0xfd, 0x4f, 0x47, 0x63, 0x7d, 0xa8, 0x9d, 0x22, 0x33, 0x37, 0xbe, 0xf8,
+	0xf3, 0xdf, 0x6f, 0x12, 0x97, 0xe8, 0x45, 0x4b, 0x39, 0xc8, 0x47, 0x4e, 0xc9, 0xab, 0x58, 0x5d,
+	0x6f, 0x57, 0xf5, 0x7a, 0xa4, 0x5f, 0x13, 0x98, 0xc1, 0xb3, 0xa2, 0xf1, 0xf1, 0x7b, 0x9f, 0x96,
+	0x46, 0x66, 0xb8, 0x21, 0x92, 0x6c, 0x4a, 0x92, 0xcb, 0xd4, 0x1c, 0x40, 0x82, 0x87, 0x42, 0x8f,
+	0x09, 0x24, 0xdb, 0x95, 0x4b, 0x37, 0x62, 0xd7, 0x08, 0x5f, 0x3f, 0xc6, 0xe6, 0x28, 0xa6, 0x08,
+	0x74, 0x55, 0x02, 0xad, 0xd1, 0xcb, 0x03, 0x80, 0xda, 0x15, 0x49, 0x1f, 0x11, 0x58, 0x08, 0xf7,
+	0x68, 0x7a, 0x2d, 0x76, 0xb9, 0x98, 0x67, 0x85, 0x91, 0x3b, 0x83, 0x07, 0x72, 0x6e, 0x49, 0xce,
+	0x2b, 0xf4, 0xd2, 0x00, 0xce, 0x00, 0x9d, 0xe9, 0xf7, 0x04, 0xe6, 0xba, 0x7b, 0x38, 0xbd, 0x3a,
+	0x54, 0x91, 0xae, 0x9c, 0x37, 0xb6, 0x47, 0xb4, 0x46, 0xb4, 0x9c, 0x44, 0xdb, 0xa2, 0x1b, 0x23,
+	0x48, 0x58, 0x28, 0x36, 0x0b, 0xae, 0x43, 0x7f, 0x25, 0xb0, 0x10, 0xbe, 0x81, 0x07, 0xe8, 0x18,
+	0xf3, 0x70, 0x30, 0x72, 0x67, 0xf0, 0x40, 0xd8, 0x57, 0x25, 0xec, 0x2e, 0xcd, 0x8d, 0x02, 0x8b,
+	0xef, 0xa2, 0xc0, 0x7a, 0xe0, 0x3a, 0x0f, 0xe9, 0x4f, 0x04, 0xfe, 0x1f, 0xea, 0xd3, 0xd4, 0x8a,
+	0x25, 0x88, 0x7e, 0x73, 0x18, 0xd7, 0x46, 0x77, 0x40, 0xe2, 0x5d, 0x49, 0xbc, 0x4d, 0xb7, 0x06,
+	0x10, 0x87, 0xfe, 0xc3, 0x19, 0xd0, 0xdf, 0x09, 0x2c, 0xf6, 0xb5, 0x45, 0x1a, 0xaf, 0x57, 0x5c,
+	0x2b, 0x37, 0x76, 0xce, 0xe2, 0x82, 0xc4, 0x37, 0x25, 0xf1, 0x0d, 0x7a, 0x7d, 0x00, 0x71, 0x7f,
+	0x87, 0xb7, 0x1e, 0xe8, 0xb1, 0x87, 0xf4, 0x17, 0x02, 0x0b, 0xe1, 0x8e, 0x31, 0x20, 0x3f, 0x62,
+	0xfa, 0xa3, 0x91, 0x3b, 0x83, 0x07, 0xb2, 0xbf, 0x22, 0xd9, 0x2d, 0xba, 0x3d, 0xfc, 0x82, 0x6a,
+	0xe5, 0xb2, 0xee, 0x93, 0xdf, 0x12, 0x80, 0x4e, 0x4b, 0xa0, 0x9b, 0x43, 0x16, 0xee, 0xae, 0xb6,
+	0xad, 0x91, 0x6c, 0x11, 0xcf, 0x92, 0x78, 0x1b, 0x74, 0x7d, 0x28, 0x9e, 0xaa, 0xb4, 0xfc, 0x47,
+	0x8f, 0x4f, 0xd2, 0xe4, 0xc9, 0x49, 0x9a, 0xfc, 0x73, 0x92, 0x26, 0xc7, 0xa7, 0xe9, 0x89, 0x27,
+	0xa7, 0xe9, 0x89, 0xbf, 0x4e, 0xd3, 0x13, 0xf7, 0xf6, 0xcb, 0xae, 0xf8, 0xb8, 0x51, 0xcc, 0x96,
+	0xbc, 0x2a, 0x7a, 0x6f, 0x87, 0xa2, 0x85, 0x83, 0x7f, 0xaa, 0xaa, 0xa3, 0x59, 0xe7, 0x81, 0xfe,
+	0x53, 0x47, 0x71, 0x5a, 0x1a, 0xed, 0xfe, 0x37, 0x00, 0x40, 0x09, 0x15, 0x25, 0x4f, 0x12, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -798,6 +1191,13 @@ type QueryClient interface {
 	SimulateTemplate(ctx context.Context, in *QuerySimulateTemplateRequest, opts ...grpc.CallOption) (*QuerySimulateTemplateResponse, error)
 	// Queries a list of TemplateById items.
 	TemplateById(ctx context.Context, in *QueryTemplateByIdRequest, opts ...grpc.CallOption) (*QueryTemplateByIdResponse, error)
+	// Queries the versions of a Template, oldest first.
+	TemplateVersions(ctx context.Context, in *QueryTemplateVersionsRequest, opts ...grpc.CallOption) (*QueryTemplateVersionsResponse, error)
+	// Queries the active vote delegations, optionally filtered by delegator or
+	// delegate.
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
+	// Queries the templates of the Actions executing a CosmWasm contract.
+	ContractTemplates(ctx context.Context, in *QueryContractTemplatesRequest, opts ...grpc.CallOption) (*QueryContractTemplatesResponse, error)
 	// Queries a list of Actions items by one participant address.
 	ActionsByAddress(ctx context.Context, in *QueryActionsByAddressRequest, opts ...grpc.CallOption) (*QueryActionsByAddressResponse, error)
 	ActionById(ctx context.Context, in *QueryActionByIdRequest, opts ...grpc.CallOption) (*QueryActionByIdResponse, error)
@@ -856,6 +1256,33 @@ func (c *queryClient) TemplateById(ctx context.Context, in *QueryTemplateByIdReq
 	return out, nil
 }
 
+func (c *queryClient) TemplateVersions(ctx context.Context, in *QueryTemplateVersionsRequest, opts ...grpc.CallOption) (*QueryTemplateVersionsResponse, error) {
+	out := new(QueryTemplateVersionsResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Query/TemplateVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Query/VoteDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractTemplates(ctx context.Context, in *QueryContractTemplatesRequest, opts ...grpc.CallOption) (*QueryContractTemplatesResponse, error) {
+	out := new(QueryContractTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Query/ContractTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ActionsByAddress(ctx context.Context, in *QueryActionsByAddressRequest, opts ...grpc.CallOption) (*QueryActionsByAddressResponse, error) {
 	out := new(QueryActionsByAddressResponse)
 	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Query/ActionsByAddress", in, out, opts...)
@@ -886,6 +1313,13 @@ type QueryServer interface {
 	SimulateTemplate(context.Context, *QuerySimulateTemplateRequest) (*QuerySimulateTemplateResponse, error)
 	// Queries a list of TemplateById items.
 	TemplateById(context.Context, *QueryTemplateByIdRequest) (*QueryTemplateByIdResponse, error)
+	// Queries the versions of a Template, oldest first.
+	TemplateVersions(context.Context, *QueryTemplateVersionsRequest) (*QueryTemplateVersionsResponse, error)
+	// Queries the active vote delegations, optionally filtered by delegator or
+	// delegate.
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
+	// Queries the templates of the Actions executing a CosmWasm contract.
+	ContractTemplates(context.Context, *QueryContractTemplatesRequest) (*QueryContractTemplatesResponse, error)
 	// Queries a list of Actions items by one participant address.
 	ActionsByAddress(context.Context, *QueryActionsByAddressRequest) (*QueryActionsByAddressResponse, error)
 	ActionById(context.Context, *QueryActionByIdRequest) (*QueryActionByIdResponse, error)
@@ -910,6 +1344,15 @@ func (*UnimplementedQueryServer) SimulateTemplate(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) TemplateById(ctx context.Context, req *QueryTemplateByIdRequest) (*QueryTemplateByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemplateById not implemented")
 }
+func (*UnimplementedQueryServer) TemplateVersions(ctx context.Context, req *QueryTemplateVersionsRequest) (*QueryTemplateVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateVersions not implemented")
+}
+func (*UnimplementedQueryServer) VoteDelegations(ctx context.Context, req *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ContractTemplates(ctx context.Context, req *QueryContractTemplatesRequest) (*QueryContractTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractTemplates not implemented")
+}
 func (*UnimplementedQueryServer) ActionsByAddress(ctx context.Context, req *QueryActionsByAddressRequest) (*QueryActionsByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActionsByAddress not implemented")
 }
@@ -1011,20 +1454,74 @@ func _Query_TemplateById_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ActionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActionsByAddressRequest)
+func _Query_TemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTemplateVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ActionsByAddress(ctx, in)
+		return srv.(QueryServer).TemplateVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Query/ActionsByAddress",
+		FullMethod: "/warden.act.v1beta1.Query/TemplateVersions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActionsByAddress(ctx, req.(*QueryActionsByAddressRequest))
+		return srv.(QueryServer).TemplateVersions(ctx, req.(*QueryTemplateVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Query/VoteDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Query/ContractTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractTemplates(ctx, req.(*QueryContractTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Query/ActionsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionsByAddress(ctx, req.(*QueryActionsByAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1072,6 +1569,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateById",
 			Handler:    _Query_TemplateById_Handler,
 		},
+		{
+			MethodName: "TemplateVersions",
+			Handler:    _Query_TemplateVersions_Handler,
+		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
+		{
+			MethodName: "ContractTemplates",
+			Handler:    _Query_ContractTemplates_Handler,
+		},
 		{
 			MethodName: "ActionsByAddress",
 			Handler:    _Query_ActionsByAddress_Handler,
@@ -1161,6 +1670,45 @@ func (m *QueryActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpdatedBefore != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UpdatedBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedBefore):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpdatedAfter != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UpdatedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedAfter):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1408,6 +1956,11 @@ func (m *QueryTemplateByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Id != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
@@ -1451,7 +2004,7 @@ func (m *QueryTemplateByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActionsByAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTemplateVersionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1461,25 +2014,67 @@ func (m *QueryActionsByAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActionsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTemplateVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActionsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTemplateVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1498,7 +2093,7 @@ func (m *QueryActionsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActionsByAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVoteDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1508,20 +2103,20 @@ func (m *QueryActionsByAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActionsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActionsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVoteDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Actions) > 0 {
-		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1547,7 +2142,7 @@ func (m *QueryActionsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActionByIdRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractTemplatesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1557,25 +2152,27 @@ func (m *QueryActionByIdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractTemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActionByIdResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractTemplatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1585,19 +2182,66 @@ func (m *QueryActionByIdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractTemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Action != nil {
+	{
+		size, err := m.ContractTemplates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTemplateVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTemplateVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTemplateVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
 		{
-			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1610,61 +2254,239 @@ func (m *QueryActionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActionsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryActionsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActionsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *QueryActionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Actions) > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdatedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdatedBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Actions) > 0 {
 		for _, e := range m.Actions {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
@@ -1748,6 +2570,9 @@ func (m *QueryTemplateByIdRequest) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovQuery(uint64(m.Id))
 	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
 	return n
 }
 
@@ -1764,7 +2589,7 @@ func (m *QueryTemplateByIdResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryActionsByAddressRequest) Size() (n int) {
+func (m *QueryTemplateVersionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1774,17 +2599,34 @@ func (m *QueryActionsByAddressRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Address)
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegator)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryActionsByAddressResponse) Size() (n int) {
+func (m *QueryVoteDelegationsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1794,8 +2636,8 @@ func (m *QueryActionsByAddressResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Actions) > 0 {
-		for _, e := range m.Actions {
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -1803,36 +2645,118 @@ func (m *QueryActionsByAddressResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryActionByIdRequest) Size() (n int) {
+func (m *QueryContractTemplatesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryActionByIdResponse) Size() (n int) {
+func (m *QueryContractTemplatesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Action != nil {
-		l = m.Action.Size()
+	l = m.ContractTemplates.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTemplateVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryActionsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryActionsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryActionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1884,7 +2808,889 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ActionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAfter == nil {
+				m.UpdatedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UpdatedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedBefore == nil {
+				m.UpdatedBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UpdatedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, Action{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTemplatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, Template{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evaluation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evaluation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTemplateByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1907,17 +3713,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTemplateByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTemplateByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1927,25 +3733,30 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1967,7 +3778,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTemplateByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1990,15 +3801,15 @@ func (m *QueryActionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTemplateByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTemplateByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2025,10 +3836,10 @@ func (m *QueryActionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Template == nil {
+				m.Template = &Template{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2053,7 +3864,7 @@ func (m *QueryActionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTemplateVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2076,10 +3887,10 @@ func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTemplateVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTemplateVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2112,17 +3923,17 @@ func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2132,26 +3943,11 @@ func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Actions = append(m.Actions, Action{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2173,7 +3969,7 @@ func (m *QueryActionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTemplatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2196,10 +3992,10 @@ func (m *QueryTemplatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTemplatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2240,7 +4036,7 @@ func (m *QueryTemplatesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2268,7 +4064,39 @@ func (m *QueryTemplatesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2291,7 +4119,7 @@ func (m *QueryTemplatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTemplatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2314,10 +4142,10 @@ func (m *QueryTemplatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTemplatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2358,7 +4186,7 @@ func (m *QueryTemplatesResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2385,8 +4213,8 @@ func (m *QueryTemplatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, Template{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, VoteDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2411,7 +4239,7 @@ func (m *QueryTemplatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateTemplateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractTemplatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2434,51 +4262,15 @@ func (m *QuerySimulateTemplateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateTemplateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractTemplatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2506,7 +4298,7 @@ func (m *QuerySimulateTemplateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definition = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2529,7 +4321,7 @@ func (m *QuerySimulateTemplateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateTemplateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractTemplatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2552,17 +4344,17 @@ func (m *QuerySimulateTemplateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateTemplateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractTemplatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evaluation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTemplates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2572,23 +4364,24 @@ func (m *QuerySimulateTemplateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evaluation = string(dAtA[iNdEx:postIndex])
+			if err := m.ContractTemplates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2611,7 +4404,7 @@ func (m *QuerySimulateTemplateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTemplateByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTemplateVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2634,17 +4427,17 @@ func (m *QueryTemplateByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTemplateByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTemplateVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTemplateByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTemplateVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2654,64 +4447,31 @@ func (m *QueryTemplateByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTemplateByIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTemplateByIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTemplateByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2738,10 +4498,8 @@ func (m *QueryTemplateByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Template == nil {
-				m.Template = &Template{}
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Templates = append(m.Templates, Template{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
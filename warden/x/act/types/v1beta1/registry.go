@@ -17,8 +17,18 @@ import (
 // Optionally, it can return a new context.Context that will be used further
 // during the Expression evaluation. This is especially useful for modules that
 // want to enrich the context for their Expanders.
+//
+// Modules can also register field resolvers, that return the Templates
+// governing a value (e.g. the Templates of a Space given its ID). Message
+// types can then be bound to a resolver by the name of one of their fields,
+// either in code with RegisterByField or through the x/act Params.
+//
+// The module owning the Spaces that Actions are scoped to (see WithSpaceID)
+// registers a SpaceExistsFn, used to validate the Space of vote delegations.
 type TemplatesRegistry struct {
 	p map[string]ProviderFnWithCtx
+	r map[string]FieldResolverFn
+	s SpaceExistsFn
 }
 
 type ProviderFnG[T sdk.Msg] func(context.Context, T) (Template, Template, error)
@@ -54,13 +64,37 @@ func RegisterCtx[T sdk.Msg](reg registryWithCtx, fn ProviderFnWithCtxG[T]) {
 	})
 }
 
+type spaceIDKey struct{}
+
+// WithSpaceID returns a copy of ctx carrying the id of the Space a message
+// belongs to. Provider functions can return it to scope the Action to the
+// Space, e.g. for vote delegations.
+func WithSpaceID(ctx context.Context, spaceID uint64) context.Context {
+	return context.WithValue(ctx, spaceIDKey{}, spaceID)
+}
+
+// SpaceIDFromContext returns the id of the Space set by WithSpaceID.
+func SpaceIDFromContext(ctx context.Context) (uint64, bool) {
+	id, ok := ctx.Value(spaceIDKey{}).(uint64)
+	return id, ok
+}
+
 type ProviderFn func(context.Context, sdk.Msg) (Template, Template, error)
 type ProviderFnWithCtx func(context.Context, sdk.Msg) (context.Context, Template, Template, error)
 
+// FieldResolverFn returns the approve and reject Templates governing the
+// value of a message field. Like ProviderFnWithCtx, it can return a new
+// context.Context.
+type FieldResolverFn func(ctx context.Context, value any) (context.Context, Template, Template, error)
+
+// SpaceExistsFn returns true if the Space with the given ID exists.
+type SpaceExistsFn func(ctx context.Context, spaceID uint64) (bool, error)
+
 // NewTemplatesRegistry returns an empty initialized *TemplatesRegistry.
 func NewTemplatesRegistry() *TemplatesRegistry {
 	return &TemplatesRegistry{
 		p: make(map[string]ProviderFnWithCtx),
+		r: make(map[string]FieldResolverFn),
 	}
 }
 
@@ -104,3 +138,86 @@ func (p *TemplatesRegistry) Get(ctx context.Context, msg sdk.Msg) (context.Conte
 	}
 	return nil, Template{}, Template{}, fmt.Errorf("no Template provider registered for %s", typeUrl)
 }
+
+// Has returns true if a provider function is registered for the typeUrl.
+func (p *TemplatesRegistry) Has(typeUrl string) bool {
+	_, found := p.p[typeUrl]
+	return found
+}
+
+// RegisterFieldResolver registers a FieldResolverFn with the given name.
+// Attempting to register two resolvers with the same name will panic.
+func (p *TemplatesRegistry) RegisterFieldResolver(name string, fn FieldResolverFn) {
+	if len(name) == 0 {
+		panic(fmt.Errorf("resolver name cannot be empty"))
+	}
+
+	if fn == nil {
+		panic(fmt.Errorf("function cannot be nil"))
+	}
+
+	if _, found := p.r[name]; found {
+		panic(fmt.Errorf("TemplateRegistry already has a field resolver named %s", name))
+	}
+
+	p.r[name] = fn
+}
+
+// HasFieldResolver returns true if a field resolver is registered with the
+// given name.
+func (p *TemplatesRegistry) HasFieldResolver(name string) bool {
+	_, found := p.r[name]
+	return found
+}
+
+// RegisterByField registers a provider function for the typeUrl that passes
+// the value of the field of the messages to the named field resolver. The
+// resolver is looked up when the provider is called, so it can be registered
+// later.
+func (p *TemplatesRegistry) RegisterByField(typeUrl, field, resolver string) {
+	p.RegisterCtx(typeUrl, func(ctx context.Context, msg sdk.Msg) (context.Context, Template, Template, error) {
+		return p.GetByField(ctx, msg, field, resolver)
+	})
+}
+
+// GetByField calls the named field resolver with the value of the field of
+// the sdk.Msg.
+//
+// An error is returned if the resolver is not registered or if the sdk.Msg
+// has no such field.
+func (p *TemplatesRegistry) GetByField(ctx context.Context, msg sdk.Msg, field, resolver string) (context.Context, Template, Template, error) {
+	fn, found := p.r[resolver]
+	if !found {
+		return nil, Template{}, Template{}, fmt.Errorf("no field resolver registered with name %s", resolver)
+	}
+
+	value, err := MsgFieldValue(msg, field)
+	if err != nil {
+		return nil, Template{}, Template{}, err
+	}
+
+	return fn(ctx, value)
+}
+
+// RegisterSpaces registers the SpaceExistsFn of the module owning the
+// Spaces. Attempting to register it twice will panic.
+func (p *TemplatesRegistry) RegisterSpaces(fn SpaceExistsFn) {
+	if fn == nil {
+		panic(fmt.Errorf("function cannot be nil"))
+	}
+
+	if p.s != nil {
+		panic(fmt.Errorf("TemplateRegistry already has a SpaceExistsFn"))
+	}
+
+	p.s = fn
+}
+
+// SpaceExists returns true if the Space with the given ID exists. It returns
+// false if no SpaceExistsFn is registered.
+func (p *TemplatesRegistry) SpaceExists(ctx context.Context, spaceID uint64) (bool, error) {
+	if p.s == nil {
+		return false, nil
+	}
+	return p.s(ctx, spaceID)
+}
@@ -0,0 +1,52 @@
+package v1beta1_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestMsgFieldValue(t *testing.T) {
+	msg := &types.MsgRevokeAction{Creator: "alice", ActionId: 42}
+
+	creator, err := types.MsgFieldValue(msg, "creator")
+	require.NoError(t, err)
+	require.Equal(t, "alice", creator)
+
+	id, err := types.MsgFieldValue(msg, "action_id")
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), id)
+
+	_, err = types.MsgFieldValue(msg, "actionId")
+	require.Error(t, err)
+}
+
+func TestTemplatesRegistry_RegisterByField(t *testing.T) {
+	reg := types.NewTemplatesRegistry()
+	typeUrl := sdk.MsgTypeURL(&types.MsgRevokeAction{})
+
+	// the resolver can be registered after the binding
+	reg.RegisterByField(typeUrl, "action_id", "test.action")
+	require.True(t, reg.Has(typeUrl))
+
+	_, _, _, err := reg.Get(context.Background(), &types.MsgRevokeAction{ActionId: 1})
+	require.Error(t, err)
+
+	reg.RegisterFieldResolver("test.action", func(ctx context.Context, value any) (context.Context, types.Template, types.Template, error) {
+		return ctx, types.Template{Id: value.(uint64)}, types.Template{}, nil
+	})
+	require.True(t, reg.HasFieldResolver("test.action"))
+	require.Panics(t, func() {
+		reg.RegisterFieldResolver("test.action", func(ctx context.Context, _ any) (context.Context, types.Template, types.Template, error) {
+			return ctx, types.Template{}, types.Template{}, nil
+		})
+	})
+
+	_, approve, _, err := reg.Get(context.Background(), &types.MsgRevokeAction{ActionId: 7})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), approve.Id)
+}
@@ -31,5 +31,14 @@ func (r *Template) Validate() error {
 		return errors.Wrapf(ErrInvalidTemplate, "name is required")
 	}
 
+	if r.ExecutionDelay < 0 {
+		return errors.Wrapf(ErrInvalidTemplate, "execution delay can't be negative")
+	}
+
 	return nil
 }
+
+// Ref returns a reference to the version of the Template.
+func (r *Template) Ref() TemplateRef {
+	return TemplateRef{Id: r.Id, Version: r.Version}
+}
@@ -0,0 +1,193 @@
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// EIP-712 domain of the signed votes.
+const (
+	EIP712VoteDomainName    = "Warden Protocol"
+	EIP712VoteDomainVersion = "1"
+)
+
+// VoteSignData returns the payload of a SignedVote: the sorted JSON encoding
+// of the chain ID, action ID and vote type, e.g.
+//
+//	{"action_id":"1","chain_id":"warden_1","vote_type":"VOTE_TYPE_APPROVED"}
+//
+// It's signed as the data of an ADR-036 message with
+// SIGNATURE_SCHEME_ADR036.
+func VoteSignData(chainID string, actionID uint64, voteType ActionVoteType) []byte {
+	bz, err := json.Marshal(struct {
+		ActionID string `json:"action_id"`
+		ChainID  string `json:"chain_id"`
+		VoteType string `json:"vote_type"`
+	}{
+		ActionID: strconv.FormatUint(actionID, 10),
+		ChainID:  chainID,
+		VoteType: voteType.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ADR036SignBytes returns the bytes signed by signer for data, according to
+// ADR-036: the amino JSON sign doc of a single MsgSignData, with an empty
+// chain ID, zero account number and sequence, and no fees.
+func ADR036SignBytes(signer string, data []byte) []byte {
+	type msgValue struct {
+		Data   []byte `json:"data"`
+		Signer string `json:"signer"`
+	}
+	type msg struct {
+		Type  string   `json:"type"`
+		Value msgValue `json:"value"`
+	}
+	type fee struct {
+		Amount []struct{} `json:"amount"`
+		Gas    string     `json:"gas"`
+	}
+
+	bz, err := json.Marshal(struct {
+		AccountNumber string `json:"account_number"`
+		ChainID       string `json:"chain_id"`
+		Fee           fee    `json:"fee"`
+		Memo          string `json:"memo"`
+		Msgs          []msg  `json:"msgs"`
+		Sequence      string `json:"sequence"`
+	}{
+		AccountNumber: "0",
+		Fee:           fee{Amount: []struct{}{}, Gas: "0"},
+		Msgs: []msg{{
+			Type:  "sign/MsgSignData",
+			Value: msgValue{Data: data, Signer: signer},
+		}},
+		Sequence: "0",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// EIP712VoteTypedData returns the EIP-712 typed data of a vote, signed with
+// SIGNATURE_SCHEME_EIP712.
+func EIP712VoteTypedData(chainID string, actionID uint64, voteType ActionVoteType) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			"Vote": {
+				{Name: "chainId", Type: "string"},
+				{Name: "actionId", Type: "uint64"},
+				{Name: "voteType", Type: "string"},
+			},
+		},
+		PrimaryType: "Vote",
+		Domain: apitypes.TypedDataDomain{
+			Name:    EIP712VoteDomainName,
+			Version: EIP712VoteDomainVersion,
+		},
+		Message: apitypes.TypedDataMessage{
+			"chainId":  chainID,
+			"actionId": strconv.FormatUint(actionID, 10),
+			"voteType": voteType.String(),
+		},
+	}
+}
+
+// EIP712VoteHash returns the hash signed with SIGNATURE_SCHEME_EIP712.
+func EIP712VoteHash(chainID string, actionID uint64, voteType ActionVoteType) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(EIP712VoteTypedData(chainID, actionID, voteType))
+	return hash, err
+}
+
+// Verify checks that the SignedVote has been signed by its participant for
+// the given chain and action.
+func (v *SignedVote) Verify(chainID string, actionID uint64) error {
+	participant, err := sdk.AccAddressFromBech32(v.Participant)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignedVote, "invalid participant: %v", err)
+	}
+
+	if v.VoteType != ActionVoteType_VOTE_TYPE_APPROVED && v.VoteType != ActionVoteType_VOTE_TYPE_REJECTED {
+		return errors.Wrapf(ErrInvalidSignedVote, "invalid vote type: %v", v.VoteType)
+	}
+
+	switch v.Scheme {
+	case SignatureScheme_SIGNATURE_SCHEME_ADR036:
+		return v.verifyADR036(participant, chainID, actionID)
+	case SignatureScheme_SIGNATURE_SCHEME_EIP712:
+		return v.verifyEIP712(participant, chainID, actionID)
+	default:
+		return errors.Wrapf(ErrInvalidSignedVote, "unsupported signature scheme: %v", v.Scheme)
+	}
+}
+
+func (v *SignedVote) verifyADR036(participant sdk.AccAddress, chainID string, actionID uint64) error {
+	signBytes := ADR036SignBytes(v.Participant, VoteSignData(chainID, actionID, v.VoteType))
+
+	// Cosmos secp256k1 keys sign the SHA-256 hash of the sign bytes
+	pubKey := &secp256k1.PubKey{Key: v.PubKey}
+	if len(v.PubKey) == secp256k1.PubKeySize && bytes.Equal(pubKey.Address(), participant) {
+		if !pubKey.VerifySignature(signBytes, v.Signature) {
+			return errors.Wrap(ErrInvalidSignedVote, "invalid signature")
+		}
+		return nil
+	}
+
+	// Ethereum secp256k1 keys sign the Keccak-256 hash of the sign bytes
+	ethPubKey, err := ethcrypto.DecompressPubkey(v.PubKey)
+	if err != nil || !bytes.Equal(ethcrypto.PubkeyToAddress(*ethPubKey).Bytes(), participant) {
+		return errors.Wrap(ErrInvalidSignedVote, "public key doesn't match participant")
+	}
+
+	sig := v.Signature
+	if len(sig) == ethcrypto.SignatureLength {
+		// drop the recovery ID
+		sig = sig[:ethcrypto.SignatureLength-1]
+	}
+	if !ethcrypto.VerifySignature(v.PubKey, ethcrypto.Keccak256(signBytes), sig) {
+		return errors.Wrap(ErrInvalidSignedVote, "invalid signature")
+	}
+	return nil
+}
+
+func (v *SignedVote) verifyEIP712(participant sdk.AccAddress, chainID string, actionID uint64) error {
+	if len(v.Signature) != ethcrypto.SignatureLength {
+		return errors.Wrapf(ErrInvalidSignedVote, "invalid signature length: %d", len(v.Signature))
+	}
+
+	hash, err := EIP712VoteHash(chainID, actionID, v.VoteType)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignedVote, "hashing typed data: %v", err)
+	}
+
+	sig := bytes.Clone(v.Signature)
+	if sig[ethcrypto.RecoveryIDOffset] >= 27 {
+		// wallets return the legacy {27, 28} recovery IDs
+		sig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := ethcrypto.SigToPub(hash, sig)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignedVote, "invalid signature: %v", err)
+	}
+
+	if !bytes.Equal(ethcrypto.PubkeyToAddress(*pubKey).Bytes(), participant) {
+		return errors.Wrap(ErrInvalidSignedVote, "signature doesn't match participant")
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package v1beta1_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+)
+
+func TestVoteSignData(t *testing.T) {
+	require.Equal(t,
+		`{"action_id":"1","chain_id":"warden_1","vote_type":"VOTE_TYPE_APPROVED"}`,
+		string(types.VoteSignData("warden_1", 1, types.ActionVoteType_VOTE_TYPE_APPROVED)),
+	)
+}
+
+func TestADR036SignBytes(t *testing.T) {
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"signer"}}],"sequence":"0"}`,
+		string(types.ADR036SignBytes("signer", []byte("hello"))),
+	)
+}
+
+func TestSignedVoteVerify(t *testing.T) {
+	const chainID = "warden_1"
+	approved := types.ActionVoteType_VOTE_TYPE_APPROVED
+
+	cosmosKey := secp256k1.GenPrivKey()
+	cosmosAddr := sdk.AccAddress(cosmosKey.PubKey().Address()).String()
+
+	ethKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := sdk.AccAddress(ethcrypto.PubkeyToAddress(ethKey.PublicKey).Bytes()).String()
+
+	adr036Cosmos := func(participant string, actionID uint64, voteType types.ActionVoteType) []byte {
+		sig, err := cosmosKey.Sign(types.ADR036SignBytes(participant, types.VoteSignData(chainID, actionID, voteType)))
+		require.NoError(t, err)
+		return sig
+	}
+	adr036Eth := func(participant string, actionID uint64) []byte {
+		signBytes := types.ADR036SignBytes(participant, types.VoteSignData(chainID, actionID, approved))
+		sig, err := ethcrypto.Sign(ethcrypto.Keccak256(signBytes), ethKey)
+		require.NoError(t, err)
+		return sig
+	}
+	eip712 := func(actionID uint64, voteType types.ActionVoteType) []byte {
+		hash, err := types.EIP712VoteHash(chainID, actionID, voteType)
+		require.NoError(t, err)
+		sig, err := ethcrypto.Sign(hash, ethKey)
+		require.NoError(t, err)
+		sig[ethcrypto.RecoveryIDOffset] += 27
+		return sig
+	}
+
+	tests := []struct {
+		name    string
+		vote    types.SignedVote
+		wantErr bool
+	}{
+		{
+			name: "adr036 with cosmos key",
+			vote: types.SignedVote{
+				Participant: cosmosAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_ADR036,
+				PubKey:      cosmosKey.PubKey().Bytes(),
+				Signature:   adr036Cosmos(cosmosAddr, 1, approved),
+			},
+		},
+		{
+			name: "adr036 with ethereum key",
+			vote: types.SignedVote{
+				Participant: ethAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_ADR036,
+				PubKey:      ethcrypto.CompressPubkey(&ethKey.PublicKey),
+				Signature:   adr036Eth(ethAddr, 1),
+			},
+		},
+		{
+			name: "eip712",
+			vote: types.SignedVote{
+				Participant: ethAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+				Signature:   eip712(1, approved),
+			},
+		},
+		{
+			name: "adr036 signed for another action",
+			vote: types.SignedVote{
+				Participant: cosmosAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_ADR036,
+				PubKey:      cosmosKey.PubKey().Bytes(),
+				Signature:   adr036Cosmos(cosmosAddr, 2, approved),
+			},
+			wantErr: true,
+		},
+		{
+			name: "adr036 signed for another vote type",
+			vote: types.SignedVote{
+				Participant: cosmosAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_ADR036,
+				PubKey:      cosmosKey.PubKey().Bytes(),
+				Signature:   adr036Cosmos(cosmosAddr, 1, types.ActionVoteType_VOTE_TYPE_REJECTED),
+			},
+			wantErr: true,
+		},
+		{
+			name: "adr036 with public key of another participant",
+			vote: types.SignedVote{
+				Participant: ethAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_ADR036,
+				PubKey:      cosmosKey.PubKey().Bytes(),
+				Signature:   adr036Cosmos(ethAddr, 1, approved),
+			},
+			wantErr: true,
+		},
+		{
+			name: "eip712 signed by another participant",
+			vote: types.SignedVote{
+				Participant: cosmosAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+				Signature:   eip712(1, approved),
+			},
+			wantErr: true,
+		},
+		{
+			name: "eip712 signed for another action",
+			vote: types.SignedVote{
+				Participant: ethAddr,
+				VoteType:    approved,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+				Signature:   eip712(2, approved),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unspecified scheme",
+			vote: types.SignedVote{
+				Participant: ethAddr,
+				VoteType:    approved,
+				Signature:   eip712(1, approved),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unspecified vote type",
+			vote: types.SignedVote{
+				Participant: ethAddr,
+				Scheme:      types.SignatureScheme_SIGNATURE_SCHEME_EIP712,
+				Signature:   eip712(1, types.ActionVoteType_VOTE_TYPE_UNSPECIFIED),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.vote.Verify(chainID, 1)
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidSignedVote)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
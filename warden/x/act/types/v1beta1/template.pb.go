@@ -6,17 +6,23 @@ package v1beta1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	ast "github.com/warden-protocol/wardenprotocol/shield/ast"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +36,22 @@ type Template struct {
 	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// The expression to be evaluated for this template.
 	Expression *ast.Expression `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// The delay between the approval of an Action using this template and the
+	// execution of its message. During the delay the Action is queued and can
+	// still be rejected.
+	ExecutionDelay time.Duration `protobuf:"bytes,5,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	// The version of the template, starting from 1 and incremented by every
+	// update. Each version is immutable and kept in the template history.
+	Version uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// The address that created this version of the template.
+	UpdatedBy string `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// The time this version of the template was created.
+	UpdatedAt time.Time `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// The id of the template governing this template, 0 if the template is
+	// not governed. Governed templates can only be updated or transferred
+	// through an Action approved by the governing template, which can be the
+	// template itself.
+	GovernanceTemplateId uint64 `protobuf:"varint,9,opt,name=governance_template_id,json=governanceTemplateId,proto3" json:"governance_template_id,omitempty"`
 }
 
 func (m *Template) Reset()         { *m = Template{} }
@@ -93,31 +115,205 @@ func (m *Template) GetExpression() *ast.Expression {
 	return nil
 }
 
+func (m *Template) GetExecutionDelay() time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *Template) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Template) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func (m *Template) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Template) GetGovernanceTemplateId() uint64 {
+	if m != nil {
+		return m.GovernanceTemplateId
+	}
+	return 0
+}
+
+// TemplateRef references a version of a Template.
+type TemplateRef struct {
+	// id of the template, 0 if the expression wasn't taken from a stored
+	// template (e.g. the default expression of a message).
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version of the template.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *TemplateRef) Reset()         { *m = TemplateRef{} }
+func (m *TemplateRef) String() string { return proto.CompactTextString(m) }
+func (*TemplateRef) ProtoMessage()    {}
+func (*TemplateRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97dfdf8dfcd7e7bf, []int{1}
+}
+func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TemplateRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TemplateRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateRef.Merge(m, src)
+}
+func (m *TemplateRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
+
+func (m *TemplateRef) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TemplateRef) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// ContractTemplates are the templates of the Actions executing a CosmWasm
+// contract (cosmwasm.wasm.v1.MsgExecuteContract messages sent by the x/act
+// module account).
+type ContractTemplates struct {
+	// contract is the address of the contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// approve_template_id is the id of the template used to approve the
+	// Actions.
+	ApproveTemplateId uint64 `protobuf:"varint,2,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
+	// reject_template_id is the id of the template used to reject the Actions.
+	RejectTemplateId uint64 `protobuf:"varint,3,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
+}
+
+func (m *ContractTemplates) Reset()         { *m = ContractTemplates{} }
+func (m *ContractTemplates) String() string { return proto.CompactTextString(m) }
+func (*ContractTemplates) ProtoMessage()    {}
+func (*ContractTemplates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97dfdf8dfcd7e7bf, []int{2}
+}
+func (m *ContractTemplates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractTemplates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractTemplates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractTemplates.Merge(m, src)
+}
+func (m *ContractTemplates) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractTemplates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractTemplates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractTemplates proto.InternalMessageInfo
+
+func (m *ContractTemplates) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractTemplates) GetApproveTemplateId() uint64 {
+	if m != nil {
+		return m.ApproveTemplateId
+	}
+	return 0
+}
+
+func (m *ContractTemplates) GetRejectTemplateId() uint64 {
+	if m != nil {
+		return m.RejectTemplateId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Template)(nil), "warden.act.v1beta1.Template")
+	proto.RegisterType((*TemplateRef)(nil), "warden.act.v1beta1.TemplateRef")
+	proto.RegisterType((*ContractTemplates)(nil), "warden.act.v1beta1.ContractTemplates")
 }
 
 func init() { proto.RegisterFile("warden/act/v1beta1/template.proto", fileDescriptor_97dfdf8dfcd7e7bf) }
 
 var fileDescriptor_97dfdf8dfcd7e7bf = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xcd, 0x4a, 0xc4, 0x30,
-	0x14, 0x85, 0x9b, 0x5a, 0xfc, 0x89, 0xe0, 0x22, 0x88, 0xd4, 0x59, 0x84, 0xea, 0xaa, 0x1b, 0x13,
-	0x46, 0xc1, 0x07, 0x10, 0x7d, 0x81, 0xe2, 0xca, 0x85, 0x90, 0xb6, 0xd7, 0x4e, 0xa1, 0x6d, 0x4a,
-	0x72, 0x47, 0x67, 0x76, 0x3e, 0x82, 0x8f, 0xe5, 0x72, 0x96, 0x2e, 0xa5, 0x7d, 0x11, 0x99, 0xa4,
-	0x23, 0xe2, 0x22, 0x90, 0x73, 0xf2, 0x91, 0x73, 0xef, 0xa1, 0x17, 0x6f, 0xca, 0x94, 0xd0, 0x49,
-	0x55, 0xa0, 0x7c, 0x9d, 0xe7, 0x80, 0x6a, 0x2e, 0x11, 0xda, 0xbe, 0x51, 0x08, 0xa2, 0x37, 0x1a,
-	0x35, 0x63, 0x1e, 0x11, 0xaa, 0x40, 0x31, 0x21, 0xb3, 0xf3, 0x4a, 0xeb, 0xaa, 0x01, 0xe9, 0x88,
-	0x7c, 0xf9, 0x22, 0x55, 0xb7, 0xf6, 0xf8, 0xec, 0xd4, 0x2e, 0x6a, 0x68, 0x4a, 0xa9, 0x2c, 0x6e,
-	0x8f, 0x77, 0x2f, 0xdf, 0x09, 0x3d, 0x7c, 0x9c, 0xfe, 0x65, 0x27, 0x34, 0xac, 0xcb, 0x98, 0x24,
-	0x24, 0x8d, 0xb2, 0xb0, 0x2e, 0x59, 0x4c, 0x0f, 0x0a, 0x03, 0x0a, 0xb5, 0x89, 0xc3, 0x84, 0xa4,
-	0x47, 0xd9, 0x4e, 0x32, 0x46, 0xa3, 0x4e, 0xb5, 0x10, 0xef, 0x39, 0xdb, 0xdd, 0xd9, 0x2d, 0xa5,
-	0xb0, 0xea, 0x0d, 0x58, 0x5b, 0xeb, 0x2e, 0x8e, 0x12, 0x92, 0x1e, 0x5f, 0x9f, 0x09, 0x9f, 0x2a,
-	0xb6, 0x89, 0x0f, 0xbf, 0xaf, 0xd9, 0x1f, 0xf2, 0xee, 0xf9, 0x73, 0xe0, 0x64, 0x33, 0x70, 0xf2,
-	0x3d, 0x70, 0xf2, 0x31, 0xf2, 0x60, 0x33, 0xf2, 0xe0, 0x6b, 0xe4, 0xc1, 0xd3, 0x7d, 0x55, 0xe3,
-	0x62, 0x99, 0x8b, 0x42, 0xb7, 0xd2, 0x2f, 0x7b, 0xe5, 0xa6, 0x2e, 0x74, 0x33, 0xe9, 0x7f, 0x52,
-	0xae, 0x5c, 0x61, 0xb8, 0xee, 0xc1, 0xee, 0x6a, 0xcb, 0xf7, 0x1d, 0x74, 0xf3, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x76, 0x89, 0x49, 0x8e, 0x53, 0x01, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xba, 0xb2, 0xb5, 0x9e, 0x34, 0x98, 0xa9, 0xa6, 0xac, 0x12, 0x69, 0xe9, 0xa9, 0x07,
+	0x70, 0x34, 0x40, 0x70, 0xa6, 0x2b, 0x07, 0x24, 0x4e, 0xd1, 0x4e, 0x1c, 0xa8, 0x1c, 0xfb, 0x2d,
+	0x0b, 0x4a, 0xe2, 0xc8, 0x71, 0x4a, 0x73, 0xe5, 0x8e, 0xb4, 0x23, 0x3f, 0x69, 0xc7, 0x1d, 0x39,
+	0x01, 0x6a, 0xff, 0x08, 0x8a, 0x63, 0xb7, 0xb4, 0x70, 0xa8, 0xe4, 0xef, 0x7d, 0x9f, 0x9f, 0xbf,
+	0xef, 0x35, 0x0f, 0x3d, 0xfd, 0x42, 0x25, 0x87, 0xcc, 0xa7, 0x4c, 0xf9, 0x8b, 0x8b, 0x10, 0x14,
+	0xbd, 0xf0, 0x15, 0xa4, 0x79, 0x42, 0x15, 0x90, 0x5c, 0x0a, 0x25, 0x30, 0x6e, 0x24, 0x84, 0x32,
+	0x45, 0x8c, 0x64, 0xd0, 0x8f, 0x44, 0x24, 0x34, 0xed, 0xd7, 0xa7, 0x46, 0x39, 0x38, 0x8f, 0x84,
+	0x88, 0x12, 0xf0, 0x35, 0x0a, 0xcb, 0x6b, 0x9f, 0x66, 0x95, 0xa1, 0xbc, 0x7d, 0x8a, 0x97, 0x92,
+	0xaa, 0x58, 0x64, 0x86, 0x1f, 0xee, 0xf3, 0x2a, 0x4e, 0xa1, 0x50, 0x34, 0xcd, 0x8d, 0xa0, 0x5f,
+	0xdc, 0xc4, 0x90, 0x70, 0x9f, 0x16, 0xaa, 0xfe, 0x35, 0xd5, 0xf1, 0xd7, 0x03, 0xd4, 0xbd, 0x32,
+	0x76, 0xf1, 0x09, 0x6a, 0xc7, 0xdc, 0x75, 0x46, 0xce, 0xa4, 0x13, 0xb4, 0x63, 0x8e, 0x5d, 0x74,
+	0xc4, 0x24, 0x50, 0x25, 0xa4, 0xdb, 0x1e, 0x39, 0x93, 0x5e, 0x60, 0x21, 0xc6, 0xa8, 0x93, 0xd1,
+	0x14, 0xdc, 0x03, 0x5d, 0xd6, 0x67, 0xfc, 0x1a, 0x21, 0x58, 0xe6, 0x12, 0x8a, 0x22, 0x16, 0x99,
+	0xdb, 0x19, 0x39, 0x93, 0xe3, 0x17, 0x67, 0xa4, 0x79, 0x95, 0xd4, 0x2f, 0xbe, 0xdb, 0xb0, 0xc1,
+	0x5f, 0x4a, 0xfc, 0x01, 0x3d, 0x84, 0x25, 0xb0, 0xb2, 0x0e, 0x33, 0xe7, 0x90, 0xd0, 0xca, 0x7d,
+	0xa0, 0x2f, 0x9f, 0x93, 0x26, 0x13, 0xb1, 0x99, 0xc8, 0xcc, 0x64, 0x9e, 0x76, 0xef, 0x7e, 0x0e,
+	0x5b, 0xdf, 0x7f, 0x0d, 0x9d, 0xe0, 0x64, 0x73, 0x77, 0x56, 0x5f, 0xad, 0x3d, 0x2f, 0x40, 0x6a,
+	0x0b, 0x87, 0x3a, 0x88, 0x85, 0xf8, 0x09, 0x42, 0x65, 0xce, 0xa9, 0x02, 0x3e, 0x0f, 0x2b, 0xf7,
+	0x48, 0x3b, 0xef, 0x99, 0xca, 0xb4, 0xc2, 0x97, 0x5b, 0x9a, 0x2a, 0xb7, 0xab, 0x1d, 0x0c, 0xfe,
+	0x71, 0x70, 0x65, 0xa7, 0xda, 0x58, 0xb8, 0xad, 0x2d, 0xd8, 0x26, 0x6f, 0x15, 0x7e, 0x85, 0xce,
+	0x22, 0xb1, 0x00, 0x99, 0xd1, 0x8c, 0xc1, 0xdc, 0x7e, 0x07, 0xf3, 0x98, 0xbb, 0x3d, 0x6d, 0xa6,
+	0xbf, 0x65, 0xed, 0xd4, 0xdf, 0xf3, 0xf1, 0x1b, 0x74, 0x6c, 0x51, 0x00, 0xd7, 0xff, 0xfb, 0x1b,
+	0x6c, 0xa4, 0xf6, 0x4e, 0xa4, 0xf1, 0x37, 0x07, 0x9d, 0x5e, 0x8a, 0x4c, 0x49, 0xca, 0x94, 0xed,
+	0x50, 0xe0, 0x01, 0xea, 0x32, 0x53, 0xd4, 0x5d, 0x7a, 0xc1, 0x06, 0x63, 0x82, 0x1e, 0xd3, 0x3c,
+	0x97, 0x62, 0xb1, 0xeb, 0xae, 0xe9, 0x7b, 0x6a, 0xa8, 0xad, 0x35, 0xfc, 0x0c, 0x61, 0x09, 0x9f,
+	0x81, 0xa9, 0x1d, 0xf9, 0x81, 0x96, 0x3f, 0x6a, 0x98, 0xad, 0x7a, 0xfa, 0xe9, 0x6e, 0xe5, 0x39,
+	0xf7, 0x2b, 0xcf, 0xf9, 0xbd, 0xf2, 0x9c, 0xdb, 0xb5, 0xd7, 0xba, 0x5f, 0x7b, 0xad, 0x1f, 0x6b,
+	0xaf, 0xf5, 0x71, 0x16, 0xc5, 0xea, 0xa6, 0x0c, 0x09, 0x13, 0xa9, 0xdf, 0xac, 0xc3, 0x73, 0x3d,
+	0x53, 0x26, 0x12, 0x83, 0xf7, 0xa0, 0xbf, 0xd4, 0x2b, 0xa5, 0xaa, 0x1c, 0x0a, 0xbb, 0x58, 0xe1,
+	0xa1, 0x16, 0xbd, 0xfc, 0x33, 0x00, 0xfd, 0x37, 0xd2, 0xa4, 0x75, 0x03, 0x00, 0x00,
 }
 
 func (m *Template) Marshal() (dAtA []byte, err error) {
@@ -140,6 +336,39 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GovernanceTemplateId != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.GovernanceTemplateId))
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTemplate(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintTemplate(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Version != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTemplate(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
 	if m.Expression != nil {
 		{
 			size, err := m.Expression.MarshalToSizedBuffer(dAtA[:i])
@@ -174,6 +403,79 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTemplates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTemplates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTemplates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectTemplateId != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.RejectTemplateId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ApproveTemplateId != 0 {
+		i = encodeVarintTemplate(dAtA, i, uint64(m.ApproveTemplateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTemplate(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTemplate(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTemplate(v)
 	base := offset
@@ -206,6 +508,54 @@ func (m *Template) Size() (n int) {
 		l = m.Expression.Size()
 		n += 1 + l + sovTemplate(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovTemplate(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovTemplate(uint64(m.Version))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovTemplate(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovTemplate(uint64(l))
+	if m.GovernanceTemplateId != 0 {
+		n += 1 + sovTemplate(uint64(m.GovernanceTemplateId))
+	}
+	return n
+}
+
+func (m *TemplateRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTemplate(uint64(m.Id))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTemplate(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *ContractTemplates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTemplate(uint64(l))
+	}
+	if m.ApproveTemplateId != 0 {
+		n += 1 + sovTemplate(uint64(m.ApproveTemplateId))
+	}
+	if m.RejectTemplateId != 0 {
+		n += 1 + sovTemplate(uint64(m.RejectTemplateId))
+	}
 	return n
 }
 
@@ -363,6 +713,350 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceTemplateId", wireType)
+			}
+			m.GovernanceTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovernanceTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractTemplates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractTemplates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractTemplates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
+			}
+			m.ApproveTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproveTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
+			}
+			m.RejectTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTemplate(dAtA[iNdEx:])
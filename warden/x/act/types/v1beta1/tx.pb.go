@@ -13,19 +13,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/warden-protocol/wardenprotocol/shield/ast"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -137,6 +142,13 @@ type MsgNewAction struct {
 	ExpectedApproveExpression string `protobuf:"bytes,4,opt,name=expected_approve_expression,json=expectedApproveExpression,proto3" json:"expected_approve_expression,omitempty"`
 	// expected_reject_expression is the definition of expected reject expression the action is created with
 	ExpectedRejectExpression string `protobuf:"bytes,5,opt,name=expected_reject_expression,json=expectedRejectExpression,proto3" json:"expected_reject_expression,omitempty"`
+	// messages is an ordered list of messages executed atomically when the
+	// action is ready, it can't be used together with message. The expected
+	// expressions are compared against the templates of the messages combined
+	// with `&&` (approve) and `||` (reject), in the order of the messages.
+	// Nested chains of the same operator are flattened before the comparison,
+	// so `a || b || c` matches the templates `a` and `b || c`.
+	Messages []*types.Any `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *MsgNewAction) Reset()         { *m = MsgNewAction{} }
@@ -207,6 +219,13 @@ func (m *MsgNewAction) GetExpectedRejectExpression() string {
 	return ""
 }
 
+func (m *MsgNewAction) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 type MsgNewActionResponse struct {
 	// id is the unique id of the action.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -256,6 +275,12 @@ type MsgNewTemplate struct {
 	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Definition string `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	// execution_delay is the delay between the approval of the Actions using
+	// the template and their execution.
+	ExecutionDelay time.Duration `protobuf:"bytes,4,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	// governance_template_id is the id of the template governing the new
+	// template, 0 if it's not governed.
+	GovernanceTemplateId uint64 `protobuf:"varint,5,opt,name=governance_template_id,json=governanceTemplateId,proto3" json:"governance_template_id,omitempty"`
 }
 
 func (m *MsgNewTemplate) Reset()         { *m = MsgNewTemplate{} }
@@ -312,6 +337,20 @@ func (m *MsgNewTemplate) GetDefinition() string {
 	return ""
 }
 
+func (m *MsgNewTemplate) GetExecutionDelay() time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *MsgNewTemplate) GetGovernanceTemplateId() uint64 {
+	if m != nil {
+		return m.GovernanceTemplateId
+	}
+	return 0
+}
+
 type MsgNewTemplateResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -361,6 +400,21 @@ type MsgUpdateTemplate struct {
 	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	// execution_delay is the delay between the approval of the Actions using
+	// the template and their execution. It's only applied if
+	// update_execution_delay is set.
+	ExecutionDelay time.Duration `protobuf:"bytes,5,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	// governance_template_id is the id of the template governing the
+	// template, 0 if it's not governed. It can be the id of the template
+	// itself. It's only applied if update_governance_template_id is set.
+	GovernanceTemplateId uint64 `protobuf:"varint,6,opt,name=governance_template_id,json=governanceTemplateId,proto3" json:"governance_template_id,omitempty"`
+	// update_execution_delay replaces the execution delay of the template
+	// with execution_delay, otherwise the current one is kept.
+	UpdateExecutionDelay bool `protobuf:"varint,7,opt,name=update_execution_delay,json=updateExecutionDelay,proto3" json:"update_execution_delay,omitempty"`
+	// update_governance_template_id replaces the governance template of the
+	// template with governance_template_id, otherwise the current one is
+	// kept.
+	UpdateGovernanceTemplateId bool `protobuf:"varint,8,opt,name=update_governance_template_id,json=updateGovernanceTemplateId,proto3" json:"update_governance_template_id,omitempty"`
 }
 
 func (m *MsgUpdateTemplate) Reset()         { *m = MsgUpdateTemplate{} }
@@ -424,6 +478,34 @@ func (m *MsgUpdateTemplate) GetDefinition() string {
 	return ""
 }
 
+func (m *MsgUpdateTemplate) GetExecutionDelay() time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *MsgUpdateTemplate) GetGovernanceTemplateId() uint64 {
+	if m != nil {
+		return m.GovernanceTemplateId
+	}
+	return 0
+}
+
+func (m *MsgUpdateTemplate) GetUpdateExecutionDelay() bool {
+	if m != nil {
+		return m.UpdateExecutionDelay
+	}
+	return false
+}
+
+func (m *MsgUpdateTemplate) GetUpdateGovernanceTemplateId() bool {
+	if m != nil {
+		return m.UpdateGovernanceTemplateId
+	}
+	return false
+}
+
 type MsgUpdateTemplateResponse struct {
 }
 
@@ -460,6 +542,105 @@ func (m *MsgUpdateTemplateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateTemplateResponse proto.InternalMessageInfo
 
+type MsgTransferTemplateOwnership struct {
+	// creator is the current owner of the template, or the x/act module
+	// account for governed templates.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// new_owner is the address of the new owner of the template.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferTemplateOwnership) Reset()         { *m = MsgTransferTemplateOwnership{} }
+func (m *MsgTransferTemplateOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTemplateOwnership) ProtoMessage()    {}
+func (*MsgTransferTemplateOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{8}
+}
+func (m *MsgTransferTemplateOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferTemplateOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTemplateOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferTemplateOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTemplateOwnership.Merge(m, src)
+}
+func (m *MsgTransferTemplateOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferTemplateOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTemplateOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferTemplateOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferTemplateOwnership) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferTemplateOwnership) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgTransferTemplateOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type MsgTransferTemplateOwnershipResponse struct {
+}
+
+func (m *MsgTransferTemplateOwnershipResponse) Reset()         { *m = MsgTransferTemplateOwnershipResponse{} }
+func (m *MsgTransferTemplateOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferTemplateOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferTemplateOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{9}
+}
+func (m *MsgTransferTemplateOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferTemplateOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferTemplateOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferTemplateOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferTemplateOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferTemplateOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferTemplateOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferTemplateOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferTemplateOwnershipResponse proto.InternalMessageInfo
+
 type MsgRevokeAction struct {
 	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
@@ -469,7 +650,7 @@ func (m *MsgRevokeAction) Reset()         { *m = MsgRevokeAction{} }
 func (m *MsgRevokeAction) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeAction) ProtoMessage()    {}
 func (*MsgRevokeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f059980976488200, []int{8}
+	return fileDescriptor_f059980976488200, []int{10}
 }
 func (m *MsgRevokeAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +700,7 @@ func (m *MsgRevokeActionResponse) Reset()         { *m = MsgRevokeActionResponse
 func (m *MsgRevokeActionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeActionResponse) ProtoMessage()    {}
 func (*MsgRevokeActionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f059980976488200, []int{9}
+	return fileDescriptor_f059980976488200, []int{11}
 }
 func (m *MsgRevokeActionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,7 +738,7 @@ func (m *MsgCheckAction) Reset()         { *m = MsgCheckAction{} }
 func (m *MsgCheckAction) String() string { return proto.CompactTextString(m) }
 func (*MsgCheckAction) ProtoMessage()    {}
 func (*MsgCheckAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f059980976488200, []int{10}
+	return fileDescriptor_f059980976488200, []int{12}
 }
 func (m *MsgCheckAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +789,7 @@ func (m *MsgCheckActionResponse) Reset()         { *m = MsgCheckActionResponse{}
 func (m *MsgCheckActionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCheckActionResponse) ProtoMessage()    {}
 func (*MsgCheckActionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f059980976488200, []int{11}
+	return fileDescriptor_f059980976488200, []int{13}
 }
 func (m *MsgCheckActionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +835,7 @@ func (m *MsgVoteForAction) Reset()         { *m = MsgVoteForAction{} }
 func (m *MsgVoteForAction) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteForAction) ProtoMessage()    {}
 func (*MsgVoteForAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f059980976488200, []int{12}
+	return fileDescriptor_f059980976488200, []int{14}
 }
 func (m *MsgVoteForAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +893,7 @@ func (m *MsgVoteForActionResponse) Reset()         { *m = MsgVoteForActionRespon
 func (m *MsgVoteForActionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteForActionResponse) ProtoMessage()    {}
 func (*MsgVoteForActionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f059980976488200, []int{13}
+	return fileDescriptor_f059980976488200, []int{15}
 }
 func (m *MsgVoteForActionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,1121 +929,3845 @@ func (m *MsgVoteForActionResponse) GetStatus() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "warden.act.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "warden.act.v1beta1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgNewAction)(nil), "warden.act.v1beta1.MsgNewAction")
-	proto.RegisterType((*MsgNewActionResponse)(nil), "warden.act.v1beta1.MsgNewActionResponse")
-	proto.RegisterType((*MsgNewTemplate)(nil), "warden.act.v1beta1.MsgNewTemplate")
-	proto.RegisterType((*MsgNewTemplateResponse)(nil), "warden.act.v1beta1.MsgNewTemplateResponse")
-	proto.RegisterType((*MsgUpdateTemplate)(nil), "warden.act.v1beta1.MsgUpdateTemplate")
-	proto.RegisterType((*MsgUpdateTemplateResponse)(nil), "warden.act.v1beta1.MsgUpdateTemplateResponse")
-	proto.RegisterType((*MsgRevokeAction)(nil), "warden.act.v1beta1.MsgRevokeAction")
-	proto.RegisterType((*MsgRevokeActionResponse)(nil), "warden.act.v1beta1.MsgRevokeActionResponse")
-	proto.RegisterType((*MsgCheckAction)(nil), "warden.act.v1beta1.MsgCheckAction")
-	proto.RegisterType((*MsgCheckActionResponse)(nil), "warden.act.v1beta1.MsgCheckActionResponse")
-	proto.RegisterType((*MsgVoteForAction)(nil), "warden.act.v1beta1.MsgVoteForAction")
-	proto.RegisterType((*MsgVoteForActionResponse)(nil), "warden.act.v1beta1.MsgVoteForActionResponse")
+type MsgRetryAction struct {
+	// creator is the address of the creator of the action.
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("warden/act/v1beta1/tx.proto", fileDescriptor_f059980976488200) }
-
-var fileDescriptor_f059980976488200 = []byte{
-	// 883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xd3, 0xb4, 0xbb, 0x7e, 0x2d, 0x65, 0xd7, 0x94, 0x5d, 0xd7, 0x45, 0xd9, 0xc8, 0x2c,
-	0x28, 0x2a, 0xd4, 0xa6, 0x41, 0xe2, 0x50, 0xf1, 0x47, 0x29, 0x7f, 0x04, 0x87, 0x20, 0x64, 0xba,
-	0x8b, 0x84, 0x04, 0xd9, 0x89, 0x3d, 0x75, 0xcc, 0xc6, 0x1e, 0xcb, 0x33, 0xc9, 0x26, 0x27, 0x10,
-	0x47, 0x4e, 0x1c, 0xf9, 0x00, 0x1c, 0x38, 0xf6, 0xb0, 0x17, 0xbe, 0xc1, 0x1e, 0x57, 0x9c, 0x38,
-	0x21, 0xd4, 0x1e, 0xfa, 0x35, 0x90, 0x67, 0xc6, 0x8e, 0xe3, 0x8d, 0x9b, 0x1c, 0x38, 0x24, 0xf1,
-	0xcc, 0xfb, 0xbd, 0xf7, 0xfb, 0xbd, 0xf7, 0x66, 0x9e, 0x03, 0xfb, 0x4f, 0x50, 0xe2, 0xe1, 0xc8,
-	0x46, 0x2e, 0xb3, 0xc7, 0x47, 0x7d, 0xcc, 0xd0, 0x91, 0xcd, 0x26, 0x56, 0x9c, 0x10, 0x46, 0x34,
-	0x4d, 0x18, 0x2d, 0xe4, 0x32, 0x4b, 0x1a, 0x8d, 0xdb, 0x28, 0x0c, 0x22, 0x62, 0xf3, 0x6f, 0x01,
-	0x33, 0xee, 0xba, 0x84, 0x86, 0x84, 0xda, 0x21, 0xf5, 0xed, 0xf1, 0x51, 0xfa, 0x23, 0x0d, 0x7b,
-	0xc2, 0xd0, 0xe3, 0x2b, 0x5b, 0x2c, 0xa4, 0x69, 0xd7, 0x27, 0x3e, 0x11, 0xfb, 0xe9, 0x53, 0xe6,
-	0xe0, 0x13, 0xe2, 0x0f, 0xb1, 0xcd, 0x57, 0xfd, 0xd1, 0x99, 0x8d, 0xa2, 0xa9, 0x34, 0xdd, 0x5b,
-	0x20, 0x34, 0x46, 0x09, 0x0a, 0xb3, 0x88, 0xf7, 0x17, 0x00, 0x90, 0xcb, 0x02, 0x12, 0xf5, 0xc6,
-	0x84, 0xe1, 0x8c, 0x97, 0x0e, 0x02, 0x3c, 0xf4, 0x6c, 0x44, 0x59, 0xfa, 0x11, 0xbb, 0xe6, 0x9f,
-	0x0a, 0xbc, 0xdc, 0xa5, 0xfe, 0x83, 0xd8, 0x43, 0x0c, 0x7f, 0xc5, 0xa3, 0x6a, 0xef, 0x81, 0x8a,
-	0x46, 0x6c, 0x40, 0x92, 0x80, 0x4d, 0x75, 0xa5, 0xa9, 0xb4, 0xd4, 0x13, 0xfd, 0xaf, 0xa7, 0x87,
-	0xbb, 0x32, 0x8d, 0x8e, 0xe7, 0x25, 0x98, 0xd2, 0xaf, 0x59, 0x12, 0x44, 0xbe, 0x33, 0x83, 0x6a,
-	0x1f, 0xc0, 0xa6, 0xd0, 0xa5, 0xd7, 0x9a, 0x4a, 0x6b, 0xab, 0x6d, 0x58, 0x2f, 0x56, 0xd1, 0x12,
-	0x1c, 0x27, 0xea, 0xb3, 0x7f, 0xee, 0xad, 0xfd, 0x71, 0x75, 0x7e, 0xa0, 0x38, 0xd2, 0xe9, 0xd8,
-	0xfe, 0xf9, 0xea, 0xfc, 0x60, 0x16, 0xee, 0x97, 0xab, 0xf3, 0x83, 0xd7, 0x64, 0x66, 0x13, 0x9e,
-	0x5b, 0x49, 0xa7, 0xb9, 0x07, 0x77, 0x4b, 0x5b, 0x0e, 0xa6, 0x31, 0x89, 0x28, 0x36, 0x7f, 0xab,
-	0xc1, 0x76, 0x97, 0xfa, 0x5f, 0xe2, 0x27, 0x1d, 0x5e, 0x08, 0x4d, 0x87, 0x1b, 0x6e, 0x82, 0x11,
-	0x23, 0x89, 0xc8, 0xc8, 0xc9, 0x96, 0x9a, 0x05, 0x37, 0x42, 0x4c, 0x29, 0xf2, 0xb1, 0x94, 0xbd,
-	0x6b, 0x89, 0x5e, 0x58, 0x59, 0x2f, 0xac, 0x4e, 0x34, 0x75, 0x32, 0x90, 0xd6, 0x86, 0x57, 0x65,
-	0x71, 0x59, 0x10, 0x62, 0x32, 0x62, 0xbd, 0x01, 0x0e, 0xfc, 0x01, 0xd3, 0xd7, 0x9b, 0x4a, 0xab,
-	0xee, 0xbc, 0x22, 0x8c, 0xa7, 0xc2, 0xf6, 0x39, 0x37, 0x69, 0x1f, 0xc2, 0x3e, 0x9e, 0xc4, 0xd8,
-	0x65, 0xd8, 0xeb, 0xa1, 0x38, 0x4e, 0xc8, 0x18, 0xf7, 0xf0, 0x24, 0x4e, 0xcb, 0x18, 0x90, 0x48,
-	0xaf, 0x73, 0x45, 0x7b, 0x19, 0xa4, 0x23, 0x10, 0x9f, 0xe6, 0x00, 0xed, 0x7d, 0x30, 0x72, 0xff,
-	0x04, 0xff, 0x80, 0x5d, 0x56, 0x74, 0xdf, 0xe0, 0xee, 0x7a, 0x86, 0x70, 0x38, 0x60, 0xe6, 0x7d,
-	0xbc, 0x9d, 0x16, 0x36, 0xcb, 0xd7, 0x7c, 0x13, 0x76, 0x8b, 0x95, 0xc9, 0x4a, 0xa6, 0xed, 0x40,
-	0x2d, 0xf0, 0x78, 0x71, 0xea, 0x4e, 0x2d, 0xf0, 0xcc, 0x21, 0xec, 0x08, 0xdc, 0x29, 0x0e, 0xe3,
-	0x21, 0x62, 0xf8, 0x9a, 0x1a, 0x6a, 0x50, 0x8f, 0x50, 0x28, 0x0a, 0xa8, 0x3a, 0xfc, 0x59, 0x6b,
-	0x00, 0x78, 0xf8, 0x2c, 0x88, 0x82, 0x94, 0x85, 0x17, 0x47, 0x75, 0x0a, 0x3b, 0x25, 0x55, 0x2d,
-	0xb8, 0x33, 0xcf, 0x56, 0xa9, 0xeb, 0x47, 0xb8, 0x9d, 0x77, 0x7d, 0x05, 0x69, 0xc2, 0xbd, 0x96,
-	0xb9, 0xe7, 0x52, 0xd7, 0x2b, 0xa5, 0xd6, 0x97, 0x48, 0xdd, 0x87, 0xbd, 0x17, 0x04, 0xe4, 0x07,
-	0xef, 0x21, 0xbf, 0x4e, 0x0e, 0x1e, 0x93, 0xc7, 0x78, 0xe9, 0xd1, 0xdb, 0x07, 0x55, 0x1e, 0xa5,
-	0x5c, 0xe2, 0x4d, 0xb1, 0xf1, 0x85, 0x57, 0x22, 0x15, 0x67, 0xbd, 0x18, 0x37, 0xa7, 0x7c, 0xc0,
-	0x1b, 0xf5, 0xf1, 0x00, 0xbb, 0x8f, 0xff, 0x4f, 0xc6, 0x77, 0x78, 0x47, 0x0a, 0x61, 0xf3, 0x8e,
-	0xdc, 0x81, 0x4d, 0xca, 0x10, 0x1b, 0x51, 0x19, 0x5d, 0xae, 0xcc, 0xdf, 0x15, 0xb8, 0xd5, 0xa5,
-	0xfe, 0x43, 0xc2, 0xf0, 0x67, 0x24, 0x91, 0x5a, 0x9a, 0xb0, 0x15, 0xa3, 0x84, 0x05, 0x6e, 0x10,
-	0xa3, 0x88, 0x49, 0x8f, 0xe2, 0xd6, 0xb5, 0x9a, 0xb4, 0x8f, 0x40, 0x4d, 0x67, 0x58, 0x8f, 0x4d,
-	0x63, 0xd1, 0xb3, 0x9d, 0xb6, 0xb9, 0x68, 0xac, 0x08, 0xb6, 0x94, 0xfa, 0x74, 0x1a, 0x63, 0xe7,
-	0xe6, 0x58, 0x3e, 0x1d, 0xdf, 0x4a, 0x93, 0x2a, 0xf2, 0x99, 0x6d, 0xd0, 0xcb, 0x2a, 0x97, 0xa5,
-	0xd6, 0x7e, 0xba, 0x01, 0xeb, 0x5d, 0xea, 0x6b, 0x8f, 0x60, 0x7b, 0x6e, 0x54, 0xbe, 0xbe, 0x48,
-	0x4b, 0x69, 0x28, 0x19, 0x6f, 0xad, 0x00, 0xca, 0x15, 0x7c, 0x03, 0xea, 0x6c, 0x6a, 0x35, 0x2b,
-	0x3c, 0x73, 0x84, 0xd1, 0x5a, 0x86, 0xc8, 0x03, 0x7f, 0x07, 0x5b, 0xc5, 0x33, 0x62, 0x56, 0x38,
-	0x16, 0x30, 0xc6, 0xc1, 0x72, 0x4c, 0x31, 0x7c, 0x71, 0x56, 0x98, 0xd5, 0xba, 0x32, 0x4c, 0x65,
-	0xf8, 0x45, 0x53, 0xe0, 0x0c, 0x76, 0x4a, 0x57, 0xfe, 0x8d, 0x6b, 0xab, 0x9a, 0x93, 0x1c, 0xae,
-	0x04, 0xcb, 0x79, 0x1e, 0xc1, 0xf6, 0xdc, 0xe5, 0xad, 0x6a, 0x70, 0x11, 0x54, 0xd9, 0xe0, 0x45,
-	0xd7, 0x55, 0x73, 0xe1, 0xa5, 0xf9, 0x1b, 0x72, 0xbf, 0xc2, 0x7b, 0x0e, 0x65, 0xbc, 0xbd, 0x0a,
-	0x2a, 0x23, 0x31, 0x36, 0x7e, 0x4a, 0x5f, 0xad, 0x27, 0xdf, 0x3f, 0xbb, 0x68, 0x28, 0xcf, 0x2f,
-	0x1a, 0xca, 0xbf, 0x17, 0x0d, 0xe5, 0xd7, 0xcb, 0xc6, 0xda, 0xf3, 0xcb, 0xc6, 0xda, 0xdf, 0x97,
-	0x8d, 0xb5, 0x6f, 0x3f, 0xf1, 0x03, 0x36, 0x18, 0xf5, 0x2d, 0x97, 0x84, 0xb6, 0x08, 0x7c, 0xc8,
-	0x5f, 0x77, 0x2e, 0x19, 0xca, 0x75, 0x69, 0x29, 0xdf, 0xc1, 0xe9, 0x3d, 0xa4, 0xd9, 0xbf, 0x8c,
-	0xfe, 0x26, 0x07, 0xbd, 0xfb, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x05, 0x77, 0xb2, 0x4c,
-	0x09, 0x00, 0x00,
+func (m *MsgRetryAction) Reset()         { *m = MsgRetryAction{} }
+func (m *MsgRetryAction) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryAction) ProtoMessage()    {}
+func (*MsgRetryAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{16}
+}
+func (m *MsgRetryAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryAction.Merge(m, src)
+}
+func (m *MsgRetryAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryAction.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgRetryAction proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgRetryAction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// NewAction creates a new Action.
-	NewAction(ctx context.Context, in *MsgNewAction, opts ...grpc.CallOption) (*MsgNewActionResponse, error)
-	// Checks a pending action and executes it if its in a valid state.
-	CheckAction(ctx context.Context, in *MsgCheckAction, opts ...grpc.CallOption) (*MsgCheckActionResponse, error)
-	// Create a new Template.
-	NewTemplate(ctx context.Context, in *MsgNewTemplate, opts ...grpc.CallOption) (*MsgNewTemplateResponse, error)
-	// Update an existing act name and definition.
-	UpdateTemplate(ctx context.Context, in *MsgUpdateTemplate, opts ...grpc.CallOption) (*MsgUpdateTemplateResponse, error)
-	// Revoke an existing Action while in pending state.
-	RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error)
-	// Vote for or against a particular Action.
-	VoteForAction(ctx context.Context, in *MsgVoteForAction, opts ...grpc.CallOption) (*MsgVoteForActionResponse, error)
+func (m *MsgRetryAction) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+type MsgRetryActionResponse struct {
+	// status of the action after the retry, ACTION_STATUS_FAILED if the
+	// execution failed again.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRetryActionResponse) Reset()         { *m = MsgRetryActionResponse{} }
+func (m *MsgRetryActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryActionResponse) ProtoMessage()    {}
+func (*MsgRetryActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{17}
+}
+func (m *MsgRetryActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryActionResponse.Merge(m, src)
+}
+func (m *MsgRetryActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryActionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRetryActionResponse proto.InternalMessageInfo
+
+func (m *MsgRetryActionResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) NewAction(ctx context.Context, in *MsgNewAction, opts ...grpc.CallOption) (*MsgNewActionResponse, error) {
-	out := new(MsgNewActionResponse)
-	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/NewAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgSubmitSignedVotes struct {
+	// relayer is the address submitting the votes, it doesn't need to be one
+	// of the participants.
+	Relayer  string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	// votes signed off-chain by their participants.
+	Votes []SignedVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *MsgSubmitSignedVotes) Reset()         { *m = MsgSubmitSignedVotes{} }
+func (m *MsgSubmitSignedVotes) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSignedVotes) ProtoMessage()    {}
+func (*MsgSubmitSignedVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{18}
+}
+func (m *MsgSubmitSignedVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSignedVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSignedVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitSignedVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSignedVotes.Merge(m, src)
+}
+func (m *MsgSubmitSignedVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSignedVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSignedVotes.DiscardUnknown(m)
 }
 
-func (c *msgClient) CheckAction(ctx context.Context, in *MsgCheckAction, opts ...grpc.CallOption) (*MsgCheckActionResponse, error) {
-	out := new(MsgCheckActionResponse)
-	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/CheckAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitSignedVotes proto.InternalMessageInfo
+
+func (m *MsgSubmitSignedVotes) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) NewTemplate(ctx context.Context, in *MsgNewTemplate, opts ...grpc.CallOption) (*MsgNewTemplateResponse, error) {
-	out := new(MsgNewTemplateResponse)
-	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/NewTemplate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSignedVotes) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateTemplate(ctx context.Context, in *MsgUpdateTemplate, opts ...grpc.CallOption) (*MsgUpdateTemplateResponse, error) {
-	out := new(MsgUpdateTemplateResponse)
-	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/UpdateTemplate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSignedVotes) GetVotes() []SignedVote {
+	if m != nil {
+		return m.Votes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error) {
-	out := new(MsgRevokeActionResponse)
-	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/RevokeAction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSubmitSignedVotesResponse struct {
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (c *msgClient) VoteForAction(ctx context.Context, in *MsgVoteForAction, opts ...grpc.CallOption) (*MsgVoteForActionResponse, error) {
-	out := new(MsgVoteForActionResponse)
-	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/VoteForAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSignedVotesResponse) Reset()         { *m = MsgSubmitSignedVotesResponse{} }
+func (m *MsgSubmitSignedVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSignedVotesResponse) ProtoMessage()    {}
+func (*MsgSubmitSignedVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{19}
+}
+func (m *MsgSubmitSignedVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSignedVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSignedVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// NewAction creates a new Action.
-	NewAction(context.Context, *MsgNewAction) (*MsgNewActionResponse, error)
-	// Checks a pending action and executes it if its in a valid state.
-	CheckAction(context.Context, *MsgCheckAction) (*MsgCheckActionResponse, error)
-	// Create a new Template.
-	NewTemplate(context.Context, *MsgNewTemplate) (*MsgNewTemplateResponse, error)
-	// Update an existing act name and definition.
-	UpdateTemplate(context.Context, *MsgUpdateTemplate) (*MsgUpdateTemplateResponse, error)
-	// Revoke an existing Action while in pending state.
-	RevokeAction(context.Context, *MsgRevokeAction) (*MsgRevokeActionResponse, error)
-	// Vote for or against a particular Action.
-	VoteForAction(context.Context, *MsgVoteForAction) (*MsgVoteForActionResponse, error)
+func (m *MsgSubmitSignedVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSignedVotesResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSubmitSignedVotesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSubmitSignedVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSignedVotesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) NewAction(ctx context.Context, req *MsgNewAction) (*MsgNewActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewAction not implemented")
+
+var xxx_messageInfo_MsgSubmitSignedVotesResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitSignedVotesResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) CheckAction(ctx context.Context, req *MsgCheckAction) (*MsgCheckActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckAction not implemented")
+
+type MsgDelegateVote struct {
+	// delegator is the address delegating its votes.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the address voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// space_id restricts the delegation to the Actions of a Space, 0 for no
+	// restriction.
+	SpaceId uint64 `protobuf:"varint,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// template_id restricts the delegation to the Actions created with a
+	// Template, 0 for no restriction.
+	TemplateId uint64 `protobuf:"varint,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// expires_at is the time after which the delegation is no longer active,
+	// unset if the delegation doesn't expire.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+}
+
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{20}
+}
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) NewTemplate(ctx context.Context, req *MsgNewTemplate) (*MsgNewTemplateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewTemplate not implemented")
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateTemplate(ctx context.Context, req *MsgUpdateTemplate) (*MsgUpdateTemplateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RevokeAction(ctx context.Context, req *MsgRevokeAction) (*MsgRevokeActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeAction not implemented")
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) VoteForAction(ctx context.Context, req *MsgVoteForAction) (*MsgVoteForActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteForAction not implemented")
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_NewAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Msg/NewAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewAction(ctx, req.(*MsgNewAction))
+func (m *MsgDelegateVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CheckAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCheckAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CheckAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Msg/CheckAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CheckAction(ctx, req.(*MsgCheckAction))
+func (m *MsgDelegateVote) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_NewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewTemplate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewTemplate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Msg/NewTemplate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewTemplate(ctx, req.(*MsgNewTemplate))
+func (m *MsgDelegateVote) GetTemplateId() uint64 {
+	if m != nil {
+		return m.TemplateId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTemplate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTemplate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Msg/UpdateTemplate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTemplate(ctx, req.(*MsgUpdateTemplate))
+func (m *MsgDelegateVote) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RevokeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Msg/RevokeAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeAction(ctx, req.(*MsgRevokeAction))
+type MsgDelegateVoteResponse struct {
+	// id of the delegation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{21}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
 }
 
-func _Msg_VoteForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteForAction)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
+func (m *MsgDelegateVoteResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteForAction(ctx, in)
+	return 0
+}
+
+type MsgRevokeVoteDelegation struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRevokeVoteDelegation) Reset()         { *m = MsgRevokeVoteDelegation{} }
+func (m *MsgRevokeVoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoteDelegation) ProtoMessage()    {}
+func (*MsgRevokeVoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{22}
+}
+func (m *MsgRevokeVoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.act.v1beta1.Msg/VoteForAction",
+}
+func (m *MsgRevokeVoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoteDelegation.Merge(m, src)
+}
+func (m *MsgRevokeVoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeVoteDelegation proto.InternalMessageInfo
+
+func (m *MsgRevokeVoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteForAction(ctx, req.(*MsgVoteForAction))
+	return ""
+}
+
+func (m *MsgRevokeVoteDelegation) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "warden.act.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "NewAction",
-			Handler:    _Msg_NewAction_Handler,
-		},
-		{
-			MethodName: "CheckAction",
-			Handler:    _Msg_CheckAction_Handler,
-		},
-		{
-			MethodName: "NewTemplate",
-			Handler:    _Msg_NewTemplate_Handler,
-		},
-		{
-			MethodName: "UpdateTemplate",
-			Handler:    _Msg_UpdateTemplate_Handler,
-		},
-		{
-			MethodName: "RevokeAction",
-			Handler:    _Msg_RevokeAction_Handler,
-		},
-		{
-			MethodName: "VoteForAction",
-			Handler:    _Msg_VoteForAction_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "warden/act/v1beta1/tx.proto",
+type MsgRevokeVoteDelegationResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeVoteDelegationResponse) Reset()         { *m = MsgRevokeVoteDelegationResponse{} }
+func (m *MsgRevokeVoteDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoteDelegationResponse) ProtoMessage()    {}
+func (*MsgRevokeVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{23}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeVoteDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevokeVoteDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoteDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeVoteDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoteDelegationResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeVoteDelegationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeVoteDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoteDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgNewAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgRevokeVoteDelegationResponse proto.InternalMessageInfo
 
-func (m *MsgNewAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetContractTemplates struct {
+	// sender is the contract itself or its admin.
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// approve_template_id and reject_template_id are the templates of the
+	// Actions executing the contract, both zero to remove them.
+	ApproveTemplateId uint64 `protobuf:"varint,3,opt,name=approve_template_id,json=approveTemplateId,proto3" json:"approve_template_id,omitempty"`
+	RejectTemplateId  uint64 `protobuf:"varint,4,opt,name=reject_template_id,json=rejectTemplateId,proto3" json:"reject_template_id,omitempty"`
 }
 
-func (m *MsgNewAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpectedRejectExpression) > 0 {
-		i -= len(m.ExpectedRejectExpression)
-		copy(dAtA[i:], m.ExpectedRejectExpression)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedRejectExpression)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ExpectedApproveExpression) > 0 {
-		i -= len(m.ExpectedApproveExpression)
-		copy(dAtA[i:], m.ExpectedApproveExpression)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedApproveExpression)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ActionTimeoutHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ActionTimeoutHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSetContractTemplates) Reset()         { *m = MsgSetContractTemplates{} }
+func (m *MsgSetContractTemplates) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractTemplates) ProtoMessage()    {}
+func (*MsgSetContractTemplates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{24}
+}
+func (m *MsgSetContractTemplates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractTemplates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgNewActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetContractTemplates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractTemplates.Merge(m, src)
 }
-
-func (m *MsgNewActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetContractTemplates) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgNewActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetContractTemplates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractTemplates.DiscardUnknown(m)
 }
 
-func (m *MsgNewTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetContractTemplates proto.InternalMessageInfo
+
+func (m *MsgSetContractTemplates) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgNewTemplate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetContractTemplates) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func (m *MsgNewTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Definition) > 0 {
-		i -= len(m.Definition)
-		copy(dAtA[i:], m.Definition)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Definition)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetContractTemplates) GetApproveTemplateId() uint64 {
+	if m != nil {
+		return m.ApproveTemplateId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgNewTemplateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetContractTemplates) GetRejectTemplateId() uint64 {
+	if m != nil {
+		return m.RejectTemplateId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgNewTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetContractTemplatesResponse struct {
 }
 
-func (m *MsgNewTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetContractTemplatesResponse) Reset()         { *m = MsgSetContractTemplatesResponse{} }
+func (m *MsgSetContractTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractTemplatesResponse) ProtoMessage()    {}
+func (*MsgSetContractTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f059980976488200, []int{25}
 }
-
-func (m *MsgUpdateTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetContractTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateTemplate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Definition) > 0 {
-		i -= len(m.Definition)
-		copy(dAtA[i:], m.Definition)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Definition)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetContractTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetContractTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractTemplatesResponse.Merge(m, src)
+}
+func (m *MsgSetContractTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractTemplatesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateTemplateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgSetContractTemplatesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "warden.act.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "warden.act.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgNewAction)(nil), "warden.act.v1beta1.MsgNewAction")
+	proto.RegisterType((*MsgNewActionResponse)(nil), "warden.act.v1beta1.MsgNewActionResponse")
+	proto.RegisterType((*MsgNewTemplate)(nil), "warden.act.v1beta1.MsgNewTemplate")
+	proto.RegisterType((*MsgNewTemplateResponse)(nil), "warden.act.v1beta1.MsgNewTemplateResponse")
+	proto.RegisterType((*MsgUpdateTemplate)(nil), "warden.act.v1beta1.MsgUpdateTemplate")
+	proto.RegisterType((*MsgUpdateTemplateResponse)(nil), "warden.act.v1beta1.MsgUpdateTemplateResponse")
+	proto.RegisterType((*MsgTransferTemplateOwnership)(nil), "warden.act.v1beta1.MsgTransferTemplateOwnership")
+	proto.RegisterType((*MsgTransferTemplateOwnershipResponse)(nil), "warden.act.v1beta1.MsgTransferTemplateOwnershipResponse")
+	proto.RegisterType((*MsgRevokeAction)(nil), "warden.act.v1beta1.MsgRevokeAction")
+	proto.RegisterType((*MsgRevokeActionResponse)(nil), "warden.act.v1beta1.MsgRevokeActionResponse")
+	proto.RegisterType((*MsgCheckAction)(nil), "warden.act.v1beta1.MsgCheckAction")
+	proto.RegisterType((*MsgCheckActionResponse)(nil), "warden.act.v1beta1.MsgCheckActionResponse")
+	proto.RegisterType((*MsgVoteForAction)(nil), "warden.act.v1beta1.MsgVoteForAction")
+	proto.RegisterType((*MsgVoteForActionResponse)(nil), "warden.act.v1beta1.MsgVoteForActionResponse")
+	proto.RegisterType((*MsgRetryAction)(nil), "warden.act.v1beta1.MsgRetryAction")
+	proto.RegisterType((*MsgRetryActionResponse)(nil), "warden.act.v1beta1.MsgRetryActionResponse")
+	proto.RegisterType((*MsgSubmitSignedVotes)(nil), "warden.act.v1beta1.MsgSubmitSignedVotes")
+	proto.RegisterType((*MsgSubmitSignedVotesResponse)(nil), "warden.act.v1beta1.MsgSubmitSignedVotesResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "warden.act.v1beta1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "warden.act.v1beta1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgRevokeVoteDelegation)(nil), "warden.act.v1beta1.MsgRevokeVoteDelegation")
+	proto.RegisterType((*MsgRevokeVoteDelegationResponse)(nil), "warden.act.v1beta1.MsgRevokeVoteDelegationResponse")
+	proto.RegisterType((*MsgSetContractTemplates)(nil), "warden.act.v1beta1.MsgSetContractTemplates")
+	proto.RegisterType((*MsgSetContractTemplatesResponse)(nil), "warden.act.v1beta1.MsgSetContractTemplatesResponse")
 }
 
-func (m *MsgUpdateTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("warden/act/v1beta1/tx.proto", fileDescriptor_f059980976488200) }
+
+var fileDescriptor_f059980976488200 = []byte{
+	// 1443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0x24, 0x4e, 0x62, 0x9f, 0xe4, 0xa5, 0xcd, 0xd4, 0x2f, 0xb5, 0x27, 0x79, 0x8e, 0x9f,
+	0x29, 0x28, 0xa4, 0xad, 0x9d, 0xa6, 0xa5, 0x42, 0x11, 0x50, 0x25, 0x4d, 0x81, 0x4a, 0x04, 0xd0,
+	0x24, 0x6d, 0x25, 0x24, 0x70, 0x6f, 0x66, 0x6e, 0xc6, 0x43, 0xe3, 0x99, 0xd1, 0xdc, 0x6b, 0xc7,
+	0xde, 0x21, 0x36, 0x48, 0x48, 0x48, 0xdd, 0xc1, 0x1f, 0xc0, 0x82, 0x65, 0x17, 0x6c, 0xf8, 0x0f,
+	0x2a, 0x56, 0x55, 0x57, 0xac, 0x00, 0xb5, 0x48, 0xdd, 0x22, 0xd6, 0x2c, 0xd0, 0xfd, 0x98, 0xeb,
+	0xf1, 0xc7, 0xd8, 0x8e, 0x54, 0x16, 0x6d, 0x7c, 0xe7, 0xfc, 0xce, 0xd7, 0xef, 0xcc, 0x3d, 0xe7,
+	0x68, 0x60, 0xf9, 0x04, 0x85, 0x36, 0xf6, 0x2a, 0xc8, 0xa2, 0x95, 0xe6, 0x95, 0x43, 0x4c, 0xd1,
+	0x95, 0x0a, 0x6d, 0x95, 0x83, 0xd0, 0xa7, 0xbe, 0xae, 0x0b, 0x61, 0x19, 0x59, 0xb4, 0x2c, 0x85,
+	0xc6, 0x22, 0xaa, 0xbb, 0x9e, 0x5f, 0xe1, 0xff, 0x0b, 0x98, 0x71, 0xde, 0xf2, 0x49, 0xdd, 0x27,
+	0x95, 0x3a, 0x71, 0x2a, 0xcd, 0x2b, 0xec, 0x8f, 0x14, 0xe4, 0x85, 0xa0, 0xca, 0x4f, 0x15, 0x71,
+	0x90, 0xa2, 0xac, 0xe3, 0x3b, 0xbe, 0x78, 0xce, 0x7e, 0x45, 0x0a, 0x8e, 0xef, 0x3b, 0xc7, 0xb8,
+	0xc2, 0x4f, 0x87, 0x8d, 0xa3, 0x0a, 0xf2, 0xda, 0x52, 0x54, 0xe8, 0x15, 0xd9, 0x8d, 0x10, 0x51,
+	0xd7, 0xf7, 0xa4, 0x7c, 0xb5, 0x57, 0x4e, 0xdd, 0x3a, 0x26, 0x14, 0xd5, 0x83, 0x08, 0x30, 0x20,
+	0xd3, 0x00, 0x85, 0xa8, 0x1e, 0x85, 0x74, 0x61, 0x00, 0x00, 0x59, 0xcc, 0x45, 0xb5, 0xe9, 0x53,
+	0x1c, 0x05, 0x4e, 0x6a, 0x2e, 0x3e, 0xb6, 0x2b, 0x88, 0x50, 0xf6, 0x4f, 0x3c, 0x2d, 0xfd, 0xa4,
+	0xc1, 0x99, 0x3d, 0xe2, 0xdc, 0x09, 0x6c, 0x44, 0xf1, 0xc7, 0xdc, 0xaa, 0x7e, 0x1d, 0x32, 0xa8,
+	0x41, 0x6b, 0x7e, 0xe8, 0xd2, 0x76, 0x4e, 0x2b, 0x6a, 0x6b, 0x99, 0x9d, 0xdc, 0xd3, 0x1f, 0x2f,
+	0x67, 0x25, 0x0f, 0xdb, 0xb6, 0x1d, 0x62, 0x42, 0xf6, 0x69, 0xe8, 0x7a, 0x8e, 0xd9, 0x81, 0xea,
+	0x6f, 0xc3, 0x8c, 0x88, 0x2b, 0x37, 0x59, 0xd4, 0xd6, 0xe6, 0x36, 0x8d, 0x72, 0x7f, 0x19, 0xca,
+	0xc2, 0xc7, 0x4e, 0xe6, 0xf1, 0xaf, 0xab, 0x13, 0x3f, 0xbc, 0x78, 0xb4, 0xae, 0x99, 0x52, 0x69,
+	0xab, 0xf2, 0xe5, 0x8b, 0x47, 0xeb, 0x1d, 0x73, 0x5f, 0xbf, 0x78, 0xb4, 0xbe, 0x22, 0x33, 0x6b,
+	0xf1, 0xdc, 0x7a, 0xe2, 0x2c, 0xe5, 0xe1, 0x7c, 0xcf, 0x23, 0x13, 0x93, 0xc0, 0xf7, 0x08, 0x2e,
+	0xfd, 0x3c, 0x09, 0xf3, 0x7b, 0xc4, 0xf9, 0x10, 0x9f, 0x6c, 0x73, 0x22, 0xf4, 0x1c, 0xcc, 0x5a,
+	0x21, 0x46, 0xd4, 0x0f, 0x45, 0x46, 0x66, 0x74, 0xd4, 0xcb, 0x30, 0x5b, 0xc7, 0x84, 0x20, 0x07,
+	0xcb, 0xb0, 0xb3, 0x65, 0x51, 0x91, 0x72, 0x54, 0x91, 0xf2, 0xb6, 0xd7, 0x36, 0x23, 0x90, 0xbe,
+	0x09, 0xff, 0x95, 0xe4, 0xb2, 0x42, 0xf9, 0x0d, 0x5a, 0xad, 0x61, 0xd7, 0xa9, 0xd1, 0xdc, 0x54,
+	0x51, 0x5b, 0x4b, 0x99, 0xe7, 0x84, 0xf0, 0x40, 0xc8, 0xde, 0xe7, 0x22, 0xfd, 0x1d, 0x58, 0xc6,
+	0xad, 0x00, 0x5b, 0x14, 0xdb, 0x55, 0x14, 0x04, 0xa1, 0xdf, 0xc4, 0x55, 0xdc, 0x0a, 0x18, 0x8d,
+	0xae, 0xef, 0xe5, 0x52, 0x3c, 0xa2, 0x7c, 0x04, 0xd9, 0x16, 0x88, 0x5b, 0x0a, 0xa0, 0xbf, 0x05,
+	0x86, 0xd2, 0x0f, 0xf1, 0xe7, 0xd8, 0xa2, 0x71, 0xf5, 0x69, 0xae, 0x9e, 0x8b, 0x10, 0x26, 0x07,
+	0xc4, 0xb4, 0x37, 0x20, 0x2d, 0x83, 0x27, 0xb9, 0x99, 0xe2, 0x54, 0x62, 0x8a, 0x0a, 0xb5, 0x35,
+	0xcf, 0x4a, 0x11, 0x31, 0x54, 0x7a, 0x0d, 0xb2, 0x71, 0x2e, 0x23, 0x92, 0xf5, 0x05, 0x98, 0x74,
+	0x6d, 0x4e, 0x67, 0xca, 0x9c, 0x74, 0xed, 0xd2, 0x9f, 0x1a, 0x2c, 0x08, 0xe0, 0x01, 0xae, 0x07,
+	0xc7, 0x88, 0xe2, 0x21, 0xb4, 0xeb, 0x90, 0xf2, 0x50, 0x5d, 0x70, 0x9e, 0x31, 0xf9, 0x6f, 0xbd,
+	0x00, 0x60, 0xe3, 0x23, 0xd7, 0x73, 0x99, 0x1b, 0xce, 0x67, 0xc6, 0x8c, 0x3d, 0xd1, 0x3f, 0x80,
+	0x33, 0xb8, 0x85, 0xad, 0x06, 0x67, 0xdf, 0xc6, 0xc7, 0xa8, 0xcd, 0xa9, 0x9b, 0xdb, 0xcc, 0xf7,
+	0xe5, 0xb3, 0x2b, 0x2f, 0xd9, 0x4e, 0x9a, 0xbd, 0x68, 0xdf, 0xfd, 0xb6, 0xaa, 0x99, 0x0b, 0x4a,
+	0x77, 0x97, 0xa9, 0xea, 0xd7, 0x60, 0xc9, 0xf1, 0x9b, 0x38, 0xf4, 0x90, 0x67, 0xe1, 0x2a, 0x95,
+	0x21, 0x57, 0x5d, 0x9b, 0x13, 0x9a, 0x32, 0xb3, 0x1d, 0x69, 0x94, 0xcf, 0x6d, 0xbb, 0x87, 0x9a,
+	0x35, 0x58, 0xea, 0xce, 0x38, 0x91, 0x9c, 0xbf, 0x27, 0x61, 0x51, 0xbd, 0xad, 0x63, 0xf0, 0x23,
+	0xf4, 0x27, 0x23, 0x7d, 0xc5, 0xd7, 0x54, 0x22, 0x5f, 0xa9, 0x71, 0xf8, 0x9a, 0xfe, 0x37, 0xf8,
+	0x9a, 0x49, 0xe6, 0x8b, 0x69, 0x35, 0x78, 0xce, 0xd5, 0xde, 0x50, 0x66, 0x8b, 0xda, 0x5a, 0xda,
+	0xcc, 0x0a, 0xe9, 0xad, 0x6e, 0x5f, 0xdb, 0xf0, 0x3f, 0xa9, 0x95, 0xe0, 0x32, 0xcd, 0x95, 0x0d,
+	0x01, 0x7a, 0x6f, 0x74, 0xa1, 0x96, 0x21, 0xdf, 0xc7, 0xbe, 0xea, 0x16, 0xdf, 0x68, 0xb0, 0xb2,
+	0x47, 0x9c, 0x83, 0x10, 0x79, 0xe4, 0x08, 0x87, 0x91, 0xfc, 0xa3, 0x13, 0x0f, 0x87, 0xa4, 0xe6,
+	0x06, 0xa7, 0x28, 0xd3, 0x1b, 0x90, 0xf1, 0xf0, 0x49, 0xd5, 0x67, 0xaa, 0xb9, 0xa9, 0x11, 0xbd,
+	0x33, 0xed, 0xe1, 0x13, 0xee, 0xa4, 0xef, 0xc2, 0x5d, 0x18, 0x16, 0x8e, 0x8a, 0xfb, 0x2e, 0xef,
+	0xdd, 0x26, 0x6e, 0xfa, 0x0f, 0xf0, 0xc8, 0x3e, 0xb7, 0x0c, 0x19, 0xd9, 0xb7, 0x54, 0xc0, 0x69,
+	0xf1, 0xa0, 0x8f, 0x2c, 0xd1, 0x58, 0xe3, 0x76, 0x95, 0xcb, 0x3b, 0xfc, 0x8a, 0xdf, 0xac, 0x61,
+	0xeb, 0xc1, 0xcb, 0xf4, 0xb8, 0x01, 0x4b, 0xdd, 0x66, 0xd5, 0x3d, 0x5a, 0x82, 0x19, 0x42, 0x11,
+	0x6d, 0x10, 0x69, 0x5d, 0x9e, 0x4a, 0xdf, 0x6b, 0x70, 0x76, 0x8f, 0x38, 0x77, 0x7d, 0x8a, 0xdf,
+	0xf5, 0x43, 0x19, 0x4b, 0x11, 0xe6, 0x02, 0x14, 0x52, 0xd7, 0x72, 0x03, 0xe4, 0x51, 0xa9, 0x11,
+	0x7f, 0x34, 0x34, 0x26, 0xfd, 0x06, 0x64, 0xd8, 0xc0, 0xac, 0xd2, 0x76, 0x20, 0x2e, 0xda, 0xc2,
+	0x66, 0x69, 0xd0, 0x0c, 0x13, 0xde, 0x98, 0xeb, 0x83, 0x76, 0x80, 0xcd, 0x74, 0x53, 0xfe, 0xda,
+	0x3a, 0xcb, 0x92, 0x8a, 0xfb, 0x2b, 0x6d, 0x42, 0xae, 0x37, 0xca, 0x91, 0xa9, 0x09, 0x8e, 0x4d,
+	0x4c, 0xc3, 0xf6, 0xcb, 0xe7, 0x38, 0x66, 0x76, 0x64, 0x20, 0xdf, 0x6a, 0xbc, 0xf3, 0xef, 0x37,
+	0x0e, 0xeb, 0x2e, 0xdd, 0x77, 0x1d, 0x0f, 0xdb, 0x2c, 0x13, 0xc2, 0xe2, 0x09, 0xd9, 0x3d, 0xc5,
+	0x2a, 0x1e, 0x79, 0x1c, 0xce, 0xef, 0x16, 0x4c, 0x33, 0xaa, 0x48, 0x6e, 0x8a, 0x4f, 0xa1, 0xc2,
+	0x20, 0x6e, 0x3b, 0x6e, 0x76, 0x52, 0xac, 0x15, 0x99, 0x42, 0x45, 0xe6, 0x22, 0xdd, 0x94, 0xae,
+	0xc3, 0xca, 0xa0, 0xc0, 0x46, 0x66, 0xf4, 0x97, 0x58, 0x77, 0x76, 0xf1, 0x31, 0x76, 0x10, 0xc5,
+	0x4c, 0x49, 0x5f, 0x81, 0x8c, 0x2d, 0xce, 0x8a, 0xde, 0xce, 0x03, 0xfd, 0x1a, 0xa4, 0xe5, 0x41,
+	0xce, 0xaa, 0x61, 0xf7, 0x39, 0x42, 0xea, 0x79, 0x48, 0x93, 0x00, 0x59, 0xbc, 0x55, 0x89, 0xbd,
+	0x60, 0x96, 0x9f, 0x6f, 0xdb, 0xfa, 0x2a, 0xcc, 0xc5, 0x1b, 0x59, 0x8a, 0x4b, 0x81, 0x76, 0x3a,
+	0xe6, 0x0d, 0x00, 0xdc, 0x0a, 0xdc, 0x10, 0x93, 0x2a, 0xa2, 0xb2, 0x61, 0x1b, 0x7d, 0x0d, 0xfb,
+	0x20, 0xda, 0x12, 0x77, 0x52, 0x0f, 0x59, 0xb7, 0xce, 0x48, 0x9d, 0x6d, 0xba, 0xb5, 0xc0, 0x17,
+	0x29, 0x95, 0x42, 0xe9, 0x75, 0x38, 0xdf, 0x93, 0x73, 0xe2, 0x94, 0xba, 0x17, 0xbb, 0xf9, 0x0c,
+	0x28, 0x95, 0xd8, 0x3b, 0x38, 0x9c, 0xa6, 0x9e, 0x3e, 0xd8, 0x17, 0xc3, 0xff, 0x61, 0x35, 0xc1,
+	0xb0, 0x6a, 0x2d, 0x4f, 0x35, 0xee, 0x7c, 0x1f, 0xd3, 0x9b, 0xbe, 0x47, 0x43, 0x64, 0xd1, 0xa8,
+	0xf3, 0x11, 0x5e, 0x4f, 0xec, 0xd9, 0xea, 0x7d, 0x93, 0x27, 0x56, 0x1d, 0x4b, 0x82, 0x47, 0x57,
+	0x27, 0x42, 0xea, 0x65, 0x38, 0x17, 0x6d, 0x61, 0xf1, 0x52, 0x88, 0x42, 0x2d, 0x4a, 0x51, 0x6c,
+	0x86, 0x5d, 0x02, 0x5d, 0x6e, 0x5d, 0xfd, 0x95, 0x3b, 0x2b, 0x24, 0xb1, 0xc1, 0x33, 0xc7, 0x52,
+	0x97, 0x01, 0xca, 0xbc, 0x07, 0xe5, 0x14, 0xe5, 0xbd, 0xf9, 0x07, 0xc0, 0xd4, 0x1e, 0x71, 0xf4,
+	0xfb, 0x30, 0xdf, 0xb5, 0x86, 0xbf, 0x32, 0xe8, 0x7a, 0xf4, 0x2c, 0xbc, 0xc6, 0xc5, 0x31, 0x40,
+	0xaa, 0xda, 0xf7, 0x20, 0xd3, 0xd9, 0x88, 0x8b, 0x09, 0x9a, 0x0a, 0x61, 0xac, 0x8d, 0x42, 0x28,
+	0xc3, 0x9f, 0xc2, 0x5c, 0x7c, 0x24, 0x94, 0x12, 0x14, 0x63, 0x18, 0x63, 0x7d, 0x34, 0x26, 0x6e,
+	0x3e, 0xbe, 0x54, 0x96, 0x92, 0xe3, 0x8a, 0x30, 0xc6, 0xfa, 0x68, 0x8c, 0x32, 0x7f, 0x04, 0x0b,
+	0x3d, 0x6b, 0xd9, 0xab, 0x43, 0x59, 0x55, 0x4e, 0x2e, 0x8f, 0x05, 0x53, 0x7e, 0xbe, 0xd2, 0x20,
+	0x9f, 0xbc, 0x63, 0x6c, 0x24, 0x18, 0x4b, 0xd4, 0x30, 0xde, 0x3c, 0xad, 0x86, 0x8a, 0xe4, 0x3e,
+	0xcc, 0x77, 0x6d, 0x0d, 0x49, 0xaf, 0x5a, 0x1c, 0x64, 0x5c, 0x1c, 0x03, 0xa4, 0x3c, 0x58, 0xf0,
+	0x9f, 0xee, 0xd1, 0x7c, 0x21, 0x41, 0xbb, 0x0b, 0x65, 0x5c, 0x1a, 0x07, 0x15, 0x7f, 0x2f, 0xe2,
+	0x53, 0xb2, 0x94, 0x18, 0xa0, 0xc2, 0x18, 0xeb, 0xa3, 0x31, 0xca, 0xbc, 0x0f, 0x8b, 0xfd, 0xa3,
+	0x2f, 0xe9, 0x52, 0xf4, 0x21, 0x8d, 0x8d, 0x71, 0x91, 0xf1, 0xb2, 0x74, 0x4d, 0xa6, 0xa4, 0xb2,
+	0xc4, 0x41, 0xc6, 0xc5, 0x31, 0x40, 0xca, 0x43, 0x0b, 0xb2, 0x03, 0x9b, 0xfb, 0xf0, 0xda, 0x76,
+	0x83, 0x8d, 0xab, 0xa7, 0x00, 0xc7, 0x3d, 0x0f, 0xec, 0xec, 0x49, 0x9e, 0x07, 0x81, 0x8d, 0xab,
+	0xa7, 0x00, 0x47, 0x9e, 0x8d, 0xe9, 0x2f, 0xd8, 0x67, 0x86, 0x9d, 0xcf, 0x1e, 0x3f, 0x2b, 0x68,
+	0x4f, 0x9e, 0x15, 0xb4, 0xdf, 0x9f, 0x15, 0xb4, 0x87, 0xcf, 0x0b, 0x13, 0x4f, 0x9e, 0x17, 0x26,
+	0x7e, 0x79, 0x5e, 0x98, 0xf8, 0x64, 0xd7, 0x71, 0x69, 0xad, 0x71, 0x58, 0xb6, 0xfc, 0x7a, 0x45,
+	0xd8, 0xbf, 0xcc, 0xc7, 0xac, 0xe5, 0x1f, 0xcb, 0x73, 0xcf, 0x51, 0x7e, 0x8f, 0x60, 0x6b, 0x22,
+	0x89, 0xbe, 0xb8, 0x1c, 0xce, 0x70, 0xd0, 0xd5, 0x7f, 0x06, 0x00, 0xc7, 0xfa, 0xe9, 0xb1, 0x99,
+	0x12, 0x00, 0x00,
 }
 
-func (m *MsgUpdateTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// NewAction creates a new Action.
+	NewAction(ctx context.Context, in *MsgNewAction, opts ...grpc.CallOption) (*MsgNewActionResponse, error)
+	// Checks a pending action and executes it if its in a valid state.
+	CheckAction(ctx context.Context, in *MsgCheckAction, opts ...grpc.CallOption) (*MsgCheckActionResponse, error)
+	// Create a new Template.
+	NewTemplate(ctx context.Context, in *MsgNewTemplate, opts ...grpc.CallOption) (*MsgNewTemplateResponse, error)
+	// Update an existing act name and definition.
+	UpdateTemplate(ctx context.Context, in *MsgUpdateTemplate, opts ...grpc.CallOption) (*MsgUpdateTemplateResponse, error)
+	// Transfer the ownership of an existing Template.
+	TransferTemplateOwnership(ctx context.Context, in *MsgTransferTemplateOwnership, opts ...grpc.CallOption) (*MsgTransferTemplateOwnershipResponse, error)
+	// Revoke an existing Action while in pending state.
+	RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error)
+	// Vote for or against a particular Action.
+	VoteForAction(ctx context.Context, in *MsgVoteForAction, opts ...grpc.CallOption) (*MsgVoteForActionResponse, error)
+	// Retry the execution of a failed Action.
+	RetryAction(ctx context.Context, in *MsgRetryAction, opts ...grpc.CallOption) (*MsgRetryActionResponse, error)
+	// Submit a batch of votes signed off-chain for a particular Action.
+	SubmitSignedVotes(ctx context.Context, in *MsgSubmitSignedVotes, opts ...grpc.CallOption) (*MsgSubmitSignedVotesResponse, error)
+	// Delegate the votes of an address to another address.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// Revoke a vote delegation.
+	RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error)
+	// Set the templates of the Actions executing a CosmWasm contract.
+	SetContractTemplates(ctx context.Context, in *MsgSetContractTemplates, opts ...grpc.CallOption) (*MsgSetContractTemplatesResponse, error)
 }
 
-func (m *MsgRevokeAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgRevokeAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgRevokeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRevokeActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) NewAction(ctx context.Context, in *MsgNewAction, opts ...grpc.CallOption) (*MsgNewActionResponse, error) {
+	out := new(MsgNewActionResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/NewAction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRevokeActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CheckAction(ctx context.Context, in *MsgCheckAction, opts ...grpc.CallOption) (*MsgCheckActionResponse, error) {
+	out := new(MsgCheckActionResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/CheckAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRevokeActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) NewTemplate(ctx context.Context, in *MsgNewTemplate, opts ...grpc.CallOption) (*MsgNewTemplateResponse, error) {
+	out := new(MsgNewTemplateResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/NewTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCheckAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateTemplate(ctx context.Context, in *MsgUpdateTemplate, opts ...grpc.CallOption) (*MsgUpdateTemplateResponse, error) {
+	out := new(MsgUpdateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/UpdateTemplate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCheckAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferTemplateOwnership(ctx context.Context, in *MsgTransferTemplateOwnership, opts ...grpc.CallOption) (*MsgTransferTemplateOwnershipResponse, error) {
+	out := new(MsgTransferTemplateOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/TransferTemplateOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCheckAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevokeAction(ctx context.Context, in *MsgRevokeAction, opts ...grpc.CallOption) (*MsgRevokeActionResponse, error) {
+	out := new(MsgRevokeActionResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/RevokeAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCheckActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VoteForAction(ctx context.Context, in *MsgVoteForAction, opts ...grpc.CallOption) (*MsgVoteForActionResponse, error) {
+	out := new(MsgVoteForActionResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/VoteForAction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCheckActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RetryAction(ctx context.Context, in *MsgRetryAction, opts ...grpc.CallOption) (*MsgRetryActionResponse, error) {
+	out := new(MsgRetryActionResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/RetryAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCheckActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubmitSignedVotes(ctx context.Context, in *MsgSubmitSignedVotes, opts ...grpc.CallOption) (*MsgSubmitSignedVotesResponse, error) {
+	out := new(MsgSubmitSignedVotesResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/SubmitSignedVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVoteForAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/DelegateVote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgVoteForAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgVoteForAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VoteType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ActionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error) {
+	out := new(MsgRevokeVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/RevokeVoteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVoteForActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetContractTemplates(ctx context.Context, in *MsgSetContractTemplates, opts ...grpc.CallOption) (*MsgSetContractTemplatesResponse, error) {
+	out := new(MsgSetContractTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/warden.act.v1beta1.Msg/SetContractTemplates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgVoteForActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// NewAction creates a new Action.
+	NewAction(context.Context, *MsgNewAction) (*MsgNewActionResponse, error)
+	// Checks a pending action and executes it if its in a valid state.
+	CheckAction(context.Context, *MsgCheckAction) (*MsgCheckActionResponse, error)
+	// Create a new Template.
+	NewTemplate(context.Context, *MsgNewTemplate) (*MsgNewTemplateResponse, error)
+	// Update an existing act name and definition.
+	UpdateTemplate(context.Context, *MsgUpdateTemplate) (*MsgUpdateTemplateResponse, error)
+	// Transfer the ownership of an existing Template.
+	TransferTemplateOwnership(context.Context, *MsgTransferTemplateOwnership) (*MsgTransferTemplateOwnershipResponse, error)
+	// Revoke an existing Action while in pending state.
+	RevokeAction(context.Context, *MsgRevokeAction) (*MsgRevokeActionResponse, error)
+	// Vote for or against a particular Action.
+	VoteForAction(context.Context, *MsgVoteForAction) (*MsgVoteForActionResponse, error)
+	// Retry the execution of a failed Action.
+	RetryAction(context.Context, *MsgRetryAction) (*MsgRetryActionResponse, error)
+	// Submit a batch of votes signed off-chain for a particular Action.
+	SubmitSignedVotes(context.Context, *MsgSubmitSignedVotes) (*MsgSubmitSignedVotesResponse, error)
+	// Delegate the votes of an address to another address.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// Revoke a vote delegation.
+	RevokeVoteDelegation(context.Context, *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error)
+	// Set the templates of the Actions executing a CosmWasm contract.
+	SetContractTemplates(context.Context, *MsgSetContractTemplates) (*MsgSetContractTemplatesResponse, error)
 }
 
-func (m *MsgVoteForActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (*UnimplementedMsgServer) NewAction(ctx context.Context, req *MsgNewAction) (*MsgNewActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewAction not implemented")
+}
+func (*UnimplementedMsgServer) CheckAction(ctx context.Context, req *MsgCheckAction) (*MsgCheckActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAction not implemented")
+}
+func (*UnimplementedMsgServer) NewTemplate(ctx context.Context, req *MsgNewTemplate) (*MsgNewTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewTemplate not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTemplate(ctx context.Context, req *MsgUpdateTemplate) (*MsgUpdateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (*UnimplementedMsgServer) TransferTemplateOwnership(ctx context.Context, req *MsgTransferTemplateOwnership) (*MsgTransferTemplateOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTemplateOwnership not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAction(ctx context.Context, req *MsgRevokeAction) (*MsgRevokeActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAction not implemented")
+}
+func (*UnimplementedMsgServer) VoteForAction(ctx context.Context, req *MsgVoteForAction) (*MsgVoteForActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteForAction not implemented")
+}
+func (*UnimplementedMsgServer) RetryAction(ctx context.Context, req *MsgRetryAction) (*MsgRetryActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryAction not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSignedVotes(ctx context.Context, req *MsgSubmitSignedVotes) (*MsgSubmitSignedVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignedVotes not implemented")
+}
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) RevokeVoteDelegation(ctx context.Context, req *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVoteDelegation not implemented")
+}
+func (*UnimplementedMsgServer) SetContractTemplates(ctx context.Context, req *MsgSetContractTemplates) (*MsgSetContractTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractTemplates not implemented")
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgNewAction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ActionTimeoutHeight != 0 {
-		n += 1 + sovTx(uint64(m.ActionTimeoutHeight))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.ExpectedApproveExpression)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/UpdateParams",
 	}
-	l = len(m.ExpectedRejectExpression)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgNewActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_NewAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).NewAction(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/NewAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewAction(ctx, req.(*MsgNewAction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgNewTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CheckAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCheckAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CheckAction(ctx, in)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/CheckAction",
 	}
-	l = len(m.Definition)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CheckAction(ctx, req.(*MsgCheckAction))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgNewTemplateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_NewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).NewTemplate(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/NewTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewTemplate(ctx, req.(*MsgNewTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateTemplate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTemplate(ctx, in)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/UpdateTemplate",
 	}
-	l = len(m.Definition)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTemplate(ctx, req.(*MsgUpdateTemplate))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateTemplateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferTemplateOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTemplateOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTemplateOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/TransferTemplateOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTemplateOwnership(ctx, req.(*MsgTransferTemplateOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevokeAction) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RevokeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAction(ctx, in)
 	}
-	if m.ActionId != 0 {
-		n += 1 + sovTx(uint64(m.ActionId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/RevokeAction",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAction(ctx, req.(*MsgRevokeAction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevokeActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_VoteForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteForAction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).VoteForAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/VoteForAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteForAction(ctx, req.(*MsgVoteForAction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCheckAction) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RetryAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/RetryAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryAction(ctx, req.(*MsgRetryAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSignedVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSignedVotes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSignedVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/SubmitSignedVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSignedVotes(ctx, req.(*MsgSubmitSignedVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeVoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVoteDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/RevokeVoteDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, req.(*MsgRevokeVoteDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractTemplates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.act.v1beta1.Msg/SetContractTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractTemplates(ctx, req.(*MsgSetContractTemplates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.act.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "NewAction",
+			Handler:    _Msg_NewAction_Handler,
+		},
+		{
+			MethodName: "CheckAction",
+			Handler:    _Msg_CheckAction_Handler,
+		},
+		{
+			MethodName: "NewTemplate",
+			Handler:    _Msg_NewTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _Msg_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "TransferTemplateOwnership",
+			Handler:    _Msg_TransferTemplateOwnership_Handler,
+		},
+		{
+			MethodName: "RevokeAction",
+			Handler:    _Msg_RevokeAction_Handler,
+		},
+		{
+			MethodName: "VoteForAction",
+			Handler:    _Msg_VoteForAction_Handler,
+		},
+		{
+			MethodName: "RetryAction",
+			Handler:    _Msg_RetryAction_Handler,
+		},
+		{
+			MethodName: "SubmitSignedVotes",
+			Handler:    _Msg_SubmitSignedVotes_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "RevokeVoteDelegation",
+			Handler:    _Msg_RevokeVoteDelegation_Handler,
+		},
+		{
+			MethodName: "SetContractTemplates",
+			Handler:    _Msg_SetContractTemplates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/act/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ActionId != 0 {
-		n += 1 + sovTx(uint64(m.ActionId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCheckActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ExpectedRejectExpression) > 0 {
+		i -= len(m.ExpectedRejectExpression)
+		copy(dAtA[i:], m.ExpectedRejectExpression)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedRejectExpression)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExpectedApproveExpression) > 0 {
+		i -= len(m.ExpectedApproveExpression)
+		copy(dAtA[i:], m.ExpectedApproveExpression)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedApproveExpression)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActionTimeoutHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionTimeoutHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GovernanceTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GovernanceTemplateId))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateGovernanceTemplateId {
+		i--
+		if m.UpdateGovernanceTemplateId {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpdateExecutionDelay {
+		i--
+		if m.UpdateExecutionDelay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GovernanceTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GovernanceTemplateId))
+		i--
+		dAtA[i] = 0x30
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTemplateOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTemplateOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTemplateOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTemplateOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTemplateOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTemplateOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCheckAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCheckAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCheckAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCheckActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCheckActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCheckActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteForAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteForAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteForAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteForActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteForActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteForActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignedVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignedVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignedVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSignedVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSignedVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSignedVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TemplateId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVoteDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVoteDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVoteDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractTemplates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractTemplates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractTemplates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectTemplateId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ApproveTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApproveTemplateId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractTemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractTemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionTimeoutHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActionTimeoutHeight))
+	}
+	l = len(m.ExpectedApproveExpression)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedRejectExpression)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgNewActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgNewTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovTx(uint64(l))
+	if m.GovernanceTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.GovernanceTemplateId))
+	}
+	return n
+}
+
+func (m *MsgNewTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovTx(uint64(l))
+	if m.GovernanceTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.GovernanceTemplateId))
+	}
+	if m.UpdateExecutionDelay {
+		n += 2
+	}
+	if m.UpdateGovernanceTemplateId {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferTemplateOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferTemplateOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgRevokeActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCheckAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgCheckActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteForAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovTx(uint64(m.VoteType))
+	}
+	return n
+}
+
+func (m *MsgVoteForActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgRetryActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitSignedVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitSignedVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.TemplateId != 0 {
+		n += 1 + sovTx(uint64(m.TemplateId))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRevokeVoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRevokeVoteDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetContractTemplates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApproveTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.ApproveTemplateId))
+	}
+	if m.RejectTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.RejectTemplateId))
+	}
+	return n
+}
+
+func (m *MsgSetContractTemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &types.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionTimeoutHeight", wireType)
+			}
+			m.ActionTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionTimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedApproveExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedApproveExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRejectExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedRejectExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceTemplateId", wireType)
+			}
+			m.GovernanceTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovernanceTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceTemplateId", wireType)
+			}
+			m.GovernanceTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovernanceTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateExecutionDelay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdateExecutionDelay = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGovernanceTemplateId", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdateGovernanceTemplateId = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTemplateOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTemplateOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTemplateOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgVoteForAction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ActionId != 0 {
-		n += 1 + sovTx(uint64(m.ActionId))
-	}
-	if m.VoteType != 0 {
-		n += 1 + sovTx(uint64(m.VoteType))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgVoteForActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferTemplateOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTemplateOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTemplateOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1885,15 +4790,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,13 +4826,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
 			}
-			var msglen int
+			m.ActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1937,25 +4842,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1977,7 +4868,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,10 +4891,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2027,7 +4918,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
+func (m *MsgCheckAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,10 +4941,10 @@ func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCheckAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCheckAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2089,10 +4980,10 @@ func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
 			}
-			var msglen int
+			m.ActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2102,33 +4993,66 @@ func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &types.Any{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCheckActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionTimeoutHeight", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ActionTimeoutHeight = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCheckActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCheckActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2138,14 +5062,77 @@ func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActionTimeoutHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteForAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteForAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteForAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedApproveExpression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2173,13 +5160,13 @@ func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedApproveExpression = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRejectExpression", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
 			}
-			var stringLen uint64
+			m.ActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2189,24 +5176,30 @@ func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= ActionVoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ExpectedRejectExpression = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2228,7 +5221,7 @@ func (m *MsgNewAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteForActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2251,17 +5244,17 @@ func (m *MsgNewActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteForActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2271,11 +5264,24 @@ func (m *MsgNewActionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2297,7 +5303,7 @@ func (m *MsgNewActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewTemplate) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2320,10 +5326,10 @@ func (m *MsgNewTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2359,42 +5365,10 @@ func (m *MsgNewTemplate) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
 			}
-			var stringLen uint64
+			m.ActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2404,24 +5378,11 @@ func (m *MsgNewTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Definition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2443,7 +5404,7 @@ func (m *MsgNewTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewTemplateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2466,17 +5427,17 @@ func (m *MsgNewTemplateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewTemplateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2486,11 +5447,24 @@ func (m *MsgNewTemplateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2512,7 +5486,7 @@ func (m *MsgNewTemplateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTemplate) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSignedVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,15 +5509,15 @@ func (m *MsgUpdateTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSignedVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSignedVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2571,13 +5545,13 @@ func (m *MsgUpdateTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
 			}
-			m.Id = 0
+			m.ActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2587,16 +5561,16 @@ func (m *MsgUpdateTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2606,55 +5580,25 @@ func (m *MsgUpdateTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Votes = append(m.Votes, SignedVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Definition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2677,7 +5621,7 @@ func (m *MsgUpdateTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTemplateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSignedVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2687,25 +5631,57 @@ func (m *MsgUpdateTemplateResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSignedVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSignedVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTemplateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2727,7 +5703,7 @@ func (m *MsgUpdateTemplateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeAction) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2750,15 +5726,15 @@ func (m *MsgRevokeAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2786,13 +5762,45 @@ func (m *MsgRevokeAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			m.ActionId = 0
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2802,11 +5810,66 @@ func (m *MsgRevokeAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActionId |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateId", wireType)
+			}
+			m.TemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2828,7 +5891,7 @@ func (m *MsgRevokeAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2851,12 +5914,31 @@ func (m *MsgRevokeActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2878,7 +5960,7 @@ func (m *MsgRevokeActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCheckAction) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVoteDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2901,15 +5983,15 @@ func (m *MsgCheckAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCheckAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVoteDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCheckAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2937,13 +6019,13 @@ func (m *MsgCheckAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.ActionId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2953,7 +6035,7 @@ func (m *MsgCheckAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActionId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2979,7 +6061,7 @@ func (m *MsgCheckAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCheckActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeVoteDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3002,44 +6084,12 @@ func (m *MsgCheckActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCheckActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCheckActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3061,7 +6111,7 @@ func (m *MsgCheckActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteForAction) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractTemplates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3084,15 +6134,15 @@ func (m *MsgVoteForAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteForAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractTemplates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteForAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractTemplates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3120,13 +6170,13 @@ func (m *MsgVoteForAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.ActionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3136,16 +6186,29 @@ func (m *MsgVoteForAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
 			}
-			m.VoteType = 0
+			m.ApproveTemplateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3155,7 +6218,26 @@ func (m *MsgVoteForAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VoteType |= ActionVoteType(b&0x7F) << shift
+				m.ApproveTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
+			}
+			m.RejectTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectTemplateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3181,7 +6263,7 @@ func (m *MsgVoteForAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteForActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractTemplatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3204,44 +6286,12 @@ func (m *MsgVoteForActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteForActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractTemplatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,54 @@
+package v1beta1
+
+import (
+	"slices"
+	"time"
+)
+
+// MaxVoteDelegations is the maximum number of active delegations of a
+// delegator, since they are all scanned when an Action mentioning the
+// delegator is evaluated.
+const MaxVoteDelegations = 32
+
+// IsActive returns true if the delegation isn't expired at t.
+func (d *VoteDelegation) IsActive(t time.Time) bool {
+	return d.ExpiresAt == nil || t.Before(*d.ExpiresAt)
+}
+
+// AppliesTo returns true if the scope of the delegation includes act: its
+// Space, if set, must be one of the Spaces of act and its Template, if set,
+// one of the templates act was created with.
+func (d *VoteDelegation) AppliesTo(act *Action) bool {
+	if d.SpaceId != 0 && !slices.Contains(act.SpaceIds, d.SpaceId) {
+		return false
+	}
+
+	if d.TemplateId != 0 {
+		matches := func(ref TemplateRef) bool { return ref.Id == d.TemplateId }
+		if !slices.ContainsFunc(act.ApproveTemplates, matches) && !slices.ContainsFunc(act.RejectTemplates, matches) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Specificity returns how narrow the scope of the delegation is. When
+// multiple delegations apply to an Action, the most specific one is used: a
+// delegation scoped to a Template wins over one scoped to a Space, that wins
+// over an unscoped one.
+func (d *VoteDelegation) Specificity() int {
+	s := 0
+	if d.TemplateId != 0 {
+		s += 2
+	}
+	if d.SpaceId != 0 {
+		s++
+	}
+	return s
+}
+
+// SameScope returns true if d and other have the same delegator and scope.
+func (d *VoteDelegation) SameScope(other *VoteDelegation) bool {
+	return d.Delegator == other.Delegator && d.SpaceId == other.SpaceId && d.TemplateId == other.TemplateId
+}
@@ -0,0 +1,5 @@
+package keeper
+
+var ParseAnalyzerValues = parseAnalyzerValues
+
+var WithSpaceReferences = withSpaceReferences
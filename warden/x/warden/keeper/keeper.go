@@ -41,9 +41,10 @@ type (
 		SpacesKeeper SpacesKeeper
 		KeysKeeper   KeysKeeper
 
-		bankKeeper    types.BankKeeper
-		actKeeper     types.ActKeeper
-		getWasmKeeper func() wasmkeeper.Keeper
+		bankKeeper        types.BankKeeper
+		actKeeper         types.ActKeeper
+		getWasmKeeper     func() wasmkeeper.Keeper
+		getTransferKeeper func() types.TransferKeeper
 	}
 )
 
@@ -71,6 +72,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	actKeeper types.ActKeeper,
 	getWasmKeeper func() wasmkeeper.Keeper,
+	getTransferKeeper func() types.TransferKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -114,9 +116,10 @@ func NewKeeper(
 		SpacesKeeper: spacesKeeper,
 		KeysKeeper:   keysKeeper,
 
-		bankKeeper:    bankKeeper,
-		actKeeper:     actKeeper,
-		getWasmKeeper: getWasmKeeper,
+		bankKeeper:        bankKeeper,
+		actKeeper:         actKeeper,
+		getWasmKeeper:     getWasmKeeper,
+		getTransferKeeper: getTransferKeeper,
 	}
 	k.RegisterTemplates(actKeeper.TemplatesRegistry())
 
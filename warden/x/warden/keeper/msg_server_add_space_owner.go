@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
@@ -39,5 +40,9 @@ func (k msgServer) AddSpaceOwner(ctx context.Context, msg *types.MsgAddSpaceOwne
 		return nil, err
 	}
 
+	if err := k.spaceChanged(ctx, space.Id, fmt.Sprintf("owner %s added", msg.NewOwner)); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgAddSpaceOwnerResponse{}, nil
 }
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+func (k msgServer) NewSpaceTreasury(ctx context.Context, msg *types.MsgNewSpaceTreasury) (*types.MsgNewSpaceTreasuryResponse, error) {
+	if err := k.assertActAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	space, err := k.SpacesKeeper.Get(ctx, msg.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if space.TreasuryAddress != "" {
+		return nil, errors.Wrapf(types.ErrDuplicateSpaceTreasury, "space %d", space.Id)
+	}
+
+	if _, err := space.IncrementNonce(msg.Nonce); err != nil {
+		return nil, err
+	}
+
+	space.TreasuryAddress = types.SpaceTreasuryAddress(space.Id).String()
+
+	if err := k.SpacesKeeper.Set(ctx, space); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventNewSpaceTreasury{
+		SpaceId: space.Id,
+		Address: space.TreasuryAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgNewSpaceTreasuryResponse{Address: space.TreasuryAddress}, nil
+}
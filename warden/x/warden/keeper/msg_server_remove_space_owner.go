@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,5 +44,9 @@ func (k msgServer) RemoveSpaceOwner(ctx context.Context, msg *types.MsgRemoveSpa
 		return nil, err
 	}
 
+	if err := k.spaceChanged(ctx, space.Id, fmt.Sprintf("owner %s removed", msg.Owner)); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgRemoveSpaceOwnerResponse{}, nil
 }
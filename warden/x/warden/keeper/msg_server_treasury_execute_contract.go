@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+func (k msgServer) TreasuryExecuteContract(ctx context.Context, msg *types.MsgTreasuryExecuteContract) (*types.MsgTreasuryExecuteContractResponse, error) {
+	if err := k.assertActAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if k.getWasmKeeper == nil {
+		return nil, fmt.Errorf("contract execution is not supported")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+
+	if !json.Valid(msg.Msg) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "contract message must be valid JSON")
+	}
+
+	if !msg.Funds.IsValid() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Funds.String())
+	}
+
+	treasury, err := k.spaceTreasury(ctx, msg.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmKeeper := wasmkeeper.NewDefaultPermissionKeeper(k.getWasmKeeper())
+	data, err := wasmKeeper.Execute(sdk.UnwrapSDKContext(ctx), contract, treasury, msg.Msg, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTreasuryExecuteContractResponse{Data: data}, nil
+}
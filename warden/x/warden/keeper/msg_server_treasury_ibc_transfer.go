@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+func (k msgServer) TreasuryIBCTransfer(ctx context.Context, msg *types.MsgTreasuryIBCTransfer) (*types.MsgTreasuryIBCTransferResponse, error) {
+	if err := k.assertActAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if k.getTransferKeeper == nil {
+		return nil, fmt.Errorf("IBC transfers are not supported")
+	}
+
+	treasury, err := k.spaceTreasury(ctx, msg.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := ibctransfertypes.NewMsgTransfer(
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.Token,
+		treasury.String(),
+		msg.Receiver,
+		clienttypes.NewHeight(msg.TimeoutRevisionNumber, msg.TimeoutRevisionHeight),
+		msg.TimeoutTimestamp,
+		msg.Memo,
+	)
+	if err := transfer.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := k.getTransferKeeper().Transfer(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTreasuryIBCTransferResponse{Sequence: res.Sequence}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+func (k msgServer) TreasurySend(ctx context.Context, msg *types.MsgTreasurySend) (*types.MsgTreasurySendResponse, error) {
+	if err := k.assertActAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	treasury, err := k.spaceTreasury(ctx, msg.SpaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, treasury, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTreasurySendResponse{}, nil
+}
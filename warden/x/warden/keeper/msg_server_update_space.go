@@ -17,11 +17,13 @@ func (k msgServer) UpdateSpace(ctx context.Context, msg *types.MsgUpdateSpace) (
 		return nil, err
 	}
 
+	rulesChanged := false
 	if msg.ApproveAdminTemplateId != space.ApproveAdminTemplateId {
 		if err := k.actKeeper.IsValidTemplate(ctx, msg.ApproveAdminTemplateId); err != nil {
 			return nil, err
 		}
 		space.ApproveAdminTemplateId = msg.ApproveAdminTemplateId
+		rulesChanged = true
 	}
 
 	if msg.RejectAdminTemplateId != space.RejectAdminTemplateId {
@@ -29,6 +31,7 @@ func (k msgServer) UpdateSpace(ctx context.Context, msg *types.MsgUpdateSpace) (
 			return nil, err
 		}
 		space.RejectAdminTemplateId = msg.RejectAdminTemplateId
+		rulesChanged = true
 	}
 
 	if msg.ApproveSignTemplateId != space.ApproveSignTemplateId {
@@ -36,6 +39,7 @@ func (k msgServer) UpdateSpace(ctx context.Context, msg *types.MsgUpdateSpace) (
 			return nil, err
 		}
 		space.ApproveSignTemplateId = msg.ApproveSignTemplateId
+		rulesChanged = true
 	}
 
 	if msg.RejectSignTemplateId != space.RejectSignTemplateId {
@@ -43,6 +47,7 @@ func (k msgServer) UpdateSpace(ctx context.Context, msg *types.MsgUpdateSpace) (
 			return nil, err
 		}
 		space.RejectSignTemplateId = msg.RejectSignTemplateId
+		rulesChanged = true
 	}
 
 	if _, err := space.IncrementNonce(msg.Nonce); err != nil {
@@ -64,5 +69,11 @@ func (k msgServer) UpdateSpace(ctx context.Context, msg *types.MsgUpdateSpace) (
 		return nil, err
 	}
 
+	if rulesChanged {
+		if err := k.spaceChanged(ctx, space.Id, "rules updated"); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateSpaceResponse{}, nil
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,69 +12,129 @@ import (
 )
 
 func (k Keeper) RegisterTemplates(reg *acttypes.TemplatesRegistry) {
-	acttypes.Register(reg, k.addSpaceOwnerTemplate)
-	acttypes.Register(reg, k.newKeyRequestTemplate)
-	acttypes.Register(reg, k.removeSpaceOwnerTemplate)
-	acttypes.Register(reg, k.updateKeyTemplate)
-	acttypes.Register(reg, k.updateSpaceTemplate)
+	acttypes.RegisterCtx(reg, k.addSpaceOwnerTemplate)
+	acttypes.RegisterCtx(reg, k.newKeyRequestTemplate)
+	acttypes.RegisterCtx(reg, k.removeSpaceOwnerTemplate)
+	acttypes.RegisterCtx(reg, k.updateKeyTemplate)
+	acttypes.RegisterCtx(reg, k.updateSpaceTemplate)
 	acttypes.RegisterCtx(reg, k.newSignRequestTemplate)
+	acttypes.RegisterCtx(reg, k.newSpaceTreasuryTemplate)
+	acttypes.RegisterCtx(reg, k.treasurySendTemplate)
+	acttypes.RegisterCtx(reg, k.treasuryIBCTransferTemplate)
+	acttypes.RegisterCtx(reg, k.treasuryExecuteContractTemplate)
+
+	reg.RegisterFieldResolver(v1beta3.SpaceAdminTemplatesResolver, k.spaceAdminTemplatesResolver)
+	reg.RegisterFieldResolver(v1beta3.SpaceSignTemplatesResolver, k.spaceSignTemplatesResolver)
+
+	reg.RegisterSpaces(k.SpacesKeeper.Has)
 }
 
-func (k Keeper) addSpaceOwnerTemplate(ctx context.Context, msg *v1beta3.MsgAddSpaceOwner) (acttypes.Template, acttypes.Template, error) {
+// spaceAdminTemplatesResolver returns the admin templates of the Space with
+// the given ID, for the messages of other modules bound to it.
+func (k Keeper) spaceAdminTemplatesResolver(ctx context.Context, value any) (context.Context, acttypes.Template, acttypes.Template, error) {
+	space, err := k.resolveSpace(ctx, value)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	approveTemplate, err := k.getApproveUpdateSpaceTemplate(ctx, space)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	rejectTemplate, err := k.getRejectUpdateSpaceTemplate(ctx, space)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
+}
+
+// spaceSignTemplatesResolver returns the sign templates of the Space with the
+// given ID, for the messages of other modules bound to it.
+func (k Keeper) spaceSignTemplatesResolver(ctx context.Context, value any) (context.Context, acttypes.Template, acttypes.Template, error) {
+	space, err := k.resolveSpace(ctx, value)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	approveTemplate, err := k.getApproveSignTemplate(ctx, space, v1beta3.Key{})
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	rejectTemplate, err := k.getRejectSignTemplate(ctx, space, v1beta3.Key{})
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
+}
+
+func (k Keeper) resolveSpace(ctx context.Context, value any) (v1beta3.Space, error) {
+	spaceID, ok := value.(uint64)
+	if !ok {
+		return v1beta3.Space{}, fmt.Errorf("expected a space ID, got %T", value)
+	}
+
+	return k.SpacesKeeper.Get(ctx, spaceID)
+}
+
+func (k Keeper) addSpaceOwnerTemplate(ctx context.Context, msg *v1beta3.MsgAddSpaceOwner) (context.Context, acttypes.Template, acttypes.Template, error) {
 	space, err := k.SpacesKeeper.Get(ctx, msg.SpaceId)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	approveTemplate, err := k.getApproveAddOwnerTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	rejectTemplate, err := k.getRejectAddOwnerTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
-	return approveTemplate, rejectTemplate, nil
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
 }
 
-func (k Keeper) removeSpaceOwnerTemplate(ctx context.Context, msg *v1beta3.MsgRemoveSpaceOwner) (acttypes.Template, acttypes.Template, error) {
+func (k Keeper) removeSpaceOwnerTemplate(ctx context.Context, msg *v1beta3.MsgRemoveSpaceOwner) (context.Context, acttypes.Template, acttypes.Template, error) {
 	space, err := k.SpacesKeeper.Get(ctx, msg.SpaceId)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	approveTemplate, err := k.getApproveRemoveOwnerTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	rejectTemplate, err := k.getRejectRemoveOwnerTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
-	return approveTemplate, rejectTemplate, nil
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
 }
 
-func (k Keeper) newKeyRequestTemplate(ctx context.Context, msg *v1beta3.MsgNewKeyRequest) (acttypes.Template, acttypes.Template, error) {
+func (k Keeper) newKeyRequestTemplate(ctx context.Context, msg *v1beta3.MsgNewKeyRequest) (context.Context, acttypes.Template, acttypes.Template, error) {
 	space, err := k.SpacesKeeper.Get(ctx, msg.SpaceId)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	approveTemplate, err := k.getApproveNewKeyRequestTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	rejectTemplate, err := k.getRejectNewKeyRequestTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
-	return approveTemplate, rejectTemplate, nil
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
 }
 
 func (k Keeper) newSignRequestTemplate(ctx context.Context, msg *v1beta3.MsgNewSignRequest) (context.Context, acttypes.Template, acttypes.Template, error) {
@@ -108,49 +169,105 @@ func (k Keeper) newSignRequestTemplate(ctx context.Context, msg *v1beta3.MsgNewS
 		msg.Input = dataForSigning
 	}
 
-	return ctxWithVals, approveTemplate, rejectTemplate, nil
+	return acttypes.WithSpaceID(ctxWithVals, space.Id), approveTemplate, rejectTemplate, nil
 }
 
-func (k Keeper) updateKeyTemplate(ctx context.Context, msg *v1beta3.MsgUpdateKey) (acttypes.Template, acttypes.Template, error) {
+func (k Keeper) updateKeyTemplate(ctx context.Context, msg *v1beta3.MsgUpdateKey) (context.Context, acttypes.Template, acttypes.Template, error) {
 	key, err := k.KeysKeeper.Get(ctx, msg.KeyId)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	space, err := k.SpacesKeeper.Get(ctx, key.SpaceId)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	approveTemplate, err := k.getApproveUpdateKeyTemplate(ctx, space, key)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	rejectTemplate, err := k.getRejectUpdateKeyTemplate(ctx, space, key)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
-	return approveTemplate, rejectTemplate, nil
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
 }
 
-func (k Keeper) updateSpaceTemplate(ctx context.Context, msg *v1beta3.MsgUpdateSpace) (acttypes.Template, acttypes.Template, error) {
+func (k Keeper) updateSpaceTemplate(ctx context.Context, msg *v1beta3.MsgUpdateSpace) (context.Context, acttypes.Template, acttypes.Template, error) {
 	space, err := k.SpacesKeeper.Get(ctx, msg.SpaceId)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
 	approveTemplate, err := k.getApproveUpdateSpaceTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 	rejectTemplate, err := k.getRejectUpdateSpaceTemplate(ctx, space)
 	if err != nil {
-		return acttypes.Template{}, acttypes.Template{}, err
+		return nil, acttypes.Template{}, acttypes.Template{}, err
 	}
 
-	return approveTemplate, rejectTemplate, nil
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
+}
+
+func (k Keeper) newSpaceTreasuryTemplate(ctx context.Context, msg *v1beta3.MsgNewSpaceTreasury) (context.Context, acttypes.Template, acttypes.Template, error) {
+	space, err := k.SpacesKeeper.Get(ctx, msg.SpaceId)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	approveTemplate, err := k.getApproveNewSpaceTreasuryTemplate(ctx, space)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	rejectTemplate, err := k.getRejectNewSpaceTreasuryTemplate(ctx, space)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
+}
+
+func (k Keeper) treasurySendTemplate(ctx context.Context, msg *v1beta3.MsgTreasurySend) (context.Context, acttypes.Template, acttypes.Template, error) {
+	return k.treasurySpendTemplates(ctx, msg.SpaceId)
+}
+
+func (k Keeper) treasuryIBCTransferTemplate(ctx context.Context, msg *v1beta3.MsgTreasuryIBCTransfer) (context.Context, acttypes.Template, acttypes.Template, error) {
+	return k.treasurySpendTemplates(ctx, msg.SpaceId)
+}
+
+func (k Keeper) treasuryExecuteContractTemplate(ctx context.Context, msg *v1beta3.MsgTreasuryExecuteContract) (context.Context, acttypes.Template, acttypes.Template, error) {
+	return k.treasurySpendTemplates(ctx, msg.SpaceId)
+}
+
+// treasurySpendTemplates returns the templates of the messages moving funds
+// out of the treasury of a Space.
+func (k Keeper) treasurySpendTemplates(ctx context.Context, spaceID uint64) (context.Context, acttypes.Template, acttypes.Template, error) {
+	space, err := k.SpacesKeeper.Get(ctx, spaceID)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	if space.TreasuryAddress == "" {
+		return nil, acttypes.Template{}, acttypes.Template{}, errors.Wrapf(v1beta3.ErrNoSpaceTreasury, "space %d", space.Id)
+	}
+
+	approveTemplate, err := k.getApproveTreasurySpendTemplate(ctx, space)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	rejectTemplate, err := k.getRejectTreasurySpendTemplate(ctx, space)
+	if err != nil {
+		return nil, acttypes.Template{}, acttypes.Template{}, err
+	}
+
+	return acttypes.WithSpaceID(ctx, space.Id), approveTemplate, rejectTemplate, nil
 }
 
 func (k Keeper) getApproveSignTemplate(ctx context.Context, space v1beta3.Space, key v1beta3.Key) (acttypes.Template, error) {
@@ -258,6 +375,38 @@ func (k Keeper) getRejectUpdateSpaceTemplate(ctx context.Context, space v1beta3.
 	}
 }
 
+func (k Keeper) getApproveNewSpaceTreasuryTemplate(ctx context.Context, space v1beta3.Space) (acttypes.Template, error) {
+	if space.ApproveAdminTemplateId > 0 {
+		return k.actKeeper.GetTemplate(ctx, space.ApproveAdminTemplateId)
+	} else {
+		return space.TemplateApproveNewSpaceTreasury(), nil
+	}
+}
+
+func (k Keeper) getRejectNewSpaceTreasuryTemplate(ctx context.Context, space v1beta3.Space) (acttypes.Template, error) {
+	if space.RejectAdminTemplateId > 0 {
+		return k.actKeeper.GetTemplate(ctx, space.RejectAdminTemplateId)
+	} else {
+		return space.TemplateRejectNewSpaceTreasury(), nil
+	}
+}
+
+func (k Keeper) getApproveTreasurySpendTemplate(ctx context.Context, space v1beta3.Space) (acttypes.Template, error) {
+	if space.ApproveSignTemplateId > 0 {
+		return k.actKeeper.GetTemplate(ctx, space.ApproveSignTemplateId)
+	} else {
+		return space.TemplateApproveTreasurySpend(), nil
+	}
+}
+
+func (k Keeper) getRejectTreasurySpendTemplate(ctx context.Context, space v1beta3.Space) (acttypes.Template, error) {
+	if space.RejectSignTemplateId > 0 {
+		return k.actKeeper.GetTemplate(ctx, space.RejectSignTemplateId)
+	} else {
+		return space.TemplateRejectTreasurySpend(), nil
+	}
+}
+
 func (k Keeper) executeAnalyzers(ctx context.Context, creator string, contracts []string, input []byte) (context.Context, []byte, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	creatorAddr := sdk.MustAccAddressFromBech32(creator)
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+	"github.com/warden-protocol/wardenprotocol/warden/x/warden/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+func TestSpaceTemplatesResolvers(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+
+	k, ctx := keepertest.WardenKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	reg := acttypes.NewTemplatesRegistry()
+	k.RegisterTemplates(reg)
+
+	res, err := ms.NewSpace(ctx, &types.MsgNewSpace{Creator: alice})
+	require.NoError(t, err)
+
+	// any message with a space ID field can be resolved to the Space templates
+	msg := &types.MsgUpdateSpace{Authority: k.GetActAuthority(), SpaceId: res.Id}
+	for _, resolver := range []string{types.SpaceAdminTemplatesResolver, types.SpaceSignTemplatesResolver} {
+		msgCtx, approve, reject, err := reg.GetByField(ctx, msg, "space_id", resolver)
+		require.NoError(t, err)
+		require.Equal(t, "AnyOwner", approve.Name)
+		require.Equal(t, "AnyOwner", reject.Name)
+		spaceID, ok := acttypes.SpaceIDFromContext(msgCtx)
+		require.True(t, ok)
+		require.Equal(t, res.Id, spaceID)
+	}
+
+	_, _, _, err = reg.GetByField(ctx, &types.MsgUpdateSpace{SpaceId: res.Id + 1}, "space_id", types.SpaceAdminTemplatesResolver)
+	require.Error(t, err)
+
+	_, _, _, err = reg.GetByField(ctx, msg, "authority", types.SpaceAdminTemplatesResolver)
+	require.Error(t, err)
+
+	// the Spaces are registered for the vote delegations
+	exists, err := reg.SpaceExists(ctx, res.Id)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = reg.SpaceExists(ctx, res.Id+1)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
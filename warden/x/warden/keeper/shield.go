@@ -3,11 +3,17 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/warden-protocol/wardenprotocol/shield/ast"
 	"github.com/warden-protocol/wardenprotocol/warden/x/act/cosmoshield"
+	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
 )
 
 var _ ast.Expander = WardenShieldExpander{}
@@ -16,11 +22,20 @@ type WardenShieldExpander struct {
 	keeper Keeper
 }
 
+// MaxSpaceReferenceDepth is the maximum number of nested Space Rules that
+// will be expanded, e.g. the Rule of a Space referencing the Rule of another
+// Space that references the Rule of a third Space has a depth of 2.
+const MaxSpaceReferenceDepth = 4
+
 func (w WardenShieldExpander) Expand(ctx context.Context, ident *ast.Identifier) (*ast.Expression, error) {
 	if strings.HasPrefix(ident.Value, "analyzers.") {
 		return w.expandAnalyzers(ctx, ident)
 	}
 
+	if strings.HasPrefix(ident.Value, "spaces.") {
+		return w.expandSpaceReference(ctx, ident)
+	}
+
 	if ident.Value == "space.owners" {
 		return w.expandSpaceOwners(ctx)
 
@@ -29,19 +44,101 @@ func (w WardenShieldExpander) Expand(ctx context.Context, ident *ast.Identifier)
 	return nil, fmt.Errorf("unknown identifier: %s", ident.Value)
 }
 
+// expandSpaceOwners expands `space.owners` into the owners of the Space the
+// message refers to or, inside the Rule of another Space being expanded (see
+// expandSpaceReference), into the owners of that Space.
 func (w WardenShieldExpander) expandSpaceOwners(ctx context.Context) (*ast.Expression, error) {
-	msg := cosmoshield.UnwrapContext(ctx).Msg()
+	spaceID, ok := currentSpaceReference(ctx)
+	if !ok {
+		msg := cosmoshield.UnwrapContext(ctx).Msg()
+
+		var err error
+		spaceID, err = w.extractSpaceID(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	spaceID, err := w.extractSpaceID(ctx, msg)
+	space, err := w.keeper.SpacesKeeper.Get(ctx, spaceID)
 	if err != nil {
 		return nil, err
 	}
 
+	cosmoshield.AddDependency(ctx, SpaceDependency(spaceID))
+	return ownersExpression(space), nil
+}
+
+// expandSpaceReference expands `spaces.<id>.<field>`, where field is:
+//   - owners: the owners of the Space;
+//   - approve_admin, reject_admin: the Rules of the Space for admin
+//     operations;
+//   - approve_sign, reject_sign: the Rules of the Space for sign operations.
+//
+// Rules are preprocessed in the context of the referenced Space, e.g.
+// `space.owners` refers to its owners, and can reference other Spaces up to
+// MaxSpaceReferenceDepth times.
+func (w WardenShieldExpander) expandSpaceReference(ctx context.Context, ident *ast.Identifier) (*ast.Expression, error) {
+	ps := strings.SplitN(ident.Value, ".", 3)
+	if len(ps) < 3 {
+		return nil, errors.Wrapf(types.ErrInvalidSpaceReference, "%s", ident.Value)
+	}
+
+	spaceID, err := strconv.ParseUint(ps[1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSpaceReference, "%s: invalid space id", ident.Value)
+	}
+
 	space, err := w.keeper.SpacesKeeper.Get(ctx, spaceID)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSpaceReference, "%s: %v", ident.Value, err)
+	}
+
+	cosmoshield.AddDependency(ctx, SpaceDependency(spaceID))
+
+	var template acttypes.Template
+	switch ps[2] {
+	case "owners":
+		return ownersExpression(space), nil
+	case "approve_admin":
+		template, err = w.keeper.getApproveUpdateSpaceTemplate(ctx, space)
+	case "reject_admin":
+		template, err = w.keeper.getRejectUpdateSpaceTemplate(ctx, space)
+	case "approve_sign":
+		template, err = w.keeper.getApproveNewKeyRequestTemplate(ctx, space)
+	case "reject_sign":
+		template, err = w.keeper.getRejectNewKeyRequestTemplate(ctx, space)
+	default:
+		return nil, errors.Wrapf(types.ErrInvalidSpaceReference, "%s: unknown field %s", ident.Value, ps[2])
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	visiting := spaceReferences(ctx)
+	if slices.Contains(visiting, spaceID) {
+		return nil, errors.Wrapf(types.ErrInvalidSpaceReference, "%s: cycle detected", ident.Value)
+	}
+	if len(visiting) >= MaxSpaceReferenceDepth {
+		return nil, errors.Wrapf(types.ErrInvalidSpaceReference, "too many nested space references (max %d)", MaxSpaceReferenceDepth)
+	}
+
+	ctx = withSpaceReferences(ctx, append(slices.Clone(visiting), spaceID))
+	return cosmoshield.UnwrapContext(ctx).Preprocess(ctx, proto.Clone(template.Expression).(*ast.Expression))
+}
+
+// SpaceDependency returns the dependency recorded by the expressions using the
+// owners or the Rules of a Space, see cosmoshield.Dependencies. Pending
+// Actions depending on it are expanded again when the Space is updated.
+func SpaceDependency(spaceID uint64) string {
+	return fmt.Sprintf("%s/spaces/%d", types.ModuleName, spaceID)
+}
+
+// spaceChanged notifies x/act that the owners or the Rules of a Space changed.
+func (k Keeper) spaceChanged(ctx context.Context, spaceID uint64, reason string) error {
+	return k.actKeeper.DependencyChanged(ctx, SpaceDependency(spaceID), reason)
+}
+
+func ownersExpression(space types.Space) *ast.Expression {
 	owners := make([]*ast.Expression, 0, len(space.Owners))
 	for _, owner := range space.Owners {
 		owners = append(owners, ast.NewIdentifier(&ast.Identifier{
@@ -51,22 +148,28 @@ func (w WardenShieldExpander) expandSpaceOwners(ctx context.Context) (*ast.Expre
 
 	return ast.NewArrayLiteral(&ast.ArrayLiteral{
 		Elements: owners,
-	}), nil
+	})
 }
 
+// expandAnalyzers expands `analyzers.<contract>.<key>` into the value
+// returned by the analyzer for key. Fields of object values can be selected
+// by appending their names, e.g. `analyzers.<contract>.tx.to`.
 func (w WardenShieldExpander) expandAnalyzers(ctx context.Context, ident *ast.Identifier) (*ast.Expression, error) {
 	analyzerVals := analyzerValues(ctx)
 
 	ps := strings.SplitN(ident.Value, ".", 3)
+	if len(ps) < 3 {
+		return ast.NewIdentifier(ident), nil
+	}
 	contract := ps[1]
-	key := ps[2]
+	path := ps[2]
 
 	contractVals, found := analyzerVals[contract]
 	if !found {
 		return ast.NewIdentifier(ident), nil
 	}
 
-	value, found := contractVals[key]
+	value, found := lookupAnalyzerValue(contractVals, path)
 	if !found {
 		return ast.NewIdentifier(ident), nil
 	}
@@ -74,6 +177,65 @@ func (w WardenShieldExpander) expandAnalyzers(ctx context.Context, ident *ast.Id
 	return value, nil
 }
 
+// lookupAnalyzerValue resolves path in vals. Keys can contain dots, the
+// longest key that is a prefix of path is used and the rest of path selects
+// the fields of its value.
+func lookupAnalyzerValue(vals map[string]*ast.Expression, path string) (*ast.Expression, bool) {
+	key, fields := path, ""
+	for {
+		if value, ok := vals[key]; ok {
+			return selectFields(value, fields)
+		}
+
+		i := strings.LastIndexByte(key, '.')
+		if i < 0 {
+			return nil, false
+		}
+		key, fields = path[:i], path[i+1:]
+	}
+}
+
+func selectFields(value *ast.Expression, fields string) (*ast.Expression, bool) {
+	if fields == "" {
+		return value, true
+	}
+
+	for _, field := range strings.Split(fields, ".") {
+		obj, ok := ast.UnwrapObjectLiteral(value)
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = obj.Get(field)
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+type spaceReferencesKey struct{}
+
+// withSpaceReferences returns a context containing the ids of the Spaces
+// whose Rules are being expanded, the last one being the innermost.
+func withSpaceReferences(ctx context.Context, ids []uint64) context.Context {
+	return context.WithValue(ctx, spaceReferencesKey{}, ids)
+}
+
+func spaceReferences(ctx context.Context) []uint64 {
+	ids, _ := ctx.Value(spaceReferencesKey{}).([]uint64)
+	return ids
+}
+
+func currentSpaceReference(ctx context.Context) (uint64, bool) {
+	ids := spaceReferences(ctx)
+	if len(ids) == 0 {
+		return 0, false
+	}
+	return ids[len(ids)-1], true
+}
+
 type analyzerValuesKey struct{}
 
 func WithAnalyzerValues(ctx context.Context, vals map[string]map[string]*ast.Expression) context.Context {
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warden-protocol/wardenprotocol/shield"
+	"github.com/warden-protocol/wardenprotocol/shield/ast"
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	"github.com/warden-protocol/wardenprotocol/warden/x/act/cosmoshield"
+	"github.com/warden-protocol/wardenprotocol/warden/x/warden/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+func TestParseAnalyzerValues(t *testing.T) {
+	dataForSigning, values, err := keeper.ParseAnalyzerValues([]byte(`{
+		"data_for_signing": "AQI=",
+		"result": {
+			"to": "0xabc",
+			"amount": 18446744073709551616,
+			"gas": 2.0,
+			"ok": true,
+			"tx": {"value": 100, "to": "0xdef", "data": {"method": "transfer", "args": [1, "x"]}}
+		}
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2}, dataForSigning)
+
+	formatted := make(map[string]string, len(values))
+	for k, v := range values {
+		formatted[k] = shield.Format(v)
+	}
+	require.Equal(t, map[string]string{
+		"to":     `"0xabc"`,
+		"amount": "18446744073709551616",
+		"gas":    "2",
+		"ok":     "true",
+		"tx":     `{data: {args: [1, "x"], method: "transfer"}, to: "0xdef", value: 100}`,
+	}, formatted)
+
+	_, _, err = keeper.ParseAnalyzerValues([]byte(`{"result": {"price": 1.5}}`))
+	require.ErrorContains(t, err, "floating point numbers are not supported")
+
+	_, _, err = keeper.ParseAnalyzerValues([]byte(`{"result": {"amount": 1e1000000000}}`))
+	require.ErrorContains(t, err, "exponent notation")
+
+	_, _, err = keeper.ParseAnalyzerValues([]byte(`{"result": {"amount": 1` + strings.Repeat("0", 100) + `}}`))
+	require.ErrorContains(t, err, "longer than")
+
+	_, _, err = keeper.ParseAnalyzerValues([]byte(`{"result": {"tx": {"value": null}}}`))
+	require.ErrorContains(t, err, "unsupported type")
+}
+
+func TestExpandAnalyzers(t *testing.T) {
+	_, values, err := keeper.ParseAnalyzerValues([]byte(`{
+		"result": {
+			"tx": {"value": 100, "data": {"method": "transfer"}},
+			"tx.fee": 5
+		}
+	}`))
+	require.NoError(t, err)
+
+	ctx := keeper.WithAnalyzerValues(context.Background(), map[string]map[string]*ast.Expression{
+		"contract1": values,
+	})
+	expander := keeper.Keeper{}.ShieldExpander()
+
+	tests := []struct {
+		ident    string
+		expected string
+	}{
+		{"analyzers.contract1.tx", `{data: {method: "transfer"}, value: 100}`},
+		{"analyzers.contract1.tx.value", "100"},
+		{"analyzers.contract1.tx.data.method", `"transfer"`},
+		{"analyzers.contract1.tx.fee", "5"},
+		{"analyzers.contract1.tx.unknown", "analyzers.contract1.tx.unknown"},
+		{"analyzers.contract1.tx.value.unknown", "analyzers.contract1.tx.value.unknown"},
+		{"analyzers.contract2.tx", "analyzers.contract2.tx"},
+		{"analyzers.contract1", "analyzers.contract1"},
+	}
+
+	for _, tt := range tests {
+		expanded, err := expander.Expand(ctx, ast.NewIdent(tt.ident))
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, shield.Format(expanded), tt.ident)
+	}
+}
+
+func TestExpandSpaceReferences(t *testing.T) {
+	k, sdkCtx := keepertest.WardenKeeper(t)
+
+	addr := func(name string) string {
+		return sdk.AccAddress(name + "_address_____").String()
+	}
+	a, b, c, d := addr("a"), addr("b"), addr("c"), addr("d")
+
+	parentID, err := k.SpacesKeeper.New(sdkCtx, &types.Space{Owners: []string{a, b, c}})
+	require.NoError(t, err)
+	childID, err := k.SpacesKeeper.New(sdkCtx, &types.Space{Owners: []string{d}})
+	require.NoError(t, err)
+
+	expander := cosmoshield.NewExpanderManager(cosmoshield.NewPrefixedExpander("warden", k.ShieldExpander()))
+	ctx := cosmoshield.NewContext(sdkCtx, &types.MsgAddSpaceOwner{SpaceId: childID}).
+		WithPreprocess(func(ctx context.Context, expr *ast.Expression) (*ast.Expression, error) {
+			return shield.Preprocess(ctx, expr, expander)
+		})
+
+	tests := []struct {
+		code     string
+		expected string
+		err      string
+	}{
+		{code: "warden.space.owners", expected: fmt.Sprintf("[%s]", d)},
+		{code: fmt.Sprintf("warden.spaces.%d.owners", parentID), expected: fmt.Sprintf("[%s, %s, %s]", a, b, c)},
+		{code: fmt.Sprintf("any(2, warden.spaces.%d.owners) || all(warden.space.owners)", parentID), expected: fmt.Sprintf("any(2, [%s, %s, %s]) || all([%s])", a, b, c, d)},
+		{code: fmt.Sprintf("warden.spaces.%d.approve_admin", parentID), expected: fmt.Sprintf("any(1, [%s, %s, %s])", a, b, c)},
+		{code: fmt.Sprintf("warden.spaces.%d.reject_sign && warden.space.owners[0]", parentID), expected: fmt.Sprintf("any(1, [%s, %s, %s]) && [%s][0]", a, b, c, d)},
+		{code: "warden.spaces.99.owners", err: "invalid space reference"},
+		{code: "warden.spaces.x.owners", err: "invalid space id"},
+		{code: fmt.Sprintf("warden.spaces.%d", parentID), err: "invalid space reference"},
+		{code: fmt.Sprintf("warden.spaces.%d.unknown", parentID), err: "unknown field unknown"},
+	}
+
+	for _, tt := range tests {
+		expr, err := shield.Parse(tt.code)
+		require.NoError(t, err)
+
+		expanded, err := shield.Preprocess(ctx, expr, expander)
+		if tt.err != "" {
+			require.ErrorContains(t, err, tt.err, tt.code)
+			continue
+		}
+		require.NoError(t, err, tt.code)
+		require.Equal(t, tt.expected, shield.Format(expanded), tt.code)
+	}
+
+	var deps cosmoshield.Dependencies
+	expr, err := shield.Parse(fmt.Sprintf("warden.spaces.%d.approve_admin || all(warden.space.owners)", parentID))
+	require.NoError(t, err)
+	_, err = shield.Preprocess(cosmoshield.WithDependencies(ctx, &deps), expr, expander)
+	require.NoError(t, err)
+	require.Equal(t, []string{keeper.SpaceDependency(parentID), keeper.SpaceDependency(childID)}, deps.List())
+
+	ident := ast.NewIdent(fmt.Sprintf("spaces.%d.approve_admin", parentID))
+
+	_, err = k.ShieldExpander().Expand(keeper.WithSpaceReferences(ctx, []uint64{childID, parentID}), ident)
+	require.ErrorContains(t, err, "cycle detected")
+
+	_, err = k.ShieldExpander().Expand(keeper.WithSpaceReferences(ctx, []uint64{10, 11, 12, 13}), ident)
+	require.ErrorContains(t, err, "too many nested space references")
+
+	_, err = k.ShieldExpander().Expand(sdkCtx, ident)
+	require.ErrorContains(t, err, "preprocessing is not available")
+}
@@ -32,6 +32,11 @@ func (k SpacesKeeper) Get(ctx context.Context, id uint64) (types.Space, error) {
 	return k.spaces.Get(ctx, id)
 }
 
+// Has returns true if the Space with the given id exists.
+func (k SpacesKeeper) Has(ctx context.Context, id uint64) (bool, error) {
+	return k.spaces.Has(ctx, id)
+}
+
 func (k SpacesKeeper) New(ctx context.Context, space *types.Space) (uint64, error) {
 	id, err := k.spaces.Append(ctx, space)
 	if err != nil {
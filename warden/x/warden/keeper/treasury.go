@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+// spaceTreasury returns the address of the treasury of the Space with the
+// given ID, or ErrNoSpaceTreasury if the Space doesn't have one.
+func (k Keeper) spaceTreasury(ctx context.Context, spaceID uint64) (sdk.AccAddress, error) {
+	space, err := k.SpacesKeeper.Get(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if space.TreasuryAddress == "" {
+		return nil, errors.Wrapf(types.ErrNoSpaceTreasury, "space %d", spaceID)
+	}
+
+	return sdk.AccAddressFromBech32(space.TreasuryAddress)
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/warden-protocol/wardenprotocol/warden/testutil/keeper"
+	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
+	"github.com/warden-protocol/wardenprotocol/warden/x/warden/keeper"
+	types "github.com/warden-protocol/wardenprotocol/warden/x/warden/types/v1beta3"
+)
+
+type bankSend struct {
+	from, to sdk.AccAddress
+	amt      sdk.Coins
+}
+
+// fakeBank records the coins sent, and blocks the addresses in blocked.
+type fakeBank struct {
+	sent    []bankSend
+	blocked []sdk.AccAddress
+}
+
+func (b *fakeBank) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	b.sent = append(b.sent, bankSend{from: from, to: to, amt: amt})
+	return nil
+}
+
+func (b *fakeBank) BlockedAddr(addr sdk.AccAddress) bool {
+	return slices.ContainsFunc(b.blocked, func(a sdk.AccAddress) bool { return a.Equals(addr) })
+}
+
+func TestSpaceTreasury(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________")
+
+	bank := &fakeBank{}
+	k, ctx := keepertest.WardenKeeperWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(k)
+	actAuthority := k.GetActAuthority()
+
+	res, err := ms.NewSpace(ctx, &types.MsgNewSpace{Creator: alice})
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewCoin("award", sdkmath.NewInt(100)))
+	send := &types.MsgTreasurySend{Authority: actAuthority, SpaceId: res.Id, ToAddress: bob.String(), Amount: coins}
+
+	_, err = ms.TreasurySend(ctx, send)
+	require.ErrorIs(t, err, types.ErrNoSpaceTreasury)
+
+	// treasuries are created by actions
+	_, err = ms.NewSpaceTreasury(ctx, &types.MsgNewSpaceTreasury{Authority: alice, SpaceId: res.Id})
+	require.ErrorIs(t, err, types.ErrInvalidActionSigner)
+
+	treasury, err := ms.NewSpaceTreasury(ctx, &types.MsgNewSpaceTreasury{Authority: actAuthority, SpaceId: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.SpaceTreasuryAddress(res.Id).String(), treasury.Address)
+	require.NotEqual(t, types.SpaceTreasuryAddress(res.Id+1), types.SpaceTreasuryAddress(res.Id))
+
+	space, err := k.SpacesKeeper.Get(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, treasury.Address, space.TreasuryAddress)
+	require.Equal(t, uint64(1), space.Nonce)
+
+	_, err = ms.NewSpaceTreasury(ctx, &types.MsgNewSpaceTreasury{Authority: actAuthority, SpaceId: res.Id, Nonce: 1})
+	require.ErrorIs(t, err, types.ErrDuplicateSpaceTreasury)
+
+	// funds are moved out of the treasury only by actions
+	_, err = ms.TreasurySend(ctx, &types.MsgTreasurySend{Authority: alice, SpaceId: res.Id, ToAddress: bob.String(), Amount: coins})
+	require.ErrorIs(t, err, types.ErrInvalidActionSigner)
+
+	_, err = ms.TreasurySend(ctx, send)
+	require.NoError(t, err)
+	require.Equal(t, []bankSend{{from: types.SpaceTreasuryAddress(res.Id), to: bob, amt: coins}}, bank.sent)
+
+	// blocked addresses, e.g. module accounts, can't receive funds
+	module := authtypes.NewModuleAddress("distribution")
+	bank.blocked = append(bank.blocked, module)
+	_, err = ms.TreasurySend(ctx, &types.MsgTreasurySend{Authority: actAuthority, SpaceId: res.Id, ToAddress: module.String(), Amount: coins})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Len(t, bank.sent, 1)
+
+	_, err = ms.TreasuryIBCTransfer(ctx, &types.MsgTreasuryIBCTransfer{Authority: actAuthority, SpaceId: res.Id})
+	require.Error(t, err)
+}
+
+func TestSpaceTreasuryTemplates(t *testing.T) {
+	alice := sdk.AccAddress("alice_address_______").String()
+	bob := sdk.AccAddress("bob_address_________").String()
+
+	k, ctx := keepertest.WardenKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	reg := acttypes.NewTemplatesRegistry()
+	k.RegisterTemplates(reg)
+
+	res, err := ms.NewSpace(ctx, &types.MsgNewSpace{Creator: alice})
+	require.NoError(t, err)
+
+	send := &types.MsgTreasurySend{Authority: k.GetActAuthority(), SpaceId: res.Id, ToAddress: bob}
+	_, _, _, err = reg.Get(ctx, send)
+	require.ErrorIs(t, err, types.ErrNoSpaceTreasury)
+
+	msgCtx, approve, reject, err := reg.Get(ctx, &types.MsgNewSpaceTreasury{Authority: k.GetActAuthority(), SpaceId: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, "AnyOwner", approve.Name)
+	require.Equal(t, "AnyOwner", reject.Name)
+	spaceID, ok := acttypes.SpaceIDFromContext(msgCtx)
+	require.True(t, ok)
+	require.Equal(t, res.Id, spaceID)
+
+	_, err = ms.NewSpaceTreasury(ctx, &types.MsgNewSpaceTreasury{Authority: k.GetActAuthority(), SpaceId: res.Id})
+	require.NoError(t, err)
+
+	for _, msg := range []sdk.Msg{
+		send,
+		&types.MsgTreasuryIBCTransfer{SpaceId: res.Id},
+		&types.MsgTreasuryExecuteContract{SpaceId: res.Id},
+	} {
+		msgCtx, approve, _, err := reg.Get(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, "AnyOwner", approve.Name)
+		spaceID, ok := acttypes.SpaceIDFromContext(msgCtx)
+		require.True(t, ok)
+		require.Equal(t, res.Id, spaceID)
+	}
+}
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"math"
 	"math/big"
+	"sort"
+	"strings"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -90,37 +92,87 @@ func parseAnalyzerValues(bz []byte) ([]byte, map[string]*ast.Expression, error)
 	return response.DataForSigning, parsedValues, nil
 }
 
+// parseShieldExpression converts a JSON value returned by an analyzer into a
+// Shield literal. Arrays and objects are converted recursively, the entries of
+// objects are sorted by key.
 func parseShieldExpression(v json.RawMessage) (*ast.Expression, error) {
+	d := json.NewDecoder(bytes.NewReader(v))
+	d.UseNumber()
+
 	var out any
-	err := json.Unmarshal(v, &out)
-	if err != nil {
+	if err := d.Decode(&out); err != nil {
 		return nil, err
 	}
 
-	switch out := out.(type) {
+	return jsonToShieldExpression(out)
+}
+
+// maxJSONNumberLength is the maximum length of the numbers returned by the
+// analyzers, enough for 256-bit integers. It bounds the cost of converting
+// them into integers.
+const maxJSONNumberLength = 80
+
+func jsonToShieldExpression(v any) (*ast.Expression, error) {
+	switch out := v.(type) {
 	case string:
 		return ast.NewStringLiteral(&ast.StringLiteral{
 			Value: out,
 		}), nil
-	case int64:
-		return ast.NewIntegerLiteral(&ast.IntegerLiteral{
-			Value: big.NewInt(out).String(),
-		}), nil
-	case int:
-		return ast.NewIntegerLiteral(&ast.IntegerLiteral{
-			Value: big.NewInt(int64(out)).String(),
-		}), nil
-	case float64:
-		if out != math.Trunc(out) {
-			return nil, fmt.Errorf("floating point numbers are not supported in the shield language (value: %+v)", out)
+	case json.Number:
+		if len(out) > maxJSONNumberLength {
+			return nil, fmt.Errorf("numbers longer than %d characters are not supported (value: %.20s...)", maxJSONNumberLength, out)
+		}
+		if strings.ContainsAny(out.String(), "eE") {
+			// the exponent would let a short number expand into a huge integer
+			return nil, fmt.Errorf("numbers in exponent notation are not supported (value: %+v)", out)
+		}
+
+		i, ok := new(big.Int).SetString(out.String(), 10)
+		if !ok {
+			// e.g. 1.0
+			f, _, err := big.ParseFloat(out.String(), 10, 0, big.ToNearestEven)
+			if err != nil || !f.IsInt() {
+				return nil, fmt.Errorf("floating point numbers are not supported in the shield language (value: %+v)", out)
+			}
+			i, _ = f.Int(nil)
 		}
 		return ast.NewIntegerLiteral(&ast.IntegerLiteral{
-			Value: big.NewInt(int64(out)).String(),
+			Value: i.String(),
 		}), nil
 	case bool:
 		return ast.NewBooleanLiteral(&ast.BooleanLiteral{
 			Value: out,
 		}), nil
+	case []any:
+		elements := make([]*ast.Expression, 0, len(out))
+		for _, el := range out {
+			node, err := jsonToShieldExpression(el)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, node)
+		}
+		return ast.NewArrayLiteral(&ast.ArrayLiteral{
+			Elements: elements,
+		}), nil
+	case map[string]any:
+		keys := make([]string, 0, len(out))
+		for k := range out {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		entries := make([]*ast.ObjectEntry, 0, len(out))
+		for _, k := range keys {
+			node, err := jsonToShieldExpression(out[k])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			entries = append(entries, &ast.ObjectEntry{Key: k, Value: node})
+		}
+		return ast.NewObjectLiteral(&ast.ObjectLiteral{
+			Entries: entries,
+		}), nil
 	default:
 		return nil, fmt.Errorf("unsupported type: %T (value: %+v)", out, out)
 	}
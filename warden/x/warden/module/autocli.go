@@ -52,6 +52,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "NewSignRequest",
 					Skip:      true, // skipped because x/act gated
 				},
+				{
+					RpcMethod: "NewSpaceTreasury",
+					Skip:      true, // skipped because x/act gated
+				},
+				{
+					RpcMethod: "TreasurySend",
+					Skip:      true, // skipped because x/act gated
+				},
+				{
+					RpcMethod: "TreasuryIBCTransfer",
+					Skip:      true, // skipped because x/act gated
+				},
+				{
+					RpcMethod: "TreasuryExecuteContract",
+					Skip:      true, // skipped because x/act gated
+				},
 				{
 					RpcMethod: "FulfilKeyRequest",
 					Skip:      true, // skipped in favor of the two separate commands for fulfilling or rejecting the request
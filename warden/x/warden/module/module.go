@@ -195,6 +195,8 @@ type ModuleInputs struct {
 	BankKeeper    types.BankKeeper
 	ActKeeper     types.ActKeeper
 	GetWasmKeeper func() wasmkeeper.Keeper `optional:"true"`
+
+	GetTransferKeeper func() types.TransferKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -222,6 +224,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.ActKeeper,
 		in.GetWasmKeeper,
+		in.GetTransferKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
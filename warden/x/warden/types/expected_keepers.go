@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 )
@@ -17,15 +18,22 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
 	// Methods imported from bank should be defined here
 }
 
+// TransferKeeper defines the expected interface for the IBC transfer module.
+type TransferKeeper interface {
+	Transfer(ctx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error)
+}
+
 // ActKeeper defined the expected interface for the Act module.
 type ActKeeper interface {
 	IsValidTemplate(ctx context.Context, id uint64) error
 	GetTemplate(ctx context.Context, id uint64) (acttypes.Template, error)
 	GetActionCreator(ctx context.Context) string
 	TemplatesRegistry() *acttypes.TemplatesRegistry
+	DependencyChanged(ctx context.Context, dependency, reason string) error
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
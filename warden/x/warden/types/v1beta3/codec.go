@@ -15,7 +15,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgNewKeychain{},
 		&MsgNewSignRequest{},
 		&MsgNewSpace{},
+		&MsgNewSpaceTreasury{},
 		&MsgRemoveSpaceOwner{},
+		&MsgTreasuryExecuteContract{},
+		&MsgTreasuryIBCTransfer{},
+		&MsgTreasurySend{},
 		&MsgUpdateKeychain{},
 		&MsgUpdateSpace{},
 	)
@@ -25,7 +29,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAddSpaceOwnerResponse{},
 		&MsgNewKeyRequestResponse{},
 		&MsgNewSignRequestResponse{},
+		&MsgNewSpaceTreasuryResponse{},
 		&MsgRemoveSpaceOwnerResponse{},
+		&MsgTreasuryExecuteContractResponse{},
+		&MsgTreasuryIBCTransferResponse{},
+		&MsgTreasurySendResponse{},
 		&MsgUpdateSpaceResponse{},
 	)
 
@@ -26,4 +26,7 @@ var (
 	ErrAnalyzer                         = sdkerrors.Register(ModuleName, 1116, "analyzer error")
 	ErrDuplicateAnalyzersDataForSigning = sdkerrors.Register(ModuleName, 1117, "two or more contracts tried to set DataForSigning. Only one analyzer contract can return DataForSigning")
 	ErrInvalidNonce                     = sdkerrors.Register(ModuleName, 1118, "space nonce does not match expected")
+	ErrInvalidSpaceReference            = sdkerrors.Register(ModuleName, 1119, "invalid space reference")
+	ErrNoSpaceTreasury                  = sdkerrors.Register(ModuleName, 1120, "space doesn't have a treasury")
+	ErrDuplicateSpaceTreasury           = sdkerrors.Register(ModuleName, 1121, "space already has a treasury")
 )
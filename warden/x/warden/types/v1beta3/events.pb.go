@@ -206,6 +206,61 @@ func (m *EventUpdateSpace) GetRejectSignTemplateId() uint64 {
 	return 0
 }
 
+// EventNewSpaceTreasury is emitted when the treasury of a Space is created
+type EventNewSpaceTreasury struct {
+	// id of the space
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// address of the treasury account
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventNewSpaceTreasury) Reset()         { *m = EventNewSpaceTreasury{} }
+func (m *EventNewSpaceTreasury) String() string { return proto.CompactTextString(m) }
+func (*EventNewSpaceTreasury) ProtoMessage()    {}
+func (*EventNewSpaceTreasury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_322e8707aae1015e, []int{2}
+}
+func (m *EventNewSpaceTreasury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewSpaceTreasury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewSpaceTreasury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNewSpaceTreasury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewSpaceTreasury.Merge(m, src)
+}
+func (m *EventNewSpaceTreasury) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewSpaceTreasury) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewSpaceTreasury.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNewSpaceTreasury proto.InternalMessageInfo
+
+func (m *EventNewSpaceTreasury) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *EventNewSpaceTreasury) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // EventAddSpaceOwner is emitted when a new owner is added
 type EventAddSpaceOwner struct {
 	// id of the space being updated
@@ -220,7 +275,7 @@ func (m *EventAddSpaceOwner) Reset()         { *m = EventAddSpaceOwner{} }
 func (m *EventAddSpaceOwner) String() string { return proto.CompactTextString(m) }
 func (*EventAddSpaceOwner) ProtoMessage()    {}
 func (*EventAddSpaceOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{2}
+	return fileDescriptor_322e8707aae1015e, []int{3}
 }
 func (m *EventAddSpaceOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +339,7 @@ func (m *EventRemoveSpaceOwner) Reset()         { *m = EventRemoveSpaceOwner{} }
 func (m *EventRemoveSpaceOwner) String() string { return proto.CompactTextString(m) }
 func (*EventRemoveSpaceOwner) ProtoMessage()    {}
 func (*EventRemoveSpaceOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{3}
+	return fileDescriptor_322e8707aae1015e, []int{4}
 }
 func (m *EventRemoveSpaceOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +411,7 @@ func (m *EventNewKeyRequest) Reset()         { *m = EventNewKeyRequest{} }
 func (m *EventNewKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*EventNewKeyRequest) ProtoMessage()    {}
 func (*EventNewKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{4}
+	return fileDescriptor_322e8707aae1015e, []int{5}
 }
 func (m *EventNewKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +509,7 @@ func (m *EventNewKey) Reset()         { *m = EventNewKey{} }
 func (m *EventNewKey) String() string { return proto.CompactTextString(m) }
 func (*EventNewKey) ProtoMessage()    {}
 func (*EventNewKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{5}
+	return fileDescriptor_322e8707aae1015e, []int{6}
 }
 func (m *EventNewKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,7 +590,7 @@ func (m *EventRejectKeyRequest) Reset()         { *m = EventRejectKeyRequest{} }
 func (m *EventRejectKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*EventRejectKeyRequest) ProtoMessage()    {}
 func (*EventRejectKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{6}
+	return fileDescriptor_322e8707aae1015e, []int{7}
 }
 func (m *EventRejectKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +640,7 @@ func (m *EventUpdateKey) Reset()         { *m = EventUpdateKey{} }
 func (m *EventUpdateKey) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateKey) ProtoMessage()    {}
 func (*EventUpdateKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{7}
+	return fileDescriptor_322e8707aae1015e, []int{8}
 }
 func (m *EventUpdateKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +704,7 @@ func (m *EventNewSignRequest) Reset()         { *m = EventNewSignRequest{} }
 func (m *EventNewSignRequest) String() string { return proto.CompactTextString(m) }
 func (*EventNewSignRequest) ProtoMessage()    {}
 func (*EventNewSignRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{8}
+	return fileDescriptor_322e8707aae1015e, []int{9}
 }
 func (m *EventNewSignRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +764,7 @@ func (m *EventFulfilSignRequest) Reset()         { *m = EventFulfilSignRequest{}
 func (m *EventFulfilSignRequest) String() string { return proto.CompactTextString(m) }
 func (*EventFulfilSignRequest) ProtoMessage()    {}
 func (*EventFulfilSignRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{9}
+	return fileDescriptor_322e8707aae1015e, []int{10}
 }
 func (m *EventFulfilSignRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +810,7 @@ func (m *EventRejectSignRequest) Reset()         { *m = EventRejectSignRequest{}
 func (m *EventRejectSignRequest) String() string { return proto.CompactTextString(m) }
 func (*EventRejectSignRequest) ProtoMessage()    {}
 func (*EventRejectSignRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{10}
+	return fileDescriptor_322e8707aae1015e, []int{11}
 }
 func (m *EventRejectSignRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +860,7 @@ func (m *EventNewKeychain) Reset()         { *m = EventNewKeychain{} }
 func (m *EventNewKeychain) String() string { return proto.CompactTextString(m) }
 func (*EventNewKeychain) ProtoMessage()    {}
 func (*EventNewKeychain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{11}
+	return fileDescriptor_322e8707aae1015e, []int{12}
 }
 func (m *EventNewKeychain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,7 +922,7 @@ func (m *EventUpdateKeychain) Reset()         { *m = EventUpdateKeychain{} }
 func (m *EventUpdateKeychain) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateKeychain) ProtoMessage()    {}
 func (*EventUpdateKeychain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{12}
+	return fileDescriptor_322e8707aae1015e, []int{13}
 }
 func (m *EventUpdateKeychain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +979,7 @@ func (m *EventAddKeychainWriter) Reset()         { *m = EventAddKeychainWriter{}
 func (m *EventAddKeychainWriter) String() string { return proto.CompactTextString(m) }
 func (*EventAddKeychainWriter) ProtoMessage()    {}
 func (*EventAddKeychainWriter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{13}
+	return fileDescriptor_322e8707aae1015e, []int{14}
 }
 func (m *EventAddKeychainWriter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,7 +1043,7 @@ func (m *EventAddKeychainAdmin) Reset()         { *m = EventAddKeychainAdmin{} }
 func (m *EventAddKeychainAdmin) String() string { return proto.CompactTextString(m) }
 func (*EventAddKeychainAdmin) ProtoMessage()    {}
 func (*EventAddKeychainAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{14}
+	return fileDescriptor_322e8707aae1015e, []int{15}
 }
 func (m *EventAddKeychainAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1107,7 @@ func (m *EventRemoveKeychainAdmin) Reset()         { *m = EventRemoveKeychainAdm
 func (m *EventRemoveKeychainAdmin) String() string { return proto.CompactTextString(m) }
 func (*EventRemoveKeychainAdmin) ProtoMessage()    {}
 func (*EventRemoveKeychainAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_322e8707aae1015e, []int{15}
+	return fileDescriptor_322e8707aae1015e, []int{16}
 }
 func (m *EventRemoveKeychainAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,6 +1160,7 @@ func (m *EventRemoveKeychainAdmin) GetAdminsCount() uint64 {
 func init() {
 	proto.RegisterType((*EventCreateSpace)(nil), "warden.warden.v1beta3.EventCreateSpace")
 	proto.RegisterType((*EventUpdateSpace)(nil), "warden.warden.v1beta3.EventUpdateSpace")
+	proto.RegisterType((*EventNewSpaceTreasury)(nil), "warden.warden.v1beta3.EventNewSpaceTreasury")
 	proto.RegisterType((*EventAddSpaceOwner)(nil), "warden.warden.v1beta3.EventAddSpaceOwner")
 	proto.RegisterType((*EventRemoveSpaceOwner)(nil), "warden.warden.v1beta3.EventRemoveSpaceOwner")
 	proto.RegisterType((*EventNewKeyRequest)(nil), "warden.warden.v1beta3.EventNewKeyRequest")
@@ -1126,59 +1182,60 @@ func init() {
 }
 
 var fileDescriptor_322e8707aae1015e = []byte{
-	// 820 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x4f, 0xdb, 0x4a,
-	0x10, 0x8f, 0x9d, 0x7f, 0x30, 0x09, 0x88, 0x67, 0x08, 0xcf, 0xf0, 0xf4, 0x02, 0x98, 0x27, 0x3d,
-	0x0e, 0x8f, 0x44, 0x0f, 0x84, 0x2a, 0x8e, 0x01, 0x95, 0x2a, 0x42, 0xa2, 0x52, 0xa0, 0xad, 0xd4,
-	0x8b, 0x65, 0xbc, 0x43, 0x70, 0x93, 0xd8, 0xae, 0xed, 0x90, 0xa6, 0x52, 0x2b, 0xf5, 0xde, 0x43,
-	0xab, 0x7e, 0x95, 0x7e, 0x83, 0x5e, 0x38, 0xa2, 0x9e, 0x7a, 0xaa, 0x2a, 0xf8, 0x06, 0xfd, 0x04,
-	0x95, 0x77, 0xd7, 0xae, 0x8d, 0x93, 0x34, 0xa1, 0xa7, 0xec, 0xce, 0xfc, 0xc6, 0xbf, 0xc9, 0x6f,
-	0x66, 0x67, 0x17, 0x94, 0x9e, 0xe6, 0x10, 0x34, 0xab, 0xfc, 0xe7, 0xe2, 0xff, 0x53, 0xf4, 0xb4,
-	0xed, 0x2a, 0x5e, 0xa0, 0xe9, 0xb9, 0x15, 0xdb, 0xb1, 0x3c, 0x4b, 0x2a, 0x31, 0x67, 0x85, 0xff,
-	0x70, 0xcc, 0xf2, 0x92, 0x6e, 0xb9, 0x1d, 0xcb, 0x55, 0x29, 0xa8, 0xca, 0x36, 0x2c, 0x62, 0x79,
-	0xa1, 0x69, 0x35, 0x2d, 0x66, 0xf7, 0x57, 0xdc, 0xba, 0x32, 0x98, 0xab, 0x85, 0x7d, 0x0e, 0xf8,
-	0x67, 0x28, 0x40, 0x3f, 0xd7, 0x0c, 0x93, 0xa1, 0x94, 0x4f, 0x22, 0xcc, 0xdd, 0xf7, 0xf3, 0xdb,
-	0x77, 0x50, 0xf3, 0xf0, 0xd8, 0xd6, 0x74, 0x94, 0x66, 0x41, 0x34, 0x88, 0x2c, 0xac, 0x0a, 0x1b,
-	0x99, 0x86, 0x68, 0x10, 0x49, 0x86, 0xbc, 0xee, 0xbb, 0x2d, 0x47, 0x16, 0x57, 0x85, 0x8d, 0xe9,
-	0x46, 0xb0, 0x95, 0xd6, 0xa0, 0x68, 0xf5, 0x4c, 0x74, 0x5c, 0x55, 0xb7, 0xba, 0xa6, 0x27, 0xa7,
-	0x69, 0x4c, 0x81, 0xd9, 0xf6, 0x7d, 0x93, 0xb4, 0x0b, 0x4b, 0x9a, 0x6d, 0x3b, 0xd6, 0x05, 0xaa,
-	0x1a, 0xe9, 0x18, 0xa6, 0xea, 0x61, 0xc7, 0x6e, 0x6b, 0x1e, 0xaa, 0x06, 0x91, 0x33, 0x14, 0xbf,
-	0xc8, 0x01, 0x35, 0xdf, 0x7f, 0xc2, 0xdd, 0x75, 0x22, 0xdd, 0x03, 0xd9, 0xc1, 0x67, 0xa8, 0x7b,
-	0x03, 0x22, 0xb3, 0x34, 0xb2, 0xc4, 0xfc, 0x03, 0x02, 0x03, 0x4e, 0xd7, 0x68, 0xc6, 0x03, 0x73,
-	0x2c, 0x90, 0xfb, 0x8f, 0x8d, 0x66, 0x34, 0x70, 0x07, 0xfe, 0xe4, 0x8c, 0x89, 0xb8, 0x3c, 0x8d,
-	0x5b, 0x60, 0xee, 0x78, 0x98, 0xf2, 0x3e, 0x50, 0xf1, 0x91, 0x4d, 0x42, 0x15, 0x97, 0x60, 0xca,
-	0xf5, 0x17, 0x6a, 0xa8, 0x65, 0x9e, 0xee, 0xeb, 0x64, 0xb4, 0x26, 0xe2, 0x9d, 0x35, 0x49, 0xdf,
-	0x55, 0x93, 0xcc, 0x1d, 0x35, 0xc9, 0x8e, 0xd0, 0xc4, 0x02, 0x89, 0x4a, 0x52, 0x23, 0x84, 0xea,
-	0xf1, 0xd0, 0xef, 0x89, 0x51, 0xa2, 0xfc, 0x05, 0xd3, 0x26, 0xf6, 0x54, 0xda, 0x3b, 0xbc, 0xcf,
-	0xa6, 0x4c, 0xec, 0xb1, 0xb8, 0x5f, 0x37, 0x9a, 0xf2, 0x12, 0x4a, 0x94, 0xb0, 0x81, 0x1d, 0xff,
-	0x4f, 0x8c, 0xc5, 0xb9, 0x0e, 0x33, 0x0e, 0x85, 0x93, 0x18, 0x6f, 0x91, 0x1b, 0xc7, 0xe6, 0xfe,
-	0x20, 0xf2, 0x7f, 0x7b, 0x84, 0xbd, 0x43, 0xec, 0x37, 0xf0, 0x79, 0x17, 0x5d, 0x2f, 0x71, 0x90,
-	0xa2, 0x99, 0x88, 0xf1, 0x4c, 0x56, 0xa0, 0x10, 0x1c, 0xcd, 0x9f, 0xa5, 0x84, 0xc0, 0x54, 0x27,
-	0x52, 0x05, 0xe6, 0x83, 0xfa, 0x25, 0x4b, 0xf7, 0x07, 0x77, 0x45, 0xca, 0xf6, 0x1f, 0x48, 0xbc,
-	0x6c, 0xc9, 0x8a, 0xcd, 0x31, 0x4f, 0x04, 0xbd, 0x0b, 0x53, 0x2d, 0xec, 0xab, 0x5e, 0xdf, 0x46,
-	0x7a, 0x42, 0x66, 0xb7, 0xca, 0x95, 0x81, 0x93, 0xaa, 0x72, 0x88, 0xfd, 0x93, 0xbe, 0x8d, 0x8d,
-	0x7c, 0x8b, 0x2d, 0xa2, 0xd3, 0x21, 0x1f, 0x9b, 0x0e, 0xca, 0x77, 0x01, 0x0a, 0x11, 0x55, 0x12,
-	0x72, 0x44, 0x49, 0xc5, 0xc9, 0x48, 0xa3, 0x4a, 0xa6, 0x47, 0x2a, 0x99, 0x19, 0x57, 0xc9, 0xec,
-	0x64, 0x4a, 0xe6, 0x06, 0x2b, 0xa9, 0xfc, 0x1b, 0xb6, 0xa1, 0xef, 0x18, 0xde, 0x0c, 0xca, 0x6b,
-	0x98, 0x8d, 0xcc, 0x8c, 0x41, 0xfa, 0x0c, 0x49, 0x34, 0x3d, 0x59, 0xa2, 0x99, 0x21, 0x89, 0x3e,
-	0x86, 0xf9, 0xa0, 0x38, 0xfe, 0xd1, 0x1d, 0xd6, 0xb3, 0x25, 0xc8, 0xf9, 0x45, 0x0a, 0x3b, 0x36,
-	0xdb, 0xc2, 0x7e, 0x3d, 0x76, 0x27, 0xa4, 0xe3, 0x55, 0xdf, 0x80, 0x45, 0xfa, 0xdd, 0x83, 0x6e,
-	0xfb, 0xcc, 0x68, 0x8f, 0xf8, 0x74, 0x88, 0x6c, 0x84, 0xf3, 0x63, 0x18, 0xf2, 0xad, 0xc0, 0x07,
-	0x2c, 0xeb, 0x24, 0x5a, 0xc9, 0x09, 0xae, 0xa9, 0x23, 0x98, 0x09, 0x5b, 0xe2, 0x0c, 0xd1, 0xa5,
-	0x29, 0x17, 0xb6, 0xd6, 0x87, 0x77, 0x1b, 0xc5, 0x1e, 0x20, 0xba, 0x7b, 0x99, 0xcb, 0xaf, 0x2b,
-	0xa9, 0x46, 0xb1, 0x15, 0xb1, 0x29, 0x5d, 0x2e, 0x5d, 0x58, 0xba, 0xc1, 0x09, 0x25, 0x68, 0xc5,
-	0xdf, 0xa3, 0x6d, 0x73, 0xbd, 0x6a, 0x84, 0x04, 0xd8, 0x27, 0x8e, 0xe1, 0xa1, 0x93, 0x60, 0xfe,
-	0x1b, 0xc0, 0x9f, 0xa5, 0x3d, 0xea, 0xe5, 0x6a, 0xf8, 0xd3, 0x95, 0xc3, 0xd7, 0x61, 0x86, 0xb9,
-	0xe2, 0x23, 0xad, 0xc8, 0x8d, 0x6c, 0xa6, 0xbd, 0x11, 0x78, 0x27, 0x47, 0xe8, 0xe8, 0xa5, 0x92,
-	0x60, 0xdb, 0x61, 0x93, 0x9b, 0x5e, 0x48, 0x8c, 0x6c, 0x4f, 0xfe, 0xfc, 0x71, 0x73, 0x81, 0x3f,
-	0x63, 0x6a, 0x84, 0x38, 0xe8, 0xba, 0xc7, 0x9e, 0x63, 0x98, 0x4d, 0x3a, 0xd3, 0xd9, 0x67, 0xd6,
-	0xa0, 0x48, 0x43, 0x6e, 0xcd, 0x55, 0x66, 0x63, 0x39, 0xbc, 0x02, 0x39, 0x32, 0xd3, 0x47, 0x67,
-	0x51, 0x81, 0xec, 0x78, 0x19, 0x30, 0xd8, 0x18, 0xf4, 0x7b, 0xda, 0xe5, 0x75, 0x59, 0xb8, 0xba,
-	0x2e, 0x0b, 0xdf, 0xae, 0xcb, 0xc2, 0xbb, 0x9b, 0x72, 0xea, 0xea, 0xa6, 0x9c, 0xfa, 0x72, 0x53,
-	0x4e, 0x3d, 0x7d, 0xd0, 0x34, 0xbc, 0xf3, 0xee, 0x69, 0x45, 0xb7, 0x3a, 0xfc, 0x85, 0xb5, 0x49,
-	0x1f, 0x54, 0xba, 0xd5, 0xe6, 0xfb, 0x5b, 0xdb, 0xea, 0x8b, 0x60, 0xe1, 0x8f, 0x3b, 0x37, 0x78,
-	0x8f, 0x9d, 0xe6, 0x28, 0x6e, 0xfb, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0xf4, 0xed, 0x82,
-	0x3c, 0x0a, 0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x4e, 0xdb, 0x58,
+	0x14, 0x8e, 0x9d, 0x3f, 0x38, 0x09, 0x88, 0x31, 0x84, 0x31, 0x8c, 0x26, 0x80, 0x19, 0x69, 0x58,
+	0x0c, 0x89, 0x06, 0x84, 0x46, 0x2c, 0x03, 0x1a, 0x46, 0x11, 0x23, 0x46, 0x0a, 0xcc, 0x8c, 0x34,
+	0x1b, 0xcb, 0xf8, 0x1e, 0x82, 0x27, 0x89, 0xed, 0xda, 0x0e, 0xa9, 0x2b, 0xb5, 0x52, 0xf7, 0x5d,
+	0xb4, 0xea, 0xab, 0xf4, 0x0d, 0xba, 0x61, 0x89, 0xba, 0xea, 0xaa, 0xaa, 0xe0, 0x0d, 0xfa, 0x04,
+	0x95, 0xef, 0xbd, 0x76, 0x6d, 0x9c, 0xa4, 0x09, 0x5d, 0xc5, 0xf7, 0x9c, 0xef, 0xdc, 0xef, 0xe4,
+	0xbb, 0x9f, 0xcf, 0x35, 0x28, 0x03, 0xcd, 0x21, 0x68, 0xd6, 0xf9, 0xcf, 0xd5, 0xaf, 0xe7, 0xe8,
+	0x69, 0xbb, 0x75, 0xbc, 0x42, 0xd3, 0x73, 0x6b, 0xb6, 0x63, 0x79, 0x96, 0x54, 0x61, 0xc9, 0x1a,
+	0xff, 0xe1, 0x98, 0xd5, 0x15, 0xdd, 0x72, 0x7b, 0x96, 0xab, 0x52, 0x50, 0x9d, 0x2d, 0x58, 0xc5,
+	0xea, 0x52, 0xdb, 0x6a, 0x5b, 0x2c, 0x1e, 0x3c, 0xf1, 0xe8, 0xda, 0x70, 0xae, 0x0e, 0xfa, 0x1c,
+	0xf0, 0xd3, 0x48, 0x80, 0x7e, 0xa9, 0x19, 0x26, 0x43, 0x29, 0x6f, 0x45, 0x58, 0xf8, 0x3d, 0xe8,
+	0xef, 0xd0, 0x41, 0xcd, 0xc3, 0x53, 0x5b, 0xd3, 0x51, 0x9a, 0x07, 0xd1, 0x20, 0xb2, 0xb0, 0x2e,
+	0x6c, 0xe5, 0x5a, 0xa2, 0x41, 0x24, 0x19, 0x8a, 0x7a, 0x90, 0xb6, 0x1c, 0x59, 0x5c, 0x17, 0xb6,
+	0x66, 0x5b, 0xe1, 0x52, 0xda, 0x80, 0xb2, 0x35, 0x30, 0xd1, 0x71, 0x55, 0xdd, 0xea, 0x9b, 0x9e,
+	0x9c, 0xa5, 0x35, 0x25, 0x16, 0x3b, 0x0c, 0x42, 0xd2, 0x3e, 0xac, 0x68, 0xb6, 0xed, 0x58, 0x57,
+	0xa8, 0x6a, 0xa4, 0x67, 0x98, 0xaa, 0x87, 0x3d, 0xbb, 0xab, 0x79, 0xa8, 0x1a, 0x44, 0xce, 0x51,
+	0xfc, 0x32, 0x07, 0x34, 0x82, 0xfc, 0x19, 0x4f, 0x37, 0x89, 0xf4, 0x1b, 0xc8, 0x0e, 0xfe, 0x8f,
+	0xba, 0x37, 0xa4, 0x32, 0x4f, 0x2b, 0x2b, 0x2c, 0x3f, 0xa4, 0x30, 0xe4, 0x74, 0x8d, 0x76, 0xb2,
+	0xb0, 0xc0, 0x0a, 0x79, 0xfe, 0xd4, 0x68, 0xc7, 0x0b, 0xf7, 0xe0, 0x7b, 0xce, 0x98, 0xaa, 0x2b,
+	0xd2, 0xba, 0x25, 0x96, 0x4e, 0x96, 0x29, 0xaf, 0x42, 0x15, 0xff, 0xb6, 0x49, 0xa4, 0xe2, 0x0a,
+	0xcc, 0xb8, 0xc1, 0x83, 0x1a, 0x69, 0x59, 0xa4, 0xeb, 0x26, 0x19, 0xaf, 0x89, 0xf8, 0x60, 0x4d,
+	0xb2, 0x0f, 0xd5, 0x24, 0xf7, 0x40, 0x4d, 0xf2, 0x63, 0x34, 0xf9, 0x13, 0x2a, 0x54, 0x92, 0x13,
+	0x1c, 0x50, 0x3d, 0xce, 0x1c, 0xd4, 0xdc, 0xbe, 0xe3, 0x8f, 0xd3, 0x45, 0x86, 0xa2, 0x46, 0x88,
+	0x83, 0xae, 0x1b, 0x1a, 0x8d, 0x2f, 0x15, 0x0b, 0x24, 0xba, 0x5b, 0x83, 0x10, 0xba, 0xdb, 0x5f,
+	0x81, 0xc3, 0xc6, 0x6d, 0xf5, 0x03, 0xcc, 0x9a, 0x38, 0x50, 0xa9, 0x13, 0xf9, 0x66, 0x33, 0x26,
+	0x0e, 0x58, 0xdd, 0xd7, 0x6d, 0xab, 0x3c, 0xe1, 0xed, 0xb7, 0xb0, 0x17, 0x48, 0x32, 0x11, 0xe7,
+	0x26, 0xcc, 0x39, 0x14, 0x4e, 0x12, 0xbc, 0x65, 0x1e, 0x9c, 0x98, 0xfb, 0xb5, 0xc8, 0xff, 0xed,
+	0x09, 0x0e, 0x8e, 0xd1, 0x6f, 0xe1, 0xa3, 0x3e, 0xba, 0x5e, 0xea, 0xb5, 0x8c, 0x77, 0x22, 0x26,
+	0x3b, 0x59, 0x83, 0x52, 0xf8, 0xa2, 0x7f, 0x31, 0x06, 0x84, 0xa1, 0x26, 0x91, 0x6a, 0xb0, 0x18,
+	0xba, 0x21, 0x6d, 0x84, 0xef, 0x78, 0x2a, 0x66, 0x82, 0x5f, 0x40, 0xe2, 0x26, 0x48, 0x9f, 0xff,
+	0x02, 0xcb, 0xc4, 0xd0, 0xfb, 0x30, 0xd3, 0x41, 0x5f, 0xf5, 0x7c, 0x1b, 0xe9, 0xfb, 0x36, 0xbf,
+	0x53, 0xad, 0x0d, 0x9d, 0x7b, 0xb5, 0x63, 0xf4, 0xcf, 0x7c, 0x1b, 0x5b, 0xc5, 0x0e, 0x7b, 0x88,
+	0xcf, 0x9a, 0x62, 0x62, 0xd6, 0x28, 0x9f, 0x04, 0x28, 0xc5, 0x54, 0x49, 0xc9, 0x11, 0x27, 0x15,
+	0xa7, 0x23, 0x8d, 0x2b, 0x99, 0x1d, 0xab, 0x64, 0x6e, 0x52, 0x25, 0xf3, 0xd3, 0x29, 0x59, 0x18,
+	0xae, 0xa4, 0xf2, 0x73, 0x64, 0xc3, 0x20, 0x31, 0xda, 0x0c, 0xca, 0x33, 0x98, 0x8f, 0x4d, 0xa0,
+	0x61, 0xfa, 0x8c, 0x68, 0x34, 0x3b, 0x5d, 0xa3, 0xb9, 0x11, 0x8d, 0xfe, 0x03, 0x8b, 0xd1, 0xeb,
+	0x6e, 0xb4, 0xcd, 0x51, 0x9e, 0xad, 0x40, 0x21, 0x38, 0xa4, 0xc8, 0xb1, 0xf9, 0x0e, 0xfa, 0xcd,
+	0xc4, 0x0d, 0x93, 0x4d, 0x9e, 0xfa, 0x16, 0x2c, 0xd3, 0x7d, 0x8f, 0xfa, 0xdd, 0x0b, 0xa3, 0x3b,
+	0x66, 0xeb, 0x08, 0xd9, 0x8a, 0xa6, 0xd1, 0x28, 0xe4, 0x0b, 0x01, 0x16, 0x62, 0x4e, 0xa2, 0x27,
+	0x39, 0xc5, 0xa5, 0x77, 0x02, 0x73, 0x91, 0x25, 0x2e, 0x10, 0x5d, 0xda, 0x72, 0x69, 0x67, 0x73,
+	0xb4, 0xdb, 0x28, 0xf6, 0x08, 0xd1, 0x3d, 0xc8, 0x5d, 0x7f, 0x58, 0xcb, 0xb4, 0xca, 0x9d, 0x58,
+	0x4c, 0xe9, 0xc3, 0x62, 0xf2, 0xe8, 0x86, 0x37, 0x94, 0xa2, 0x15, 0xbf, 0x8d, 0xb6, 0xcb, 0xf5,
+	0x6a, 0x10, 0x12, 0x62, 0xff, 0x75, 0x0c, 0x0f, 0x9d, 0x14, 0xf3, 0x8f, 0x00, 0xc1, 0x2c, 0x1d,
+	0xd0, 0x2c, 0x57, 0x23, 0x98, 0xae, 0x1c, 0xbe, 0x09, 0x73, 0x2c, 0x95, 0x1c, 0x69, 0x65, 0x1e,
+	0x64, 0x33, 0xed, 0xb9, 0x00, 0x95, 0xfb, 0x74, 0xf4, 0x8a, 0x4a, 0xb1, 0xed, 0xb1, 0xc9, 0x4d,
+	0xaf, 0x37, 0x46, 0x76, 0x20, 0xbf, 0x7b, 0xb3, 0xbd, 0xc4, 0x3f, 0x8a, 0x1a, 0xec, 0x46, 0x38,
+	0xf5, 0x1c, 0xc3, 0x6c, 0xd3, 0x99, 0xce, 0xb6, 0xd9, 0x80, 0x32, 0x2d, 0xb9, 0x37, 0x57, 0x59,
+	0x8c, 0xf5, 0xf0, 0x14, 0xe4, 0xd8, 0x4c, 0x1f, 0xdf, 0x45, 0x0d, 0xf2, 0x93, 0x75, 0x90, 0xd7,
+	0x26, 0xa4, 0x3f, 0xd0, 0xae, 0x6f, 0xab, 0xc2, 0xcd, 0x6d, 0x55, 0xf8, 0x78, 0x5b, 0x15, 0x5e,
+	0xde, 0x55, 0x33, 0x37, 0x77, 0xd5, 0xcc, 0xfb, 0xbb, 0x6a, 0xe6, 0xbf, 0x3f, 0xda, 0x86, 0x77,
+	0xd9, 0x3f, 0xaf, 0xe9, 0x56, 0x8f, 0x7f, 0xaf, 0x6d, 0xd3, 0xcf, 0x33, 0xdd, 0xea, 0xf2, 0xf5,
+	0xbd, 0x65, 0xfd, 0x71, 0xf8, 0x10, 0x8c, 0x3b, 0x37, 0xfc, 0xba, 0x3b, 0x2f, 0x50, 0xdc, 0xee,
+	0xe7, 0x01, 0x00, 0x13, 0xcf, 0x8e, 0xe6, 0x8a, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventCreateSpace) Marshal() (dAtA []byte, err error) {
@@ -1289,6 +1346,41 @@ func (m *EventUpdateSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventNewSpaceTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewSpaceTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewSpaceTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAddSpaceOwner) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1913,6 +2005,22 @@ func (m *EventUpdateSpace) Size() (n int) {
 	return n
 }
 
+func (m *EventNewSpaceTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovEvents(uint64(m.SpaceId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventAddSpaceOwner) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2518,6 +2626,107 @@ func (m *EventUpdateSpace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventNewSpaceTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewSpaceTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewSpaceTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventAddSpaceOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
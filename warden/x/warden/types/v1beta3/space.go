@@ -3,10 +3,24 @@ package v1beta3
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
 	"github.com/warden-protocol/wardenprotocol/shield"
 	acttypes "github.com/warden-protocol/wardenprotocol/warden/x/act/types/v1beta1"
 )
 
+// Names of the x/act field resolvers returning the templates of a Space given
+// its ID. Message types of other modules can be bound to them with a x/act
+// TemplatesBinding on their space ID field.
+const (
+	// SpaceAdminTemplatesResolver returns the admin templates of the Space.
+	SpaceAdminTemplatesResolver = "warden.space.admin"
+
+	// SpaceSignTemplatesResolver returns the sign templates of the Space.
+	SpaceSignTemplatesResolver = "warden.space.sign"
+)
+
 func (w *Space) IsOwner(address string) bool {
 	for _, owner := range w.Owners {
 		if owner == address {
@@ -109,6 +123,28 @@ func (w *Space) TemplateRejectUpdateSpace() acttypes.Template {
 	return w.AnyOwnerTemplate()
 }
 
+func (w *Space) TemplateApproveNewSpaceTreasury() acttypes.Template {
+	return w.AnyOwnerTemplate()
+}
+
+func (w *Space) TemplateRejectNewSpaceTreasury() acttypes.Template {
+	return w.AnyOwnerTemplate()
+}
+
+func (w *Space) TemplateApproveTreasurySpend() acttypes.Template {
+	return w.AnyOwnerTemplate()
+}
+
+func (w *Space) TemplateRejectTreasurySpend() acttypes.Template {
+	return w.AnyOwnerTemplate()
+}
+
+// SpaceTreasuryAddress returns the address of the treasury of the Space with
+// the given ID, derived from the x/warden module address.
+func SpaceTreasuryAddress(spaceID uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("space_treasury"), sdk.Uint64ToBigEndian(spaceID))
+}
+
 // AnyOwnerTemplate returns a template that is satisfied when at least one of the owners of the space approves.
 func (w *Space) AnyOwnerTemplate() acttypes.Template {
 	expr, err := shield.Parse("any(1, warden.space.owners)")
@@ -5,6 +5,7 @@ package v1beta3
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -76,6 +77,17 @@ type Space struct {
 	// The default Template is to allow any operation when at least one of its
 	// owner approves it.
 	RejectSignTemplateId uint64 `protobuf:"varint,8,opt,name=reject_sign_template_id,json=rejectSignTemplateId,proto3" json:"reject_sign_template_id,omitempty"`
+	// Address of the treasury account of the space, empty if the space
+	// doesn't have a treasury.
+	//
+	// The treasury address is derived from the x/warden module address and the
+	// ID of the space, so no private key controls it. Funds can only be moved
+	// out of the treasury by Actions evaluated with the *sign* Templates of the
+	// space:
+	// - warden.warden.Msg.TreasurySend
+	// - warden.warden.Msg.TreasuryIBCTransfer
+	// - warden.warden.Msg.TreasuryExecuteContract
+	TreasuryAddress string `protobuf:"bytes,9,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
 }
 
 func (m *Space) Reset()         { *m = Space{} }
@@ -167,6 +179,13 @@ func (m *Space) GetRejectSignTemplateId() uint64 {
 	return 0
 }
 
+func (m *Space) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Space)(nil), "warden.warden.v1beta3.Space")
 }
@@ -174,26 +193,30 @@ func init() {
 func init() { proto.RegisterFile("warden/warden/v1beta3/space.proto", fileDescriptor_da76bdfe3c1772b2) }
 
 var fileDescriptor_da76bdfe3c1772b2 = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0xeb, 0xf4, 0xef, 0xab, 0x87, 0x6f, 0xb0, 0xda, 0x62, 0x16, 0xab, 0x30, 0x75, 0xa1,
-	0x15, 0xaa, 0x10, 0x62, 0x84, 0x05, 0xb1, 0xb6, 0x4c, 0x2c, 0x95, 0x63, 0x1f, 0x05, 0xa3, 0x26,
-	0xb6, 0x1c, 0xd3, 0xc2, 0x5d, 0x70, 0x4f, 0x2c, 0x8c, 0x1d, 0x19, 0x51, 0x72, 0x23, 0xa8, 0x8e,
-	0x23, 0x51, 0xe8, 0x74, 0xfc, 0xea, 0x79, 0x1f, 0x9f, 0xe1, 0xe0, 0x93, 0x0d, 0xb7, 0x12, 0xb2,
-	0x69, 0x18, 0xeb, 0xf3, 0x18, 0x1c, 0x9f, 0x4d, 0x73, 0xc3, 0x05, 0x4c, 0x8c, 0xd5, 0x4e, 0x93,
-	0x41, 0xc5, 0x26, 0x61, 0x84, 0xca, 0xe9, 0x7b, 0x84, 0xdb, 0x8b, 0x5d, 0x8d, 0xfc, 0xc7, 0x91,
-	0x92, 0x14, 0x8d, 0xd0, 0xb8, 0x35, 0x8f, 0x94, 0x24, 0x14, 0x77, 0x85, 0x05, 0xee, 0xb4, 0xa5,
-	0xd1, 0x08, 0x8d, 0x7b, 0xf3, 0x3a, 0x92, 0x21, 0xee, 0xe8, 0x4d, 0x06, 0x36, 0xa7, 0xcd, 0x51,
-	0x73, 0xdc, 0x9b, 0x87, 0x44, 0xfa, 0xb8, 0x9d, 0xe9, 0x4c, 0x00, 0x6d, 0xf9, 0x4f, 0xaa, 0x40,
-	0xae, 0xf0, 0x31, 0x37, 0xc6, 0xea, 0x35, 0x2c, 0xb9, 0x4c, 0x55, 0xb6, 0x74, 0x90, 0x9a, 0x15,
-	0x77, 0xb0, 0x54, 0x92, 0xb6, 0x7d, 0x73, 0x18, 0x0a, 0xd7, 0x3b, 0x7e, 0x1f, 0xf0, 0x9d, 0x24,
-	0x97, 0x98, 0x5a, 0x78, 0x02, 0xe1, 0x0e, 0x98, 0x1d, 0x6f, 0x0e, 0x2a, 0x7e, 0x40, 0xac, 0x77,
-	0xe6, 0x2a, 0xd9, 0x17, 0xbb, 0x95, 0x18, 0xf8, 0x42, 0x25, 0x3f, 0xc5, 0x0b, 0x7c, 0x14, 0x36,
-	0xfe, 0xf1, 0xfe, 0x79, 0xaf, 0x5f, 0xe1, 0x7d, 0xed, 0x86, 0x7f, 0x14, 0x0c, 0x6d, 0x0b, 0x86,
-	0xbe, 0x0a, 0x86, 0xde, 0x4a, 0xd6, 0xd8, 0x96, 0xac, 0xf1, 0x59, 0xb2, 0xc6, 0xc3, 0x6d, 0xa2,
-	0xdc, 0xe3, 0x73, 0x3c, 0x11, 0x3a, 0x0d, 0xd7, 0x39, 0xf3, 0xf7, 0x10, 0x7a, 0x15, 0xf2, 0xaf,
-	0x38, 0x7d, 0xa9, 0x1f, 0xee, 0xd5, 0x40, 0x5e, 0xdf, 0x32, 0xee, 0xf8, 0xde, 0xec, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0x3a, 0xf7, 0x05, 0x54, 0xeb, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x6e, 0xea, 0x30,
+	0x14, 0xc6, 0x09, 0x7f, 0x2f, 0x1e, 0xee, 0xbd, 0x8a, 0x80, 0x6b, 0xee, 0x10, 0xd1, 0x4e, 0x2c,
+	0x10, 0x55, 0xa8, 0xaa, 0x3a, 0x42, 0x87, 0xaa, 0x6b, 0xe8, 0xd4, 0x25, 0x32, 0xf6, 0x51, 0xea,
+	0x8a, 0xc4, 0x91, 0x6d, 0xa0, 0xbc, 0x41, 0xc7, 0x3e, 0x4c, 0x1f, 0xa2, 0x23, 0xea, 0xd4, 0xb1,
+	0x82, 0x17, 0xa9, 0xb0, 0x1d, 0xa9, 0xa5, 0x4c, 0x27, 0x5f, 0xbe, 0xef, 0x77, 0x8e, 0x74, 0x8e,
+	0xd1, 0xc9, 0x8a, 0x48, 0x06, 0x59, 0xe8, 0xca, 0xf2, 0x6c, 0x06, 0x9a, 0x8c, 0x42, 0x95, 0x13,
+	0x0a, 0xc3, 0x5c, 0x0a, 0x2d, 0xfc, 0xb6, 0xf5, 0x86, 0xae, 0xb8, 0xc8, 0xff, 0x2e, 0x15, 0x2a,
+	0x15, 0x2a, 0x36, 0xa1, 0xd0, 0x0a, 0x4b, 0x9c, 0x3e, 0x55, 0x50, 0x6d, 0xba, 0xef, 0xe0, 0xff,
+	0x46, 0x65, 0xce, 0xb0, 0xd7, 0xf3, 0xfa, 0xd5, 0xa8, 0xcc, 0x99, 0x8f, 0x51, 0x83, 0x4a, 0x20,
+	0x5a, 0x48, 0x5c, 0xee, 0x79, 0xfd, 0x66, 0x54, 0x48, 0xbf, 0x83, 0xea, 0x62, 0x95, 0x81, 0x54,
+	0xb8, 0xd2, 0xab, 0xf4, 0x9b, 0x91, 0x53, 0x7e, 0x0b, 0xd5, 0x32, 0x91, 0x51, 0xc0, 0x55, 0xd3,
+	0xc4, 0x0a, 0xff, 0x12, 0x75, 0x49, 0x9e, 0x4b, 0xb1, 0x84, 0x98, 0xb0, 0x94, 0x67, 0xb1, 0x86,
+	0x34, 0x9f, 0x13, 0x0d, 0x31, 0x67, 0xb8, 0x66, 0x92, 0x1d, 0x17, 0x18, 0xef, 0xfd, 0x5b, 0x67,
+	0xdf, 0x30, 0xff, 0x02, 0x61, 0x09, 0x0f, 0x40, 0xf5, 0x11, 0xb2, 0x6e, 0xc8, 0xb6, 0xf5, 0x8f,
+	0x80, 0xc5, 0x4c, 0xc5, 0x93, 0xef, 0x60, 0xc3, 0x82, 0xce, 0x9f, 0xf2, 0xe4, 0x2b, 0x78, 0x8e,
+	0xfe, 0xb9, 0x89, 0x3f, 0xb8, 0x5f, 0x86, 0x6b, 0x59, 0xfb, 0x00, 0xbb, 0x42, 0x7f, 0xb5, 0x04,
+	0xa2, 0x16, 0x72, 0x1d, 0x13, 0xc6, 0x24, 0x28, 0x85, 0x9b, 0xfb, 0xa5, 0x4d, 0xf0, 0xdb, 0xcb,
+	0xa0, 0xe5, 0x36, 0x3e, 0xb6, 0xce, 0x54, 0x4b, 0x9e, 0x25, 0xd1, 0x9f, 0x82, 0x70, 0xbf, 0x27,
+	0xe4, 0x75, 0x1b, 0x78, 0x9b, 0x6d, 0xe0, 0x7d, 0x6c, 0x03, 0xef, 0x79, 0x17, 0x94, 0x36, 0xbb,
+	0xa0, 0xf4, 0xbe, 0x0b, 0x4a, 0x77, 0xd7, 0x09, 0xd7, 0xf7, 0x8b, 0xd9, 0x90, 0x8a, 0xd4, 0x5d,
+	0x7f, 0x60, 0xae, 0x47, 0xc5, 0xdc, 0xe9, 0x03, 0x19, 0x3e, 0x16, 0x1f, 0x7a, 0x9d, 0x83, 0x2a,
+	0xde, 0xca, 0xac, 0x6e, 0x72, 0xa3, 0xcf, 0x01, 0x00, 0x0e, 0x9e, 0x38, 0xcb, 0x4b, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Space) Marshal() (dAtA []byte, err error) {
@@ -216,6 +239,13 @@ func (m *Space) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintSpace(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.RejectSignTemplateId != 0 {
 		i = encodeVarintSpace(dAtA, i, uint64(m.RejectSignTemplateId))
 		i--
@@ -310,6 +340,10 @@ func (m *Space) Size() (n int) {
 	if m.RejectSignTemplateId != 0 {
 		n += 1 + sovSpace(uint64(m.RejectSignTemplateId))
 	}
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovSpace(uint64(l))
+	}
 	return n
 }
 
@@ -526,6 +560,38 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpace(dAtA[iNdEx:])
@@ -1330,7 +1330,6 @@ type MsgFulfilKeyRequest struct {
 	RequestId uint64           `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Status    KeyRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=warden.warden.v1beta3.KeyRequestStatus" json:"status,omitempty"`
 	// Types that are valid to be assigned to Result:
-	//
 	//	*MsgFulfilKeyRequest_Key
 	//	*MsgFulfilKeyRequest_RejectReason
 	Result isMsgFulfilKeyRequest_Result `protobuf_oneof:"result"`
@@ -1764,7 +1763,6 @@ type MsgFulfilSignRequest struct {
 	// If status is rejected, the result will contain the reason.
 	//
 	// Types that are valid to be assigned to Result:
-	//
 	//	*MsgFulfilSignRequest_Payload
 	//	*MsgFulfilSignRequest_RejectReason
 	Result isMsgFulfilSignRequest_Result `protobuf_oneof:"result"`
@@ -1905,1607 +1903,1454 @@ func (m *MsgFulfilSignRequestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFulfilSignRequestResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "warden.warden.v1beta3.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "warden.warden.v1beta3.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgNewSpace)(nil), "warden.warden.v1beta3.MsgNewSpace")
-	proto.RegisterType((*MsgNewSpaceResponse)(nil), "warden.warden.v1beta3.MsgNewSpaceResponse")
-	proto.RegisterType((*MsgAddSpaceOwner)(nil), "warden.warden.v1beta3.MsgAddSpaceOwner")
-	proto.RegisterType((*MsgAddSpaceOwnerResponse)(nil), "warden.warden.v1beta3.MsgAddSpaceOwnerResponse")
-	proto.RegisterType((*MsgRemoveSpaceOwner)(nil), "warden.warden.v1beta3.MsgRemoveSpaceOwner")
-	proto.RegisterType((*MsgRemoveSpaceOwnerResponse)(nil), "warden.warden.v1beta3.MsgRemoveSpaceOwnerResponse")
-	proto.RegisterType((*MsgNewKeychain)(nil), "warden.warden.v1beta3.MsgNewKeychain")
-	proto.RegisterType((*MsgNewKeychainResponse)(nil), "warden.warden.v1beta3.MsgNewKeychainResponse")
-	proto.RegisterType((*MsgAddKeychainWriter)(nil), "warden.warden.v1beta3.MsgAddKeychainWriter")
-	proto.RegisterType((*MsgAddKeychainWriterResponse)(nil), "warden.warden.v1beta3.MsgAddKeychainWriterResponse")
-	proto.RegisterType((*MsgUpdateSpace)(nil), "warden.warden.v1beta3.MsgUpdateSpace")
-	proto.RegisterType((*MsgUpdateSpaceResponse)(nil), "warden.warden.v1beta3.MsgUpdateSpaceResponse")
-	proto.RegisterType((*MsgUpdateKeychain)(nil), "warden.warden.v1beta3.MsgUpdateKeychain")
-	proto.RegisterType((*MsgUpdateKeychainResponse)(nil), "warden.warden.v1beta3.MsgUpdateKeychainResponse")
-	proto.RegisterType((*MsgAddKeychainAdminRequest)(nil), "warden.warden.v1beta3.MsgAddKeychainAdminRequest")
-	proto.RegisterType((*MsgAddKeychainAdminResponse)(nil), "warden.warden.v1beta3.MsgAddKeychainAdminResponse")
-	proto.RegisterType((*MsgRemoveKeychainAdminRequest)(nil), "warden.warden.v1beta3.MsgRemoveKeychainAdminRequest")
-	proto.RegisterType((*MsgRemoveKeychainAdminResponse)(nil), "warden.warden.v1beta3.MsgRemoveKeychainAdminResponse")
-	proto.RegisterType((*MsgNewKeyRequest)(nil), "warden.warden.v1beta3.MsgNewKeyRequest")
-	proto.RegisterType((*MsgNewKeyRequestResponse)(nil), "warden.warden.v1beta3.MsgNewKeyRequestResponse")
-	proto.RegisterType((*MsgNewKey)(nil), "warden.warden.v1beta3.MsgNewKey")
-	proto.RegisterType((*MsgFulfilKeyRequest)(nil), "warden.warden.v1beta3.MsgFulfilKeyRequest")
-	proto.RegisterType((*MsgFulfilKeyRequestResponse)(nil), "warden.warden.v1beta3.MsgFulfilKeyRequestResponse")
-	proto.RegisterType((*MsgUpdateKey)(nil), "warden.warden.v1beta3.MsgUpdateKey")
-	proto.RegisterType((*MsgUpdateKeyResponse)(nil), "warden.warden.v1beta3.MsgUpdateKeyResponse")
-	proto.RegisterType((*MsgNewSignRequest)(nil), "warden.warden.v1beta3.MsgNewSignRequest")
-	proto.RegisterType((*MsgNewSignRequestResponse)(nil), "warden.warden.v1beta3.MsgNewSignRequestResponse")
-	proto.RegisterType((*MsgSignedData)(nil), "warden.warden.v1beta3.MsgSignedData")
-	proto.RegisterType((*MsgFulfilSignRequest)(nil), "warden.warden.v1beta3.MsgFulfilSignRequest")
-	proto.RegisterType((*MsgFulfilSignRequestResponse)(nil), "warden.warden.v1beta3.MsgFulfilSignRequestResponse")
+type MsgNewSpaceTreasury struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Nonce     uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func init() { proto.RegisterFile("warden/warden/v1beta3/tx.proto", fileDescriptor_35889fa9428b2166) }
-
-var fileDescriptor_35889fa9428b2166 = []byte{
-	// 1719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0xf5, 0xad, 0x67, 0xd9, 0x91, 0x19, 0xc7, 0x91, 0x95, 0x58, 0x36, 0x94, 0x78, 0x23,
-	0xd8, 0x6b, 0x29, 0x96, 0x93, 0x5d, 0x24, 0x97, 0x4d, 0xbc, 0x8b, 0x6c, 0xbc, 0x81, 0xb3, 0x0b,
-	0x3a, 0x8b, 0x02, 0x01, 0x0a, 0x61, 0x2c, 0x8e, 0x19, 0xd6, 0x12, 0xa9, 0x72, 0xa8, 0xd8, 0xea,
-	0xa9, 0x0d, 0x7a, 0x0a, 0xd0, 0xa2, 0xe8, 0xad, 0x3d, 0xf4, 0xd0, 0x53, 0xd1, 0x93, 0x0b, 0xf4,
-	0xd6, 0x43, 0x6f, 0x45, 0x8e, 0x41, 0x7b, 0xe9, 0xa9, 0x2d, 0x92, 0x83, 0xff, 0x80, 0xa2, 0xf7,
-	0x62, 0x3e, 0x48, 0x51, 0xa4, 0x48, 0xc9, 0x4e, 0x8a, 0x5e, 0x6c, 0xcd, 0xbc, 0xdf, 0xcc, 0xbc,
-	0x8f, 0xdf, 0x7b, 0xf3, 0x86, 0x50, 0x3a, 0x40, 0x96, 0x8a, 0x8d, 0x9a, 0xf8, 0xf7, 0x78, 0x7d,
-	0x17, 0xdb, 0x68, 0xa3, 0x66, 0x1f, 0x56, 0x3b, 0x96, 0x69, 0x9b, 0xf2, 0x39, 0x2e, 0xa8, 0x8a,
-	0x7f, 0x42, 0x5e, 0x9c, 0x41, 0x6d, 0xdd, 0x30, 0x6b, 0xec, 0x2f, 0x47, 0x16, 0x4b, 0x4d, 0x93,
-	0xb4, 0x4d, 0x52, 0xdb, 0x45, 0x04, 0x8b, 0x7d, 0xd6, 0x6b, 0x4d, 0x53, 0x37, 0x84, 0xfc, 0xbc,
-	0x90, 0xb7, 0x89, 0x56, 0x7b, 0xbc, 0x4e, 0xff, 0x09, 0xc1, 0x3c, 0x17, 0x34, 0xd8, 0xa8, 0xc6,
-	0x07, 0x42, 0x34, 0xab, 0x99, 0x9a, 0xc9, 0xe7, 0xe9, 0x2f, 0x67, 0x81, 0x66, 0x9a, 0x5a, 0x0b,
-	0xd7, 0xd8, 0x68, 0xb7, 0xbb, 0x57, 0x43, 0x46, 0x4f, 0x88, 0x16, 0x87, 0x9b, 0xb3, 0x8f, 0x1d,
-	0xc0, 0xe5, 0x50, 0x40, 0xf3, 0x11, 0x72, 0x75, 0x2d, 0x0f, 0x47, 0x75, 0x90, 0x85, 0xda, 0x8e,
-	0x6e, 0xcb, 0xc3, 0x31, 0x44, 0xd7, 0x0c, 0x64, 0x77, 0x2d, 0xcc, 0x61, 0xe5, 0x6f, 0x25, 0x38,
-	0xb3, 0x4d, 0xb4, 0xff, 0x77, 0x54, 0x64, 0xe3, 0xff, 0xb1, 0x0d, 0xe4, 0xbf, 0x41, 0x16, 0x75,
-	0xed, 0x47, 0xa6, 0xa5, 0xdb, 0xbd, 0x82, 0xb4, 0x24, 0x55, 0xb2, 0x9b, 0x85, 0xef, 0xbf, 0x5e,
-	0x9b, 0x15, 0xb6, 0xdf, 0x56, 0x55, 0x0b, 0x13, 0xb2, 0x63, 0x5b, 0xba, 0xa1, 0x29, 0x7d, 0xa8,
-	0x7c, 0x0b, 0x52, 0x5c, 0x85, 0x42, 0x6c, 0x49, 0xaa, 0x4c, 0xd6, 0x17, 0xaa, 0x43, 0xa3, 0x53,
-	0xe5, 0xc7, 0x6c, 0x66, 0x9f, 0xfd, 0xb4, 0x38, 0xf1, 0xc5, 0xf1, 0xd1, 0x8a, 0xa4, 0x88, 0x75,
-	0x37, 0xeb, 0x4f, 0x8e, 0x8f, 0x56, 0xfa, 0x3b, 0x3e, 0x3d, 0x3e, 0x5a, 0x71, 0x5c, 0x76, 0xe8,
-	0x58, 0xe2, 0xd3, 0xb6, 0x3c, 0x0f, 0xe7, 0x7d, 0x53, 0x0a, 0x26, 0x1d, 0xd3, 0x20, 0xb8, 0xfc,
-	0x5d, 0x0c, 0x26, 0xb7, 0x89, 0x76, 0x1f, 0x1f, 0xec, 0x74, 0x50, 0x13, 0xcb, 0x05, 0x48, 0x37,
-	0x2d, 0x8c, 0x6c, 0xd3, 0xe2, 0x66, 0x29, 0xce, 0x50, 0xbe, 0x01, 0xf3, 0xa8, 0xd3, 0xb1, 0xcc,
-	0xc7, 0xb8, 0x81, 0xd4, 0xb6, 0x6e, 0x34, 0x6c, 0xdc, 0xee, 0xb4, 0x90, 0x8d, 0x1b, 0xba, 0xca,
-	0xac, 0x49, 0x28, 0x73, 0x02, 0x70, 0x9b, 0xca, 0x1f, 0x08, 0xf1, 0x96, 0x2a, 0xff, 0x1d, 0x0a,
-	0x16, 0x7e, 0x0b, 0x37, 0xed, 0x21, 0x2b, 0xe3, 0x6c, 0xe5, 0x39, 0x2e, 0x1f, 0xb2, 0xd0, 0x39,
-	0x93, 0x46, 0x65, 0x60, 0x61, 0x82, 0x2f, 0x14, 0xf2, 0x1d, 0x5d, 0xf3, 0x2e, 0xbc, 0x0e, 0xe7,
-	0xc5, 0x89, 0x81, 0x75, 0x49, 0xb6, 0x6e, 0x96, 0x8b, 0x7d, 0xcb, 0x56, 0x61, 0x06, 0xa9, 0xaa,
-	0x6e, 0xeb, 0xa6, 0x81, 0x5a, 0x0d, 0xf3, 0xc0, 0xc0, 0x16, 0x29, 0xa4, 0x96, 0xe2, 0x95, 0xac,
-	0x92, 0xef, 0x0b, 0xfe, 0xcb, 0xe6, 0x6f, 0xe6, 0x68, 0x24, 0x1c, 0xf7, 0x94, 0x97, 0xe1, 0xac,
-	0xc7, 0x8f, 0x8e, 0x7f, 0xe5, 0x69, 0x88, 0xe9, 0x2a, 0x73, 0x65, 0x42, 0x89, 0xe9, 0x6a, 0xf9,
-	0x43, 0x09, 0xf2, 0xdb, 0x44, 0xbb, 0xad, 0xaa, 0x0c, 0xc7, 0xb6, 0x92, 0x2f, 0x06, 0xd8, 0xe4,
-	0xe5, 0xcc, 0x3c, 0x64, 0x08, 0xc5, 0xf6, 0xfd, 0x9c, 0x66, 0xe3, 0x2d, 0x55, 0xbe, 0x00, 0x59,
-	0x03, 0x1f, 0x70, 0x45, 0x99, 0x27, 0xb3, 0x4a, 0xc6, 0xc0, 0x07, 0x7c, 0xd7, 0x59, 0x48, 0x1a,
-	0xa6, 0xd1, 0xc4, 0xc2, 0x53, 0x7c, 0x70, 0x73, 0x7a, 0x90, 0x3f, 0xe5, 0x22, 0x14, 0xfc, 0xfa,
-	0xb8, 0xe4, 0x78, 0x2a, 0x31, 0xa3, 0x14, 0xdc, 0xa6, 0x1e, 0x7e, 0x0d, 0xfa, 0xce, 0x42, 0xd2,
-	0xab, 0x2b, 0x1f, 0x8c, 0xa9, 0xe8, 0x02, 0x5c, 0x18, 0xa2, 0x8b, 0xab, 0xeb, 0x6f, 0x12, 0x4c,
-	0xf3, 0x00, 0xdc, 0x13, 0x95, 0x20, 0x82, 0xcb, 0x32, 0x24, 0x0c, 0xd4, 0xc6, 0x4c, 0xbd, 0xac,
-	0xc2, 0x7e, 0xcb, 0xf7, 0x61, 0xca, 0xa9, 0x21, 0x8d, 0x3d, 0x8c, 0x09, 0xd3, 0x66, 0xb2, 0x7e,
-	0x29, 0x24, 0x43, 0x9d, 0x53, 0xee, 0x60, 0x4c, 0x36, 0x13, 0x34, 0x4f, 0x95, 0xdc, 0xbe, 0x67,
-	0x4e, 0x5e, 0x82, 0x49, 0x15, 0x93, 0xa6, 0xa5, 0x77, 0x28, 0x6b, 0x18, 0xed, 0xb2, 0x8a, 0x77,
-	0x4a, 0xce, 0x43, 0xbc, 0x6b, 0xb5, 0x0a, 0x29, 0x26, 0xa1, 0x3f, 0xe5, 0x05, 0x80, 0x7d, 0xdc,
-	0xa3, 0xf5, 0x97, 0x3a, 0x2f, 0xcd, 0x3d, 0x2b, 0x66, 0xb6, 0xd4, 0x41, 0xc6, 0xfd, 0x27, 0x91,
-	0x89, 0xe7, 0x13, 0xe5, 0x0a, 0xcc, 0x0d, 0x9a, 0x1d, 0x4a, 0xbd, 0x03, 0x98, 0xe5, 0x91, 0x76,
-	0x90, 0x6f, 0x58, 0xba, 0x8d, 0xad, 0x08, 0x37, 0x2d, 0xc2, 0xa4, 0xeb, 0x12, 0x37, 0x98, 0xe0,
-	0x4c, 0x6d, 0xa9, 0xf2, 0x1c, 0xa4, 0x0e, 0xd8, 0x26, 0x22, 0xa0, 0x62, 0xe4, 0x4b, 0x8d, 0x12,
-	0x5c, 0x1c, 0x76, 0xb0, 0x1b, 0xba, 0x1f, 0x62, 0x2c, 0x74, 0xbc, 0x3e, 0xf1, 0x32, 0xf4, 0x2a,
-	0x0c, 0xe3, 0x5c, 0x8a, 0x7b, 0xb8, 0x14, 0x5d, 0xbb, 0x12, 0xa7, 0xae, 0x5d, 0xc9, 0xd3, 0xd6,
-	0xae, 0xd4, 0x29, 0x6b, 0x57, 0x3a, 0xbc, 0x76, 0x05, 0xf2, 0xa5, 0xc0, 0x88, 0xe1, 0x71, 0xaa,
-	0xeb, 0xef, 0x4f, 0x62, 0x30, 0xe3, 0x8a, 0xc6, 0xc8, 0x96, 0x91, 0x34, 0x70, 0xd2, 0x29, 0x1e,
-	0x95, 0x4e, 0xc9, 0xd7, 0x9a, 0x4e, 0xa9, 0xd0, 0x74, 0x4a, 0x87, 0xa5, 0x53, 0x66, 0x54, 0x3a,
-	0x25, 0xf2, 0xc9, 0xf2, 0x05, 0x98, 0x0f, 0xb8, 0xc6, 0x75, 0xdc, 0xe7, 0x12, 0x14, 0x07, 0x99,
-	0xcc, 0x82, 0xae, 0xe0, 0xb7, 0xbb, 0x98, 0xd8, 0x23, 0x48, 0x3b, 0xd2, 0x8b, 0xd7, 0x79, 0x31,
-	0x67, 0x34, 0xe3, 0xae, 0x8c, 0xe8, 0x29, 0x68, 0x99, 0x67, 0x87, 0x87, 0xd4, 0xc9, 0xa0, 0x8e,
-	0xc2, 0x86, 0xcf, 0x24, 0x58, 0x70, 0xeb, 0xe8, 0x1f, 0x61, 0x46, 0x15, 0x92, 0xe3, 0x99, 0xc0,
-	0x61, 0x01, 0xfd, 0x97, 0xa0, 0x14, 0xa6, 0x9f, 0x30, 0xe1, 0x9b, 0x38, 0xbb, 0x43, 0x79, 0xcd,
-	0x1b, 0x4f, 0xeb, 0x88, 0x8a, 0xe1, 0x33, 0x28, 0x1e, 0x30, 0xe8, 0x06, 0x64, 0xf6, 0x71, 0xaf,
-	0x61, 0xf7, 0x3a, 0xfc, 0x86, 0x9a, 0xae, 0x97, 0xc2, 0x49, 0xfc, 0xa0, 0xd7, 0xc1, 0x4a, 0x7a,
-	0x9f, 0xff, 0x90, 0xab, 0x70, 0xd6, 0xa9, 0x01, 0xc1, 0xba, 0x31, 0x23, 0x44, 0x9e, 0xd4, 0xff,
-	0x2b, 0xc8, 0x22, 0xf5, 0x83, 0xd5, 0x22, 0xcf, 0x25, 0x1e, 0xf4, 0x07, 0x12, 0xcc, 0xb4, 0xd1,
-	0x61, 0x63, 0x30, 0xcf, 0xd2, 0x4b, 0xf1, 0xca, 0x64, 0x7d, 0xbe, 0x2a, 0x7c, 0x4e, 0xb9, 0x2e,
-	0x14, 0x5c, 0xaf, 0xfe, 0xd3, 0xd4, 0x8d, 0xcd, 0x3b, 0x34, 0xbb, 0xbe, 0xfc, 0x79, 0xb1, 0xa2,
-	0xe9, 0xf6, 0xa3, 0xee, 0x6e, 0xb5, 0x69, 0xb6, 0x45, 0xcf, 0x2e, 0xfe, 0xad, 0x11, 0x75, 0xbf,
-	0x46, 0xcd, 0x25, 0x6c, 0x01, 0xf9, 0xf4, 0xf8, 0x68, 0x25, 0xd7, 0xc2, 0x1a, 0x6a, 0xf6, 0x1a,
-	0xf4, 0x39, 0x40, 0x78, 0x47, 0x7a, 0xa6, 0x8d, 0x0e, 0xbd, 0x69, 0xdb, 0xaf, 0xbd, 0x99, 0xa8,
-	0x7b, 0x7c, 0x85, 0x35, 0x1c, 0x03, 0xc1, 0x0b, 0xbd, 0xb2, 0x56, 0x20, 0xeb, 0x62, 0x69, 0x36,
-	0x77, 0xba, 0xbb, 0x2d, 0xbd, 0x49, 0x0d, 0x66, 0xa0, 0x9c, 0x92, 0xe5, 0x33, 0xf7, 0x70, 0xaf,
-	0xfc, 0x7e, 0x8c, 0x35, 0x2b, 0x77, 0xba, 0xad, 0x3d, 0xbd, 0xe5, 0x21, 0x46, 0x78, 0x5d, 0x5b,
-	0x00, 0xb0, 0x38, 0xa8, 0x4f, 0x8b, 0xac, 0x98, 0xd9, 0x52, 0xe5, 0x7f, 0x40, 0x8a, 0xd8, 0xc8,
-	0xee, 0x12, 0xc6, 0x89, 0xe9, 0xfa, 0x95, 0xf0, 0xa8, 0x8b, 0xb3, 0x76, 0x18, 0x5c, 0x11, 0xcb,
-	0xe4, 0x6b, 0x10, 0xa7, 0x9a, 0xf2, 0x3e, 0x62, 0x29, 0x64, 0xb5, 0x6b, 0xdf, 0xdd, 0x09, 0x85,
-	0xc2, 0xe5, 0x65, 0x98, 0x12, 0x1c, 0xb0, 0x30, 0x22, 0x4e, 0xe7, 0x70, 0x77, 0x42, 0xc9, 0xf1,
-	0x69, 0x85, 0xcd, 0x0e, 0x16, 0xaf, 0xcd, 0x0c, 0xa4, 0x2c, 0x4c, 0xba, 0x2d, 0x5b, 0xa4, 0xbf,
-	0xdf, 0x0b, 0x6e, 0xee, 0x7c, 0x25, 0x41, 0xce, 0x5b, 0xe0, 0x46, 0xe4, 0xcd, 0x39, 0x48, 0x51,
-	0xee, 0xbb, 0xee, 0x49, 0xee, 0xe3, 0x1e, 0xcb, 0xf1, 0xa1, 0xbc, 0x4e, 0x9c, 0x8c, 0xd7, 0xc9,
-	0xe1, 0xbc, 0x0e, 0x30, 0x66, 0x8e, 0x35, 0x2e, 0xae, 0xca, 0xae, 0x2d, 0xbf, 0xf2, 0x7b, 0x8c,
-	0xf6, 0xdc, 0xba, 0x36, 0x66, 0xf9, 0x0a, 0x31, 0x68, 0x16, 0x92, 0xba, 0xd1, 0xe9, 0xda, 0x2c,
-	0xd4, 0x39, 0x85, 0x0f, 0xd8, 0x2b, 0xcf, 0x40, 0xad, 0xde, 0x3b, 0xf4, 0x19, 0x90, 0xa0, 0xcf,
-	0x80, 0xc8, 0x57, 0x9e, 0x03, 0x95, 0x97, 0x61, 0x1a, 0x1b, 0x4d, 0xab, 0xc7, 0xee, 0x25, 0xc6,
-	0xd6, 0x24, 0xdb, 0x76, 0xaa, 0x3f, 0x4b, 0x5d, 0x3f, 0x3c, 0x7f, 0x53, 0x7f, 0x7e, 0xfe, 0xa6,
-	0xa3, 0xf2, 0x77, 0x95, 0xdd, 0x90, 0x83, 0x4e, 0x0f, 0x4d, 0xe0, 0xab, 0x30, 0xb5, 0x4d, 0x34,
-	0x8a, 0xc4, 0xea, 0xbf, 0x90, 0x8d, 0x68, 0xb5, 0x25, 0x6c, 0xd4, 0x50, 0x91, 0x8d, 0x44, 0x16,
-	0x03, 0x71, 0x01, 0xe5, 0x8f, 0x63, 0x2c, 0xda, 0x9c, 0xc0, 0xde, 0xb8, 0x9e, 0x3a, 0x8f, 0x6f,
-	0xf9, 0xf2, 0xb8, 0x12, 0x92, 0x89, 0x9e, 0xc3, 0x7c, 0x89, 0x7c, 0x0b, 0xd2, 0x1d, 0xd4, 0x6b,
-	0x99, 0x48, 0x15, 0xc9, 0x7c, 0x39, 0x3c, 0x99, 0xfb, 0xb6, 0xde, 0x9d, 0x50, 0x9c, 0x65, 0xaf,
-	0x9a, 0xd4, 0xbc, 0x83, 0x0e, 0xf8, 0xc4, 0x71, 0x7b, 0xfd, 0xbd, 0x29, 0x88, 0x6f, 0x13, 0x4d,
-	0xde, 0x83, 0xdc, 0xc0, 0x67, 0x8a, 0xbf, 0x84, 0xeb, 0xe9, 0xc5, 0x15, 0xab, 0xe3, 0xe1, 0xdc,
-	0x30, 0x3f, 0x84, 0x8c, 0xfb, 0xc5, 0xa0, 0x1c, 0x59, 0xd8, 0x18, 0xa6, 0xb8, 0x32, 0x1a, 0xe3,
-	0xee, 0xad, 0xc3, 0xd4, 0xe0, 0xeb, 0xf8, 0x4a, 0xf8, 0xe2, 0x01, 0x60, 0xb1, 0x36, 0x26, 0xd0,
-	0x3d, 0xca, 0x82, 0x7c, 0xe0, 0x6d, 0x1b, 0xa1, 0xaa, 0x1f, 0x5b, 0xac, 0x8f, 0x8f, 0x75, 0xcf,
-	0x6c, 0xc2, 0xa4, 0xf7, 0x8d, 0xba, 0x3c, 0xea, 0x5a, 0x60, 0xb0, 0xe2, 0xda, 0x58, 0x30, 0xf7,
-	0x90, 0x2e, 0xcc, 0x04, 0xdf, 0x79, 0xab, 0x91, 0xee, 0x19, 0x04, 0x17, 0x37, 0x4e, 0x00, 0xf6,
-	0xda, 0xe6, 0x7d, 0xc4, 0x2d, 0x8f, 0x62, 0x15, 0x27, 0xc7, 0xda, 0x58, 0x30, 0xf7, 0x90, 0x16,
-	0x4c, 0xfb, 0x5e, 0x2e, 0x95, 0x51, 0x1b, 0xb8, 0x6e, 0xbc, 0x3a, 0x2e, 0xd2, 0xcb, 0xc6, 0xc1,
-	0x3e, 0xf3, 0xca, 0xa8, 0x48, 0x08, 0x60, 0x14, 0x1b, 0x87, 0x37, 0x3f, 0x16, 0xe4, 0x03, 0xcd,
-	0x4b, 0x04, 0x1b, 0xfd, 0xd8, 0x28, 0x36, 0x86, 0xb5, 0x03, 0xf2, 0x9b, 0x90, 0xed, 0xb7, 0x02,
-	0x97, 0xc6, 0xf0, 0x4e, 0x71, 0x75, 0x0c, 0x90, 0x37, 0x56, 0xbe, 0xdb, 0xb9, 0x12, 0x5d, 0x09,
-	0xfa, 0xc8, 0xa8, 0x58, 0x85, 0x5c, 0x3e, 0x5d, 0x98, 0x09, 0x5e, 0x1b, 0xab, 0xa3, 0xbc, 0xe2,
-	0x3d, 0x73, 0xe3, 0x04, 0x60, 0xf7, 0xd8, 0x1e, 0xe4, 0xfd, 0xaf, 0x2d, 0x79, 0x7d, 0xac, 0xf4,
-	0xf1, 0x3e, 0xbb, 0xa2, 0xc2, 0x17, 0xf6, 0x98, 0x93, 0x9f, 0x48, 0x70, 0x76, 0xc8, 0x4b, 0x49,
-	0xbe, 0x36, 0xaa, 0x30, 0x0d, 0xd5, 0xe0, 0xfa, 0x09, 0x57, 0x71, 0x25, 0x8a, 0xc9, 0x77, 0x69,
-	0x3b, 0xb1, 0x89, 0x9e, 0xbd, 0x28, 0x49, 0xcf, 0x5f, 0x94, 0xa4, 0x5f, 0x5e, 0x94, 0xa4, 0x8f,
-	0x5e, 0x96, 0x26, 0x9e, 0xbf, 0x2c, 0x4d, 0xfc, 0xf8, 0xb2, 0x34, 0xf1, 0xf0, 0xdf, 0x9e, 0x46,
-	0x85, 0x6f, 0xbd, 0xc6, 0xbe, 0xac, 0x37, 0xcd, 0x96, 0x18, 0xfb, 0x86, 0xfd, 0x2f, 0xd9, 0xac,
-	0x8b, 0x71, 0xbe, 0xcc, 0xef, 0xa6, 0x18, 0x6e, 0xe3, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55,
-	0xbf, 0x55, 0x31, 0xf3, 0x18, 0x00, 0x00,
+func (m *MsgNewSpaceTreasury) Reset()         { *m = MsgNewSpaceTreasury{} }
+func (m *MsgNewSpaceTreasury) String() string { return proto.CompactTextString(m) }
+func (*MsgNewSpaceTreasury) ProtoMessage()    {}
+func (*MsgNewSpaceTreasury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{32}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// Create a new Space. The creator will be the first owner of the Space.
-	NewSpace(ctx context.Context, in *MsgNewSpace, opts ...grpc.CallOption) (*MsgNewSpaceResponse, error)
-	// Add a new owner to a Space.
-	AddSpaceOwner(ctx context.Context, in *MsgAddSpaceOwner, opts ...grpc.CallOption) (*MsgAddSpaceOwnerResponse, error)
-	// Remove an owner from the Space.
-	RemoveSpaceOwner(ctx context.Context, in *MsgRemoveSpaceOwner, opts ...grpc.CallOption) (*MsgRemoveSpaceOwnerResponse, error)
-	// Create a new Keychain. The user will be the first admin of the Keychain.
-	NewKeychain(ctx context.Context, in *MsgNewKeychain, opts ...grpc.CallOption) (*MsgNewKeychainResponse, error)
-	// Add a new writer to a Keychain.
-	AddKeychainWriter(ctx context.Context, in *MsgAddKeychainWriter, opts ...grpc.CallOption) (*MsgAddKeychainWriterResponse, error)
-	// Update a Space.
-	UpdateSpace(ctx context.Context, in *MsgUpdateSpace, opts ...grpc.CallOption) (*MsgUpdateSpaceResponse, error)
-	// Update a Keychain.
-	UpdateKeychain(ctx context.Context, in *MsgUpdateKeychain, opts ...grpc.CallOption) (*MsgUpdateKeychainResponse, error)
-	// Create a new KeyRequest.
-	NewKeyRequest(ctx context.Context, in *MsgNewKeyRequest, opts ...grpc.CallOption) (*MsgNewKeyRequestResponse, error)
-	// Fulfil or reject a KeyRequest.
-	FulfilKeyRequest(ctx context.Context, in *MsgFulfilKeyRequest, opts ...grpc.CallOption) (*MsgFulfilKeyRequestResponse, error)
-	// Update a Key.
-	UpdateKey(ctx context.Context, in *MsgUpdateKey, opts ...grpc.CallOption) (*MsgUpdateKeyResponse, error)
-	// Create a new SignRequest.
-	NewSignRequest(ctx context.Context, in *MsgNewSignRequest, opts ...grpc.CallOption) (*MsgNewSignRequestResponse, error)
-	// Fulfil or reject a SignRequest.
-	FulfilSignRequest(ctx context.Context, in *MsgFulfilSignRequest, opts ...grpc.CallOption) (*MsgFulfilSignRequestResponse, error)
-	// Add a new admin to a Keychain
-	AddKeychainAdmin(ctx context.Context, in *MsgAddKeychainAdminRequest, opts ...grpc.CallOption) (*MsgAddKeychainAdminResponse, error)
-	// / Remove admin from a Keychain
-	RemoveKeychainAdmin(ctx context.Context, in *MsgRemoveKeychainAdminRequest, opts ...grpc.CallOption) (*MsgRemoveKeychainAdminResponse, error)
+func (m *MsgNewSpaceTreasury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgNewSpaceTreasury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNewSpaceTreasury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgNewSpaceTreasury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNewSpaceTreasury.Merge(m, src)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgNewSpaceTreasury) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) NewSpace(ctx context.Context, in *MsgNewSpace, opts ...grpc.CallOption) (*MsgNewSpaceResponse, error) {
-	out := new(MsgNewSpaceResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewSpace", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgNewSpaceTreasury) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNewSpaceTreasury.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddSpaceOwner(ctx context.Context, in *MsgAddSpaceOwner, opts ...grpc.CallOption) (*MsgAddSpaceOwnerResponse, error) {
-	out := new(MsgAddSpaceOwnerResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/AddSpaceOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgNewSpaceTreasury proto.InternalMessageInfo
+
+func (m *MsgNewSpaceTreasury) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveSpaceOwner(ctx context.Context, in *MsgRemoveSpaceOwner, opts ...grpc.CallOption) (*MsgRemoveSpaceOwnerResponse, error) {
-	out := new(MsgRemoveSpaceOwnerResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/RemoveSpaceOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgNewSpaceTreasury) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) NewKeychain(ctx context.Context, in *MsgNewKeychain, opts ...grpc.CallOption) (*MsgNewKeychainResponse, error) {
-	out := new(MsgNewKeychainResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewKeychain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgNewSpaceTreasury) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AddKeychainWriter(ctx context.Context, in *MsgAddKeychainWriter, opts ...grpc.CallOption) (*MsgAddKeychainWriterResponse, error) {
-	out := new(MsgAddKeychainWriterResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/AddKeychainWriter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgNewSpaceTreasuryResponse struct {
+	// address of the treasury account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *msgClient) UpdateSpace(ctx context.Context, in *MsgUpdateSpace, opts ...grpc.CallOption) (*MsgUpdateSpaceResponse, error) {
-	out := new(MsgUpdateSpaceResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateSpace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgNewSpaceTreasuryResponse) Reset()         { *m = MsgNewSpaceTreasuryResponse{} }
+func (m *MsgNewSpaceTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgNewSpaceTreasuryResponse) ProtoMessage()    {}
+func (*MsgNewSpaceTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{33}
+}
+func (m *MsgNewSpaceTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNewSpaceTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNewSpaceTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgNewSpaceTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNewSpaceTreasuryResponse.Merge(m, src)
+}
+func (m *MsgNewSpaceTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNewSpaceTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNewSpaceTreasuryResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateKeychain(ctx context.Context, in *MsgUpdateKeychain, opts ...grpc.CallOption) (*MsgUpdateKeychainResponse, error) {
-	out := new(MsgUpdateKeychainResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateKeychain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgNewSpaceTreasuryResponse proto.InternalMessageInfo
+
+func (m *MsgNewSpaceTreasuryResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) NewKeyRequest(ctx context.Context, in *MsgNewKeyRequest, opts ...grpc.CallOption) (*MsgNewKeyRequestResponse, error) {
-	out := new(MsgNewKeyRequestResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewKeyRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgTreasurySend struct {
+	Authority string                                   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId   uint64                                   `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	ToAddress string                                   `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (c *msgClient) FulfilKeyRequest(ctx context.Context, in *MsgFulfilKeyRequest, opts ...grpc.CallOption) (*MsgFulfilKeyRequestResponse, error) {
-	out := new(MsgFulfilKeyRequestResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/FulfilKeyRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTreasurySend) Reset()         { *m = MsgTreasurySend{} }
+func (m *MsgTreasurySend) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasurySend) ProtoMessage()    {}
+func (*MsgTreasurySend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{34}
+}
+func (m *MsgTreasurySend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTreasurySend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasurySend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTreasurySend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasurySend.Merge(m, src)
+}
+func (m *MsgTreasurySend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTreasurySend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasurySend.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateKey(ctx context.Context, in *MsgUpdateKey, opts ...grpc.CallOption) (*MsgUpdateKeyResponse, error) {
-	out := new(MsgUpdateKeyResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTreasurySend proto.InternalMessageInfo
+
+func (m *MsgTreasurySend) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) NewSignRequest(ctx context.Context, in *MsgNewSignRequest, opts ...grpc.CallOption) (*MsgNewSignRequestResponse, error) {
-	out := new(MsgNewSignRequestResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewSignRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTreasurySend) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) FulfilSignRequest(ctx context.Context, in *MsgFulfilSignRequest, opts ...grpc.CallOption) (*MsgFulfilSignRequestResponse, error) {
-	out := new(MsgFulfilSignRequestResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/FulfilSignRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTreasurySend) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddKeychainAdmin(ctx context.Context, in *MsgAddKeychainAdminRequest, opts ...grpc.CallOption) (*MsgAddKeychainAdminResponse, error) {
-	out := new(MsgAddKeychainAdminResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/AddKeychainAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTreasurySend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RemoveKeychainAdmin(ctx context.Context, in *MsgRemoveKeychainAdminRequest, opts ...grpc.CallOption) (*MsgRemoveKeychainAdminResponse, error) {
-	out := new(MsgRemoveKeychainAdminResponse)
-	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/RemoveKeychainAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgTreasurySendResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// Create a new Space. The creator will be the first owner of the Space.
-	NewSpace(context.Context, *MsgNewSpace) (*MsgNewSpaceResponse, error)
-	// Add a new owner to a Space.
-	AddSpaceOwner(context.Context, *MsgAddSpaceOwner) (*MsgAddSpaceOwnerResponse, error)
-	// Remove an owner from the Space.
-	RemoveSpaceOwner(context.Context, *MsgRemoveSpaceOwner) (*MsgRemoveSpaceOwnerResponse, error)
-	// Create a new Keychain. The user will be the first admin of the Keychain.
-	NewKeychain(context.Context, *MsgNewKeychain) (*MsgNewKeychainResponse, error)
-	// Add a new writer to a Keychain.
-	AddKeychainWriter(context.Context, *MsgAddKeychainWriter) (*MsgAddKeychainWriterResponse, error)
-	// Update a Space.
-	UpdateSpace(context.Context, *MsgUpdateSpace) (*MsgUpdateSpaceResponse, error)
-	// Update a Keychain.
-	UpdateKeychain(context.Context, *MsgUpdateKeychain) (*MsgUpdateKeychainResponse, error)
-	// Create a new KeyRequest.
-	NewKeyRequest(context.Context, *MsgNewKeyRequest) (*MsgNewKeyRequestResponse, error)
-	// Fulfil or reject a KeyRequest.
-	FulfilKeyRequest(context.Context, *MsgFulfilKeyRequest) (*MsgFulfilKeyRequestResponse, error)
-	// Update a Key.
-	UpdateKey(context.Context, *MsgUpdateKey) (*MsgUpdateKeyResponse, error)
-	// Create a new SignRequest.
-	NewSignRequest(context.Context, *MsgNewSignRequest) (*MsgNewSignRequestResponse, error)
-	// Fulfil or reject a SignRequest.
-	FulfilSignRequest(context.Context, *MsgFulfilSignRequest) (*MsgFulfilSignRequestResponse, error)
-	// Add a new admin to a Keychain
-	AddKeychainAdmin(context.Context, *MsgAddKeychainAdminRequest) (*MsgAddKeychainAdminResponse, error)
-	// / Remove admin from a Keychain
-	RemoveKeychainAdmin(context.Context, *MsgRemoveKeychainAdminRequest) (*MsgRemoveKeychainAdminResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
-}
-func (*UnimplementedMsgServer) NewSpace(ctx context.Context, req *MsgNewSpace) (*MsgNewSpaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewSpace not implemented")
-}
-func (*UnimplementedMsgServer) AddSpaceOwner(ctx context.Context, req *MsgAddSpaceOwner) (*MsgAddSpaceOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSpaceOwner not implemented")
-}
-func (*UnimplementedMsgServer) RemoveSpaceOwner(ctx context.Context, req *MsgRemoveSpaceOwner) (*MsgRemoveSpaceOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveSpaceOwner not implemented")
-}
-func (*UnimplementedMsgServer) NewKeychain(ctx context.Context, req *MsgNewKeychain) (*MsgNewKeychainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewKeychain not implemented")
-}
-func (*UnimplementedMsgServer) AddKeychainWriter(ctx context.Context, req *MsgAddKeychainWriter) (*MsgAddKeychainWriterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddKeychainWriter not implemented")
+func (m *MsgTreasurySendResponse) Reset()         { *m = MsgTreasurySendResponse{} }
+func (m *MsgTreasurySendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasurySendResponse) ProtoMessage()    {}
+func (*MsgTreasurySendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{35}
 }
-func (*UnimplementedMsgServer) UpdateSpace(ctx context.Context, req *MsgUpdateSpace) (*MsgUpdateSpaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpace not implemented")
+func (m *MsgTreasurySendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateKeychain(ctx context.Context, req *MsgUpdateKeychain) (*MsgUpdateKeychainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeychain not implemented")
+func (m *MsgTreasurySendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasurySendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) NewKeyRequest(ctx context.Context, req *MsgNewKeyRequest) (*MsgNewKeyRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewKeyRequest not implemented")
+func (m *MsgTreasurySendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasurySendResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) FulfilKeyRequest(ctx context.Context, req *MsgFulfilKeyRequest) (*MsgFulfilKeyRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfilKeyRequest not implemented")
+func (m *MsgTreasurySendResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateKey(ctx context.Context, req *MsgUpdateKey) (*MsgUpdateKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateKey not implemented")
+func (m *MsgTreasurySendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasurySendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTreasurySendResponse proto.InternalMessageInfo
+
+type MsgTreasuryIBCTransfer struct {
+	Authority     string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId       uint64     `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	SourcePort    string     `protobuf:"bytes,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel string     `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	Token         types.Coin `protobuf:"bytes,5,opt,name=token,proto3" json:"token"`
+	// address of the receiver on the counterparty chain
+	Receiver string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout height on the counterparty chain, zero values disable the timeout
+	// height
+	TimeoutRevisionNumber uint64 `protobuf:"varint,7,opt,name=timeout_revision_number,json=timeoutRevisionNumber,proto3" json:"timeout_revision_number,omitempty"`
+	TimeoutRevisionHeight uint64 `protobuf:"varint,8,opt,name=timeout_revision_height,json=timeoutRevisionHeight,proto3" json:"timeout_revision_height,omitempty"`
+	// timeout timestamp in nanoseconds on the counterparty chain, zero
+	// disables the timeout timestamp
+	TimeoutTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Memo             string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *MsgTreasuryIBCTransfer) Reset()         { *m = MsgTreasuryIBCTransfer{} }
+func (m *MsgTreasuryIBCTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasuryIBCTransfer) ProtoMessage()    {}
+func (*MsgTreasuryIBCTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{36}
+}
+func (m *MsgTreasuryIBCTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) NewSignRequest(ctx context.Context, req *MsgNewSignRequest) (*MsgNewSignRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewSignRequest not implemented")
+func (m *MsgTreasuryIBCTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasuryIBCTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) FulfilSignRequest(ctx context.Context, req *MsgFulfilSignRequest) (*MsgFulfilSignRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfilSignRequest not implemented")
+func (m *MsgTreasuryIBCTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasuryIBCTransfer.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddKeychainAdmin(ctx context.Context, req *MsgAddKeychainAdminRequest) (*MsgAddKeychainAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddKeychainAdmin not implemented")
+func (m *MsgTreasuryIBCTransfer) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveKeychainAdmin(ctx context.Context, req *MsgRemoveKeychainAdminRequest) (*MsgRemoveKeychainAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeychainAdmin not implemented")
+func (m *MsgTreasuryIBCTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasuryIBCTransfer.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgTreasuryIBCTransfer proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgTreasuryIBCTransfer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_NewSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewSpace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewSpace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/NewSpace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewSpace(ctx, req.(*MsgNewSpace))
+func (m *MsgTreasuryIBCTransfer) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddSpaceOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddSpaceOwner)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddSpaceOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/AddSpaceOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddSpaceOwner(ctx, req.(*MsgAddSpaceOwner))
+func (m *MsgTreasuryIBCTransfer) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveSpaceOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveSpaceOwner)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveSpaceOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/RemoveSpaceOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveSpaceOwner(ctx, req.(*MsgRemoveSpaceOwner))
+func (m *MsgTreasuryIBCTransfer) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_NewKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewKeychain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewKeychain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/NewKeychain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewKeychain(ctx, req.(*MsgNewKeychain))
+func (m *MsgTreasuryIBCTransfer) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_AddKeychainWriter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddKeychainWriter)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddKeychainWriter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/AddKeychainWriter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddKeychainWriter(ctx, req.(*MsgAddKeychainWriter))
+func (m *MsgTreasuryIBCTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSpace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSpace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/UpdateSpace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSpace(ctx, req.(*MsgUpdateSpace))
+func (m *MsgTreasuryIBCTransfer) GetTimeoutRevisionNumber() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateKeychain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateKeychain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/UpdateKeychain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateKeychain(ctx, req.(*MsgUpdateKeychain))
+func (m *MsgTreasuryIBCTransfer) GetTimeoutRevisionHeight() uint64 {
+	if m != nil {
+		return m.TimeoutRevisionHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_NewKeyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewKeyRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/NewKeyRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewKeyRequest(ctx, req.(*MsgNewKeyRequest))
+func (m *MsgTreasuryIBCTransfer) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_FulfilKeyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfilKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfilKeyRequest(ctx, in)
+func (m *MsgTreasuryIBCTransfer) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/FulfilKeyRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfilKeyRequest(ctx, req.(*MsgFulfilKeyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateKey)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/UpdateKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateKey(ctx, req.(*MsgUpdateKey))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgTreasuryIBCTransferResponse struct {
+	// sequence number of the IBC packet sent
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func _Msg_NewSignRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgNewSignRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).NewSignRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/NewSignRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).NewSignRequest(ctx, req.(*MsgNewSignRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgTreasuryIBCTransferResponse) Reset()         { *m = MsgTreasuryIBCTransferResponse{} }
+func (m *MsgTreasuryIBCTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasuryIBCTransferResponse) ProtoMessage()    {}
+func (*MsgTreasuryIBCTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{37}
 }
-
-func _Msg_FulfilSignRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfilSignRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfilSignRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/FulfilSignRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfilSignRequest(ctx, req.(*MsgFulfilSignRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgTreasuryIBCTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_AddKeychainAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddKeychainAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddKeychainAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/AddKeychainAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddKeychainAdmin(ctx, req.(*MsgAddKeychainAdminRequest))
+func (m *MsgTreasuryIBCTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasuryIBCTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_RemoveKeychainAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveKeychainAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveKeychainAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/warden.warden.v1beta3.Msg/RemoveKeychainAdmin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveKeychainAdmin(ctx, req.(*MsgRemoveKeychainAdminRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgTreasuryIBCTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasuryIBCTransferResponse.Merge(m, src)
 }
-
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "warden.warden.v1beta3.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "NewSpace",
-			Handler:    _Msg_NewSpace_Handler,
-		},
-		{
-			MethodName: "AddSpaceOwner",
-			Handler:    _Msg_AddSpaceOwner_Handler,
-		},
-		{
-			MethodName: "RemoveSpaceOwner",
-			Handler:    _Msg_RemoveSpaceOwner_Handler,
-		},
-		{
-			MethodName: "NewKeychain",
-			Handler:    _Msg_NewKeychain_Handler,
-		},
-		{
-			MethodName: "AddKeychainWriter",
-			Handler:    _Msg_AddKeychainWriter_Handler,
-		},
-		{
-			MethodName: "UpdateSpace",
-			Handler:    _Msg_UpdateSpace_Handler,
-		},
-		{
-			MethodName: "UpdateKeychain",
-			Handler:    _Msg_UpdateKeychain_Handler,
-		},
-		{
-			MethodName: "NewKeyRequest",
-			Handler:    _Msg_NewKeyRequest_Handler,
-		},
-		{
-			MethodName: "FulfilKeyRequest",
-			Handler:    _Msg_FulfilKeyRequest_Handler,
-		},
-		{
-			MethodName: "UpdateKey",
-			Handler:    _Msg_UpdateKey_Handler,
-		},
-		{
-			MethodName: "NewSignRequest",
-			Handler:    _Msg_NewSignRequest_Handler,
-		},
-		{
-			MethodName: "FulfilSignRequest",
-			Handler:    _Msg_FulfilSignRequest_Handler,
-		},
-		{
-			MethodName: "AddKeychainAdmin",
-			Handler:    _Msg_AddKeychainAdmin_Handler,
-		},
-		{
-			MethodName: "RemoveKeychainAdmin",
-			Handler:    _Msg_RemoveKeychainAdmin_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "warden/warden/v1beta3/tx.proto",
+func (m *MsgTreasuryIBCTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTreasuryIBCTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasuryIBCTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTreasuryIBCTransferResponse proto.InternalMessageInfo
+
+func (m *MsgTreasuryIBCTransferResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTreasuryExecuteContract struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SpaceId   uint64 `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Contract  string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// JSON encoded message passed to the contract
+	Msg   []byte                                   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTreasuryExecuteContract) Reset()         { *m = MsgTreasuryExecuteContract{} }
+func (m *MsgTreasuryExecuteContract) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasuryExecuteContract) ProtoMessage()    {}
+func (*MsgTreasuryExecuteContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{38}
+}
+func (m *MsgTreasuryExecuteContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTreasuryExecuteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasuryExecuteContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTreasuryExecuteContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasuryExecuteContract.Merge(m, src)
+}
+func (m *MsgTreasuryExecuteContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTreasuryExecuteContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasuryExecuteContract.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTreasuryExecuteContract proto.InternalMessageInfo
+
+func (m *MsgTreasuryExecuteContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTreasuryExecuteContract) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTreasuryExecuteContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func (m *MsgNewSpace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTreasuryExecuteContract) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgNewSpace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTreasuryExecuteContract) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
 }
 
-func (m *MsgNewSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdditionalOwners) > 0 {
-		for iNdEx := len(m.AdditionalOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AdditionalOwners[iNdEx])
-			copy(dAtA[i:], m.AdditionalOwners[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AdditionalOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x32
+type MsgTreasuryExecuteContractResponse struct {
+	// data returned by the contract
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgTreasuryExecuteContractResponse) Reset()         { *m = MsgTreasuryExecuteContractResponse{} }
+func (m *MsgTreasuryExecuteContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTreasuryExecuteContractResponse) ProtoMessage()    {}
+func (*MsgTreasuryExecuteContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35889fa9428b2166, []int{39}
+}
+func (m *MsgTreasuryExecuteContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTreasuryExecuteContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTreasuryExecuteContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RejectSignTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RejectSignTemplateId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ApproveSignTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApproveSignTemplateId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RejectAdminTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RejectAdminTemplateId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ApproveAdminTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApproveAdminTemplateId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTreasuryExecuteContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTreasuryExecuteContractResponse.Merge(m, src)
+}
+func (m *MsgTreasuryExecuteContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTreasuryExecuteContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTreasuryExecuteContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgNewSpaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTreasuryExecuteContractResponse proto.InternalMessageInfo
+
+func (m *MsgTreasuryExecuteContractResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgNewSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "warden.warden.v1beta3.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "warden.warden.v1beta3.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgNewSpace)(nil), "warden.warden.v1beta3.MsgNewSpace")
+	proto.RegisterType((*MsgNewSpaceResponse)(nil), "warden.warden.v1beta3.MsgNewSpaceResponse")
+	proto.RegisterType((*MsgAddSpaceOwner)(nil), "warden.warden.v1beta3.MsgAddSpaceOwner")
+	proto.RegisterType((*MsgAddSpaceOwnerResponse)(nil), "warden.warden.v1beta3.MsgAddSpaceOwnerResponse")
+	proto.RegisterType((*MsgRemoveSpaceOwner)(nil), "warden.warden.v1beta3.MsgRemoveSpaceOwner")
+	proto.RegisterType((*MsgRemoveSpaceOwnerResponse)(nil), "warden.warden.v1beta3.MsgRemoveSpaceOwnerResponse")
+	proto.RegisterType((*MsgNewKeychain)(nil), "warden.warden.v1beta3.MsgNewKeychain")
+	proto.RegisterType((*MsgNewKeychainResponse)(nil), "warden.warden.v1beta3.MsgNewKeychainResponse")
+	proto.RegisterType((*MsgAddKeychainWriter)(nil), "warden.warden.v1beta3.MsgAddKeychainWriter")
+	proto.RegisterType((*MsgAddKeychainWriterResponse)(nil), "warden.warden.v1beta3.MsgAddKeychainWriterResponse")
+	proto.RegisterType((*MsgUpdateSpace)(nil), "warden.warden.v1beta3.MsgUpdateSpace")
+	proto.RegisterType((*MsgUpdateSpaceResponse)(nil), "warden.warden.v1beta3.MsgUpdateSpaceResponse")
+	proto.RegisterType((*MsgUpdateKeychain)(nil), "warden.warden.v1beta3.MsgUpdateKeychain")
+	proto.RegisterType((*MsgUpdateKeychainResponse)(nil), "warden.warden.v1beta3.MsgUpdateKeychainResponse")
+	proto.RegisterType((*MsgAddKeychainAdminRequest)(nil), "warden.warden.v1beta3.MsgAddKeychainAdminRequest")
+	proto.RegisterType((*MsgAddKeychainAdminResponse)(nil), "warden.warden.v1beta3.MsgAddKeychainAdminResponse")
+	proto.RegisterType((*MsgRemoveKeychainAdminRequest)(nil), "warden.warden.v1beta3.MsgRemoveKeychainAdminRequest")
+	proto.RegisterType((*MsgRemoveKeychainAdminResponse)(nil), "warden.warden.v1beta3.MsgRemoveKeychainAdminResponse")
+	proto.RegisterType((*MsgNewKeyRequest)(nil), "warden.warden.v1beta3.MsgNewKeyRequest")
+	proto.RegisterType((*MsgNewKeyRequestResponse)(nil), "warden.warden.v1beta3.MsgNewKeyRequestResponse")
+	proto.RegisterType((*MsgNewKey)(nil), "warden.warden.v1beta3.MsgNewKey")
+	proto.RegisterType((*MsgFulfilKeyRequest)(nil), "warden.warden.v1beta3.MsgFulfilKeyRequest")
+	proto.RegisterType((*MsgFulfilKeyRequestResponse)(nil), "warden.warden.v1beta3.MsgFulfilKeyRequestResponse")
+	proto.RegisterType((*MsgUpdateKey)(nil), "warden.warden.v1beta3.MsgUpdateKey")
+	proto.RegisterType((*MsgUpdateKeyResponse)(nil), "warden.warden.v1beta3.MsgUpdateKeyResponse")
+	proto.RegisterType((*MsgNewSignRequest)(nil), "warden.warden.v1beta3.MsgNewSignRequest")
+	proto.RegisterType((*MsgNewSignRequestResponse)(nil), "warden.warden.v1beta3.MsgNewSignRequestResponse")
+	proto.RegisterType((*MsgSignedData)(nil), "warden.warden.v1beta3.MsgSignedData")
+	proto.RegisterType((*MsgFulfilSignRequest)(nil), "warden.warden.v1beta3.MsgFulfilSignRequest")
+	proto.RegisterType((*MsgFulfilSignRequestResponse)(nil), "warden.warden.v1beta3.MsgFulfilSignRequestResponse")
+	proto.RegisterType((*MsgNewSpaceTreasury)(nil), "warden.warden.v1beta3.MsgNewSpaceTreasury")
+	proto.RegisterType((*MsgNewSpaceTreasuryResponse)(nil), "warden.warden.v1beta3.MsgNewSpaceTreasuryResponse")
+	proto.RegisterType((*MsgTreasurySend)(nil), "warden.warden.v1beta3.MsgTreasurySend")
+	proto.RegisterType((*MsgTreasurySendResponse)(nil), "warden.warden.v1beta3.MsgTreasurySendResponse")
+	proto.RegisterType((*MsgTreasuryIBCTransfer)(nil), "warden.warden.v1beta3.MsgTreasuryIBCTransfer")
+	proto.RegisterType((*MsgTreasuryIBCTransferResponse)(nil), "warden.warden.v1beta3.MsgTreasuryIBCTransferResponse")
+	proto.RegisterType((*MsgTreasuryExecuteContract)(nil), "warden.warden.v1beta3.MsgTreasuryExecuteContract")
+	proto.RegisterType((*MsgTreasuryExecuteContractResponse)(nil), "warden.warden.v1beta3.MsgTreasuryExecuteContractResponse")
 }
 
-func (m *MsgNewSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("warden/warden/v1beta3/tx.proto", fileDescriptor_35889fa9428b2166) }
+
+var fileDescriptor_35889fa9428b2166 = []byte{
+	// 2105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0x8f, 0xbf, 0xed, 0x13, 0x27, 0xeb, 0x4c, 0xd3, 0xc4, 0x99, 0x6e, 0xdc, 0xc8, 0xbb, 0xa1,
+	0x56, 0x42, 0xec, 0xc6, 0x6d, 0xb6, 0xb4, 0x42, 0xa2, 0x4d, 0xa1, 0x34, 0xac, 0x52, 0x56, 0x93,
+	0x20, 0xa4, 0x95, 0x90, 0x75, 0x33, 0x73, 0xe3, 0x0c, 0xb1, 0x67, 0xcc, 0x7c, 0x24, 0x31, 0xbc,
+	0xa0, 0x15, 0x12, 0xd2, 0x4a, 0xac, 0x10, 0x6f, 0xf0, 0xc0, 0x03, 0x0f, 0x08, 0x21, 0x21, 0x15,
+	0x89, 0x37, 0x1e, 0x78, 0x43, 0xfb, 0xb8, 0x82, 0x17, 0x9e, 0x00, 0xb5, 0x48, 0xfd, 0x03, 0x10,
+	0xef, 0xe8, 0x7e, 0xcc, 0xf5, 0xd8, 0x33, 0xe3, 0x99, 0xa4, 0x65, 0x79, 0x89, 0xe7, 0xde, 0xf3,
+	0x3b, 0x73, 0xce, 0x3d, 0xf7, 0xfc, 0xce, 0x9c, 0x7b, 0x03, 0xb5, 0x73, 0x64, 0x69, 0xd8, 0x68,
+	0xf1, 0x9f, 0xb3, 0xed, 0x23, 0xec, 0xa0, 0x3b, 0x2d, 0xe7, 0xa2, 0x39, 0xb0, 0x4c, 0xc7, 0x94,
+	0xae, 0x33, 0x41, 0x93, 0xff, 0x70, 0xb9, 0xbc, 0x80, 0xfa, 0xba, 0x61, 0xb6, 0xe8, 0x5f, 0x86,
+	0x94, 0x6b, 0xaa, 0x69, 0xf7, 0x4d, 0xbb, 0x75, 0x84, 0x6c, 0xcc, 0xdf, 0xb3, 0xdd, 0x52, 0x4d,
+	0xdd, 0xe0, 0xf2, 0x65, 0x2e, 0xef, 0xdb, 0xdd, 0xd6, 0xd9, 0x36, 0xf9, 0xe1, 0x82, 0x15, 0x26,
+	0xe8, 0xd0, 0x51, 0x8b, 0x0d, 0xb8, 0x68, 0xb1, 0x6b, 0x76, 0x4d, 0x36, 0x4f, 0x9e, 0x3c, 0x85,
+	0xae, 0x69, 0x76, 0x7b, 0xb8, 0x45, 0x47, 0x47, 0xee, 0x71, 0x0b, 0x19, 0x43, 0x2e, 0xba, 0x19,
+	0xbe, 0x9c, 0x53, 0xec, 0x01, 0xde, 0x8d, 0x04, 0xa8, 0x27, 0x48, 0xf8, 0x5a, 0x0f, 0x47, 0x0d,
+	0x90, 0x85, 0xfa, 0x9e, 0x6f, 0xeb, 0xe1, 0x18, 0x5b, 0xef, 0x1a, 0xc8, 0x71, 0x2d, 0xcc, 0x60,
+	0xf5, 0x3f, 0xa5, 0xe0, 0xad, 0x7d, 0xbb, 0xfb, 0xad, 0x81, 0x86, 0x1c, 0xfc, 0x01, 0x7d, 0x81,
+	0xf4, 0x1e, 0x94, 0x90, 0xeb, 0x9c, 0x98, 0x96, 0xee, 0x0c, 0xab, 0xa9, 0xb5, 0x54, 0xa3, 0xb4,
+	0x5b, 0xfd, 0xcb, 0x1f, 0xb6, 0x16, 0xf9, 0xda, 0x1f, 0x69, 0x9a, 0x85, 0x6d, 0xfb, 0xc0, 0xb1,
+	0x74, 0xa3, 0xab, 0x8c, 0xa0, 0xd2, 0x43, 0xc8, 0x33, 0x17, 0xaa, 0xe9, 0xb5, 0x54, 0x63, 0xb6,
+	0xbd, 0xda, 0x0c, 0xdd, 0x9d, 0x26, 0x33, 0xb3, 0x5b, 0xfa, 0xf4, 0xef, 0x37, 0x67, 0x7e, 0xf3,
+	0xea, 0xf9, 0x46, 0x4a, 0xe1, 0x7a, 0x0f, 0xda, 0x1f, 0xbd, 0x7a, 0xbe, 0x31, 0x7a, 0xe3, 0xc7,
+	0xaf, 0x9e, 0x6f, 0x78, 0x21, 0xbb, 0xf0, 0x56, 0x32, 0xe1, 0x6d, 0x7d, 0x05, 0x96, 0x27, 0xa6,
+	0x14, 0x6c, 0x0f, 0x4c, 0xc3, 0xc6, 0xf5, 0x3f, 0xa7, 0x61, 0x76, 0xdf, 0xee, 0x3e, 0xc3, 0xe7,
+	0x07, 0x03, 0xa4, 0x62, 0xa9, 0x0a, 0x05, 0xd5, 0xc2, 0xc8, 0x31, 0x2d, 0xb6, 0x2c, 0xc5, 0x1b,
+	0x4a, 0xf7, 0x61, 0x05, 0x0d, 0x06, 0x96, 0x79, 0x86, 0x3b, 0x48, 0xeb, 0xeb, 0x46, 0xc7, 0xc1,
+	0xfd, 0x41, 0x0f, 0x39, 0xb8, 0xa3, 0x6b, 0x74, 0x35, 0x59, 0x65, 0x89, 0x03, 0x1e, 0x11, 0xf9,
+	0x21, 0x17, 0xef, 0x69, 0xd2, 0x3d, 0xa8, 0x5a, 0xf8, 0xbb, 0x58, 0x75, 0x42, 0x34, 0x33, 0x54,
+	0xf3, 0x3a, 0x93, 0x87, 0x28, 0x7a, 0x36, 0xc9, 0xae, 0x8c, 0x29, 0x66, 0x99, 0x22, 0x97, 0x1f,
+	0xe8, 0x5d, 0xbf, 0xe2, 0x0e, 0x2c, 0x73, 0x8b, 0x01, 0xbd, 0x1c, 0xd5, 0x5b, 0x64, 0xe2, 0x09,
+	0xb5, 0x4d, 0x58, 0x40, 0x9a, 0xa6, 0x3b, 0xba, 0x69, 0xa0, 0x5e, 0xc7, 0x3c, 0x37, 0xb0, 0x65,
+	0x57, 0xf3, 0x6b, 0x99, 0x46, 0x49, 0xa9, 0x8c, 0x04, 0xdf, 0xa4, 0xf3, 0x0f, 0xca, 0x64, 0x27,
+	0xbc, 0xf0, 0xd4, 0xd7, 0xe1, 0x9a, 0x2f, 0x8e, 0x5e, 0x7c, 0xa5, 0x79, 0x48, 0xeb, 0x1a, 0x0d,
+	0x65, 0x56, 0x49, 0xeb, 0x5a, 0xfd, 0x93, 0x14, 0x54, 0xf6, 0xed, 0xee, 0x23, 0x4d, 0xa3, 0x38,
+	0xfa, 0x2a, 0xe9, 0xed, 0x40, 0x36, 0xf9, 0x73, 0x66, 0x05, 0x8a, 0x36, 0xc1, 0x8e, 0xe2, 0x5c,
+	0xa0, 0xe3, 0x3d, 0x4d, 0xba, 0x01, 0x25, 0x03, 0x9f, 0x33, 0x47, 0x69, 0x24, 0x4b, 0x4a, 0xd1,
+	0xc0, 0xe7, 0xec, 0xad, 0x8b, 0x90, 0x33, 0x4c, 0x43, 0xc5, 0x3c, 0x52, 0x6c, 0xf0, 0x60, 0x7e,
+	0x3c, 0x7f, 0xea, 0x32, 0x54, 0x27, 0xfd, 0x11, 0xc9, 0xf1, 0x71, 0x8a, 0x2e, 0x4a, 0xc1, 0x7d,
+	0x12, 0xe1, 0x37, 0xe0, 0xef, 0x22, 0xe4, 0xfc, 0xbe, 0xb2, 0x41, 0x42, 0x47, 0x57, 0xe1, 0x46,
+	0x88, 0x2f, 0xc2, 0xd7, 0xff, 0xa4, 0x60, 0x9e, 0x6d, 0xc0, 0xfb, 0xbc, 0x12, 0x4c, 0xc9, 0x65,
+	0x09, 0xb2, 0x06, 0xea, 0x63, 0xea, 0x5e, 0x49, 0xa1, 0xcf, 0xd2, 0x33, 0x98, 0xf3, 0x6a, 0x48,
+	0xe7, 0x18, 0x63, 0x9b, 0x7a, 0x33, 0xdb, 0x7e, 0x27, 0x82, 0xa1, 0x9e, 0x95, 0x27, 0x18, 0xdb,
+	0xbb, 0x59, 0xc2, 0x53, 0xa5, 0x7c, 0xea, 0x9b, 0x93, 0xd6, 0x60, 0x56, 0xc3, 0xb6, 0x6a, 0xe9,
+	0x03, 0x92, 0x35, 0x34, 0xed, 0x4a, 0x8a, 0x7f, 0x4a, 0xaa, 0x40, 0xc6, 0xb5, 0x7a, 0xd5, 0x3c,
+	0x95, 0x90, 0x47, 0x69, 0x15, 0xe0, 0x14, 0x0f, 0x49, 0xfd, 0x25, 0xc1, 0x2b, 0xb0, 0xc8, 0xf2,
+	0x99, 0x3d, 0x6d, 0x3c, 0xe3, 0xbe, 0x91, 0x2d, 0x66, 0x2a, 0xd9, 0x7a, 0x03, 0x96, 0xc6, 0x97,
+	0x1d, 0x99, 0x7a, 0xe7, 0xb0, 0xc8, 0x76, 0xda, 0x43, 0x7e, 0xdb, 0xd2, 0x1d, 0x6c, 0x4d, 0x09,
+	0xd3, 0x4d, 0x98, 0x15, 0x21, 0x11, 0x9b, 0x09, 0xde, 0xd4, 0x9e, 0x26, 0x2d, 0x41, 0xfe, 0x9c,
+	0xbe, 0x84, 0x6f, 0x28, 0x1f, 0x4d, 0x50, 0xa3, 0x06, 0x6f, 0x87, 0x19, 0x16, 0x5b, 0xf7, 0xd7,
+	0x34, 0xcc, 0x8b, 0xfa, 0xc4, 0xca, 0xd0, 0xeb, 0x64, 0x18, 0xcb, 0xa5, 0x8c, 0x2f, 0x97, 0xa6,
+	0xd7, 0xae, 0xec, 0x95, 0x6b, 0x57, 0xee, 0xaa, 0xb5, 0x2b, 0x7f, 0xc5, 0xda, 0x55, 0x88, 0xae,
+	0x5d, 0x01, 0xbe, 0x54, 0x61, 0x69, 0x3c, 0xa8, 0x22, 0xde, 0x3f, 0x4f, 0xc3, 0x82, 0x10, 0x25,
+	0x60, 0x4b, 0x6c, 0x1a, 0x78, 0x74, 0xca, 0x4c, 0xa3, 0x53, 0xee, 0x8d, 0xd2, 0x29, 0x1f, 0x49,
+	0xa7, 0x42, 0x14, 0x9d, 0x8a, 0x71, 0x74, 0xca, 0x56, 0x72, 0xf5, 0x1b, 0xb0, 0x12, 0x08, 0x8d,
+	0x08, 0xdc, 0xaf, 0x52, 0x20, 0x8f, 0x67, 0x32, 0xdd, 0x74, 0x05, 0x7f, 0xcf, 0xc5, 0xb6, 0x13,
+	0x93, 0xb4, 0xb1, 0x51, 0xdc, 0x61, 0xc5, 0x9c, 0xa6, 0x59, 0x35, 0x13, 0xd3, 0x53, 0x90, 0x32,
+	0x4f, 0x8d, 0x47, 0xd4, 0xc9, 0xa0, 0x8f, 0x7c, 0x0d, 0xbf, 0x4c, 0xc1, 0xaa, 0xa8, 0xa3, 0xff,
+	0x8b, 0x65, 0x34, 0x21, 0x97, 0x6c, 0x09, 0x39, 0x14, 0xea, 0xff, 0x1a, 0xd4, 0xa2, 0xfc, 0xe3,
+	0x4b, 0xf8, 0x63, 0x06, 0x2a, 0xa2, 0xe6, 0x25, 0xf3, 0x7a, 0x4a, 0xc5, 0x98, 0x58, 0x50, 0x26,
+	0xb0, 0xa0, 0xfb, 0x50, 0x3c, 0xc5, 0xc3, 0x8e, 0x33, 0x1c, 0xb0, 0x2f, 0xd4, 0x7c, 0xbb, 0x16,
+	0x9d, 0xc4, 0x87, 0xc3, 0x01, 0x56, 0x0a, 0xa7, 0xec, 0x41, 0x6a, 0xc2, 0x35, 0xaf, 0x06, 0x04,
+	0xeb, 0xc6, 0x02, 0x17, 0xf9, 0xa8, 0xff, 0x45, 0x90, 0x38, 0xf5, 0x83, 0xd5, 0xa2, 0xc2, 0x24,
+	0x3e, 0xf4, 0x4f, 0x52, 0xb0, 0xd0, 0x47, 0x17, 0x9d, 0x71, 0x9e, 0x15, 0xd6, 0x32, 0x8d, 0xd9,
+	0xf6, 0x4a, 0x93, 0xc7, 0x9c, 0xe4, 0x3a, 0x77, 0x70, 0xbb, 0xf9, 0xd8, 0xd4, 0x8d, 0xdd, 0x27,
+	0x84, 0x5d, 0xbf, 0xfd, 0xc7, 0xcd, 0x46, 0x57, 0x77, 0x4e, 0xdc, 0xa3, 0xa6, 0x6a, 0xf6, 0x79,
+	0xcf, 0xce, 0x7f, 0xb6, 0x6c, 0xed, 0xb4, 0x45, 0x96, 0x6b, 0x53, 0x05, 0xfb, 0x17, 0xaf, 0x9e,
+	0x6f, 0x94, 0x7b, 0xb8, 0x8b, 0xd4, 0x61, 0x87, 0x1c, 0x07, 0x6c, 0xd6, 0x91, 0xbe, 0xd5, 0x47,
+	0x17, 0x7e, 0xda, 0x8e, 0x6a, 0x6f, 0x71, 0xda, 0x77, 0x7c, 0x03, 0xaa, 0x93, 0x9b, 0x17, 0xf9,
+	0xc9, 0xda, 0x80, 0x92, 0xc0, 0x12, 0x36, 0x0f, 0xdc, 0xa3, 0x9e, 0xae, 0x92, 0x05, 0x53, 0x50,
+	0x59, 0x29, 0xb1, 0x99, 0xf7, 0xf1, 0xb0, 0xfe, 0xa3, 0x34, 0x6d, 0x56, 0x9e, 0xb8, 0xbd, 0x63,
+	0xbd, 0xe7, 0x4b, 0x8c, 0xe8, 0xba, 0xb6, 0x0a, 0x60, 0x31, 0xd0, 0x28, 0x2d, 0x4a, 0x7c, 0x66,
+	0x4f, 0x93, 0xbe, 0x02, 0x79, 0xdb, 0x41, 0x8e, 0x6b, 0xd3, 0x9c, 0x98, 0x6f, 0xdf, 0x8a, 0xde,
+	0x75, 0x6e, 0xeb, 0x80, 0xc2, 0x15, 0xae, 0x26, 0xdd, 0x85, 0x0c, 0xf1, 0x94, 0xf5, 0x11, 0x6b,
+	0x11, 0xda, 0x62, 0x7d, 0x4f, 0x67, 0x14, 0x02, 0x97, 0xd6, 0x61, 0x8e, 0xe7, 0x80, 0x85, 0x91,
+	0xed, 0x75, 0x0e, 0x4f, 0x67, 0x94, 0x32, 0x9b, 0x56, 0xe8, 0xec, 0x78, 0xf1, 0xda, 0x2d, 0x42,
+	0xde, 0xc2, 0xb6, 0xdb, 0x73, 0x38, 0xfd, 0x27, 0xa3, 0x20, 0xb8, 0xf3, 0xfb, 0x14, 0x94, 0xfd,
+	0x05, 0x2e, 0x86, 0x37, 0xd7, 0x21, 0x4f, 0x72, 0x5f, 0x84, 0x27, 0x77, 0x8a, 0x87, 0x7b, 0x5a,
+	0x54, 0x5e, 0x67, 0x2f, 0x97, 0xd7, 0xb9, 0xf0, 0xbc, 0x0e, 0x64, 0xcc, 0x12, 0x2c, 0xfa, 0x5d,
+	0x16, 0x6b, 0xf9, 0x37, 0xfb, 0x8e, 0x91, 0x9e, 0x5b, 0xef, 0x26, 0x2c, 0x5f, 0x11, 0x0b, 0x5a,
+	0x84, 0x9c, 0x6e, 0x0c, 0x5c, 0x87, 0x6e, 0x75, 0x59, 0x61, 0x03, 0x7a, 0xca, 0x33, 0x50, 0x6f,
+	0xf8, 0x7d, 0x6c, 0x91, 0x76, 0x30, 0x13, 0x73, 0xca, 0xf3, 0xa0, 0xd2, 0x3a, 0xcc, 0x63, 0x43,
+	0xb5, 0x86, 0xf4, 0xbb, 0x44, 0xb3, 0x35, 0x47, 0x5f, 0x3b, 0x37, 0x9a, 0x25, 0xa1, 0x0f, 0xe7,
+	0x6f, 0xfe, 0xff, 0xcf, 0xdf, 0xc2, 0x34, 0xfe, 0x6e, 0xc2, 0x4a, 0x20, 0xe8, 0x91, 0x04, 0xbe,
+	0x0d, 0x73, 0xfb, 0x76, 0x97, 0x20, 0xb1, 0xf6, 0x55, 0xe4, 0x20, 0x52, 0x6d, 0x6d, 0x3a, 0xea,
+	0x68, 0xc8, 0x41, 0x9c, 0xc5, 0x60, 0x0b, 0x40, 0xfd, 0x67, 0x69, 0x58, 0x14, 0x09, 0xec, 0xdf,
+	0xd7, 0x2b, 0xf3, 0xf8, 0xe1, 0x04, 0x8f, 0x1b, 0x11, 0x4c, 0xf4, 0x19, 0x9b, 0x20, 0xf2, 0x43,
+	0x28, 0x0c, 0xd0, 0xb0, 0x67, 0x22, 0x8d, 0x93, 0xf9, 0xdd, 0x68, 0x32, 0x8f, 0xd6, 0xfa, 0x74,
+	0x46, 0xf1, 0xd4, 0x5e, 0x97, 0xd4, 0xac, 0x83, 0x0e, 0xc4, 0x44, 0x30, 0xc1, 0x19, 0x3b, 0x7c,
+	0x1e, 0x12, 0x1b, 0xae, 0x35, 0x7c, 0xc3, 0x5d, 0x74, 0x20, 0x13, 0xee, 0xc1, 0x8d, 0x10, 0xab,
+	0x22, 0x17, 0xaa, 0x50, 0x40, 0x8c, 0x21, 0xde, 0x86, 0xf1, 0x61, 0xfd, 0x93, 0x34, 0xbd, 0x51,
+	0xf1, 0x34, 0x0e, 0xb0, 0xa1, 0x5d, 0xdd, 0xd7, 0x7b, 0x00, 0x8e, 0xd9, 0xf1, 0x2c, 0xc5, 0x35,
+	0x1d, 0x25, 0xc7, 0xe4, 0x13, 0xd2, 0x10, 0xf2, 0xa8, 0x6f, 0xba, 0x86, 0x53, 0xcd, 0x7e, 0x5e,
+	0x94, 0xe3, 0x06, 0x03, 0x91, 0x64, 0x17, 0x34, 0xfe, 0x78, 0x88, 0xad, 0xfd, 0x5d, 0x06, 0x96,
+	0x7c, 0xb2, 0xbd, 0xdd, 0xc7, 0x87, 0x16, 0x32, 0xec, 0xe3, 0xd7, 0x39, 0x86, 0x13, 0x12, 0x9a,
+	0xae, 0xa5, 0xe2, 0xce, 0xc0, 0xb4, 0x1c, 0xde, 0xb6, 0x03, 0x9b, 0xfa, 0xc0, 0xb4, 0x1c, 0x52,
+	0xc0, 0x38, 0x40, 0x3d, 0x41, 0x86, 0x81, 0x7b, 0x34, 0xef, 0x4b, 0xca, 0x1c, 0x9b, 0x7d, 0xcc,
+	0x26, 0xa5, 0x1d, 0xc8, 0x39, 0xe6, 0x29, 0x36, 0x78, 0x6f, 0x3f, 0x25, 0x80, 0xac, 0xa3, 0x67,
+	0x68, 0x49, 0x86, 0xa2, 0x85, 0x55, 0xac, 0x9f, 0x61, 0x8b, 0xf7, 0xf1, 0x62, 0x2c, 0xbd, 0x07,
+	0xcb, 0x8e, 0xde, 0xc7, 0xa6, 0x4b, 0x98, 0x72, 0xa6, 0xdb, 0xa4, 0x80, 0x1a, 0x6e, 0xff, 0x08,
+	0x5b, 0xbc, 0x2a, 0x5d, 0xe7, 0x62, 0x85, 0x4b, 0x9f, 0x51, 0x61, 0xa8, 0xde, 0x09, 0xd6, 0xbb,
+	0x27, 0x4e, 0xb5, 0x18, 0xaa, 0xf7, 0x94, 0x0a, 0xc9, 0x8d, 0x8f, 0xa7, 0x47, 0x7e, 0x6d, 0x07,
+	0xf5, 0x07, 0xd5, 0x12, 0xfb, 0x30, 0x71, 0xc1, 0xa1, 0x37, 0x4f, 0xce, 0x39, 0x7d, 0xdc, 0x37,
+	0xab, 0xc0, 0xce, 0x39, 0xe4, 0x39, 0xb0, 0x95, 0x5f, 0x86, 0x5a, 0xf8, 0x76, 0x09, 0x5e, 0xc8,
+	0x50, 0xb4, 0x09, 0x7f, 0x09, 0xbf, 0x58, 0xa5, 0x14, 0xe3, 0xfa, 0xaf, 0xd3, 0x20, 0xfb, 0xd4,
+	0xbf, 0x76, 0x81, 0x55, 0xd7, 0xc1, 0x8f, 0x4d, 0xc3, 0xb1, 0x90, 0xfa, 0x1a, 0x4d, 0xee, 0x5d,
+	0x28, 0xaa, 0xfc, 0x25, 0xf1, 0x47, 0x0b, 0x0f, 0x49, 0x4e, 0x54, 0x7d, 0xbb, 0x4b, 0xf7, 0xbe,
+	0xac, 0x90, 0x47, 0xe9, 0x1c, 0x72, 0xc7, 0xae, 0xa1, 0x91, 0xd3, 0xdc, 0xe7, 0x44, 0x19, 0x66,
+	0x2f, 0x10, 0xe6, 0x2f, 0x41, 0x3d, 0x3a, 0x4e, 0x22, 0xd4, 0x12, 0x64, 0x7d, 0x9f, 0x19, 0xfa,
+	0xdc, 0xfe, 0x57, 0x05, 0x32, 0xfb, 0x76, 0x57, 0x3a, 0x86, 0xf2, 0xd8, 0x95, 0xee, 0x17, 0xa2,
+	0x6b, 0xba, 0x1f, 0x27, 0x37, 0x93, 0xe1, 0x84, 0x0f, 0x1f, 0x42, 0x51, 0xdc, 0xae, 0xd6, 0xa7,
+	0x36, 0x81, 0x14, 0x23, 0x6f, 0xc4, 0x63, 0xc4, 0xbb, 0x75, 0x98, 0x1b, 0xbf, 0x49, 0xbc, 0x15,
+	0xad, 0x3c, 0x06, 0x94, 0x5b, 0x09, 0x81, 0xc2, 0x94, 0x05, 0x95, 0xc0, 0x3d, 0xe0, 0x14, 0x57,
+	0x27, 0xb1, 0x72, 0x3b, 0x39, 0x56, 0xd8, 0x54, 0x61, 0xd6, 0x7f, 0x9f, 0xb7, 0x1e, 0xd7, 0x42,
+	0x53, 0x98, 0xbc, 0x95, 0x08, 0x26, 0x8c, 0xb8, 0xb0, 0x10, 0xbc, 0x13, 0xdb, 0x9c, 0x1a, 0x9e,
+	0x71, 0xb0, 0x7c, 0xe7, 0x12, 0x60, 0xff, 0xda, 0xfc, 0x17, 0x5e, 0xeb, 0x71, 0x59, 0xc5, 0x92,
+	0x63, 0x2b, 0x11, 0x4c, 0x18, 0xe9, 0xc1, 0xfc, 0xc4, 0x2d, 0x4f, 0x23, 0xee, 0x05, 0x22, 0x8c,
+	0xb7, 0x93, 0x22, 0xfd, 0xd9, 0x38, 0x7e, 0x26, 0xbf, 0x15, 0xb7, 0x13, 0x1c, 0x28, 0xb7, 0x12,
+	0x02, 0xfd, 0xd9, 0x18, 0x38, 0xe8, 0x4d, 0xc9, 0xc6, 0x49, 0xac, 0xdc, 0x4e, 0x8e, 0x15, 0x36,
+	0xbf, 0x03, 0xa5, 0xd1, 0xb1, 0xe9, 0x9d, 0x04, 0xd1, 0x91, 0x37, 0x13, 0x80, 0xfc, 0x7b, 0x35,
+	0x71, 0x92, 0x69, 0x4c, 0xaf, 0x04, 0x23, 0xa4, 0x7c, 0x3b, 0x29, 0xd2, 0x9f, 0xf5, 0xc1, 0x16,
+	0x7b, 0x33, 0x2e, 0x2a, 0x7e, 0x9b, 0x77, 0x2e, 0x01, 0x16, 0x66, 0x87, 0x50, 0x99, 0xbc, 0x99,
+	0x92, 0xb6, 0x13, 0xd1, 0xc7, 0x7f, 0x45, 0x25, 0xb7, 0x2f, 0xa3, 0xc2, 0x4d, 0x7f, 0x94, 0x82,
+	0x6b, 0x21, 0xb7, 0x4a, 0xd2, 0xdd, 0xb8, 0xc2, 0x14, 0xea, 0xc1, 0xce, 0x25, 0xb5, 0x46, 0x79,
+	0x1b, 0xe8, 0xd2, 0x13, 0x14, 0x7c, 0x0f, 0x2b, 0xb7, 0x93, 0x63, 0x85, 0xcd, 0x63, 0x28, 0x8f,
+	0x75, 0xda, 0x53, 0x3e, 0x74, 0x7e, 0x9c, 0xdc, 0x4c, 0x86, 0x13, 0x76, 0x7e, 0x00, 0xd7, 0xc2,
+	0xba, 0xd4, 0xad, 0xf8, 0xd7, 0xf8, 0xe0, 0xf2, 0xce, 0xa5, 0xe0, 0xc2, 0xf8, 0x8f, 0x53, 0xb0,
+	0x1c, 0xd5, 0x35, 0x6d, 0xc7, 0xbf, 0x72, 0x42, 0x45, 0xbe, 0x7f, 0x69, 0x15, 0xcf, 0x13, 0x39,
+	0xf7, 0x43, 0xd2, 0xb7, 0xec, 0xa2, 0x4f, 0x5f, 0xd4, 0x52, 0x9f, 0xbd, 0xa8, 0xa5, 0xfe, 0xf9,
+	0xa2, 0x96, 0xfa, 0xe9, 0xcb, 0xda, 0xcc, 0x67, 0x2f, 0x6b, 0x33, 0x7f, 0x7b, 0x59, 0x9b, 0xf9,
+	0xf0, 0xeb, 0xbe, 0x8e, 0x88, 0xbd, 0x7e, 0x8b, 0xfe, 0xa3, 0x59, 0x35, 0x7b, 0x7c, 0x3c, 0x31,
+	0x1c, 0xfd, 0x63, 0x97, 0xb6, 0x4b, 0xde, 0x3f, 0xaa, 0x8f, 0xf2, 0x14, 0x77, 0xe7, 0xbf, 0x03,
+	0x00, 0x0e, 0xaa, 0x04, 0xff, 0x02, 0x20, 0x00, 0x00,
 }
 
-func (m *MsgAddSpaceOwner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Create a new Space. The creator will be the first owner of the Space.
+	NewSpace(ctx context.Context, in *MsgNewSpace, opts ...grpc.CallOption) (*MsgNewSpaceResponse, error)
+	// Add a new owner to a Space.
+	AddSpaceOwner(ctx context.Context, in *MsgAddSpaceOwner, opts ...grpc.CallOption) (*MsgAddSpaceOwnerResponse, error)
+	// Remove an owner from the Space.
+	RemoveSpaceOwner(ctx context.Context, in *MsgRemoveSpaceOwner, opts ...grpc.CallOption) (*MsgRemoveSpaceOwnerResponse, error)
+	// Create a new Keychain. The user will be the first admin of the Keychain.
+	NewKeychain(ctx context.Context, in *MsgNewKeychain, opts ...grpc.CallOption) (*MsgNewKeychainResponse, error)
+	// Add a new writer to a Keychain.
+	AddKeychainWriter(ctx context.Context, in *MsgAddKeychainWriter, opts ...grpc.CallOption) (*MsgAddKeychainWriterResponse, error)
+	// Update a Space.
+	UpdateSpace(ctx context.Context, in *MsgUpdateSpace, opts ...grpc.CallOption) (*MsgUpdateSpaceResponse, error)
+	// Update a Keychain.
+	UpdateKeychain(ctx context.Context, in *MsgUpdateKeychain, opts ...grpc.CallOption) (*MsgUpdateKeychainResponse, error)
+	// Create a new KeyRequest.
+	NewKeyRequest(ctx context.Context, in *MsgNewKeyRequest, opts ...grpc.CallOption) (*MsgNewKeyRequestResponse, error)
+	// Fulfil or reject a KeyRequest.
+	FulfilKeyRequest(ctx context.Context, in *MsgFulfilKeyRequest, opts ...grpc.CallOption) (*MsgFulfilKeyRequestResponse, error)
+	// Update a Key.
+	UpdateKey(ctx context.Context, in *MsgUpdateKey, opts ...grpc.CallOption) (*MsgUpdateKeyResponse, error)
+	// Create a new SignRequest.
+	NewSignRequest(ctx context.Context, in *MsgNewSignRequest, opts ...grpc.CallOption) (*MsgNewSignRequestResponse, error)
+	// Fulfil or reject a SignRequest.
+	FulfilSignRequest(ctx context.Context, in *MsgFulfilSignRequest, opts ...grpc.CallOption) (*MsgFulfilSignRequestResponse, error)
+	// Add a new admin to a Keychain
+	AddKeychainAdmin(ctx context.Context, in *MsgAddKeychainAdminRequest, opts ...grpc.CallOption) (*MsgAddKeychainAdminResponse, error)
+	/// Remove admin from a Keychain
+	RemoveKeychainAdmin(ctx context.Context, in *MsgRemoveKeychainAdminRequest, opts ...grpc.CallOption) (*MsgRemoveKeychainAdminResponse, error)
+	// Create the treasury account of a Space.
+	NewSpaceTreasury(ctx context.Context, in *MsgNewSpaceTreasury, opts ...grpc.CallOption) (*MsgNewSpaceTreasuryResponse, error)
+	// Send coins from the treasury of a Space.
+	TreasurySend(ctx context.Context, in *MsgTreasurySend, opts ...grpc.CallOption) (*MsgTreasurySendResponse, error)
+	// Transfer coins from the treasury of a Space to another chain over IBC.
+	TreasuryIBCTransfer(ctx context.Context, in *MsgTreasuryIBCTransfer, opts ...grpc.CallOption) (*MsgTreasuryIBCTransferResponse, error)
+	// Execute a CosmWasm contract on behalf of the treasury of a Space.
+	TreasuryExecuteContract(ctx context.Context, in *MsgTreasuryExecuteContract, opts ...grpc.CallOption) (*MsgTreasuryExecuteContractResponse, error)
 }
 
-func (m *MsgAddSpaceOwner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgAddSpaceOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SpaceId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddSpaceOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) NewSpace(ctx context.Context, in *MsgNewSpace, opts ...grpc.CallOption) (*MsgNewSpaceResponse, error) {
+	out := new(MsgNewSpaceResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewSpace", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddSpaceOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddSpaceOwner(ctx context.Context, in *MsgAddSpaceOwner, opts ...grpc.CallOption) (*MsgAddSpaceOwnerResponse, error) {
+	out := new(MsgAddSpaceOwnerResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/AddSpaceOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddSpaceOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RemoveSpaceOwner(ctx context.Context, in *MsgRemoveSpaceOwner, opts ...grpc.CallOption) (*MsgRemoveSpaceOwnerResponse, error) {
+	out := new(MsgRemoveSpaceOwnerResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/RemoveSpaceOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveSpaceOwner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) NewKeychain(ctx context.Context, in *MsgNewKeychain, opts ...grpc.CallOption) (*MsgNewKeychainResponse, error) {
+	out := new(MsgNewKeychainResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewKeychain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveSpaceOwner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveSpaceOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SpaceId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddKeychainWriter(ctx context.Context, in *MsgAddKeychainWriter, opts ...grpc.CallOption) (*MsgAddKeychainWriterResponse, error) {
+	out := new(MsgAddKeychainWriterResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/AddKeychainWriter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveSpaceOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSpace(ctx context.Context, in *MsgUpdateSpace, opts ...grpc.CallOption) (*MsgUpdateSpaceResponse, error) {
+	out := new(MsgUpdateSpaceResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateSpace", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveSpaceOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveSpaceOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgNewKeychain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateKeychain(ctx context.Context, in *MsgUpdateKeychain, opts ...grpc.CallOption) (*MsgUpdateKeychainResponse, error) {
+	out := new(MsgUpdateKeychainResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateKeychain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgNewKeychain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgNewKeychain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeybaseId) > 0 {
-		i -= len(m.KeybaseId)
-		copy(dAtA[i:], m.KeybaseId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.KeybaseId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.KeychainFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) NewKeyRequest(ctx context.Context, in *MsgNewKeyRequest, opts ...grpc.CallOption) (*MsgNewKeyRequestResponse, error) {
+	out := new(MsgNewKeyRequestResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewKeyRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgNewKeychainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FulfilKeyRequest(ctx context.Context, in *MsgFulfilKeyRequest, opts ...grpc.CallOption) (*MsgFulfilKeyRequestResponse, error) {
+	out := new(MsgFulfilKeyRequestResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/FulfilKeyRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgNewKeychainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgNewKeychainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) UpdateKey(ctx context.Context, in *MsgUpdateKey, opts ...grpc.CallOption) (*MsgUpdateKeyResponse, error) {
+	out := new(MsgUpdateKeyResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/UpdateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddKeychainWriter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) NewSignRequest(ctx context.Context, in *MsgNewSignRequest, opts ...grpc.CallOption) (*MsgNewSignRequestResponse, error) {
+	out := new(MsgNewSignRequestResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewSignRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddKeychainWriter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FulfilSignRequest(ctx context.Context, in *MsgFulfilSignRequest, opts ...grpc.CallOption) (*MsgFulfilSignRequestResponse, error) {
+	out := new(MsgFulfilSignRequestResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/FulfilSignRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddKeychainWriter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Writer) > 0 {
-		i -= len(m.Writer)
-		copy(dAtA[i:], m.Writer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Writer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.KeychainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddKeychainAdmin(ctx context.Context, in *MsgAddKeychainAdminRequest, opts ...grpc.CallOption) (*MsgAddKeychainAdminResponse, error) {
+	out := new(MsgAddKeychainAdminResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/AddKeychainAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddKeychainWriterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveKeychainAdmin(ctx context.Context, in *MsgRemoveKeychainAdminRequest, opts ...grpc.CallOption) (*MsgRemoveKeychainAdminResponse, error) {
+	out := new(MsgRemoveKeychainAdminResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/RemoveKeychainAdmin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddKeychainWriterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) NewSpaceTreasury(ctx context.Context, in *MsgNewSpaceTreasury, opts ...grpc.CallOption) (*MsgNewSpaceTreasuryResponse, error) {
+	out := new(MsgNewSpaceTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/NewSpaceTreasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddKeychainWriterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) TreasurySend(ctx context.Context, in *MsgTreasurySend, opts ...grpc.CallOption) (*MsgTreasurySendResponse, error) {
+	out := new(MsgTreasurySendResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/TreasurySend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSpace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TreasuryIBCTransfer(ctx context.Context, in *MsgTreasuryIBCTransfer, opts ...grpc.CallOption) (*MsgTreasuryIBCTransferResponse, error) {
+	out := new(MsgTreasuryIBCTransferResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/TreasuryIBCTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateSpace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TreasuryExecuteContract(ctx context.Context, in *MsgTreasuryExecuteContract, opts ...grpc.CallOption) (*MsgTreasuryExecuteContractResponse, error) {
+	out := new(MsgTreasuryExecuteContractResponse)
+	err := c.cc.Invoke(ctx, "/warden.warden.v1beta3.Msg/TreasuryExecuteContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RejectSignTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RejectSignTemplateId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ApproveSignTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApproveSignTemplateId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.RejectAdminTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RejectAdminTemplateId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ApproveAdminTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApproveAdminTemplateId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SpaceId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Create a new Space. The creator will be the first owner of the Space.
+	NewSpace(context.Context, *MsgNewSpace) (*MsgNewSpaceResponse, error)
+	// Add a new owner to a Space.
+	AddSpaceOwner(context.Context, *MsgAddSpaceOwner) (*MsgAddSpaceOwnerResponse, error)
+	// Remove an owner from the Space.
+	RemoveSpaceOwner(context.Context, *MsgRemoveSpaceOwner) (*MsgRemoveSpaceOwnerResponse, error)
+	// Create a new Keychain. The user will be the first admin of the Keychain.
+	NewKeychain(context.Context, *MsgNewKeychain) (*MsgNewKeychainResponse, error)
+	// Add a new writer to a Keychain.
+	AddKeychainWriter(context.Context, *MsgAddKeychainWriter) (*MsgAddKeychainWriterResponse, error)
+	// Update a Space.
+	UpdateSpace(context.Context, *MsgUpdateSpace) (*MsgUpdateSpaceResponse, error)
+	// Update a Keychain.
+	UpdateKeychain(context.Context, *MsgUpdateKeychain) (*MsgUpdateKeychainResponse, error)
+	// Create a new KeyRequest.
+	NewKeyRequest(context.Context, *MsgNewKeyRequest) (*MsgNewKeyRequestResponse, error)
+	// Fulfil or reject a KeyRequest.
+	FulfilKeyRequest(context.Context, *MsgFulfilKeyRequest) (*MsgFulfilKeyRequestResponse, error)
+	// Update a Key.
+	UpdateKey(context.Context, *MsgUpdateKey) (*MsgUpdateKeyResponse, error)
+	// Create a new SignRequest.
+	NewSignRequest(context.Context, *MsgNewSignRequest) (*MsgNewSignRequestResponse, error)
+	// Fulfil or reject a SignRequest.
+	FulfilSignRequest(context.Context, *MsgFulfilSignRequest) (*MsgFulfilSignRequestResponse, error)
+	// Add a new admin to a Keychain
+	AddKeychainAdmin(context.Context, *MsgAddKeychainAdminRequest) (*MsgAddKeychainAdminResponse, error)
+	/// Remove admin from a Keychain
+	RemoveKeychainAdmin(context.Context, *MsgRemoveKeychainAdminRequest) (*MsgRemoveKeychainAdminResponse, error)
+	// Create the treasury account of a Space.
+	NewSpaceTreasury(context.Context, *MsgNewSpaceTreasury) (*MsgNewSpaceTreasuryResponse, error)
+	// Send coins from the treasury of a Space.
+	TreasurySend(context.Context, *MsgTreasurySend) (*MsgTreasurySendResponse, error)
+	// Transfer coins from the treasury of a Space to another chain over IBC.
+	TreasuryIBCTransfer(context.Context, *MsgTreasuryIBCTransfer) (*MsgTreasuryIBCTransferResponse, error)
+	// Execute a CosmWasm contract on behalf of the treasury of a Space.
+	TreasuryExecuteContract(context.Context, *MsgTreasuryExecuteContract) (*MsgTreasuryExecuteContractResponse, error)
 }
 
-func (m *MsgUpdateSpaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *MsgUpdateSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) NewSpace(ctx context.Context, req *MsgNewSpace) (*MsgNewSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewSpace not implemented")
 }
-
-func (m *MsgUpdateKeychain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) AddSpaceOwner(ctx context.Context, req *MsgAddSpaceOwner) (*MsgAddSpaceOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSpaceOwner not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSpaceOwner(ctx context.Context, req *MsgRemoveSpaceOwner) (*MsgRemoveSpaceOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSpaceOwner not implemented")
+}
+func (*UnimplementedMsgServer) NewKeychain(ctx context.Context, req *MsgNewKeychain) (*MsgNewKeychainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewKeychain not implemented")
+}
+func (*UnimplementedMsgServer) AddKeychainWriter(ctx context.Context, req *MsgAddKeychainWriter) (*MsgAddKeychainWriterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddKeychainWriter not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSpace(ctx context.Context, req *MsgUpdateSpace) (*MsgUpdateSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpace not implemented")
+}
+func (*UnimplementedMsgServer) UpdateKeychain(ctx context.Context, req *MsgUpdateKeychain) (*MsgUpdateKeychainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeychain not implemented")
+}
+func (*UnimplementedMsgServer) NewKeyRequest(ctx context.Context, req *MsgNewKeyRequest) (*MsgNewKeyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewKeyRequest not implemented")
+}
+func (*UnimplementedMsgServer) FulfilKeyRequest(ctx context.Context, req *MsgFulfilKeyRequest) (*MsgFulfilKeyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfilKeyRequest not implemented")
+}
+func (*UnimplementedMsgServer) UpdateKey(ctx context.Context, req *MsgUpdateKey) (*MsgUpdateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKey not implemented")
+}
+func (*UnimplementedMsgServer) NewSignRequest(ctx context.Context, req *MsgNewSignRequest) (*MsgNewSignRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewSignRequest not implemented")
+}
+func (*UnimplementedMsgServer) FulfilSignRequest(ctx context.Context, req *MsgFulfilSignRequest) (*MsgFulfilSignRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfilSignRequest not implemented")
+}
+func (*UnimplementedMsgServer) AddKeychainAdmin(ctx context.Context, req *MsgAddKeychainAdminRequest) (*MsgAddKeychainAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddKeychainAdmin not implemented")
+}
+func (*UnimplementedMsgServer) RemoveKeychainAdmin(ctx context.Context, req *MsgRemoveKeychainAdminRequest) (*MsgRemoveKeychainAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeychainAdmin not implemented")
+}
+func (*UnimplementedMsgServer) NewSpaceTreasury(ctx context.Context, req *MsgNewSpaceTreasury) (*MsgNewSpaceTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewSpaceTreasury not implemented")
+}
+func (*UnimplementedMsgServer) TreasurySend(ctx context.Context, req *MsgTreasurySend) (*MsgTreasurySendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasurySend not implemented")
+}
+func (*UnimplementedMsgServer) TreasuryIBCTransfer(ctx context.Context, req *MsgTreasuryIBCTransfer) (*MsgTreasuryIBCTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryIBCTransfer not implemented")
+}
+func (*UnimplementedMsgServer) TreasuryExecuteContract(ctx context.Context, req *MsgTreasuryExecuteContract) (*MsgTreasuryExecuteContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryExecuteContract not implemented")
 }
 
-func (m *MsgUpdateKeychain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateKeychain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeybaseId) > 0 {
-		i -= len(m.KeybaseId)
-		copy(dAtA[i:], m.KeybaseId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.KeybaseId)))
-		i--
-		dAtA[i] = 0x42
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/UpdateParams",
 	}
-	{
-		size, err := m.KeychainFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_NewSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewSpace)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.KeychainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).NewSpace(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/NewSpace",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewSpace(ctx, req.(*MsgNewSpace))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateKeychainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddSpaceOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSpaceOwner)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateKeychainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AddSpaceOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/AddSpaceOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSpaceOwner(ctx, req.(*MsgAddSpaceOwner))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateKeychainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddKeychainAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveSpaceOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSpaceOwner)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddKeychainAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddKeychainAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewAdmin) > 0 {
-		i -= len(m.NewAdmin)
-		copy(dAtA[i:], m.NewAdmin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSpaceOwner(ctx, in)
 	}
-	if m.KeychainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/RemoveSpaceOwner",
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSpaceOwner(ctx, req.(*MsgRemoveSpaceOwner))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddKeychainAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_NewKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewKeychain)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddKeychainAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddKeychainAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).NewKeychain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/NewKeychain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewKeychain(ctx, req.(*MsgNewKeychain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveKeychainAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddKeychainWriter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddKeychainWriter)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddKeychainWriter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/AddKeychainWriter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddKeychainWriter(ctx, req.(*MsgAddKeychainWriter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveKeychainAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSpace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/UpdateSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpace(ctx, req.(*MsgUpdateSpace))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveKeychainAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_UpdateKeychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKeychain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.KeychainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateKeychain(ctx, in)
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/UpdateKeychain",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateKeychain(ctx, req.(*MsgUpdateKeychain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveKeychainAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_NewKeyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).NewKeyRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/NewKeyRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewKeyRequest(ctx, req.(*MsgNewKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveKeychainAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_FulfilKeyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfilKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfilKeyRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/FulfilKeyRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfilKeyRequest(ctx, req.(*MsgFulfilKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveKeychainAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/UpdateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateKey(ctx, req.(*MsgUpdateKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgNewKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_NewSignRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewSignRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).NewSignRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/NewSignRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewSignRequest(ctx, req.(*MsgNewSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgNewKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_FulfilSignRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfilSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfilSignRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/FulfilSignRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfilSignRequest(ctx, req.(*MsgFulfilSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgNewKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x40
+func _Msg_AddKeychainAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddKeychainAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MaxKeychainFees) > 0 {
-		for iNdEx := len(m.MaxKeychainFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MaxKeychainFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).AddKeychainAdmin(ctx, in)
 	}
-	if m.RejectTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RejectTemplateId))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/AddKeychainAdmin",
 	}
-	if m.ApproveTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApproveTemplateId))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddKeychainAdmin(ctx, req.(*MsgAddKeychainAdminRequest))
 	}
-	if m.KeyType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveKeychainAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveKeychainAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.KeychainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveKeychainAdmin(ctx, in)
 	}
-	if m.SpaceId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/RemoveKeychainAdmin",
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveKeychainAdmin(ctx, req.(*MsgRemoveKeychainAdminRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgNewKeyRequestResponse) Marshal() (dAtA []byte, err error) {
+func _Msg_NewSpaceTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNewSpaceTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).NewSpaceTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/NewSpaceTreasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NewSpaceTreasury(ctx, req.(*MsgNewSpaceTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TreasurySend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTreasurySend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TreasurySend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/TreasurySend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TreasurySend(ctx, req.(*MsgTreasurySend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TreasuryIBCTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTreasuryIBCTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TreasuryIBCTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/TreasuryIBCTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TreasuryIBCTransfer(ctx, req.(*MsgTreasuryIBCTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TreasuryExecuteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTreasuryExecuteContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TreasuryExecuteContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/warden.warden.v1beta3.Msg/TreasuryExecuteContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TreasuryExecuteContract(ctx, req.(*MsgTreasuryExecuteContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "warden.warden.v1beta3.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "NewSpace",
+			Handler:    _Msg_NewSpace_Handler,
+		},
+		{
+			MethodName: "AddSpaceOwner",
+			Handler:    _Msg_AddSpaceOwner_Handler,
+		},
+		{
+			MethodName: "RemoveSpaceOwner",
+			Handler:    _Msg_RemoveSpaceOwner_Handler,
+		},
+		{
+			MethodName: "NewKeychain",
+			Handler:    _Msg_NewKeychain_Handler,
+		},
+		{
+			MethodName: "AddKeychainWriter",
+			Handler:    _Msg_AddKeychainWriter_Handler,
+		},
+		{
+			MethodName: "UpdateSpace",
+			Handler:    _Msg_UpdateSpace_Handler,
+		},
+		{
+			MethodName: "UpdateKeychain",
+			Handler:    _Msg_UpdateKeychain_Handler,
+		},
+		{
+			MethodName: "NewKeyRequest",
+			Handler:    _Msg_NewKeyRequest_Handler,
+		},
+		{
+			MethodName: "FulfilKeyRequest",
+			Handler:    _Msg_FulfilKeyRequest_Handler,
+		},
+		{
+			MethodName: "UpdateKey",
+			Handler:    _Msg_UpdateKey_Handler,
+		},
+		{
+			MethodName: "NewSignRequest",
+			Handler:    _Msg_NewSignRequest_Handler,
+		},
+		{
+			MethodName: "FulfilSignRequest",
+			Handler:    _Msg_FulfilSignRequest_Handler,
+		},
+		{
+			MethodName: "AddKeychainAdmin",
+			Handler:    _Msg_AddKeychainAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveKeychainAdmin",
+			Handler:    _Msg_RemoveKeychainAdmin_Handler,
+		},
+		{
+			MethodName: "NewSpaceTreasury",
+			Handler:    _Msg_NewSpaceTreasury_Handler,
+		},
+		{
+			MethodName: "TreasurySend",
+			Handler:    _Msg_TreasurySend_Handler,
+		},
+		{
+			MethodName: "TreasuryIBCTransfer",
+			Handler:    _Msg_TreasuryIBCTransfer_Handler,
+		},
+		{
+			MethodName: "TreasuryExecuteContract",
+			Handler:    _Msg_TreasuryExecuteContract_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "warden/warden/v1beta3/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3515,25 +3360,37 @@ func (m *MsgNewKeyRequestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgNewKeyRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgNewKeyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewKey) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3543,27 +3400,20 @@ func (m *MsgNewKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgNewKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgNewKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFulfilKeyRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgNewSpace) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3573,32 +3423,42 @@ func (m *MsgFulfilKeyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFulfilKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgNewSpace) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgNewSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size := m.Result.Size()
-			i -= size
-			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+	if len(m.AdditionalOwners) > 0 {
+		for iNdEx := len(m.AdditionalOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalOwners[iNdEx])
+			copy(dAtA[i:], m.AdditionalOwners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AdditionalOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.RejectSignTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectSignTemplateId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ApproveSignTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApproveSignTemplateId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RejectAdminTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectAdminTemplateId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.RequestId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+	if m.ApproveAdminTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApproveAdminTemplateId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3612,42 +3472,82 @@ func (m *MsgFulfilKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFulfilKeyRequest_Key) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgNewSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilKeyRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgNewSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Key != nil {
-		{
-			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MsgFulfilKeyRequest_RejectReason) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *MsgAddSpaceOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSpaceOwner) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilKeyRequest_RejectReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddSpaceOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	i -= len(m.RejectReason)
-	copy(dAtA[i:], m.RejectReason)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.RejectReason)))
-	i--
-	dAtA[i] = 0x2a
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
-func (m *MsgFulfilKeyRequestResponse) Marshal() (dAtA []byte, err error) {
+
+func (m *MsgAddSpaceOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3657,12 +3557,12 @@ func (m *MsgFulfilKeyRequestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFulfilKeyRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddSpaceOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilKeyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddSpaceOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3670,7 +3570,7 @@ func (m *MsgFulfilKeyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateKey) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveSpaceOwner) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3680,28 +3580,30 @@ func (m *MsgUpdateKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveSpaceOwner) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveSpaceOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RejectTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RejectTemplateId))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x20
 	}
-	if m.ApproveTemplateId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApproveTemplateId))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1a
 	}
-	if m.KeyId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeyId))
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3715,7 +3617,7 @@ func (m *MsgUpdateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateKeyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveSpaceOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3725,12 +3627,12 @@ func (m *MsgUpdateKeyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveSpaceOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveSpaceOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3738,7 +3640,7 @@ func (m *MsgUpdateKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewSignRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgNewKeychain) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3748,74 +3650,65 @@ func (m *MsgNewSignRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgNewSignRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgNewKeychain) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgNewSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgNewKeychain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+	if len(m.KeybaseId) > 0 {
+		i -= len(m.KeybaseId)
+		copy(dAtA[i:], m.KeybaseId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeybaseId)))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x3a
 	}
-	if len(m.MaxKeychainFees) > 0 {
-		for iNdEx := len(m.MaxKeychainFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MaxKeychainFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.EncryptionKey) > 0 {
-		i -= len(m.EncryptionKey)
-		copy(dAtA[i:], m.EncryptionKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptionKey)))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Analyzers) > 0 {
-		for iNdEx := len(m.Analyzers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Analyzers[iNdEx])
-			copy(dAtA[i:], m.Analyzers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Analyzers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	{
+		size, err := m.KeychainFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Input) > 0 {
-		i -= len(m.Input)
-		copy(dAtA[i:], m.Input)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Input)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.KeyId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeyId))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewSignRequestResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgNewKeychainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3825,12 +3718,12 @@ func (m *MsgNewSignRequestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgNewSignRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgNewKeychainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgNewSignRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgNewKeychainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3843,7 +3736,7 @@ func (m *MsgNewSignRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSignedData) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddKeychainWriter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3853,27 +3746,39 @@ func (m *MsgSignedData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSignedData) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddKeychainWriter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSignedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddKeychainWriter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignedData) > 0 {
-		i -= len(m.SignedData)
-		copy(dAtA[i:], m.SignedData)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SignedData)))
+	if len(m.Writer) > 0 {
+		i -= len(m.Writer)
+		copy(dAtA[i:], m.Writer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Writer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeychainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFulfilSignRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddKeychainWriterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3883,81 +3788,176 @@ func (m *MsgFulfilSignRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFulfilSignRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddKeychainWriterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddKeychainWriterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != nil {
-		{
-			size := m.Result.Size()
-			i -= size
-			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectSignTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectSignTemplateId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ApproveSignTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApproveSignTemplateId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RejectAdminTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectAdminTemplateId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ApproveAdminTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApproveAdminTemplateId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.RequestId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFulfilSignRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilSignRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Payload != nil {
-		{
-			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
-func (m *MsgFulfilSignRequest_RejectReason) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *MsgUpdateKeychain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateKeychain) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilSignRequest_RejectReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateKeychain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	i -= len(m.RejectReason)
-	copy(dAtA[i:], m.RejectReason)
-	i = encodeVarintTx(dAtA, i, uint64(len(m.RejectReason)))
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeybaseId) > 0 {
+		i -= len(m.KeybaseId)
+		copy(dAtA[i:], m.KeybaseId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeybaseId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.KeychainFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x2a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeychainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
-func (m *MsgFulfilSignRequestResponse) Marshal() (dAtA []byte, err error) {
+
+func (m *MsgUpdateKeychainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3967,12 +3967,12 @@ func (m *MsgFulfilSignRequestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFulfilSignRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateKeychainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilSignRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateKeychainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3980,631 +3980,2459 @@ func (m *MsgFulfilSignRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddKeychainAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgAddKeychainAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgNewSpace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddKeychainAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ApproveAdminTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.ApproveAdminTemplateId))
-	}
-	if m.RejectAdminTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.RejectAdminTemplateId))
-	}
-	if m.ApproveSignTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.ApproveSignTemplateId))
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RejectSignTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.RejectSignTemplateId))
+	if m.KeychainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.AdditionalOwners) > 0 {
-		for _, s := range m.AdditionalOwners {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewSpaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (m *MsgAddKeychainAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddSpaceOwner) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SpaceId != 0 {
-		n += 1 + sovTx(uint64(m.SpaceId))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	return n
+func (m *MsgAddKeychainAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddSpaceOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddKeychainAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveSpaceOwner) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveKeychainAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveKeychainAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveKeychainAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SpaceId != 0 {
-		n += 1 + sovTx(uint64(m.SpaceId))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.KeychainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveSpaceOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveKeychainAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveKeychainAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveKeychainAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewKeychain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgNewKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MaxKeychainFees) > 0 {
+		for iNdEx := len(m.MaxKeychainFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxKeychainFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = m.KeychainFees.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RejectTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectTemplateId))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ApproveTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApproveTemplateId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.KeybaseId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.KeychainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeychainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewKeychainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgNewKeyRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewKeyRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewKeyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddKeychainWriter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.KeychainId != 0 {
-		n += 1 + sovTx(uint64(m.KeychainId))
-	}
-	l = len(m.Writer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgNewKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddKeychainWriterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgNewKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateSpace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgNewKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SpaceId != 0 {
-		n += 1 + sovTx(uint64(m.SpaceId))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	if m.ApproveAdminTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.ApproveAdminTemplateId))
-	}
-	if m.RejectAdminTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.RejectAdminTemplateId))
-	}
-	if m.ApproveSignTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.ApproveSignTemplateId))
-	}
-	if m.RejectSignTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.RejectSignTemplateId))
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSpaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfilKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateKeychain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFulfilKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfilKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.KeychainId != 0 {
-		n += 1 + sovTx(uint64(m.KeychainId))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Result != nil {
+		{
+			size := m.Result.Size()
+			i -= size
+			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	l = m.KeychainFees.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.KeybaseId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateKeychainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgFulfilKeyRequest_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddKeychainAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.KeychainId != 0 {
-		n += 1 + sovTx(uint64(m.KeychainId))
-	}
-	l = len(m.NewAdmin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgFulfilKeyRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Key != nil {
+		{
+			size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *MsgFulfilKeyRequest_RejectReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddKeychainAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfilKeyRequest_RejectReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.RejectReason)
+	copy(dAtA[i:], m.RejectReason)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.RejectReason)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *MsgFulfilKeyRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfilKeyRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfilKeyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveKeychainAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RejectTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RejectTemplateId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.KeychainId != 0 {
-		n += 1 + sovTx(uint64(m.KeychainId))
+	if m.ApproveTemplateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApproveTemplateId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.KeyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveKeychainAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgNewKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewSignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.SpaceId != 0 {
-		n += 1 + sovTx(uint64(m.SpaceId))
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewSignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.KeychainId != 0 {
-		n += 1 + sovTx(uint64(m.KeychainId))
+	if len(m.MaxKeychainFees) > 0 {
+		for iNdEx := len(m.MaxKeychainFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxKeychainFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.KeyType != 0 {
-		n += 1 + sovTx(uint64(m.KeyType))
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ApproveTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.ApproveTemplateId))
+	if len(m.Analyzers) > 0 {
+		for iNdEx := len(m.Analyzers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Analyzers[iNdEx])
+			copy(dAtA[i:], m.Analyzers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Analyzers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.RejectTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.RejectTemplateId))
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.MaxKeychainFees) > 0 {
-		for _, e := range m.MaxKeychainFees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.KeyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewKeyRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgNewSignRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewSignRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewSignRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgNewKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSignedData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFulfilKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequestId != 0 {
-		n += 1 + sovTx(uint64(m.RequestId))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	if m.Result != nil {
-		n += m.Result.Size()
-	}
-	return n
+func (m *MsgSignedData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfilKeyRequest_Key) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSignedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Key != nil {
-		l = m.Key.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SignedData) > 0 {
+		i -= len(m.SignedData)
+		copy(dAtA[i:], m.SignedData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SignedData)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MsgFulfilKeyRequest_RejectReason) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgFulfilSignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RejectReason)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
-func (m *MsgFulfilKeyRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *MsgFulfilSignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFulfilSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Result != nil {
+		{
+			size := m.Result.Size()
+			i -= size
+			if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.KeyId != 0 {
-		n += 1 + sovTx(uint64(m.KeyId))
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ApproveTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.ApproveTemplateId))
+	if m.RequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.RejectTemplateId != 0 {
-		n += 1 + sovTx(uint64(m.RejectTemplateId))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfilSignRequest_Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfilSignRequest_Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgFulfilSignRequest_RejectReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfilSignRequest_RejectReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.RejectReason)
+	copy(dAtA[i:], m.RejectReason)
+	i = encodeVarintTx(dAtA, i, uint64(len(m.RejectReason)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *MsgFulfilSignRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfilSignRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfilSignRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewSpaceTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewSpaceTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewSpaceTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewSpaceTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewSpaceTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewSpaceTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasurySend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasurySend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasurySend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasurySendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasurySendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasurySendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasuryIBCTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasuryIBCTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasuryIBCTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutRevisionHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutRevisionNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasuryIBCTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasuryIBCTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasuryIBCTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasuryExecuteContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasuryExecuteContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasuryExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTreasuryExecuteContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTreasuryExecuteContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTreasuryExecuteContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApproveAdminTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.ApproveAdminTemplateId))
+	}
+	if m.RejectAdminTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.RejectAdminTemplateId))
+	}
+	if m.ApproveSignTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.ApproveSignTemplateId))
+	}
+	if m.RejectSignTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.RejectSignTemplateId))
+	}
+	if len(m.AdditionalOwners) > 0 {
+		for _, s := range m.AdditionalOwners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgNewSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAddSpaceOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgAddSpaceOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSpaceOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRemoveSpaceOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewKeychain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.KeychainFees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeybaseId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgNewKeychainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAddKeychainWriter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeychainId != 0 {
+		n += 1 + sovTx(uint64(m.KeychainId))
+	}
+	l = len(m.Writer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddKeychainWriterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.ApproveAdminTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.ApproveAdminTemplateId))
+	}
+	if m.RejectAdminTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.RejectAdminTemplateId))
+	}
+	if m.ApproveSignTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.ApproveSignTemplateId))
+	}
+	if m.RejectSignTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.RejectSignTemplateId))
+	}
+	return n
+}
+
+func (m *MsgUpdateSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateKeychain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeychainId != 0 {
+		n += 1 + sovTx(uint64(m.KeychainId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.KeychainFees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeybaseId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateKeychainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddKeychainAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeychainId != 0 {
+		n += 1 + sovTx(uint64(m.KeychainId))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddKeychainAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveKeychainAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeychainId != 0 {
+		n += 1 + sovTx(uint64(m.KeychainId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveKeychainAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.KeychainId != 0 {
+		n += 1 + sovTx(uint64(m.KeychainId))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	if m.ApproveTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.ApproveTemplateId))
+	}
+	if m.RejectTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.RejectTemplateId))
+	}
+	if len(m.MaxKeychainFees) > 0 {
+		for _, e := range m.MaxKeychainFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgNewKeyRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgNewKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfilKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.Result != nil {
+		n += m.Result.Size()
+	}
+	return n
+}
+
+func (m *MsgFulfilKeyRequest_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgFulfilKeyRequest_RejectReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RejectReason)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgFulfilKeyRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovTx(uint64(m.KeyId))
+	}
+	if m.ApproveTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.ApproveTemplateId))
+	}
+	if m.RejectTemplateId != 0 {
+		n += 1 + sovTx(uint64(m.RejectTemplateId))
+	}
+	return n
+}
+
+func (m *MsgUpdateKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewSignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyId != 0 {
+		n += 1 + sovTx(uint64(m.KeyId))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Analyzers) > 0 {
+		for _, s := range m.Analyzers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxKeychainFees) > 0 {
+		for _, e := range m.MaxKeychainFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgNewSignRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgSignedData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignedData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfilSignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestId != 0 {
+		n += 1 + sovTx(uint64(m.RequestId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.Result != nil {
+		n += m.Result.Size()
+	}
+	return n
+}
+
+func (m *MsgFulfilSignRequest_Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgFulfilSignRequest_RejectReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RejectReason)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+func (m *MsgFulfilSignRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNewSpaceTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgNewSpaceTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTreasurySend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTreasurySendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTreasuryIBCTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutRevisionNumber != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutRevisionNumber))
+	}
+	if m.TimeoutRevisionHeight != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutRevisionHeight))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTreasuryIBCTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgTreasuryExecuteContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTreasuryExecuteContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAdminTemplateId", wireType)
+			}
+			m.ApproveAdminTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproveAdminTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectAdminTemplateId", wireType)
+			}
+			m.RejectAdminTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectAdminTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveSignTemplateId", wireType)
+			}
+			m.ApproveSignTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproveSignTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectSignTemplateId", wireType)
+			}
+			m.RejectSignTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectSignTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalOwners = append(m.AdditionalOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewSpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewSpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSpaceOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSpaceOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSpaceOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgNewSignRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.KeyId != 0 {
-		n += 1 + sovTx(uint64(m.KeyId))
-	}
-	l = len(m.Input)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Analyzers) > 0 {
-		for _, s := range m.Analyzers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	l = len(m.EncryptionKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.MaxKeychainFees) > 0 {
-		for _, e := range m.MaxKeychainFees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSpaceOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSpaceOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	return n
-}
-
-func (m *MsgNewSignRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	return n
-}
-
-func (m *MsgSignedData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SignedData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgFulfilSignRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequestId != 0 {
-		n += 1 + sovTx(uint64(m.RequestId))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	if m.Result != nil {
-		n += m.Result.Size()
-	}
-	return n
-}
 
-func (m *MsgFulfilSignRequest_Payload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		l = m.Payload.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-func (m *MsgFulfilSignRequest_RejectReason) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RejectReason)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-func (m *MsgFulfilSignRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSpaceOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4627,15 +6455,66 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSpaceOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSpaceOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4663,13 +6542,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4679,25 +6558,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4719,7 +6584,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4742,10 +6607,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSpaceOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSpaceOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4769,7 +6634,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
+func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4792,10 +6657,10 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSpace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewKeychain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewKeychain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4831,10 +6696,10 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAdminTemplateId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ApproveAdminTemplateId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4844,16 +6709,29 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproveAdminTemplateId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectAdminTemplateId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.RejectAdminTemplateId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeychainFees", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4863,16 +6741,30 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectAdminTemplateId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveSignTemplateId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ApproveSignTemplateId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeychainFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4882,16 +6774,29 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproveSignTemplateId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectSignTemplateId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.RejectSignTemplateId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4901,14 +6806,27 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectSignTemplateId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeybaseId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4936,7 +6854,7 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalOwners = append(m.AdditionalOwners, string(dAtA[iNdEx:postIndex]))
+			m.KeybaseId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4959,7 +6877,7 @@ func (m *MsgNewSpace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewSpaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgNewKeychainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4982,10 +6900,10 @@ func (m *MsgNewSpaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSpaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewKeychainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewKeychainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5028,7 +6946,7 @@ func (m *MsgNewSpaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddSpaceOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgAddKeychainWriter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5051,15 +6969,15 @@ func (m *MsgAddSpaceOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSpaceOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddKeychainWriter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSpaceOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddKeychainWriter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5087,13 +7005,13 @@ func (m *MsgAddSpaceOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
 			}
-			m.SpaceId = 0
+			m.KeychainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5103,14 +7021,14 @@ func (m *MsgAddSpaceOwner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpaceId |= uint64(b&0x7F) << shift
+				m.KeychainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Writer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5138,27 +7056,8 @@ func (m *MsgAddSpaceOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.Writer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5180,7 +7079,7 @@ func (m *MsgAddSpaceOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddKeychainWriterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5203,10 +7102,10 @@ func (m *MsgAddSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSpaceOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddKeychainWriterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSpaceOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddKeychainWriterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5230,7 +7129,7 @@ func (m *MsgAddSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveSpaceOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5253,10 +7152,10 @@ func (m *MsgRemoveSpaceOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveSpaceOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSpace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveSpaceOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSpace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5305,16 +7204,73 @@ func (m *MsgRemoveSpaceOwner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpaceId |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAdminTemplateId", wireType)
+			}
+			m.ApproveAdminTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproveAdminTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectAdminTemplateId", wireType)
+			}
+			m.RejectAdminTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectAdminTemplateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveSignTemplateId", wireType)
 			}
-			var stringLen uint64
+			m.ApproveSignTemplateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5324,29 +7280,16 @@ func (m *MsgRemoveSpaceOwner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ApproveSignTemplateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectSignTemplateId", wireType)
 			}
-			m.Nonce = 0
+			m.RejectSignTemplateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5356,7 +7299,7 @@ func (m *MsgRemoveSpaceOwner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.RejectSignTemplateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5382,7 +7325,7 @@ func (m *MsgRemoveSpaceOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSpaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5405,10 +7348,10 @@ func (m *MsgRemoveSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveSpaceOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSpaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveSpaceOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5432,7 +7375,7 @@ func (m *MsgRemoveSpaceOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5455,10 +7398,10 @@ func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKeychain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeychain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKeychain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeychain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5494,6 +7437,25 @@ func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
+			}
+			m.KeychainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeychainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -5525,7 +7487,7 @@ func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KeychainFees", wireType)
 			}
@@ -5558,7 +7520,7 @@ func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -5590,7 +7552,7 @@ func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
@@ -5622,7 +7584,7 @@ func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 			}
 			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KeybaseId", wireType)
 			}
@@ -5675,7 +7637,190 @@ func (m *MsgNewKeychain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewKeychainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeychainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateKeychainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateKeychainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddKeychainAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddKeychainAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddKeychainAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
+			}
+			m.KeychainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeychainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddKeychainAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5698,31 +7843,12 @@ func (m *MsgNewKeychainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKeychainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddKeychainAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKeychainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddKeychainAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5744,7 +7870,7 @@ func (m *MsgNewKeychainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddKeychainWriter) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5767,15 +7893,15 @@ func (m *MsgAddKeychainWriter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKeychainWriter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveKeychainAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKeychainWriter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveKeychainAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5803,7 +7929,7 @@ func (m *MsgAddKeychainWriter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -5826,7 +7952,7 @@ func (m *MsgAddKeychainWriter) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Writer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5854,7 +7980,7 @@ func (m *MsgAddKeychainWriter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Writer = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5877,7 +8003,7 @@ func (m *MsgAddKeychainWriter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddKeychainWriterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveKeychainAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5900,10 +8026,10 @@ func (m *MsgAddKeychainWriterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKeychainWriterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveKeychainAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKeychainWriterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveKeychainAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5927,7 +8053,7 @@ func (m *MsgAddKeychainWriterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
+func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5950,10 +8076,10 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6009,9 +8135,9 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
 			}
-			m.Nonce = 0
+			m.KeychainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6021,16 +8147,16 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.KeychainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAdminTemplateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 			}
-			m.ApproveAdminTemplateId = 0
+			m.KeyType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6040,16 +8166,16 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproveAdminTemplateId |= uint64(b&0x7F) << shift
+				m.KeyType |= KeyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectAdminTemplateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
 			}
-			m.RejectAdminTemplateId = 0
+			m.ApproveTemplateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6059,16 +8185,16 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectAdminTemplateId |= uint64(b&0x7F) << shift
+				m.ApproveTemplateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveSignTemplateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
 			}
-			m.ApproveSignTemplateId = 0
+			m.RejectTemplateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6078,16 +8204,50 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproveSignTemplateId |= uint64(b&0x7F) << shift
+				m.RejectTemplateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeychainFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxKeychainFees = append(m.MaxKeychainFees, types.Coin{})
+			if err := m.MaxKeychainFees[len(m.MaxKeychainFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectSignTemplateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.RejectSignTemplateId = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6097,7 +8257,7 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectSignTemplateId |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6123,7 +8283,7 @@ func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6146,12 +8306,31 @@ func (m *MsgUpdateSpaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewKeyRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6173,7 +8352,7 @@ func (m *MsgUpdateSpaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
+func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6196,17 +8375,17 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeychain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeychain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6216,46 +8395,79 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.KeychainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.KeychainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfilKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfilKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfilKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6283,13 +8495,13 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeychainFees", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6299,30 +8511,16 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.KeychainFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6332,29 +8530,16 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= KeyRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6364,27 +8549,30 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			v := &MsgNewKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &MsgFulfilKeyRequest_Key{v}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeybaseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6412,7 +8600,7 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeybaseId = string(dAtA[iNdEx:postIndex])
+			m.Result = &MsgFulfilKeyRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6435,7 +8623,7 @@ func (m *MsgUpdateKeychain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeychainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfilKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6458,10 +8646,10 @@ func (m *MsgUpdateKeychainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeychainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfilKeyRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeychainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfilKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6485,7 +8673,7 @@ func (m *MsgUpdateKeychainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddKeychainAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6508,10 +8696,10 @@ func (m *MsgAddKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKeychainAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKeychainAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6548,9 +8736,9 @@ func (m *MsgAddKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			m.KeychainId = 0
+			m.KeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6560,16 +8748,16 @@ func (m *MsgAddKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeychainId |= uint64(b&0x7F) << shift
+				m.KeyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
 			}
-			var stringLen uint64
+			m.ApproveTemplateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6579,24 +8767,30 @@ func (m *MsgAddKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ApproveTemplateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RejectTemplateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectTemplateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6618,7 +8812,7 @@ func (m *MsgAddKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddKeychainAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6641,10 +8835,10 @@ func (m *MsgAddKeychainAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKeychainAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKeychainAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6668,7 +8862,7 @@ func (m *MsgAddKeychainAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveKeychainAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6691,10 +8885,10 @@ func (m *MsgRemoveKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveKeychainAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewSignRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveKeychainAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6731,9 +8925,9 @@ func (m *MsgRemoveKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
-			m.KeychainId = 0
+			m.KeyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6743,16 +8937,150 @@ func (m *MsgRemoveKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeychainId |= uint64(b&0x7F) << shift
+				m.KeyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyzers = append(m.Analyzers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = append(m.EncryptionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptionKey == nil {
+				m.EncryptionKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeychainFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxKeychainFees = append(m.MaxKeychainFees, types.Coin{})
+			if err := m.MaxKeychainFees[len(m.MaxKeychainFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6762,24 +9090,11 @@ func (m *MsgRemoveKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6801,7 +9116,7 @@ func (m *MsgRemoveKeychainAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveKeychainAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgNewSignRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6824,12 +9139,31 @@ func (m *MsgRemoveKeychainAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveKeychainAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewSignRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveKeychainAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewSignRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6851,7 +9185,7 @@ func (m *MsgRemoveKeychainAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6874,17 +9208,17 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSignedData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSignedData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6894,67 +9228,81 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.SignedData = append(m.SignedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedData == nil {
+				m.SignedData = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SpaceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SpaceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeychainId", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.KeychainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.KeychainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.KeyType = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfilSignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfilSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6964,16 +9312,29 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyType |= KeyType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			m.ApproveTemplateId = 0
+			m.RequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6983,16 +9344,16 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproveTemplateId |= uint64(b&0x7F) << shift
+				m.RequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.RejectTemplateId = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7002,14 +9363,14 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectTemplateId |= uint64(b&0x7F) << shift
+				m.Status |= SignRequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeychainFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7036,85 +9397,17 @@ func (m *MsgNewKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxKeychainFees = append(m.MaxKeychainFees, types.Coin{})
-			if err := m.MaxKeychainFees[len(m.MaxKeychainFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &MsgSignedData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKeyRequestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			m.Result = &MsgFulfilSignRequest_Payload{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7124,11 +9417,24 @@ func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = &MsgFulfilSignRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7150,7 +9456,7 @@ func (m *MsgNewKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfilSignRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7173,46 +9479,12 @@ func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfilSignRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfilSignRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7234,7 +9506,7 @@ func (m *MsgNewKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfilKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgNewSpaceTreasury) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7257,15 +9529,15 @@ func (m *MsgFulfilKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfilKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewSpaceTreasury: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfilKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewSpaceTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7293,13 +9565,13 @@ func (m *MsgFulfilKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			m.RequestId = 0
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7309,70 +9581,16 @@ func (m *MsgFulfilKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= KeyRequestStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MsgNewKey{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Result = &MsgFulfilKeyRequest_Key{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7382,24 +9600,11 @@ func (m *MsgFulfilKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = &MsgFulfilKeyRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7421,7 +9626,7 @@ func (m *MsgFulfilKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfilKeyRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgNewSpaceTreasuryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7444,12 +9649,44 @@ func (m *MsgFulfilKeyRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfilKeyRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgNewSpaceTreasuryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfilKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgNewSpaceTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7471,7 +9708,7 @@ func (m *MsgFulfilKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasurySend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7494,10 +9731,10 @@ func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasurySend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasurySend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7534,9 +9771,9 @@ func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			m.KeyId = 0
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7546,16 +9783,16 @@ func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyId |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveTemplateId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			m.ApproveTemplateId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7565,16 +9802,29 @@ func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproveTemplateId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectTemplateId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.RejectTemplateId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7584,11 +9834,26 @@ func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RejectTemplateId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7610,7 +9875,7 @@ func (m *MsgUpdateKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasurySendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7633,10 +9898,10 @@ func (m *MsgUpdateKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasurySendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasurySendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7660,7 +9925,7 @@ func (m *MsgUpdateKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasuryIBCTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7683,10 +9948,10 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasuryIBCTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasuryIBCTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7723,9 +9988,9 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			m.KeyId = 0
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7735,16 +10000,16 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeyId |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7754,29 +10019,27 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
-			if m.Input == nil {
-				m.Input = []byte{}
-			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyzers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7804,13 +10067,13 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyzers = append(m.Analyzers, string(dAtA[iNdEx:postIndex]))
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7820,31 +10083,30 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptionKey = append(m.EncryptionKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptionKey == nil {
-				m.EncryptionKey = []byte{}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeychainFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7854,31 +10116,29 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxKeychainFees = append(m.MaxKeychainFees, types.Coin{})
-			if err := m.MaxKeychainFees[len(m.MaxKeychainFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionNumber", wireType)
 			}
-			m.Nonce = 0
+			m.TimeoutRevisionNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7888,66 +10148,54 @@ func (m *MsgNewSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.TimeoutRevisionNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutRevisionHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgNewSignRequestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.TimeoutRevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutRevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewSignRequestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewSignRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7957,11 +10205,24 @@ func (m *MsgNewSignRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7983,7 +10244,7 @@ func (m *MsgNewSignRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasuryIBCTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8006,17 +10267,17 @@ func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignedData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasuryIBCTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignedData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasuryIBCTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8026,26 +10287,11 @@ func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SignedData = append(m.SignedData[:0], dAtA[iNdEx:postIndex]...)
-			if m.SignedData == nil {
-				m.SignedData = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8067,7 +10313,7 @@ func (m *MsgSignedData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasuryExecuteContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8090,15 +10336,15 @@ func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfilSignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasuryExecuteContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfilSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasuryExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8126,13 +10372,13 @@ func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			m.RequestId = 0
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8142,16 +10388,16 @@ func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8161,16 +10407,29 @@ func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= SignRequestStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8180,32 +10439,31 @@ func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgSignedData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
-			m.Result = &MsgFulfilSignRequest_Payload{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8215,23 +10473,25 @@ func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &MsgFulfilSignRequest_RejectReason{string(dAtA[iNdEx:postIndex])}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8254,7 +10514,7 @@ func (m *MsgFulfilSignRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfilSignRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTreasuryExecuteContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8277,12 +10537,46 @@ func (m *MsgFulfilSignRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfilSignRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTreasuryExecuteContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfilSignRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTreasuryExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])